@@ -16,18 +16,23 @@ package appmanager
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type AppManagerServiceClientConfig struct {
 	ServerAddr  string
 	DialTimeout time.Duration
 	CallTimeout time.Duration
+	TLSConfig   *tls.Config // optional; if it's specified, TLS is used
 }
 
 // AppManagerService represents a client service for working with the AppManager Service.
@@ -65,7 +70,12 @@ func (s *AppManagerService) Init() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.config.DialTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithInsecure(), grpc.WithBlock())
+	creds := insecure.NewCredentials()
+	if s.config.TLSConfig != nil {
+		creds = credentials.NewTLS(s.config.TLSConfig)
+	}
+
+	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
 
 	if err != nil {
 		return fmt.Errorf("[appmanager.AppManagerService.Init] create a client connection: %w", err)
@@ -98,3 +108,23 @@ func (s *AppManagerService) Dispose() error {
 	s.disposed = true
 	return nil
 }
+
+// HealthCheck checks the state of the connection. The connection isn't healthy
+// if it has failed to connect or has been closed.
+func (s *AppManagerService) HealthCheck(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isInitialized || s.disposed {
+		return errors.New("[appmanager.AppManagerService.HealthCheck] AppManagerService not initialized or disposed")
+	}
+
+	switch st := s.conn.GetState(); st {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("[appmanager.AppManagerService.HealthCheck] connection state is %s", st)
+	case connectivity.Idle:
+		// reconnect now rather than on the next call
+		s.conn.Connect()
+	}
+	return nil
+}
@@ -1,8 +1,58 @@
 package config
 
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"personal-website-v2/pkg/net/tlsconfig"
+)
+
 // AppManagerService, LoggingManagerService.
 type ServiceClientConfig struct {
-	ServerAddr  string `json:"serverAddr"`
-	DialTimeout int64  `json:"dialTimeout"` // in milliseconds
-	CallTimeout int64  `json:"callTimeout"` // in milliseconds
+	ServerAddr  string     `json:"serverAddr"`
+	DialTimeout int64      `json:"dialTimeout"` // in milliseconds
+	CallTimeout int64      `json:"callTimeout"` // in milliseconds
+	TLS         *ClientTLS `json:"tls"`         // optional
+}
+
+// TLSConfig returns the TLS config of the client or nil if TLS isn't used.
+func (c *ServiceClientConfig) TLSConfig() (*tls.Config, error) {
+	if c.TLS == nil {
+		return nil, nil
+	}
+
+	tc, err := tlsconfig.NewClientConfig(c.TLS.Options(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("[config.ServiceClientConfig.TLSConfig] new client TLS config: %w", err)
+	}
+	return tc, nil
+}
+
+type ClientTLS struct {
+	// Optional. The file of the CA certificates (PEM) used to verify the server certificate.
+	CAFile string `json:"caFile"`
+
+	// Optional. The certificate file (PEM) presented to the server if it requires mutual TLS.
+	CertFile string `json:"certFile"`
+
+	// Optional. The private key file (PEM) of the client certificate.
+	KeyFile string `json:"keyFile"`
+
+	// Optional. The server name used to verify the server certificate.
+	ServerName string `json:"serverName"`
+
+	// The interval (in seconds) at which the client certificate files are checked for changes.
+	// If it's 0, the files aren't reloaded.
+	ReloadInterval int64 `json:"reloadInterval"`
+}
+
+func (t *ClientTLS) Options() *tlsconfig.ClientOptions {
+	return &tlsconfig.ClientOptions{
+		CAFile:         t.CAFile,
+		CertFile:       t.CertFile,
+		KeyFile:        t.KeyFile,
+		ServerName:     t.ServerName,
+		ReloadInterval: time.Duration(t.ReloadInterval) * time.Second,
+	}
 }
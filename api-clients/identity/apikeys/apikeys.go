@@ -0,0 +1,115 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	apikeyspb "personal-website-v2/go-apis/identity/apikeys"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type ApiKeysService struct {
+	client apikeyspb.ApiKeyServiceClient
+	config *config.ServiceConfig
+}
+
+var _ ApiKeys = (*ApiKeysService)(nil)
+
+func NewApiKeysService(conn *grpc.ClientConn, config *config.ServiceConfig) *ApiKeysService {
+	return &ApiKeysService{
+		client: apikeyspb.NewApiKeyServiceClient(conn),
+		config: config,
+	}
+}
+
+func (s *ApiKeysService) Authenticate(ctx *actions.OperationContext, key []byte) (*AuthenticationResult, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.apikeys.ApiKeysService.Authenticate] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &apikeyspb.AuthenticateRequest{Key: key}
+	res, err := s.client.Authenticate(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.apikeys.ApiKeysService.Authenticate] authenticate an API key: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return newAuthenticationResult(res), nil
+}
+
+func (s *ApiKeysService) AuthenticateById(ctx *actions.OperationContext, id uint64) (*AuthenticationResult, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.apikeys.ApiKeysService.AuthenticateById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &apikeyspb.AuthenticateByIdRequest{Id: id}
+	res, err := s.client.AuthenticateById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.apikeys.ApiKeysService.AuthenticateById] authenticate an API key by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return newAuthenticationResult(res), nil
+}
+
+func (s *ApiKeysService) Revoke(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.apikeys.ApiKeysService.Revoke] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &apikeyspb.RevokeRequest{Id: id}
+	_, err = s.client.Revoke(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.apikeys.ApiKeysService.Revoke] revoke an API key: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+func newAuthenticationResult(res *apikeyspb.AuthenticateResponse) *AuthenticationResult {
+	r := &AuthenticationResult{
+		ApiKeyId: res.ApiKeyId,
+		UserType: res.UserType,
+	}
+
+	if res.UserId != nil {
+		r.UserId = nullable.NewNullable(res.UserId.Value)
+	}
+	if res.ClientId != nil {
+		r.ClientId = nullable.NewNullable(res.ClientId.Value)
+	}
+	if res.Permissions != nil {
+		r.PermissionIds = res.Permissions.PermissionIds
+		if r.PermissionIds == nil {
+			r.PermissionIds = []uint64{}
+		}
+	}
+	return r
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"personal-website-v2/pkg/actions"
+)
+
+type ApiKeys interface {
+	// Authenticate authenticates the owner of the specified API key.
+	Authenticate(ctx *actions.OperationContext, key []byte) (*AuthenticationResult, error)
+
+	// AuthenticateById authenticates the owner of the API key by the specified API key ID.
+	AuthenticateById(ctx *actions.OperationContext, id uint64) (*AuthenticationResult, error)
+
+	// Revoke revokes an API key by the specified API key ID.
+	Revoke(ctx *actions.OperationContext, id uint64) error
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	userspb "personal-website-v2/go-apis/identity/users"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type AuthenticationResult struct {
+	// The API key ID.
+	ApiKeyId uint64
+
+	// The ID of the user who owns the personal API key.
+	UserId nullable.Nullable[uint64]
+
+	// The type of the user who owns the personal API key.
+	UserType userspb.UserTypeEnum_UserType
+
+	// The ID of the service client that owns the service API key.
+	ClientId nullable.Nullable[uint64]
+
+	// The IDs of the permissions the key is restricted to.
+	// If it's nil, the key isn't restricted and has all the permissions of its owner.
+	PermissionIds []uint64
+}
@@ -142,3 +142,48 @@ func (s *AuthenticationService) AuthenticateClient(ctx *actions.OperationContext
 		ClientId: res.ClientId,
 	}, nil
 }
+
+// CreateServiceClientToken creates an access token of the service client by the client credentials
+// and returns it if the operation is successful.
+func (s *AuthenticationService) CreateServiceClientToken(ctx *actions.OperationContext, clientId uint64, clientSecret string) (*ServiceClientToken, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authentication.AuthenticationService.CreateServiceClientToken] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &authenticationpb.CreateServiceClientTokenRequest{
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+	}
+	res, err := s.client.CreateServiceClientToken(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authentication.AuthenticationService.CreateServiceClientToken] create a service client token: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return &ServiceClientToken{
+		Token:     res.Token,
+		ExpiresAt: res.ExpiresAt.AsTime(),
+	}, nil
+}
+
+// AuthenticateServiceClient authenticates a service client.
+func (s *AuthenticationService) AuthenticateServiceClient(ctx *actions.OperationContext, clientToken []byte) (ClientAuthenticationResult, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return ClientAuthenticationResult{}, fmt.Errorf("[identity.authentication.AuthenticationService.AuthenticateServiceClient] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &authenticationpb.AuthenticateServiceClientRequest{ClientToken: clientToken}
+	res, err := s.client.AuthenticateServiceClient(ctx2, req)
+	if err != nil {
+		return ClientAuthenticationResult{}, fmt.Errorf("[identity.authentication.AuthenticationService.AuthenticateServiceClient] authenticate a service client: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return ClientAuthenticationResult{
+		ClientId: res.ClientId,
+	}, nil
+}
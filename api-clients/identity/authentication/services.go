@@ -33,4 +33,11 @@ type Authentication interface {
 
 	// AuthenticateClient authenticates a client.
 	AuthenticateClient(ctx *actions.OperationContext, clientToken []byte) (ClientAuthenticationResult, error)
+
+	// CreateServiceClientToken creates an access token of the service client by the client credentials
+	// and returns it if the operation is successful.
+	CreateServiceClientToken(ctx *actions.OperationContext, clientId uint64, clientSecret string) (*ServiceClientToken, error)
+
+	// AuthenticateServiceClient authenticates a service client.
+	AuthenticateServiceClient(ctx *actions.OperationContext, clientToken []byte) (ClientAuthenticationResult, error)
 }
@@ -15,6 +15,8 @@
 package authentication
 
 import (
+	"time"
+
 	userspb "personal-website-v2/go-apis/identity/users"
 )
 
@@ -44,3 +46,12 @@ type ClientAuthenticationResult struct {
 	// The client ID.
 	ClientId uint64
 }
+
+// The access token of the service client.
+type ServiceClientToken struct {
+	// The token.
+	Token []byte
+
+	// It stores the date and time at which the token expires.
+	ExpiresAt time.Time
+}
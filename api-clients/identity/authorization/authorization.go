@@ -23,6 +23,7 @@ import (
 
 	"personal-website-v2/api-clients/identity/config"
 	authorizationpb "personal-website-v2/go-apis/identity/authorization"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
 	"personal-website-v2/pkg/actions"
 	apigrpc "personal-website-v2/pkg/api/grpc"
 	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
@@ -44,7 +45,11 @@ func NewAuthorizationService(conn *grpc.ClientConn, config *config.ServiceConfig
 }
 
 // Authorize authorizes a user and returns the authorization result if the operation is successful.
-func (s *AuthorizationService) Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64) (*AuthorizationResult, error) {
+// If resources are specified, then a permission that isn't granted to the user (client) regardless of the resources
+// must be granted for each of the resources.
+func (s *AuthorizationService) Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64,
+	resources []*authorizationpb.Resource,
+) (*AuthorizationResult, error) {
 	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("[identity.authorization.AuthorizationService.Authorize] create an outgoing context with OperationContext: %w", err)
@@ -65,6 +70,7 @@ func (s *AuthorizationService) Authorize(ctx *actions.OperationContext, userId,
 		UserId:                userId2,
 		ClientId:              clientId2,
 		RequiredPermissionIds: requiredPermissionIds,
+		Resources:             resources,
 	}
 
 	res, err := s.client.Authorize(ctx2, req)
@@ -76,3 +82,56 @@ func (s *AuthorizationService) Authorize(ctx *actions.OperationContext, userId,
 		PermissionRoles: res.PermissionRoles,
 	}, nil
 }
+
+// Explain explains the authorization of a user (client) for the specified permissions regardless of the resources.
+func (s *AuthorizationService) Explain(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], permissionIds []uint64,
+) (*authorizationpb.AuthorizationExplanation, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authorization.AuthorizationService.Explain] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var userId2, clientId2 *wrapperspb.UInt64Value
+	if userId.HasValue {
+		userId2 = wrapperspb.UInt64(userId.Value)
+	}
+	if clientId.HasValue {
+		clientId2 = wrapperspb.UInt64(clientId.Value)
+	}
+
+	req := &authorizationpb.ExplainRequest{
+		UserId:        userId2,
+		ClientId:      clientId2,
+		PermissionIds: permissionIds,
+	}
+
+	res, err := s.client.Explain(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authorization.AuthorizationService.Explain] explain the authorization: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Explanation, nil
+}
+
+// GetAllRoleAssignmentsByPermissionId gets all active role assignments of the roles that are granted
+// the specified permission, directly or by inheritance.
+func (s *AuthorizationService) GetAllRoleAssignmentsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]*assignmentspb.RoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authorization.AuthorizationService.GetAllRoleAssignmentsByPermissionId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &authorizationpb.GetAllRoleAssignmentsByPermissionIdRequest{PermissionId: permissionId}
+	res, err := s.client.GetAllRoleAssignmentsByPermissionId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.authorization.AuthorizationService.GetAllRoleAssignmentsByPermissionId] get all role assignments by permission id: %w",
+			apigrpcerrors.ParseGrpcError(err),
+		)
+	}
+	return res.RoleAssignments, nil
+}
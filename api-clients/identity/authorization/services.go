@@ -15,11 +15,23 @@
 package authorization
 
 import (
+	authorizationpb "personal-website-v2/go-apis/identity/authorization"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
 	"personal-website-v2/pkg/actions"
 	"personal-website-v2/pkg/base/nullable"
 )
 
 type Authorization interface {
 	// Authorize authorizes a user and returns the authorization result if the operation is successful.
-	Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64) (*AuthorizationResult, error)
+	// If resources are specified, then a permission that isn't granted to the user (client) regardless of the resources
+	// must be granted for each of the resources.
+	Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64, resources []*authorizationpb.Resource,
+	) (*AuthorizationResult, error)
+
+	// Explain explains the authorization of a user (client) for the specified permissions regardless of the resources.
+	Explain(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], permissionIds []uint64) (*authorizationpb.AuthorizationExplanation, error)
+
+	// GetAllRoleAssignmentsByPermissionId gets all active role assignments of the roles that are granted
+	// the specified permission, directly or by inheritance.
+	GetAllRoleAssignmentsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]*assignmentspb.RoleAssignment, error)
 }
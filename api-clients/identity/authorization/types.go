@@ -25,5 +25,6 @@ type AuthorizationResult struct {
 	Group groupspb.UserGroup
 
 	// The roles of permissions.
+	// The permissions that are granted only for the specified resources are omitted.
 	PermissionRoles []*authorizationpb.PermissionWithRoles
 }
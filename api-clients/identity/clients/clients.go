@@ -99,6 +99,46 @@ func (s *ClientsService) CreateMobileClient(ctx *actions.OperationContext, data
 	return res.Id, nil
 }
 
+// CreateServiceClient creates a service client and returns the client ID and secret if the operation is successful.
+func (s *ClientsService) CreateServiceClient(ctx *actions.OperationContext, data *clientoperations.CreateServiceClientOperationData) (uint64, string, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, "", fmt.Errorf("[identity.clients.ClientsService.CreateServiceClient] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &clientspb.CreateServiceClientRequest{
+		AppId: data.AppId,
+		Ip:    data.IP,
+	}
+
+	res, err := s.client.CreateServiceClient(ctx2, req)
+	if err != nil {
+		return 0, "", fmt.Errorf("[identity.clients.ClientsService.CreateServiceClient] create a service client: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, res.Secret, nil
+}
+
+// RotateServiceClientSecret generates a new secret of the service client and returns it if the operation is successful.
+// The previous secret remains valid during the grace period.
+func (s *ClientsService) RotateServiceClientSecret(ctx *actions.OperationContext, id uint64) (string, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("[identity.clients.ClientsService.RotateServiceClientSecret] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.RotateServiceClientSecret(ctx2, &clientspb.RotateServiceClientSecretRequest{Id: id})
+	if err != nil {
+		return "", fmt.Errorf("[identity.clients.ClientsService.RotateServiceClientSecret] rotate a service client secret: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Secret, nil
+}
+
 // Delete deletes a client by the specified client ID.
 func (s *ClientsService) Delete(ctx *actions.OperationContext, id uint64) error {
 	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
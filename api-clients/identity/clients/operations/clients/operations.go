@@ -39,3 +39,11 @@ type CreateMobileClientOperationData struct {
 	// The IP address.
 	IP string `json:"ip"`
 }
+
+type CreateServiceClientOperationData struct {
+	// The app ID.
+	AppId uint64 `json:"appId"`
+
+	// The IP address.
+	IP string `json:"ip"`
+}
@@ -27,6 +27,13 @@ type Clients interface {
 	// CreateMobileClient creates a mobile client and returns the client ID if the operation is successful.
 	CreateMobileClient(ctx *actions.OperationContext, data *clientoperations.CreateMobileClientOperationData) (uint64, error)
 
+	// CreateServiceClient creates a service client and returns the client ID and secret if the operation is successful.
+	CreateServiceClient(ctx *actions.OperationContext, data *clientoperations.CreateServiceClientOperationData) (uint64, string, error)
+
+	// RotateServiceClientSecret generates a new secret of the service client and returns it if the operation is successful.
+	// The previous secret remains valid during the grace period.
+	RotateServiceClientSecret(ctx *actions.OperationContext, id uint64) (string, error)
+
 	// Delete deletes a client by the specified client ID.
 	Delete(ctx *actions.OperationContext, id uint64) error
 
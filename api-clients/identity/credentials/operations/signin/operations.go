@@ -0,0 +1,59 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signin
+
+import (
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type SignInWithPasswordOperationData struct {
+	// The user name.
+	// Either name or email must be specified.
+	Name nullable.Nullable[string] `json:"name"`
+
+	// The user's email.
+	// Either name or email must be specified.
+	Email nullable.Nullable[string] `json:"email"`
+
+	// The user's password.
+	Password string `json:"-"`
+
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The app ID.
+	AppId nullable.Nullable[uint64] `json:"appId"`
+
+	// The User-Agent.
+	UserAgent string `json:"userAgent"`
+
+	// The IP address (sign-in IP address).
+	IP string `json:"ip"`
+}
+
+type CompleteSignInOperationData struct {
+	// The MFA challenge token.
+	ChallengeToken string `json:"-"`
+
+	// The MFA method.
+	Method mfapb.MfaMethodEnum_MfaMethod `json:"method"`
+
+	// The TOTP code or the recovery code.
+	Code string `json:"-"`
+
+	// The IP address (sign-in IP address).
+	IP string `json:"ip"`
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"personal-website-v2/api-clients/identity/config"
+	"personal-website-v2/api-clients/identity/credentials/operations/signin"
+	credentialspb "personal-website-v2/go-apis/identity/credentials"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserCredentialsService struct {
+	client credentialspb.UserCredentialServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserCredentials = (*UserCredentialsService)(nil)
+
+func NewUserCredentialsService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserCredentialsService {
+	return &UserCredentialsService{
+		client: credentialspb.NewUserCredentialServiceClient(conn),
+		config: config,
+	}
+}
+
+// SetPassword sets (resets) a user's password by the specified user ID.
+func (s *UserCredentialsService) SetPassword(ctx *actions.OperationContext, userId uint64, password string) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.credentials.UserCredentialsService.SetPassword] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &credentialspb.SetPasswordRequest{
+		UserId:   userId,
+		Password: password,
+	}
+	_, err = s.client.SetPassword(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.credentials.UserCredentialsService.SetPassword] set a user's password: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// ChangePassword changes a user's password by the specified user ID if the current password is valid.
+func (s *UserCredentialsService) ChangePassword(ctx *actions.OperationContext, userId uint64, currentPassword, newPassword string) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.credentials.UserCredentialsService.ChangePassword] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &credentialspb.ChangePasswordRequest{
+		UserId:          userId,
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+	_, err = s.client.ChangePassword(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.credentials.UserCredentialsService.ChangePassword] change a user's password: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// SignInWithPassword signs in a user with a name or an email and a password, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+// If MFA is required, then only the MFA challenge is created and the sign-in must be completed
+// using CompleteSignIn.
+func (s *UserCredentialsService) SignInWithPassword(ctx *actions.OperationContext, data *signin.SignInWithPasswordOperationData) (*credentialspb.SignInWithPasswordResponse, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.credentials.UserCredentialsService.SignInWithPassword] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &credentialspb.SignInWithPasswordRequest{
+		Password:  data.Password,
+		ClientId:  data.ClientId,
+		UserAgent: data.UserAgent,
+		Ip:        data.IP,
+	}
+	if data.Name.HasValue {
+		req.Name = wrapperspb.String(data.Name.Value)
+	}
+	if data.Email.HasValue {
+		req.Email = wrapperspb.String(data.Email.Value)
+	}
+	if data.AppId.HasValue {
+		req.AppId = wrapperspb.UInt64(data.AppId.Value)
+	}
+
+	res, err := s.client.SignInWithPassword(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.credentials.UserCredentialsService.SignInWithPassword] sign in a user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res, nil
+}
+
+// CompleteSignIn completes the sign-in of a user with the MFA challenge, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+func (s *UserCredentialsService) CompleteSignIn(ctx *actions.OperationContext, data *signin.CompleteSignInOperationData) (*credentialspb.CompleteSignInResponse, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.credentials.UserCredentialsService.CompleteSignIn] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &credentialspb.CompleteSignInRequest{
+		ChallengeToken: data.ChallengeToken,
+		Method:         data.Method,
+		Code:           data.Code,
+		Ip:             data.IP,
+	}
+	res, err := s.client.CompleteSignIn(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.credentials.UserCredentialsService.CompleteSignIn] complete the sign-in of a user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res, nil
+}
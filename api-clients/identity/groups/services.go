@@ -0,0 +1,63 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	usergroupoperations "personal-website-v2/api-clients/identity/groups/operations/usergroups"
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	"personal-website-v2/pkg/actions"
+)
+
+type UserGroups interface {
+	// Create creates a user group and returns the user group ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *usergroupoperations.CreateOperationData) (uint64, error)
+
+	// Update updates a user group by the specified user group ID.
+	Update(ctx *actions.OperationContext, id uint64, data *usergroupoperations.UpdateOperationData) error
+
+	// Delete deletes a user group by the specified user group ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// GetById gets a user group by the specified user group ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*usergroupspb.UserGroup, error)
+
+	// GetByName gets a user group by the specified user group name.
+	GetByName(ctx *actions.OperationContext, name string) (*usergroupspb.UserGroup, error)
+
+	// GetAll gets all user groups.
+	GetAll(ctx *actions.OperationContext) ([]*usergroupspb.UserGroup, error)
+
+	// Exists returns true if the user group exists.
+	Exists(ctx *actions.OperationContext, name string) (bool, error)
+}
+
+type UserGroupMembers interface {
+	// Add adds a user to the user group.
+	Add(ctx *actions.OperationContext, groupId, userId uint64) error
+
+	// Remove removes a user from the user group.
+	Remove(ctx *actions.OperationContext, groupId, userId uint64) error
+
+	// GetAllByGroupId gets all members of the user group by the specified user group ID.
+	GetAllByGroupId(ctx *actions.OperationContext, groupId uint64) ([]*memberspb.UserGroupMember, error)
+
+	// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+	// by the specified user ID.
+	GetAllGroupIdsByUserId(ctx *actions.OperationContext, userId uint64) ([]uint64, error)
+
+	// IsMember returns true if the user is a member of the user group.
+	IsMember(ctx *actions.OperationContext, groupId, userId uint64) (bool, error)
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserGroupMembersService struct {
+	client memberspb.UserGroupMemberServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserGroupMembers = (*UserGroupMembersService)(nil)
+
+func NewUserGroupMembersService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserGroupMembersService {
+	return &UserGroupMembersService{
+		client: memberspb.NewUserGroupMemberServiceClient(conn),
+		config: config,
+	}
+}
+
+// Add adds a user to the user group.
+func (s *UserGroupMembersService) Add(ctx *actions.OperationContext, groupId, userId uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupMembersService.Add] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &memberspb.AddRequest{GroupId: groupId, UserId: userId}
+	_, err = s.client.Add(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupMembersService.Add] add a user to the user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// Remove removes a user from the user group.
+func (s *UserGroupMembersService) Remove(ctx *actions.OperationContext, groupId, userId uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupMembersService.Remove] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &memberspb.RemoveRequest{GroupId: groupId, UserId: userId}
+	_, err = s.client.Remove(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupMembersService.Remove] remove a user from the user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetAllByGroupId gets all members of the user group by the specified user group ID.
+func (s *UserGroupMembersService) GetAllByGroupId(ctx *actions.OperationContext, groupId uint64) ([]*memberspb.UserGroupMember, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupMembersService.GetAllByGroupId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &memberspb.GetAllByGroupIdRequest{GroupId: groupId}
+	res, err := s.client.GetAllByGroupId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupMembersService.GetAllByGroupId] get all members of the user group by group id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Members, nil
+}
+
+// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+// by the specified user ID.
+func (s *UserGroupMembersService) GetAllGroupIdsByUserId(ctx *actions.OperationContext, userId uint64) ([]uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupMembersService.GetAllGroupIdsByUserId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &memberspb.GetAllGroupIdsByUserIdRequest{UserId: userId}
+	res, err := s.client.GetAllGroupIdsByUserId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupMembersService.GetAllGroupIdsByUserId] get all user group ids by user id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.GroupIds, nil
+}
+
+// IsMember returns true if the user is a member of the user group.
+func (s *UserGroupMembersService) IsMember(ctx *actions.OperationContext, groupId, userId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.groups.UserGroupMembersService.IsMember] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &memberspb.IsMemberRequest{GroupId: groupId, UserId: userId}
+	res, err := s.client.IsMember(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.groups.UserGroupMembersService.IsMember] user is a member of the user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.IsMember, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	usergroupoperations "personal-website-v2/api-clients/identity/groups/operations/usergroups"
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserGroupsService struct {
+	client usergroupspb.UserGroupServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserGroups = (*UserGroupsService)(nil)
+
+func NewUserGroupsService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserGroupsService {
+	return &UserGroupsService{
+		client: usergroupspb.NewUserGroupServiceClient(conn),
+		config: config,
+	}
+}
+
+// Create creates a user group and returns the user group ID if the operation is successful.
+func (s *UserGroupsService) Create(ctx *actions.OperationContext, data *usergroupoperations.CreateOperationData) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.groups.UserGroupsService.Create] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.CreateRequest{
+		Name:        data.Name,
+		Description: data.Description,
+	}
+
+	res, err := s.client.Create(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.groups.UserGroupsService.Create] create a user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// Update updates a user group by the specified user group ID.
+func (s *UserGroupsService) Update(ctx *actions.OperationContext, id uint64, data *usergroupoperations.UpdateOperationData) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupsService.Update] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.UpdateRequest{
+		Id:          id,
+		Name:        data.Name,
+		Description: data.Description,
+	}
+
+	_, err = s.client.Update(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupsService.Update] update a user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// Delete deletes a user group by the specified user group ID.
+func (s *UserGroupsService) Delete(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupsService.Delete] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.DeleteRequest{Id: id}
+	_, err = s.client.Delete(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.groups.UserGroupsService.Delete] delete a user group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetById gets a user group by the specified user group ID.
+func (s *UserGroupsService) GetById(ctx *actions.OperationContext, id uint64) (*usergroupspb.UserGroup, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetById] get a user group by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Group, nil
+}
+
+// GetByName gets a user group by the specified user group name.
+func (s *UserGroupsService) GetByName(ctx *actions.OperationContext, name string) (*usergroupspb.UserGroup, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetByName] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.GetByNameRequest{Name: name}
+	res, err := s.client.GetByName(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetByName] get a user group by name: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Group, nil
+}
+
+// GetAll gets all user groups.
+func (s *UserGroupsService) GetAll(ctx *actions.OperationContext) ([]*usergroupspb.UserGroup, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetAll] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.GetAllRequest{}
+	res, err := s.client.GetAll(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.groups.UserGroupsService.GetAll] get all user groups: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Groups, nil
+}
+
+// Exists returns true if the user group exists.
+func (s *UserGroupsService) Exists(ctx *actions.OperationContext, name string) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.groups.UserGroupsService.Exists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &usergroupspb.ExistsRequest{Name: name}
+	res, err := s.client.Exists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.groups.UserGroupsService.Exists] user group exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
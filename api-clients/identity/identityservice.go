@@ -16,19 +16,32 @@ package identity
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	grpccredentials "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"personal-website-v2/api-clients/identity/apikeys"
 	"personal-website-v2/api-clients/identity/authentication"
 	"personal-website-v2/api-clients/identity/authorization"
 	"personal-website-v2/api-clients/identity/clients"
 	"personal-website-v2/api-clients/identity/config"
+	"personal-website-v2/api-clients/identity/credentials"
+	"personal-website-v2/api-clients/identity/groups"
+	"personal-website-v2/api-clients/identity/impersonation"
+	"personal-website-v2/api-clients/identity/lockouts"
+	"personal-website-v2/api-clients/identity/mfa"
 	"personal-website-v2/api-clients/identity/permissions"
+	"personal-website-v2/api-clients/identity/provisioning"
+	"personal-website-v2/api-clients/identity/resources"
 	"personal-website-v2/api-clients/identity/roles"
+	"personal-website-v2/api-clients/identity/sessions"
 	"personal-website-v2/api-clients/identity/users"
 )
 
@@ -36,21 +49,38 @@ type IdentityServiceClientConfig struct {
 	ServerAddr  string
 	DialTimeout time.Duration
 	CallTimeout time.Duration
+	TLSConfig   *tls.Config // optional; if it's specified, TLS is used
 }
 
 // IdentityService represents a client service for working with the Identity Service.
 type IdentityService struct {
-	Users          *users.UsersService
-	Clients        *clients.ClientsService
-	Roles          *roles.RolesService
-	Permissions    *permissions.PermissionsService
-	Authentication *authentication.AuthenticationService
-	Authorization  *authorization.AuthorizationService
-	config         *IdentityServiceClientConfig
-	conn           *grpc.ClientConn
-	mu             sync.Mutex
-	isInitialized  bool
-	disposed       bool
+	Users                   *users.UsersService
+	UserPersonalInfo        *users.UserPersonalInfoService
+	UserCredentials         *credentials.UserCredentialsService
+	Clients                 *clients.ClientsService
+	UserGroups              *groups.UserGroupsService
+	UserGroupMembers        *groups.UserGroupMembersService
+	Roles                   *roles.RolesService
+	RoleAssignments         *roles.RoleAssignmentsService
+	UserRoleAssignments     *roles.UserRoleAssignmentsService
+	GroupRoleAssignments    *roles.GroupRoleAssignmentsService
+	Permissions             *permissions.PermissionsService
+	RolePermissions         *permissions.RolePermissionsService
+	ResourceTypes           *resources.ResourceTypesService
+	ResourceRoleAssignments *resources.ResourceRoleAssignmentsService
+	Authentication          *authentication.AuthenticationService
+	Authorization           *authorization.AuthorizationService
+	Impersonation           *impersonation.ImpersonationService
+	Lockouts                *lockouts.LockoutsService
+	UserMfa                 *mfa.UserMfaService
+	ActiveSessions          *sessions.ActiveSessionsService
+	Provisioning            *provisioning.ProvisioningService
+	ApiKeys                 *apikeys.ApiKeysService
+	config                  *IdentityServiceClientConfig
+	conn                    *grpc.ClientConn
+	mu                      sync.Mutex
+	isInitialized           bool
+	disposed                bool
 }
 
 // NewIdentityService returns a new IdentityService.
@@ -75,7 +105,12 @@ func (s *IdentityService) Init() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.config.DialTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithInsecure(), grpc.WithBlock())
+	creds := insecure.NewCredentials()
+	if s.config.TLSConfig != nil {
+		creds = grpccredentials.NewTLS(s.config.TLSConfig)
+	}
+
+	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
 	if err != nil {
 		return fmt.Errorf("[identity.IdentityService.Init] create a client connection: %w", err)
 	}
@@ -83,11 +118,27 @@ func (s *IdentityService) Init() error {
 	s.conn = conn
 	c := &config.ServiceConfig{CallTimeout: s.config.CallTimeout}
 	s.Users = users.NewUsersService(conn, c)
+	s.UserPersonalInfo = users.NewUserPersonalInfoService(conn, c)
+	s.UserCredentials = credentials.NewUserCredentialsService(conn, c)
 	s.Clients = clients.NewClientsService(conn, c)
+	s.UserGroups = groups.NewUserGroupsService(conn, c)
+	s.UserGroupMembers = groups.NewUserGroupMembersService(conn, c)
 	s.Roles = roles.NewRolesService(conn, c)
+	s.RoleAssignments = roles.NewRoleAssignmentsService(conn, c)
+	s.UserRoleAssignments = roles.NewUserRoleAssignmentsService(conn, c)
+	s.GroupRoleAssignments = roles.NewGroupRoleAssignmentsService(conn, c)
 	s.Permissions = permissions.NewPermissionsService(conn, c)
+	s.RolePermissions = permissions.NewRolePermissionsService(conn, c)
+	s.ResourceTypes = resources.NewResourceTypesService(conn, c)
+	s.ResourceRoleAssignments = resources.NewResourceRoleAssignmentsService(conn, c)
 	s.Authentication = authentication.NewAuthenticationService(conn, c)
 	s.Authorization = authorization.NewAuthorizationService(conn, c)
+	s.Impersonation = impersonation.NewImpersonationService(conn, c)
+	s.Lockouts = lockouts.NewLockoutsService(conn, c)
+	s.UserMfa = mfa.NewUserMfaService(conn, c)
+	s.ActiveSessions = sessions.NewActiveSessionsService(conn, c)
+	s.Provisioning = provisioning.NewProvisioningService(conn, c)
+	s.ApiKeys = apikeys.NewApiKeysService(conn, c)
 	s.isInitialized = true
 	return nil
 }
@@ -110,3 +161,23 @@ func (s *IdentityService) Dispose() error {
 	s.disposed = true
 	return nil
 }
+
+// HealthCheck checks the state of the connection. The connection isn't healthy
+// if it has failed to connect or has been closed.
+func (s *IdentityService) HealthCheck(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isInitialized || s.disposed {
+		return errors.New("[identity.IdentityService.HealthCheck] IdentityService not initialized or disposed")
+	}
+
+	switch st := s.conn.GetState(); st {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("[identity.IdentityService.HealthCheck] connection state is %s", st)
+	case connectivity.Idle:
+		// reconnect now rather than on the next call
+		s.conn.Connect()
+	}
+	return nil
+}
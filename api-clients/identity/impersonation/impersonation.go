@@ -0,0 +1,105 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impersonation
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	impersonationpb "personal-website-v2/go-apis/identity/impersonation"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type ImpersonationService struct {
+	client impersonationpb.ImpersonationServiceClient
+	config *config.ServiceConfig
+}
+
+var _ Impersonation = (*ImpersonationService)(nil)
+
+func NewImpersonationService(conn *grpc.ClientConn, config *config.ServiceConfig) *ImpersonationService {
+	return &ImpersonationService{
+		client: impersonationpb.NewImpersonationServiceClient(conn),
+		config: config,
+	}
+}
+
+// CreateToken creates an impersonation token of the specified user and returns it
+// if the operation is successful.
+func (s *ImpersonationService) CreateToken(ctx *actions.OperationContext, userId uint64) (*ImpersonationToken, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.impersonation.ImpersonationService.CreateToken] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &impersonationpb.CreateTokenRequest{UserId: userId}
+	res, err := s.client.CreateToken(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.impersonation.ImpersonationService.CreateToken] create an impersonation token: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return &ImpersonationToken{
+		Id:        res.Id,
+		Token:     res.Token,
+		ExpiresAt: res.ExpiresAt.AsTime(),
+	}, nil
+}
+
+// Authenticate authenticates an impersonated user.
+func (s *ImpersonationService) Authenticate(ctx *actions.OperationContext, token []byte) (AuthenticationResult, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return AuthenticationResult{}, fmt.Errorf("[identity.impersonation.ImpersonationService.Authenticate] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &impersonationpb.AuthenticateRequest{Token: token}
+	res, err := s.client.Authenticate(ctx2, req)
+	if err != nil {
+		return AuthenticationResult{}, fmt.Errorf("[identity.impersonation.ImpersonationService.Authenticate] authenticate an impersonated user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return AuthenticationResult{
+		UserId:         res.UserId,
+		UserType:       res.UserType,
+		ImpersonatorId: res.ImpersonatorId,
+	}, nil
+}
+
+// RevokeToken revokes an impersonation token.
+func (s *ImpersonationService) RevokeToken(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.impersonation.ImpersonationService.RevokeToken] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &impersonationpb.RevokeTokenRequest{Id: id}
+	_, err = s.client.RevokeToken(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.impersonation.ImpersonationService.RevokeToken] revoke an impersonation token: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
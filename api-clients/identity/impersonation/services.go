@@ -0,0 +1,31 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impersonation
+
+import (
+	"personal-website-v2/pkg/actions"
+)
+
+type Impersonation interface {
+	// CreateToken creates an impersonation token of the specified user and returns it
+	// if the operation is successful.
+	CreateToken(ctx *actions.OperationContext, userId uint64) (*ImpersonationToken, error)
+
+	// Authenticate authenticates an impersonated user.
+	Authenticate(ctx *actions.OperationContext, token []byte) (AuthenticationResult, error)
+
+	// RevokeToken revokes an impersonation token.
+	RevokeToken(ctx *actions.OperationContext, id uint64) error
+}
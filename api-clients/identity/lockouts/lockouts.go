@@ -0,0 +1,85 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockouts
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type LockoutsService struct {
+	client lockoutspb.LockoutServiceClient
+	config *config.ServiceConfig
+}
+
+var _ Lockouts = (*LockoutsService)(nil)
+
+func NewLockoutsService(conn *grpc.ClientConn, config *config.ServiceConfig) *LockoutsService {
+	return &LockoutsService{
+		client: lockoutspb.NewLockoutServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetInfo gets lockout info of the user, client or user agent by the specified ID.
+func (s *LockoutsService) GetInfo(ctx *actions.OperationContext, targetType lockoutspb.LockoutTargetTypeEnum_LockoutTargetType, targetId uint64) (*lockoutspb.LockoutInfo, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.lockouts.LockoutsService.GetInfo] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &lockoutspb.GetInfoRequest{
+		TargetType: targetType,
+		TargetId:   targetId,
+	}
+	res, err := s.client.GetInfo(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.lockouts.LockoutsService.GetInfo] get lockout info: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Info, nil
+}
+
+// Unlock unlocks the locked out user, client or user agent by the specified ID
+// and returns true if it has been unlocked.
+func (s *LockoutsService) Unlock(ctx *actions.OperationContext, targetType lockoutspb.LockoutTargetTypeEnum_LockoutTargetType, targetId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.lockouts.LockoutsService.Unlock] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &lockoutspb.UnlockRequest{
+		TargetType: targetType,
+		TargetId:   targetId,
+	}
+	res, err := s.client.Unlock(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.lockouts.LockoutsService.Unlock] unlock: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Unlocked, nil
+}
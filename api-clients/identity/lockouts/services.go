@@ -0,0 +1,29 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockouts
+
+import (
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	"personal-website-v2/pkg/actions"
+)
+
+type Lockouts interface {
+	// GetInfo gets lockout info of the user, client or user agent by the specified ID.
+	GetInfo(ctx *actions.OperationContext, targetType lockoutspb.LockoutTargetTypeEnum_LockoutTargetType, targetId uint64) (*lockoutspb.LockoutInfo, error)
+
+	// Unlock unlocks the locked out user, client or user agent by the specified ID
+	// and returns true if it has been unlocked.
+	Unlock(ctx *actions.OperationContext, targetType lockoutspb.LockoutTargetTypeEnum_LockoutTargetType, targetId uint64) (bool, error)
+}
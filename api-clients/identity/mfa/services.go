@@ -0,0 +1,40 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	"personal-website-v2/pkg/actions"
+)
+
+type UserMfa interface {
+	// StartTotpEnrollment starts the TOTP enrollment of the user by the specified user ID and returns
+	// the TOTP secret and the provisioning URI.
+	StartTotpEnrollment(ctx *actions.OperationContext, userId uint64) (*mfapb.StartTotpEnrollmentResponse, error)
+
+	// ConfirmTotpEnrollment enables TOTP of the user by the specified user ID if the code is valid
+	// and returns the user's recovery codes. The recovery codes are only returned once.
+	ConfirmTotpEnrollment(ctx *actions.OperationContext, userId uint64, code string) ([]string, error)
+
+	// DisableTotp disables TOTP and deletes the recovery codes of the user by the specified user ID.
+	DisableTotp(ctx *actions.OperationContext, userId uint64) error
+
+	// RegenerateRecoveryCodes replaces the recovery codes of the user by the specified user ID
+	// and returns the new recovery codes.
+	RegenerateRecoveryCodes(ctx *actions.OperationContext, userId uint64) ([]string, error)
+
+	// GetStatus gets the MFA status of the user by the specified user ID.
+	GetStatus(ctx *actions.OperationContext, userId uint64) (*mfapb.UserMfaStatus, error)
+}
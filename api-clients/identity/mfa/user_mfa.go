@@ -0,0 +1,133 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserMfaService struct {
+	client mfapb.UserMfaServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserMfa = (*UserMfaService)(nil)
+
+func NewUserMfaService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserMfaService {
+	return &UserMfaService{
+		client: mfapb.NewUserMfaServiceClient(conn),
+		config: config,
+	}
+}
+
+// StartTotpEnrollment starts the TOTP enrollment of the user by the specified user ID and returns
+// the TOTP secret and the provisioning URI.
+func (s *UserMfaService) StartTotpEnrollment(ctx *actions.OperationContext, userId uint64) (*mfapb.StartTotpEnrollmentResponse, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.StartTotpEnrollment] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.StartTotpEnrollment(ctx2, &mfapb.StartTotpEnrollmentRequest{UserId: userId})
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.StartTotpEnrollment] start the TOTP enrollment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res, nil
+}
+
+// ConfirmTotpEnrollment enables TOTP of the user by the specified user ID if the code is valid
+// and returns the user's recovery codes. The recovery codes are only returned once.
+func (s *UserMfaService) ConfirmTotpEnrollment(ctx *actions.OperationContext, userId uint64, code string) ([]string, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.ConfirmTotpEnrollment] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &mfapb.ConfirmTotpEnrollmentRequest{
+		UserId: userId,
+		Code:   code,
+	}
+	res, err := s.client.ConfirmTotpEnrollment(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.ConfirmTotpEnrollment] confirm the TOTP enrollment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.RecoveryCodes, nil
+}
+
+// DisableTotp disables TOTP and deletes the recovery codes of the user by the specified user ID.
+func (s *UserMfaService) DisableTotp(ctx *actions.OperationContext, userId uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.mfa.UserMfaService.DisableTotp] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	if _, err = s.client.DisableTotp(ctx2, &mfapb.DisableTotpRequest{UserId: userId}); err != nil {
+		return fmt.Errorf("[identity.mfa.UserMfaService.DisableTotp] disable TOTP: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user by the specified user ID
+// and returns the new recovery codes.
+func (s *UserMfaService) RegenerateRecoveryCodes(ctx *actions.OperationContext, userId uint64) ([]string, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.RegenerateRecoveryCodes] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.RegenerateRecoveryCodes(ctx2, &mfapb.RegenerateRecoveryCodesRequest{UserId: userId})
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.RegenerateRecoveryCodes] regenerate recovery codes: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.RecoveryCodes, nil
+}
+
+// GetStatus gets the MFA status of the user by the specified user ID.
+func (s *UserMfaService) GetStatus(ctx *actions.OperationContext, userId uint64) (*mfapb.UserMfaStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.GetStatus] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.GetStatus(ctx2, &mfapb.GetStatusRequest{UserId: userId})
+	if err != nil {
+		return nil, fmt.Errorf("[identity.mfa.UserMfaService.GetStatus] get the MFA status: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	rolepermissionspb "personal-website-v2/go-apis/identity/permissions/rolepermissions"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type RolePermissionsService struct {
+	client rolepermissionspb.RolePermissionServiceClient
+	config *config.ServiceConfig
+}
+
+var _ RolePermissions = (*RolePermissionsService)(nil)
+
+func NewRolePermissionsService(conn *grpc.ClientConn, config *config.ServiceConfig) *RolePermissionsService {
+	return &RolePermissionsService{
+		client: rolepermissionspb.NewRolePermissionServiceClient(conn),
+		config: config,
+	}
+}
+
+// Grant grants permissions to the role.
+func (s *RolePermissionsService) Grant(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Grant] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.GrantRequest{
+		RoleId:        roleId,
+		PermissionIds: permissionIds,
+	}
+
+	_, err = s.client.Grant(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Grant] grant permissions to the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// Revoke revokes permissions from the role.
+func (s *RolePermissionsService) Revoke(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Revoke] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.RevokeRequest{
+		RoleId:        roleId,
+		PermissionIds: permissionIds,
+	}
+
+	_, err = s.client.Revoke(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Revoke] revoke permissions from the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// RevokeAll revokes all permissions from the role.
+func (s *RolePermissionsService) RevokeAll(ctx *actions.OperationContext, roleId uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.RevokeAll] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.RevokeAllRequest{RoleId: roleId}
+	_, err = s.client.RevokeAll(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.RevokeAll] revoke all permissions from the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// RevokeFromAll revokes permissions from all roles.
+func (s *RolePermissionsService) RevokeFromAll(ctx *actions.OperationContext, permissionIds []uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.RevokeFromAll] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.RevokeFromAllRequest{PermissionIds: permissionIds}
+	_, err = s.client.RevokeFromAll(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.RevokeFromAll] revoke permissions from all roles: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// Update updates permissions of the role.
+func (s *RolePermissionsService) Update(ctx *actions.OperationContext, roleId uint64, permissionIdsToGrant, permissionIdsToRevoke []uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Update] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.UpdateRequest{
+		RoleId:                roleId,
+		PermissionIdsToGrant:  permissionIdsToGrant,
+		PermissionIdsToRevoke: permissionIdsToRevoke,
+	}
+
+	_, err = s.client.Update(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.permissions.RolePermissionsService.Update] update permissions of the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// IsGranted returns true if the permission is granted to the role.
+func (s *RolePermissionsService) IsGranted(ctx *actions.OperationContext, roleId, permissionId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.permissions.RolePermissionsService.IsGranted] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.IsGrantedRequest{
+		RoleId:       roleId,
+		PermissionId: permissionId,
+	}
+
+	res, err := s.client.IsGranted(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.permissions.RolePermissionsService.IsGranted] is permission granted to the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.IsGranted, nil
+}
+
+// AreGranted returns true if all permissions are granted to the role.
+func (s *RolePermissionsService) AreGranted(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.permissions.RolePermissionsService.AreGranted] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.AreGrantedRequest{
+		RoleId:        roleId,
+		PermissionIds: permissionIds,
+	}
+
+	res, err := s.client.AreGranted(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.permissions.RolePermissionsService.AreGranted] are all permissions granted to the role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.AreGranted, nil
+}
+
+// GetAllPermissionIdsByRoleId gets all IDs of the permissions granted to the role by the specified role ID.
+func (s *RolePermissionsService) GetAllPermissionIdsByRoleId(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.permissions.RolePermissionsService.GetAllPermissionIdsByRoleId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.GetAllPermissionIdsByRoleIdRequest{RoleId: roleId}
+	res, err := s.client.GetAllPermissionIdsByRoleId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.permissions.RolePermissionsService.GetAllPermissionIdsByRoleId] get all permission ids by role id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.PermissionIds, nil
+}
+
+// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission.
+func (s *RolePermissionsService) GetAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.permissions.RolePermissionsService.GetAllRoleIdsByPermissionId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolepermissionspb.GetAllRoleIdsByPermissionIdRequest{PermissionId: permissionId}
+	res, err := s.client.GetAllRoleIdsByPermissionId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.permissions.RolePermissionsService.GetAllRoleIdsByPermissionId] get all role ids by permission id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.RoleIds, nil
+}
@@ -26,3 +26,32 @@ type Permissions interface {
 	// GetAllByNamesWithContext gets all permissions by the specified permission names.
 	GetAllByNamesWithContext(ctx *actions.OperationContext, names []string) ([]*permissionspb.Permission, error)
 }
+
+type RolePermissions interface {
+	// Grant grants permissions to the role.
+	Grant(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) error
+
+	// Revoke revokes permissions from the role.
+	Revoke(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) error
+
+	// RevokeAll revokes all permissions from the role.
+	RevokeAll(ctx *actions.OperationContext, roleId uint64) error
+
+	// RevokeFromAll revokes permissions from all roles.
+	RevokeFromAll(ctx *actions.OperationContext, permissionIds []uint64) error
+
+	// Update updates permissions of the role.
+	Update(ctx *actions.OperationContext, roleId uint64, permissionIdsToGrant, permissionIdsToRevoke []uint64) error
+
+	// IsGranted returns true if the permission is granted to the role.
+	IsGranted(ctx *actions.OperationContext, roleId, permissionId uint64) (bool, error)
+
+	// AreGranted returns true if all permissions are granted to the role.
+	AreGranted(ctx *actions.OperationContext, roleId uint64, permissionIds []uint64) (bool, error)
+
+	// GetAllPermissionIdsByRoleId gets all IDs of the permissions granted to the role by the specified role ID.
+	GetAllPermissionIdsByRoleId(ctx *actions.OperationContext, roleId uint64) ([]uint64, error)
+
+	// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission.
+	GetAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error)
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"personal-website-v2/api-clients/identity/config"
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	apimetadata "personal-website-v2/pkg/api/metadata"
+)
+
+type ProvisioningService struct {
+	client provisioningpb.ProvisioningServiceClient
+	config *config.ServiceConfig
+}
+
+var _ Provisioning = (*ProvisioningService)(nil)
+
+func NewProvisioningService(conn *grpc.ClientConn, config *config.ServiceConfig) *ProvisioningService {
+	return &ProvisioningService{
+		client: provisioningpb.NewProvisioningServiceClient(conn),
+		config: config,
+	}
+}
+
+// Plan plans the reconciliation of the manifest and returns the changes that would be applied
+// without applying them.
+func (s *ProvisioningService) Plan(manifest *provisioningpb.Manifest, operationUserId uint64) ([]*provisioningpb.Change, error) {
+	md := metadata.New(map[string]string{apimetadata.UserIdMDKey: strconv.FormatUint(operationUserId, 10)})
+	ctx2 := metadata.NewOutgoingContext(context.Background(), md)
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &provisioningpb.PlanRequest{Manifest: manifest}
+	res, err := s.client.Plan(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.provisioning.ProvisioningService.Plan] plan the reconciliation of the manifest: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Changes, nil
+}
+
+// Apply reconciles the manifest and returns the applied changes if the operation is successful.
+func (s *ProvisioningService) Apply(manifest *provisioningpb.Manifest, operationUserId uint64) ([]*provisioningpb.Change, error) {
+	md := metadata.New(map[string]string{apimetadata.UserIdMDKey: strconv.FormatUint(operationUserId, 10)})
+	ctx2 := metadata.NewOutgoingContext(context.Background(), md)
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &provisioningpb.ApplyRequest{Manifest: manifest}
+	res, err := s.client.Apply(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.provisioning.ProvisioningService.Apply] apply the manifest: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Changes, nil
+}
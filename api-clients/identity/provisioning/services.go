@@ -0,0 +1,28 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+)
+
+type Provisioning interface {
+	// Plan plans the reconciliation of the manifest and returns the changes that would be applied
+	// without applying them.
+	Plan(manifest *provisioningpb.Manifest, operationUserId uint64) ([]*provisioningpb.Change, error)
+
+	// Apply reconciles the manifest and returns the applied changes if the operation is successful.
+	Apply(manifest *provisioningpb.Manifest, operationUserId uint64) ([]*provisioningpb.Change, error)
+}
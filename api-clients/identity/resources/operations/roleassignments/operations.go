@@ -0,0 +1,46 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roleassignments
+
+import (
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type CreateOperationData struct {
+	// The role ID.
+	RoleId uint64 `json:"roleId"`
+
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
+	AssignedTo uint64 `json:"assignedTo"`
+
+	// The type of the assignee.
+	AssigneeType assignmentspb.AssigneeTypeEnum_AssigneeType `json:"assigneeType"`
+
+	// The resource type ID.
+	ResourceTypeId uint64 `json:"resourceTypeId"`
+
+	// The resource ID. It must be specified if the owner rule is NONE and
+	// must not be specified otherwise.
+	ResourceId nullable.Nullable[uint64] `json:"resourceId"`
+
+	// The resource owner rule.
+	OwnerRule roleassignmentspb.ResourceOwnerRuleEnum_ResourceOwnerRule `json:"ownerRule"`
+
+	// The resource role assignment description.
+	Description nullable.Nullable[string] `json:"description"`
+}
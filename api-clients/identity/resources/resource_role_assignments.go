@@ -0,0 +1,144 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"personal-website-v2/api-clients/identity/config"
+	roleassignmentoperations "personal-website-v2/api-clients/identity/resources/operations/roleassignments"
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type ResourceRoleAssignmentsService struct {
+	client roleassignmentspb.ResourceRoleAssignmentServiceClient
+	config *config.ServiceConfig
+}
+
+var _ ResourceRoleAssignments = (*ResourceRoleAssignmentsService)(nil)
+
+func NewResourceRoleAssignmentsService(conn *grpc.ClientConn, config *config.ServiceConfig) *ResourceRoleAssignmentsService {
+	return &ResourceRoleAssignmentsService{
+		client: roleassignmentspb.NewResourceRoleAssignmentServiceClient(conn),
+		config: config,
+	}
+}
+
+// Create creates a resource role assignment and returns the resource role assignment ID
+// if the operation is successful.
+func (s *ResourceRoleAssignmentsService) Create(ctx *actions.OperationContext, data *roleassignmentoperations.CreateOperationData) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.Create] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var resourceId *wrapperspb.UInt64Value
+	if data.ResourceId.HasValue {
+		resourceId = wrapperspb.UInt64(data.ResourceId.Value)
+	}
+
+	var description *wrapperspb.StringValue
+	if data.Description.HasValue {
+		description = wrapperspb.String(data.Description.Value)
+	}
+
+	req := &roleassignmentspb.CreateRequest{
+		RoleId:         data.RoleId,
+		AssignedTo:     data.AssignedTo,
+		AssigneeType:   data.AssigneeType,
+		ResourceTypeId: data.ResourceTypeId,
+		ResourceId:     resourceId,
+		OwnerRule:      data.OwnerRule,
+		Description:    description,
+	}
+
+	res, err := s.client.Create(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.Create] create a resource role assignment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// Delete deletes a resource role assignment by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentsService) Delete(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.Delete] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &roleassignmentspb.DeleteRequest{Id: id}
+	_, err = s.client.Delete(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.Delete] delete a resource role assignment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetById gets a resource role assignment by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentsService) GetById(ctx *actions.OperationContext, id uint64) (*roleassignmentspb.ResourceRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &roleassignmentspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.GetById] get a resource role assignment by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetAllByAssignee gets all resource role assignments by the specified assignee.
+func (s *ResourceRoleAssignmentsService) GetAllByAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType,
+) ([]*roleassignmentspb.ResourceRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.GetAllByAssignee] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &roleassignmentspb.GetAllByAssigneeRequest{
+		AssigneeId:   assigneeId,
+		AssigneeType: assigneeType,
+	}
+
+	res, err := s.client.GetAllByAssignee(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceRoleAssignmentsService.GetAllByAssignee] get all resource role assignments by assignee: %w",
+			apigrpcerrors.ParseGrpcError(err),
+		)
+	}
+	return res.Assignments, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"personal-website-v2/api-clients/identity/config"
+	resourcespb "personal-website-v2/go-apis/identity/resources"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	apimetadata "personal-website-v2/pkg/api/metadata"
+)
+
+type ResourceTypesService struct {
+	client resourcespb.ResourceTypeServiceClient
+	config *config.ServiceConfig
+}
+
+var _ ResourceTypes = (*ResourceTypesService)(nil)
+
+func NewResourceTypesService(conn *grpc.ClientConn, config *config.ServiceConfig) *ResourceTypesService {
+	return &ResourceTypesService{
+		client: resourcespb.NewResourceTypeServiceClient(conn),
+		config: config,
+	}
+}
+
+// Register registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (s *ResourceTypesService) Register(names []string, operationUserId uint64) ([]*resourcespb.ResourceType, error) {
+	md := metadata.New(map[string]string{apimetadata.UserIdMDKey: strconv.FormatUint(operationUserId, 10)})
+	ctx2 := metadata.NewOutgoingContext(context.Background(), md)
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &resourcespb.RegisterRequest{Names: names}
+	res, err := s.client.Register(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceTypesService.Register] register resource types: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.ResourceTypes, nil
+}
+
+// GetById gets a resource type by the specified resource type ID.
+func (s *ResourceTypesService) GetById(ctx *actions.OperationContext, id uint64) (*resourcespb.ResourceType, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceTypesService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &resourcespb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceTypesService.GetById] get a resource type by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.ResourceType, nil
+}
+
+// GetAll gets all resource types.
+func (s *ResourceTypesService) GetAll(ctx *actions.OperationContext) ([]*resourcespb.ResourceType, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceTypesService.GetAll] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.GetAll(ctx2, &resourcespb.GetAllRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("[identity.resources.ResourceTypesService.GetAll] get all resource types: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.ResourceTypes, nil
+}
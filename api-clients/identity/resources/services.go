@@ -0,0 +1,51 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	roleassignmentoperations "personal-website-v2/api-clients/identity/resources/operations/roleassignments"
+	resourcespb "personal-website-v2/go-apis/identity/resources"
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/actions"
+)
+
+type ResourceTypes interface {
+	// Register registers the resource types that aren't registered yet and returns all the resource types
+	// with the specified names if the operation is successful.
+	Register(names []string, operationUserId uint64) ([]*resourcespb.ResourceType, error)
+
+	// GetById gets a resource type by the specified resource type ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*resourcespb.ResourceType, error)
+
+	// GetAll gets all resource types.
+	GetAll(ctx *actions.OperationContext) ([]*resourcespb.ResourceType, error)
+}
+
+type ResourceRoleAssignments interface {
+	// Create creates a resource role assignment and returns the resource role assignment ID
+	// if the operation is successful.
+	Create(ctx *actions.OperationContext, data *roleassignmentoperations.CreateOperationData) (uint64, error)
+
+	// Delete deletes a resource role assignment by the specified resource role assignment ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// GetById gets a resource role assignment by the specified resource role assignment ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*roleassignmentspb.ResourceRoleAssignment, error)
+
+	// GetAllByAssignee gets all resource role assignments by the specified assignee.
+	GetAllByAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType,
+	) ([]*roleassignmentspb.ResourceRoleAssignment, error)
+}
@@ -0,0 +1,219 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type GroupRoleAssignmentsService struct {
+	client grouproleassignmentspb.GroupRoleAssignmentServiceClient
+	config *config.ServiceConfig
+}
+
+var _ GroupRoleAssignments = (*GroupRoleAssignmentsService)(nil)
+
+func NewGroupRoleAssignmentsService(conn *grpc.ClientConn, config *config.ServiceConfig) *GroupRoleAssignmentsService {
+	return &GroupRoleAssignmentsService{
+		client: grouproleassignmentspb.NewGroupRoleAssignmentServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetById gets a group role assignment by the specified group role assignment ID.
+func (s *GroupRoleAssignmentsService) GetById(ctx *actions.OperationContext, id uint64) (*grouproleassignmentspb.GroupRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetById] get a group role assignment by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetByRoleAssignmentId gets a group role assignment by the specified role assignment ID.
+func (s *GroupRoleAssignmentsService) GetByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*grouproleassignmentspb.GroupRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetByRoleAssignmentId] get a group role assignment by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetAllByGroup gets all role assignments of the group by the specified group.
+func (s *GroupRoleAssignmentsService) GetAllByGroup(ctx *actions.OperationContext, group groupspb.UserGroup) ([]*grouproleassignmentspb.GroupRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetAllByGroup] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetAllByGroupRequest{Group: group}
+	res, err := s.client.GetAllByGroup(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetAllByGroup] get all role assignments of the group by group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignments, nil
+}
+
+// Exists returns true if the group role assignment exists.
+func (s *GroupRoleAssignmentsService) Exists(ctx *actions.OperationContext, group groupspb.UserGroup, roleId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.Exists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.ExistsRequest{
+		Group:  group,
+		RoleId: roleId,
+	}
+
+	res, err := s.client.Exists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.Exists] group role assignment exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
+
+// IsAssigned returns true if the role is assigned to the group.
+func (s *GroupRoleAssignmentsService) IsAssigned(ctx *actions.OperationContext, group groupspb.UserGroup, roleId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.IsAssigned] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.IsAssignedRequest{
+		Group:  group,
+		RoleId: roleId,
+	}
+
+	res, err := s.client.IsAssigned(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.IsAssigned] is the role assigned to the group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.IsAssigned, nil
+}
+
+// GetIdByRoleAssignmentId gets the group role assignment ID by the specified role assignment ID.
+func (s *GroupRoleAssignmentsService) GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetIdByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetIdByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetIdByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetIdByRoleAssignmentId] get the group role assignment id by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// GetStatusById gets a group role assignment status by the specified group role assignment ID.
+func (s *GroupRoleAssignmentsService) GetStatusById(ctx *actions.OperationContext, id uint64) (grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return grouproleassignmentspb.GroupRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetStatusByIdRequest{Id: id}
+	res, err := s.client.GetStatusById(ctx2, req)
+	if err != nil {
+		return grouproleassignmentspb.GroupRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetStatusById] get a group role assignment status by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
+// GetStatusByRoleAssignmentId gets a group role assignment status by the specified role assignment ID.
+func (s *GroupRoleAssignmentsService) GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return grouproleassignmentspb.GroupRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetStatusByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetStatusByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetStatusByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return grouproleassignmentspb.GroupRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetStatusByRoleAssignmentId] get a group role assignment status by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
+// GetGroupRoleIdsByGroup gets the IDs of the roles assigned to the group by the specified group.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (s *GroupRoleAssignmentsService) GetGroupRoleIdsByGroup(ctx *actions.OperationContext, group groupspb.UserGroup, roleFilter []uint64) ([]uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetGroupRoleIdsByGroup] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &grouproleassignmentspb.GetGroupRoleIdsByGroupRequest{
+		Group:      group,
+		RoleFilter: roleFilter,
+	}
+
+	res, err := s.client.GetGroupRoleIdsByGroup(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.GroupRoleAssignmentsService.GetGroupRoleIdsByGroup] get the ids of the roles assigned to the group by group: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.RoleIds, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	rolespb "personal-website-v2/go-apis/identity/roles"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type CreateOperationData struct {
+	// The role name.
+	Name string `json:"name"`
+
+	// The role type.
+	Type rolespb.RoleTypeEnum_RoleType `json:"type"`
+
+	// The role title.
+	Title string `json:"title"`
+
+	// The app ID.
+	AppId nullable.Nullable[uint64] `json:"appId"`
+
+	// The app group ID.
+	AppGroupId nullable.Nullable[uint64] `json:"appGroupId"`
+
+	// The role description.
+	Description string `json:"description"`
+}
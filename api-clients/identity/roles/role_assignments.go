@@ -0,0 +1,232 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"personal-website-v2/api-clients/identity/config"
+	assignmentoperations "personal-website-v2/api-clients/identity/roles/operations/assignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type RoleAssignmentsService struct {
+	client assignmentspb.RoleAssignmentServiceClient
+	config *config.ServiceConfig
+}
+
+var _ RoleAssignments = (*RoleAssignmentsService)(nil)
+
+func NewRoleAssignmentsService(conn *grpc.ClientConn, config *config.ServiceConfig) *RoleAssignmentsService {
+	return &RoleAssignmentsService{
+		client: assignmentspb.NewRoleAssignmentServiceClient(conn),
+		config: config,
+	}
+}
+
+// Create creates a role assignment and returns the role assignment ID if the operation is successful.
+func (s *RoleAssignmentsService) Create(ctx *actions.OperationContext, data *assignmentoperations.CreateOperationData) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.RoleAssignmentsService.Create] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var description *wrapperspb.StringValue
+	if data.Description.HasValue {
+		description = wrapperspb.String(data.Description.Value)
+	}
+
+	req := &assignmentspb.CreateRequest{
+		RoleId:       data.RoleId,
+		AssignedTo:   data.AssignedTo,
+		AssigneeType: data.AssigneeType,
+		Description:  description,
+	}
+
+	res, err := s.client.Create(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.RoleAssignmentsService.Create] create a role assignment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// Delete deletes a role assignment by the specified role assignment ID.
+func (s *RoleAssignmentsService) Delete(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.roles.RoleAssignmentsService.Delete] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.DeleteRequest{Id: id}
+	_, err = s.client.Delete(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.roles.RoleAssignmentsService.Delete] delete a role assignment: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetById gets a role assignment by the specified role assignment ID.
+func (s *RoleAssignmentsService) GetById(ctx *actions.OperationContext, id uint64) (*assignmentspb.RoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetById] get a role assignment by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetByRoleIdAndAssignee gets a role assignment by the specified role ID and assignee.
+func (s *RoleAssignmentsService) GetByRoleIdAndAssignee(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (*assignmentspb.RoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetByRoleIdAndAssignee] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.GetByRoleIdAndAssigneeRequest{
+		RoleId:       roleId,
+		AssigneeId:   assigneeId,
+		AssigneeType: assigneeType,
+	}
+
+	res, err := s.client.GetByRoleIdAndAssignee(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetByRoleIdAndAssignee] get a role assignment by role id and assignee: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// Exists returns true if the role assignment exists.
+func (s *RoleAssignmentsService) Exists(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RoleAssignmentsService.Exists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.ExistsRequest{
+		RoleId:       roleId,
+		AssigneeId:   assigneeId,
+		AssigneeType: assigneeType,
+	}
+
+	res, err := s.client.Exists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RoleAssignmentsService.Exists] role assignment exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
+
+// IsAssigned returns true if the role is assigned.
+func (s *RoleAssignmentsService) IsAssigned(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RoleAssignmentsService.IsAssigned] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.IsAssignedRequest{
+		RoleId:       roleId,
+		AssigneeId:   assigneeId,
+		AssigneeType: assigneeType,
+	}
+
+	res, err := s.client.IsAssigned(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RoleAssignmentsService.IsAssigned] is the role assigned: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.IsAssigned, nil
+}
+
+// GetAssigneeTypeById gets a role assignment assignee type by the specified role assignment ID.
+func (s *RoleAssignmentsService) GetAssigneeTypeById(ctx *actions.OperationContext, id uint64) (assignmentspb.AssigneeTypeEnum_AssigneeType, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return assignmentspb.AssigneeTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetAssigneeTypeById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.GetAssigneeTypeByIdRequest{Id: id}
+	res, err := s.client.GetAssigneeTypeById(ctx2, req)
+	if err != nil {
+		return assignmentspb.AssigneeTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetAssigneeTypeById] get a role assignment assignee type by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.AssigneeType, nil
+}
+
+// GetStatusById gets a role assignment status by the specified role assignment ID.
+func (s *RoleAssignmentsService) GetStatusById(ctx *actions.OperationContext, id uint64) (assignmentspb.RoleAssignmentStatusEnum_RoleAssignmentStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return assignmentspb.RoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.GetStatusByIdRequest{Id: id}
+	res, err := s.client.GetStatusById(ctx2, req)
+	if err != nil {
+		return assignmentspb.RoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetStatusById] get a role assignment status by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
+// GetRoleIdAndAssigneeById gets the role ID and assignee by the specified role assignment ID.
+func (s *RoleAssignmentsService) GetRoleIdAndAssigneeById(ctx *actions.OperationContext, id uint64) (*assignmentspb.GetRoleIdAndAssigneeByIdResponse, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetRoleIdAndAssigneeById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &assignmentspb.GetRoleIdAndAssigneeByIdRequest{Id: id}
+	res, err := s.client.GetRoleIdAndAssigneeById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RoleAssignmentsService.GetRoleIdAndAssigneeById] get the role id and assignee by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res, nil
+}
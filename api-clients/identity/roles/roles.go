@@ -21,8 +21,10 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"personal-website-v2/api-clients/identity/config"
+	roleoperations "personal-website-v2/api-clients/identity/roles/operations/roles"
 	rolespb "personal-website-v2/go-apis/identity/roles"
 	"personal-website-v2/pkg/actions"
 	apigrpc "personal-website-v2/pkg/api/grpc"
@@ -44,6 +46,114 @@ func NewRolesService(conn *grpc.ClientConn, config *config.ServiceConfig) *Roles
 	}
 }
 
+// Create creates a role and returns the role ID if the operation is successful.
+func (s *RolesService) Create(ctx *actions.OperationContext, data *roleoperations.CreateOperationData) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.RolesService.Create] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var appId *wrapperspb.UInt64Value
+	if data.AppId.HasValue {
+		appId = wrapperspb.UInt64(data.AppId.Value)
+	}
+
+	var appGroupId *wrapperspb.UInt64Value
+	if data.AppGroupId.HasValue {
+		appGroupId = wrapperspb.UInt64(data.AppGroupId.Value)
+	}
+
+	req := &rolespb.CreateRequest{
+		Name:        data.Name,
+		Type:        data.Type,
+		Title:       data.Title,
+		AppId:       appId,
+		AppGroupId:  appGroupId,
+		Description: data.Description,
+	}
+
+	res, err := s.client.Create(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.RolesService.Create] create a role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// Delete deletes a role by the specified role ID.
+func (s *RolesService) Delete(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.roles.RolesService.Delete] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.DeleteRequest{Id: id}
+	_, err = s.client.Delete(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.roles.RolesService.Delete] delete a role: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetById gets a role by the specified role ID.
+func (s *RolesService) GetById(ctx *actions.OperationContext, id uint64) (*rolespb.Role, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetById] get a role by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Role, nil
+}
+
+// GetByName gets a role by the specified role name.
+func (s *RolesService) GetByName(ctx *actions.OperationContext, name string) (*rolespb.Role, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetByName] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.GetByNameRequest{Name: name}
+	res, err := s.client.GetByName(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetByName] get a role by name: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Role, nil
+}
+
+// GetAllByIds gets all roles by the specified role IDs.
+func (s *RolesService) GetAllByIds(ctx *actions.OperationContext, ids []uint64) ([]*rolespb.Role, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetAllByIds] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.GetAllByIdsRequest{Ids: ids}
+	res, err := s.client.GetAllByIds(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.RolesService.GetAllByIds] get all roles by ids: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Roles, nil
+}
+
 // GetAllByNames gets all roles by the specified role names.
 func (s *RolesService) GetAllByNames(names []string, operationUserId uint64) ([]*rolespb.Role, error) {
 	md := metadata.New(map[string]string{apimetadata.UserIdMDKey: strconv.FormatUint(operationUserId, 10)})
@@ -77,3 +187,57 @@ func (s *RolesService) GetAllByNamesWithContext(ctx *actions.OperationContext, n
 	}
 	return res.Roles, nil
 }
+
+// Exists returns true if the role exists.
+func (s *RolesService) Exists(ctx *actions.OperationContext, name string) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RolesService.Exists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.ExistsRequest{Name: name}
+	res, err := s.client.Exists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.RolesService.Exists] role exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
+
+// GetTypeById gets a role type by the specified role ID.
+func (s *RolesService) GetTypeById(ctx *actions.OperationContext, id uint64) (rolespb.RoleTypeEnum_RoleType, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return rolespb.RoleTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RolesService.GetTypeById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.GetTypeByIdRequest{Id: id}
+	res, err := s.client.GetTypeById(ctx2, req)
+	if err != nil {
+		return rolespb.RoleTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RolesService.GetTypeById] get a role type by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Type, nil
+}
+
+// GetStatusById gets a role status by the specified role ID.
+func (s *RolesService) GetStatusById(ctx *actions.OperationContext, id uint64) (rolespb.RoleStatusEnum_RoleStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return rolespb.RoleStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RolesService.GetStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &rolespb.GetStatusByIdRequest{Id: id}
+	res, err := s.client.GetStatusById(ctx2, req)
+	if err != nil {
+		return rolespb.RoleStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.RolesService.GetStatusById] get a role status by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
@@ -15,14 +15,135 @@
 package roles
 
 import (
+	assignmentoperations "personal-website-v2/api-clients/identity/roles/operations/assignments"
+	roleoperations "personal-website-v2/api-clients/identity/roles/operations/roles"
+	groupspb "personal-website-v2/go-apis/identity/groups"
 	rolespb "personal-website-v2/go-apis/identity/roles"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	userroleassignmentspb "personal-website-v2/go-apis/identity/roles/userroleassignments"
 	"personal-website-v2/pkg/actions"
 )
 
 type Roles interface {
+	// Create creates a role and returns the role ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *roleoperations.CreateOperationData) (uint64, error)
+
+	// Delete deletes a role by the specified role ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// GetById gets a role by the specified role ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*rolespb.Role, error)
+
+	// GetByName gets a role by the specified role name.
+	GetByName(ctx *actions.OperationContext, name string) (*rolespb.Role, error)
+
+	// GetAllByIds gets all roles by the specified role IDs.
+	GetAllByIds(ctx *actions.OperationContext, ids []uint64) ([]*rolespb.Role, error)
+
 	// GetAllByNames gets all roles by the specified role names.
 	GetAllByNames(names []string, operationUserId uint64) ([]*rolespb.Role, error)
 
 	// GetAllByNamesWithContext gets all roles by the specified role names.
 	GetAllByNamesWithContext(ctx *actions.OperationContext, names []string) ([]*rolespb.Role, error)
+
+	// Exists returns true if the role exists.
+	Exists(ctx *actions.OperationContext, name string) (bool, error)
+
+	// GetTypeById gets a role type by the specified role ID.
+	GetTypeById(ctx *actions.OperationContext, id uint64) (rolespb.RoleTypeEnum_RoleType, error)
+
+	// GetStatusById gets a role status by the specified role ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (rolespb.RoleStatusEnum_RoleStatus, error)
+}
+
+type RoleAssignments interface {
+	// Create creates a role assignment and returns the role assignment ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *assignmentoperations.CreateOperationData) (uint64, error)
+
+	// Delete deletes a role assignment by the specified role assignment ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// GetById gets a role assignment by the specified role assignment ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*assignmentspb.RoleAssignment, error)
+
+	// GetByRoleIdAndAssignee gets a role assignment by the specified role ID and assignee.
+	GetByRoleIdAndAssignee(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (*assignmentspb.RoleAssignment, error)
+
+	// Exists returns true if the role assignment exists.
+	Exists(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (bool, error)
+
+	// IsAssigned returns true if the role is assigned.
+	IsAssigned(ctx *actions.OperationContext, roleId, assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) (bool, error)
+
+	// GetAssigneeTypeById gets a role assignment assignee type by the specified role assignment ID.
+	GetAssigneeTypeById(ctx *actions.OperationContext, id uint64) (assignmentspb.AssigneeTypeEnum_AssigneeType, error)
+
+	// GetStatusById gets a role assignment status by the specified role assignment ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (assignmentspb.RoleAssignmentStatusEnum_RoleAssignmentStatus, error)
+
+	// GetRoleIdAndAssigneeById gets the role ID and assignee by the specified role assignment ID.
+	GetRoleIdAndAssigneeById(ctx *actions.OperationContext, id uint64) (*assignmentspb.GetRoleIdAndAssigneeByIdResponse, error)
+}
+
+type UserRoleAssignments interface {
+	// GetById gets a user's role assignment by the specified user's role assignment ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*userroleassignmentspb.UserRoleAssignment, error)
+
+	// GetByRoleAssignmentId gets a user's role assignment by the specified role assignment ID.
+	GetByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*userroleassignmentspb.UserRoleAssignment, error)
+
+	// GetAllByUserId gets all user's role assignments by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*userroleassignmentspb.UserRoleAssignment, error)
+
+	// Exists returns true if the user's role assignment exists.
+	Exists(ctx *actions.OperationContext, userId, roleId uint64) (bool, error)
+
+	// IsAssigned returns true if the role is assigned to the user.
+	IsAssigned(ctx *actions.OperationContext, userId, roleId uint64) (bool, error)
+
+	// GetIdByRoleAssignmentId gets the user's role assignment ID by the specified role assignment ID.
+	GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
+	// GetStatusById gets a user's role assignment status by the specified user's role assignment ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus, error)
+
+	// GetStatusByRoleAssignmentId gets a user's role assignment status by the specified role assignment ID.
+	GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus, error)
+
+	// GetUserRoleIdsByUserId gets the IDs of the roles assigned to the user by the specified user ID.
+	// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+	// specified in the filter, if any, are returned.
+	GetUserRoleIdsByUserId(ctx *actions.OperationContext, userId uint64, roleFilter []uint64) ([]uint64, error)
+}
+
+type GroupRoleAssignments interface {
+	// GetById gets a group role assignment by the specified group role assignment ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*grouproleassignmentspb.GroupRoleAssignment, error)
+
+	// GetByRoleAssignmentId gets a group role assignment by the specified role assignment ID.
+	GetByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*grouproleassignmentspb.GroupRoleAssignment, error)
+
+	// GetAllByGroup gets all role assignments of the group by the specified group.
+	GetAllByGroup(ctx *actions.OperationContext, group groupspb.UserGroup) ([]*grouproleassignmentspb.GroupRoleAssignment, error)
+
+	// Exists returns true if the group role assignment exists.
+	Exists(ctx *actions.OperationContext, group groupspb.UserGroup, roleId uint64) (bool, error)
+
+	// IsAssigned returns true if the role is assigned to the group.
+	IsAssigned(ctx *actions.OperationContext, group groupspb.UserGroup, roleId uint64) (bool, error)
+
+	// GetIdByRoleAssignmentId gets the group role assignment ID by the specified role assignment ID.
+	GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
+	// GetStatusById gets a group role assignment status by the specified group role assignment ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus, error)
+
+	// GetStatusByRoleAssignmentId gets a group role assignment status by the specified role assignment ID.
+	GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus, error)
+
+	// GetGroupRoleIdsByGroup gets the IDs of the roles assigned to the group by the specified group.
+	// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+	// specified in the filter, if any, are returned.
+	GetGroupRoleIdsByGroup(ctx *actions.OperationContext, group groupspb.UserGroup, roleFilter []uint64) ([]uint64, error)
 }
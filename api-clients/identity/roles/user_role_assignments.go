@@ -0,0 +1,218 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	userroleassignmentspb "personal-website-v2/go-apis/identity/roles/userroleassignments"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserRoleAssignmentsService struct {
+	client userroleassignmentspb.UserRoleAssignmentServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserRoleAssignments = (*UserRoleAssignmentsService)(nil)
+
+func NewUserRoleAssignmentsService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserRoleAssignmentsService {
+	return &UserRoleAssignmentsService{
+		client: userroleassignmentspb.NewUserRoleAssignmentServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetById gets a user's role assignment by the specified user's role assignment ID.
+func (s *UserRoleAssignmentsService) GetById(ctx *actions.OperationContext, id uint64) (*userroleassignmentspb.UserRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetById] get a user's role assignment by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetByRoleAssignmentId gets a user's role assignment by the specified role assignment ID.
+func (s *UserRoleAssignmentsService) GetByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*userroleassignmentspb.UserRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetByRoleAssignmentId] get a user's role assignment by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignment, nil
+}
+
+// GetAllByUserId gets all user's role assignments by the specified user ID.
+func (s *UserRoleAssignmentsService) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*userroleassignmentspb.UserRoleAssignment, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetAllByUserId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetAllByUserIdRequest{UserId: userId}
+	res, err := s.client.GetAllByUserId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetAllByUserId] get all user's role assignments by user id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Assignments, nil
+}
+
+// Exists returns true if the user's role assignment exists.
+func (s *UserRoleAssignmentsService) Exists(ctx *actions.OperationContext, userId, roleId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.Exists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.ExistsRequest{
+		UserId: userId,
+		RoleId: roleId,
+	}
+
+	res, err := s.client.Exists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.Exists] user's role assignment exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
+
+// IsAssigned returns true if the role is assigned to the user.
+func (s *UserRoleAssignmentsService) IsAssigned(ctx *actions.OperationContext, userId, roleId uint64) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.IsAssigned] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.IsAssignedRequest{
+		UserId: userId,
+		RoleId: roleId,
+	}
+
+	res, err := s.client.IsAssigned(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.IsAssigned] is the role assigned to the user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.IsAssigned, nil
+}
+
+// GetIdByRoleAssignmentId gets the user's role assignment ID by the specified role assignment ID.
+func (s *UserRoleAssignmentsService) GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetIdByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetIdByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetIdByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetIdByRoleAssignmentId] get the user's role assignment id by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// GetStatusById gets a user's role assignment status by the specified user's role assignment ID.
+func (s *UserRoleAssignmentsService) GetStatusById(ctx *actions.OperationContext, id uint64) (userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return userroleassignmentspb.UserRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetStatusByIdRequest{Id: id}
+	res, err := s.client.GetStatusById(ctx2, req)
+	if err != nil {
+		return userroleassignmentspb.UserRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetStatusById] get a user's role assignment status by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
+// GetStatusByRoleAssignmentId gets a user's role assignment status by the specified role assignment ID.
+func (s *UserRoleAssignmentsService) GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return userroleassignmentspb.UserRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetStatusByRoleAssignmentId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetStatusByRoleAssignmentIdRequest{RoleAssignmentId: roleAssignmentId}
+	res, err := s.client.GetStatusByRoleAssignmentId(ctx2, req)
+	if err != nil {
+		return userroleassignmentspb.UserRoleAssignmentStatusEnum_UNSPECIFIED, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetStatusByRoleAssignmentId] get a user's role assignment status by role assignment id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
+// GetUserRoleIdsByUserId gets the IDs of the roles assigned to the user by the specified user ID.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (s *UserRoleAssignmentsService) GetUserRoleIdsByUserId(ctx *actions.OperationContext, userId uint64, roleFilter []uint64) ([]uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetUserRoleIdsByUserId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userroleassignmentspb.GetUserRoleIdsByUserIdRequest{
+		UserId:     userId,
+		RoleFilter: roleFilter,
+	}
+
+	res, err := s.client.GetUserRoleIdsByUserId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.roles.UserRoleAssignmentsService.GetUserRoleIdsByUserId] get the ids of the roles assigned to the user by user id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.RoleIds, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type ActiveSessionsService struct {
+	client activesessionspb.ActiveSessionServiceClient
+	config *config.ServiceConfig
+}
+
+var _ ActiveSessions = (*ActiveSessionsService)(nil)
+
+func NewActiveSessionsService(conn *grpc.ClientConn, config *config.ServiceConfig) *ActiveSessionsService {
+	return &ActiveSessionsService{
+		client: activesessionspb.NewActiveSessionServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetAllByUserId gets all active sessions of the user by the specified user ID.
+func (s *ActiveSessionsService) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*activesessionspb.ActiveSession, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.sessions.ActiveSessionsService.GetAllByUserId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.GetAllByUserId(ctx2, &activesessionspb.GetAllByUserIdRequest{UserId: userId})
+	if err != nil {
+		return nil, fmt.Errorf("[identity.sessions.ActiveSessionsService.GetAllByUserId] get all active sessions of the user by user id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Sessions, nil
+}
+
+// Revoke revokes the user's session by the specified user ID and user's session ID.
+func (s *ActiveSessionsService) Revoke(ctx *actions.OperationContext, userId, sessionId uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.sessions.ActiveSessionsService.Revoke] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &activesessionspb.RevokeRequest{
+		UserId:    userId,
+		SessionId: sessionId,
+	}
+	if _, err = s.client.Revoke(ctx2, req); err != nil {
+		return fmt.Errorf("[identity.sessions.ActiveSessionsService.Revoke] revoke a user's session: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// RevokeAllOther revokes all active sessions of the user except the current session
+// and returns the number of revoked sessions.
+func (s *ActiveSessionsService) RevokeAllOther(ctx *actions.OperationContext, userId uint64) (int, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.sessions.ActiveSessionsService.RevokeAllOther] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	res, err := s.client.RevokeAllOther(ctx2, &activesessionspb.RevokeAllOtherRequest{UserId: userId})
+	if err != nil {
+		return 0, fmt.Errorf("[identity.sessions.ActiveSessionsService.RevokeAllOther] revoke all other sessions of the user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return int(res.Count), nil
+}
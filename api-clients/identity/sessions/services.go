@@ -0,0 +1,32 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions
+
+import (
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
+	"personal-website-v2/pkg/actions"
+)
+
+type ActiveSessions interface {
+	// GetAllByUserId gets all active sessions of the user by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*activesessionspb.ActiveSession, error)
+
+	// Revoke revokes the user's session by the specified user ID and user's session ID.
+	Revoke(ctx *actions.OperationContext, userId, sessionId uint64) error
+
+	// RevokeAllOther revokes all active sessions of the user except the current session
+	// and returns the number of revoked sessions.
+	RevokeAllOther(ctx *actions.OperationContext, userId uint64) (int, error)
+}
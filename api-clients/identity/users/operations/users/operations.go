@@ -0,0 +1,53 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"time"
+
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	userspb "personal-website-v2/go-apis/identity/users"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type CreateOperationData struct {
+	// The user's type (account type).
+	Type userspb.UserTypeEnum_UserType `json:"type"`
+
+	// The user's group.
+	Group groupspb.UserGroup `json:"group"`
+
+	// The user's status.
+	Status userspb.UserStatus `json:"status"`
+
+	// The user's email.
+	Email nullable.Nullable[string] `json:"email"`
+
+	// The first name.
+	FirstName string `json:"firstName"`
+
+	// The last name.
+	LastName string `json:"lastName"`
+
+	// The display name.
+	DisplayName string `json:"displayName"`
+
+	// The user's date of birth.
+	BirthDate nullable.Nullable[time.Time] `json:"birthDate"`
+
+	// The user's gender.
+	Gender personalinfopb.GenderEnum_Gender `json:"gender"`
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"personal-website-v2/api-clients/identity/config"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	"personal-website-v2/pkg/actions"
+	apigrpc "personal-website-v2/pkg/api/grpc"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+)
+
+type UserPersonalInfoService struct {
+	client personalinfopb.UserPersonalInfoServiceClient
+	config *config.ServiceConfig
+}
+
+var _ UserPersonalInfo = (*UserPersonalInfoService)(nil)
+
+func NewUserPersonalInfoService(conn *grpc.ClientConn, config *config.ServiceConfig) *UserPersonalInfoService {
+	return &UserPersonalInfoService{
+		client: personalinfopb.NewUserPersonalInfoServiceClient(conn),
+		config: config,
+	}
+}
+
+// GetByUserId gets user's personal info by the specified user ID.
+func (s *UserPersonalInfoService) GetByUserId(ctx *actions.OperationContext, userId uint64) (*personalinfopb.PersonalInfo, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UserPersonalInfoService.GetByUserId] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &personalinfopb.GetByUserIdRequest{UserId: userId}
+	res, err := s.client.GetByUserId(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UserPersonalInfoService.GetByUserId] get user's personal info by user id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Info, nil
+}
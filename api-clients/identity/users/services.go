@@ -15,11 +15,59 @@
 package users
 
 import (
+	useroperations "personal-website-v2/api-clients/identity/users/operations/users"
+	groupspb "personal-website-v2/go-apis/identity/groups"
 	userspb "personal-website-v2/go-apis/identity/users"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
 	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
 )
 
 type Users interface {
+	// Create creates a user and returns the user ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *useroperations.CreateOperationData) (uint64, error)
+
+	// Delete deletes a user by the specified user ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// GetById gets a user by the specified user ID.
+	GetById(ctx *actions.OperationContext, id uint64) (*userspb.User, error)
+
+	// GetByName gets a user by the specified user name.
+	GetByName(ctx *actions.OperationContext, name string, isCaseSensitive bool) (*userspb.User, error)
+
+	// GetByEmail gets a user by the specified user's email.
+	GetByEmail(ctx *actions.OperationContext, email string, isCaseSensitive bool) (*userspb.User, error)
+
+	// GetIdByName gets the user ID by the specified user name.
+	GetIdByName(ctx *actions.OperationContext, name string, isCaseSensitive bool) (uint64, error)
+
+	// GetNameById gets a user name by the specified user ID.
+	GetNameById(ctx *actions.OperationContext, id uint64) (string, error)
+
+	// SetNameById sets a user name by the specified user ID.
+	SetNameById(ctx *actions.OperationContext, id uint64, name nullable.Nullable[string]) error
+
+	// NameExists returns true if the user name exists.
+	NameExists(ctx *actions.OperationContext, name string) (bool, error)
+
+	// GetTypeById gets a user's type by the specified user ID.
+	GetTypeById(ctx *actions.OperationContext, id uint64) (userspb.UserTypeEnum_UserType, error)
+
+	// GetGroupById gets a user's group by the specified user ID.
+	GetGroupById(ctx *actions.OperationContext, id uint64) (groupspb.UserGroup, error)
+
+	// GetStatusById gets a user's status by the specified user ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (userspb.UserStatus, error)
+
 	// GetTypeAndStatusById gets a type and a status of the user by the specified user ID.
 	GetTypeAndStatusById(ctx *actions.OperationContext, id uint64) (userspb.UserTypeEnum_UserType, userspb.UserStatus, error)
+
+	// GetGroupAndStatusById gets a group and a status of the user by the specified user ID.
+	GetGroupAndStatusById(ctx *actions.OperationContext, id uint64) (groupspb.UserGroup, userspb.UserStatus, error)
+}
+
+type UserPersonalInfo interface {
+	// GetByUserId gets user's personal info by the specified user ID.
+	GetByUserId(ctx *actions.OperationContext, userId uint64) (*personalinfopb.PersonalInfo, error)
 }
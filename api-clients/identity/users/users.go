@@ -19,12 +19,17 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"personal-website-v2/api-clients/identity/config"
+	useroperations "personal-website-v2/api-clients/identity/users/operations/users"
+	groupspb "personal-website-v2/go-apis/identity/groups"
 	userspb "personal-website-v2/go-apis/identity/users"
 	"personal-website-v2/pkg/actions"
 	apigrpc "personal-website-v2/pkg/api/grpc"
 	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
 )
 
 type UsersService struct {
@@ -41,6 +46,264 @@ func NewUsersService(conn *grpc.ClientConn, config *config.ServiceConfig) *Users
 	}
 }
 
+// Create creates a user and returns the user ID if the operation is successful.
+func (s *UsersService) Create(ctx *actions.OperationContext, data *useroperations.CreateOperationData) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.users.UsersService.Create] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var email *wrapperspb.StringValue
+	if data.Email.HasValue {
+		email = wrapperspb.String(data.Email.Value)
+	}
+
+	var birthDate *timestamppb.Timestamp
+	if data.BirthDate.HasValue {
+		birthDate = timestamppb.New(data.BirthDate.Value)
+	}
+
+	req := &userspb.CreateRequest{
+		Type:        data.Type,
+		Group:       data.Group,
+		Status:      data.Status,
+		Email:       email,
+		FirstName:   data.FirstName,
+		LastName:    data.LastName,
+		DisplayName: data.DisplayName,
+		BirthDate:   birthDate,
+		Gender:      data.Gender,
+	}
+
+	res, err := s.client.Create(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.users.UsersService.Create] create a user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// Delete deletes a user by the specified user ID.
+func (s *UsersService) Delete(ctx *actions.OperationContext, id uint64) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.users.UsersService.Delete] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.DeleteRequest{Id: id}
+	_, err = s.client.Delete(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.users.UsersService.Delete] delete a user: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// GetById gets a user by the specified user ID.
+func (s *UsersService) GetById(ctx *actions.OperationContext, id uint64) (*userspb.User, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetByIdRequest{Id: id}
+	res, err := s.client.GetById(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetById] get a user by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.User, nil
+}
+
+// GetByName gets a user by the specified user name.
+func (s *UsersService) GetByName(ctx *actions.OperationContext, name string, isCaseSensitive bool) (*userspb.User, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetByName] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetByNameRequest{
+		Name:            name,
+		IsCaseSensitive: isCaseSensitive,
+	}
+
+	res, err := s.client.GetByName(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetByName] get a user by name: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.User, nil
+}
+
+// GetByEmail gets a user by the specified user's email.
+func (s *UsersService) GetByEmail(ctx *actions.OperationContext, email string, isCaseSensitive bool) (*userspb.User, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetByEmail] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetByEmailRequest{
+		Email:           email,
+		IsCaseSensitive: isCaseSensitive,
+	}
+
+	res, err := s.client.GetByEmail(ctx2, req)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.users.UsersService.GetByEmail] get a user by email: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.User, nil
+}
+
+// GetIdByName gets the user ID by the specified user name.
+func (s *UsersService) GetIdByName(ctx *actions.OperationContext, name string, isCaseSensitive bool) (uint64, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.users.UsersService.GetIdByName] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetIdByNameRequest{
+		Name:            name,
+		IsCaseSensitive: isCaseSensitive,
+	}
+
+	res, err := s.client.GetIdByName(ctx2, req)
+	if err != nil {
+		return 0, fmt.Errorf("[identity.users.UsersService.GetIdByName] get the user id by name: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Id, nil
+}
+
+// GetNameById gets a user name by the specified user ID.
+func (s *UsersService) GetNameById(ctx *actions.OperationContext, id uint64) (string, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("[identity.users.UsersService.GetNameById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetNameByIdRequest{Id: id}
+	res, err := s.client.GetNameById(ctx2, req)
+	if err != nil {
+		return "", fmt.Errorf("[identity.users.UsersService.GetNameById] get a user name by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Name, nil
+}
+
+// SetNameById sets a user name by the specified user ID.
+func (s *UsersService) SetNameById(ctx *actions.OperationContext, id uint64, name nullable.Nullable[string]) error {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return fmt.Errorf("[identity.users.UsersService.SetNameById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	var n *wrapperspb.StringValue
+	if name.HasValue {
+		n = wrapperspb.String(name.Value)
+	}
+
+	req := &userspb.SetNameByIdRequest{
+		Id:   id,
+		Name: n,
+	}
+
+	_, err = s.client.SetNameById(ctx2, req)
+	if err != nil {
+		return fmt.Errorf("[identity.users.UsersService.SetNameById] set a user name by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return nil
+}
+
+// NameExists returns true if the user name exists.
+func (s *UsersService) NameExists(ctx *actions.OperationContext, name string) (bool, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("[identity.users.UsersService.NameExists] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.NameExistsRequest{Name: name}
+	res, err := s.client.NameExists(ctx2, req)
+	if err != nil {
+		return false, fmt.Errorf("[identity.users.UsersService.NameExists] user name exists: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Exists, nil
+}
+
+// GetTypeById gets a user's type by the specified user ID.
+func (s *UsersService) GetTypeById(ctx *actions.OperationContext, id uint64) (userspb.UserTypeEnum_UserType, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return userspb.UserTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetTypeById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetTypeByIdRequest{Id: id}
+	res, err := s.client.GetTypeById(ctx2, req)
+	if err != nil {
+		return userspb.UserTypeEnum_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetTypeById] get a user's type by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Type, nil
+}
+
+// GetGroupById gets a user's group by the specified user ID.
+func (s *UsersService) GetGroupById(ctx *actions.OperationContext, id uint64) (groupspb.UserGroup, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return groupspb.UserGroup_USER_GROUP_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetGroupById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetGroupByIdRequest{Id: id}
+	res, err := s.client.GetGroupById(ctx2, req)
+	if err != nil {
+		return groupspb.UserGroup_USER_GROUP_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetGroupById] get a user's group by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Group, nil
+}
+
+// GetStatusById gets a user's status by the specified user ID.
+func (s *UsersService) GetStatusById(ctx *actions.OperationContext, id uint64) (userspb.UserStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return userspb.UserStatus_USER_STATUS_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetStatusByIdRequest{Id: id}
+	res, err := s.client.GetStatusById(ctx2, req)
+	if err != nil {
+		return userspb.UserStatus_USER_STATUS_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetStatusById] get a user's status by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Status, nil
+}
+
 // GetTypeAndStatusById gets a type and a status of the user by the specified user ID.
 func (s *UsersService) GetTypeAndStatusById(ctx *actions.OperationContext, id uint64) (userspb.UserTypeEnum_UserType, userspb.UserStatus, error) {
 	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
@@ -58,3 +321,21 @@ func (s *UsersService) GetTypeAndStatusById(ctx *actions.OperationContext, id ui
 	}
 	return res.Type, res.Status, nil
 }
+
+// GetGroupAndStatusById gets a group and a status of the user by the specified user ID.
+func (s *UsersService) GetGroupAndStatusById(ctx *actions.OperationContext, id uint64) (groupspb.UserGroup, userspb.UserStatus, error) {
+	ctx2, err := apigrpc.CreateOutgoingContextWithOperationContext(ctx)
+	if err != nil {
+		return groupspb.UserGroup_USER_GROUP_UNSPECIFIED, userspb.UserStatus_USER_STATUS_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetGroupAndStatusById] create an outgoing context with OperationContext: %w", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx2, s.config.CallTimeout)
+	defer cancel()
+
+	req := &userspb.GetGroupAndStatusByIdRequest{Id: id}
+	res, err := s.client.GetGroupAndStatusById(ctx2, req)
+	if err != nil {
+		return groupspb.UserGroup_USER_GROUP_UNSPECIFIED, userspb.UserStatus_USER_STATUS_UNSPECIFIED, fmt.Errorf("[identity.users.UsersService.GetGroupAndStatusById] get a group and a status of the user by id: %w", apigrpcerrors.ParseGrpcError(err))
+	}
+	return res.Group, res.Status, nil
+}
@@ -16,18 +16,23 @@ package loggingmanager
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type LoggingManagerServiceClientConfig struct {
 	ServerAddr  string
 	DialTimeout time.Duration
 	CallTimeout time.Duration
+	TLSConfig   *tls.Config // optional; if it's specified, TLS is used
 }
 
 // LoggingManagerService represents a client service for working with the LoggingManager Service.
@@ -62,7 +67,12 @@ func (s *LoggingManagerService) Init() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.config.DialTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithInsecure(), grpc.WithBlock())
+	creds := insecure.NewCredentials()
+	if s.config.TLSConfig != nil {
+		creds = credentials.NewTLS(s.config.TLSConfig)
+	}
+
+	conn, err := grpc.DialContext(ctx, s.config.ServerAddr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
 	if err != nil {
 		return fmt.Errorf("[loggingmanager.LoggingManagerService.Init] create a client connection: %w", err)
 	}
@@ -92,3 +102,23 @@ func (s *LoggingManagerService) Dispose() error {
 	s.disposed = true
 	return nil
 }
+
+// HealthCheck checks the state of the connection. The connection isn't healthy
+// if it has failed to connect or has been closed.
+func (s *LoggingManagerService) HealthCheck(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isInitialized || s.disposed {
+		return errors.New("[loggingmanager.LoggingManagerService.HealthCheck] LoggingManagerService not initialized or disposed")
+	}
+
+	switch st := s.conn.GetState(); st {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("[loggingmanager.LoggingManagerService.HealthCheck] connection state is %s", st)
+	case connectivity.Idle:
+		// reconnect now rather than on the next call
+		s.conn.Connect()
+	}
+	return nil
+}
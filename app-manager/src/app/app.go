@@ -15,6 +15,7 @@
 package app
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -63,9 +64,12 @@ import (
 	appcontrollers "personal-website-v2/pkg/app/service/net/http/server/controllers/app"
 	"personal-website-v2/pkg/base/env"
 	"personal-website-v2/pkg/base/nullable"
+	kafkacomponent "personal-website-v2/pkg/components/kafka"
 	"personal-website-v2/pkg/db/postgres"
 	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/health"
 	"personal-website-v2/pkg/identity"
+	identityapikeys "personal-website-v2/pkg/identity/apikeys"
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/adapters/console"
 	filelogadapter "personal-website-v2/pkg/logging/adapters/filelog"
@@ -81,6 +85,7 @@ import (
 	httpserver "personal-website-v2/pkg/net/http/server"
 	httpserverlogging "personal-website-v2/pkg/net/http/server/logging"
 	httpserverrouting "personal-website-v2/pkg/net/http/server/routing"
+	"personal-website-v2/pkg/net/tlsconfig"
 	"personal-website-v2/pkg/web/identity/authn/cookies"
 )
 
@@ -110,6 +115,8 @@ type Application struct {
 	mu                sync.Mutex
 	done              chan struct{}
 
+	healthChecker *health.Checker
+
 	identityManager identity.IdentityManager
 
 	tranManager   *actions.TransactionManager
@@ -124,8 +131,9 @@ type Application struct {
 
 	postgresManager *postgres.DbManager[ampostgres.Stores]
 
-	loggingManagerService *loggingmanager.LoggingManagerService
-	identityService       *identityclient.IdentityService
+	loggingManagerService   *loggingmanager.LoggingManagerService
+	identityService         *identityclient.IdentityService
+	apiKeyRevocationService *identityapikeys.ApiKeyRevocationService
 
 	appManager        *appmanager.AppManager
 	appGroupManager   *groupmanager.AppGroupManager
@@ -311,10 +319,18 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] init an identity manager: %w", err)
 	}
 
+	if a.apiKeyRevocationService != nil {
+		if err = a.apiKeyRevocationService.Start(); err != nil {
+			return fmt.Errorf("[app.Application.Start] start the API key revocation service: %w", err)
+		}
+	}
+
 	if err = a.configureActions(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure actions: %w", err)
 	}
 
+	a.configureHealthChecks()
+
 	if err = a.configureHttpServer(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure an HTTP server: %w", err)
 	}
@@ -368,10 +384,16 @@ func (a *Application) startLoggingSession() error {
 	if a.config.Mode == amappconfig.AppModeStartup {
 		ls = amapplogging.NewStartupLoggingSession()
 	} else {
+		var tlsc *tls.Config
+		if tlsc, err = a.config.Apis.Clients.LoggingManagerService.TLSConfig(); err != nil {
+			return fmt.Errorf("[app.Application.startLoggingSession] get a TLS config of the logging manager service: %w", err)
+		}
+
 		c := &loggingmanager.LoggingManagerServiceClientConfig{
 			ServerAddr:  a.config.Apis.Clients.LoggingManagerService.ServerAddr,
 			DialTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.DialTimeout) * time.Millisecond,
 			CallTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.CallTimeout) * time.Millisecond,
+			TLSConfig:   tlsc,
 		}
 		lms = loggingmanager.NewLoggingManagerService(c)
 
@@ -595,10 +617,16 @@ func (a *Application) configureIdentity() error {
 			return fmt.Errorf("[app.Application.configureIdentity] new startup identity manager: %w", err)
 		}
 	} else {
+		var tlsc *tls.Config
+		if tlsc, err = a.config.Apis.Clients.IdentityService.TLSConfig(); err != nil {
+			return fmt.Errorf("[app.Application.configureIdentity] get a TLS config of the identity service: %w", err)
+		}
+
 		c := &identityclient.IdentityServiceClientConfig{
 			ServerAddr:  a.config.Apis.Clients.IdentityService.ServerAddr,
 			DialTimeout: time.Duration(a.config.Apis.Clients.IdentityService.DialTimeout) * time.Millisecond,
 			CallTimeout: time.Duration(a.config.Apis.Clients.IdentityService.CallTimeout) * time.Millisecond,
+			TLSConfig:   tlsc,
 		}
 		is = identityclient.NewIdentityService(c)
 		if err = is.Init(); err != nil {
@@ -613,7 +641,12 @@ func (a *Application) configureIdentity() error {
 			}
 		}()
 
-		if im, err = identity.NewIdentityManager(a.config.UserId, is, amidentity.Roles, amidentity.Permissions, a.loggerFactory); err != nil {
+		var akc *identity.ApiKeyCache
+		if akc, err = a.configureApiKeyCache(); err != nil {
+			return fmt.Errorf("[app.Application.configureIdentity] configure the API key cache: %w", err)
+		}
+
+		if im, err = identity.NewIdentityManager(a.config.UserId, is, amidentity.Roles, amidentity.Permissions, nil, akc, a.loggerFactory); err != nil {
 			return fmt.Errorf("[app.Application.configureIdentity] new identity manager: %w", err)
 		}
 	}
@@ -623,6 +656,37 @@ func (a *Application) configureIdentity() error {
 	return nil
 }
 
+// configureApiKeyCache creates the API key cache and the service that invalidates the cache
+// when API keys are revoked, if the cache is configured.
+func (a *Application) configureApiKeyCache() (*identity.ApiKeyCache, error) {
+	if a.config.Identity == nil || a.config.Identity.ApiKeyCache == nil {
+		return nil, nil
+	}
+
+	cc := a.config.Identity.ApiKeyCache
+	c, err := identity.NewApiKeyCache(&identity.ApiKeyCacheConfig{
+		Capacity: cc.Capacity,
+		TTL:      time.Duration(cc.TTL) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key cache: %w", err)
+	}
+
+	sc := &identityapikeys.ApiKeyRevocationServiceConfig{
+		Kafka: &identityapikeys.ApiKeyRevocationServiceKafkaConfig{
+			Config: cc.Revocation.Kafka.ConsumerConfig.Config(),
+			Topic:  cc.Revocation.Kafka.Topic,
+		},
+	}
+	s, err := identityapikeys.NewApiKeyRevocationService(a.appSessionId.Value, c, sc, a.loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key revocation service: %w", err)
+	}
+
+	a.apiKeyRevocationService = s
+	return c, nil
+}
+
 func (a *Application) configureDb() error {
 	dbConfigs := make(map[string]*postgres.DbConfig, len(a.config.Db.Postgres.Configs))
 	dataMap := make(map[string]string, len(a.config.Db.Postgres.DataMap))
@@ -727,6 +791,24 @@ func (a *Application) configureActions() error {
 	return nil
 }
 
+func (a *Application) configureHealthChecks() {
+	c := health.NewChecker(0)
+	c.Add("appSession", a.session.HealthCheck)
+	c.Add("loggingSession", a.loggingSession.HealthCheck)
+	c.Add("postgres", a.postgresManager.HealthCheck)
+	c.Add("kafka", kafkacomponent.HealthCheck)
+
+	if a.loggingManagerService != nil {
+		c.Add("loggingManagerService", a.loggingManagerService.HealthCheck)
+	}
+
+	if a.identityService != nil {
+		c.Add("identityService", a.identityService.HealthCheck)
+	}
+
+	a.healthChecker = c
+}
+
 func (a *Application) configureHttpServer() error {
 	var ac *cookies.CookieAuthnConfig
 	if a.config.Auth != nil && a.config.Auth.Authn != nil && a.config.Auth.Authn.Http != nil && a.config.Auth.Authn.Http.Cookies != nil {
@@ -751,6 +833,12 @@ func (a *Application) configureHttpServer() error {
 		return fmt.Errorf("[app.Application.configureHttpServer] configure HTTP routing: %w", err)
 	}
 
+	if a.config.Net.Http.Server.HealthProbes {
+		if err := httpserver.AddHealthRoutes(router, a.healthChecker, a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] add the routes of the health probes: %w", err)
+		}
+	}
+
 	rpcb := httpserver.NewRequestPipelineConfigBuilder()
 	rpc := rpcb.SetPipelineLifetime(rpl).
 		UseAuthentication().
@@ -780,6 +868,13 @@ func (a *Application) configureHttpServer() error {
 	}
 
 	a.httpServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Http.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Http.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] new TLS config: %w", err)
+		}
+	}
+
 	hsb := httpserver.NewHttpServerBuilder(httpServerId, a.appSessionId.Value, l, a.loggerFactory)
 	hsb.Configure(func(config *httpserver.HttpServerConfig) {
 		config.Addr = a.config.Net.Http.Server.Addr
@@ -787,6 +882,7 @@ func (a *Application) configureHttpServer() error {
 		config.WriteTimeout = time.Duration(a.config.Net.Http.Server.WriteTimeout) * time.Millisecond
 		config.IdleTimeout = time.Duration(a.config.Net.Http.Server.IdleTimeout) * time.Millisecond
 		config.PipelineConfig = rpc
+		config.TLSConfig = tlsc
 	})
 
 	s, err := hsb.Build()
@@ -799,7 +895,10 @@ func (a *Application) configureHttpServer() error {
 }
 
 func (a *Application) configureHttpRouting(router *httpserverrouting.Router) error {
-	ic := &appcontrollers.ApplicationControllerIdentityConfig{StopPermission: amidentity.PermissionApp_Stop}
+	ic := &appcontrollers.ApplicationControllerIdentityConfig{
+		StopPermission:       amidentity.PermissionApp_Stop,
+		GetMetricsPermission: amidentity.PermissionApp_GetMetrics,
+	}
 	applicationController, err := appcontrollers.NewApplicationController(a, a.appSessionId.Value, a.actionManager, a.identityManager, ic, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureHttpRouting] new application controller: %w", err)
@@ -817,6 +916,7 @@ func (a *Application) configureHttpRouting(router *httpserverrouting.Router) err
 
 	// private
 	router.AddPost("App_Stop", "/private/api/app/stop", applicationController.Stop)
+	router.AddGet("App_GetMetrics", "/metrics", applicationController.GetMetrics)
 
 	// public
 	router.AddGet("Apps_GetByIdOrName", "/api/apps", appController.GetByIdOrName)
@@ -863,6 +963,7 @@ func (a *Application) configureGrpcServer() error {
 		UseAuthentication().
 		UseAuthorization().
 		UseErrorHandler().
+		UseHealthChecks(a.healthChecker).
 		Build()
 
 	c := &grpcserverlogging.LoggerConfig{
@@ -885,10 +986,18 @@ func (a *Application) configureGrpcServer() error {
 	}
 
 	a.grpcServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Grpc.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Grpc.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureGrpcServer] new TLS config: %w", err)
+		}
+	}
+
 	sb := grpcserver.NewGrpcServerBuilder(grpcServerId, a.appSessionId.Value, l, a.loggerFactory)
 	sb.Configure(func(config *grpcserver.GrpcServerConfig) {
 		config.Addr = a.config.Net.Grpc.Server.Addr
 		config.PipelineConfig = rpc
+		config.TLSConfig = tlsc
 	})
 
 	if err := a.configureGrpcServices(sb); err != nil {
@@ -1071,6 +1180,12 @@ func (a *Application) stop(ctx *actions.OperationContext) {
 		a.postgresManager.Dispose()
 	}
 
+	if a.apiKeyRevocationService != nil && a.apiKeyRevocationService.IsStarted() {
+		if err := a.apiKeyRevocationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the API key revocation service")
+		}
+	}
+
 	if a.identityService != nil {
 		if err := a.identityService.Dispose(); err != nil {
 			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the identity service")
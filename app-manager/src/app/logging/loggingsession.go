@@ -15,6 +15,7 @@
 package logging
 
 import (
+	"context"
 	"errors"
 	"log"
 	"sync"
@@ -40,6 +41,13 @@ func (s *StartupLoggingSession) IsStarted() bool {
 	return s.isStarted.Load()
 }
 
+func (s *StartupLoggingSession) HealthCheck(ctx context.Context) error {
+	if !s.isStarted.Load() {
+		return errors.New("[logging.StartupLoggingSession.HealthCheck] logging session not started")
+	}
+	return nil
+}
+
 func (s *StartupLoggingSession) GetId() (uint64, error) {
 	if !s.isStarted.Load() {
 		return 0, errors.New("[logging.StartupLoggingSession.GetId] logging session not started")
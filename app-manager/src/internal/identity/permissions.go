@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "appmanager.app.stop"
+	PermissionApp_Stop       = "appmanager.app.stop"
+	PermissionApp_GetMetrics = "appmanager.app.getMetrics"
 
 	// Permissions of Apps.
 	//
@@ -39,6 +40,7 @@ const (
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionApps_Get,
 	PermissionApps_GetStatus,
 	PermissionAppGroup_Get,
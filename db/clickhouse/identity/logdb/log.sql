@@ -48,6 +48,7 @@ CREATE TABLE IF NOT EXISTS identity_logdb.log
     ),
     logging_session_id UInt64,
     app_session_id Nullable(UInt64),
+    impersonator_id Nullable(UInt64),
     tran Tuple(
         id Nullable(UUID)
     ) DEFAULT tuple(NULL),
@@ -95,6 +96,7 @@ CREATE TABLE IF NOT EXISTS identity_logdb.log
     INDEX agent_name_idx tupleElement(agent, 'name') TYPE set(0) GRANULARITY 1,
     INDEX logging_session_id_idx logging_session_id TYPE set(0) GRANULARITY 1,
     INDEX app_session_id_idx app_session_id TYPE set(0) GRANULARITY 1,
+    INDEX impersonator_id_idx impersonator_id TYPE set(0) GRANULARITY 1,
     INDEX tran_id_idx tupleElement(tran, 'id') TYPE bloom_filter GRANULARITY 1,
     INDEX action_id_idx tupleElement(action, 'id') TYPE bloom_filter GRANULARITY 1,
     INDEX action_type_idx tupleElement(action, 'type') TYPE set(0) GRANULARITY 1,
@@ -135,6 +137,7 @@ CREATE TABLE IF NOT EXISTS identity_logdb.log_queue
     agent Tuple(name String, `type` String, version String),
     logging_session_id UInt64,
     app_session_id Nullable(UInt64),
+    impersonator_id Nullable(UInt64),
     tran Tuple(
         id Nullable(String)
     ),
@@ -191,6 +194,7 @@ SELECT
     agent,
     logging_session_id,
     app_session_id,
+    impersonator_id,
     tuple(toUUID(tupleElement(tran, 'id'))) AS tran,
     if(tupleElement(action, 'id') IS NOT NULL, (toUUID(tupleElement(action, 'id')), tupleElement(action, 'type'), tupleElement(action, 'category'), tupleElement(action, 'group')), (NULL, 0, 0, 0)) AS action,
     if(tupleElement(operation, 'id') IS NOT NULL, (toUUID(tupleElement(operation, 'id')), tupleElement(operation, 'type'), tupleElement(operation, 'category'), tupleElement(operation, 'group')), (NULL, 0, 0, 0)) AS operation,
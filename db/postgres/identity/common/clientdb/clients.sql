@@ -141,5 +141,7 @@ BEGIN
         SET updated_at = _time, updated_by = _deleted_by, status = 8, status_updated_at = _time, status_updated_by = _deleted_by,
             status_comment = _status_comment, _version_stamp = _version_stamp + 1, _timestamp = _time
         WHERE id = _id;
+
+    DELETE FROM public.lockouts WHERE id = _id;
 END;
 $$ LANGUAGE plpgsql;
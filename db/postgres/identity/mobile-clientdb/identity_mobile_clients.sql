@@ -90,3 +90,32 @@ CREATE INDEX IF NOT EXISTS clients_status_updated_at_idx ON public.clients (stat
 CREATE INDEX IF NOT EXISTS clients_app_id_idx ON public.clients (app_id);
 CREATE INDEX IF NOT EXISTS clients_last_activity_time_idx ON public.clients (last_activity_time);
 CREATE INDEX IF NOT EXISTS clients_last_activity_ip_idx ON public.clients (last_activity_ip);
+
+-- Table: public.lockouts
+CREATE TABLE IF NOT EXISTS public.lockouts
+(
+    id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    failed_attempts integer NOT NULL,
+    first_failed_attempt_at timestamp(6) without time zone,
+    last_failed_attempt_at timestamp(6) without time zone,
+    lockout_count integer NOT NULL,
+    locked_out_at timestamp(6) without time zone,
+    locked_until timestamp(6) without time zone,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT lockouts_pkey PRIMARY KEY (id),
+    CONSTRAINT lockouts_id_fkey FOREIGN KEY (id)
+        REFERENCES public.clients (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT lockouts_failed_attempts_check CHECK (failed_attempts >= 0),
+    CONSTRAINT lockouts_lockout_count_check CHECK (lockout_count >= 0)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS lockouts_updated_at_idx ON public.lockouts (updated_at);
+CREATE INDEX IF NOT EXISTS lockouts_locked_until_idx
+    ON public.lockouts (locked_until)
+    WHERE locked_until IS NOT NULL;
@@ -176,3 +176,24 @@ TABLESPACE pg_default;
 CREATE INDEX IF NOT EXISTS deleted_role_permissions_created_at_idx ON public.deleted_role_permissions (created_at);
 CREATE INDEX IF NOT EXISTS deleted_role_permissions_deleted_at_idx ON public.deleted_role_permissions (deleted_at);
 CREATE INDEX IF NOT EXISTS deleted_role_permissions_role_id_permission_id_idx ON public.deleted_role_permissions (role_id, permission_id);
+
+-- Table: public.resource_types
+/*
+The types of the resources against which the permissions can be checked (e.g. 'website.contactMessages').
+The resource types are registered by the services at startup.
+*/
+CREATE TABLE IF NOT EXISTS public.resource_types
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    name character varying(256) COLLATE pg_catalog."default" NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT resource_types_pkey PRIMARY KEY (id)
+)
+TABLESPACE pg_default;
+
+CREATE UNIQUE INDEX IF NOT EXISTS resource_types_name_idx ON public.resource_types (name);
+CREATE UNIQUE INDEX IF NOT EXISTS resource_types_name_lc_idx ON public.resource_types (lower(name));
+CREATE INDEX IF NOT EXISTS resource_types_created_at_idx ON public.resource_types (created_at);
@@ -0,0 +1,38 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.register_resource_types(character varying[], bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.register_resource_types(
+    IN _names character varying[],
+    IN _created_by public.resource_types.created_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- the resource types that are already registered are skipped
+    INSERT INTO public.resource_types(name, created_at, created_by, _version_stamp, _timestamp)
+        SELECT DISTINCT n, _time, _created_by, 1, _time FROM unnest(_names) AS n
+        ON CONFLICT DO NOTHING;
+END;
+$$ LANGUAGE plpgsql;
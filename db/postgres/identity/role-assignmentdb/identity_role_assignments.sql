@@ -31,6 +31,7 @@ Assignee types:
     Unspecified = 0
     User        = 1
     Group       = 2
+    Client      = 3
 
 Role assignment statuses:
     Unspecified = 0
@@ -55,11 +56,14 @@ CREATE TABLE IF NOT EXISTS public.role_assignments
     status_updated_by bigint NOT NULL,
     status_comment text COLLATE pg_catalog."default",
     description text COLLATE pg_catalog."default",
+    valid_from timestamp(6) without time zone,
+    valid_until timestamp(6) without time zone,
     _version_stamp bigint NOT NULL,
     _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
     CONSTRAINT role_assignments_pkey PRIMARY KEY (id),
-    CONSTRAINT role_assignments_assignee_type_check CHECK (assignee_type = 1 OR assignee_type = 2),
-    CONSTRAINT role_assignments_status_check CHECK (status >= 1 AND status <= 5)
+    CONSTRAINT role_assignments_assignee_type_check CHECK (assignee_type >= 1 AND assignee_type <= 3),
+    CONSTRAINT role_assignments_status_check CHECK (status >= 1 AND status <= 5),
+    CONSTRAINT role_assignments_valid_until_check CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
 )
 TABLESPACE pg_default;
 
@@ -74,6 +78,9 @@ CREATE INDEX IF NOT EXISTS role_assignments_created_at_idx ON public.role_assign
 CREATE INDEX IF NOT EXISTS role_assignments_updated_at_idx ON public.role_assignments (updated_at);
 CREATE INDEX IF NOT EXISTS role_assignments_status_idx ON public.role_assignments (status);
 CREATE INDEX IF NOT EXISTS role_assignments_status_updated_at_idx ON public.role_assignments (status_updated_at);
+CREATE INDEX IF NOT EXISTS role_assignments_valid_until_idx
+    ON public.role_assignments (valid_until)
+    WHERE status = 2 AND valid_until IS NOT NULL;
 
 -- Table: public.deleted_role_assignments
 /*
@@ -81,6 +88,7 @@ Assignee types:
     Unspecified = 0
     User        = 1
     Group       = 2
+    Client      = 3
 
 Role assignment statuses:
     Unspecified = 0
@@ -105,11 +113,14 @@ CREATE TABLE IF NOT EXISTS public.deleted_role_assignments
     status_updated_by bigint NOT NULL,
     status_comment text COLLATE pg_catalog."default",
     description text COLLATE pg_catalog."default",
+    valid_from timestamp(6) without time zone,
+    valid_until timestamp(6) without time zone,
     _version_stamp bigint NOT NULL,
     _timestamp timestamp(6) without time zone NOT NULL,
     CONSTRAINT deleted_role_assignments_pkey PRIMARY KEY (id),
-    CONSTRAINT deleted_role_assignments_assignee_type_check CHECK (assignee_type = 1 OR assignee_type = 2),
-    CONSTRAINT deleted_role_assignments_status_check CHECK (status = 5)
+    CONSTRAINT deleted_role_assignments_assignee_type_check CHECK (assignee_type >= 1 AND assignee_type <= 3),
+    CONSTRAINT deleted_role_assignments_status_check CHECK (status = 5),
+    CONSTRAINT deleted_role_assignments_valid_until_check CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
 )
 TABLESPACE pg_default;
 
@@ -120,3 +131,46 @@ CREATE INDEX IF NOT EXISTS deleted_role_assignments_created_at_idx ON public.del
 CREATE INDEX IF NOT EXISTS deleted_role_assignments_updated_at_idx ON public.deleted_role_assignments (updated_at);
 CREATE INDEX IF NOT EXISTS deleted_role_assignments_status_updated_at_idx ON public.deleted_role_assignments (status_updated_at);
 CREATE INDEX IF NOT EXISTS deleted_role_assignments_role_id_assigned_to_assignee_type_idx ON public.deleted_role_assignments (role_id, assigned_to, assignee_type);
+
+-- Table: public.resource_role_assignments
+/*
+Assignee types:
+    Unspecified = 0
+    User        = 1
+    Group       = 2
+    Client      = 3
+
+Resource owner rules:
+    None          = 0
+    CreatedBySelf = 1
+
+A resource role assignment grants the role to the assignee only for the resource with the specified ID
+(resource_id) or for all resources of the specified type that satisfy the owner rule (owner_rule).
+*/
+CREATE TABLE IF NOT EXISTS public.resource_role_assignments
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    role_id bigint NOT NULL,
+    assigned_to bigint NOT NULL,
+    assignee_type smallint NOT NULL,
+    resource_type_id bigint NOT NULL,
+    resource_id bigint,
+    owner_rule smallint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    description text COLLATE pg_catalog."default",
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT resource_role_assignments_pkey PRIMARY KEY (id),
+    CONSTRAINT resource_role_assignments_assignee_type_check CHECK (assignee_type >= 1 AND assignee_type <= 3),
+    CONSTRAINT resource_role_assignments_scope_check CHECK ((resource_id IS NOT NULL AND owner_rule = 0) OR (resource_id IS NULL AND owner_rule = 1))
+)
+TABLESPACE pg_default;
+
+CREATE UNIQUE INDEX IF NOT EXISTS resource_role_assignments_role_id_assignee_resource_idx
+    ON public.resource_role_assignments (role_id, assigned_to, assignee_type, resource_type_id, COALESCE(resource_id, 0), owner_rule);
+
+CREATE INDEX IF NOT EXISTS resource_role_assignments_assigned_to_assignee_type_idx ON public.resource_role_assignments (assigned_to, assignee_type);
+CREATE INDEX IF NOT EXISTS resource_role_assignments_role_id_idx ON public.resource_role_assignments (role_id);
+CREATE INDEX IF NOT EXISTS resource_role_assignments_resource_type_id_resource_id_idx ON public.resource_role_assignments (resource_type_id, resource_id);
+CREATE INDEX IF NOT EXISTS resource_role_assignments_created_at_idx ON public.resource_role_assignments (created_at);
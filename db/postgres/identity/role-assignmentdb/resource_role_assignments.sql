@@ -0,0 +1,101 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- FUNCTION: public.resource_role_assignment_exists(bigint, bigint, smallint, bigint, bigint, smallint)
+CREATE OR REPLACE FUNCTION public.resource_role_assignment_exists(
+    _role_id public.resource_role_assignments.role_id%TYPE,
+    _assigned_to public.resource_role_assignments.assigned_to%TYPE,
+    _assignee_type public.resource_role_assignments.assignee_type%TYPE,
+    _resource_type_id public.resource_role_assignments.resource_type_id%TYPE,
+    _resource_id public.resource_role_assignments.resource_id%TYPE,
+    _owner_rule public.resource_role_assignments.owner_rule%TYPE
+) RETURNS boolean AS $$
+BEGIN
+    RETURN EXISTS (SELECT 1 FROM public.resource_role_assignments WHERE role_id = _role_id AND assigned_to = _assigned_to AND assignee_type = _assignee_type
+        AND resource_type_id = _resource_type_id AND resource_id IS NOT DISTINCT FROM _resource_id AND owner_rule = _owner_rule LIMIT 1);
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.create_resource_role_assignment(bigint, bigint, smallint, bigint, bigint, smallint, bigint, text)
+/*
+Error codes:
+    NoError                             = 0
+    ResourceRoleAssignmentAlreadyExists = 16202
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_resource_role_assignment(
+    IN _role_id public.resource_role_assignments.role_id%TYPE,
+    IN _assigned_to public.resource_role_assignments.assigned_to%TYPE,
+    IN _assignee_type public.resource_role_assignments.assignee_type%TYPE,
+    IN _resource_type_id public.resource_role_assignments.resource_type_id%TYPE,
+    IN _resource_id public.resource_role_assignments.resource_id%TYPE,
+    IN _owner_rule public.resource_role_assignments.owner_rule%TYPE,
+    IN _created_by public.resource_role_assignments.created_by%TYPE,
+    IN _description public.resource_role_assignments.description%TYPE,
+    OUT _id public.resource_role_assignments.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF public.resource_role_assignment_exists(_role_id, _assigned_to, _assignee_type, _resource_type_id, _resource_id, _owner_rule) THEN
+        err_code := 16202; -- ResourceRoleAssignmentAlreadyExists
+        err_msg := 'resource role assignment with the same params already exists';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.resource_role_assignments(role_id, assigned_to, assignee_type, resource_type_id, resource_id, owner_rule, created_at, created_by,
+            description, _version_stamp, _timestamp)
+        VALUES (_role_id, _assigned_to, _assignee_type, _resource_type_id, _resource_id, _owner_rule, _time, _created_by, _description, 1, _time)
+        RETURNING id INTO _id;
+
+    EXCEPTION
+        WHEN unique_violation THEN
+            IF _id = 0 AND public.resource_role_assignment_exists(_role_id, _assigned_to, _assignee_type, _resource_type_id, _resource_id, _owner_rule) THEN
+                err_code := 16202; -- ResourceRoleAssignmentAlreadyExists
+                err_msg := 'resource role assignment with the same params already exists';
+                RETURN;
+            END IF;
+            RAISE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_resource_role_assignment(bigint)
+/*
+Error codes:
+    NoError                        = 0
+    ResourceRoleAssignmentNotFound = 16201
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_resource_role_assignment(
+    IN _id public.resource_role_assignments.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.resource_role_assignments WHERE id = _id;
+    IF NOT FOUND THEN
+        err_code := 16201; -- ResourceRoleAssignmentNotFound
+        err_msg := 'resource role assignment not found';
+        RETURN;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
@@ -38,13 +38,17 @@ CREATE OR REPLACE FUNCTION public.is_role_assigned(
     _assigned_to public.role_assignments.assigned_to%TYPE,
     _assignee_type public.role_assignments.assignee_type%TYPE
 ) RETURNS boolean AS $$
+DECLARE
+    _time timestamp(6) without time zone;
 BEGIN
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
    -- role assignment status: Active(2)
-    RETURN EXISTS (SELECT 1 FROM public.role_assignments WHERE role_id = _role_id AND assigned_to = _assigned_to AND assignee_type = _assignee_type AND status = 2 LIMIT 1);
+    RETURN EXISTS (SELECT 1 FROM public.role_assignments WHERE role_id = _role_id AND assigned_to = _assigned_to AND assignee_type = _assignee_type AND status = 2
+        AND (valid_from IS NULL OR valid_from <= _time) AND (valid_until IS NULL OR valid_until > _time) LIMIT 1);
 END;
 $$ LANGUAGE plpgsql;
 
--- PROCEDURE: public.create_role_assignment(bigint, bigint, smallint, bigint, text, text)
+-- PROCEDURE: public.create_role_assignment(bigint, bigint, smallint, bigint, text, text, timestamp, timestamp)
 /*
 Role assignment statuses:
     Active = 2
@@ -61,6 +65,8 @@ CREATE OR REPLACE PROCEDURE public.create_role_assignment(
     IN _created_by public.role_assignments.created_by%TYPE,
     IN _status_comment public.role_assignments.status_comment%TYPE,
     IN _description public.role_assignments.description%TYPE,
+    IN _valid_from public.role_assignments.valid_from%TYPE,
+    IN _valid_until public.role_assignments.valid_until%TYPE,
     OUT _id public.role_assignments.id%TYPE,
     OUT err_code bigint,
     OUT err_msg text) AS $$
@@ -80,8 +86,9 @@ BEGIN
     _time := (clock_timestamp() AT TIME ZONE 'UTC');
     -- role assignment status: Active(2)
     INSERT INTO public.role_assignments(role_id, assigned_to, assignee_type, created_at, created_by, updated_at, updated_by, status, status_updated_at,
-            status_updated_by, status_comment, description, _version_stamp, _timestamp)
-        VALUES (_role_id, _assigned_to, _assignee_type, _time, _created_by, _time, _created_by, 2, _time, _created_by, _status_comment, _description, 1, _time)
+            status_updated_by, status_comment, description, valid_from, valid_until, _version_stamp, _timestamp)
+        VALUES (_role_id, _assigned_to, _assignee_type, _time, _created_by, _time, _created_by, 2, _time, _created_by, _status_comment, _description,
+            _valid_from, _valid_until, 1, _time)
         RETURNING id INTO _id;
 
     EXCEPTION
@@ -208,3 +215,133 @@ BEGIN
     END IF;
 END;
 $$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.extend_role_assignment(bigint, timestamp, bigint)
+/*
+Role assignment statuses:
+    Active = 2
+
+Error codes:
+    NoError                = 0
+    InvalidOperation       = 3
+    RoleAssignmentNotFound = 13400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.extend_role_assignment(
+    IN _id public.role_assignments.id%TYPE,
+    IN _valid_until public.role_assignments.valid_until%TYPE,
+    IN _updated_by public.role_assignments.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.role_assignments.status%TYPE;
+    _old_valid_until public.role_assignments.valid_until%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status, valid_until INTO _status, _old_valid_until FROM public.role_assignments WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 13400; -- RoleAssignmentNotFound
+        err_msg := 'role assignment not found';
+        RETURN;
+    END IF;
+
+    -- role assignment status: Active(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid role assignment status (%s)', _status);
+        RETURN;
+    END IF;
+
+    IF _old_valid_until IS NULL THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'role assignment is permanent';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    IF _old_valid_until <= _time THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'role assignment has expired';
+        RETURN;
+    END IF;
+
+    IF _valid_until <= _old_valid_until THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'new end of the validity period must be later than the current one';
+        RETURN;
+    END IF;
+
+    UPDATE public.role_assignments
+        SET updated_at = _time, updated_by = _updated_by, valid_until = _valid_until, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.deactivate_role_assignment(bigint, boolean, bigint, text)
+/*
+Role assignment statuses:
+    Active   = 2
+    Inactive = 3
+
+Error codes:
+    NoError                = 0
+    InvalidOperation       = 3
+    RoleAssignmentNotFound = 13400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.deactivate_role_assignment(
+    IN _id public.role_assignments.id%TYPE,
+    IN _only_if_expired boolean,
+    IN _updated_by public.role_assignments.updated_by%TYPE,
+    IN _status_comment public.role_assignments.status_comment%TYPE,
+    OUT _deactivated boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.role_assignments.status%TYPE;
+    _valid_from public.role_assignments.valid_from%TYPE;
+    _valid_until public.role_assignments.valid_until%TYPE;
+BEGIN
+    _deactivated := FALSE;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status, valid_from, valid_until INTO _status, _valid_from, _valid_until FROM public.role_assignments WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 13400; -- RoleAssignmentNotFound
+        err_msg := 'role assignment not found';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    IF _only_if_expired THEN
+        -- role assignment status: Active(2)
+        IF _status <> 2 OR _valid_until IS NULL OR _valid_until > _time THEN
+            RETURN;
+        END IF;
+    -- role assignment status: Active(2)
+    ELSIF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid role assignment status (%s)', _status);
+        RETURN;
+    END IF;
+
+    -- the validity period of a role assignment that is revoked early ends now
+    -- (unless the validity period hasn't started yet)
+    IF (_valid_until IS NULL OR _valid_until > _time) AND (_valid_from IS NULL OR _valid_from < _time) THEN
+        _valid_until := _time;
+    END IF;
+
+    -- role assignment status: Inactive(3)
+    UPDATE public.role_assignments
+        SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = _status_comment, valid_until = _valid_until, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    _deactivated := TRUE;
+END;
+$$ LANGUAGE plpgsql;
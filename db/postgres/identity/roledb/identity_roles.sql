@@ -129,3 +129,34 @@ CREATE TABLE IF NOT EXISTS public.new_role_assignments
     CONSTRAINT new_role_assignments_operation_id_key UNIQUE (operation_id)
 )
 TABLESPACE pg_default;
+
+-- Table: public.role_inheritance
+/*
+A role inherits the permissions of its parent roles (and their parent roles, etc.).
+*/
+CREATE TABLE IF NOT EXISTS public.role_inheritance
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    role_id bigint NOT NULL,
+    parent_role_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT role_inheritance_pkey PRIMARY KEY (id),
+    CONSTRAINT role_inheritance_role_id_parent_role_id_key UNIQUE (role_id, parent_role_id),
+    CONSTRAINT role_inheritance_role_id_fkey FOREIGN KEY (role_id)
+        REFERENCES public.roles (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT role_inheritance_parent_role_id_fkey FOREIGN KEY (parent_role_id)
+        REFERENCES public.roles (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT role_inheritance_role_id_check CHECK (role_id <> parent_role_id)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS role_inheritance_role_id_idx ON public.role_inheritance (role_id);
+CREATE INDEX IF NOT EXISTS role_inheritance_parent_role_id_idx ON public.role_inheritance (parent_role_id);
+CREATE INDEX IF NOT EXISTS role_inheritance_created_at_idx ON public.role_inheritance (created_at);
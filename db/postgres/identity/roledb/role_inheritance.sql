@@ -0,0 +1,182 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- FUNCTION: public.get_role_ancestor_ids(bigint, integer)
+-- Returns the IDs of all roles from which the role inherits (directly or indirectly).
+CREATE OR REPLACE FUNCTION public.get_role_ancestor_ids(
+    _role_id public.role_inheritance.role_id%TYPE,
+    _max_depth integer
+) RETURNS SETOF bigint AS $$
+BEGIN
+    RETURN QUERY
+        WITH RECURSIVE ancestors(id, depth) AS (
+            SELECT ri.parent_role_id, 1 FROM public.role_inheritance ri WHERE ri.role_id = _role_id
+            UNION
+            SELECT ri.parent_role_id, a.depth + 1 FROM public.role_inheritance ri
+                INNER JOIN ancestors a ON ri.role_id = a.id
+                WHERE a.depth < _max_depth
+        )
+        SELECT DISTINCT a.id FROM ancestors a;
+END;
+$$ LANGUAGE plpgsql;
+
+-- FUNCTION: public.get_role_descendant_ids(bigint[], integer)
+-- Returns the IDs of all roles that inherit (directly or indirectly) from any of the specified roles.
+CREATE OR REPLACE FUNCTION public.get_role_descendant_ids(
+    _role_ids bigint[],
+    _max_depth integer
+) RETURNS SETOF bigint AS $$
+BEGIN
+    RETURN QUERY
+        WITH RECURSIVE descendants(id, depth) AS (
+            SELECT ri.role_id, 1 FROM public.role_inheritance ri WHERE ri.parent_role_id = ANY(_role_ids)
+            UNION
+            SELECT ri.role_id, d.depth + 1 FROM public.role_inheritance ri
+                INNER JOIN descendants d ON ri.parent_role_id = d.id
+                WHERE d.depth < _max_depth
+        )
+        SELECT DISTINCT d.id FROM descendants d;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.add_role_parent(bigint, bigint, bigint, integer)
+/*
+Role statuses:
+    Deleting = 4
+    Deleted  = 5
+
+Error codes:
+    NoError                      = 0
+    InvalidOperation             = 3
+    RoleNotFound                 = 11600
+    RoleParentAlreadyAdded       = 16000
+    RoleInheritanceCycle         = 16002
+    RoleInheritanceDepthExceeded = 16003
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.add_role_parent(
+    IN _role_id public.role_inheritance.role_id%TYPE,
+    IN _parent_role_id public.role_inheritance.parent_role_id%TYPE,
+    IN _created_by public.role_inheritance.created_by%TYPE,
+    IN _max_depth integer,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _status public.roles.status%TYPE;
+    _time timestamp(6) without time zone;
+    _ancestor_depth integer;
+    _descendant_depth integer;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    -- the role hierarchy is locked so that the cycle and depth checks of concurrent writers
+    -- are serialized (the lock conflicts with itself, but not with readers)
+    LOCK TABLE public.role_inheritance IN SHARE ROW EXCLUSIVE MODE;
+
+    SELECT status INTO _status FROM public.roles WHERE id = _role_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11600; -- RoleNotFound
+        err_msg := 'role not found';
+        RETURN;
+    END IF;
+
+    -- role status: Deleting(4), Deleted(5)
+    IF _status = 4 OR _status = 5 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'invalid role status';
+        RETURN;
+    END IF;
+
+    SELECT status INTO _status FROM public.roles WHERE id = _parent_role_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11600; -- RoleNotFound
+        err_msg := 'parent role not found';
+        RETURN;
+    END IF;
+
+    -- role status: Deleting(4), Deleted(5)
+    IF _status = 4 OR _status = 5 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'invalid parent role status';
+        RETURN;
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM public.role_inheritance WHERE role_id = _role_id AND parent_role_id = _parent_role_id LIMIT 1) THEN
+        err_code := 16000; -- RoleParentAlreadyAdded
+        err_msg := 'role already inherits from the parent role';
+        RETURN;
+    END IF;
+
+    IF _role_id = _parent_role_id OR _role_id IN (SELECT public.get_role_ancestor_ids(_parent_role_id, _max_depth)) THEN
+        err_code := 16002; -- RoleInheritanceCycle
+        err_msg := 'role inheritance cycle';
+        RETURN;
+    END IF;
+
+    -- the longest path in the hierarchy that goes through the new link
+    WITH RECURSIVE ancestors(id, depth) AS (
+        SELECT ri.parent_role_id, 1 FROM public.role_inheritance ri WHERE ri.role_id = _parent_role_id
+        UNION
+        SELECT ri.parent_role_id, a.depth + 1 FROM public.role_inheritance ri
+            INNER JOIN ancestors a ON ri.role_id = a.id
+            WHERE a.depth < _max_depth
+    )
+    SELECT COALESCE(max(a.depth), 0) INTO _ancestor_depth FROM ancestors a;
+
+    WITH RECURSIVE descendants(id, depth) AS (
+        SELECT ri.role_id, 1 FROM public.role_inheritance ri WHERE ri.parent_role_id = _role_id
+        UNION
+        SELECT ri.role_id, d.depth + 1 FROM public.role_inheritance ri
+            INNER JOIN descendants d ON ri.parent_role_id = d.id
+            WHERE d.depth < _max_depth
+    )
+    SELECT COALESCE(max(d.depth), 0) INTO _descendant_depth FROM descendants d;
+
+    IF _ancestor_depth + 1 + _descendant_depth > _max_depth THEN
+        err_code := 16003; -- RoleInheritanceDepthExceeded
+        err_msg := 'maximum depth of the role hierarchy exceeded';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.role_inheritance(role_id, parent_role_id, created_at, created_by, _version_stamp, _timestamp)
+        VALUES (_role_id, _parent_role_id, _time, _created_by, 1, _time);
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.remove_role_parent(bigint, bigint)
+/*
+Error codes:
+    NoError            = 0
+    RoleParentNotFound = 16001
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.remove_role_parent(
+    IN _role_id public.role_inheritance.role_id%TYPE,
+    IN _parent_role_id public.role_inheritance.parent_role_id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.role_inheritance WHERE role_id = _role_id AND parent_role_id = _parent_role_id;
+    IF NOT FOUND THEN
+        err_code := 16001; -- RoleParentNotFound
+        err_msg := 'role doesn''t inherit from the parent role';
+        RETURN;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
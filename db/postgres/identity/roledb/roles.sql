@@ -150,6 +150,14 @@ BEGIN
         RETURN;
     END IF;
 
+    IF EXISTS (SELECT 1 FROM public.role_inheritance WHERE parent_role_id = _id LIMIT 1) THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'role is inherited by other roles';
+        RETURN;
+    END IF;
+
+    DELETE FROM public.role_inheritance WHERE role_id = _id;
+
     _time := (clock_timestamp() AT TIME ZONE 'UTC');
     -- role status: Deleted(5)
     UPDATE public.roles
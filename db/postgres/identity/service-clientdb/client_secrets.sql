@@ -0,0 +1,69 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.rotate_client_secret(bigint, bytea, interval, bigint)
+/*
+Client statuses:
+    Deleting = 7
+    Deleted  = 8
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    ClientNotFound   = 11200
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.rotate_client_secret(
+    IN _client_id public.client_secrets.id%TYPE,
+    IN _secret_hash public.client_secrets.secret_hash%TYPE,
+    IN _previous_secret_ttl interval,
+    IN _updated_by public.client_secrets.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.clients.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.clients WHERE id = _client_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11200; -- ClientNotFound
+        err_msg := 'client not found';
+        RETURN;
+    END IF;
+
+    -- client statuses: Deleting(7), Deleted(8)
+    IF _status = 7 OR _status = 8 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid client status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.client_secrets(id, created_at, created_by, updated_at, updated_by, secret_hash, secret_created_at, previous_secret_hash,
+            previous_secret_expires_at, _version_stamp, _timestamp)
+        VALUES (_client_id, _time, _updated_by, _time, _updated_by, _secret_hash, _time, NULL, NULL, 1, _time)
+        ON CONFLICT (id) DO UPDATE
+            SET updated_at = EXCLUDED.updated_at,
+                updated_by = EXCLUDED.updated_by,
+                secret_hash = EXCLUDED.secret_hash,
+                secret_created_at = EXCLUDED.secret_created_at,
+                previous_secret_hash = public.client_secrets.secret_hash,
+                previous_secret_expires_at = EXCLUDED._timestamp + _previous_secret_ttl,
+                _version_stamp = public.client_secrets._version_stamp + 1,
+                _timestamp = EXCLUDED._timestamp;
+END;
+$$ LANGUAGE plpgsql;
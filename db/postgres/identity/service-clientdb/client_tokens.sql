@@ -0,0 +1,42 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_client_token(bigint, bytea, interval)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_client_token(
+    IN _client_id public.client_tokens.client_id%TYPE,
+    IN _token_hash public.client_tokens.token_hash%TYPE,
+    IN _ttl interval,
+    OUT _id public.client_tokens.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    DELETE FROM public.client_tokens WHERE client_id = _client_id AND expires_at <= _time;
+
+    INSERT INTO public.client_tokens(client_id, token_hash, created_at, expires_at)
+        VALUES (_client_id, _token_hash, _time, _time + _ttl)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
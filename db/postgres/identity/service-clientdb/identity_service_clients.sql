@@ -0,0 +1,213 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- ../db/postgres/common/clientdb/identity_clients.sql
+
+-- Database: identity_service_clients
+
+CREATE DATABASE identity_service_clients
+    WITH
+    OWNER = postgres
+    ENCODING = 'UTF8'
+    LC_COLLATE = 'en_US.UTF-8'
+    LC_CTYPE = 'en_US.UTF-8'
+    TABLESPACE = pg_default
+    CONNECTION LIMIT = -1
+    IS_TEMPLATE = False;
+
+
+-- Table: public.clients
+/*
+Client types:
+    Unspecified = 0
+    Web         = 1
+    Mobile      = 2
+    Service     = 3
+
+Client statuses:
+    Unspecified          = 0
+    New                  = 1
+    PendingApproval      = 2
+    Active               = 3
+    LockedOut            = 4
+    TemporarilyLockedOut = 5
+    Disabled             = 6
+    Deleting             = 7
+    Deleted              = 8
+
+id:
+increment: 1<<8 = 256
+start: (1<<8)+3 = 259 // 00000001 00000011(Service), 3(00000011): Service Client
+min_value: (1<<8)+3 = 259
+max_value: (1<<63)-1 = 9223372036854775807 // ((256^8)/2)-1
+max_count: (1<<55)-1 = 36028797018963967   // ((256^7)/2)-1, 9223372036854775807>>8
+exact_max_value: (36028797018963967*256)+3 = 9223372036854775555 // ((9223372036854775807>>8)<<8)+3, 3: Service Client
+
+id examples:
+259       // 00000001 00000011
++256: 515 // 00000010 00000011
++256: 771 // 00000011 00000011
+and so on
+*/
+CREATE TABLE IF NOT EXISTS public.clients
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 256 START 259 MINVALUE 259 MAXVALUE 9223372036854775807 CACHE 1 ),
+    type smallint NOT NULL GENERATED ALWAYS AS (3) STORED,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    status smallint NOT NULL,
+    status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    status_updated_by bigint NOT NULL,
+    status_comment text COLLATE pg_catalog."default",
+    app_id bigint,
+    first_user_agent text COLLATE pg_catalog."default",
+    last_user_agent text COLLATE pg_catalog."default",
+    last_activity_time timestamp(6) without time zone NOT NULL,
+    last_activity_ip character varying(64) COLLATE pg_catalog."default" NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT clients_pkey PRIMARY KEY (id),
+    CONSTRAINT clients_status_check CHECK (status >= 1 AND status <= 8)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS clients_created_at_idx ON public.clients (created_at);
+CREATE INDEX IF NOT EXISTS clients_updated_at_idx ON public.clients (updated_at);
+CREATE INDEX IF NOT EXISTS clients_status_idx ON public.clients (status);
+CREATE INDEX IF NOT EXISTS clients_status_updated_at_idx ON public.clients (status_updated_at);
+CREATE INDEX IF NOT EXISTS clients_app_id_idx ON public.clients (app_id);
+CREATE INDEX IF NOT EXISTS clients_last_activity_time_idx ON public.clients (last_activity_time);
+CREATE INDEX IF NOT EXISTS clients_last_activity_ip_idx ON public.clients (last_activity_ip);
+
+-- Table: public.lockouts
+CREATE TABLE IF NOT EXISTS public.lockouts
+(
+    id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    failed_attempts integer NOT NULL,
+    first_failed_attempt_at timestamp(6) without time zone,
+    last_failed_attempt_at timestamp(6) without time zone,
+    lockout_count integer NOT NULL,
+    locked_out_at timestamp(6) without time zone,
+    locked_until timestamp(6) without time zone,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT lockouts_pkey PRIMARY KEY (id),
+    CONSTRAINT lockouts_id_fkey FOREIGN KEY (id)
+        REFERENCES public.clients (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT lockouts_failed_attempts_check CHECK (failed_attempts >= 0),
+    CONSTRAINT lockouts_lockout_count_check CHECK (lockout_count >= 0)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS lockouts_updated_at_idx ON public.lockouts (updated_at);
+CREATE INDEX IF NOT EXISTS lockouts_locked_until_idx
+    ON public.lockouts (locked_until)
+    WHERE locked_until IS NOT NULL;
+
+-- Table: public.client_secrets
+CREATE TABLE IF NOT EXISTS public.client_secrets
+(
+    id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    secret_hash bytea NOT NULL,
+    secret_created_at timestamp(6) without time zone NOT NULL,
+    previous_secret_hash bytea,
+    previous_secret_expires_at timestamp(6) without time zone,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT client_secrets_pkey PRIMARY KEY (id),
+    CONSTRAINT client_secrets_id_fkey FOREIGN KEY (id)
+        REFERENCES public.clients (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS client_secrets_updated_at_idx ON public.client_secrets (updated_at);
+
+-- Table: public.client_tokens
+CREATE TABLE IF NOT EXISTS public.client_tokens
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    client_id bigint NOT NULL,
+    token_hash bytea NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT client_tokens_pkey PRIMARY KEY (id),
+    CONSTRAINT client_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT client_tokens_client_id_fkey FOREIGN KEY (client_id)
+        REFERENCES public.clients (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS client_tokens_client_id_idx ON public.client_tokens (client_id);
+CREATE INDEX IF NOT EXISTS client_tokens_expires_at_idx ON public.client_tokens (expires_at);
+
+-- Table: public.client_role_assignments
+/*
+Client role assignment statuses:
+    Unspecified = 0
+    New         = 1
+    Active      = 2
+    Inactive    = 3
+    Deleting    = 4
+    Deleted     = 5
+*/
+CREATE TABLE IF NOT EXISTS public.client_role_assignments
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    role_assignment_id bigint NOT NULL,
+    client_id bigint NOT NULL,
+    role_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    status smallint NOT NULL,
+    status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    status_updated_by bigint NOT NULL,
+    status_comment text COLLATE pg_catalog."default",
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT client_role_assignments_pkey PRIMARY KEY (id),
+    CONSTRAINT client_role_assignments_role_assignment_id_key UNIQUE (role_assignment_id),
+    CONSTRAINT client_role_assignments_client_id_fkey FOREIGN KEY (client_id)
+        REFERENCES public.clients (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT client_role_assignments_status_check CHECK (status >= 1 AND status <= 5)
+)
+TABLESPACE pg_default;
+
+CREATE UNIQUE INDEX IF NOT EXISTS client_role_assignments_client_id_role_id_idx
+    ON public.client_role_assignments (client_id, role_id)
+    WHERE status <> 5;
+
+CREATE INDEX IF NOT EXISTS client_role_assignments_client_id_idx ON public.client_role_assignments (client_id);
+CREATE INDEX IF NOT EXISTS client_role_assignments_role_id_idx ON public.client_role_assignments (role_id);
+CREATE INDEX IF NOT EXISTS client_role_assignments_created_at_idx ON public.client_role_assignments (created_at);
+CREATE INDEX IF NOT EXISTS client_role_assignments_updated_at_idx ON public.client_role_assignments (updated_at);
+CREATE INDEX IF NOT EXISTS client_role_assignments_status_idx ON public.client_role_assignments (status);
+CREATE INDEX IF NOT EXISTS client_role_assignments_status_updated_at_idx ON public.client_role_assignments (status_updated_at);
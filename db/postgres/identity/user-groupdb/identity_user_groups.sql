@@ -49,11 +49,14 @@ CREATE TABLE IF NOT EXISTS public.group_role_assignments
     status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
     status_updated_by bigint NOT NULL,
     status_comment text COLLATE pg_catalog."default",
+    valid_from timestamp(6) without time zone,
+    valid_until timestamp(6) without time zone,
     _version_stamp bigint NOT NULL,
     _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
     CONSTRAINT group_role_assignments_pkey PRIMARY KEY (id),
     CONSTRAINT group_role_assignments_role_assignment_id_key UNIQUE (role_assignment_id),
-    CONSTRAINT group_role_assignments_status_check CHECK (status >= 1 AND status <= 5)
+    CONSTRAINT group_role_assignments_status_check CHECK (status >= 1 AND status <= 5),
+    CONSTRAINT group_role_assignments_valid_until_check CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
 )
 TABLESPACE pg_default;
 
@@ -67,3 +70,72 @@ CREATE INDEX IF NOT EXISTS group_role_assignments_created_at_idx ON public.group
 CREATE INDEX IF NOT EXISTS group_role_assignments_updated_at_idx ON public.group_role_assignments (updated_at);
 CREATE INDEX IF NOT EXISTS group_role_assignments_status_idx ON public.group_role_assignments (status);
 CREATE INDEX IF NOT EXISTS group_role_assignments_status_updated_at_idx ON public.group_role_assignments (status_updated_at);
+
+-- Table: public.user_groups
+/*
+Built-in user groups:
+    AnonymousUsers = 1
+    Superusers     = 2
+    SystemUsers    = 3
+    Admins         = 4
+    Users          = 5
+
+The IDs of user groups created by users start from 1001.
+*/
+CREATE TABLE IF NOT EXISTS public.user_groups
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1001 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    name character varying(256) COLLATE pg_catalog."default" NOT NULL,
+    is_built_in boolean NOT NULL DEFAULT FALSE,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    description text COLLATE pg_catalog."default" NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT user_groups_pkey PRIMARY KEY (id)
+)
+TABLESPACE pg_default;
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_groups_name_idx ON public.user_groups (name);
+CREATE UNIQUE INDEX IF NOT EXISTS user_groups_name_lc_idx ON public.user_groups (lower(name));
+CREATE INDEX IF NOT EXISTS user_groups_created_at_idx ON public.user_groups (created_at);
+CREATE INDEX IF NOT EXISTS user_groups_updated_at_idx ON public.user_groups (updated_at);
+
+-- the built-in user groups are created by the system user (id: 1)
+INSERT INTO public.user_groups(id, name, is_built_in, created_at, created_by, updated_at, updated_by, description, _version_stamp, _timestamp)
+    OVERRIDING SYSTEM VALUE
+    VALUES
+        (1, 'anonymousUsers', TRUE, (clock_timestamp() AT TIME ZONE 'UTC'), 1, (clock_timestamp() AT TIME ZONE 'UTC'), 1, 'Anonymous users', 1, (clock_timestamp() AT TIME ZONE 'UTC')),
+        (2, 'superusers', TRUE, (clock_timestamp() AT TIME ZONE 'UTC'), 1, (clock_timestamp() AT TIME ZONE 'UTC'), 1, 'Superusers', 1, (clock_timestamp() AT TIME ZONE 'UTC')),
+        (3, 'systemUsers', TRUE, (clock_timestamp() AT TIME ZONE 'UTC'), 1, (clock_timestamp() AT TIME ZONE 'UTC'), 1, 'System users', 1, (clock_timestamp() AT TIME ZONE 'UTC')),
+        (4, 'admins', TRUE, (clock_timestamp() AT TIME ZONE 'UTC'), 1, (clock_timestamp() AT TIME ZONE 'UTC'), 1, 'Admins', 1, (clock_timestamp() AT TIME ZONE 'UTC')),
+        (5, 'users', TRUE, (clock_timestamp() AT TIME ZONE 'UTC'), 1, (clock_timestamp() AT TIME ZONE 'UTC'), 1, 'Standard users', 1, (clock_timestamp() AT TIME ZONE 'UTC'))
+    ON CONFLICT (id) DO NOTHING;
+
+-- Table: public.user_group_members
+/*
+The members of the user groups created by users. A user is a member of its built-in group
+(users.group) and can also be a member of any number of user groups created by users.
+*/
+CREATE TABLE IF NOT EXISTS public.user_group_members
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    group_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT user_group_members_pkey PRIMARY KEY (id),
+    CONSTRAINT user_group_members_group_id_user_id_key UNIQUE (group_id, user_id),
+    CONSTRAINT user_group_members_group_id_fkey FOREIGN KEY (group_id)
+        REFERENCES public.user_groups (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS user_group_members_user_id_idx ON public.user_group_members (user_id);
+CREATE INDEX IF NOT EXISTS user_group_members_created_at_idx ON public.user_group_members (created_at);
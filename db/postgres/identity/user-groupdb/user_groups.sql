@@ -0,0 +1,254 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- FUNCTION: public.user_group_exists(character varying)
+CREATE OR REPLACE FUNCTION public.user_group_exists(
+    _name public.user_groups.name%TYPE
+) RETURNS boolean AS $$
+BEGIN
+    RETURN EXISTS (SELECT 1 FROM public.user_groups WHERE lower(name) = lower(_name) LIMIT 1);
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.create_user_group(character varying, bigint, text)
+/*
+Error codes:
+    NoError                = 0
+    UserGroupAlreadyExists = 11401
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_user_group(
+    IN _name public.user_groups.name%TYPE,
+    IN _created_by public.user_groups.created_by%TYPE,
+    IN _description public.user_groups.description%TYPE,
+    OUT _id public.user_groups.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF public.user_group_exists(_name) THEN
+        err_code := 11401; -- UserGroupAlreadyExists
+        err_msg := 'user group with the same name already exists';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.user_groups(name, is_built_in, created_at, created_by, updated_at, updated_by, description, _version_stamp, _timestamp)
+        VALUES (_name, FALSE, _time, _created_by, _time, _created_by, _description, 1, _time)
+        RETURNING id INTO _id;
+
+    EXCEPTION
+        WHEN unique_violation THEN
+            IF _id = 0 AND public.user_group_exists(_name) THEN
+                err_code := 11401; -- UserGroupAlreadyExists
+                err_msg := 'user group with the same name already exists';
+                RETURN;
+            END IF;
+            RAISE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.update_user_group(bigint, character varying, text, bigint)
+/*
+Error codes:
+    NoError                = 0
+    InvalidOperation       = 3
+    UserGroupNotFound      = 11400
+    UserGroupAlreadyExists = 11401
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.update_user_group(
+    IN _id public.user_groups.id%TYPE,
+    IN _name public.user_groups.name%TYPE,
+    IN _description public.user_groups.description%TYPE,
+    IN _updated_by public.user_groups.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _is_built_in public.user_groups.is_built_in%TYPE;
+    _time timestamp(6) without time zone;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT is_built_in INTO _is_built_in FROM public.user_groups WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11400; -- UserGroupNotFound
+        err_msg := 'user group not found';
+        RETURN;
+    END IF;
+
+    IF _is_built_in THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'built-in user group can''t be updated';
+        RETURN;
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM public.user_groups WHERE lower(name) = lower(_name) AND id <> _id LIMIT 1) THEN
+        err_code := 11401; -- UserGroupAlreadyExists
+        err_msg := 'user group with the same name already exists';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    UPDATE public.user_groups
+        SET name = _name, updated_at = _time, updated_by = _updated_by, description = _description, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    EXCEPTION
+        WHEN unique_violation THEN
+            IF EXISTS (SELECT 1 FROM public.user_groups WHERE lower(name) = lower(_name) AND id <> _id LIMIT 1) THEN
+                err_code := 11401; -- UserGroupAlreadyExists
+                err_msg := 'user group with the same name already exists';
+                RETURN;
+            END IF;
+            RAISE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_user_group(bigint)
+/*
+Group role assignment statuses:
+    Deleted = 5
+
+Error codes:
+    NoError           = 0
+    InvalidOperation  = 3
+    UserGroupNotFound = 11400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_user_group(
+    IN _id public.user_groups.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _is_built_in public.user_groups.is_built_in%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT is_built_in INTO _is_built_in FROM public.user_groups WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11400; -- UserGroupNotFound
+        err_msg := 'user group not found';
+        RETURN;
+    END IF;
+
+    IF _is_built_in THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'built-in user group can''t be deleted';
+        RETURN;
+    END IF;
+
+    -- group role assignment status: Deleted(5)
+    IF EXISTS (SELECT 1 FROM public.group_role_assignments WHERE "group" = _id AND status <> 5 LIMIT 1) THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'user group has assigned roles';
+        RETURN;
+    END IF;
+
+    DELETE FROM public.user_group_members WHERE group_id = _id;
+    DELETE FROM public.user_groups WHERE id = _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.add_user_group_member(bigint, bigint, bigint)
+/*
+Error codes:
+    NoError                      = 0
+    InvalidOperation             = 3
+    UserGroupNotFound            = 11400
+    UserGroupMemberAlreadyExists = 11403
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.add_user_group_member(
+    IN _group_id public.user_group_members.group_id%TYPE,
+    IN _user_id public.user_group_members.user_id%TYPE,
+    IN _created_by public.user_group_members.created_by%TYPE,
+    OUT _id public.user_group_members.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _is_built_in public.user_groups.is_built_in%TYPE;
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT is_built_in INTO _is_built_in FROM public.user_groups WHERE id = _group_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11400; -- UserGroupNotFound
+        err_msg := 'user group not found';
+        RETURN;
+    END IF;
+
+    -- the members of the built-in groups are determined by users.group
+    IF _is_built_in THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'members of the built-in user group can''t be managed';
+        RETURN;
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM public.user_group_members WHERE group_id = _group_id AND user_id = _user_id LIMIT 1) THEN
+        err_code := 11403; -- UserGroupMemberAlreadyExists
+        err_msg := 'user is already a member of the group';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.user_group_members(group_id, user_id, created_at, created_by, _version_stamp, _timestamp)
+        VALUES (_group_id, _user_id, _time, _created_by, 1, _time)
+        RETURNING id INTO _id;
+
+    EXCEPTION
+        WHEN unique_violation THEN
+            IF _id = 0 AND EXISTS (SELECT 1 FROM public.user_group_members WHERE group_id = _group_id AND user_id = _user_id LIMIT 1) THEN
+                err_code := 11403; -- UserGroupMemberAlreadyExists
+                err_msg := 'user is already a member of the group';
+                RETURN;
+            END IF;
+            RAISE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.remove_user_group_member(bigint, bigint)
+/*
+Error codes:
+    NoError                 = 0
+    UserGroupMemberNotFound = 11402
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.remove_user_group_member(
+    IN _group_id public.user_group_members.group_id%TYPE,
+    IN _user_id public.user_group_members.user_id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.user_group_members WHERE group_id = _group_id AND user_id = _user_id;
+    IF NOT FOUND THEN
+        err_code := 11402; -- UserGroupMemberNotFound
+        err_msg := 'user isn''t a member of the group';
+        RETURN;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,147 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_api_key(character varying, smallint, bigint, bigint, character varying, bytea, bigint[], timestamp without time zone, bigint)
+/*
+API key types:
+    Personal = 1
+    Service  = 2
+
+API key statuses:
+    Active = 1
+
+User statuses:
+    Active = 3
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_api_key(
+    IN _name public.api_keys.name%TYPE,
+    IN _type public.api_keys.type%TYPE,
+    IN _user_id public.api_keys.user_id%TYPE,
+    IN _client_id public.api_keys.client_id%TYPE,
+    IN _prefix public.api_keys.prefix%TYPE,
+    IN _key_hash public.api_keys.key_hash%TYPE,
+    IN _permission_ids public.api_keys.permission_ids%TYPE,
+    IN _expires_at public.api_keys.expires_at%TYPE,
+    IN _created_by public.api_keys.created_by%TYPE,
+    OUT _id public.api_keys.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    IF _expires_at IS NOT NULL AND _expires_at <= _time THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'expiration time has already passed';
+        RETURN;
+    END IF;
+
+    -- API key's type: Personal(1)
+    IF _type = 1 THEN
+        SELECT status INTO _status FROM public.users WHERE id = _user_id LIMIT 1 FOR SHARE;
+        IF NOT FOUND THEN
+            err_code := 11000; -- UserNotFound
+            err_msg := 'user not found';
+            RETURN;
+        END IF;
+
+        -- user's status: Active(3)
+        IF _status <> 3 THEN
+            err_code := 3; -- InvalidOperation
+            err_msg := format('invalid user''s status (%s)', _status);
+            RETURN;
+        END IF;
+    END IF;
+
+    -- API key's status: Active(1)
+    INSERT INTO public.api_keys(name, type, user_id, client_id, prefix, key_hash, permission_ids, created_at, created_by, expires_at,
+            status, status_updated_at, status_updated_by, _version_stamp, _timestamp)
+        VALUES (_name, _type, _user_id, _client_id, _prefix, _key_hash, _permission_ids, _time, _created_by, _expires_at,
+            1, _time, _created_by, 1, _time)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.revoke_api_key(bigint, bigint, bigint)
+/*
+API key statuses:
+    Active  = 1
+    Revoked = 2
+
+Error codes:
+    NoError        = 0
+    ApiKeyNotFound = 16800
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.revoke_api_key(
+    IN _id public.api_keys.id%TYPE,
+    IN _user_id public.api_keys.user_id%TYPE,
+    IN _revoked_by public.api_keys.status_updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- API key's statuses: Active(1), Revoked(2)
+    -- if the user ID is specified, only the user's own API key can be revoked
+    UPDATE public.api_keys
+        SET status = 2, status_updated_at = _time, status_updated_by = _revoked_by, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id AND status = 1 AND (_user_id IS NULL OR user_id = _user_id);
+
+    IF NOT FOUND THEN
+        err_code := 16800; -- ApiKeyNotFound
+        err_msg := 'API key not found';
+        RETURN;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.update_api_key_last_used_at(bigint, interval)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.update_api_key_last_used_at(
+    IN _id public.api_keys.id%TYPE,
+    IN _min_interval interval,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- the time of the last use is updated no more often than once per the specified interval
+    UPDATE public.api_keys SET last_used_at = _time
+        WHERE id = _id AND (last_used_at IS NULL OR last_used_at <= _time - _min_interval);
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,310 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_data_subject_request(bigint, smallint, smallint[], bigint)
+/*
+Data subject request statuses:
+    New        = 1
+    InProgress = 2
+
+Data subject request step statuses:
+    Pending = 1
+
+Error codes:
+    NoError                         = 0
+    InvalidOperation                = 3
+    UserNotFound                    = 11000
+    DataSubjectRequestAlreadyExists = 16600
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_data_subject_request(
+    IN _user_id public.data_subject_requests.user_id%TYPE,
+    IN _type public.data_subject_requests.type%TYPE,
+    IN _steps smallint[],
+    IN _created_by public.data_subject_requests.created_by%TYPE,
+    OUT _id public.data_subject_requests.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF array_length(_steps, 1) IS NULL THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'steps are empty';
+        RETURN;
+    END IF;
+
+    PERFORM 1 FROM public.users WHERE id = _user_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- request's statuses: New(1), InProgress(2)
+    IF EXISTS (SELECT 1 FROM public.data_subject_requests WHERE user_id = _user_id AND type = _type AND status IN (1, 2) LIMIT 1) THEN
+        err_code := 16600; -- DataSubjectRequestAlreadyExists
+        err_msg := 'unfinished data subject request of the same type already exists';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- request's status: New(1)
+    INSERT INTO public.data_subject_requests(user_id, type, created_at, created_by, updated_at, updated_by, status, status_updated_at,
+            status_updated_by, _version_stamp, _timestamp)
+        VALUES (_user_id, _type, _time, _created_by, _time, _created_by, 1, _time, _created_by, 1, _time)
+        RETURNING id INTO _id;
+
+    -- step's status: Pending(1)
+    INSERT INTO public.data_subject_request_steps(request_id, step, seq_num, status, _version_stamp, _timestamp)
+        SELECT _id, s.step, s.seq_num, 1, 1, _time
+        FROM unnest(_steps) WITH ORDINALITY AS s(step, seq_num);
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.start_data_subject_request_step(bigint, smallint, uuid, bigint)
+/*
+Data subject request statuses:
+    New        = 1
+    InProgress = 2
+
+Data subject request step statuses:
+    Pending    = 1
+    InProgress = 2
+
+Error codes:
+    NoError                    = 0
+    InvalidOperation           = 3
+    DataSubjectRequestNotFound = 16601
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.start_data_subject_request_step(
+    IN _request_id public.data_subject_request_steps.request_id%TYPE,
+    IN _step public.data_subject_request_steps.step%TYPE,
+    IN _action_id public.data_subject_request_steps.action_id%TYPE,
+    IN _updated_by public.data_subject_requests.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.data_subject_requests.status%TYPE;
+    _step_status public.data_subject_request_steps.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.data_subject_requests WHERE id = _request_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 16601; -- DataSubjectRequestNotFound
+        err_msg := 'data subject request not found';
+        RETURN;
+    END IF;
+
+    -- request's statuses: New(1), InProgress(2)
+    IF _status <> 1 AND _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid request''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    SELECT status INTO _step_status FROM public.data_subject_request_steps WHERE request_id = _request_id AND step = _step LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('step (%s) not found', _step);
+        RETURN;
+    END IF;
+
+    -- step's statuses: Pending(1), InProgress(2)
+    -- a step in progress can be restarted (e.g. after the app was stopped during its processing)
+    IF _step_status <> 1 AND _step_status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid step''s status (%s)', _step_status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- request's status: New(1)
+    IF _status = 1 THEN
+        -- request's status: InProgress(2)
+        UPDATE public.data_subject_requests
+            SET updated_at = _time, updated_by = _updated_by, status = 2, status_updated_at = _time, status_updated_by = _updated_by,
+                _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _request_id;
+    END IF;
+
+    -- step's status: InProgress(2)
+    UPDATE public.data_subject_request_steps
+        SET status = 2, action_id = _action_id, started_at = _time, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE request_id = _request_id AND step = _step;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.complete_data_subject_request_step(bigint, smallint, bytea, bigint)
+/*
+Data subject request statuses:
+    InProgress = 2
+    Completed  = 3
+
+Data subject request step statuses:
+    InProgress = 2
+    Completed  = 3
+
+Error codes:
+    NoError                    = 0
+    InvalidOperation           = 3
+    DataSubjectRequestNotFound = 16601
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.complete_data_subject_request_step(
+    IN _request_id public.data_subject_request_steps.request_id%TYPE,
+    IN _step public.data_subject_request_steps.step%TYPE,
+    IN _data public.data_subject_request_export_sections.data%TYPE,
+    IN _updated_by public.data_subject_requests.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.data_subject_requests.status%TYPE;
+    _step_status public.data_subject_request_steps.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.data_subject_requests WHERE id = _request_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 16601; -- DataSubjectRequestNotFound
+        err_msg := 'data subject request not found';
+        RETURN;
+    END IF;
+
+    -- request's status: InProgress(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid request''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    SELECT status INTO _step_status FROM public.data_subject_request_steps WHERE request_id = _request_id AND step = _step LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('step (%s) not found', _step);
+        RETURN;
+    END IF;
+
+    -- step's status: InProgress(2)
+    IF _step_status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid step''s status (%s)', _step_status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    IF _data IS NOT NULL THEN
+        INSERT INTO public.data_subject_request_export_sections(request_id, step, data, created_at)
+            VALUES (_request_id, _step, _data, _time)
+            ON CONFLICT (request_id, step) DO UPDATE SET data = EXCLUDED.data, created_at = EXCLUDED.created_at;
+    END IF;
+
+    -- step's status: Completed(3)
+    UPDATE public.data_subject_request_steps
+        SET status = 3, completed_at = _time, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE request_id = _request_id AND step = _step;
+
+    -- step's status: Completed(3)
+    IF NOT EXISTS (SELECT 1 FROM public.data_subject_request_steps WHERE request_id = _request_id AND status <> 3 LIMIT 1) THEN
+        -- request's status: Completed(3)
+        UPDATE public.data_subject_requests
+            SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+                completed_at = _time, _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _request_id;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.fail_data_subject_request_step(bigint, smallint, text, bigint)
+/*
+Data subject request statuses:
+    InProgress = 2
+    Failed     = 4
+
+Data subject request step statuses:
+    InProgress = 2
+    Failed     = 4
+
+Error codes:
+    NoError                    = 0
+    InvalidOperation           = 3
+    DataSubjectRequestNotFound = 16601
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.fail_data_subject_request_step(
+    IN _request_id public.data_subject_request_steps.request_id%TYPE,
+    IN _step public.data_subject_request_steps.step%TYPE,
+    IN _error_message public.data_subject_request_steps.error_message%TYPE,
+    IN _updated_by public.data_subject_requests.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.data_subject_requests.status%TYPE;
+    _step_status public.data_subject_request_steps.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.data_subject_requests WHERE id = _request_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 16601; -- DataSubjectRequestNotFound
+        err_msg := 'data subject request not found';
+        RETURN;
+    END IF;
+
+    -- request's status: InProgress(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid request''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    SELECT status INTO _step_status FROM public.data_subject_request_steps WHERE request_id = _request_id AND step = _step LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('step (%s) not found', _step);
+        RETURN;
+    END IF;
+
+    -- step's status: InProgress(2)
+    IF _step_status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid step''s status (%s)', _step_status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- step's status: Failed(4)
+    UPDATE public.data_subject_request_steps
+        SET status = 4, completed_at = _time, error_message = _error_message, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE request_id = _request_id AND step = _step;
+
+    -- request's status: Failed(4)
+    UPDATE public.data_subject_requests
+        SET updated_at = _time, updated_by = _updated_by, status = 4, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = format('step (%s) failed', _step), _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _request_id;
+END;
+$$ LANGUAGE plpgsql;
@@ -173,6 +173,8 @@ CREATE TABLE IF NOT EXISTS public.user_role_assignments
     status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
     status_updated_by bigint NOT NULL,
     status_comment text COLLATE pg_catalog."default",
+    valid_from timestamp(6) without time zone,
+    valid_until timestamp(6) without time zone,
     _version_stamp bigint NOT NULL,
     _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
     CONSTRAINT user_role_assignments_pkey PRIMARY KEY (id),
@@ -181,7 +183,8 @@ CREATE TABLE IF NOT EXISTS public.user_role_assignments
         REFERENCES public.users (id) MATCH SIMPLE
         ON UPDATE CASCADE
         ON DELETE RESTRICT,
-    CONSTRAINT user_role_assignments_status_check CHECK (status >= 1 AND status <= 5)
+    CONSTRAINT user_role_assignments_status_check CHECK (status >= 1 AND status <= 5),
+    CONSTRAINT user_role_assignments_valid_until_check CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from)
 )
 TABLESPACE pg_default;
 
@@ -195,3 +198,391 @@ CREATE INDEX IF NOT EXISTS user_role_assignments_created_at_idx ON public.user_r
 CREATE INDEX IF NOT EXISTS user_role_assignments_updated_at_idx ON public.user_role_assignments (updated_at);
 CREATE INDEX IF NOT EXISTS user_role_assignments_status_idx ON public.user_role_assignments (status);
 CREATE INDEX IF NOT EXISTS user_role_assignments_status_updated_at_idx ON public.user_role_assignments (status_updated_at);
+
+-- Table: public.user_credentials
+CREATE TABLE IF NOT EXISTS public.user_credentials
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    password_hash text COLLATE pg_catalog."default" NOT NULL,
+    password_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    password_updated_by bigint NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT user_credentials_pkey PRIMARY KEY (id),
+    CONSTRAINT user_credentials_user_id_key UNIQUE (user_id),
+    CONSTRAINT user_credentials_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS user_credentials_created_at_idx ON public.user_credentials (created_at);
+CREATE INDEX IF NOT EXISTS user_credentials_updated_at_idx ON public.user_credentials (updated_at);
+CREATE INDEX IF NOT EXISTS user_credentials_password_updated_at_idx ON public.user_credentials (password_updated_at);
+
+-- Table: public.lockouts
+CREATE TABLE IF NOT EXISTS public.lockouts
+(
+    id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    failed_attempts integer NOT NULL,
+    first_failed_attempt_at timestamp(6) without time zone,
+    last_failed_attempt_at timestamp(6) without time zone,
+    lockout_count integer NOT NULL,
+    locked_out_at timestamp(6) without time zone,
+    locked_until timestamp(6) without time zone,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT lockouts_pkey PRIMARY KEY (id),
+    CONSTRAINT lockouts_id_fkey FOREIGN KEY (id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT lockouts_failed_attempts_check CHECK (failed_attempts >= 0),
+    CONSTRAINT lockouts_lockout_count_check CHECK (lockout_count >= 0)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS lockouts_updated_at_idx ON public.lockouts (updated_at);
+CREATE INDEX IF NOT EXISTS lockouts_locked_until_idx
+    ON public.lockouts (locked_until)
+    WHERE locked_until IS NOT NULL;
+
+-- Table: public.user_totp
+/*
+TOTP statuses:
+    Pending = 1 (the enrollment has been started, but not confirmed)
+    Enabled = 2
+*/
+CREATE TABLE IF NOT EXISTS public.user_totp
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    status smallint NOT NULL,
+    secret bytea NOT NULL,
+    enabled_at timestamp(6) without time zone,
+    last_used_step bigint,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT user_totp_pkey PRIMARY KEY (id),
+    CONSTRAINT user_totp_user_id_key UNIQUE (user_id),
+    CONSTRAINT user_totp_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS user_totp_updated_at_idx ON public.user_totp (updated_at);
+CREATE INDEX IF NOT EXISTS user_totp_status_idx ON public.user_totp (status);
+
+-- Table: public.user_recovery_codes
+CREATE TABLE IF NOT EXISTS public.user_recovery_codes
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    code_hash bytea NOT NULL,
+    used_at timestamp(6) without time zone,
+    CONSTRAINT user_recovery_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT user_recovery_codes_user_id_code_hash_key UNIQUE (user_id, code_hash),
+    CONSTRAINT user_recovery_codes_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+-- Table: public.mfa_challenges
+CREATE TABLE IF NOT EXISTS public.mfa_challenges
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    token_hash bytea NOT NULL,
+    client_id bigint NOT NULL,
+    app_id bigint,
+    user_agent text COLLATE pg_catalog."default" NOT NULL,
+    ip character varying(64) COLLATE pg_catalog."default" NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    failed_attempts integer NOT NULL,
+    CONSTRAINT mfa_challenges_pkey PRIMARY KEY (id),
+    CONSTRAINT mfa_challenges_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT mfa_challenges_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT mfa_challenges_failed_attempts_check CHECK (failed_attempts >= 0)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS mfa_challenges_user_id_idx ON public.mfa_challenges (user_id);
+CREATE INDEX IF NOT EXISTS mfa_challenges_expires_at_idx ON public.mfa_challenges (expires_at);
+
+-- Table: public.oidc_authorization_codes
+CREATE TABLE IF NOT EXISTS public.oidc_authorization_codes
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    code_hash bytea NOT NULL,
+    client_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    redirect_uri text COLLATE pg_catalog."default" NOT NULL,
+    scopes text[] NOT NULL,
+    nonce text COLLATE pg_catalog."default",
+    code_challenge character varying(128) COLLATE pg_catalog."default" NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT oidc_authorization_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT oidc_authorization_codes_code_hash_key UNIQUE (code_hash),
+    CONSTRAINT oidc_authorization_codes_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS oidc_authorization_codes_user_id_idx ON public.oidc_authorization_codes (user_id);
+CREATE INDEX IF NOT EXISTS oidc_authorization_codes_expires_at_idx ON public.oidc_authorization_codes (expires_at);
+
+-- Table: public.oidc_refresh_tokens
+CREATE TABLE IF NOT EXISTS public.oidc_refresh_tokens
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    token_hash bytea NOT NULL,
+    client_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    scopes text[] NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT oidc_refresh_tokens_pkey PRIMARY KEY (id),
+    CONSTRAINT oidc_refresh_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT oidc_refresh_tokens_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS oidc_refresh_tokens_user_id_client_id_idx ON public.oidc_refresh_tokens (user_id, client_id);
+CREATE INDEX IF NOT EXISTS oidc_refresh_tokens_expires_at_idx ON public.oidc_refresh_tokens (expires_at);
+
+-- Table: public.email_verification_tokens
+CREATE TABLE IF NOT EXISTS public.email_verification_tokens
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    token_hash bytea NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT email_verification_tokens_pkey PRIMARY KEY (id),
+    CONSTRAINT email_verification_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT email_verification_tokens_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS email_verification_tokens_user_id_idx ON public.email_verification_tokens (user_id);
+CREATE INDEX IF NOT EXISTS email_verification_tokens_expires_at_idx ON public.email_verification_tokens (expires_at);
+
+-- Table: public.impersonation_tokens
+CREATE TABLE IF NOT EXISTS public.impersonation_tokens
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    impersonator_id bigint NOT NULL,
+    token_hash bytea NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    expires_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT impersonation_tokens_pkey PRIMARY KEY (id),
+    CONSTRAINT impersonation_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT impersonation_tokens_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT impersonation_tokens_impersonator_id_fkey FOREIGN KEY (impersonator_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT impersonation_tokens_check CHECK (user_id <> impersonator_id)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS impersonation_tokens_user_id_idx ON public.impersonation_tokens (user_id);
+CREATE INDEX IF NOT EXISTS impersonation_tokens_impersonator_id_idx ON public.impersonation_tokens (impersonator_id);
+CREATE INDEX IF NOT EXISTS impersonation_tokens_expires_at_idx ON public.impersonation_tokens (expires_at);
+
+-- Table: public.data_subject_requests
+/*
+Data subject request types:
+    Unspecified = 0
+    Export      = 1
+    Erasure     = 2
+
+Data subject request statuses:
+    Unspecified = 0
+    New         = 1
+    InProgress  = 2
+    Completed   = 3
+    Failed      = 4
+*/
+CREATE TABLE IF NOT EXISTS public.data_subject_requests
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    user_id bigint NOT NULL,
+    type smallint NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    updated_by bigint NOT NULL,
+    status smallint NOT NULL,
+    status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    status_updated_by bigint NOT NULL,
+    status_comment text COLLATE pg_catalog."default",
+    completed_at timestamp(6) without time zone,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT data_subject_requests_pkey PRIMARY KEY (id),
+    CONSTRAINT data_subject_requests_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    CONSTRAINT data_subject_requests_type_check CHECK (type >= 1 AND type <= 2),
+    CONSTRAINT data_subject_requests_status_check CHECK (status >= 1 AND status <= 4)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS data_subject_requests_user_id_idx ON public.data_subject_requests (user_id);
+CREATE INDEX IF NOT EXISTS data_subject_requests_created_at_idx ON public.data_subject_requests (created_at);
+CREATE INDEX IF NOT EXISTS data_subject_requests_status_idx ON public.data_subject_requests (status);
+
+-- Table: public.data_subject_request_steps
+/*
+Data subject request steps:
+    Unspecified             = 0
+    ExportUser              = 1
+    ExportPersonalInfo      = 2
+    ExportUserSessions      = 3
+    ExportUserAgentSessions = 4
+    ExportUserAgents        = 5
+    ExportRoleAssignments   = 6
+    ExportUserGroups        = 7
+    ExportApiKeys           = 8
+    RevokeSessions          = 101
+    AnonymizeUser           = 102
+    DeleteUser              = 103
+    RevokeApiKeys           = 104
+
+Data subject request step statuses:
+    Unspecified = 0
+    Pending     = 1
+    InProgress  = 2
+    Completed   = 3
+    Failed      = 4
+*/
+CREATE TABLE IF NOT EXISTS public.data_subject_request_steps
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    request_id bigint NOT NULL,
+    step smallint NOT NULL,
+    seq_num smallint NOT NULL,
+    status smallint NOT NULL,
+    action_id uuid,
+    started_at timestamp(6) without time zone,
+    completed_at timestamp(6) without time zone,
+    error_message text COLLATE pg_catalog."default",
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT data_subject_request_steps_pkey PRIMARY KEY (id),
+    CONSTRAINT data_subject_request_steps_request_id_step_key UNIQUE (request_id, step),
+    CONSTRAINT data_subject_request_steps_request_id_fkey FOREIGN KEY (request_id)
+        REFERENCES public.data_subject_requests (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    CONSTRAINT data_subject_request_steps_status_check CHECK (status >= 1 AND status <= 4)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS data_subject_request_steps_action_id_idx ON public.data_subject_request_steps (action_id);
+
+-- Table: public.data_subject_request_export_sections
+/*
+The sections of the data export (one section per export step). The sections are deleted when the user's data is erased.
+*/
+CREATE TABLE IF NOT EXISTS public.data_subject_request_export_sections
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    request_id bigint NOT NULL,
+    step smallint NOT NULL,
+    data bytea NOT NULL,
+    created_at timestamp(6) without time zone NOT NULL,
+    CONSTRAINT data_subject_request_export_sections_pkey PRIMARY KEY (id),
+    CONSTRAINT data_subject_request_export_sections_request_id_step_key UNIQUE (request_id, step),
+    CONSTRAINT data_subject_request_export_sections_request_id_fkey FOREIGN KEY (request_id)
+        REFERENCES public.data_subject_requests (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+)
+TABLESPACE pg_default;
+
+-- Table: public.api_keys
+/*
+API key types:
+    Unspecified = 0
+    Personal    = 1
+    Service     = 2
+
+API key statuses:
+    Unspecified = 0
+    Active      = 1
+    Revoked     = 2
+
+A personal API key belongs to a user (user_id), a service API key belongs to a service client (client_id).
+Only the SHA-256 hash of the key is stored, the key is looked up by its prefix.
+If permission_ids is NULL, the key isn't restricted and has all the permissions of its owner.
+*/
+CREATE TABLE IF NOT EXISTS public.api_keys
+(
+    id bigint NOT NULL GENERATED ALWAYS AS IDENTITY ( INCREMENT 1 START 1 MINVALUE 1 MAXVALUE 9223372036854775807 CACHE 1 ),
+    name character varying(256) COLLATE pg_catalog."default" NOT NULL,
+    type smallint NOT NULL,
+    user_id bigint,
+    client_id bigint,
+    prefix character varying(32) COLLATE pg_catalog."default" NOT NULL,
+    key_hash bytea NOT NULL,
+    permission_ids bigint[],
+    created_at timestamp(6) without time zone NOT NULL,
+    created_by bigint NOT NULL,
+    expires_at timestamp(6) without time zone,
+    last_used_at timestamp(6) without time zone,
+    status smallint NOT NULL,
+    status_updated_at timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    status_updated_by bigint NOT NULL,
+    _version_stamp bigint NOT NULL,
+    _timestamp timestamp(6) without time zone NOT NULL DEFAULT (clock_timestamp() AT TIME ZONE 'UTC'::text),
+    CONSTRAINT api_keys_pkey PRIMARY KEY (id),
+    CONSTRAINT api_keys_prefix_key UNIQUE (prefix),
+    CONSTRAINT api_keys_user_id_fkey FOREIGN KEY (user_id)
+        REFERENCES public.users (id) MATCH SIMPLE
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    CONSTRAINT api_keys_type_check CHECK (type = 1 AND user_id IS NOT NULL AND client_id IS NULL OR
+        type = 2 AND user_id IS NULL AND client_id IS NOT NULL),
+    CONSTRAINT api_keys_status_check CHECK (status >= 1 AND status <= 2)
+)
+TABLESPACE pg_default;
+
+CREATE INDEX IF NOT EXISTS api_keys_user_id_idx ON public.api_keys (user_id);
+CREATE INDEX IF NOT EXISTS api_keys_client_id_idx ON public.api_keys (client_id);
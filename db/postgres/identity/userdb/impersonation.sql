@@ -0,0 +1,115 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_impersonation_token(bigint, bigint, bytea, interval)
+/*
+User types:
+    User = 1
+
+User groups:
+    Superusers = 2
+
+User statuses:
+    Active = 3
+
+Error codes:
+    NoError                 = 0
+    UserNotFound            = 11000
+    ImpersonationNotAllowed = 16400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_impersonation_token(
+    IN _user_id public.impersonation_tokens.user_id%TYPE,
+    IN _impersonator_id public.impersonation_tokens.impersonator_id%TYPE,
+    IN _token_hash public.impersonation_tokens.token_hash%TYPE,
+    IN _ttl interval,
+    OUT _id public.impersonation_tokens.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _type public.users.type%TYPE;
+    _group public.users.group%TYPE;
+    _status public.users.status%TYPE;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF _user_id = _impersonator_id THEN
+        err_code := 16400; -- ImpersonationNotAllowed
+        err_msg := 'user can''t impersonate themselves';
+        RETURN;
+    END IF;
+
+    SELECT "group", status INTO _group, _status FROM public.users WHERE id = _impersonator_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'impersonator not found';
+        RETURN;
+    END IF;
+
+    -- impersonator's group: Superusers(2), status: Active(3)
+    IF _group <> 2 OR _status <> 3 THEN
+        err_code := 16400; -- ImpersonationNotAllowed
+        err_msg := format('invalid impersonator''s group (%s) or status (%s)', _group, _status);
+        RETURN;
+    END IF;
+
+    SELECT type, "group", status INTO _type, _group, _status FROM public.users WHERE id = _user_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's type: User(1), group: not Superusers(2), status: Active(3)
+    IF _type <> 1 OR _group = 2 OR _status <> 3 THEN
+        err_code := 16400; -- ImpersonationNotAllowed
+        err_msg := format('invalid user''s type (%s), group (%s) or status (%s)', _type, _group, _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    DELETE FROM public.impersonation_tokens WHERE impersonator_id = _impersonator_id AND expires_at <= _time;
+
+    INSERT INTO public.impersonation_tokens(user_id, impersonator_id, token_hash, created_at, expires_at)
+        VALUES (_user_id, _impersonator_id, _token_hash, _time, _time + _ttl)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_impersonation_token(bigint)
+/*
+Error codes:
+    NoError                    = 0
+    ImpersonationTokenNotFound = 16401
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_impersonation_token(
+    IN _id public.impersonation_tokens.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.impersonation_tokens WHERE id = _id;
+    IF NOT FOUND THEN
+        err_code := 16401; -- ImpersonationTokenNotFound
+        err_msg := 'impersonation token not found';
+        RETURN;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,261 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http:--www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.register_failed_attempt(bigint, integer, interval, interval, integer, bigint)
+/*
+User statuses:
+    Active               = 3
+    LockedOut            = 4
+    TemporarilyLockedOut = 5
+
+Error codes:
+    NoError      = 0
+    UserNotFound = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.register_failed_attempt(
+    IN _id public.users.id%TYPE,
+    IN _max_failed_attempts integer,
+    IN _failure_window interval,
+    IN _lockout_duration interval,
+    IN _max_temporary_lockouts integer,
+    IN _updated_by public.users.updated_by%TYPE,
+    OUT _status public.users.status%TYPE,
+    OUT _locked_until public.lockouts.locked_until%TYPE,
+    OUT _is_locked_out boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _failed_attempts public.lockouts.failed_attempts%TYPE;
+    _first_failed_attempt_at public.lockouts.first_failed_attempt_at%TYPE;
+    _lockout_count public.lockouts.lockout_count%TYPE;
+BEGIN
+    _status := 0;
+    _locked_until := NULL;
+    _is_locked_out := FALSE;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    SELECT failed_attempts, first_failed_attempt_at, lockout_count, locked_until
+        INTO _failed_attempts, _first_failed_attempt_at, _lockout_count, _locked_until
+        FROM public.lockouts WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        INSERT INTO public.lockouts(id, created_at, updated_at, failed_attempts, lockout_count, _version_stamp, _timestamp)
+            VALUES (_id, _time, _time, 0, 0, 1, _time);
+        _failed_attempts := 0;
+        _lockout_count := 0;
+    END IF;
+
+    -- user's status: TemporarilyLockedOut(5)
+    IF _status = 5 THEN
+        IF _locked_until IS NOT NULL AND _locked_until > _time THEN
+            -- the user is still locked out
+            RETURN;
+        END IF;
+
+        -- the lockout has expired, user's status: Active(3)
+        _status := 3;
+        _failed_attempts := 0;
+        _first_failed_attempt_at := NULL;
+        _locked_until := NULL;
+        UPDATE public.users
+            SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+                status_comment = NULL, _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _id;
+    -- user's status: Active(3)
+    ELSIF _status <> 3 THEN
+        -- failed attempts are only counted for active users
+        _locked_until := NULL;
+        RETURN;
+    END IF;
+
+    IF _first_failed_attempt_at IS NULL OR _first_failed_attempt_at + _failure_window <= _time THEN
+        _failed_attempts := 1;
+        _first_failed_attempt_at := _time;
+    ELSE
+        _failed_attempts := _failed_attempts + 1;
+    END IF;
+
+    IF _failed_attempts < _max_failed_attempts THEN
+        UPDATE public.lockouts
+            SET updated_at = _time, failed_attempts = _failed_attempts, first_failed_attempt_at = _first_failed_attempt_at,
+                last_failed_attempt_at = _time, locked_until = NULL, _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _id;
+        RETURN;
+    END IF;
+
+    _lockout_count := _lockout_count + 1;
+    IF _max_temporary_lockouts > 0 AND _lockout_count >= _max_temporary_lockouts THEN
+        -- user's status: LockedOut(4)
+        _status := 4;
+        _locked_until := NULL;
+    ELSE
+        -- user's status: TemporarilyLockedOut(5)
+        _status := 5;
+        _locked_until := _time + _lockout_duration;
+    END IF;
+
+    UPDATE public.users
+        SET updated_at = _time, updated_by = _updated_by, status = _status, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = 'too many failed attempts', _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    UPDATE public.lockouts
+        SET updated_at = _time, failed_attempts = 0, first_failed_attempt_at = NULL, last_failed_attempt_at = _time,
+            lockout_count = _lockout_count, locked_out_at = _time, locked_until = _locked_until, _version_stamp = _version_stamp + 1,
+            _timestamp = _time
+        WHERE id = _id;
+
+    _is_locked_out := TRUE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.reset_failed_attempts(bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.reset_failed_attempts(
+    IN _id public.lockouts.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    UPDATE public.lockouts
+        SET updated_at = _time, failed_attempts = 0, first_failed_attempt_at = NULL, lockout_count = 0,
+            _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id AND (failed_attempts > 0 OR lockout_count > 0);
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.unlock(bigint, boolean, bigint)
+/*
+User statuses:
+    Active               = 3
+    LockedOut            = 4
+    TemporarilyLockedOut = 5
+
+Error codes:
+    NoError      = 0
+    UserNotFound = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.unlock(
+    IN _id public.users.id%TYPE,
+    IN _only_if_expired boolean,
+    IN _updated_by public.users.updated_by%TYPE,
+    OUT _unlocked boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+    _locked_until public.lockouts.locked_until%TYPE;
+BEGIN
+    _unlocked := FALSE;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's statuses: LockedOut(4), TemporarilyLockedOut(5)
+    IF _status <> 4 AND _status <> 5 THEN
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    SELECT locked_until INTO _locked_until FROM public.lockouts WHERE id = _id LIMIT 1 FOR UPDATE;
+
+    -- user's status: TemporarilyLockedOut(5)
+    IF _only_if_expired AND (_status <> 5 OR _locked_until IS NOT NULL AND _locked_until > _time) THEN
+        RETURN;
+    END IF;
+
+    -- user's status: Active(3)
+    UPDATE public.users
+        SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = NULL, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    -- the number of lockouts is only reset if the user is unlocked by the administrator
+    UPDATE public.lockouts
+        SET updated_at = _time, failed_attempts = 0, first_failed_attempt_at = NULL, locked_until = NULL,
+            lockout_count = CASE WHEN _only_if_expired THEN lockout_count ELSE 0 END,
+            _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    _unlocked := TRUE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- FUNCTION: public.unlock_expired_lockouts(bigint, integer)
+/*
+User statuses:
+    Active               = 3
+    TemporarilyLockedOut = 5
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE FUNCTION public.unlock_expired_lockouts(
+    _updated_by public.users.updated_by%TYPE,
+    _limit integer
+) RETURNS SETOF bigint AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _id public.users.id%TYPE;
+BEGIN
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- user's status: TemporarilyLockedOut(5)
+    FOR _id IN
+        SELECT e.id FROM public.users e
+            INNER JOIN public.lockouts l ON l.id = e.id
+            WHERE e.status = 5 AND l.locked_until <= _time
+            ORDER BY l.locked_until
+            LIMIT _limit
+            FOR UPDATE SKIP LOCKED
+    LOOP
+        -- user's status: Active(3)
+        UPDATE public.users
+            SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+                status_comment = NULL, _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _id;
+
+        UPDATE public.lockouts
+            SET updated_at = _time, failed_attempts = 0, first_failed_attempt_at = NULL, locked_until = NULL,
+                _version_stamp = _version_stamp + 1, _timestamp = _time
+            WHERE id = _id;
+
+        RETURN NEXT _id;
+    END LOOP;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,152 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_oidc_authorization_code(bytea, bigint, bigint, text, text[], text, character varying, interval)
+/*
+Error codes:
+    NoError      = 0
+    UserNotFound = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_oidc_authorization_code(
+    IN _code_hash public.oidc_authorization_codes.code_hash%TYPE,
+    IN _client_id public.oidc_authorization_codes.client_id%TYPE,
+    IN _user_id public.oidc_authorization_codes.user_id%TYPE,
+    IN _redirect_uri public.oidc_authorization_codes.redirect_uri%TYPE,
+    IN _scopes public.oidc_authorization_codes.scopes%TYPE,
+    IN _nonce public.oidc_authorization_codes.nonce%TYPE,
+    IN _code_challenge public.oidc_authorization_codes.code_challenge%TYPE,
+    IN _ttl interval,
+    OUT _id public.oidc_authorization_codes.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF NOT EXISTS (SELECT 1 FROM public.users WHERE id = _user_id LIMIT 1) THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- expired codes are no longer needed
+    DELETE FROM public.oidc_authorization_codes WHERE expires_at < _time;
+
+    INSERT INTO public.oidc_authorization_codes(code_hash, client_id, user_id, redirect_uri, scopes, nonce, code_challenge, created_at, expires_at)
+        VALUES (_code_hash, _client_id, _user_id, _redirect_uri, _scopes, _nonce, _code_challenge, _time, _time + _ttl)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_oidc_authorization_code(bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_oidc_authorization_code(
+    IN _id public.oidc_authorization_codes.id%TYPE,
+    OUT _is_deleted boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.oidc_authorization_codes WHERE id = _id;
+    _is_deleted := FOUND;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.create_oidc_refresh_token(bytea, bigint, bigint, text[], interval)
+/*
+Error codes:
+    NoError      = 0
+    UserNotFound = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_oidc_refresh_token(
+    IN _token_hash public.oidc_refresh_tokens.token_hash%TYPE,
+    IN _client_id public.oidc_refresh_tokens.client_id%TYPE,
+    IN _user_id public.oidc_refresh_tokens.user_id%TYPE,
+    IN _scopes public.oidc_refresh_tokens.scopes%TYPE,
+    IN _ttl interval,
+    OUT _id public.oidc_refresh_tokens.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF NOT EXISTS (SELECT 1 FROM public.users WHERE id = _user_id LIMIT 1) THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    DELETE FROM public.oidc_refresh_tokens WHERE user_id = _user_id AND client_id = _client_id AND expires_at <= _time;
+
+    INSERT INTO public.oidc_refresh_tokens(token_hash, client_id, user_id, scopes, created_at, expires_at)
+        VALUES (_token_hash, _client_id, _user_id, _scopes, _time, _time + _ttl)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_oidc_refresh_token(bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_oidc_refresh_token(
+    IN _id public.oidc_refresh_tokens.id%TYPE,
+    OUT _is_deleted boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.oidc_refresh_tokens WHERE id = _id;
+    _is_deleted := FOUND;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_oidc_refresh_tokens(bigint, bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_oidc_refresh_tokens(
+    IN _user_id public.oidc_refresh_tokens.user_id%TYPE,
+    IN _client_id public.oidc_refresh_tokens.client_id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.oidc_refresh_tokens WHERE user_id = _user_id AND client_id = _client_id;
+END;
+$$ LANGUAGE plpgsql;
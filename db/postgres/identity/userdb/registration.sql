@@ -0,0 +1,174 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_email_verification_token(bigint, bytea, interval)
+/*
+User statuses:
+    New = 1
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_email_verification_token(
+    IN _user_id public.email_verification_tokens.user_id%TYPE,
+    IN _token_hash public.email_verification_tokens.token_hash%TYPE,
+    IN _ttl interval,
+    OUT _id public.email_verification_tokens.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _user_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's status: New(1)
+    IF _status <> 1 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- only the last issued token is valid
+    DELETE FROM public.email_verification_tokens WHERE user_id = _user_id OR expires_at < _time;
+
+    INSERT INTO public.email_verification_tokens(user_id, token_hash, created_at, expires_at)
+        VALUES (_user_id, _token_hash, _time, _time + _ttl)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.verify_user_email(bytea, boolean)
+/*
+User statuses:
+    New             = 1
+    PendingApproval = 2
+    Active          = 3
+
+Error codes:
+    NoError                        = 0
+    EmailVerificationTokenNotFound = 15800
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.verify_user_email(
+    IN _token_hash public.email_verification_tokens.token_hash%TYPE,
+    IN _require_approval boolean,
+    OUT _user_id public.users.id%TYPE,
+    OUT _status public.users.status%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _expires_at public.email_verification_tokens.expires_at%TYPE;
+    _user_status public.users.status%TYPE;
+BEGIN
+    _user_id := 0;
+    _status := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- the token can only be used once
+    DELETE FROM public.email_verification_tokens WHERE token_hash = _token_hash
+        RETURNING user_id, expires_at INTO _user_id, _expires_at;
+    IF NOT FOUND OR _expires_at < _time THEN
+        _user_id := 0;
+        err_code := 15800; -- EmailVerificationTokenNotFound
+        err_msg := 'email verification token not found';
+        RETURN;
+    END IF;
+
+    SELECT status INTO _user_status FROM public.users WHERE id = _user_id LIMIT 1 FOR UPDATE;
+    -- user's status: New(1)
+    IF NOT FOUND OR _user_status <> 1 THEN
+        _user_id := 0;
+        err_code := 15800; -- EmailVerificationTokenNotFound
+        err_msg := 'email verification token not found';
+        RETURN;
+    END IF;
+
+    -- user's statuses: PendingApproval(2), Active(3)
+    IF _require_approval THEN
+        _status := 2;
+    ELSE
+        _status := 3;
+    END IF;
+
+    UPDATE public.users
+        SET updated_at = _time, updated_by = _user_id, status = _status, status_updated_at = _time, status_updated_by = _user_id,
+            status_comment = 'email verified', _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _user_id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.approve_user(bigint, bigint, text)
+/*
+User statuses:
+    PendingApproval = 2
+    Active          = 3
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.approve_user(
+    IN _id public.users.id%TYPE,
+    IN _updated_by public.users.updated_by%TYPE,
+    IN _status_comment public.users.status_comment%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's status: PendingApproval(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- user's status: Active(3)
+    UPDATE public.users
+        SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = _status_comment, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,67 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.set_user_password(bigint, text, bigint)
+/*
+User statuses:
+    Deleting = 7
+    Deleted  = 8
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.set_user_password(
+    IN _user_id public.user_credentials.user_id%TYPE,
+    IN _password_hash public.user_credentials.password_hash%TYPE,
+    IN _updated_by public.user_credentials.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _user_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's statuses: Deleting(7), Deleted(8)
+    IF _status = 7 OR _status = 8 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    INSERT INTO public.user_credentials(user_id, created_at, created_by, updated_at, updated_by, password_hash, password_updated_at,
+            password_updated_by, _version_stamp, _timestamp)
+        VALUES (_user_id, _time, _updated_by, _time, _updated_by, _password_hash, _time, _updated_by, 1, _time)
+        ON CONFLICT (user_id) DO UPDATE
+            SET updated_at = EXCLUDED.updated_at,
+                updated_by = EXCLUDED.updated_by,
+                password_hash = EXCLUDED.password_hash,
+                password_updated_at = EXCLUDED.password_updated_at,
+                password_updated_by = EXCLUDED.password_updated_by,
+                _version_stamp = public.user_credentials._version_stamp + 1,
+                _timestamp = EXCLUDED._timestamp;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,378 @@
+-- Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- 	http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PROCEDURE: public.create_pending_user_totp(bigint, bytea, bigint)
+/*
+User statuses:
+    Deleting = 7
+    Deleted  = 8
+
+TOTP statuses:
+    Pending = 1
+    Enabled = 2
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_pending_user_totp(
+    IN _user_id public.user_totp.user_id%TYPE,
+    IN _secret public.user_totp.secret%TYPE,
+    IN _created_by public.user_totp.created_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.users.status%TYPE;
+    _totp_status public.user_totp.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.users WHERE id = _user_id LIMIT 1 FOR SHARE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's statuses: Deleting(7), Deleted(8)
+    IF _status = 7 OR _status = 8 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s status (%s)', _status);
+        RETURN;
+    END IF;
+
+    SELECT status INTO _totp_status FROM public.user_totp WHERE user_id = _user_id LIMIT 1 FOR UPDATE;
+    -- TOTP status: Enabled(2)
+    IF FOUND AND _totp_status = 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'TOTP has already been enabled';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- TOTP status: Pending(1)
+    INSERT INTO public.user_totp(user_id, created_at, created_by, updated_at, updated_by, status, secret, _version_stamp, _timestamp)
+        VALUES (_user_id, _time, _created_by, _time, _created_by, 1, _secret, 1, _time)
+        ON CONFLICT (user_id) DO UPDATE
+            SET updated_at = EXCLUDED.updated_at,
+                updated_by = EXCLUDED.updated_by,
+                status = EXCLUDED.status,
+                secret = EXCLUDED.secret,
+                enabled_at = NULL,
+                last_used_step = NULL,
+                _version_stamp = public.user_totp._version_stamp + 1,
+                _timestamp = EXCLUDED._timestamp;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.enable_user_totp(bigint, bigint, bytea[], bigint)
+/*
+TOTP statuses:
+    Pending = 1
+    Enabled = 2
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserTotpNotFound = 15400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.enable_user_totp(
+    IN _user_id public.user_totp.user_id%TYPE,
+    IN _last_used_step public.user_totp.last_used_step%TYPE,
+    IN _recovery_code_hashes bytea[],
+    IN _updated_by public.user_totp.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _totp_status public.user_totp.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _totp_status FROM public.user_totp WHERE user_id = _user_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 15400; -- UserTotpNotFound
+        err_msg := 'user''s TOTP not found';
+        RETURN;
+    END IF;
+
+    -- TOTP status: Pending(1)
+    IF _totp_status <> 1 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'TOTP has already been enabled';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- TOTP status: Enabled(2)
+    UPDATE public.user_totp
+        SET updated_at = _time, updated_by = _updated_by, status = 2, enabled_at = _time, last_used_step = _last_used_step,
+            _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE user_id = _user_id;
+
+    DELETE FROM public.user_recovery_codes WHERE user_id = _user_id;
+    INSERT INTO public.user_recovery_codes(user_id, created_at, code_hash)
+        SELECT _user_id, _time, h FROM unnest(_recovery_code_hashes) AS h;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_user_totp(bigint)
+/*
+Error codes:
+    NoError          = 0
+    UserTotpNotFound = 15400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_user_totp(
+    IN _user_id public.user_totp.user_id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.user_totp WHERE user_id = _user_id;
+    IF NOT FOUND THEN
+        err_code := 15400; -- UserTotpNotFound
+        err_msg := 'user''s TOTP not found';
+        RETURN;
+    END IF;
+
+    DELETE FROM public.user_recovery_codes WHERE user_id = _user_id;
+    DELETE FROM public.mfa_challenges WHERE user_id = _user_id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.set_user_totp_last_used_step(bigint, bigint)
+/*
+TOTP statuses:
+    Enabled = 2
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserTotpNotFound = 15400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.set_user_totp_last_used_step(
+    IN _user_id public.user_totp.user_id%TYPE,
+    IN _step public.user_totp.last_used_step%TYPE,
+    OUT _is_set boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _totp_status public.user_totp.status%TYPE;
+    _last_used_step public.user_totp.last_used_step%TYPE;
+BEGIN
+    _is_set := FALSE;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status, last_used_step INTO _totp_status, _last_used_step FROM public.user_totp WHERE user_id = _user_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 15400; -- UserTotpNotFound
+        err_msg := 'user''s TOTP not found';
+        RETURN;
+    END IF;
+
+    -- TOTP status: Enabled(2)
+    IF _totp_status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'TOTP isn''t enabled';
+        RETURN;
+    END IF;
+
+    -- the code of the same or an earlier time step has already been used
+    IF _last_used_step IS NOT NULL AND _last_used_step >= _step THEN
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    UPDATE public.user_totp
+        SET updated_at = _time, last_used_step = _step, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE user_id = _user_id;
+    _is_set := TRUE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.replace_user_recovery_codes(bigint, bytea[])
+/*
+TOTP statuses:
+    Enabled = 2
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserTotpNotFound = 15400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.replace_user_recovery_codes(
+    IN _user_id public.user_recovery_codes.user_id%TYPE,
+    IN _recovery_code_hashes bytea[],
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _totp_status public.user_totp.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _totp_status FROM public.user_totp WHERE user_id = _user_id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 15400; -- UserTotpNotFound
+        err_msg := 'user''s TOTP not found';
+        RETURN;
+    END IF;
+
+    -- TOTP status: Enabled(2)
+    IF _totp_status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := 'TOTP isn''t enabled';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    DELETE FROM public.user_recovery_codes WHERE user_id = _user_id;
+    INSERT INTO public.user_recovery_codes(user_id, created_at, code_hash)
+        SELECT _user_id, _time, h FROM unnest(_recovery_code_hashes) AS h;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.use_user_recovery_code(bigint, bytea)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.use_user_recovery_code(
+    IN _user_id public.user_recovery_codes.user_id%TYPE,
+    IN _code_hash public.user_recovery_codes.code_hash%TYPE,
+    OUT _is_used boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    UPDATE public.user_recovery_codes
+        SET used_at = (clock_timestamp() AT TIME ZONE 'UTC')
+        WHERE user_id = _user_id AND code_hash = _code_hash AND used_at IS NULL;
+    _is_used := FOUND;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.create_mfa_challenge(bigint, bytea, bigint, bigint, text, character varying, interval)
+/*
+Error codes:
+    NoError      = 0
+    UserNotFound = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.create_mfa_challenge(
+    IN _user_id public.mfa_challenges.user_id%TYPE,
+    IN _token_hash public.mfa_challenges.token_hash%TYPE,
+    IN _client_id public.mfa_challenges.client_id%TYPE,
+    IN _app_id public.mfa_challenges.app_id%TYPE,
+    IN _user_agent public.mfa_challenges.user_agent%TYPE,
+    IN _ip public.mfa_challenges.ip%TYPE,
+    IN _ttl interval,
+    OUT _id public.mfa_challenges.id%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+BEGIN
+    _id := 0;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    IF NOT EXISTS (SELECT 1 FROM public.users WHERE id = _user_id LIMIT 1) THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- expired challenges are no longer needed
+    DELETE FROM public.mfa_challenges WHERE expires_at < _time;
+
+    INSERT INTO public.mfa_challenges(user_id, token_hash, client_id, app_id, user_agent, ip, created_at, expires_at, failed_attempts)
+        VALUES (_user_id, _token_hash, _client_id, _app_id, _user_agent, _ip, _time, _time + _ttl, 0)
+        RETURNING id INTO _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.register_mfa_challenge_failed_attempt(bigint, integer)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.register_mfa_challenge_failed_attempt(
+    IN _id public.mfa_challenges.id%TYPE,
+    IN _max_failed_attempts integer,
+    OUT _is_deleted boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _failed_attempts public.mfa_challenges.failed_attempts%TYPE;
+BEGIN
+    _is_deleted := FALSE;
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    UPDATE public.mfa_challenges
+        SET failed_attempts = failed_attempts + 1
+        WHERE id = _id
+        RETURNING failed_attempts INTO _failed_attempts;
+    IF NOT FOUND THEN
+        _is_deleted := TRUE;
+        RETURN;
+    END IF;
+
+    -- the challenge can't be completed after too many failed attempts
+    IF _failed_attempts >= _max_failed_attempts THEN
+        DELETE FROM public.mfa_challenges WHERE id = _id;
+        _is_deleted := TRUE;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.delete_mfa_challenge(bigint)
+/*
+Error codes:
+    NoError = 0
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.delete_mfa_challenge(
+    IN _id public.mfa_challenges.id%TYPE,
+    OUT _is_deleted boolean,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    DELETE FROM public.mfa_challenges WHERE id = _id;
+    _is_deleted := FOUND;
+END;
+$$ LANGUAGE plpgsql;
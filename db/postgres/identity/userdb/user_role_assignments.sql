@@ -36,13 +36,17 @@ CREATE OR REPLACE FUNCTION public.is_role_assigned(
     _user_id public.user_role_assignments.user_id%TYPE,
     _role_id public.user_role_assignments.role_id%TYPE
 ) RETURNS boolean AS $$
+DECLARE
+    _time timestamp(6) without time zone;
 BEGIN
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
    -- user's role assignment status: Active(2)
-    RETURN EXISTS (SELECT 1 FROM public.user_role_assignments WHERE user_id = _user_id AND role_id = _role_id AND status = 2 LIMIT 1);
+    RETURN EXISTS (SELECT 1 FROM public.user_role_assignments WHERE user_id = _user_id AND role_id = _role_id AND status = 2
+        AND (valid_from IS NULL OR valid_from <= _time) AND (valid_until IS NULL OR valid_until > _time) LIMIT 1);
 END;
 $$ LANGUAGE plpgsql;
 
--- PROCEDURE: public.create_user_role_assignment(bigint, bigint, bigint, bigint, text)
+-- PROCEDURE: public.create_user_role_assignment(bigint, bigint, bigint, bigint, text, timestamp, timestamp)
 /*
 User role assignment statuses:
     Active = 2
@@ -58,6 +62,8 @@ CREATE OR REPLACE PROCEDURE public.create_user_role_assignment(
     IN _role_id public.user_role_assignments.role_id%TYPE,
     IN _created_by public.user_role_assignments.created_by%TYPE,
     IN _status_comment public.user_role_assignments.status_comment%TYPE,
+    IN _valid_from public.user_role_assignments.valid_from%TYPE,
+    IN _valid_until public.user_role_assignments.valid_until%TYPE,
     OUT _id public.user_role_assignments.id%TYPE,
     OUT err_code bigint,
     OUT err_msg text) AS $$
@@ -77,8 +83,9 @@ BEGIN
     _time := (clock_timestamp() AT TIME ZONE 'UTC');
     -- user's role assignment status: Active(2)
     INSERT INTO public.user_role_assignments(role_assignment_id, user_id, role_id, created_at, created_by, updated_at, updated_by, status, status_updated_at,
-            status_updated_by, status_comment, _version_stamp, _timestamp)
-        VALUES (_role_assignment_id, _user_id, _role_id, _time, _created_by, _time, _created_by, 2, _time, _created_by, _status_comment, 1, _time)
+            status_updated_by, status_comment, valid_from, valid_until, _version_stamp, _timestamp)
+        VALUES (_role_assignment_id, _user_id, _role_id, _time, _created_by, _time, _created_by, 2, _time, _created_by, _status_comment, _valid_from, _valid_until,
+            1, _time)
         RETURNING id INTO _id;
 
     EXCEPTION
@@ -138,3 +145,104 @@ BEGIN
         WHERE id = _id;
 END;
 $$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.update_user_role_assignment_valid_until(bigint, timestamp, bigint)
+/*
+User role assignment statuses:
+    Active = 2
+
+Error codes:
+    NoError                = 0
+    InvalidOperation       = 3
+    RoleAssignmentNotFound = 13400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.update_user_role_assignment_valid_until(
+    IN _id public.user_role_assignments.id%TYPE,
+    IN _valid_until public.user_role_assignments.valid_until%TYPE,
+    IN _updated_by public.user_role_assignments.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.user_role_assignments.status%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status INTO _status FROM public.user_role_assignments WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 13400; -- RoleAssignmentNotFound
+        err_msg := 'user''s role assignment not found';
+        RETURN;
+    END IF;
+
+    -- user's role assignment status: Active(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s role assignment status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    UPDATE public.user_role_assignments
+        SET updated_at = _time, updated_by = _updated_by, valid_until = _valid_until, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.deactivate_user_role_assignment(bigint, bigint, text)
+/*
+User role assignment statuses:
+    Active   = 2
+    Inactive = 3
+
+Error codes:
+    NoError                = 0
+    InvalidOperation       = 3
+    RoleAssignmentNotFound = 13400
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.deactivate_user_role_assignment(
+    IN _id public.user_role_assignments.id%TYPE,
+    IN _updated_by public.user_role_assignments.updated_by%TYPE,
+    IN _status_comment public.user_role_assignments.status_comment%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _status public.user_role_assignments.status%TYPE;
+    _valid_from public.user_role_assignments.valid_from%TYPE;
+    _valid_until public.user_role_assignments.valid_until%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT status, valid_from, valid_until INTO _status, _valid_from, _valid_until FROM public.user_role_assignments WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 13400; -- RoleAssignmentNotFound
+        err_msg := 'user''s role assignment not found';
+        RETURN;
+    END IF;
+
+    -- user's role assignment status: Active(2)
+    IF _status <> 2 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s role assignment status (%s)', _status);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    -- the validity period of a role assignment that is revoked early ends now
+    -- (unless the validity period hasn't started yet)
+    IF (_valid_until IS NULL OR _valid_until > _time) AND (_valid_from IS NULL OR _valid_from < _time) THEN
+        _valid_until := _time;
+    END IF;
+
+    -- user's role assignment status: Inactive(3)
+    UPDATE public.user_role_assignments
+        SET updated_at = _time, updated_by = _updated_by, status = 3, status_updated_at = _time, status_updated_by = _updated_by,
+            status_comment = _status_comment, valid_until = _valid_until, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+END;
+$$ LANGUAGE plpgsql;
@@ -216,6 +216,16 @@ BEGIN
         SET updated_at = _time, updated_by = _deleted_by, is_deleted = TRUE, deleted_at = _time, deleted_by = _deleted_by,
             _version_stamp = _version_stamp + 1, _timestamp = _time
         WHERE user_id = _id;
+
+    DELETE FROM public.user_credentials WHERE user_id = _id;
+    DELETE FROM public.lockouts WHERE id = _id;
+    DELETE FROM public.user_totp WHERE user_id = _id;
+    DELETE FROM public.user_recovery_codes WHERE user_id = _id;
+    DELETE FROM public.mfa_challenges WHERE user_id = _id;
+    DELETE FROM public.oidc_authorization_codes WHERE user_id = _id;
+    DELETE FROM public.oidc_refresh_tokens WHERE user_id = _id;
+    DELETE FROM public.email_verification_tokens WHERE user_id = _id;
+    DELETE FROM public.api_keys WHERE user_id = _id;
 END;
 $$ LANGUAGE plpgsql;
 
@@ -279,3 +289,68 @@ BEGIN
             RAISE;
 END;
 $$ LANGUAGE plpgsql;
+
+-- PROCEDURE: public.anonymize_user(bigint, bigint)
+/*
+User types:
+    User = 1
+
+Genders:
+    Unspecified = 0
+
+Error codes:
+    NoError          = 0
+    InvalidOperation = 3
+    UserNotFound     = 11000
+*/
+-- Minimum transaction isolation level: Read committed.
+CREATE OR REPLACE PROCEDURE public.anonymize_user(
+    IN _id public.users.id%TYPE,
+    IN _updated_by public.users.updated_by%TYPE,
+    OUT err_code bigint,
+    OUT err_msg text) AS $$
+DECLARE
+    _time timestamp(6) without time zone;
+    _type public.users.type%TYPE;
+BEGIN
+    err_code := 0; -- NoError
+    err_msg := '';
+
+    SELECT type INTO _type FROM public.users WHERE id = _id LIMIT 1 FOR UPDATE;
+    IF NOT FOUND THEN
+        err_code := 11000; -- UserNotFound
+        err_msg := 'user not found';
+        RETURN;
+    END IF;
+
+    -- user's type: User(1)
+    IF _type <> 1 THEN
+        err_code := 3; -- InvalidOperation
+        err_msg := format('invalid user''s type (%s)', _type);
+        RETURN;
+    END IF;
+
+    _time := (clock_timestamp() AT TIME ZONE 'UTC');
+    UPDATE public.users
+        SET name = NULL, email = NULL, first_sign_in_ip = NULL, last_sign_in_ip = NULL, last_activity_ip = NULL,
+            updated_at = _time, updated_by = _updated_by, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE id = _id;
+
+    -- gender: Unspecified(0)
+    UPDATE public.personal_info
+        SET first_name = '', last_name = '', display_name = '', birth_date = NULL, gender = 0,
+            updated_at = _time, updated_by = _updated_by, _version_stamp = _version_stamp + 1, _timestamp = _time
+        WHERE user_id = _id;
+
+    DELETE FROM public.mfa_challenges WHERE user_id = _id;
+    DELETE FROM public.oidc_authorization_codes WHERE user_id = _id;
+    DELETE FROM public.oidc_refresh_tokens WHERE user_id = _id;
+    DELETE FROM public.email_verification_tokens WHERE user_id = _id;
+    DELETE FROM public.lockouts WHERE id = _id;
+    -- the user's API keys have been revoked before the anonymization, their names are personal data
+    DELETE FROM public.api_keys WHERE user_id = _id;
+    DELETE FROM public.impersonation_tokens WHERE user_id = _id OR impersonator_id = _id;
+    DELETE FROM public.data_subject_request_export_sections
+        WHERE request_id IN (SELECT id FROM public.data_subject_requests WHERE user_id = _id);
+END;
+$$ LANGUAGE plpgsql;
@@ -15,6 +15,7 @@
 package app
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -57,9 +58,12 @@ import (
 	appcontrollers "personal-website-v2/pkg/app/service/net/http/server/controllers/app"
 	"personal-website-v2/pkg/base/env"
 	"personal-website-v2/pkg/base/nullable"
+	kafkacomponent "personal-website-v2/pkg/components/kafka"
 	"personal-website-v2/pkg/db/postgres"
 	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/health"
 	"personal-website-v2/pkg/identity"
+	identityapikeys "personal-website-v2/pkg/identity/apikeys"
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/adapters/console"
 	filelogadapter "personal-website-v2/pkg/logging/adapters/filelog"
@@ -74,6 +78,7 @@ import (
 	httpserver "personal-website-v2/pkg/net/http/server"
 	httpserverlogging "personal-website-v2/pkg/net/http/server/logging"
 	httpserverrouting "personal-website-v2/pkg/net/http/server/routing"
+	"personal-website-v2/pkg/net/tlsconfig"
 	"personal-website-v2/pkg/web/identity/authn/cookies"
 )
 
@@ -102,6 +107,8 @@ type Application struct {
 	mu                sync.Mutex
 	done              chan struct{}
 
+	healthChecker *health.Checker
+
 	identityManager identity.IdentityManager
 
 	tranManager   *actions.TransactionManager
@@ -114,9 +121,10 @@ type Application struct {
 
 	postgresManager *postgres.DbManager[enpostgres.Stores]
 
-	appManagerService     *appmanager.AppManagerService
-	loggingManagerService *loggingmanager.LoggingManagerService
-	identityService       *identityclient.IdentityService
+	appManagerService       *appmanager.AppManagerService
+	loggingManagerService   *loggingmanager.LoggingManagerService
+	identityService         *identityclient.IdentityService
+	apiKeyRevocationService *identityapikeys.ApiKeyRevocationService
 
 	mailAccountManager *mailmanager.MailAccountManager
 	notifManager       *notificationmanager.NotificationManager
@@ -289,6 +297,12 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] init an identity manager: %w", err)
 	}
 
+	if a.apiKeyRevocationService != nil {
+		if err = a.apiKeyRevocationService.Start(); err != nil {
+			return fmt.Errorf("[app.Application.Start] start the API key revocation service: %w", err)
+		}
+	}
+
 	if err = a.configureActions(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure actions: %w", err)
 	}
@@ -305,6 +319,8 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] configure: %w", err)
 	}
 
+	a.configureHealthChecks()
+
 	if err = a.configureHttpServer(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure an HTTP server: %w", err)
 	}
@@ -341,10 +357,16 @@ func (a *Application) loadConfig() error {
 }
 
 func (a *Application) startLoggingSession() error {
+	tlsc, err := a.config.Apis.Clients.LoggingManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startLoggingSession] get a TLS config of the logging manager service: %w", err)
+	}
+
 	c := &loggingmanager.LoggingManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.LoggingManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	lms := loggingmanager.NewLoggingManagerService(c)
 
@@ -382,10 +404,16 @@ func (a *Application) startLoggingSession() error {
 }
 
 func (a *Application) startSession() error {
+	tlsc, err := a.config.Apis.Clients.AppManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startSession] get a TLS config of the app manager service: %w", err)
+	}
+
 	c := &appmanager.AppManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.AppManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	ams := appmanager.NewAppManagerService(c)
 
@@ -573,10 +601,16 @@ func (a *Application) createFileLogAdapter(appInfo *info.AppInfo, loggingSession
 }
 
 func (a *Application) configureIdentity() error {
+	tlsc, err := a.config.Apis.Clients.IdentityService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureIdentity] get a TLS config of the identity service: %w", err)
+	}
+
 	c := &identityclient.IdentityServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.IdentityService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.IdentityService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.IdentityService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	is := identityclient.NewIdentityService(c)
 	if err := is.Init(); err != nil {
@@ -591,7 +625,12 @@ func (a *Application) configureIdentity() error {
 		}
 	}()
 
-	im, err := identity.NewIdentityManager(a.config.UserId, is, enidentity.Roles, enidentity.Permissions, a.loggerFactory)
+	akc, err := a.configureApiKeyCache()
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureIdentity] configure the API key cache: %w", err)
+	}
+
+	im, err := identity.NewIdentityManager(a.config.UserId, is, enidentity.Roles, enidentity.Permissions, nil, akc, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureIdentity] new identity manager: %w", err)
 	}
@@ -601,6 +640,37 @@ func (a *Application) configureIdentity() error {
 	return nil
 }
 
+// configureApiKeyCache creates the API key cache and the service that invalidates the cache
+// when API keys are revoked, if the cache is configured.
+func (a *Application) configureApiKeyCache() (*identity.ApiKeyCache, error) {
+	if a.config.Identity == nil || a.config.Identity.ApiKeyCache == nil {
+		return nil, nil
+	}
+
+	cc := a.config.Identity.ApiKeyCache
+	c, err := identity.NewApiKeyCache(&identity.ApiKeyCacheConfig{
+		Capacity: cc.Capacity,
+		TTL:      time.Duration(cc.TTL) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key cache: %w", err)
+	}
+
+	sc := &identityapikeys.ApiKeyRevocationServiceConfig{
+		Kafka: &identityapikeys.ApiKeyRevocationServiceKafkaConfig{
+			Config: cc.Revocation.Kafka.ConsumerConfig.Config(),
+			Topic:  cc.Revocation.Kafka.Topic,
+		},
+	}
+	s, err := identityapikeys.NewApiKeyRevocationService(a.appSessionId.Value, c, sc, a.loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key revocation service: %w", err)
+	}
+
+	a.apiKeyRevocationService = s
+	return c, nil
+}
+
 func (a *Application) configureActions() error {
 	c := &actionlogging.LoggerConfig{
 		AppInfo: &info.AppInfo{
@@ -687,6 +757,28 @@ func (a *Application) configure() error {
 	return nil
 }
 
+func (a *Application) configureHealthChecks() {
+	c := health.NewChecker(0)
+	c.Add("appSession", a.session.HealthCheck)
+	c.Add("loggingSession", a.loggingSession.HealthCheck)
+	c.Add("postgres", a.postgresManager.HealthCheck)
+	c.Add("kafka", kafkacomponent.HealthCheck)
+
+	if a.appManagerService != nil {
+		c.Add("appManagerService", a.appManagerService.HealthCheck)
+	}
+
+	if a.loggingManagerService != nil {
+		c.Add("loggingManagerService", a.loggingManagerService.HealthCheck)
+	}
+
+	if a.identityService != nil {
+		c.Add("identityService", a.identityService.HealthCheck)
+	}
+
+	a.healthChecker = c
+}
+
 func (a *Application) configureHttpServer() error {
 	var ac *cookies.CookieAuthnConfig
 	if a.config.Auth != nil && a.config.Auth.Authn != nil && a.config.Auth.Authn.Http != nil && a.config.Auth.Authn.Http.Cookies != nil {
@@ -710,6 +802,12 @@ func (a *Application) configureHttpServer() error {
 		return fmt.Errorf("[app.Application.configureHttpServer] configure HTTP routing: %w", err)
 	}
 
+	if a.config.Net.Http.Server.HealthProbes {
+		if err := httpserver.AddHealthRoutes(router, a.healthChecker, a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] add the routes of the health probes: %w", err)
+		}
+	}
+
 	rpcb := httpserver.NewRequestPipelineConfigBuilder()
 	rpcb.SetPipelineLifetime(rpl).
 		UseAuthentication().
@@ -741,6 +839,13 @@ func (a *Application) configureHttpServer() error {
 	}
 
 	a.httpServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Http.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Http.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] new TLS config: %w", err)
+		}
+	}
+
 	hsb := httpserver.NewHttpServerBuilder(httpServerId, a.appSessionId.Value, l, a.loggerFactory)
 	hsb.Configure(func(config *httpserver.HttpServerConfig) {
 		config.Addr = a.config.Net.Http.Server.Addr
@@ -748,6 +853,7 @@ func (a *Application) configureHttpServer() error {
 		config.WriteTimeout = time.Duration(a.config.Net.Http.Server.WriteTimeout) * time.Millisecond
 		config.IdleTimeout = time.Duration(a.config.Net.Http.Server.IdleTimeout) * time.Millisecond
 		config.PipelineConfig = rpcb.Build()
+		config.TLSConfig = tlsc
 	})
 
 	s, err := hsb.Build()
@@ -760,7 +866,10 @@ func (a *Application) configureHttpServer() error {
 }
 
 func (a *Application) configureHttpRouting(router *httpserverrouting.Router) error {
-	ic := &appcontrollers.ApplicationControllerIdentityConfig{StopPermission: enidentity.PermissionApp_Stop}
+	ic := &appcontrollers.ApplicationControllerIdentityConfig{
+		StopPermission:       enidentity.PermissionApp_Stop,
+		GetMetricsPermission: enidentity.PermissionApp_GetMetrics,
+	}
 	appController, err := appcontrollers.NewApplicationController(a, a.appSessionId.Value, a.actionManager, a.identityManager, ic, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureHttpRouting] new application controller: %w", err)
@@ -769,6 +878,7 @@ func (a *Application) configureHttpRouting(router *httpserverrouting.Router) err
 	// private
 	// api
 	router.AddPost("App_Stop", "/private/api/app/stop", appController.Stop)
+	router.AddGet("App_GetMetrics", "/metrics", appController.GetMetrics)
 	return nil
 }
 
@@ -921,6 +1031,12 @@ func (a *Application) stop(ctx *actions.OperationContext) {
 		a.postgresManager.Dispose()
 	}
 
+	if a.apiKeyRevocationService != nil && a.apiKeyRevocationService.IsStarted() {
+		if err := a.apiKeyRevocationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the API key revocation service")
+		}
+	}
+
 	if a.identityService != nil {
 		if err := a.identityService.Dispose(); err != nil {
 			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the identity service")
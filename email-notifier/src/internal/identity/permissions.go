@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "emailnotifier.app.stop"
+	PermissionApp_Stop       = "emailnotifier.app.stop"
+	PermissionApp_GetMetrics = "emailnotifier.app.getMetrics"
 
 	// Notification group permissions.
 	PermissionNotificationGroup_Create = "emailnotifier.notificationGroups.create"
@@ -39,6 +40,7 @@ const (
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionNotificationGroup_Create,
 	PermissionNotificationGroup_Delete,
 	PermissionNotificationGroup_Get,
@@ -33,11 +33,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthenticationService_CreateUserToken_FullMethodName    = "/personalwebsite.identity.authentication.AuthenticationService/CreateUserToken"
-	AuthenticationService_CreateClientToken_FullMethodName  = "/personalwebsite.identity.authentication.AuthenticationService/CreateClientToken"
-	AuthenticationService_Authenticate_FullMethodName       = "/personalwebsite.identity.authentication.AuthenticationService/Authenticate"
-	AuthenticationService_AuthenticateUser_FullMethodName   = "/personalwebsite.identity.authentication.AuthenticationService/AuthenticateUser"
-	AuthenticationService_AuthenticateClient_FullMethodName = "/personalwebsite.identity.authentication.AuthenticationService/AuthenticateClient"
+	AuthenticationService_CreateUserToken_FullMethodName           = "/personalwebsite.identity.authentication.AuthenticationService/CreateUserToken"
+	AuthenticationService_CreateClientToken_FullMethodName         = "/personalwebsite.identity.authentication.AuthenticationService/CreateClientToken"
+	AuthenticationService_Authenticate_FullMethodName              = "/personalwebsite.identity.authentication.AuthenticationService/Authenticate"
+	AuthenticationService_AuthenticateUser_FullMethodName          = "/personalwebsite.identity.authentication.AuthenticationService/AuthenticateUser"
+	AuthenticationService_AuthenticateClient_FullMethodName        = "/personalwebsite.identity.authentication.AuthenticationService/AuthenticateClient"
+	AuthenticationService_CreateServiceClientToken_FullMethodName  = "/personalwebsite.identity.authentication.AuthenticationService/CreateServiceClientToken"
+	AuthenticationService_AuthenticateServiceClient_FullMethodName = "/personalwebsite.identity.authentication.AuthenticationService/AuthenticateServiceClient"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -54,6 +56,11 @@ type AuthenticationServiceClient interface {
 	AuthenticateUser(ctx context.Context, in *AuthenticateUserRequest, opts ...grpc.CallOption) (*AuthenticateUserResponse, error)
 	// Authenticates a client.
 	AuthenticateClient(ctx context.Context, in *AuthenticateClientRequest, opts ...grpc.CallOption) (*AuthenticateClientResponse, error)
+	// Creates a short-lived token of the service client using the client credentials (client ID and client secret)
+	// and returns it if the operation is successful. The token grants the roles assigned to the service client.
+	CreateServiceClientToken(ctx context.Context, in *CreateServiceClientTokenRequest, opts ...grpc.CallOption) (*CreateServiceClientTokenResponse, error)
+	// Authenticates a service client.
+	AuthenticateServiceClient(ctx context.Context, in *AuthenticateServiceClientRequest, opts ...grpc.CallOption) (*AuthenticateServiceClientResponse, error)
 }
 
 type authenticationServiceClient struct {
@@ -109,6 +116,24 @@ func (c *authenticationServiceClient) AuthenticateClient(ctx context.Context, in
 	return out, nil
 }
 
+func (c *authenticationServiceClient) CreateServiceClientToken(ctx context.Context, in *CreateServiceClientTokenRequest, opts ...grpc.CallOption) (*CreateServiceClientTokenResponse, error) {
+	out := new(CreateServiceClientTokenResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_CreateServiceClientToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) AuthenticateServiceClient(ctx context.Context, in *AuthenticateServiceClientRequest, opts ...grpc.CallOption) (*AuthenticateServiceClientResponse, error) {
+	out := new(AuthenticateServiceClientResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_AuthenticateServiceClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility
@@ -123,6 +148,11 @@ type AuthenticationServiceServer interface {
 	AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error)
 	// Authenticates a client.
 	AuthenticateClient(context.Context, *AuthenticateClientRequest) (*AuthenticateClientResponse, error)
+	// Creates a short-lived token of the service client using the client credentials (client ID and client secret)
+	// and returns it if the operation is successful. The token grants the roles assigned to the service client.
+	CreateServiceClientToken(context.Context, *CreateServiceClientTokenRequest) (*CreateServiceClientTokenResponse, error)
+	// Authenticates a service client.
+	AuthenticateServiceClient(context.Context, *AuthenticateServiceClientRequest) (*AuthenticateServiceClientResponse, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -145,6 +175,12 @@ func (UnimplementedAuthenticationServiceServer) AuthenticateUser(context.Context
 func (UnimplementedAuthenticationServiceServer) AuthenticateClient(context.Context, *AuthenticateClientRequest) (*AuthenticateClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateClient not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) CreateServiceClientToken(context.Context, *CreateServiceClientTokenRequest) (*CreateServiceClientTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceClientToken not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) AuthenticateServiceClient(context.Context, *AuthenticateServiceClientRequest) (*AuthenticateServiceClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateServiceClient not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 
 // UnsafeAuthenticationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +284,42 @@ func _AuthenticationService_AuthenticateClient_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_CreateServiceClientToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceClientTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).CreateServiceClientToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_CreateServiceClientToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).CreateServiceClientToken(ctx, req.(*CreateServiceClientTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_AuthenticateServiceClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateServiceClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).AuthenticateServiceClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_AuthenticateServiceClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).AuthenticateServiceClient(ctx, req.(*AuthenticateServiceClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,6 +347,14 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateClient",
 			Handler:    _AuthenticationService_AuthenticateClient_Handler,
 		},
+		{
+			MethodName: "CreateServiceClientToken",
+			Handler:    _AuthenticationService_CreateServiceClientToken_Handler,
+		},
+		{
+			MethodName: "AuthenticateServiceClient",
+			Handler:    _AuthenticationService_AuthenticateServiceClient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/identity/authentication/authentication_service.proto",
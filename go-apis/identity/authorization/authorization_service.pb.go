@@ -23,8 +23,14 @@ package authorization
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	clients "personal-website-v2/go-apis/identity/clients"
 	groups "personal-website-v2/go-apis/identity/groups"
+	permissions "personal-website-v2/go-apis/identity/permissions"
+	roles "personal-website-v2/go-apis/identity/roles"
+	assignments "personal-website-v2/go-apis/identity/roles/assignments"
+	users "personal-website-v2/go-apis/identity/users"
 	reflect "reflect"
 	sync "sync"
 )
@@ -36,6 +42,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The missing link.
+// If several links are missing, the one closest to the permission is reported.
+type MissingLinkEnum_MissingLink int32
+
+const (
+	// The permission is granted.
+	MissingLinkEnum_NONE MissingLinkEnum_MissingLink = 0
+	// The permission doesn't exist.
+	MissingLinkEnum_PERMISSION MissingLinkEnum_MissingLink = 1
+	// The permission isn't granted to any role.
+	MissingLinkEnum_ROLE MissingLinkEnum_MissingLink = 2
+	// None of the roles that are granted the permission are effectively assigned
+	// to the user, the user's groups or the client.
+	MissingLinkEnum_ROLE_ASSIGNMENT MissingLinkEnum_MissingLink = 3
+	// The user (client) isn't active.
+	MissingLinkEnum_ASSIGNEE_STATUS MissingLinkEnum_MissingLink = 4
+)
+
+// Enum value maps for MissingLinkEnum_MissingLink.
+var (
+	MissingLinkEnum_MissingLink_name = map[int32]string{
+		0: "NONE",
+		1: "PERMISSION",
+		2: "ROLE",
+		3: "ROLE_ASSIGNMENT",
+		4: "ASSIGNEE_STATUS",
+	}
+	MissingLinkEnum_MissingLink_value = map[string]int32{
+		"NONE":            0,
+		"PERMISSION":      1,
+		"ROLE":            2,
+		"ROLE_ASSIGNMENT": 3,
+		"ASSIGNEE_STATUS": 4,
+	}
+)
+
+func (x MissingLinkEnum_MissingLink) Enum() *MissingLinkEnum_MissingLink {
+	p := new(MissingLinkEnum_MissingLink)
+	*p = x
+	return p
+}
+
+func (x MissingLinkEnum_MissingLink) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissingLinkEnum_MissingLink) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_identity_authorization_authorization_service_proto_enumTypes[0].Descriptor()
+}
+
+func (MissingLinkEnum_MissingLink) Type() protoreflect.EnumType {
+	return &file_apis_identity_authorization_authorization_service_proto_enumTypes[0]
+}
+
+func (x MissingLinkEnum_MissingLink) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissingLinkEnum_MissingLink.Descriptor instead.
+func (MissingLinkEnum_MissingLink) EnumDescriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // Request message for 'AuthorizationService.Authorize'.
 type AuthorizeRequest struct {
 	state         protoimpl.MessageState
@@ -47,6 +116,10 @@ type AuthorizeRequest struct {
 	// The client ID.
 	ClientId              *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	RequiredPermissionIds []uint64                `protobuf:"varint,3,rep,packed,name=required_permission_ids,json=requiredPermissionIds,proto3" json:"required_permission_ids,omitempty"`
+	// Optional. The resources against which the permissions are checked.
+	// A permission that isn't granted to the user (client) regardless of the resources
+	// must be granted for each of the resources.
+	Resources []*Resource `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
 }
 
 func (x *AuthorizeRequest) Reset() {
@@ -102,6 +175,80 @@ func (x *AuthorizeRequest) GetRequiredPermissionIds() []uint64 {
 	return nil
 }
 
+func (x *AuthorizeRequest) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// The resource against which the permissions are checked.
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource type ID.
+	TypeId uint64 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// The resource ID.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user who created the resource (the owner of the resource), if known.
+	OwnerId *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Resource) GetTypeId() uint64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *Resource) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Resource) GetOwnerId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.OwnerId
+	}
+	return nil
+}
+
 // Response message for 'AuthorizationService.Authorize'.
 type AuthorizeResponse struct {
 	state         protoimpl.MessageState
@@ -111,13 +258,14 @@ type AuthorizeResponse struct {
 	// The user's group.
 	Group groups.UserGroup `protobuf:"varint,1,opt,name=group,proto3,enum=personalwebsite.identity.groups.UserGroup" json:"group,omitempty"`
 	// The roles of permissions.
+	// The permissions that are granted only for the specified resources are omitted.
 	PermissionRoles []*PermissionWithRoles `protobuf:"bytes,2,rep,name=permission_roles,json=permissionRoles,proto3" json:"permission_roles,omitempty"`
 }
 
 func (x *AuthorizeResponse) Reset() {
 	*x = AuthorizeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[1]
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -130,7 +278,7 @@ func (x *AuthorizeResponse) String() string {
 func (*AuthorizeResponse) ProtoMessage() {}
 
 func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[1]
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -143,7 +291,7 @@ func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
 func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{1}
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AuthorizeResponse) GetGroup() groups.UserGroup {
@@ -175,7 +323,7 @@ type PermissionWithRoles struct {
 func (x *PermissionWithRoles) Reset() {
 	*x = PermissionWithRoles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[2]
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -188,7 +336,7 @@ func (x *PermissionWithRoles) String() string {
 func (*PermissionWithRoles) ProtoMessage() {}
 
 func (x *PermissionWithRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[2]
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,7 +349,7 @@ func (x *PermissionWithRoles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionWithRoles.ProtoReflect.Descriptor instead.
 func (*PermissionWithRoles) Descriptor() ([]byte, []int) {
-	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{2}
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PermissionWithRoles) GetPermissionId() uint64 {
@@ -218,132 +366,1107 @@ func (x *PermissionWithRoles) GetRoleIds() []uint64 {
 	return nil
 }
 
-var File_apis_identity_authorization_authorization_service_proto protoreflect.FileDescriptor
+// Request message for 'AuthorizationService.Explain'.
+type ExplainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_apis_identity_authorization_authorization_service_proto_rawDesc = []byte{
-	0x0a, 0x37, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x25, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x66, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x32, 0x9b,
-	0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The user ID.
+	UserId *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The client ID.
+	ClientId      *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PermissionIds []uint64                `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
 }
 
-var (
-	file_apis_identity_authorization_authorization_service_proto_rawDescOnce sync.Once
-	file_apis_identity_authorization_authorization_service_proto_rawDescData = file_apis_identity_authorization_authorization_service_proto_rawDesc
-)
+func (x *ExplainRequest) Reset() {
+	*x = ExplainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_apis_identity_authorization_authorization_service_proto_rawDescGZIP() []byte {
-	file_apis_identity_authorization_authorization_service_proto_rawDescOnce.Do(func() {
-		file_apis_identity_authorization_authorization_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_identity_authorization_authorization_service_proto_rawDescData)
-	})
-	return file_apis_identity_authorization_authorization_service_proto_rawDescData
+func (x *ExplainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_apis_identity_authorization_authorization_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_apis_identity_authorization_authorization_service_proto_goTypes = []interface{}{
-	(*AuthorizeRequest)(nil),       // 0: personalwebsite.identity.authorization.AuthorizeRequest
-	(*AuthorizeResponse)(nil),      // 1: personalwebsite.identity.authorization.AuthorizeResponse
-	(*PermissionWithRoles)(nil),    // 2: personalwebsite.identity.authorization.PermissionWithRoles
-	(*wrapperspb.UInt64Value)(nil), // 3: google.protobuf.UInt64Value
-	(groups.UserGroup)(0),          // 4: personalwebsite.identity.groups.UserGroup
+func (*ExplainRequest) ProtoMessage() {}
+
+func (x *ExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_apis_identity_authorization_authorization_service_proto_depIdxs = []int32{
-	3, // 0: personalwebsite.identity.authorization.AuthorizeRequest.user_id:type_name -> google.protobuf.UInt64Value
-	3, // 1: personalwebsite.identity.authorization.AuthorizeRequest.client_id:type_name -> google.protobuf.UInt64Value
-	4, // 2: personalwebsite.identity.authorization.AuthorizeResponse.group:type_name -> personalwebsite.identity.groups.UserGroup
-	2, // 3: personalwebsite.identity.authorization.AuthorizeResponse.permission_roles:type_name -> personalwebsite.identity.authorization.PermissionWithRoles
-	0, // 4: personalwebsite.identity.authorization.AuthorizationService.Authorize:input_type -> personalwebsite.identity.authorization.AuthorizeRequest
-	1, // 5: personalwebsite.identity.authorization.AuthorizationService.Authorize:output_type -> personalwebsite.identity.authorization.AuthorizeResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use ExplainRequest.ProtoReflect.Descriptor instead.
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_apis_identity_authorization_authorization_service_proto_init() }
-func file_apis_identity_authorization_authorization_service_proto_init() {
-	if File_apis_identity_authorization_authorization_service_proto != nil {
-		return
+func (x *ExplainRequest) GetUserId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.UserId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_apis_identity_authorization_authorization_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *ExplainRequest) GetClientId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ClientId
+	}
+	return nil
+}
+
+func (x *ExplainRequest) GetPermissionIds() []uint64 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+// Response message for 'AuthorizationService.Explain'.
+type ExplainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The explanation of the authorization.
+	Explanation *AuthorizationExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *ExplainResponse) Reset() {
+	*x = ExplainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResponse) ProtoMessage() {}
+
+func (x *ExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_apis_identity_authorization_authorization_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResponse.ProtoReflect.Descriptor instead.
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExplainResponse) GetExplanation() *AuthorizationExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+// The explanation of the authorization of a user (client).
+type AuthorizationExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user's group.
+	Group groups.UserGroup `protobuf:"varint,1,opt,name=group,proto3,enum=personalwebsite.identity.groups.UserGroup" json:"group,omitempty"`
+	// The groups of which the user is a member.
+	MemberGroups []groups.UserGroup `protobuf:"varint,2,rep,packed,name=member_groups,json=memberGroups,proto3,enum=personalwebsite.identity.groups.UserGroup" json:"member_groups,omitempty"`
+	// The user's status if the user is specified.
+	UserStatus users.UserStatus `protobuf:"varint,3,opt,name=user_status,json=userStatus,proto3,enum=personalwebsite.identity.users.UserStatus" json:"user_status,omitempty"`
+	// The client status if the client is specified.
+	ClientStatus clients.ClientStatus `protobuf:"varint,4,opt,name=client_status,json=clientStatus,proto3,enum=personalwebsite.identity.clients.ClientStatus" json:"client_status,omitempty"`
+	// The explanations of the permissions in the order of the specified permissions.
+	Permissions []*PermissionExplanation `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *AuthorizationExplanation) Reset() {
+	*x = AuthorizationExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationExplanation) ProtoMessage() {}
+
+func (x *AuthorizationExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_apis_identity_authorization_authorization_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissionWithRoles); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationExplanation.ProtoReflect.Descriptor instead.
+func (*AuthorizationExplanation) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuthorizationExplanation) GetGroup() groups.UserGroup {
+	if x != nil {
+		return x.Group
+	}
+	return groups.UserGroup(0)
+}
+
+func (x *AuthorizationExplanation) GetMemberGroups() []groups.UserGroup {
+	if x != nil {
+		return x.MemberGroups
+	}
+	return nil
+}
+
+func (x *AuthorizationExplanation) GetUserStatus() users.UserStatus {
+	if x != nil {
+		return x.UserStatus
+	}
+	return users.UserStatus(0)
+}
+
+func (x *AuthorizationExplanation) GetClientStatus() clients.ClientStatus {
+	if x != nil {
+		return x.ClientStatus
+	}
+	return clients.ClientStatus(0)
+}
+
+func (x *AuthorizationExplanation) GetPermissions() []*PermissionExplanation {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// The explanation of a permission.
+type PermissionExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The permission ID.
+	PermissionId uint64 `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	// The permission name if the permission exists.
+	PermissionName string `protobuf:"bytes,2,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	// The permission status if the permission exists.
+	PermissionStatus permissions.PermissionStatusEnum_PermissionStatus `protobuf:"varint,3,opt,name=permission_status,json=permissionStatus,proto3,enum=personalwebsite.identity.permissions.PermissionStatusEnum_PermissionStatus" json:"permission_status,omitempty"`
+	// Indicates whether the permission is granted regardless of the resources.
+	Granted bool `protobuf:"varint,4,opt,name=granted,proto3" json:"granted,omitempty"`
+	// The link that is missing if the permission isn't granted.
+	MissingLink MissingLinkEnum_MissingLink `protobuf:"varint,5,opt,name=missing_link,json=missingLink,proto3,enum=personalwebsite.identity.authorization.MissingLinkEnum_MissingLink" json:"missing_link,omitempty"`
+	// The roles that are granted the permission, directly or by inheritance.
+	Roles []*RoleExplanation `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *PermissionExplanation) Reset() {
+	*x = PermissionExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionExplanation) ProtoMessage() {}
+
+func (x *PermissionExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionExplanation.ProtoReflect.Descriptor instead.
+func (*PermissionExplanation) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PermissionExplanation) GetPermissionId() uint64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PermissionExplanation) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *PermissionExplanation) GetPermissionStatus() permissions.PermissionStatusEnum_PermissionStatus {
+	if x != nil {
+		return x.PermissionStatus
+	}
+	return permissions.PermissionStatusEnum_PermissionStatus(0)
+}
+
+func (x *PermissionExplanation) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *PermissionExplanation) GetMissingLink() MissingLinkEnum_MissingLink {
+	if x != nil {
+		return x.MissingLink
+	}
+	return MissingLinkEnum_NONE
+}
+
+func (x *PermissionExplanation) GetRoles() []*RoleExplanation {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Container for enum describing the missing link in the chain "user (client) -> role assignment -> role -> permission".
+type MissingLinkEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MissingLinkEnum) Reset() {
+	*x = MissingLinkEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingLinkEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingLinkEnum) ProtoMessage() {}
+
+func (x *MissingLinkEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingLinkEnum.ProtoReflect.Descriptor instead.
+func (*MissingLinkEnum) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{8}
+}
+
+// The explanation of a role that is granted a permission.
+type RoleExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role ID.
+	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// The role name.
+	RoleName string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// The role status.
+	RoleStatus roles.RoleStatusEnum_RoleStatus `protobuf:"varint,3,opt,name=role_status,json=roleStatus,proto3,enum=personalwebsite.identity.roles.RoleStatusEnum_RoleStatus" json:"role_status,omitempty"`
+	// Indicates whether the permission is granted to the role directly.
+	// Otherwise, the role inherits the permission from a parent role.
+	GrantedDirectly bool `protobuf:"varint,4,opt,name=granted_directly,json=grantedDirectly,proto3" json:"granted_directly,omitempty"`
+	// The assignments of the role to the user, the user's groups or the client, including inactive ones.
+	Assignments []*RoleAssignmentExplanation `protobuf:"bytes,5,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *RoleExplanation) Reset() {
+	*x = RoleExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleExplanation) ProtoMessage() {}
+
+func (x *RoleExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleExplanation.ProtoReflect.Descriptor instead.
+func (*RoleExplanation) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RoleExplanation) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *RoleExplanation) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *RoleExplanation) GetRoleStatus() roles.RoleStatusEnum_RoleStatus {
+	if x != nil {
+		return x.RoleStatus
+	}
+	return roles.RoleStatusEnum_RoleStatus(0)
+}
+
+func (x *RoleExplanation) GetGrantedDirectly() bool {
+	if x != nil {
+		return x.GrantedDirectly
+	}
+	return false
+}
+
+func (x *RoleExplanation) GetAssignments() []*RoleAssignmentExplanation {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// The explanation of a role assignment.
+type RoleAssignmentExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role assignment ID.
+	RoleAssignmentId uint64 `protobuf:"varint,1,opt,name=role_assignment_id,json=roleAssignmentId,proto3" json:"role_assignment_id,omitempty"`
+	// The assignee type.
+	AssigneeType assignments.AssigneeTypeEnum_AssigneeType `protobuf:"varint,2,opt,name=assignee_type,json=assigneeType,proto3,enum=personalwebsite.identity.roles.assignments.AssigneeTypeEnum_AssigneeType" json:"assignee_type,omitempty"`
+	// The assignee ID (user ID, group or client ID).
+	AssigneeId uint64 `protobuf:"varint,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	// The role assignment status.
+	Status assignments.RoleAssignmentStatusEnum_RoleAssignmentStatus `protobuf:"varint,4,opt,name=status,proto3,enum=personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum_RoleAssignmentStatus" json:"status,omitempty"`
+	// Optional. It stores the date and time from which the role assignment is valid.
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Optional. It stores the date and time until which the role assignment is valid.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Indicates whether the role assignment is taken into account by the authorization.
+	Effective bool `protobuf:"varint,7,opt,name=effective,proto3" json:"effective,omitempty"`
+}
+
+func (x *RoleAssignmentExplanation) Reset() {
+	*x = RoleAssignmentExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleAssignmentExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignmentExplanation) ProtoMessage() {}
+
+func (x *RoleAssignmentExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAssignmentExplanation.ProtoReflect.Descriptor instead.
+func (*RoleAssignmentExplanation) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RoleAssignmentExplanation) GetRoleAssignmentId() uint64 {
+	if x != nil {
+		return x.RoleAssignmentId
+	}
+	return 0
+}
+
+func (x *RoleAssignmentExplanation) GetAssigneeType() assignments.AssigneeTypeEnum_AssigneeType {
+	if x != nil {
+		return x.AssigneeType
+	}
+	return assignments.AssigneeTypeEnum_AssigneeType(0)
+}
+
+func (x *RoleAssignmentExplanation) GetAssigneeId() uint64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *RoleAssignmentExplanation) GetStatus() assignments.RoleAssignmentStatusEnum_RoleAssignmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return assignments.RoleAssignmentStatusEnum_RoleAssignmentStatus(0)
+}
+
+func (x *RoleAssignmentExplanation) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *RoleAssignmentExplanation) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *RoleAssignmentExplanation) GetEffective() bool {
+	if x != nil {
+		return x.Effective
+	}
+	return false
+}
+
+// Request message for 'AuthorizationService.GetAllRoleAssignmentsByPermissionId'.
+type GetAllRoleAssignmentsByPermissionIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The permission ID.
+	PermissionId uint64 `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdRequest) Reset() {
+	*x = GetAllRoleAssignmentsByPermissionIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllRoleAssignmentsByPermissionIdRequest) ProtoMessage() {}
+
+func (x *GetAllRoleAssignmentsByPermissionIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllRoleAssignmentsByPermissionIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAllRoleAssignmentsByPermissionIdRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdRequest) GetPermissionId() uint64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+// Response message for 'AuthorizationService.GetAllRoleAssignmentsByPermissionId'.
+type GetAllRoleAssignmentsByPermissionIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The active role assignments.
+	RoleAssignments []*assignments.RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdResponse) Reset() {
+	*x = GetAllRoleAssignmentsByPermissionIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllRoleAssignmentsByPermissionIdResponse) ProtoMessage() {}
+
+func (x *GetAllRoleAssignmentsByPermissionIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_authorization_authorization_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllRoleAssignmentsByPermissionIdResponse.ProtoReflect.Descriptor instead.
+func (*GetAllRoleAssignmentsByPermissionIdResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_authorization_authorization_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAllRoleAssignmentsByPermissionIdResponse) GetRoleAssignments() []*assignments.RoleAssignment {
+	if x != nil {
+		return x.RoleAssignments
+	}
+	return nil
+}
+
+var File_apis_identity_authorization_authorization_service_proto protoreflect.FileDescriptor
+
+var file_apis_identity_authorization_authorization_service_proto_rawDesc = []byte{
+	0x0a, 0x37, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x22, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x61,
+	0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x61, 0x70, 0x69, 0x73, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x8c, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x4e, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
+	0x6c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbd, 0x01,
+	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x66, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x0f, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x55, 0x0a,
+	0x13, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0x75, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x03, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4f, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x0b, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x15, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4b, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e,
+	0x6b, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x4d,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x6e, 0x0a,
+	0x0f, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x75, 0x6d,
+	0x22, 0x5b, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x4c,
+	0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x45, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x04, 0x22, 0xb3, 0x02,
+	0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6c, 0x79, 0x12, 0x63,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xe3, 0x03, 0x0a, 0x19, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72,
+	0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64,
+	0x12, 0x71, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x59, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b,
+	0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x51, 0x0a, 0x2a, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a,
+	0x2b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x32, 0xec, 0x03, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x7c, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x36, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0xd0, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x52, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x53, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apis_identity_authorization_authorization_service_proto_rawDescOnce sync.Once
+	file_apis_identity_authorization_authorization_service_proto_rawDescData = file_apis_identity_authorization_authorization_service_proto_rawDesc
+)
+
+func file_apis_identity_authorization_authorization_service_proto_rawDescGZIP() []byte {
+	file_apis_identity_authorization_authorization_service_proto_rawDescOnce.Do(func() {
+		file_apis_identity_authorization_authorization_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_identity_authorization_authorization_service_proto_rawDescData)
+	})
+	return file_apis_identity_authorization_authorization_service_proto_rawDescData
+}
+
+var file_apis_identity_authorization_authorization_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apis_identity_authorization_authorization_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_apis_identity_authorization_authorization_service_proto_goTypes = []interface{}{
+	(MissingLinkEnum_MissingLink)(0),                               // 0: personalwebsite.identity.authorization.MissingLinkEnum.MissingLink
+	(*AuthorizeRequest)(nil),                                       // 1: personalwebsite.identity.authorization.AuthorizeRequest
+	(*Resource)(nil),                                               // 2: personalwebsite.identity.authorization.Resource
+	(*AuthorizeResponse)(nil),                                      // 3: personalwebsite.identity.authorization.AuthorizeResponse
+	(*PermissionWithRoles)(nil),                                    // 4: personalwebsite.identity.authorization.PermissionWithRoles
+	(*ExplainRequest)(nil),                                         // 5: personalwebsite.identity.authorization.ExplainRequest
+	(*ExplainResponse)(nil),                                        // 6: personalwebsite.identity.authorization.ExplainResponse
+	(*AuthorizationExplanation)(nil),                               // 7: personalwebsite.identity.authorization.AuthorizationExplanation
+	(*PermissionExplanation)(nil),                                  // 8: personalwebsite.identity.authorization.PermissionExplanation
+	(*MissingLinkEnum)(nil),                                        // 9: personalwebsite.identity.authorization.MissingLinkEnum
+	(*RoleExplanation)(nil),                                        // 10: personalwebsite.identity.authorization.RoleExplanation
+	(*RoleAssignmentExplanation)(nil),                              // 11: personalwebsite.identity.authorization.RoleAssignmentExplanation
+	(*GetAllRoleAssignmentsByPermissionIdRequest)(nil),             // 12: personalwebsite.identity.authorization.GetAllRoleAssignmentsByPermissionIdRequest
+	(*GetAllRoleAssignmentsByPermissionIdResponse)(nil),            // 13: personalwebsite.identity.authorization.GetAllRoleAssignmentsByPermissionIdResponse
+	(*wrapperspb.UInt64Value)(nil),                                 // 14: google.protobuf.UInt64Value
+	(groups.UserGroup)(0),                                          // 15: personalwebsite.identity.groups.UserGroup
+	(users.UserStatus)(0),                                          // 16: personalwebsite.identity.users.UserStatus
+	(clients.ClientStatus)(0),                                      // 17: personalwebsite.identity.clients.ClientStatus
+	(permissions.PermissionStatusEnum_PermissionStatus)(0),         // 18: personalwebsite.identity.permissions.PermissionStatusEnum.PermissionStatus
+	(roles.RoleStatusEnum_RoleStatus)(0),                           // 19: personalwebsite.identity.roles.RoleStatusEnum.RoleStatus
+	(assignments.AssigneeTypeEnum_AssigneeType)(0),                 // 20: personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	(assignments.RoleAssignmentStatusEnum_RoleAssignmentStatus)(0), // 21: personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
+	(*timestamppb.Timestamp)(nil),                                  // 22: google.protobuf.Timestamp
+	(*assignments.RoleAssignment)(nil),                             // 23: personalwebsite.identity.roles.assignments.RoleAssignment
+}
+var file_apis_identity_authorization_authorization_service_proto_depIdxs = []int32{
+	14, // 0: personalwebsite.identity.authorization.AuthorizeRequest.user_id:type_name -> google.protobuf.UInt64Value
+	14, // 1: personalwebsite.identity.authorization.AuthorizeRequest.client_id:type_name -> google.protobuf.UInt64Value
+	2,  // 2: personalwebsite.identity.authorization.AuthorizeRequest.resources:type_name -> personalwebsite.identity.authorization.Resource
+	14, // 3: personalwebsite.identity.authorization.Resource.owner_id:type_name -> google.protobuf.UInt64Value
+	15, // 4: personalwebsite.identity.authorization.AuthorizeResponse.group:type_name -> personalwebsite.identity.groups.UserGroup
+	4,  // 5: personalwebsite.identity.authorization.AuthorizeResponse.permission_roles:type_name -> personalwebsite.identity.authorization.PermissionWithRoles
+	14, // 6: personalwebsite.identity.authorization.ExplainRequest.user_id:type_name -> google.protobuf.UInt64Value
+	14, // 7: personalwebsite.identity.authorization.ExplainRequest.client_id:type_name -> google.protobuf.UInt64Value
+	7,  // 8: personalwebsite.identity.authorization.ExplainResponse.explanation:type_name -> personalwebsite.identity.authorization.AuthorizationExplanation
+	15, // 9: personalwebsite.identity.authorization.AuthorizationExplanation.group:type_name -> personalwebsite.identity.groups.UserGroup
+	15, // 10: personalwebsite.identity.authorization.AuthorizationExplanation.member_groups:type_name -> personalwebsite.identity.groups.UserGroup
+	16, // 11: personalwebsite.identity.authorization.AuthorizationExplanation.user_status:type_name -> personalwebsite.identity.users.UserStatus
+	17, // 12: personalwebsite.identity.authorization.AuthorizationExplanation.client_status:type_name -> personalwebsite.identity.clients.ClientStatus
+	8,  // 13: personalwebsite.identity.authorization.AuthorizationExplanation.permissions:type_name -> personalwebsite.identity.authorization.PermissionExplanation
+	18, // 14: personalwebsite.identity.authorization.PermissionExplanation.permission_status:type_name -> personalwebsite.identity.permissions.PermissionStatusEnum.PermissionStatus
+	0,  // 15: personalwebsite.identity.authorization.PermissionExplanation.missing_link:type_name -> personalwebsite.identity.authorization.MissingLinkEnum.MissingLink
+	10, // 16: personalwebsite.identity.authorization.PermissionExplanation.roles:type_name -> personalwebsite.identity.authorization.RoleExplanation
+	19, // 17: personalwebsite.identity.authorization.RoleExplanation.role_status:type_name -> personalwebsite.identity.roles.RoleStatusEnum.RoleStatus
+	11, // 18: personalwebsite.identity.authorization.RoleExplanation.assignments:type_name -> personalwebsite.identity.authorization.RoleAssignmentExplanation
+	20, // 19: personalwebsite.identity.authorization.RoleAssignmentExplanation.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	21, // 20: personalwebsite.identity.authorization.RoleAssignmentExplanation.status:type_name -> personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
+	22, // 21: personalwebsite.identity.authorization.RoleAssignmentExplanation.valid_from:type_name -> google.protobuf.Timestamp
+	22, // 22: personalwebsite.identity.authorization.RoleAssignmentExplanation.valid_until:type_name -> google.protobuf.Timestamp
+	23, // 23: personalwebsite.identity.authorization.GetAllRoleAssignmentsByPermissionIdResponse.role_assignments:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
+	1,  // 24: personalwebsite.identity.authorization.AuthorizationService.Authorize:input_type -> personalwebsite.identity.authorization.AuthorizeRequest
+	5,  // 25: personalwebsite.identity.authorization.AuthorizationService.Explain:input_type -> personalwebsite.identity.authorization.ExplainRequest
+	12, // 26: personalwebsite.identity.authorization.AuthorizationService.GetAllRoleAssignmentsByPermissionId:input_type -> personalwebsite.identity.authorization.GetAllRoleAssignmentsByPermissionIdRequest
+	3,  // 27: personalwebsite.identity.authorization.AuthorizationService.Authorize:output_type -> personalwebsite.identity.authorization.AuthorizeResponse
+	6,  // 28: personalwebsite.identity.authorization.AuthorizationService.Explain:output_type -> personalwebsite.identity.authorization.ExplainResponse
+	13, // 29: personalwebsite.identity.authorization.AuthorizationService.GetAllRoleAssignmentsByPermissionId:output_type -> personalwebsite.identity.authorization.GetAllRoleAssignmentsByPermissionIdResponse
+	27, // [27:30] is the sub-list for method output_type
+	24, // [24:27] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_apis_identity_authorization_authorization_service_proto_init() }
+func file_apis_identity_authorization_authorization_service_proto_init() {
+	if File_apis_identity_authorization_authorization_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionWithRoles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingLinkEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleAssignmentExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllRoleAssignmentsByPermissionIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_authorization_authorization_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllRoleAssignmentsByPermissionIdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -360,13 +1483,14 @@ func file_apis_identity_authorization_authorization_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apis_identity_authorization_authorization_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_apis_identity_authorization_authorization_service_proto_goTypes,
 		DependencyIndexes: file_apis_identity_authorization_authorization_service_proto_depIdxs,
+		EnumInfos:         file_apis_identity_authorization_authorization_service_proto_enumTypes,
 		MessageInfos:      file_apis_identity_authorization_authorization_service_proto_msgTypes,
 	}.Build()
 	File_apis_identity_authorization_authorization_service_proto = out.File
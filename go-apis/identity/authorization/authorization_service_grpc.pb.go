@@ -33,7 +33,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthorizationService_Authorize_FullMethodName = "/personalwebsite.identity.authorization.AuthorizationService/Authorize"
+	AuthorizationService_Authorize_FullMethodName                           = "/personalwebsite.identity.authorization.AuthorizationService/Authorize"
+	AuthorizationService_Explain_FullMethodName                             = "/personalwebsite.identity.authorization.AuthorizationService/Explain"
+	AuthorizationService_GetAllRoleAssignmentsByPermissionId_FullMethodName = "/personalwebsite.identity.authorization.AuthorizationService/GetAllRoleAssignmentsByPermissionId"
 )
 
 // AuthorizationServiceClient is the client API for AuthorizationService service.
@@ -42,6 +44,12 @@ const (
 type AuthorizationServiceClient interface {
 	// Authorizes a user.
 	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	// Explains the authorization of a user (client) for the specified permissions
+	// regardless of the resources.
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
+	// Gets all active role assignments of the roles that are granted the specified permission,
+	// directly or by inheritance.
+	GetAllRoleAssignmentsByPermissionId(ctx context.Context, in *GetAllRoleAssignmentsByPermissionIdRequest, opts ...grpc.CallOption) (*GetAllRoleAssignmentsByPermissionIdResponse, error)
 }
 
 type authorizationServiceClient struct {
@@ -61,12 +69,36 @@ func (c *authorizationServiceClient) Authorize(ctx context.Context, in *Authoriz
 	return out, nil
 }
 
+func (c *authorizationServiceClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, AuthorizationService_Explain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorizationServiceClient) GetAllRoleAssignmentsByPermissionId(ctx context.Context, in *GetAllRoleAssignmentsByPermissionIdRequest, opts ...grpc.CallOption) (*GetAllRoleAssignmentsByPermissionIdResponse, error) {
+	out := new(GetAllRoleAssignmentsByPermissionIdResponse)
+	err := c.cc.Invoke(ctx, AuthorizationService_GetAllRoleAssignmentsByPermissionId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthorizationServiceServer is the server API for AuthorizationService service.
 // All implementations must embed UnimplementedAuthorizationServiceServer
 // for forward compatibility
 type AuthorizationServiceServer interface {
 	// Authorizes a user.
 	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	// Explains the authorization of a user (client) for the specified permissions
+	// regardless of the resources.
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
+	// Gets all active role assignments of the roles that are granted the specified permission,
+	// directly or by inheritance.
+	GetAllRoleAssignmentsByPermissionId(context.Context, *GetAllRoleAssignmentsByPermissionIdRequest) (*GetAllRoleAssignmentsByPermissionIdResponse, error)
 	mustEmbedUnimplementedAuthorizationServiceServer()
 }
 
@@ -77,6 +109,12 @@ type UnimplementedAuthorizationServiceServer struct {
 func (UnimplementedAuthorizationServiceServer) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
 }
+func (UnimplementedAuthorizationServiceServer) Explain(context.Context, *ExplainRequest) (*ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
+func (UnimplementedAuthorizationServiceServer) GetAllRoleAssignmentsByPermissionId(context.Context, *GetAllRoleAssignmentsByPermissionIdRequest) (*GetAllRoleAssignmentsByPermissionIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllRoleAssignmentsByPermissionId not implemented")
+}
 func (UnimplementedAuthorizationServiceServer) mustEmbedUnimplementedAuthorizationServiceServer() {}
 
 // UnsafeAuthorizationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -108,6 +146,42 @@ func _AuthorizationService_Authorize_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthorizationService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServiceServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorizationService_Explain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServiceServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorizationService_GetAllRoleAssignmentsByPermissionId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRoleAssignmentsByPermissionIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServiceServer).GetAllRoleAssignmentsByPermissionId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorizationService_GetAllRoleAssignmentsByPermissionId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServiceServer).GetAllRoleAssignmentsByPermissionId(ctx, req.(*GetAllRoleAssignmentsByPermissionIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthorizationService_ServiceDesc is the grpc.ServiceDesc for AuthorizationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,6 +193,14 @@ var AuthorizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authorize",
 			Handler:    _AuthorizationService_Authorize_Handler,
 		},
+		{
+			MethodName: "Explain",
+			Handler:    _AuthorizationService_Explain_Handler,
+		},
+		{
+			MethodName: "GetAllRoleAssignmentsByPermissionId",
+			Handler:    _AuthorizationService_GetAllRoleAssignmentsByPermissionId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/identity/authorization/authorization_service.proto",
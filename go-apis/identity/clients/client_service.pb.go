@@ -268,6 +268,220 @@ func (x *CreateMobileClientResponse) GetId() uint64 {
 	return 0
 }
 
+// Request message for 'ClientService.CreateServiceClient'.
+type CreateServiceClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The app ID.
+	AppId uint64 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The IP address.
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (x *CreateServiceClientRequest) Reset() {
+	*x = CreateServiceClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceClientRequest) ProtoMessage() {}
+
+func (x *CreateServiceClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceClientRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceClientRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateServiceClientRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *CreateServiceClientRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+// Response message for 'ClientService.CreateServiceClient'.
+type CreateServiceClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The client ID.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The client secret.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateServiceClientResponse) Reset() {
+	*x = CreateServiceClientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceClientResponse) ProtoMessage() {}
+
+func (x *CreateServiceClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceClientResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceClientResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateServiceClientResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateServiceClientResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// Request message for 'ClientService.RotateServiceClientSecret'.
+type RotateServiceClientSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The client ID.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RotateServiceClientSecretRequest) Reset() {
+	*x = RotateServiceClientSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateServiceClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceClientSecretRequest) ProtoMessage() {}
+
+func (x *RotateServiceClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateServiceClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RotateServiceClientSecretRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Response message for 'ClientService.RotateServiceClientSecret'.
+type RotateServiceClientSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new client secret.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RotateServiceClientSecretResponse) Reset() {
+	*x = RotateServiceClientSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateServiceClientSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceClientSecretResponse) ProtoMessage() {}
+
+func (x *RotateServiceClientSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceClientSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateServiceClientSecretResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RotateServiceClientSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
 // Request message for 'ClientService.Delete'.
 type DeleteRequest struct {
 	state         protoimpl.MessageState
@@ -281,7 +495,7 @@ type DeleteRequest struct {
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[4]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +508,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[4]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +521,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{4}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteRequest) GetId() uint64 {
@@ -330,7 +544,7 @@ type GetByIdRequest struct {
 func (x *GetByIdRequest) Reset() {
 	*x = GetByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[5]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +557,7 @@ func (x *GetByIdRequest) String() string {
 func (*GetByIdRequest) ProtoMessage() {}
 
 func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[5]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +570,7 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{5}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetByIdRequest) GetId() uint64 {
@@ -379,7 +593,7 @@ type GetByIdResponse struct {
 func (x *GetByIdResponse) Reset() {
 	*x = GetByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[6]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +606,7 @@ func (x *GetByIdResponse) String() string {
 func (*GetByIdResponse) ProtoMessage() {}
 
 func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[6]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +619,7 @@ func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{6}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetByIdResponse) GetClient() *Client {
@@ -428,7 +642,7 @@ type GetTypeByIdRequest struct {
 func (x *GetTypeByIdRequest) Reset() {
 	*x = GetTypeByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[7]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +655,7 @@ func (x *GetTypeByIdRequest) String() string {
 func (*GetTypeByIdRequest) ProtoMessage() {}
 
 func (x *GetTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[7]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +668,7 @@ func (x *GetTypeByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTypeByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{7}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetTypeByIdRequest) GetId() uint64 {
@@ -477,7 +691,7 @@ type GetTypeByIdResponse struct {
 func (x *GetTypeByIdResponse) Reset() {
 	*x = GetTypeByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[8]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +704,7 @@ func (x *GetTypeByIdResponse) String() string {
 func (*GetTypeByIdResponse) ProtoMessage() {}
 
 func (x *GetTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[8]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +717,7 @@ func (x *GetTypeByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTypeByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{8}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetTypeByIdResponse) GetType() ClientTypeEnum_ClientType {
@@ -526,7 +740,7 @@ type GetStatusByIdRequest struct {
 func (x *GetStatusByIdRequest) Reset() {
 	*x = GetStatusByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[9]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +753,7 @@ func (x *GetStatusByIdRequest) String() string {
 func (*GetStatusByIdRequest) ProtoMessage() {}
 
 func (x *GetStatusByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[9]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +766,7 @@ func (x *GetStatusByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetStatusByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{9}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetStatusByIdRequest) GetId() uint64 {
@@ -575,7 +789,7 @@ type GetStatusByIdResponse struct {
 func (x *GetStatusByIdResponse) Reset() {
 	*x = GetStatusByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_clients_client_service_proto_msgTypes[10]
+		mi := &file_apis_identity_clients_client_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +802,7 @@ func (x *GetStatusByIdResponse) String() string {
 func (*GetStatusByIdResponse) ProtoMessage() {}
 
 func (x *GetStatusByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_clients_client_service_proto_msgTypes[10]
+	mi := &file_apis_identity_clients_client_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +815,7 @@ func (x *GetStatusByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStatusByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{10}
+	return file_apis_identity_clients_client_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetStatusByIdResponse) GetStatus() ClientStatus {
@@ -646,86 +860,121 @@ var file_apis_identity_clients_client_service_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
-	0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x45, 0x0a, 0x1b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x20, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x21, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a,
-	0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
-	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xf8, 0x05, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x2e, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x32, 0xb8, 0x08, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x91, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x62, 0x69, 0x6c,
+	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x2e, 0x70,
 	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
 	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x57, 0x65, 0x62, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x2e, 0x70, 0x65, 0x72,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa6, 0x01, 0x0a, 0x19,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x42, 0x2e, 0x70, 0x65, 0x72, 0x73,
 	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x34, 0x2e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e,
 	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
 	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x36,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2f,
 	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
 	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
 	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x36, 0x5a, 0x34, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69, 0x73,
-	0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x3b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x34, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x36, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36,
+	0x5a, 0x34, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x3b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -740,46 +989,54 @@ func file_apis_identity_clients_client_service_proto_rawDescGZIP() []byte {
 	return file_apis_identity_clients_client_service_proto_rawDescData
 }
 
-var file_apis_identity_clients_client_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_apis_identity_clients_client_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_apis_identity_clients_client_service_proto_goTypes = []interface{}{
-	(*CreateWebClientRequest)(nil),     // 0: personalwebsite.identity.clients.CreateWebClientRequest
-	(*CreateWebClientResponse)(nil),    // 1: personalwebsite.identity.clients.CreateWebClientResponse
-	(*CreateMobileClientRequest)(nil),  // 2: personalwebsite.identity.clients.CreateMobileClientRequest
-	(*CreateMobileClientResponse)(nil), // 3: personalwebsite.identity.clients.CreateMobileClientResponse
-	(*DeleteRequest)(nil),              // 4: personalwebsite.identity.clients.DeleteRequest
-	(*GetByIdRequest)(nil),             // 5: personalwebsite.identity.clients.GetByIdRequest
-	(*GetByIdResponse)(nil),            // 6: personalwebsite.identity.clients.GetByIdResponse
-	(*GetTypeByIdRequest)(nil),         // 7: personalwebsite.identity.clients.GetTypeByIdRequest
-	(*GetTypeByIdResponse)(nil),        // 8: personalwebsite.identity.clients.GetTypeByIdResponse
-	(*GetStatusByIdRequest)(nil),       // 9: personalwebsite.identity.clients.GetStatusByIdRequest
-	(*GetStatusByIdResponse)(nil),      // 10: personalwebsite.identity.clients.GetStatusByIdResponse
-	(*wrapperspb.UInt64Value)(nil),     // 11: google.protobuf.UInt64Value
-	(*wrapperspb.StringValue)(nil),     // 12: google.protobuf.StringValue
-	(*Client)(nil),                     // 13: personalwebsite.identity.clients.Client
-	(ClientTypeEnum_ClientType)(0),     // 14: personalwebsite.identity.clients.ClientTypeEnum.ClientType
-	(ClientStatus)(0),                  // 15: personalwebsite.identity.clients.ClientStatus
-	(*emptypb.Empty)(nil),              // 16: google.protobuf.Empty
+	(*CreateWebClientRequest)(nil),            // 0: personalwebsite.identity.clients.CreateWebClientRequest
+	(*CreateWebClientResponse)(nil),           // 1: personalwebsite.identity.clients.CreateWebClientResponse
+	(*CreateMobileClientRequest)(nil),         // 2: personalwebsite.identity.clients.CreateMobileClientRequest
+	(*CreateMobileClientResponse)(nil),        // 3: personalwebsite.identity.clients.CreateMobileClientResponse
+	(*CreateServiceClientRequest)(nil),        // 4: personalwebsite.identity.clients.CreateServiceClientRequest
+	(*CreateServiceClientResponse)(nil),       // 5: personalwebsite.identity.clients.CreateServiceClientResponse
+	(*RotateServiceClientSecretRequest)(nil),  // 6: personalwebsite.identity.clients.RotateServiceClientSecretRequest
+	(*RotateServiceClientSecretResponse)(nil), // 7: personalwebsite.identity.clients.RotateServiceClientSecretResponse
+	(*DeleteRequest)(nil),                     // 8: personalwebsite.identity.clients.DeleteRequest
+	(*GetByIdRequest)(nil),                    // 9: personalwebsite.identity.clients.GetByIdRequest
+	(*GetByIdResponse)(nil),                   // 10: personalwebsite.identity.clients.GetByIdResponse
+	(*GetTypeByIdRequest)(nil),                // 11: personalwebsite.identity.clients.GetTypeByIdRequest
+	(*GetTypeByIdResponse)(nil),               // 12: personalwebsite.identity.clients.GetTypeByIdResponse
+	(*GetStatusByIdRequest)(nil),              // 13: personalwebsite.identity.clients.GetStatusByIdRequest
+	(*GetStatusByIdResponse)(nil),             // 14: personalwebsite.identity.clients.GetStatusByIdResponse
+	(*wrapperspb.UInt64Value)(nil),            // 15: google.protobuf.UInt64Value
+	(*wrapperspb.StringValue)(nil),            // 16: google.protobuf.StringValue
+	(*Client)(nil),                            // 17: personalwebsite.identity.clients.Client
+	(ClientTypeEnum_ClientType)(0),            // 18: personalwebsite.identity.clients.ClientTypeEnum.ClientType
+	(ClientStatus)(0),                         // 19: personalwebsite.identity.clients.ClientStatus
+	(*emptypb.Empty)(nil),                     // 20: google.protobuf.Empty
 }
 var file_apis_identity_clients_client_service_proto_depIdxs = []int32{
-	11, // 0: personalwebsite.identity.clients.CreateWebClientRequest.app_id:type_name -> google.protobuf.UInt64Value
-	12, // 1: personalwebsite.identity.clients.CreateMobileClientRequest.user_agent:type_name -> google.protobuf.StringValue
-	13, // 2: personalwebsite.identity.clients.GetByIdResponse.client:type_name -> personalwebsite.identity.clients.Client
-	14, // 3: personalwebsite.identity.clients.GetTypeByIdResponse.type:type_name -> personalwebsite.identity.clients.ClientTypeEnum.ClientType
-	15, // 4: personalwebsite.identity.clients.GetStatusByIdResponse.status:type_name -> personalwebsite.identity.clients.ClientStatus
+	15, // 0: personalwebsite.identity.clients.CreateWebClientRequest.app_id:type_name -> google.protobuf.UInt64Value
+	16, // 1: personalwebsite.identity.clients.CreateMobileClientRequest.user_agent:type_name -> google.protobuf.StringValue
+	17, // 2: personalwebsite.identity.clients.GetByIdResponse.client:type_name -> personalwebsite.identity.clients.Client
+	18, // 3: personalwebsite.identity.clients.GetTypeByIdResponse.type:type_name -> personalwebsite.identity.clients.ClientTypeEnum.ClientType
+	19, // 4: personalwebsite.identity.clients.GetStatusByIdResponse.status:type_name -> personalwebsite.identity.clients.ClientStatus
 	0,  // 5: personalwebsite.identity.clients.ClientService.CreateWebClient:input_type -> personalwebsite.identity.clients.CreateWebClientRequest
 	2,  // 6: personalwebsite.identity.clients.ClientService.CreateMobileClient:input_type -> personalwebsite.identity.clients.CreateMobileClientRequest
-	4,  // 7: personalwebsite.identity.clients.ClientService.Delete:input_type -> personalwebsite.identity.clients.DeleteRequest
-	5,  // 8: personalwebsite.identity.clients.ClientService.GetById:input_type -> personalwebsite.identity.clients.GetByIdRequest
-	7,  // 9: personalwebsite.identity.clients.ClientService.GetTypeById:input_type -> personalwebsite.identity.clients.GetTypeByIdRequest
-	9,  // 10: personalwebsite.identity.clients.ClientService.GetStatusById:input_type -> personalwebsite.identity.clients.GetStatusByIdRequest
-	1,  // 11: personalwebsite.identity.clients.ClientService.CreateWebClient:output_type -> personalwebsite.identity.clients.CreateWebClientResponse
-	3,  // 12: personalwebsite.identity.clients.ClientService.CreateMobileClient:output_type -> personalwebsite.identity.clients.CreateMobileClientResponse
-	16, // 13: personalwebsite.identity.clients.ClientService.Delete:output_type -> google.protobuf.Empty
-	6,  // 14: personalwebsite.identity.clients.ClientService.GetById:output_type -> personalwebsite.identity.clients.GetByIdResponse
-	8,  // 15: personalwebsite.identity.clients.ClientService.GetTypeById:output_type -> personalwebsite.identity.clients.GetTypeByIdResponse
-	10, // 16: personalwebsite.identity.clients.ClientService.GetStatusById:output_type -> personalwebsite.identity.clients.GetStatusByIdResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
+	4,  // 7: personalwebsite.identity.clients.ClientService.CreateServiceClient:input_type -> personalwebsite.identity.clients.CreateServiceClientRequest
+	6,  // 8: personalwebsite.identity.clients.ClientService.RotateServiceClientSecret:input_type -> personalwebsite.identity.clients.RotateServiceClientSecretRequest
+	8,  // 9: personalwebsite.identity.clients.ClientService.Delete:input_type -> personalwebsite.identity.clients.DeleteRequest
+	9,  // 10: personalwebsite.identity.clients.ClientService.GetById:input_type -> personalwebsite.identity.clients.GetByIdRequest
+	11, // 11: personalwebsite.identity.clients.ClientService.GetTypeById:input_type -> personalwebsite.identity.clients.GetTypeByIdRequest
+	13, // 12: personalwebsite.identity.clients.ClientService.GetStatusById:input_type -> personalwebsite.identity.clients.GetStatusByIdRequest
+	1,  // 13: personalwebsite.identity.clients.ClientService.CreateWebClient:output_type -> personalwebsite.identity.clients.CreateWebClientResponse
+	3,  // 14: personalwebsite.identity.clients.ClientService.CreateMobileClient:output_type -> personalwebsite.identity.clients.CreateMobileClientResponse
+	5,  // 15: personalwebsite.identity.clients.ClientService.CreateServiceClient:output_type -> personalwebsite.identity.clients.CreateServiceClientResponse
+	7,  // 16: personalwebsite.identity.clients.ClientService.RotateServiceClientSecret:output_type -> personalwebsite.identity.clients.RotateServiceClientSecretResponse
+	20, // 17: personalwebsite.identity.clients.ClientService.Delete:output_type -> google.protobuf.Empty
+	10, // 18: personalwebsite.identity.clients.ClientService.GetById:output_type -> personalwebsite.identity.clients.GetByIdResponse
+	12, // 19: personalwebsite.identity.clients.ClientService.GetTypeById:output_type -> personalwebsite.identity.clients.GetTypeByIdResponse
+	14, // 20: personalwebsite.identity.clients.ClientService.GetStatusById:output_type -> personalwebsite.identity.clients.GetStatusByIdResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
@@ -841,7 +1098,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRequest); i {
+			switch v := v.(*CreateServiceClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -853,7 +1110,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByIdRequest); i {
+			switch v := v.(*CreateServiceClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -865,7 +1122,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByIdResponse); i {
+			switch v := v.(*RotateServiceClientSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -877,7 +1134,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTypeByIdRequest); i {
+			switch v := v.(*RotateServiceClientSecretResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -889,7 +1146,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTypeByIdResponse); i {
+			switch v := v.(*DeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -901,7 +1158,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusByIdRequest); i {
+			switch v := v.(*GetByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -913,6 +1170,54 @@ func file_apis_identity_clients_client_service_proto_init() {
 			}
 		}
 		file_apis_identity_clients_client_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_clients_client_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypeByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_clients_client_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypeByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_clients_client_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_clients_client_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusByIdResponse); i {
 			case 0:
 				return &v.state
@@ -931,7 +1236,7 @@ func file_apis_identity_clients_client_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apis_identity_clients_client_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,12 +34,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ClientService_CreateWebClient_FullMethodName    = "/personalwebsite.identity.clients.ClientService/CreateWebClient"
-	ClientService_CreateMobileClient_FullMethodName = "/personalwebsite.identity.clients.ClientService/CreateMobileClient"
-	ClientService_Delete_FullMethodName             = "/personalwebsite.identity.clients.ClientService/Delete"
-	ClientService_GetById_FullMethodName            = "/personalwebsite.identity.clients.ClientService/GetById"
-	ClientService_GetTypeById_FullMethodName        = "/personalwebsite.identity.clients.ClientService/GetTypeById"
-	ClientService_GetStatusById_FullMethodName      = "/personalwebsite.identity.clients.ClientService/GetStatusById"
+	ClientService_CreateWebClient_FullMethodName           = "/personalwebsite.identity.clients.ClientService/CreateWebClient"
+	ClientService_CreateMobileClient_FullMethodName        = "/personalwebsite.identity.clients.ClientService/CreateMobileClient"
+	ClientService_CreateServiceClient_FullMethodName       = "/personalwebsite.identity.clients.ClientService/CreateServiceClient"
+	ClientService_RotateServiceClientSecret_FullMethodName = "/personalwebsite.identity.clients.ClientService/RotateServiceClientSecret"
+	ClientService_Delete_FullMethodName                    = "/personalwebsite.identity.clients.ClientService/Delete"
+	ClientService_GetById_FullMethodName                   = "/personalwebsite.identity.clients.ClientService/GetById"
+	ClientService_GetTypeById_FullMethodName               = "/personalwebsite.identity.clients.ClientService/GetTypeById"
+	ClientService_GetStatusById_FullMethodName             = "/personalwebsite.identity.clients.ClientService/GetStatusById"
 )
 
 // ClientServiceClient is the client API for ClientService service.
@@ -50,6 +52,12 @@ type ClientServiceClient interface {
 	CreateWebClient(ctx context.Context, in *CreateWebClientRequest, opts ...grpc.CallOption) (*CreateWebClientResponse, error)
 	// Creates a mobile client and returns the client ID if the operation is successful.
 	CreateMobileClient(ctx context.Context, in *CreateMobileClientRequest, opts ...grpc.CallOption) (*CreateMobileClientResponse, error)
+	// Creates a service client and returns the client ID and the client secret if the operation is successful.
+	// The client secret is only returned once.
+	CreateServiceClient(ctx context.Context, in *CreateServiceClientRequest, opts ...grpc.CallOption) (*CreateServiceClientResponse, error)
+	// Rotates the secret of the service client and returns a new client secret if the operation is successful.
+	// The previous client secret remains valid during the grace period.
+	RotateServiceClientSecret(ctx context.Context, in *RotateServiceClientSecretRequest, opts ...grpc.CallOption) (*RotateServiceClientSecretResponse, error)
 	// Deletes a client by the specified client ID.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets a client by the specified client ID.
@@ -86,6 +94,24 @@ func (c *clientServiceClient) CreateMobileClient(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *clientServiceClient) CreateServiceClient(ctx context.Context, in *CreateServiceClientRequest, opts ...grpc.CallOption) (*CreateServiceClientResponse, error) {
+	out := new(CreateServiceClientResponse)
+	err := c.cc.Invoke(ctx, ClientService_CreateServiceClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) RotateServiceClientSecret(ctx context.Context, in *RotateServiceClientSecretRequest, opts ...grpc.CallOption) (*RotateServiceClientSecretResponse, error) {
+	out := new(RotateServiceClientSecretResponse)
+	err := c.cc.Invoke(ctx, ClientService_RotateServiceClientSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ClientService_Delete_FullMethodName, in, out, opts...)
@@ -130,6 +156,12 @@ type ClientServiceServer interface {
 	CreateWebClient(context.Context, *CreateWebClientRequest) (*CreateWebClientResponse, error)
 	// Creates a mobile client and returns the client ID if the operation is successful.
 	CreateMobileClient(context.Context, *CreateMobileClientRequest) (*CreateMobileClientResponse, error)
+	// Creates a service client and returns the client ID and the client secret if the operation is successful.
+	// The client secret is only returned once.
+	CreateServiceClient(context.Context, *CreateServiceClientRequest) (*CreateServiceClientResponse, error)
+	// Rotates the secret of the service client and returns a new client secret if the operation is successful.
+	// The previous client secret remains valid during the grace period.
+	RotateServiceClientSecret(context.Context, *RotateServiceClientSecretRequest) (*RotateServiceClientSecretResponse, error)
 	// Deletes a client by the specified client ID.
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
 	// Gets a client by the specified client ID.
@@ -151,6 +183,12 @@ func (UnimplementedClientServiceServer) CreateWebClient(context.Context, *Create
 func (UnimplementedClientServiceServer) CreateMobileClient(context.Context, *CreateMobileClientRequest) (*CreateMobileClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMobileClient not implemented")
 }
+func (UnimplementedClientServiceServer) CreateServiceClient(context.Context, *CreateServiceClientRequest) (*CreateServiceClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceClient not implemented")
+}
+func (UnimplementedClientServiceServer) RotateServiceClientSecret(context.Context, *RotateServiceClientSecretRequest) (*RotateServiceClientSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceClientSecret not implemented")
+}
 func (UnimplementedClientServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -212,6 +250,42 @@ func _ClientService_CreateMobileClient_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientService_CreateServiceClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).CreateServiceClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_CreateServiceClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).CreateServiceClient(ctx, req.(*CreateServiceClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_RotateServiceClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceClientSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).RotateServiceClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_RotateServiceClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).RotateServiceClientSecret(ctx, req.(*RotateServiceClientSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -299,6 +373,14 @@ var ClientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMobileClient",
 			Handler:    _ClientService_CreateMobileClient_Handler,
 		},
+		{
+			MethodName: "CreateServiceClient",
+			Handler:    _ClientService_CreateServiceClient_Handler,
+		},
+		{
+			MethodName: "RotateServiceClientSecret",
+			Handler:    _ClientService_RotateServiceClientSecret_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ClientService_Delete_Handler,
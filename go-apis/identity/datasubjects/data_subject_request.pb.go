@@ -0,0 +1,974 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.3
+// source: apis/identity/datasubjects/data_subject_request.proto
+
+package datasubjects
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The data subject request type.
+type DataSubjectRequestTypeEnum_DataSubjectRequestType int32
+
+const (
+	// Unspecified. Do not use.
+	DataSubjectRequestTypeEnum_UNSPECIFIED DataSubjectRequestTypeEnum_DataSubjectRequestType = 0
+	DataSubjectRequestTypeEnum_EXPORT      DataSubjectRequestTypeEnum_DataSubjectRequestType = 1
+	DataSubjectRequestTypeEnum_ERASURE     DataSubjectRequestTypeEnum_DataSubjectRequestType = 2
+)
+
+// Enum value maps for DataSubjectRequestTypeEnum_DataSubjectRequestType.
+var (
+	DataSubjectRequestTypeEnum_DataSubjectRequestType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "EXPORT",
+		2: "ERASURE",
+	}
+	DataSubjectRequestTypeEnum_DataSubjectRequestType_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"EXPORT":      1,
+		"ERASURE":     2,
+	}
+)
+
+func (x DataSubjectRequestTypeEnum_DataSubjectRequestType) Enum() *DataSubjectRequestTypeEnum_DataSubjectRequestType {
+	p := new(DataSubjectRequestTypeEnum_DataSubjectRequestType)
+	*p = x
+	return p
+}
+
+func (x DataSubjectRequestTypeEnum_DataSubjectRequestType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataSubjectRequestTypeEnum_DataSubjectRequestType) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[0].Descriptor()
+}
+
+func (DataSubjectRequestTypeEnum_DataSubjectRequestType) Type() protoreflect.EnumType {
+	return &file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[0]
+}
+
+func (x DataSubjectRequestTypeEnum_DataSubjectRequestType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataSubjectRequestTypeEnum_DataSubjectRequestType.Descriptor instead.
+func (DataSubjectRequestTypeEnum_DataSubjectRequestType) EnumDescriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// The data subject request status.
+type DataSubjectRequestStatusEnum_DataSubjectRequestStatus int32
+
+const (
+	// Unspecified. Do not use.
+	DataSubjectRequestStatusEnum_UNSPECIFIED DataSubjectRequestStatusEnum_DataSubjectRequestStatus = 0
+	DataSubjectRequestStatusEnum_NEW         DataSubjectRequestStatusEnum_DataSubjectRequestStatus = 1
+	DataSubjectRequestStatusEnum_IN_PROGRESS DataSubjectRequestStatusEnum_DataSubjectRequestStatus = 2
+	DataSubjectRequestStatusEnum_COMPLETED   DataSubjectRequestStatusEnum_DataSubjectRequestStatus = 3
+	DataSubjectRequestStatusEnum_FAILED      DataSubjectRequestStatusEnum_DataSubjectRequestStatus = 4
+)
+
+// Enum value maps for DataSubjectRequestStatusEnum_DataSubjectRequestStatus.
+var (
+	DataSubjectRequestStatusEnum_DataSubjectRequestStatus_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "NEW",
+		2: "IN_PROGRESS",
+		3: "COMPLETED",
+		4: "FAILED",
+	}
+	DataSubjectRequestStatusEnum_DataSubjectRequestStatus_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"NEW":         1,
+		"IN_PROGRESS": 2,
+		"COMPLETED":   3,
+		"FAILED":      4,
+	}
+)
+
+func (x DataSubjectRequestStatusEnum_DataSubjectRequestStatus) Enum() *DataSubjectRequestStatusEnum_DataSubjectRequestStatus {
+	p := new(DataSubjectRequestStatusEnum_DataSubjectRequestStatus)
+	*p = x
+	return p
+}
+
+func (x DataSubjectRequestStatusEnum_DataSubjectRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataSubjectRequestStatusEnum_DataSubjectRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[1].Descriptor()
+}
+
+func (DataSubjectRequestStatusEnum_DataSubjectRequestStatus) Type() protoreflect.EnumType {
+	return &file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[1]
+}
+
+func (x DataSubjectRequestStatusEnum_DataSubjectRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataSubjectRequestStatusEnum_DataSubjectRequestStatus.Descriptor instead.
+func (DataSubjectRequestStatusEnum_DataSubjectRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// The step of the data subject request.
+type DataSubjectRequestStepEnum_DataSubjectRequestStep int32
+
+const (
+	// Unspecified. Do not use.
+	DataSubjectRequestStepEnum_UNSPECIFIED DataSubjectRequestStepEnum_DataSubjectRequestStep = 0
+	// Export steps.
+	DataSubjectRequestStepEnum_EXPORT_USER                DataSubjectRequestStepEnum_DataSubjectRequestStep = 1
+	DataSubjectRequestStepEnum_EXPORT_PERSONAL_INFO       DataSubjectRequestStepEnum_DataSubjectRequestStep = 2
+	DataSubjectRequestStepEnum_EXPORT_USER_SESSIONS       DataSubjectRequestStepEnum_DataSubjectRequestStep = 3
+	DataSubjectRequestStepEnum_EXPORT_USER_AGENT_SESSIONS DataSubjectRequestStepEnum_DataSubjectRequestStep = 4
+	DataSubjectRequestStepEnum_EXPORT_USER_AGENTS         DataSubjectRequestStepEnum_DataSubjectRequestStep = 5
+	DataSubjectRequestStepEnum_EXPORT_ROLE_ASSIGNMENTS    DataSubjectRequestStepEnum_DataSubjectRequestStep = 6
+	DataSubjectRequestStepEnum_EXPORT_USER_GROUPS         DataSubjectRequestStepEnum_DataSubjectRequestStep = 7
+	DataSubjectRequestStepEnum_EXPORT_API_KEYS            DataSubjectRequestStepEnum_DataSubjectRequestStep = 8
+	// Erasure steps.
+	DataSubjectRequestStepEnum_REVOKE_SESSIONS DataSubjectRequestStepEnum_DataSubjectRequestStep = 101
+	DataSubjectRequestStepEnum_ANONYMIZE_USER  DataSubjectRequestStepEnum_DataSubjectRequestStep = 102
+	DataSubjectRequestStepEnum_DELETE_USER     DataSubjectRequestStepEnum_DataSubjectRequestStep = 103
+	DataSubjectRequestStepEnum_REVOKE_API_KEYS DataSubjectRequestStepEnum_DataSubjectRequestStep = 104
+)
+
+// Enum value maps for DataSubjectRequestStepEnum_DataSubjectRequestStep.
+var (
+	DataSubjectRequestStepEnum_DataSubjectRequestStep_name = map[int32]string{
+		0:   "UNSPECIFIED",
+		1:   "EXPORT_USER",
+		2:   "EXPORT_PERSONAL_INFO",
+		3:   "EXPORT_USER_SESSIONS",
+		4:   "EXPORT_USER_AGENT_SESSIONS",
+		5:   "EXPORT_USER_AGENTS",
+		6:   "EXPORT_ROLE_ASSIGNMENTS",
+		7:   "EXPORT_USER_GROUPS",
+		8:   "EXPORT_API_KEYS",
+		101: "REVOKE_SESSIONS",
+		102: "ANONYMIZE_USER",
+		103: "DELETE_USER",
+		104: "REVOKE_API_KEYS",
+	}
+	DataSubjectRequestStepEnum_DataSubjectRequestStep_value = map[string]int32{
+		"UNSPECIFIED":                0,
+		"EXPORT_USER":                1,
+		"EXPORT_PERSONAL_INFO":       2,
+		"EXPORT_USER_SESSIONS":       3,
+		"EXPORT_USER_AGENT_SESSIONS": 4,
+		"EXPORT_USER_AGENTS":         5,
+		"EXPORT_ROLE_ASSIGNMENTS":    6,
+		"EXPORT_USER_GROUPS":         7,
+		"EXPORT_API_KEYS":            8,
+		"REVOKE_SESSIONS":            101,
+		"ANONYMIZE_USER":             102,
+		"DELETE_USER":                103,
+		"REVOKE_API_KEYS":            104,
+	}
+)
+
+func (x DataSubjectRequestStepEnum_DataSubjectRequestStep) Enum() *DataSubjectRequestStepEnum_DataSubjectRequestStep {
+	p := new(DataSubjectRequestStepEnum_DataSubjectRequestStep)
+	*p = x
+	return p
+}
+
+func (x DataSubjectRequestStepEnum_DataSubjectRequestStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataSubjectRequestStepEnum_DataSubjectRequestStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[2].Descriptor()
+}
+
+func (DataSubjectRequestStepEnum_DataSubjectRequestStep) Type() protoreflect.EnumType {
+	return &file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[2]
+}
+
+func (x DataSubjectRequestStepEnum_DataSubjectRequestStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataSubjectRequestStepEnum_DataSubjectRequestStep.Descriptor instead.
+func (DataSubjectRequestStepEnum_DataSubjectRequestStep) EnumDescriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// The status of the data subject request step.
+type DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus int32
+
+const (
+	// Unspecified. Do not use.
+	DataSubjectRequestStepStatusEnum_UNSPECIFIED DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus = 0
+	DataSubjectRequestStepStatusEnum_PENDING     DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus = 1
+	DataSubjectRequestStepStatusEnum_IN_PROGRESS DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus = 2
+	DataSubjectRequestStepStatusEnum_COMPLETED   DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus = 3
+	DataSubjectRequestStepStatusEnum_FAILED      DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus = 4
+)
+
+// Enum value maps for DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus.
+var (
+	DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "PENDING",
+		2: "IN_PROGRESS",
+		3: "COMPLETED",
+		4: "FAILED",
+	}
+	DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"PENDING":     1,
+		"IN_PROGRESS": 2,
+		"COMPLETED":   3,
+		"FAILED":      4,
+	}
+)
+
+func (x DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) Enum() *DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus {
+	p := new(DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus)
+	*p = x
+	return p
+}
+
+func (x DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[3].Descriptor()
+}
+
+func (DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) Type() protoreflect.EnumType {
+	return &file_apis_identity_datasubjects_data_subject_request_proto_enumTypes[3]
+}
+
+func (x DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus.Descriptor instead.
+func (DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// The data subject request (export or erasure of the user's personal data).
+type DataSubjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID to identify the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user (data subject) whose data the request concerns.
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The request type.
+	Type DataSubjectRequestTypeEnum_DataSubjectRequestType `protobuf:"varint,3,opt,name=type,proto3,enum=personalwebsite.identity.datasubjects.DataSubjectRequestTypeEnum_DataSubjectRequestType" json:"type,omitempty"`
+	// It stores the date and time at which the request was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The user ID to identify the creator of the request.
+	CreatedBy uint64 `protobuf:"varint,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// It stores the date and time at which the request was updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The user ID to identify the user who updated the request.
+	UpdatedBy uint64 `protobuf:"varint,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// The request status.
+	Status DataSubjectRequestStatusEnum_DataSubjectRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=personalwebsite.identity.datasubjects.DataSubjectRequestStatusEnum_DataSubjectRequestStatus" json:"status,omitempty"`
+	// It stores the date and time at which the request status was updated.
+	StatusUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
+	// The user ID to identify the user who updated the request status.
+	StatusUpdatedBy uint64 `protobuf:"varint,10,opt,name=status_updated_by,json=statusUpdatedBy,proto3" json:"status_updated_by,omitempty"`
+	// Optional. The request status comment.
+	StatusComment *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=status_comment,json=statusComment,proto3" json:"status_comment,omitempty"`
+	// Optional. It stores the date and time at which the request was completed.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *DataSubjectRequest) Reset() {
+	*x = DataSubjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequest) ProtoMessage() {}
+
+func (x *DataSubjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequest.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataSubjectRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DataSubjectRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DataSubjectRequest) GetType() DataSubjectRequestTypeEnum_DataSubjectRequestType {
+	if x != nil {
+		return x.Type
+	}
+	return DataSubjectRequestTypeEnum_UNSPECIFIED
+}
+
+func (x *DataSubjectRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataSubjectRequest) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *DataSubjectRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DataSubjectRequest) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *DataSubjectRequest) GetStatus() DataSubjectRequestStatusEnum_DataSubjectRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DataSubjectRequestStatusEnum_UNSPECIFIED
+}
+
+func (x *DataSubjectRequest) GetStatusUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusUpdatedAt
+	}
+	return nil
+}
+
+func (x *DataSubjectRequest) GetStatusUpdatedBy() uint64 {
+	if x != nil {
+		return x.StatusUpdatedBy
+	}
+	return 0
+}
+
+func (x *DataSubjectRequest) GetStatusComment() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StatusComment
+	}
+	return nil
+}
+
+func (x *DataSubjectRequest) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// The step of the data subject request.
+type DataSubjectRequestStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The step.
+	Step DataSubjectRequestStepEnum_DataSubjectRequestStep `protobuf:"varint,1,opt,name=step,proto3,enum=personalwebsite.identity.datasubjects.DataSubjectRequestStepEnum_DataSubjectRequestStep" json:"step,omitempty"`
+	// The step status.
+	Status DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus `protobuf:"varint,2,opt,name=status,proto3,enum=personalwebsite.identity.datasubjects.DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus" json:"status,omitempty"`
+	// Optional. The ID (UUID) of the action within which the step was (is being) performed.
+	ActionId *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	// Optional. It stores the date and time at which the step was started.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Optional. It stores the date and time at which the step was completed or failed.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Optional. The error message if the step failed.
+	ErrorMessage *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *DataSubjectRequestStep) Reset() {
+	*x = DataSubjectRequestStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequestStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequestStep) ProtoMessage() {}
+
+func (x *DataSubjectRequestStep) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequestStep.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequestStep) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DataSubjectRequestStep) GetStep() DataSubjectRequestStepEnum_DataSubjectRequestStep {
+	if x != nil {
+		return x.Step
+	}
+	return DataSubjectRequestStepEnum_UNSPECIFIED
+}
+
+func (x *DataSubjectRequestStep) GetStatus() DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DataSubjectRequestStepStatusEnum_UNSPECIFIED
+}
+
+func (x *DataSubjectRequestStep) GetActionId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ActionId
+	}
+	return nil
+}
+
+func (x *DataSubjectRequestStep) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *DataSubjectRequestStep) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataSubjectRequestStep) GetErrorMessage() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// Container for enum describing the data subject request type.
+type DataSubjectRequestTypeEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DataSubjectRequestTypeEnum) Reset() {
+	*x = DataSubjectRequestTypeEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequestTypeEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequestTypeEnum) ProtoMessage() {}
+
+func (x *DataSubjectRequestTypeEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequestTypeEnum.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequestTypeEnum) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{2}
+}
+
+// Container for enum describing the data subject request status.
+type DataSubjectRequestStatusEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DataSubjectRequestStatusEnum) Reset() {
+	*x = DataSubjectRequestStatusEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequestStatusEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequestStatusEnum) ProtoMessage() {}
+
+func (x *DataSubjectRequestStatusEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequestStatusEnum.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequestStatusEnum) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{3}
+}
+
+// Container for enum describing the step of the data subject request.
+type DataSubjectRequestStepEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DataSubjectRequestStepEnum) Reset() {
+	*x = DataSubjectRequestStepEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequestStepEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequestStepEnum) ProtoMessage() {}
+
+func (x *DataSubjectRequestStepEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequestStepEnum.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequestStepEnum) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{4}
+}
+
+// Container for enum describing the status of the data subject request step.
+type DataSubjectRequestStepStatusEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DataSubjectRequestStepStatusEnum) Reset() {
+	*x = DataSubjectRequestStepStatusEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSubjectRequestStepStatusEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSubjectRequestStepStatusEnum) ProtoMessage() {}
+
+func (x *DataSubjectRequestStepStatusEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSubjectRequestStepStatusEnum.ProtoReflect.Descriptor instead.
+func (*DataSubjectRequestStepStatusEnum) Descriptor() ([]byte, []int) {
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP(), []int{5}
+}
+
+var File_apis_identity_datasubjects_data_subject_request_proto protoreflect.FileDescriptor
+
+var file_apis_identity_datasubjects_data_subject_request_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xcd, 0x05, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x6c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x58, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x74, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x5c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73,
+	0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xfc, 0x03, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x6c, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x58, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x7c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x64, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60,
+	0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x22, 0x42, 0x0a, 0x16,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x22, 0x80, 0x01, 0x0a, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75,
+	0x6d, 0x22, 0x60, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0xde, 0x02, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e,
+	0x75, 0x6d, 0x22, 0xbf, 0x02, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e,
+	0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x53, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x53, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47,
+	0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x07,
+	0x12, 0x13, 0x0a, 0x0f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x53, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f,
+	0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4e,
+	0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x5a, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x66, 0x12, 0x0f,
+	0x0a, 0x0b, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x67, 0x12,
+	0x13, 0x0a, 0x0f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x53, 0x10, 0x68, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x22, 0x68, 0x0a, 0x1c, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x42, 0x40, 0x5a, 0x3e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apis_identity_datasubjects_data_subject_request_proto_rawDescOnce sync.Once
+	file_apis_identity_datasubjects_data_subject_request_proto_rawDescData = file_apis_identity_datasubjects_data_subject_request_proto_rawDesc
+)
+
+func file_apis_identity_datasubjects_data_subject_request_proto_rawDescGZIP() []byte {
+	file_apis_identity_datasubjects_data_subject_request_proto_rawDescOnce.Do(func() {
+		file_apis_identity_datasubjects_data_subject_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_identity_datasubjects_data_subject_request_proto_rawDescData)
+	})
+	return file_apis_identity_datasubjects_data_subject_request_proto_rawDescData
+}
+
+var file_apis_identity_datasubjects_data_subject_request_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_apis_identity_datasubjects_data_subject_request_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apis_identity_datasubjects_data_subject_request_proto_goTypes = []interface{}{
+	(DataSubjectRequestTypeEnum_DataSubjectRequestType)(0),             // 0: personalwebsite.identity.datasubjects.DataSubjectRequestTypeEnum.DataSubjectRequestType
+	(DataSubjectRequestStatusEnum_DataSubjectRequestStatus)(0),         // 1: personalwebsite.identity.datasubjects.DataSubjectRequestStatusEnum.DataSubjectRequestStatus
+	(DataSubjectRequestStepEnum_DataSubjectRequestStep)(0),             // 2: personalwebsite.identity.datasubjects.DataSubjectRequestStepEnum.DataSubjectRequestStep
+	(DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus)(0), // 3: personalwebsite.identity.datasubjects.DataSubjectRequestStepStatusEnum.DataSubjectRequestStepStatus
+	(*DataSubjectRequest)(nil),                                         // 4: personalwebsite.identity.datasubjects.DataSubjectRequest
+	(*DataSubjectRequestStep)(nil),                                     // 5: personalwebsite.identity.datasubjects.DataSubjectRequestStep
+	(*DataSubjectRequestTypeEnum)(nil),                                 // 6: personalwebsite.identity.datasubjects.DataSubjectRequestTypeEnum
+	(*DataSubjectRequestStatusEnum)(nil),                               // 7: personalwebsite.identity.datasubjects.DataSubjectRequestStatusEnum
+	(*DataSubjectRequestStepEnum)(nil),                                 // 8: personalwebsite.identity.datasubjects.DataSubjectRequestStepEnum
+	(*DataSubjectRequestStepStatusEnum)(nil),                           // 9: personalwebsite.identity.datasubjects.DataSubjectRequestStepStatusEnum
+	(*timestamppb.Timestamp)(nil),                                      // 10: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),                                     // 11: google.protobuf.StringValue
+}
+var file_apis_identity_datasubjects_data_subject_request_proto_depIdxs = []int32{
+	0,  // 0: personalwebsite.identity.datasubjects.DataSubjectRequest.type:type_name -> personalwebsite.identity.datasubjects.DataSubjectRequestTypeEnum.DataSubjectRequestType
+	10, // 1: personalwebsite.identity.datasubjects.DataSubjectRequest.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: personalwebsite.identity.datasubjects.DataSubjectRequest.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: personalwebsite.identity.datasubjects.DataSubjectRequest.status:type_name -> personalwebsite.identity.datasubjects.DataSubjectRequestStatusEnum.DataSubjectRequestStatus
+	10, // 4: personalwebsite.identity.datasubjects.DataSubjectRequest.status_updated_at:type_name -> google.protobuf.Timestamp
+	11, // 5: personalwebsite.identity.datasubjects.DataSubjectRequest.status_comment:type_name -> google.protobuf.StringValue
+	10, // 6: personalwebsite.identity.datasubjects.DataSubjectRequest.completed_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: personalwebsite.identity.datasubjects.DataSubjectRequestStep.step:type_name -> personalwebsite.identity.datasubjects.DataSubjectRequestStepEnum.DataSubjectRequestStep
+	3,  // 8: personalwebsite.identity.datasubjects.DataSubjectRequestStep.status:type_name -> personalwebsite.identity.datasubjects.DataSubjectRequestStepStatusEnum.DataSubjectRequestStepStatus
+	11, // 9: personalwebsite.identity.datasubjects.DataSubjectRequestStep.action_id:type_name -> google.protobuf.StringValue
+	10, // 10: personalwebsite.identity.datasubjects.DataSubjectRequestStep.started_at:type_name -> google.protobuf.Timestamp
+	10, // 11: personalwebsite.identity.datasubjects.DataSubjectRequestStep.completed_at:type_name -> google.protobuf.Timestamp
+	11, // 12: personalwebsite.identity.datasubjects.DataSubjectRequestStep.error_message:type_name -> google.protobuf.StringValue
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_apis_identity_datasubjects_data_subject_request_proto_init() }
+func file_apis_identity_datasubjects_data_subject_request_proto_init() {
+	if File_apis_identity_datasubjects_data_subject_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequestStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequestTypeEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequestStatusEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequestStepEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_datasubjects_data_subject_request_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSubjectRequestStepStatusEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apis_identity_datasubjects_data_subject_request_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apis_identity_datasubjects_data_subject_request_proto_goTypes,
+		DependencyIndexes: file_apis_identity_datasubjects_data_subject_request_proto_depIdxs,
+		EnumInfos:         file_apis_identity_datasubjects_data_subject_request_proto_enumTypes,
+		MessageInfos:      file_apis_identity_datasubjects_data_subject_request_proto_msgTypes,
+	}.Build()
+	File_apis_identity_datasubjects_data_subject_request_proto = out.File
+	file_apis_identity_datasubjects_data_subject_request_proto_rawDesc = nil
+	file_apis_identity_datasubjects_data_subject_request_proto_goTypes = nil
+	file_apis_identity_datasubjects_data_subject_request_proto_depIdxs = nil
+}
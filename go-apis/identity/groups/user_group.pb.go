@@ -35,6 +35,7 @@ const (
 )
 
 // The user's group.
+// Only the built-in groups are listed; the IDs of the groups created by users start from 1001.
 type UserGroup int32
 
 const (
@@ -34,15 +34,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RolePermissionService_Grant_FullMethodName                       = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Grant"
-	RolePermissionService_Revoke_FullMethodName                      = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Revoke"
-	RolePermissionService_RevokeAll_FullMethodName                   = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/RevokeAll"
-	RolePermissionService_RevokeFromAll_FullMethodName               = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/RevokeFromAll"
-	RolePermissionService_Update_FullMethodName                      = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Update"
-	RolePermissionService_IsGranted_FullMethodName                   = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/IsGranted"
-	RolePermissionService_AreGranted_FullMethodName                  = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/AreGranted"
-	RolePermissionService_GetAllPermissionIdsByRoleId_FullMethodName = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/GetAllPermissionIdsByRoleId"
-	RolePermissionService_GetAllRoleIdsByPermissionId_FullMethodName = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/GetAllRoleIdsByPermissionId"
+	RolePermissionService_Grant_FullMethodName                                = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Grant"
+	RolePermissionService_Revoke_FullMethodName                               = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Revoke"
+	RolePermissionService_RevokeAll_FullMethodName                            = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/RevokeAll"
+	RolePermissionService_RevokeFromAll_FullMethodName                        = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/RevokeFromAll"
+	RolePermissionService_Update_FullMethodName                               = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/Update"
+	RolePermissionService_IsGranted_FullMethodName                            = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/IsGranted"
+	RolePermissionService_AreGranted_FullMethodName                           = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/AreGranted"
+	RolePermissionService_GetAllPermissionIdsByRoleId_FullMethodName          = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/GetAllPermissionIdsByRoleId"
+	RolePermissionService_GetAllRoleIdsByPermissionId_FullMethodName          = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/GetAllRoleIdsByPermissionId"
+	RolePermissionService_GetAllEffectivePermissionIdsByRoleId_FullMethodName = "/personalwebsite.identity.permissions.rolepermissions.RolePermissionService/GetAllEffectivePermissionIdsByRoleId"
 )
 
 // RolePermissionServiceClient is the client API for RolePermissionService service.
@@ -65,8 +66,12 @@ type RolePermissionServiceClient interface {
 	AreGranted(ctx context.Context, in *AreGrantedRequest, opts ...grpc.CallOption) (*AreGrantedResponse, error)
 	// Gets all IDs of the permissions granted to the role by the specified role ID.
 	GetAllPermissionIdsByRoleId(ctx context.Context, in *GetAllPermissionIdsByRoleIdRequest, opts ...grpc.CallOption) (*GetAllPermissionIdsByRoleIdResponse, error)
-	// Gets all IDs of the roles that are granted the specified permission.
+	// Gets all IDs of the roles that are granted the specified permission,
+	// including the roles that inherit the permission from their parent roles.
 	GetAllRoleIdsByPermissionId(ctx context.Context, in *GetAllRoleIdsByPermissionIdRequest, opts ...grpc.CallOption) (*GetAllRoleIdsByPermissionIdResponse, error)
+	// Gets all IDs of the permissions granted to the role and the permissions inherited
+	// from its parent roles by the specified role ID.
+	GetAllEffectivePermissionIdsByRoleId(ctx context.Context, in *GetAllEffectivePermissionIdsByRoleIdRequest, opts ...grpc.CallOption) (*GetAllEffectivePermissionIdsByRoleIdResponse, error)
 }
 
 type rolePermissionServiceClient struct {
@@ -158,6 +163,15 @@ func (c *rolePermissionServiceClient) GetAllRoleIdsByPermissionId(ctx context.Co
 	return out, nil
 }
 
+func (c *rolePermissionServiceClient) GetAllEffectivePermissionIdsByRoleId(ctx context.Context, in *GetAllEffectivePermissionIdsByRoleIdRequest, opts ...grpc.CallOption) (*GetAllEffectivePermissionIdsByRoleIdResponse, error) {
+	out := new(GetAllEffectivePermissionIdsByRoleIdResponse)
+	err := c.cc.Invoke(ctx, RolePermissionService_GetAllEffectivePermissionIdsByRoleId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RolePermissionServiceServer is the server API for RolePermissionService service.
 // All implementations must embed UnimplementedRolePermissionServiceServer
 // for forward compatibility
@@ -178,8 +192,12 @@ type RolePermissionServiceServer interface {
 	AreGranted(context.Context, *AreGrantedRequest) (*AreGrantedResponse, error)
 	// Gets all IDs of the permissions granted to the role by the specified role ID.
 	GetAllPermissionIdsByRoleId(context.Context, *GetAllPermissionIdsByRoleIdRequest) (*GetAllPermissionIdsByRoleIdResponse, error)
-	// Gets all IDs of the roles that are granted the specified permission.
+	// Gets all IDs of the roles that are granted the specified permission,
+	// including the roles that inherit the permission from their parent roles.
 	GetAllRoleIdsByPermissionId(context.Context, *GetAllRoleIdsByPermissionIdRequest) (*GetAllRoleIdsByPermissionIdResponse, error)
+	// Gets all IDs of the permissions granted to the role and the permissions inherited
+	// from its parent roles by the specified role ID.
+	GetAllEffectivePermissionIdsByRoleId(context.Context, *GetAllEffectivePermissionIdsByRoleIdRequest) (*GetAllEffectivePermissionIdsByRoleIdResponse, error)
 	mustEmbedUnimplementedRolePermissionServiceServer()
 }
 
@@ -214,6 +232,9 @@ func (UnimplementedRolePermissionServiceServer) GetAllPermissionIdsByRoleId(cont
 func (UnimplementedRolePermissionServiceServer) GetAllRoleIdsByPermissionId(context.Context, *GetAllRoleIdsByPermissionIdRequest) (*GetAllRoleIdsByPermissionIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllRoleIdsByPermissionId not implemented")
 }
+func (UnimplementedRolePermissionServiceServer) GetAllEffectivePermissionIdsByRoleId(context.Context, *GetAllEffectivePermissionIdsByRoleIdRequest) (*GetAllEffectivePermissionIdsByRoleIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEffectivePermissionIdsByRoleId not implemented")
+}
 func (UnimplementedRolePermissionServiceServer) mustEmbedUnimplementedRolePermissionServiceServer() {}
 
 // UnsafeRolePermissionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -389,6 +410,24 @@ func _RolePermissionService_GetAllRoleIdsByPermissionId_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolePermissionService_GetAllEffectivePermissionIdsByRoleId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEffectivePermissionIdsByRoleIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolePermissionServiceServer).GetAllEffectivePermissionIdsByRoleId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolePermissionService_GetAllEffectivePermissionIdsByRoleId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolePermissionServiceServer).GetAllEffectivePermissionIdsByRoleId(ctx, req.(*GetAllEffectivePermissionIdsByRoleIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RolePermissionService_ServiceDesc is the grpc.ServiceDesc for RolePermissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,6 +471,10 @@ var RolePermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllRoleIdsByPermissionId",
 			Handler:    _RolePermissionService_GetAllRoleIdsByPermissionId_Handler,
 		},
+		{
+			MethodName: "GetAllEffectivePermissionIdsByRoleId",
+			Handler:    _RolePermissionService_GetAllEffectivePermissionIdsByRoleId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/identity/permissions/rolepermissions/role_permission_service.proto",
@@ -23,7 +23,9 @@ package assignments
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -44,13 +46,19 @@ type CreateRequest struct {
 
 	// The role ID.
 	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	// The unique ID of the entity the role is assigned to - either the userId of a user
-	// or the groupId of a group.
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
 	AssignedTo uint64 `protobuf:"varint,2,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
 	// The type of the assignee.
 	AssigneeType AssigneeTypeEnum_AssigneeType `protobuf:"varint,3,opt,name=assignee_type,json=assigneeType,proto3,enum=personalwebsite.identity.roles.assignments.AssigneeTypeEnum_AssigneeType" json:"assignee_type,omitempty"`
 	// Optional. The role assignment description.
 	Description *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional. The date and time from which the role assignment is valid.
+	// If it isn't specified, then the role assignment is valid immediately.
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Optional. The date and time until which the role assignment is valid.
+	// If it isn't specified, then the role assignment is permanent.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 }
 
 func (x *CreateRequest) Reset() {
@@ -113,6 +121,20 @@ func (x *CreateRequest) GetDescription() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *CreateRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
 // Response message for 'RoleAssignmentService.Create'.
 type CreateResponse struct {
 	state         protoimpl.MessageState
@@ -211,6 +233,111 @@ func (x *DeleteRequest) GetId() uint64 {
 	return 0
 }
 
+type ExtendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role assignment ID.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The new date and time until which the role assignment is valid.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+}
+
+func (x *ExtendRequest) Reset() {
+	*x = ExtendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendRequest) ProtoMessage() {}
+
+func (x *ExtendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendRequest.ProtoReflect.Descriptor instead.
+func (*ExtendRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExtendRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExtendRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type RevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role assignment ID.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeRequest) Reset() {
+	*x = RevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRequest) ProtoMessage() {}
+
+func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 // Request message for 'RoleAssignmentService.GetById'.
 type GetByIdRequest struct {
 	state         protoimpl.MessageState
@@ -224,7 +351,7 @@ type GetByIdRequest struct {
 func (x *GetByIdRequest) Reset() {
 	*x = GetByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[3]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +364,7 @@ func (x *GetByIdRequest) String() string {
 func (*GetByIdRequest) ProtoMessage() {}
 
 func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[3]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +377,7 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{3}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetByIdRequest) GetId() uint64 {
@@ -273,7 +400,7 @@ type GetByIdResponse struct {
 func (x *GetByIdResponse) Reset() {
 	*x = GetByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[4]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -286,7 +413,7 @@ func (x *GetByIdResponse) String() string {
 func (*GetByIdResponse) ProtoMessage() {}
 
 func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[4]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +426,7 @@ func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{4}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetByIdResponse) GetAssignment() *RoleAssignment {
@@ -326,7 +453,7 @@ type GetByRoleIdAndAssigneeRequest struct {
 func (x *GetByRoleIdAndAssigneeRequest) Reset() {
 	*x = GetByRoleIdAndAssigneeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[5]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +466,7 @@ func (x *GetByRoleIdAndAssigneeRequest) String() string {
 func (*GetByRoleIdAndAssigneeRequest) ProtoMessage() {}
 
 func (x *GetByRoleIdAndAssigneeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[5]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +479,7 @@ func (x *GetByRoleIdAndAssigneeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByRoleIdAndAssigneeRequest.ProtoReflect.Descriptor instead.
 func (*GetByRoleIdAndAssigneeRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{5}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetByRoleIdAndAssigneeRequest) GetRoleId() uint64 {
@@ -389,7 +516,7 @@ type GetByRoleIdAndAssigneeResponse struct {
 func (x *GetByRoleIdAndAssigneeResponse) Reset() {
 	*x = GetByRoleIdAndAssigneeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[6]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -402,7 +529,7 @@ func (x *GetByRoleIdAndAssigneeResponse) String() string {
 func (*GetByRoleIdAndAssigneeResponse) ProtoMessage() {}
 
 func (x *GetByRoleIdAndAssigneeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[6]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +542,7 @@ func (x *GetByRoleIdAndAssigneeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByRoleIdAndAssigneeResponse.ProtoReflect.Descriptor instead.
 func (*GetByRoleIdAndAssigneeResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{6}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetByRoleIdAndAssigneeResponse) GetAssignment() *RoleAssignment {
@@ -442,7 +569,7 @@ type ExistsRequest struct {
 func (x *ExistsRequest) Reset() {
 	*x = ExistsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[7]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -455,7 +582,7 @@ func (x *ExistsRequest) String() string {
 func (*ExistsRequest) ProtoMessage() {}
 
 func (x *ExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[7]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +595,7 @@ func (x *ExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExistsRequest.ProtoReflect.Descriptor instead.
 func (*ExistsRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{7}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExistsRequest) GetRoleId() uint64 {
@@ -505,7 +632,7 @@ type ExistsResponse struct {
 func (x *ExistsResponse) Reset() {
 	*x = ExistsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[8]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +645,7 @@ func (x *ExistsResponse) String() string {
 func (*ExistsResponse) ProtoMessage() {}
 
 func (x *ExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[8]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +658,7 @@ func (x *ExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExistsResponse.ProtoReflect.Descriptor instead.
 func (*ExistsResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{8}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExistsResponse) GetExists() bool {
@@ -558,7 +685,7 @@ type IsAssignedRequest struct {
 func (x *IsAssignedRequest) Reset() {
 	*x = IsAssignedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[9]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -571,7 +698,7 @@ func (x *IsAssignedRequest) String() string {
 func (*IsAssignedRequest) ProtoMessage() {}
 
 func (x *IsAssignedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[9]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +711,7 @@ func (x *IsAssignedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsAssignedRequest.ProtoReflect.Descriptor instead.
 func (*IsAssignedRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{9}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *IsAssignedRequest) GetRoleId() uint64 {
@@ -620,7 +747,7 @@ type IsAssignedResponse struct {
 func (x *IsAssignedResponse) Reset() {
 	*x = IsAssignedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[10]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +760,7 @@ func (x *IsAssignedResponse) String() string {
 func (*IsAssignedResponse) ProtoMessage() {}
 
 func (x *IsAssignedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[10]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +773,7 @@ func (x *IsAssignedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsAssignedResponse.ProtoReflect.Descriptor instead.
 func (*IsAssignedResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{10}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IsAssignedResponse) GetIsAssigned() bool {
@@ -669,7 +796,7 @@ type GetAssigneeTypeByIdRequest struct {
 func (x *GetAssigneeTypeByIdRequest) Reset() {
 	*x = GetAssigneeTypeByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[11]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +809,7 @@ func (x *GetAssigneeTypeByIdRequest) String() string {
 func (*GetAssigneeTypeByIdRequest) ProtoMessage() {}
 
 func (x *GetAssigneeTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[11]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +822,7 @@ func (x *GetAssigneeTypeByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssigneeTypeByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetAssigneeTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{11}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAssigneeTypeByIdRequest) GetId() uint64 {
@@ -718,7 +845,7 @@ type GetAssigneeTypeByIdResponse struct {
 func (x *GetAssigneeTypeByIdResponse) Reset() {
 	*x = GetAssigneeTypeByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[12]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +858,7 @@ func (x *GetAssigneeTypeByIdResponse) String() string {
 func (*GetAssigneeTypeByIdResponse) ProtoMessage() {}
 
 func (x *GetAssigneeTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[12]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +871,7 @@ func (x *GetAssigneeTypeByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssigneeTypeByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetAssigneeTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{12}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAssigneeTypeByIdResponse) GetAssigneeType() AssigneeTypeEnum_AssigneeType {
@@ -767,7 +894,7 @@ type GetStatusByIdRequest struct {
 func (x *GetStatusByIdRequest) Reset() {
 	*x = GetStatusByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[13]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +907,7 @@ func (x *GetStatusByIdRequest) String() string {
 func (*GetStatusByIdRequest) ProtoMessage() {}
 
 func (x *GetStatusByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[13]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +920,7 @@ func (x *GetStatusByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetStatusByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{13}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetStatusByIdRequest) GetId() uint64 {
@@ -816,7 +943,7 @@ type GetStatusByIdResponse struct {
 func (x *GetStatusByIdResponse) Reset() {
 	*x = GetStatusByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[14]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -829,7 +956,7 @@ func (x *GetStatusByIdResponse) String() string {
 func (*GetStatusByIdResponse) ProtoMessage() {}
 
 func (x *GetStatusByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[14]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +969,7 @@ func (x *GetStatusByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStatusByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{14}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetStatusByIdResponse) GetStatus() RoleAssignmentStatusEnum_RoleAssignmentStatus {
@@ -865,7 +992,7 @@ type GetRoleIdAndAssigneeByIdRequest struct {
 func (x *GetRoleIdAndAssigneeByIdRequest) Reset() {
 	*x = GetRoleIdAndAssigneeByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[15]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -878,7 +1005,7 @@ func (x *GetRoleIdAndAssigneeByIdRequest) String() string {
 func (*GetRoleIdAndAssigneeByIdRequest) ProtoMessage() {}
 
 func (x *GetRoleIdAndAssigneeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[15]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +1018,7 @@ func (x *GetRoleIdAndAssigneeByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleIdAndAssigneeByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetRoleIdAndAssigneeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{15}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetRoleIdAndAssigneeByIdRequest) GetId() uint64 {
@@ -918,7 +1045,7 @@ type GetRoleIdAndAssigneeByIdResponse struct {
 func (x *GetRoleIdAndAssigneeByIdResponse) Reset() {
 	*x = GetRoleIdAndAssigneeByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[16]
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1058,7 @@ func (x *GetRoleIdAndAssigneeByIdResponse) String() string {
 func (*GetRoleIdAndAssigneeByIdResponse) ProtoMessage() {}
 
 func (x *GetRoleIdAndAssigneeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[16]
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1071,7 @@ func (x *GetRoleIdAndAssigneeByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleIdAndAssigneeByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetRoleIdAndAssigneeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{16}
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetRoleIdAndAssigneeByIdResponse) GetRoleId() uint64 {
@@ -968,6 +1095,102 @@ func (x *GetRoleIdAndAssigneeByIdResponse) GetAssigneeType() AssigneeTypeEnum_As
 	return AssigneeTypeEnum_UNSPECIFIED
 }
 
+type GetAllExpiringWithinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period within which the validity period of role assignments ends.
+	Period *durationpb.Duration `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *GetAllExpiringWithinRequest) Reset() {
+	*x = GetAllExpiringWithinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllExpiringWithinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpiringWithinRequest) ProtoMessage() {}
+
+func (x *GetAllExpiringWithinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpiringWithinRequest.ProtoReflect.Descriptor instead.
+func (*GetAllExpiringWithinRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAllExpiringWithinRequest) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type GetAllExpiringWithinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role assignments.
+	Assignments []*RoleAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *GetAllExpiringWithinResponse) Reset() {
+	*x = GetAllExpiringWithinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllExpiringWithinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpiringWithinResponse) ProtoMessage() {}
+
+func (x *GetAllExpiringWithinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpiringWithinResponse.ProtoReflect.Descriptor instead.
+func (*GetAllExpiringWithinResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAllExpiringWithinResponse) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
 var File_apis_identity_roles_assignments_role_assignment_service_proto protoreflect.FileDescriptor
 
 var file_apis_identity_roles_assignments_role_assignment_service_proto_rawDesc = []byte{
@@ -977,223 +1200,278 @@ var file_apis_identity_roles_assignments_role_assignment_service_proto_rawDesc =
 	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x2a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
 	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xf9, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x6e, 0x0a, 0x0d, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a,
-	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x6d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0xc9, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
-	0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
-	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f,
-	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22,
-	0xbd, 0x01, 0x0a, 0x11, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12,
-	0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x35, 0x0a, 0x12, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x61, 0x70, 0x69, 0x73, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf1, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x6e, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x22, 0x20, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x28, 0x0a,
+	0x0e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x11, 0x49, 0x73, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x49, 0x73, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x2c,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x59,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e,
+	0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x50, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x32, 0xb3, 0x0d, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x65,
 	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x59, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x06, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x5d, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x84,
+	0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x3a, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
 	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
 	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcc, 0x01, 0x0a,
-	0x20, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x32, 0xc7, 0x0a, 0x0a, 0x15,
-	0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69,
+	0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xb1, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
+	0x12, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69,
 	0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70, 0x65,
+	0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4a, 0x2e, 0x70, 0x65,
 	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x06, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72,
-	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x42, 0x79, 0x49, 0x64, 0x12, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
 	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
 	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69,
-	0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0xb1, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x49, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c,
-	0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x73, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01,
+	0x0a, 0x0a, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x3d, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa8, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x46, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
 	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e,
-	0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x39,
+	0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x47, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x12, 0x40, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0xb7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x4b,
 	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
 	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x49, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12,
-	0x46, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73,
-	0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x47, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x79, 0x49, 0x64, 0x12, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4c, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xab, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x12, 0x47, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77,
 	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
 	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xb7, 0x01, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x4b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x44, 0x5a, 0x42, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x2d, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d,
-	0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3b,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x44, 0x5a, 0x42, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32,
+	0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x3b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1208,65 +1486,82 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescG
 	return file_apis_identity_roles_assignments_role_assignment_service_proto_rawDescData
 }
 
-var file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_apis_identity_roles_assignments_role_assignment_service_proto_goTypes = []interface{}{
 	(*CreateRequest)(nil),                              // 0: personalwebsite.identity.roles.assignments.CreateRequest
 	(*CreateResponse)(nil),                             // 1: personalwebsite.identity.roles.assignments.CreateResponse
 	(*DeleteRequest)(nil),                              // 2: personalwebsite.identity.roles.assignments.DeleteRequest
-	(*GetByIdRequest)(nil),                             // 3: personalwebsite.identity.roles.assignments.GetByIdRequest
-	(*GetByIdResponse)(nil),                            // 4: personalwebsite.identity.roles.assignments.GetByIdResponse
-	(*GetByRoleIdAndAssigneeRequest)(nil),              // 5: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest
-	(*GetByRoleIdAndAssigneeResponse)(nil),             // 6: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse
-	(*ExistsRequest)(nil),                              // 7: personalwebsite.identity.roles.assignments.ExistsRequest
-	(*ExistsResponse)(nil),                             // 8: personalwebsite.identity.roles.assignments.ExistsResponse
-	(*IsAssignedRequest)(nil),                          // 9: personalwebsite.identity.roles.assignments.IsAssignedRequest
-	(*IsAssignedResponse)(nil),                         // 10: personalwebsite.identity.roles.assignments.IsAssignedResponse
-	(*GetAssigneeTypeByIdRequest)(nil),                 // 11: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdRequest
-	(*GetAssigneeTypeByIdResponse)(nil),                // 12: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse
-	(*GetStatusByIdRequest)(nil),                       // 13: personalwebsite.identity.roles.assignments.GetStatusByIdRequest
-	(*GetStatusByIdResponse)(nil),                      // 14: personalwebsite.identity.roles.assignments.GetStatusByIdResponse
-	(*GetRoleIdAndAssigneeByIdRequest)(nil),            // 15: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdRequest
-	(*GetRoleIdAndAssigneeByIdResponse)(nil),           // 16: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse
-	(AssigneeTypeEnum_AssigneeType)(0),                 // 17: personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	(*wrapperspb.StringValue)(nil),                     // 18: google.protobuf.StringValue
-	(*RoleAssignment)(nil),                             // 19: personalwebsite.identity.roles.assignments.RoleAssignment
-	(RoleAssignmentStatusEnum_RoleAssignmentStatus)(0), // 20: personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
-	(*emptypb.Empty)(nil),                              // 21: google.protobuf.Empty
+	(*ExtendRequest)(nil),                              // 3: personalwebsite.identity.roles.assignments.ExtendRequest
+	(*RevokeRequest)(nil),                              // 4: personalwebsite.identity.roles.assignments.RevokeRequest
+	(*GetByIdRequest)(nil),                             // 5: personalwebsite.identity.roles.assignments.GetByIdRequest
+	(*GetByIdResponse)(nil),                            // 6: personalwebsite.identity.roles.assignments.GetByIdResponse
+	(*GetByRoleIdAndAssigneeRequest)(nil),              // 7: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest
+	(*GetByRoleIdAndAssigneeResponse)(nil),             // 8: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse
+	(*ExistsRequest)(nil),                              // 9: personalwebsite.identity.roles.assignments.ExistsRequest
+	(*ExistsResponse)(nil),                             // 10: personalwebsite.identity.roles.assignments.ExistsResponse
+	(*IsAssignedRequest)(nil),                          // 11: personalwebsite.identity.roles.assignments.IsAssignedRequest
+	(*IsAssignedResponse)(nil),                         // 12: personalwebsite.identity.roles.assignments.IsAssignedResponse
+	(*GetAssigneeTypeByIdRequest)(nil),                 // 13: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdRequest
+	(*GetAssigneeTypeByIdResponse)(nil),                // 14: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse
+	(*GetStatusByIdRequest)(nil),                       // 15: personalwebsite.identity.roles.assignments.GetStatusByIdRequest
+	(*GetStatusByIdResponse)(nil),                      // 16: personalwebsite.identity.roles.assignments.GetStatusByIdResponse
+	(*GetRoleIdAndAssigneeByIdRequest)(nil),            // 17: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdRequest
+	(*GetRoleIdAndAssigneeByIdResponse)(nil),           // 18: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse
+	(*GetAllExpiringWithinRequest)(nil),                // 19: personalwebsite.identity.roles.assignments.GetAllExpiringWithinRequest
+	(*GetAllExpiringWithinResponse)(nil),               // 20: personalwebsite.identity.roles.assignments.GetAllExpiringWithinResponse
+	(AssigneeTypeEnum_AssigneeType)(0),                 // 21: personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	(*wrapperspb.StringValue)(nil),                     // 22: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                      // 23: google.protobuf.Timestamp
+	(*RoleAssignment)(nil),                             // 24: personalwebsite.identity.roles.assignments.RoleAssignment
+	(RoleAssignmentStatusEnum_RoleAssignmentStatus)(0), // 25: personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
+	(*durationpb.Duration)(nil),                        // 26: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                              // 27: google.protobuf.Empty
 }
 var file_apis_identity_roles_assignments_role_assignment_service_proto_depIdxs = []int32{
-	17, // 0: personalwebsite.identity.roles.assignments.CreateRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	18, // 1: personalwebsite.identity.roles.assignments.CreateRequest.description:type_name -> google.protobuf.StringValue
-	19, // 2: personalwebsite.identity.roles.assignments.GetByIdResponse.assignment:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
-	17, // 3: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	19, // 4: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse.assignment:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
-	17, // 5: personalwebsite.identity.roles.assignments.ExistsRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	17, // 6: personalwebsite.identity.roles.assignments.IsAssignedRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	17, // 7: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	20, // 8: personalwebsite.identity.roles.assignments.GetStatusByIdResponse.status:type_name -> personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
-	17, // 9: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
-	0,  // 10: personalwebsite.identity.roles.assignments.RoleAssignmentService.Create:input_type -> personalwebsite.identity.roles.assignments.CreateRequest
-	2,  // 11: personalwebsite.identity.roles.assignments.RoleAssignmentService.Delete:input_type -> personalwebsite.identity.roles.assignments.DeleteRequest
-	3,  // 12: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetById:input_type -> personalwebsite.identity.roles.assignments.GetByIdRequest
-	5,  // 13: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetByRoleIdAndAssignee:input_type -> personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest
-	7,  // 14: personalwebsite.identity.roles.assignments.RoleAssignmentService.Exists:input_type -> personalwebsite.identity.roles.assignments.ExistsRequest
-	9,  // 15: personalwebsite.identity.roles.assignments.RoleAssignmentService.IsAssigned:input_type -> personalwebsite.identity.roles.assignments.IsAssignedRequest
-	11, // 16: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAssigneeTypeById:input_type -> personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdRequest
-	13, // 17: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetStatusById:input_type -> personalwebsite.identity.roles.assignments.GetStatusByIdRequest
-	15, // 18: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetRoleIdAndAssigneeById:input_type -> personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdRequest
-	1,  // 19: personalwebsite.identity.roles.assignments.RoleAssignmentService.Create:output_type -> personalwebsite.identity.roles.assignments.CreateResponse
-	21, // 20: personalwebsite.identity.roles.assignments.RoleAssignmentService.Delete:output_type -> google.protobuf.Empty
-	4,  // 21: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetById:output_type -> personalwebsite.identity.roles.assignments.GetByIdResponse
-	6,  // 22: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetByRoleIdAndAssignee:output_type -> personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse
-	8,  // 23: personalwebsite.identity.roles.assignments.RoleAssignmentService.Exists:output_type -> personalwebsite.identity.roles.assignments.ExistsResponse
-	10, // 24: personalwebsite.identity.roles.assignments.RoleAssignmentService.IsAssigned:output_type -> personalwebsite.identity.roles.assignments.IsAssignedResponse
-	12, // 25: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAssigneeTypeById:output_type -> personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse
-	14, // 26: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetStatusById:output_type -> personalwebsite.identity.roles.assignments.GetStatusByIdResponse
-	16, // 27: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetRoleIdAndAssigneeById:output_type -> personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	21, // 0: personalwebsite.identity.roles.assignments.CreateRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	22, // 1: personalwebsite.identity.roles.assignments.CreateRequest.description:type_name -> google.protobuf.StringValue
+	23, // 2: personalwebsite.identity.roles.assignments.CreateRequest.valid_from:type_name -> google.protobuf.Timestamp
+	23, // 3: personalwebsite.identity.roles.assignments.CreateRequest.valid_until:type_name -> google.protobuf.Timestamp
+	23, // 4: personalwebsite.identity.roles.assignments.ExtendRequest.valid_until:type_name -> google.protobuf.Timestamp
+	24, // 5: personalwebsite.identity.roles.assignments.GetByIdResponse.assignment:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
+	21, // 6: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	24, // 7: personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse.assignment:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
+	21, // 8: personalwebsite.identity.roles.assignments.ExistsRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	21, // 9: personalwebsite.identity.roles.assignments.IsAssignedRequest.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	21, // 10: personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	25, // 11: personalwebsite.identity.roles.assignments.GetStatusByIdResponse.status:type_name -> personalwebsite.identity.roles.assignments.RoleAssignmentStatusEnum.RoleAssignmentStatus
+	21, // 12: personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse.assignee_type:type_name -> personalwebsite.identity.roles.assignments.AssigneeTypeEnum.AssigneeType
+	26, // 13: personalwebsite.identity.roles.assignments.GetAllExpiringWithinRequest.period:type_name -> google.protobuf.Duration
+	24, // 14: personalwebsite.identity.roles.assignments.GetAllExpiringWithinResponse.assignments:type_name -> personalwebsite.identity.roles.assignments.RoleAssignment
+	0,  // 15: personalwebsite.identity.roles.assignments.RoleAssignmentService.Create:input_type -> personalwebsite.identity.roles.assignments.CreateRequest
+	2,  // 16: personalwebsite.identity.roles.assignments.RoleAssignmentService.Delete:input_type -> personalwebsite.identity.roles.assignments.DeleteRequest
+	3,  // 17: personalwebsite.identity.roles.assignments.RoleAssignmentService.Extend:input_type -> personalwebsite.identity.roles.assignments.ExtendRequest
+	4,  // 18: personalwebsite.identity.roles.assignments.RoleAssignmentService.Revoke:input_type -> personalwebsite.identity.roles.assignments.RevokeRequest
+	5,  // 19: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetById:input_type -> personalwebsite.identity.roles.assignments.GetByIdRequest
+	7,  // 20: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetByRoleIdAndAssignee:input_type -> personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeRequest
+	9,  // 21: personalwebsite.identity.roles.assignments.RoleAssignmentService.Exists:input_type -> personalwebsite.identity.roles.assignments.ExistsRequest
+	11, // 22: personalwebsite.identity.roles.assignments.RoleAssignmentService.IsAssigned:input_type -> personalwebsite.identity.roles.assignments.IsAssignedRequest
+	13, // 23: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAssigneeTypeById:input_type -> personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdRequest
+	15, // 24: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetStatusById:input_type -> personalwebsite.identity.roles.assignments.GetStatusByIdRequest
+	17, // 25: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetRoleIdAndAssigneeById:input_type -> personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdRequest
+	19, // 26: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAllExpiringWithin:input_type -> personalwebsite.identity.roles.assignments.GetAllExpiringWithinRequest
+	1,  // 27: personalwebsite.identity.roles.assignments.RoleAssignmentService.Create:output_type -> personalwebsite.identity.roles.assignments.CreateResponse
+	27, // 28: personalwebsite.identity.roles.assignments.RoleAssignmentService.Delete:output_type -> google.protobuf.Empty
+	27, // 29: personalwebsite.identity.roles.assignments.RoleAssignmentService.Extend:output_type -> google.protobuf.Empty
+	27, // 30: personalwebsite.identity.roles.assignments.RoleAssignmentService.Revoke:output_type -> google.protobuf.Empty
+	6,  // 31: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetById:output_type -> personalwebsite.identity.roles.assignments.GetByIdResponse
+	8,  // 32: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetByRoleIdAndAssignee:output_type -> personalwebsite.identity.roles.assignments.GetByRoleIdAndAssigneeResponse
+	10, // 33: personalwebsite.identity.roles.assignments.RoleAssignmentService.Exists:output_type -> personalwebsite.identity.roles.assignments.ExistsResponse
+	12, // 34: personalwebsite.identity.roles.assignments.RoleAssignmentService.IsAssigned:output_type -> personalwebsite.identity.roles.assignments.IsAssignedResponse
+	14, // 35: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAssigneeTypeById:output_type -> personalwebsite.identity.roles.assignments.GetAssigneeTypeByIdResponse
+	16, // 36: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetStatusById:output_type -> personalwebsite.identity.roles.assignments.GetStatusByIdResponse
+	18, // 37: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetRoleIdAndAssigneeById:output_type -> personalwebsite.identity.roles.assignments.GetRoleIdAndAssigneeByIdResponse
+	20, // 38: personalwebsite.identity.roles.assignments.RoleAssignmentService.GetAllExpiringWithin:output_type -> personalwebsite.identity.roles.assignments.GetAllExpiringWithinResponse
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_apis_identity_roles_assignments_role_assignment_service_proto_init() }
@@ -1313,7 +1608,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByIdRequest); i {
+			switch v := v.(*ExtendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1325,7 +1620,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByIdResponse); i {
+			switch v := v.(*RevokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1337,7 +1632,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByRoleIdAndAssigneeRequest); i {
+			switch v := v.(*GetByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1349,7 +1644,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByRoleIdAndAssigneeResponse); i {
+			switch v := v.(*GetByIdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1361,7 +1656,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExistsRequest); i {
+			switch v := v.(*GetByRoleIdAndAssigneeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1373,7 +1668,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExistsResponse); i {
+			switch v := v.(*GetByRoleIdAndAssigneeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,7 +1680,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsAssignedRequest); i {
+			switch v := v.(*ExistsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,7 +1692,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsAssignedResponse); i {
+			switch v := v.(*ExistsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,7 +1704,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAssigneeTypeByIdRequest); i {
+			switch v := v.(*IsAssignedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,7 +1716,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAssigneeTypeByIdResponse); i {
+			switch v := v.(*IsAssignedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1433,7 +1728,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusByIdRequest); i {
+			switch v := v.(*GetAssigneeTypeByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1445,7 +1740,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusByIdResponse); i {
+			switch v := v.(*GetAssigneeTypeByIdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1457,7 +1752,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleIdAndAssigneeByIdRequest); i {
+			switch v := v.(*GetStatusByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1469,6 +1764,30 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			}
 		}
 		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleIdAndAssigneeByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRoleIdAndAssigneeByIdResponse); i {
 			case 0:
 				return &v.state
@@ -1480,6 +1799,30 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 				return nil
 			}
 		}
+		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllExpiringWithinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_assignments_role_assignment_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllExpiringWithinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1487,7 +1830,7 @@ func file_apis_identity_roles_assignments_role_assignment_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apis_identity_roles_assignments_role_assignment_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	RoleAssignmentService_Create_FullMethodName                   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/Create"
 	RoleAssignmentService_Delete_FullMethodName                   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/Delete"
+	RoleAssignmentService_Extend_FullMethodName                   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/Extend"
+	RoleAssignmentService_Revoke_FullMethodName                   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/Revoke"
 	RoleAssignmentService_GetById_FullMethodName                  = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetById"
 	RoleAssignmentService_GetByRoleIdAndAssignee_FullMethodName   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetByRoleIdAndAssignee"
 	RoleAssignmentService_Exists_FullMethodName                   = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/Exists"
@@ -43,6 +45,7 @@ const (
 	RoleAssignmentService_GetAssigneeTypeById_FullMethodName      = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetAssigneeTypeById"
 	RoleAssignmentService_GetStatusById_FullMethodName            = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetStatusById"
 	RoleAssignmentService_GetRoleIdAndAssigneeById_FullMethodName = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetRoleIdAndAssigneeById"
+	RoleAssignmentService_GetAllExpiringWithin_FullMethodName     = "/personalwebsite.identity.roles.assignments.RoleAssignmentService/GetAllExpiringWithin"
 )
 
 // RoleAssignmentServiceClient is the client API for RoleAssignmentService service.
@@ -53,6 +56,10 @@ type RoleAssignmentServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	// Deletes a role assignment by the specified role assignment ID.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Extends the validity period of an active role assignment by the specified role assignment ID.
+	Extend(ctx context.Context, in *ExtendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Revokes an active role assignment by the specified role assignment ID before its validity period ends.
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets a role assignment by the specified role assignment ID.
 	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error)
 	// Gets a role assignment by the specified role ID and assignee.
@@ -67,6 +74,8 @@ type RoleAssignmentServiceClient interface {
 	GetStatusById(ctx context.Context, in *GetStatusByIdRequest, opts ...grpc.CallOption) (*GetStatusByIdResponse, error)
 	// Gets the role ID and assignee by the specified role assignment ID.
 	GetRoleIdAndAssigneeById(ctx context.Context, in *GetRoleIdAndAssigneeByIdRequest, opts ...grpc.CallOption) (*GetRoleIdAndAssigneeByIdResponse, error)
+	// Gets all active role assignments whose validity period ends within the specified period.
+	GetAllExpiringWithin(ctx context.Context, in *GetAllExpiringWithinRequest, opts ...grpc.CallOption) (*GetAllExpiringWithinResponse, error)
 }
 
 type roleAssignmentServiceClient struct {
@@ -95,6 +104,24 @@ func (c *roleAssignmentServiceClient) Delete(ctx context.Context, in *DeleteRequ
 	return out, nil
 }
 
+func (c *roleAssignmentServiceClient) Extend(ctx context.Context, in *ExtendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoleAssignmentService_Extend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAssignmentServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoleAssignmentService_Revoke_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleAssignmentServiceClient) GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error) {
 	out := new(GetByIdResponse)
 	err := c.cc.Invoke(ctx, RoleAssignmentService_GetById_FullMethodName, in, out, opts...)
@@ -158,6 +185,15 @@ func (c *roleAssignmentServiceClient) GetRoleIdAndAssigneeById(ctx context.Conte
 	return out, nil
 }
 
+func (c *roleAssignmentServiceClient) GetAllExpiringWithin(ctx context.Context, in *GetAllExpiringWithinRequest, opts ...grpc.CallOption) (*GetAllExpiringWithinResponse, error) {
+	out := new(GetAllExpiringWithinResponse)
+	err := c.cc.Invoke(ctx, RoleAssignmentService_GetAllExpiringWithin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleAssignmentServiceServer is the server API for RoleAssignmentService service.
 // All implementations must embed UnimplementedRoleAssignmentServiceServer
 // for forward compatibility
@@ -166,6 +202,10 @@ type RoleAssignmentServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	// Deletes a role assignment by the specified role assignment ID.
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// Extends the validity period of an active role assignment by the specified role assignment ID.
+	Extend(context.Context, *ExtendRequest) (*emptypb.Empty, error)
+	// Revokes an active role assignment by the specified role assignment ID before its validity period ends.
+	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
 	// Gets a role assignment by the specified role assignment ID.
 	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
 	// Gets a role assignment by the specified role ID and assignee.
@@ -180,6 +220,8 @@ type RoleAssignmentServiceServer interface {
 	GetStatusById(context.Context, *GetStatusByIdRequest) (*GetStatusByIdResponse, error)
 	// Gets the role ID and assignee by the specified role assignment ID.
 	GetRoleIdAndAssigneeById(context.Context, *GetRoleIdAndAssigneeByIdRequest) (*GetRoleIdAndAssigneeByIdResponse, error)
+	// Gets all active role assignments whose validity period ends within the specified period.
+	GetAllExpiringWithin(context.Context, *GetAllExpiringWithinRequest) (*GetAllExpiringWithinResponse, error)
 	mustEmbedUnimplementedRoleAssignmentServiceServer()
 }
 
@@ -193,6 +235,12 @@ func (UnimplementedRoleAssignmentServiceServer) Create(context.Context, *CreateR
 func (UnimplementedRoleAssignmentServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedRoleAssignmentServiceServer) Extend(context.Context, *ExtendRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Extend not implemented")
+}
+func (UnimplementedRoleAssignmentServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
 func (UnimplementedRoleAssignmentServiceServer) GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
 }
@@ -214,6 +262,9 @@ func (UnimplementedRoleAssignmentServiceServer) GetStatusById(context.Context, *
 func (UnimplementedRoleAssignmentServiceServer) GetRoleIdAndAssigneeById(context.Context, *GetRoleIdAndAssigneeByIdRequest) (*GetRoleIdAndAssigneeByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleIdAndAssigneeById not implemented")
 }
+func (UnimplementedRoleAssignmentServiceServer) GetAllExpiringWithin(context.Context, *GetAllExpiringWithinRequest) (*GetAllExpiringWithinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllExpiringWithin not implemented")
+}
 func (UnimplementedRoleAssignmentServiceServer) mustEmbedUnimplementedRoleAssignmentServiceServer() {}
 
 // UnsafeRoleAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -263,6 +314,42 @@ func _RoleAssignmentService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleAssignmentService_Extend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAssignmentServiceServer).Extend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleAssignmentService_Extend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAssignmentServiceServer).Extend(ctx, req.(*ExtendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAssignmentService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAssignmentServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleAssignmentService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAssignmentServiceServer).Revoke(ctx, req.(*RevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleAssignmentService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +476,24 @@ func _RoleAssignmentService_GetRoleIdAndAssigneeById_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleAssignmentService_GetAllExpiringWithin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllExpiringWithinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAssignmentServiceServer).GetAllExpiringWithin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleAssignmentService_GetAllExpiringWithin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAssignmentServiceServer).GetAllExpiringWithin(ctx, req.(*GetAllExpiringWithinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleAssignmentService_ServiceDesc is the grpc.ServiceDesc for RoleAssignmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -404,6 +509,14 @@ var RoleAssignmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _RoleAssignmentService_Delete_Handler,
 		},
+		{
+			MethodName: "Extend",
+			Handler:    _RoleAssignmentService_Extend_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _RoleAssignmentService_Revoke_Handler,
+		},
 		{
 			MethodName: "GetById",
 			Handler:    _RoleAssignmentService_GetById_Handler,
@@ -432,6 +545,10 @@ var RoleAssignmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoleIdAndAssigneeById",
 			Handler:    _RoleAssignmentService_GetRoleIdAndAssigneeById_Handler,
 		},
+		{
+			MethodName: "GetAllExpiringWithin",
+			Handler:    _RoleAssignmentService_GetAllExpiringWithin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis/identity/roles/assignments/role_assignment_service.proto",
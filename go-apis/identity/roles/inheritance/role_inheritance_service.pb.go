@@ -0,0 +1,564 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.3
+// source: apis/identity/roles/inheritance/role_inheritance_service.proto
+
+package inheritance
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request message for 'RoleInheritanceService.AddParent'.
+type AddParentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role ID.
+	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// The parent role ID.
+	ParentRoleId uint64 `protobuf:"varint,2,opt,name=parent_role_id,json=parentRoleId,proto3" json:"parent_role_id,omitempty"`
+}
+
+func (x *AddParentRequest) Reset() {
+	*x = AddParentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParentRequest) ProtoMessage() {}
+
+func (x *AddParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParentRequest.ProtoReflect.Descriptor instead.
+func (*AddParentRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddParentRequest) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *AddParentRequest) GetParentRoleId() uint64 {
+	if x != nil {
+		return x.ParentRoleId
+	}
+	return 0
+}
+
+// Request message for 'RoleInheritanceService.RemoveParent'.
+type RemoveParentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role ID.
+	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// The parent role ID.
+	ParentRoleId uint64 `protobuf:"varint,2,opt,name=parent_role_id,json=parentRoleId,proto3" json:"parent_role_id,omitempty"`
+}
+
+func (x *RemoveParentRequest) Reset() {
+	*x = RemoveParentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParentRequest) ProtoMessage() {}
+
+func (x *RemoveParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveParentRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RemoveParentRequest) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *RemoveParentRequest) GetParentRoleId() uint64 {
+	if x != nil {
+		return x.ParentRoleId
+	}
+	return 0
+}
+
+// Request message for 'RoleInheritanceService.GetParentRoleIds'.
+type GetParentRoleIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role ID.
+	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *GetParentRoleIdsRequest) Reset() {
+	*x = GetParentRoleIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParentRoleIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParentRoleIdsRequest) ProtoMessage() {}
+
+func (x *GetParentRoleIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParentRoleIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetParentRoleIdsRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetParentRoleIdsRequest) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+// Response message for 'RoleInheritanceService.GetParentRoleIds'.
+type GetParentRoleIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent role IDs.
+	RoleIds []uint64 `protobuf:"varint,1,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+}
+
+func (x *GetParentRoleIdsResponse) Reset() {
+	*x = GetParentRoleIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParentRoleIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParentRoleIdsResponse) ProtoMessage() {}
+
+func (x *GetParentRoleIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParentRoleIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetParentRoleIdsResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetParentRoleIdsResponse) GetRoleIds() []uint64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// Request message for 'RoleInheritanceService.GetAllAncestorRoleIds'.
+type GetAllAncestorRoleIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role ID.
+	RoleId uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *GetAllAncestorRoleIdsRequest) Reset() {
+	*x = GetAllAncestorRoleIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAncestorRoleIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAncestorRoleIdsRequest) ProtoMessage() {}
+
+func (x *GetAllAncestorRoleIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAncestorRoleIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAncestorRoleIdsRequest) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAllAncestorRoleIdsRequest) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+// Response message for 'RoleInheritanceService.GetAllAncestorRoleIds'.
+type GetAllAncestorRoleIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ancestor role IDs.
+	RoleIds []uint64 `protobuf:"varint,1,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+}
+
+func (x *GetAllAncestorRoleIdsResponse) Reset() {
+	*x = GetAllAncestorRoleIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAncestorRoleIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAncestorRoleIdsResponse) ProtoMessage() {}
+
+func (x *GetAllAncestorRoleIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAncestorRoleIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAncestorRoleIdsResponse) Descriptor() ([]byte, []int) {
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAllAncestorRoleIdsResponse) GetRoleIds() []uint64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+var File_apis_identity_roles_inheritance_role_inheritance_service_proto protoreflect.FileDescriptor
+
+var file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDesc = []byte{
+	0x0a, 0x3e, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x2a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x37, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x73, 0x32, 0xbb, 0x04, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x69, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x9f, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x48, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x44, 0x5a, 0x42, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x69, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescOnce sync.Once
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescData = file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDesc
+)
+
+func file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescGZIP() []byte {
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescOnce.Do(func() {
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescData)
+	})
+	return file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDescData
+}
+
+var file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apis_identity_roles_inheritance_role_inheritance_service_proto_goTypes = []interface{}{
+	(*AddParentRequest)(nil),              // 0: personalwebsite.identity.roles.inheritance.AddParentRequest
+	(*RemoveParentRequest)(nil),           // 1: personalwebsite.identity.roles.inheritance.RemoveParentRequest
+	(*GetParentRoleIdsRequest)(nil),       // 2: personalwebsite.identity.roles.inheritance.GetParentRoleIdsRequest
+	(*GetParentRoleIdsResponse)(nil),      // 3: personalwebsite.identity.roles.inheritance.GetParentRoleIdsResponse
+	(*GetAllAncestorRoleIdsRequest)(nil),  // 4: personalwebsite.identity.roles.inheritance.GetAllAncestorRoleIdsRequest
+	(*GetAllAncestorRoleIdsResponse)(nil), // 5: personalwebsite.identity.roles.inheritance.GetAllAncestorRoleIdsResponse
+	(*emptypb.Empty)(nil),                 // 6: google.protobuf.Empty
+}
+var file_apis_identity_roles_inheritance_role_inheritance_service_proto_depIdxs = []int32{
+	0, // 0: personalwebsite.identity.roles.inheritance.RoleInheritanceService.AddParent:input_type -> personalwebsite.identity.roles.inheritance.AddParentRequest
+	1, // 1: personalwebsite.identity.roles.inheritance.RoleInheritanceService.RemoveParent:input_type -> personalwebsite.identity.roles.inheritance.RemoveParentRequest
+	2, // 2: personalwebsite.identity.roles.inheritance.RoleInheritanceService.GetParentRoleIds:input_type -> personalwebsite.identity.roles.inheritance.GetParentRoleIdsRequest
+	4, // 3: personalwebsite.identity.roles.inheritance.RoleInheritanceService.GetAllAncestorRoleIds:input_type -> personalwebsite.identity.roles.inheritance.GetAllAncestorRoleIdsRequest
+	6, // 4: personalwebsite.identity.roles.inheritance.RoleInheritanceService.AddParent:output_type -> google.protobuf.Empty
+	6, // 5: personalwebsite.identity.roles.inheritance.RoleInheritanceService.RemoveParent:output_type -> google.protobuf.Empty
+	3, // 6: personalwebsite.identity.roles.inheritance.RoleInheritanceService.GetParentRoleIds:output_type -> personalwebsite.identity.roles.inheritance.GetParentRoleIdsResponse
+	5, // 7: personalwebsite.identity.roles.inheritance.RoleInheritanceService.GetAllAncestorRoleIds:output_type -> personalwebsite.identity.roles.inheritance.GetAllAncestorRoleIdsResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_apis_identity_roles_inheritance_role_inheritance_service_proto_init() }
+func file_apis_identity_roles_inheritance_role_inheritance_service_proto_init() {
+	if File_apis_identity_roles_inheritance_role_inheritance_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddParentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveParentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParentRoleIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParentRoleIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllAncestorRoleIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllAncestorRoleIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apis_identity_roles_inheritance_role_inheritance_service_proto_goTypes,
+		DependencyIndexes: file_apis_identity_roles_inheritance_role_inheritance_service_proto_depIdxs,
+		MessageInfos:      file_apis_identity_roles_inheritance_role_inheritance_service_proto_msgTypes,
+	}.Build()
+	File_apis_identity_roles_inheritance_role_inheritance_service_proto = out.File
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_rawDesc = nil
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_goTypes = nil
+	file_apis_identity_roles_inheritance_role_inheritance_service_proto_depIdxs = nil
+}
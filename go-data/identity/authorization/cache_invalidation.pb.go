@@ -0,0 +1,459 @@
+// Copyright 2024 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.3
+// source: data/identity/authorization/cache_invalidation.proto
+
+package authorization
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The authorization cache invalidation type.
+type CacheInvalidationTypeEnum_CacheInvalidationType int32
+
+const (
+	// Unspecified. Do not use.
+	CacheInvalidationTypeEnum_UNSPECIFIED CacheInvalidationTypeEnum_CacheInvalidationType = 0
+	// The cached roles of the specified permissions are invalidated.
+	CacheInvalidationTypeEnum_PERMISSIONS CacheInvalidationTypeEnum_CacheInvalidationType = 1
+	// The cached roles of all permissions are invalidated.
+	CacheInvalidationTypeEnum_ALL_PERMISSIONS CacheInvalidationTypeEnum_CacheInvalidationType = 2
+	// The cached roles, group and status of the specified user are invalidated.
+	CacheInvalidationTypeEnum_USER CacheInvalidationTypeEnum_CacheInvalidationType = 3
+	// The cached roles of the specified group are invalidated.
+	CacheInvalidationTypeEnum_GROUP CacheInvalidationTypeEnum_CacheInvalidationType = 4
+	// All cached data is invalidated.
+	CacheInvalidationTypeEnum_ALL CacheInvalidationTypeEnum_CacheInvalidationType = 5
+	// The cached roles of the specified service client are invalidated.
+	CacheInvalidationTypeEnum_CLIENT CacheInvalidationTypeEnum_CacheInvalidationType = 6
+)
+
+// Enum value maps for CacheInvalidationTypeEnum_CacheInvalidationType.
+var (
+	CacheInvalidationTypeEnum_CacheInvalidationType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "PERMISSIONS",
+		2: "ALL_PERMISSIONS",
+		3: "USER",
+		4: "GROUP",
+		5: "ALL",
+		6: "CLIENT",
+	}
+	CacheInvalidationTypeEnum_CacheInvalidationType_value = map[string]int32{
+		"UNSPECIFIED":     0,
+		"PERMISSIONS":     1,
+		"ALL_PERMISSIONS": 2,
+		"USER":            3,
+		"GROUP":           4,
+		"ALL":             5,
+		"CLIENT":          6,
+	}
+)
+
+func (x CacheInvalidationTypeEnum_CacheInvalidationType) Enum() *CacheInvalidationTypeEnum_CacheInvalidationType {
+	p := new(CacheInvalidationTypeEnum_CacheInvalidationType)
+	*p = x
+	return p
+}
+
+func (x CacheInvalidationTypeEnum_CacheInvalidationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheInvalidationTypeEnum_CacheInvalidationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_data_identity_authorization_cache_invalidation_proto_enumTypes[0].Descriptor()
+}
+
+func (CacheInvalidationTypeEnum_CacheInvalidationType) Type() protoreflect.EnumType {
+	return &file_data_identity_authorization_cache_invalidation_proto_enumTypes[0]
+}
+
+func (x CacheInvalidationTypeEnum_CacheInvalidationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CacheInvalidationTypeEnum_CacheInvalidationType.Descriptor instead.
+func (CacheInvalidationTypeEnum_CacheInvalidationType) EnumDescriptor() ([]byte, []int) {
+	return file_data_identity_authorization_cache_invalidation_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// The authorization cache invalidation.
+type CacheInvalidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the invalidation.
+	Type CacheInvalidationTypeEnum_CacheInvalidationType `protobuf:"varint,1,opt,name=type,proto3,enum=personalwebsite.identity.authorization.CacheInvalidationTypeEnum_CacheInvalidationType" json:"type,omitempty"`
+	// It stores the date and time at which the invalidation was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The permission IDs (if the type is PERMISSIONS).
+	PermissionIds []uint64 `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	// The user ID (if the type is USER).
+	UserId uint64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The user's group (if the type is GROUP).
+	Group uint64 `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	// The invalidation metadata.
+	Metadata *CacheInvalidationMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The client ID (if the type is CLIENT).
+	ClientId uint64 `protobuf:"varint,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *CacheInvalidation) Reset() {
+	*x = CacheInvalidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheInvalidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInvalidation) ProtoMessage() {}
+
+func (x *CacheInvalidation) ProtoReflect() protoreflect.Message {
+	mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInvalidation.ProtoReflect.Descriptor instead.
+func (*CacheInvalidation) Descriptor() ([]byte, []int) {
+	return file_data_identity_authorization_cache_invalidation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CacheInvalidation) GetType() CacheInvalidationTypeEnum_CacheInvalidationType {
+	if x != nil {
+		return x.Type
+	}
+	return CacheInvalidationTypeEnum_UNSPECIFIED
+}
+
+func (x *CacheInvalidation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CacheInvalidation) GetPermissionIds() []uint64 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+func (x *CacheInvalidation) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CacheInvalidation) GetGroup() uint64 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *CacheInvalidation) GetMetadata() *CacheInvalidationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CacheInvalidation) GetClientId() uint64 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+// Container for enum describing the type of the authorization cache invalidation.
+type CacheInvalidationTypeEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CacheInvalidationTypeEnum) Reset() {
+	*x = CacheInvalidationTypeEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheInvalidationTypeEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInvalidationTypeEnum) ProtoMessage() {}
+
+func (x *CacheInvalidationTypeEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInvalidationTypeEnum.ProtoReflect.Descriptor instead.
+func (*CacheInvalidationTypeEnum) Descriptor() ([]byte, []int) {
+	return file_data_identity_authorization_cache_invalidation_proto_rawDescGZIP(), []int{1}
+}
+
+// The authorization cache invalidation metadata.
+type CacheInvalidationMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The app session ID.
+	AppSessionId uint64 `protobuf:"varint,1,opt,name=app_session_id,json=appSessionId,proto3" json:"app_session_id,omitempty"`
+	// The transaction ID.
+	TranId string `protobuf:"bytes,2,opt,name=tran_id,json=tranId,proto3" json:"tran_id,omitempty"`
+}
+
+func (x *CacheInvalidationMetadata) Reset() {
+	*x = CacheInvalidationMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheInvalidationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInvalidationMetadata) ProtoMessage() {}
+
+func (x *CacheInvalidationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_data_identity_authorization_cache_invalidation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInvalidationMetadata.ProtoReflect.Descriptor instead.
+func (*CacheInvalidationMetadata) Descriptor() ([]byte, []int) {
+	return file_data_identity_authorization_cache_invalidation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CacheInvalidationMetadata) GetAppSessionId() uint64 {
+	if x != nil {
+		return x.AppSessionId
+	}
+	return 0
+}
+
+func (x *CacheInvalidationMetadata) GetTranId() string {
+	if x != nil {
+		return x.TranId
+	}
+	return ""
+}
+
+var File_data_identity_authorization_cache_invalidation_proto protoreflect.FileDescriptor
+
+var file_data_identity_authorization_cache_invalidation_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x8d, 0x03, 0x0a, 0x11, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x57, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x5d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x95, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x22, 0x78, 0x0a,
+	0x15, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x4c, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x22, 0x5a, 0x0a, 0x19, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x6e, 0x49, 0x64, 0x42, 0x42, 0x5a, 0x40, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2d,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x76, 0x32, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_data_identity_authorization_cache_invalidation_proto_rawDescOnce sync.Once
+	file_data_identity_authorization_cache_invalidation_proto_rawDescData = file_data_identity_authorization_cache_invalidation_proto_rawDesc
+)
+
+func file_data_identity_authorization_cache_invalidation_proto_rawDescGZIP() []byte {
+	file_data_identity_authorization_cache_invalidation_proto_rawDescOnce.Do(func() {
+		file_data_identity_authorization_cache_invalidation_proto_rawDescData = protoimpl.X.CompressGZIP(file_data_identity_authorization_cache_invalidation_proto_rawDescData)
+	})
+	return file_data_identity_authorization_cache_invalidation_proto_rawDescData
+}
+
+var file_data_identity_authorization_cache_invalidation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_data_identity_authorization_cache_invalidation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_data_identity_authorization_cache_invalidation_proto_goTypes = []interface{}{
+	(CacheInvalidationTypeEnum_CacheInvalidationType)(0), // 0: personalwebsite.identity.authorization.CacheInvalidationTypeEnum.CacheInvalidationType
+	(*CacheInvalidation)(nil),                            // 1: personalwebsite.identity.authorization.CacheInvalidation
+	(*CacheInvalidationTypeEnum)(nil),                    // 2: personalwebsite.identity.authorization.CacheInvalidationTypeEnum
+	(*CacheInvalidationMetadata)(nil),                    // 3: personalwebsite.identity.authorization.CacheInvalidationMetadata
+	(*timestamppb.Timestamp)(nil),                        // 4: google.protobuf.Timestamp
+}
+var file_data_identity_authorization_cache_invalidation_proto_depIdxs = []int32{
+	0, // 0: personalwebsite.identity.authorization.CacheInvalidation.type:type_name -> personalwebsite.identity.authorization.CacheInvalidationTypeEnum.CacheInvalidationType
+	4, // 1: personalwebsite.identity.authorization.CacheInvalidation.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: personalwebsite.identity.authorization.CacheInvalidation.metadata:type_name -> personalwebsite.identity.authorization.CacheInvalidationMetadata
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_data_identity_authorization_cache_invalidation_proto_init() }
+func file_data_identity_authorization_cache_invalidation_proto_init() {
+	if File_data_identity_authorization_cache_invalidation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_data_identity_authorization_cache_invalidation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheInvalidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_identity_authorization_cache_invalidation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheInvalidationTypeEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_identity_authorization_cache_invalidation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheInvalidationMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_data_identity_authorization_cache_invalidation_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_data_identity_authorization_cache_invalidation_proto_goTypes,
+		DependencyIndexes: file_data_identity_authorization_cache_invalidation_proto_depIdxs,
+		EnumInfos:         file_data_identity_authorization_cache_invalidation_proto_enumTypes,
+		MessageInfos:      file_data_identity_authorization_cache_invalidation_proto_msgTypes,
+	}.Build()
+	File_data_identity_authorization_cache_invalidation_proto = out.File
+	file_data_identity_authorization_cache_invalidation_proto_rawDesc = nil
+	file_data_identity_authorization_cache_invalidation_proto_goTypes = nil
+	file_data_identity_authorization_cache_invalidation_proto_depIdxs = nil
+}
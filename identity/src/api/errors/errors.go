@@ -35,6 +35,16 @@ const (
 	ApiErrorCodeInvalidClientId errors.ApiErrorCode = 31201
 
 	// User group error codes (31400-31599).
+	ApiErrorCodeUserGroupNotFound errors.ApiErrorCode = 31400
+
+	// The user group with the same name already exists.
+	ApiErrorCodeUserGroupAlreadyExists errors.ApiErrorCode = 31401
+
+	// The user isn't a member of the group.
+	ApiErrorCodeUserGroupMemberNotFound errors.ApiErrorCode = 31402
+
+	// The user is already a member of the group.
+	ApiErrorCodeUserGroupMemberAlreadyExists errors.ApiErrorCode = 31403
 
 	// Role error codes (31600-31799).
 	ApiErrorCodeRoleNotFound      errors.ApiErrorCode = 31600
@@ -90,6 +100,72 @@ const (
 
 	// Role already assigned (to the user or group).
 	ApiErrorCodeRoleAlreadyAssigned errors.ApiErrorCode = 33402
+
+	// User credential error codes (35000-35199).
+	ApiErrorCodeUserCredentialNotFound errors.ApiErrorCode = 35000
+
+	// Invalid user name, email or password.
+	ApiErrorCodeInvalidCredentials errors.ApiErrorCode = 35001
+
+	// Lockout error codes (35200-35399).
+	ApiErrorCodeUserLockedOut      errors.ApiErrorCode = 35200
+	ApiErrorCodeClientLockedOut    errors.ApiErrorCode = 35201
+	ApiErrorCodeUserAgentLockedOut errors.ApiErrorCode = 35202
+
+	// MFA error codes (35400-35599).
+	ApiErrorCodeUserTotpNotFound errors.ApiErrorCode = 35400
+
+	// Invalid TOTP code or recovery code.
+	ApiErrorCodeInvalidMfaCode errors.ApiErrorCode = 35401
+
+	// MFA challenge not found or expired.
+	ApiErrorCodeMfaChallengeNotFound errors.ApiErrorCode = 35402
+
+	// MFA is required for the user, but the user hasn't enrolled in MFA.
+	ApiErrorCodeMfaEnrollmentRequired errors.ApiErrorCode = 35403
+
+	// Registration error codes (35800-35999).
+	// Email verification token not found, expired or already used.
+	ApiErrorCodeEmailVerificationTokenNotFound errors.ApiErrorCode = 35800
+
+	// Role inheritance error codes (36000-36199).
+	// The role already inherits from the parent role.
+	ApiErrorCodeRoleParentAlreadyAdded errors.ApiErrorCode = 36000
+
+	// The role doesn't inherit from the parent role.
+	ApiErrorCodeRoleParentNotFound errors.ApiErrorCode = 36001
+
+	// Adding the parent role would create a cycle in the role hierarchy.
+	ApiErrorCodeRoleInheritanceCycle errors.ApiErrorCode = 36002
+
+	// Adding the parent role would exceed the maximum depth of the role hierarchy.
+	ApiErrorCodeRoleInheritanceDepthExceeded errors.ApiErrorCode = 36003
+
+	// Resource error codes (36200-36399).
+	ApiErrorCodeResourceTypeNotFound errors.ApiErrorCode = 36200
+
+	// Resource role assignment not found.
+	ApiErrorCodeResourceRoleAssignmentNotFound errors.ApiErrorCode = 36201
+
+	// Resource role assignment already exists.
+	ApiErrorCodeResourceRoleAssignmentAlreadyExists errors.ApiErrorCode = 36202
+
+	// Impersonation error codes (36400-36599).
+	ApiErrorCodeImpersonationNotAllowed errors.ApiErrorCode = 36400
+
+	// Impersonation token not found.
+	ApiErrorCodeImpersonationTokenNotFound errors.ApiErrorCode = 36401
+
+	// Data subject request error codes (36600-36799).
+	// An unfinished data subject request of the same type already exists.
+	ApiErrorCodeDataSubjectRequestAlreadyExists errors.ApiErrorCode = 36600
+
+	// Data subject request not found.
+	ApiErrorCodeDataSubjectRequestNotFound errors.ApiErrorCode = 36601
+
+	// API key error codes (36800-36999).
+	// API key not found.
+	ApiErrorCodeApiKeyNotFound errors.ApiErrorCode = 36800
 )
 
 var (
@@ -104,6 +180,14 @@ var (
 	ErrInvalidClientId = errors.NewApiError(ApiErrorCodeInvalidClientId, "invalid client id")
 
 	// User group errors.
+	ErrUserGroupNotFound      = errors.NewApiError(ApiErrorCodeUserGroupNotFound, "user group not found")
+	ErrUserGroupAlreadyExists = errors.NewApiError(ApiErrorCodeUserGroupAlreadyExists, "user group with the same name already exists")
+
+	// The user isn't a member of the group.
+	ErrUserGroupMemberNotFound = errors.NewApiError(ApiErrorCodeUserGroupMemberNotFound, "user isn't a member of the group")
+
+	// The user is already a member of the group.
+	ErrUserGroupMemberAlreadyExists = errors.NewApiError(ApiErrorCodeUserGroupMemberAlreadyExists, "user is already a member of the group")
 
 	// Role errors.
 	ErrRoleNotFound      = errors.NewApiError(ApiErrorCodeRoleNotFound, "role not found")
@@ -157,4 +241,66 @@ var (
 
 	// Role already assigned (to the user or group).
 	ErrRoleAlreadyAssigned = errors.NewApiError(ApiErrorCodeRoleAlreadyAssigned, "role already assigned")
+
+	// User credential errors.
+	ErrUserCredentialNotFound = errors.NewApiError(ApiErrorCodeUserCredentialNotFound, "user's credentials not found")
+
+	// Invalid user name, email or password.
+	ErrInvalidCredentials = errors.NewApiError(ApiErrorCodeInvalidCredentials, "invalid credentials")
+
+	// Lockout errors.
+	ErrUserLockedOut      = errors.NewApiError(ApiErrorCodeUserLockedOut, "user is locked out")
+	ErrClientLockedOut    = errors.NewApiError(ApiErrorCodeClientLockedOut, "client is locked out")
+	ErrUserAgentLockedOut = errors.NewApiError(ApiErrorCodeUserAgentLockedOut, "user agent is locked out")
+
+	// MFA errors.
+	ErrUserTotpNotFound = errors.NewApiError(ApiErrorCodeUserTotpNotFound, "user's TOTP not found")
+
+	// Invalid TOTP code or recovery code.
+	ErrInvalidMfaCode = errors.NewApiError(ApiErrorCodeInvalidMfaCode, "invalid MFA code")
+
+	// MFA challenge not found or expired.
+	ErrMfaChallengeNotFound = errors.NewApiError(ApiErrorCodeMfaChallengeNotFound, "MFA challenge not found")
+
+	// MFA is required for the user, but the user hasn't enrolled in MFA.
+	ErrMfaEnrollmentRequired = errors.NewApiError(ApiErrorCodeMfaEnrollmentRequired, "MFA enrollment required")
+
+	// Registration errors.
+	// Email verification token not found, expired or already used.
+	ErrEmailVerificationTokenNotFound = errors.NewApiError(ApiErrorCodeEmailVerificationTokenNotFound, "email verification token not found")
+
+	// Role inheritance errors.
+	// The role already inherits from the parent role.
+	ErrRoleParentAlreadyAdded = errors.NewApiError(ApiErrorCodeRoleParentAlreadyAdded, "role already inherits from the parent role")
+
+	// The role doesn't inherit from the parent role.
+	ErrRoleParentNotFound = errors.NewApiError(ApiErrorCodeRoleParentNotFound, "role doesn't inherit from the parent role")
+
+	// Adding the parent role would create a cycle in the role hierarchy.
+	ErrRoleInheritanceCycle = errors.NewApiError(ApiErrorCodeRoleInheritanceCycle, "role inheritance cycle")
+
+	// Adding the parent role would exceed the maximum depth of the role hierarchy.
+	ErrRoleInheritanceDepthExceeded = errors.NewApiError(ApiErrorCodeRoleInheritanceDepthExceeded, "maximum depth of the role hierarchy exceeded")
+
+	// Resource errors.
+	ErrResourceTypeNotFound = errors.NewApiError(ApiErrorCodeResourceTypeNotFound, "resource type not found")
+
+	// Resource role assignment not found.
+	ErrResourceRoleAssignmentNotFound = errors.NewApiError(ApiErrorCodeResourceRoleAssignmentNotFound, "resource role assignment not found")
+
+	// Resource role assignment already exists.
+	ErrResourceRoleAssignmentAlreadyExists = errors.NewApiError(ApiErrorCodeResourceRoleAssignmentAlreadyExists, "resource role assignment with the same params already exists")
+
+	// Impersonation errors.
+	ErrImpersonationNotAllowed = errors.NewApiError(ApiErrorCodeImpersonationNotAllowed, "impersonation of the user isn't allowed")
+
+	// Impersonation token not found.
+	ErrImpersonationTokenNotFound = errors.NewApiError(ApiErrorCodeImpersonationTokenNotFound, "impersonation token not found")
+
+	// Data subject request errors.
+	ErrDataSubjectRequestAlreadyExists = errors.NewApiError(ApiErrorCodeDataSubjectRequestAlreadyExists, "unfinished data subject request of the same type already exists")
+	ErrDataSubjectRequestNotFound      = errors.NewApiError(ApiErrorCodeDataSubjectRequestNotFound, "data subject request not found")
+
+	// API key errors.
+	ErrApiKeyNotFound = errors.NewApiError(ApiErrorCodeApiKeyNotFound, "API key not found")
 )
@@ -0,0 +1,54 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	apikeyspb "personal-website-v2/go-apis/identity/apikeys"
+	"personal-website-v2/identity/src/internal/apikeys/dbmodels"
+)
+
+func ConvertToApiApiKey(k *dbmodels.ApiKey) *apikeyspb.ApiKey {
+	key := &apikeyspb.ApiKey{
+		Id:              k.Id,
+		Name:            k.Name,
+		Type:            apikeyspb.ApiKeyTypeEnum_ApiKeyType(k.Type),
+		Prefix:          k.Prefix,
+		CreatedAt:       timestamppb.New(k.CreatedAt),
+		CreatedBy:       k.CreatedBy,
+		Status:          apikeyspb.ApiKeyStatusEnum_ApiKeyStatus(k.Status),
+		StatusUpdatedAt: timestamppb.New(k.StatusUpdatedAt),
+		StatusUpdatedBy: k.StatusUpdatedBy,
+	}
+
+	if k.UserId != nil {
+		key.UserId = wrapperspb.UInt64(*k.UserId)
+	}
+	if k.ClientId != nil {
+		key.ClientId = wrapperspb.UInt64(*k.ClientId)
+	}
+	if k.PermissionIds != nil {
+		key.Permissions = &apikeyspb.ApiKeyPermissions{PermissionIds: k.PermissionIds}
+	}
+	if k.ExpiresAt != nil {
+		key.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		key.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	return key
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apikeyspb "personal-website-v2/go-apis/identity/apikeys"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateCreatePersonalRequest(r *apikeyspb.CreatePersonalRequest) *errors.ApiError {
+	if err := validateName(r.Name); err != nil {
+		return err
+	}
+	return validatePermissions(r.Permissions)
+}
+
+func ValidateCreateServiceRequest(r *apikeyspb.CreateServiceRequest) *errors.ApiError {
+	if r.ClientId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid client id")
+	}
+	if err := validateName(r.Name); err != nil {
+		return err
+	}
+	return validatePermissions(r.Permissions)
+}
+
+func ValidateAuthenticateRequest(r *apikeyspb.AuthenticateRequest) *errors.ApiError {
+	if len(r.Key) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "key is empty")
+	}
+	return nil
+}
+
+func ValidateAuthenticateByIdRequest(r *apikeyspb.AuthenticateByIdRequest) *errors.ApiError {
+	if r.Id == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid id")
+	}
+	return nil
+}
+
+func validateName(name string) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func validatePermissions(ps *apikeyspb.ApiKeyPermissions) *errors.ApiError {
+	if ps == nil {
+		return nil
+	}
+
+	if len(ps.PermissionIds) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids is 0")
+	}
+
+	for _, id := range ps.PermissionIds {
+		if id == 0 {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid permission id")
+		}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package validation
 import (
 	authenticationpb "personal-website-v2/go-apis/identity/authentication"
 	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
 )
 
 func ValidateAuthenticateRequest(r *authenticationpb.AuthenticateRequest) *errors.ApiError {
@@ -42,3 +43,17 @@ func ValidateAuthenticateClientRequest(r *authenticationpb.AuthenticateClientReq
 	}
 	return nil
 }
+
+func ValidateCreateServiceClientTokenRequest(r *authenticationpb.CreateServiceClientTokenRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.ClientSecret) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "clientSecret is empty")
+	}
+	return nil
+}
+
+func ValidateAuthenticateServiceClientRequest(r *authenticationpb.AuthenticateServiceClientRequest) *errors.ApiError {
+	if len(r.ClientToken) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "clientToken is empty")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authorizationpb "personal-website-v2/go-apis/identity/authorization"
+	clientspb "personal-website-v2/go-apis/identity/clients"
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	permissionspb "personal-website-v2/go-apis/identity/permissions"
+	rolespb "personal-website-v2/go-apis/identity/roles"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	userspb "personal-website-v2/go-apis/identity/users"
+	"personal-website-v2/identity/src/internal/authorization/models"
+)
+
+func ConvertToApiAuthorizationExplanation(e *models.AuthorizationExplanation) *authorizationpb.AuthorizationExplanation {
+	explanation := &authorizationpb.AuthorizationExplanation{
+		Group:       groupspb.UserGroup(e.Group),
+		Permissions: make([]*authorizationpb.PermissionExplanation, len(e.Permissions)),
+	}
+
+	if len(e.MemberGroups) > 0 {
+		explanation.MemberGroups = make([]groupspb.UserGroup, len(e.MemberGroups))
+		for i := 0; i < len(e.MemberGroups); i++ {
+			explanation.MemberGroups[i] = groupspb.UserGroup(e.MemberGroups[i])
+		}
+	}
+	if e.UserStatus.HasValue {
+		explanation.UserStatus = userspb.UserStatus(e.UserStatus.Value)
+	}
+	if e.ClientStatus.HasValue {
+		explanation.ClientStatus = clientspb.ClientStatus(e.ClientStatus.Value)
+	}
+
+	for i := 0; i < len(e.Permissions); i++ {
+		explanation.Permissions[i] = convertToApiPermissionExplanation(e.Permissions[i])
+	}
+	return explanation
+}
+
+func convertToApiPermissionExplanation(e *models.PermissionExplanation) *authorizationpb.PermissionExplanation {
+	explanation := &authorizationpb.PermissionExplanation{
+		PermissionId:     e.PermissionId,
+		PermissionName:   e.PermissionName,
+		PermissionStatus: permissionspb.PermissionStatusEnum_PermissionStatus(e.PermissionStatus),
+		Granted:          e.Granted,
+		MissingLink:      authorizationpb.MissingLinkEnum_MissingLink(e.MissingLink),
+		Roles:            make([]*authorizationpb.RoleExplanation, len(e.Roles)),
+	}
+
+	for i := 0; i < len(e.Roles); i++ {
+		r := e.Roles[i]
+		explanation.Roles[i] = &authorizationpb.RoleExplanation{
+			RoleId:          r.RoleId,
+			RoleName:        r.RoleName,
+			RoleStatus:      rolespb.RoleStatusEnum_RoleStatus(r.RoleStatus),
+			GrantedDirectly: r.GrantedDirectly,
+			Assignments:     make([]*authorizationpb.RoleAssignmentExplanation, len(r.Assignments)),
+		}
+
+		for j := 0; j < len(r.Assignments); j++ {
+			explanation.Roles[i].Assignments[j] = convertToApiRoleAssignmentExplanation(r.Assignments[j])
+		}
+	}
+	return explanation
+}
+
+func convertToApiRoleAssignmentExplanation(e *models.RoleAssignmentExplanation) *authorizationpb.RoleAssignmentExplanation {
+	explanation := &authorizationpb.RoleAssignmentExplanation{
+		RoleAssignmentId: e.RoleAssignmentId,
+		AssigneeType:     assignmentspb.AssigneeTypeEnum_AssigneeType(e.AssigneeType),
+		AssigneeId:       e.AssigneeId,
+		Status:           assignmentspb.RoleAssignmentStatusEnum_RoleAssignmentStatus(e.Status),
+		Effective:        e.Effective,
+	}
+
+	if e.ValidFrom != nil {
+		explanation.ValidFrom = timestamppb.New(*e.ValidFrom)
+	}
+	if e.ValidUntil != nil {
+		explanation.ValidUntil = timestamppb.New(*e.ValidUntil)
+	}
+	return explanation
+}
@@ -23,5 +23,13 @@ func ValidateAuthorizeRequest(r *authorizationpb.AuthorizeRequest) *errors.ApiEr
 	if len(r.RequiredPermissionIds) == 0 {
 		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of required permission ids is 0")
 	}
+	for _, r := range r.Resources {
+		if r == nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "resource is null")
+		}
+		if r.TypeId == 0 {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid resource type id")
+		}
+	}
 	return nil
 }
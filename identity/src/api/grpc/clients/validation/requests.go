@@ -36,3 +36,10 @@ func ValidateCreateMobileClientRequest(r *clientspb.CreateMobileClientRequest) *
 	}
 	return nil
 }
+
+func ValidateCreateServiceClientRequest(r *clientspb.CreateServiceClientRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Ip) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
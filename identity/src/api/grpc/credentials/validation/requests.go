@@ -0,0 +1,77 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	credentialspb "personal-website-v2/go-apis/identity/credentials"
+	mfavalidation "personal-website-v2/identity/src/api/grpc/mfa/validation"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateSetPasswordRequest(r *credentialspb.SetPasswordRequest) *errors.ApiError {
+	if len(r.Password) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "password is empty")
+	}
+	return nil
+}
+
+func ValidateChangePasswordRequest(r *credentialspb.ChangePasswordRequest) *errors.ApiError {
+	if len(r.CurrentPassword) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "currentPassword is empty")
+	}
+	if len(r.NewPassword) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "newPassword is empty")
+	}
+	return nil
+}
+
+func ValidateSignInWithPasswordRequest(r *credentialspb.SignInWithPasswordRequest) *errors.ApiError {
+	if (r.Name == nil) == (r.Email == nil) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "either name or email must be specified")
+	}
+	if r.Name != nil && strings.IsEmptyOrWhitespace(r.Name.Value) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	if r.Email != nil && strings.IsEmptyOrWhitespace(r.Email.Value) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "email is empty")
+	}
+	if len(r.Password) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "password is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.UserAgent) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "userAgent is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Ip) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
+
+func ValidateCompleteSignInRequest(r *credentialspb.CompleteSignInRequest) *errors.ApiError {
+	if len(r.ChallengeToken) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "challengeToken is empty")
+	}
+	if err := mfavalidation.ValidateMfaMethod(r.Method); err != nil {
+		return err
+	}
+	if strings.IsEmptyOrWhitespace(r.Code) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "code is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Ip) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
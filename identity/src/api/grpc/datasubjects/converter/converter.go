@@ -0,0 +1,67 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	datasubjectspb "personal-website-v2/go-apis/identity/datasubjects"
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+)
+
+func ConvertToApiDataSubjectRequest(r *dbmodels.DataSubjectRequest) *datasubjectspb.DataSubjectRequest {
+	req := &datasubjectspb.DataSubjectRequest{
+		Id:              r.Id,
+		UserId:          r.UserId,
+		Type:            datasubjectspb.DataSubjectRequestTypeEnum_DataSubjectRequestType(r.Type),
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+		CreatedBy:       r.CreatedBy,
+		UpdatedAt:       timestamppb.New(r.UpdatedAt),
+		UpdatedBy:       r.UpdatedBy,
+		Status:          datasubjectspb.DataSubjectRequestStatusEnum_DataSubjectRequestStatus(r.Status),
+		StatusUpdatedAt: timestamppb.New(r.StatusUpdatedAt),
+		StatusUpdatedBy: r.StatusUpdatedBy,
+	}
+
+	if r.StatusComment != nil {
+		req.StatusComment = wrapperspb.String(*r.StatusComment)
+	}
+	if r.CompletedAt != nil {
+		req.CompletedAt = timestamppb.New(*r.CompletedAt)
+	}
+	return req
+}
+
+func ConvertToApiDataSubjectRequestStep(s *dbmodels.DataSubjectRequestStep) *datasubjectspb.DataSubjectRequestStep {
+	step := &datasubjectspb.DataSubjectRequestStep{
+		Step:   datasubjectspb.DataSubjectRequestStepEnum_DataSubjectRequestStep(s.Step),
+		Status: datasubjectspb.DataSubjectRequestStepStatusEnum_DataSubjectRequestStepStatus(s.Status),
+	}
+
+	if s.ActionId.Valid {
+		step.ActionId = wrapperspb.String(s.ActionId.UUID.String())
+	}
+	if s.StartedAt != nil {
+		step.StartedAt = timestamppb.New(*s.StartedAt)
+	}
+	if s.CompletedAt != nil {
+		step.CompletedAt = timestamppb.New(*s.CompletedAt)
+	}
+	if s.ErrorMessage != nil {
+		step.ErrorMessage = wrapperspb.String(*s.ErrorMessage)
+	}
+	return step
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+)
+
+func ConvertToApiUserGroup(g *dbmodels.UserGroup) *usergroupspb.UserGroup {
+	return &usergroupspb.UserGroup{
+		Id:          uint64(g.Id),
+		Name:        g.Name,
+		IsBuiltIn:   g.IsBuiltIn,
+		CreatedAt:   timestamppb.New(g.CreatedAt),
+		CreatedBy:   g.CreatedBy,
+		UpdatedAt:   timestamppb.New(g.UpdatedAt),
+		UpdatedBy:   g.UpdatedBy,
+		Description: g.Description,
+	}
+}
+
+func ConvertToApiUserGroupMember(m *dbmodels.UserGroupMember) *memberspb.UserGroupMember {
+	return &memberspb.UserGroupMember{
+		Id:        m.Id,
+		GroupId:   uint64(m.GroupId),
+		UserId:    m.UserId,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		CreatedBy: m.CreatedBy,
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package members
+
+import (
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateAddRequest(r *memberspb.AddRequest) *errors.ApiError {
+	return validateGroupIdAndUserId(r.GroupId, r.UserId)
+}
+
+func ValidateRemoveRequest(r *memberspb.RemoveRequest) *errors.ApiError {
+	return validateGroupIdAndUserId(r.GroupId, r.UserId)
+}
+
+func ValidateIsMemberRequest(r *memberspb.IsMemberRequest) *errors.ApiError {
+	return validateGroupIdAndUserId(r.GroupId, r.UserId)
+}
+
+func validateGroupIdAndUserId(groupId, userId uint64) *errors.ApiError {
+	if groupId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid group id")
+	}
+	if userId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid user id")
+	}
+	return nil
+}
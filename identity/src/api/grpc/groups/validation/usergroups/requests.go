@@ -0,0 +1,58 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usergroups
+
+import (
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateCreateRequest(r *usergroupspb.CreateRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Description) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "description is empty")
+	}
+	return nil
+}
+
+func ValidateUpdateRequest(r *usergroupspb.UpdateRequest) *errors.ApiError {
+	if r.Id == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid id")
+	}
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Description) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "description is empty")
+	}
+	return nil
+}
+
+func ValidateGetByNameRequest(r *usergroupspb.GetByNameRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func ValidateExistsRequest(r *usergroupspb.ExistsRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	"personal-website-v2/identity/src/internal/lockouts/models"
+)
+
+func ConvertToApiLockoutInfo(i *models.LockoutInfo) *lockoutspb.LockoutInfo {
+	info := &lockoutspb.LockoutInfo{
+		TargetType:     lockoutspb.LockoutTargetTypeEnum_LockoutTargetType(i.TargetType),
+		TargetId:       i.TargetId,
+		Status:         lockoutspb.LockoutStatusEnum_LockoutStatus(i.Status),
+		FailedAttempts: int32(i.FailedAttempts),
+		LockoutCount:   int32(i.LockoutCount),
+	}
+
+	if i.FirstFailedAttemptAt.HasValue {
+		info.FirstFailedAttemptAt = timestamppb.New(i.FirstFailedAttemptAt.Value)
+	}
+	if i.LastFailedAttemptAt.HasValue {
+		info.LastFailedAttemptAt = timestamppb.New(i.LastFailedAttemptAt.Value)
+	}
+	if i.LockedOutAt.HasValue {
+		info.LockedOutAt = timestamppb.New(i.LockedOutAt.Value)
+	}
+	if i.LockedUntil.HasValue {
+		info.LockedUntil = timestamppb.New(i.LockedUntil.Value)
+	}
+	return info
+}
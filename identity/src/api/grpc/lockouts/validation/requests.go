@@ -0,0 +1,38 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateGetInfoRequest(r *lockoutspb.GetInfoRequest) *errors.ApiError {
+	return validateTarget(r.TargetType, r.TargetId)
+}
+
+func ValidateUnlockRequest(r *lockoutspb.UnlockRequest) *errors.ApiError {
+	return validateTarget(r.TargetType, r.TargetId)
+}
+
+func validateTarget(t lockoutspb.LockoutTargetTypeEnum_LockoutTargetType, id uint64) *errors.ApiError {
+	if _, ok := lockoutspb.LockoutTargetTypeEnum_LockoutTargetType_name[int32(t)]; !ok || t == lockoutspb.LockoutTargetTypeEnum_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid targetType")
+	}
+	if id == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid targetId")
+	}
+	return nil
+}
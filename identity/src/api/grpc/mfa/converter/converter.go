@@ -0,0 +1,39 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	"personal-website-v2/identity/src/internal/mfa/models"
+)
+
+func ConvertToApiUserMfaStatus(s *models.UserMfaStatus) *mfapb.UserMfaStatus {
+	status := &mfapb.UserMfaStatus{
+		IsTotpEnabled:     s.IsTotpEnabled,
+		RecoveryCodesLeft: int32(s.RecoveryCodesLeft),
+		IsRequired:        s.IsRequired,
+	}
+
+	if s.TotpEnabledAt.HasValue {
+		status.TotpEnabledAt = timestamppb.New(s.TotpEnabledAt.Value)
+	}
+	return status
+}
+
+func ConvertToMfaMethod(m mfapb.MfaMethodEnum_MfaMethod) models.MfaMethod {
+	return models.MfaMethod(m)
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateStartTotpEnrollmentRequest(r *mfapb.StartTotpEnrollmentRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func ValidateConfirmTotpEnrollmentRequest(r *mfapb.ConfirmTotpEnrollmentRequest) *errors.ApiError {
+	if err := validateUserId(r.UserId); err != nil {
+		return err
+	}
+	if strings.IsEmptyOrWhitespace(r.Code) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "code is empty")
+	}
+	return nil
+}
+
+func ValidateDisableTotpRequest(r *mfapb.DisableTotpRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func ValidateRegenerateRecoveryCodesRequest(r *mfapb.RegenerateRecoveryCodesRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func ValidateGetStatusRequest(r *mfapb.GetStatusRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func ValidateMfaMethod(m mfapb.MfaMethodEnum_MfaMethod) *errors.ApiError {
+	if _, ok := mfapb.MfaMethodEnum_MfaMethod_name[int32(m)]; !ok || m == mfapb.MfaMethodEnum_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid method")
+	}
+	return nil
+}
+
+func validateUserId(id uint64) *errors.ApiError {
+	if id == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid userId")
+	}
+	return nil
+}
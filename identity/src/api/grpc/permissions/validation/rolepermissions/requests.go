@@ -0,0 +1,55 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rolepermissions
+
+import (
+	rolepermissionspb "personal-website-v2/go-apis/identity/permissions/rolepermissions"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateGrantRequest(r *rolepermissionspb.GrantRequest) *errors.ApiError {
+	if len(r.PermissionIds) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids is 0")
+	}
+	return nil
+}
+
+func ValidateRevokeRequest(r *rolepermissionspb.RevokeRequest) *errors.ApiError {
+	if len(r.PermissionIds) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids is 0")
+	}
+	return nil
+}
+
+func ValidateRevokeFromAllRequest(r *rolepermissionspb.RevokeFromAllRequest) *errors.ApiError {
+	if len(r.PermissionIds) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids is 0")
+	}
+	return nil
+}
+
+func ValidateUpdateRequest(r *rolepermissionspb.UpdateRequest) *errors.ApiError {
+	if len(r.PermissionIdsToGrant) == 0 && len(r.PermissionIdsToRevoke) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids to grant and permission ids to revoke is 0")
+	}
+	return nil
+}
+
+func ValidateAreGrantedRequest(r *rolepermissionspb.AreGrantedRequest) *errors.ApiError {
+	if len(r.PermissionIds) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of permission ids is 0")
+	}
+	return nil
+}
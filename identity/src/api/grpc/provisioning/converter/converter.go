@@ -0,0 +1,73 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	"personal-website-v2/identity/src/internal/provisioning/models"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+)
+
+func ConvertToManifest(m *provisioningpb.Manifest) *models.Manifest {
+	gs := make([]*models.ManifestPermissionGroup, len(m.PermissionGroups))
+	for i, g := range m.PermissionGroups {
+		gs[i] = &models.ManifestPermissionGroup{
+			Name:        g.Name,
+			Description: g.Description,
+		}
+	}
+
+	ps := make([]*models.ManifestPermission, len(m.Permissions))
+	for i, p := range m.Permissions {
+		ps[i] = &models.ManifestPermission{
+			Name:        p.Name,
+			Group:       p.Group,
+			Description: p.Description,
+		}
+	}
+
+	rs := make([]*models.ManifestRole, len(m.Roles))
+	for i, r := range m.Roles {
+		rs[i] = &models.ManifestRole{
+			Name:        r.Name,
+			Type:        rolemodels.RoleType(r.Type),
+			Title:       r.Title,
+			Description: r.Description,
+		}
+	}
+
+	grs := make([]*models.ManifestGrant, len(m.Grants))
+	for i, g := range m.Grants {
+		grs[i] = &models.ManifestGrant{
+			Role:        g.Role,
+			Permissions: g.Permissions,
+		}
+	}
+
+	return &models.Manifest{
+		PermissionGroups: gs,
+		Permissions:      ps,
+		Roles:            rs,
+		Grants:           grs,
+	}
+}
+
+func ConvertToApiChange(c *models.Change) *provisioningpb.Change {
+	return &provisioningpb.Change{
+		Type:        provisioningpb.ChangeTypeEnum_ChangeType(c.Type),
+		Name:        c.Name,
+		Permissions: c.Permissions,
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidatePlanRequest(r *provisioningpb.PlanRequest) *errors.ApiError {
+	if r.Manifest == nil {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "manifest is null")
+	}
+	return nil
+}
+
+func ValidateApplyRequest(r *provisioningpb.ApplyRequest) *errors.ApiError {
+	if r.Manifest == nil {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "manifest is null")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	registrationpb "personal-website-v2/go-apis/identity/registration"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateRegisterRequest(r *registrationpb.RegisterRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Email) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "email is empty")
+	}
+	if len(r.Password) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "password is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.FirstName) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "firstName is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.LastName) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "lastName is empty")
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.CheckValid(); err != nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid birthDate")
+		}
+	}
+	if _, ok := personalinfopb.GenderEnum_Gender_name[int32(r.Gender)]; !ok {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid gender")
+	}
+	return nil
+}
+
+func ValidateVerifyEmailRequest(r *registrationpb.VerifyEmailRequest) *errors.ApiError {
+	if len(r.Token) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "token is empty")
+	}
+	return nil
+}
+
+func ValidateResendVerificationEmailRequest(r *registrationpb.ResendVerificationEmailRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Email) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "email is empty")
+	}
+	return nil
+}
+
+func ValidateApproveRequest(r *registrationpb.ApproveRequest) *errors.ApiError {
+	if r.UserId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid userId")
+	}
+	return nil
+}
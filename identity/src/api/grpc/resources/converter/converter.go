@@ -0,0 +1,55 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	resourcespb "personal-website-v2/go-apis/identity/resources"
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+)
+
+func ConvertToApiResourceType(t *dbmodels.ResourceType) *resourcespb.ResourceType {
+	return &resourcespb.ResourceType{
+		Id:        t.Id,
+		Name:      t.Name,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		CreatedBy: t.CreatedBy,
+	}
+}
+
+func ConvertToApiResourceRoleAssignment(a *dbmodels.ResourceRoleAssignment) *roleassignmentspb.ResourceRoleAssignment {
+	ra := &roleassignmentspb.ResourceRoleAssignment{
+		Id:             a.Id,
+		RoleId:         a.RoleId,
+		AssignedTo:     a.AssignedTo,
+		AssigneeType:   assignmentspb.AssigneeTypeEnum_AssigneeType(a.AssigneeType),
+		ResourceTypeId: a.ResourceTypeId,
+		OwnerRule:      roleassignmentspb.ResourceOwnerRuleEnum_ResourceOwnerRule(a.OwnerRule),
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		CreatedBy:      a.CreatedBy,
+	}
+
+	if a.ResourceId != nil {
+		ra.ResourceId = wrapperspb.UInt64(*a.ResourceId)
+	}
+	if a.Description != nil {
+		ra.Description = wrapperspb.String(*a.Description)
+	}
+	return ra
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roleassignments
+
+import (
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateCreateRequest(r *roleassignmentspb.CreateRequest) *errors.ApiError {
+	if r.RoleId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid role id")
+	}
+	if r.ResourceTypeId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid resource type id")
+	}
+
+	switch r.OwnerRule {
+	case roleassignmentspb.ResourceOwnerRuleEnum_NONE:
+		if r.ResourceId == nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "resource id is missing")
+		}
+	case roleassignmentspb.ResourceOwnerRuleEnum_CREATED_BY_SELF:
+		if r.ResourceId != nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "resource id isn't allowed if the owner rule is specified")
+		}
+	default:
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid owner rule")
+	}
+
+	if r.Description != nil && strings.IsEmptyOrWhitespace(r.Description.Value) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "description is empty")
+	}
+	return validateAssigneeType(r.AssigneeType)
+}
+
+func ValidateGetAllByAssigneeRequest(r *roleassignmentspb.GetAllByAssigneeRequest) *errors.ApiError {
+	return validateAssigneeType(r.AssigneeType)
+}
+
+func validateAssigneeType(t assignmentspb.AssigneeTypeEnum_AssigneeType) *errors.ApiError {
+	switch t {
+	case assignmentspb.AssigneeTypeEnum_USER, assignmentspb.AssigneeTypeEnum_GROUP, assignmentspb.AssigneeTypeEnum_CLIENT:
+		return nil
+	}
+	return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid assignee type")
+}
@@ -18,7 +18,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	groupspb "personal-website-v2/go-apis/identity/groups"
 	rolespb "personal-website-v2/go-apis/identity/roles"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	userroleassignmentspb "personal-website-v2/go-apis/identity/roles/userroleassignments"
 	"personal-website-v2/identity/src/internal/roles/dbmodels"
 )
 
@@ -49,3 +53,75 @@ func ConvertToApiRole(r *dbmodels.Role) *rolespb.Role {
 	}
 	return role
 }
+
+func ConvertToApiRoleAssignment(a *dbmodels.RoleAssignment) *assignmentspb.RoleAssignment {
+	assignment := &assignmentspb.RoleAssignment{
+		Id:              a.Id,
+		RoleId:          a.RoleId,
+		AssignedTo:      a.AssignedTo,
+		AssigneeType:    assignmentspb.AssigneeTypeEnum_AssigneeType(a.AssigneeType),
+		CreatedAt:       timestamppb.New(a.CreatedAt),
+		CreatedBy:       a.CreatedBy,
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
+		UpdatedBy:       a.UpdatedBy,
+		Status:          assignmentspb.RoleAssignmentStatusEnum_RoleAssignmentStatus(a.Status),
+		StatusUpdatedAt: timestamppb.New(a.StatusUpdatedAt),
+		StatusUpdatedBy: a.StatusUpdatedBy,
+	}
+
+	if a.StatusComment != nil {
+		assignment.StatusComment = wrapperspb.String(*a.StatusComment)
+	}
+	if a.Description != nil {
+		assignment.Description = *a.Description
+	}
+	if a.ValidFrom != nil {
+		assignment.ValidFrom = timestamppb.New(*a.ValidFrom)
+	}
+	if a.ValidUntil != nil {
+		assignment.ValidUntil = timestamppb.New(*a.ValidUntil)
+	}
+	return assignment
+}
+
+func ConvertToApiUserRoleAssignment(a *dbmodels.UserRoleAssignment) *userroleassignmentspb.UserRoleAssignment {
+	assignment := &userroleassignmentspb.UserRoleAssignment{
+		Id:               a.Id,
+		RoleAssignmentId: a.RoleAssignmentId,
+		UserId:           a.UserId,
+		RoleId:           a.RoleId,
+		CreatedAt:        timestamppb.New(a.CreatedAt),
+		CreatedBy:        a.CreatedBy,
+		UpdatedAt:        timestamppb.New(a.UpdatedAt),
+		UpdatedBy:        a.UpdatedBy,
+		Status:           userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus(a.Status),
+		StatusUpdatedAt:  timestamppb.New(a.StatusUpdatedAt),
+		StatusUpdatedBy:  a.StatusUpdatedBy,
+	}
+
+	if a.StatusComment != nil {
+		assignment.StatusComment = wrapperspb.String(*a.StatusComment)
+	}
+	return assignment
+}
+
+func ConvertToApiGroupRoleAssignment(a *dbmodels.GroupRoleAssignment) *grouproleassignmentspb.GroupRoleAssignment {
+	assignment := &grouproleassignmentspb.GroupRoleAssignment{
+		Id:               a.Id,
+		RoleAssignmentId: a.RoleAssignmentId,
+		Group:            groupspb.UserGroup(a.Group),
+		RoleId:           a.RoleId,
+		CreatedAt:        timestamppb.New(a.CreatedAt),
+		CreatedBy:        a.CreatedBy,
+		UpdatedAt:        timestamppb.New(a.UpdatedAt),
+		UpdatedBy:        a.UpdatedBy,
+		Status:           grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus(a.Status),
+		StatusUpdatedAt:  timestamppb.New(a.StatusUpdatedAt),
+		StatusUpdatedBy:  a.StatusUpdatedBy,
+	}
+
+	if a.StatusComment != nil {
+		assignment.StatusComment = wrapperspb.String(*a.StatusComment)
+	}
+	return assignment
+}
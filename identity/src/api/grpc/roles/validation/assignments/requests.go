@@ -0,0 +1,90 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assignments
+
+import (
+	"math"
+
+	clientspb "personal-website-v2/go-apis/identity/clients"
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateCreateRequest(r *assignmentspb.CreateRequest) *errors.ApiError {
+	if r.ValidFrom != nil {
+		if err := r.ValidFrom.CheckValid(); err != nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid validFrom")
+		}
+	}
+	if r.ValidUntil != nil {
+		if err := r.ValidUntil.CheckValid(); err != nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid validUntil")
+		}
+	}
+	return validateAssignee(r.AssignedTo, r.AssigneeType)
+}
+
+func ValidateExtendRequest(r *assignmentspb.ExtendRequest) *errors.ApiError {
+	if r.ValidUntil == nil {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "validUntil is missing")
+	}
+	if err := r.ValidUntil.CheckValid(); err != nil {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid validUntil")
+	}
+	return nil
+}
+
+func ValidateGetAllExpiringWithinRequest(r *assignmentspb.GetAllExpiringWithinRequest) *errors.ApiError {
+	if r.Period == nil {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "period is missing")
+	}
+	if err := r.Period.CheckValid(); err != nil || r.Period.AsDuration() <= 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid period")
+	}
+	return nil
+}
+
+func ValidateGetByRoleIdAndAssigneeRequest(r *assignmentspb.GetByRoleIdAndAssigneeRequest) *errors.ApiError {
+	return validateAssignee(r.AssigneeId, r.AssigneeType)
+}
+
+func ValidateExistsRequest(r *assignmentspb.ExistsRequest) *errors.ApiError {
+	return validateAssignee(r.AssigneeId, r.AssigneeType)
+}
+
+func ValidateIsAssignedRequest(r *assignmentspb.IsAssignedRequest) *errors.ApiError {
+	return validateAssignee(r.AssigneeId, r.AssigneeType)
+}
+
+func validateAssignee(assigneeId uint64, assigneeType assignmentspb.AssigneeTypeEnum_AssigneeType) *errors.ApiError {
+	switch assigneeType {
+	case assignmentspb.AssigneeTypeEnum_USER:
+		return nil
+	case assignmentspb.AssigneeTypeEnum_GROUP:
+		// the group can be a built-in group or a group created by users
+		if assigneeId == uint64(groupspb.UserGroup_USER_GROUP_UNSPECIFIED) || assigneeId > math.MaxInt32 {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid assignee id")
+		}
+		return nil
+	case assignmentspb.AssigneeTypeEnum_CLIENT:
+		// only service clients can be assignees
+		if clientspb.ClientTypeEnum_ClientType(byte(assigneeId)) != clientspb.ClientTypeEnum_SERVICE {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid assignee id")
+		}
+		return nil
+	}
+	return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid assignee type")
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouproleassignments
+
+import (
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateGetAllByGroupRequest(r *grouproleassignmentspb.GetAllByGroupRequest) *errors.ApiError {
+	return validateGroup(r.Group)
+}
+
+func ValidateExistsRequest(r *grouproleassignmentspb.ExistsRequest) *errors.ApiError {
+	return validateGroup(r.Group)
+}
+
+func ValidateIsAssignedRequest(r *grouproleassignmentspb.IsAssignedRequest) *errors.ApiError {
+	return validateGroup(r.Group)
+}
+
+func ValidateGetGroupRoleIdsByGroupRequest(r *grouproleassignmentspb.GetGroupRoleIdsByGroupRequest) *errors.ApiError {
+	return validateGroup(r.Group)
+}
+
+func validateGroup(g groupspb.UserGroup) *errors.ApiError {
+	// the group can be a built-in group or a group created by users
+	if g <= groupspb.UserGroup_USER_GROUP_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid group")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inheritance
+
+import (
+	inheritancepb "personal-website-v2/go-apis/identity/roles/inheritance"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateAddParentRequest(r *inheritancepb.AddParentRequest) *errors.ApiError {
+	if r.RoleId == r.ParentRoleId {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "role can't inherit from itself")
+	}
+	return nil
+}
@@ -17,11 +17,52 @@ package roles
 import (
 	rolespb "personal-website-v2/go-apis/identity/roles"
 	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
 )
 
+func ValidateCreateRequest(r *rolespb.CreateRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	if r.Type == rolespb.RoleTypeEnum_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid type")
+	}
+	if _, ok := rolespb.RoleTypeEnum_RoleType_name[int32(r.Type)]; !ok {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid type")
+	}
+	if strings.IsEmptyOrWhitespace(r.Title) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "title is empty")
+	}
+	if strings.IsEmptyOrWhitespace(r.Description) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "description is empty")
+	}
+	return nil
+}
+
+func ValidateGetByNameRequest(r *rolespb.GetByNameRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func ValidateGetAllByIdsRequest(r *rolespb.GetAllByIdsRequest) *errors.ApiError {
+	if len(r.Ids) == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of ids is 0")
+	}
+	return nil
+}
+
 func ValidateGetAllByNamesRequest(r *rolespb.GetAllByNamesRequest) *errors.ApiError {
 	if len(r.Names) == 0 {
 		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "number of names is 0")
 	}
 	return nil
 }
+
+func ValidateExistsRequest(r *rolespb.ExistsRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
+	usersessionspb "personal-website-v2/go-apis/identity/sessions/usersessions"
+	"personal-website-v2/identity/src/internal/sessions/models"
+)
+
+// ConvertToApiActiveSession converts the active session to the API active session.
+// currentClientId is the ID of the client that made the request.
+func ConvertToApiActiveSession(s *models.ActiveSession, currentClientId uint64) *activesessionspb.ActiveSession {
+	session := &activesessionspb.ActiveSession{
+		Id:        s.Id,
+		ClientId:  s.ClientId,
+		Type:      usersessionspb.UserSessionTypeEnum_UserSessionType(s.Type),
+		StartTime: timestamppb.New(s.StartTime),
+		FirstIp:   s.FirstIP,
+		IsCurrent: s.ClientId == currentClientId,
+	}
+
+	if s.UserAgent != nil {
+		session.UserAgent = wrapperspb.String(*s.UserAgent)
+	}
+	if s.LastActivityTime != nil {
+		session.LastActivityTime = timestamppb.New(*s.LastActivityTime)
+	}
+	if s.LastActivityIP != nil {
+		session.LastActivityIp = wrapperspb.String(*s.LastActivityIP)
+	}
+	return session
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
+	"personal-website-v2/pkg/api/errors"
+)
+
+func ValidateGetAllByUserIdRequest(r *activesessionspb.GetAllByUserIdRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func ValidateRevokeRequest(r *activesessionspb.RevokeRequest) *errors.ApiError {
+	if err := validateUserId(r.UserId); err != nil {
+		return err
+	}
+	if r.SessionId == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid sessionId")
+	}
+	return nil
+}
+
+func ValidateRevokeAllOtherRequest(r *activesessionspb.RevokeAllOtherRequest) *errors.ApiError {
+	return validateUserId(r.UserId)
+}
+
+func validateUserId(id uint64) *errors.ApiError {
+	if id == 0 {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid userId")
+	}
+	return nil
+}
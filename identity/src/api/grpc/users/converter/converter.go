@@ -0,0 +1,99 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	userspb "personal-website-v2/go-apis/identity/users"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	"personal-website-v2/identity/src/internal/users/dbmodels"
+)
+
+func ConvertToApiUser(u *dbmodels.User) *userspb.User {
+	user := &userspb.User{
+		Id:              u.Id,
+		Type:            userspb.UserTypeEnum_UserType(u.Type),
+		Group:           groupspb.UserGroup(u.Group),
+		CreatedAt:       timestamppb.New(u.CreatedAt),
+		CreatedBy:       u.CreatedBy,
+		UpdatedAt:       timestamppb.New(u.UpdatedAt),
+		UpdatedBy:       u.UpdatedBy,
+		Status:          userspb.UserStatus(u.Status),
+		StatusUpdatedAt: timestamppb.New(u.StatusUpdatedAt),
+		StatusUpdatedBy: u.StatusUpdatedBy,
+	}
+
+	if u.Name != nil {
+		user.Name = wrapperspb.String(*u.Name)
+	}
+	if u.StatusComment != nil {
+		user.StatusComment = wrapperspb.String(*u.StatusComment)
+	}
+	if u.Email != nil {
+		user.Email = wrapperspb.String(*u.Email)
+	}
+	if u.FirstSignInTime != nil {
+		user.FirstSignInTime = timestamppb.New(*u.FirstSignInTime)
+	}
+	if u.FirstSignInIP != nil {
+		user.FirstSignInIp = wrapperspb.String(*u.FirstSignInIP)
+	}
+	if u.LastSignInTime != nil {
+		user.LastSignInTime = timestamppb.New(*u.LastSignInTime)
+	}
+	if u.LastSignInIP != nil {
+		user.LastSignInIp = wrapperspb.String(*u.LastSignInIP)
+	}
+	if u.LastSignOutTime != nil {
+		user.LastSignOutTime = timestamppb.New(*u.LastSignOutTime)
+	}
+	if u.LastActivityTime != nil {
+		user.LastActivityTime = timestamppb.New(*u.LastActivityTime)
+	}
+	if u.LastActivityIP != nil {
+		user.LastActivityIp = wrapperspb.String(*u.LastActivityIP)
+	}
+	return user
+}
+
+func ConvertToApiPersonalInfo(i *dbmodels.PersonalInfo) *personalinfopb.PersonalInfo {
+	info := &personalinfopb.PersonalInfo{
+		Id:          i.Id,
+		UserId:      i.UserId,
+		CreatedAt:   timestamppb.New(i.CreatedAt),
+		CreatedBy:   i.CreatedBy,
+		UpdatedAt:   timestamppb.New(i.UpdatedAt),
+		UpdatedBy:   i.UpdatedBy,
+		IsDeleted:   i.IsDeleted,
+		FirstName:   i.FirstName,
+		LastName:    i.LastName,
+		DisplayName: i.DisplayName,
+		Gender:      personalinfopb.GenderEnum_Gender(i.Gender),
+	}
+
+	if i.DeletedAt != nil {
+		info.DeletedAt = timestamppb.New(*i.DeletedAt)
+	}
+	if i.DeletedBy != nil {
+		info.DeletedBy = wrapperspb.UInt64(*i.DeletedBy)
+	}
+	if i.BirthDate != nil {
+		info.BirthDate = timestamppb.New(*i.BirthDate)
+	}
+	return info
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	groupspb "personal-website-v2/go-apis/identity/groups"
+	userspb "personal-website-v2/go-apis/identity/users"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	"personal-website-v2/pkg/api/errors"
+	"personal-website-v2/pkg/base/strings"
+)
+
+func ValidateCreateRequest(r *userspb.CreateRequest) *errors.ApiError {
+	if r.Type == userspb.UserTypeEnum_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid type")
+	}
+	if r.Group == groupspb.UserGroup_USER_GROUP_UNSPECIFIED || r.Group == groupspb.UserGroup_ANONYMOUS_USERS {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid group")
+	}
+	if r.Status == userspb.UserStatus_USER_STATUS_UNSPECIFIED {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid status")
+	}
+	if r.Email != nil && strings.IsEmptyOrWhitespace(r.Email.Value) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "email is empty")
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.CheckValid(); err != nil {
+			return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid birthDate")
+		}
+	}
+	if _, ok := personalinfopb.GenderEnum_Gender_name[int32(r.Gender)]; !ok {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "invalid gender")
+	}
+	return nil
+}
+
+func ValidateGetByNameRequest(r *userspb.GetByNameRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func ValidateGetByEmailRequest(r *userspb.GetByEmailRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Email) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "email is empty")
+	}
+	return nil
+}
+
+func ValidateGetIdByNameRequest(r *userspb.GetIdByNameRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func ValidateSetNameByIdRequest(r *userspb.SetNameByIdRequest) *errors.ApiError {
+	if r.Name != nil && strings.IsEmptyOrWhitespace(r.Name.Value) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
+
+func ValidateNameExistsRequest(r *userspb.NameExistsRequest) *errors.ApiError {
+	if strings.IsEmptyOrWhitespace(r.Name) {
+		return errors.NewApiError(errors.ApiErrorCodeInvalidData, "name is empty")
+	}
+	return nil
+}
@@ -15,7 +15,12 @@
 package app
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"log"
@@ -33,33 +38,90 @@ import (
 
 	"personal-website-v2/api-clients/appmanager"
 	"personal-website-v2/api-clients/loggingmanager"
+	apikeyspb "personal-website-v2/go-apis/identity/apikeys"
 	authenticationpb "personal-website-v2/go-apis/identity/authentication"
 	authorizationpb "personal-website-v2/go-apis/identity/authorization"
 	clientspb "personal-website-v2/go-apis/identity/clients"
+	credentialspb "personal-website-v2/go-apis/identity/credentials"
+	datasubjectspb "personal-website-v2/go-apis/identity/datasubjects"
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	impersonationpb "personal-website-v2/go-apis/identity/impersonation"
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	mfapb "personal-website-v2/go-apis/identity/mfa"
 	permissionspb "personal-website-v2/go-apis/identity/permissions"
+	rolepermissionspb "personal-website-v2/go-apis/identity/permissions/rolepermissions"
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	registrationpb "personal-website-v2/go-apis/identity/registration"
+	resourcespb "personal-website-v2/go-apis/identity/resources"
+	resourceroleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
 	rolespb "personal-website-v2/go-apis/identity/roles"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	inheritancepb "personal-website-v2/go-apis/identity/roles/inheritance"
+	userroleassignmentspb "personal-website-v2/go-apis/identity/roles/userroleassignments"
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
 	userspb "personal-website-v2/go-apis/identity/users"
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
 	iappconfig "personal-website-v2/identity/src/app/config"
+	apikeyservices "personal-website-v2/identity/src/grpcservices/apikeys"
 	authenticationservices "personal-website-v2/identity/src/grpcservices/authentication"
 	authorizationservices "personal-website-v2/identity/src/grpcservices/authorization"
 	clientservices "personal-website-v2/identity/src/grpcservices/clients"
+	credentialservices "personal-website-v2/identity/src/grpcservices/credentials"
+	datasubjectservices "personal-website-v2/identity/src/grpcservices/datasubjects"
+	groupservices "personal-website-v2/identity/src/grpcservices/groups"
+	impersonationservices "personal-website-v2/identity/src/grpcservices/impersonation"
+	lockoutservices "personal-website-v2/identity/src/grpcservices/lockouts"
+	mfaservices "personal-website-v2/identity/src/grpcservices/mfa"
 	permissionservices "personal-website-v2/identity/src/grpcservices/permissions"
+	provisioningservices "personal-website-v2/identity/src/grpcservices/provisioning"
+	registrationservices "personal-website-v2/identity/src/grpcservices/registration"
+	resourceservices "personal-website-v2/identity/src/grpcservices/resources"
 	roleservices "personal-website-v2/identity/src/grpcservices/roles"
+	activesessionservices "personal-website-v2/identity/src/grpcservices/sessions/activesessions"
 	userservices "personal-website-v2/identity/src/grpcservices/users"
+	authorizationcontrollers "personal-website-v2/identity/src/httpcontrollers/authorization"
+	oidccontrollers "personal-website-v2/identity/src/httpcontrollers/oidc"
+	apikeymanager "personal-website-v2/identity/src/internal/apikeys/manager"
+	apikeyrevocationnotification "personal-website-v2/identity/src/internal/apikeys/revocation/notification"
 	authenticationmanager "personal-website-v2/identity/src/internal/authentication/manager"
+	authorizationcache "personal-website-v2/identity/src/internal/authorization/cache"
+	authorizationcacheinvalidation "personal-website-v2/identity/src/internal/authorization/cache/invalidation"
 	authorizationmanager "personal-website-v2/identity/src/internal/authorization/manager"
 	clientmanager "personal-website-v2/identity/src/internal/clients/manager"
+	credentialmanager "personal-website-v2/identity/src/internal/credentials/manager"
+	datasubjectmanager "personal-website-v2/identity/src/internal/datasubjects/manager"
+	datasubjectprocessing "personal-website-v2/identity/src/internal/datasubjects/processing"
 	ipostgres "personal-website-v2/identity/src/internal/db/postgres"
+	groupmanager "personal-website-v2/identity/src/internal/groups/manager"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	iidentity "personal-website-v2/identity/src/internal/identity"
+	impersonationmanager "personal-website-v2/identity/src/internal/impersonation/manager"
+	lockoutmanager "personal-website-v2/identity/src/internal/lockouts/manager"
+	lockoutmodels "personal-website-v2/identity/src/internal/lockouts/models"
+	lockoutunlocking "personal-website-v2/identity/src/internal/lockouts/unlocking"
+	mfamanager "personal-website-v2/identity/src/internal/mfa/manager"
+	mfamodels "personal-website-v2/identity/src/internal/mfa/models"
+	oidcmanager "personal-website-v2/identity/src/internal/oidc/manager"
+	oidcmodels "personal-website-v2/identity/src/internal/oidc/models"
+	oidcstores "personal-website-v2/identity/src/internal/oidc/stores"
 	permissionmanager "personal-website-v2/identity/src/internal/permissions/manager"
+	provisioningmanager "personal-website-v2/identity/src/internal/provisioning/manager"
+	registrationmanager "personal-website-v2/identity/src/internal/registration/manager"
+	resourcemanager "personal-website-v2/identity/src/internal/resources/manager"
+	roleexpiration "personal-website-v2/identity/src/internal/roles/expiration"
 	rolemanager "personal-website-v2/identity/src/internal/roles/manager"
 	rolestate "personal-website-v2/identity/src/internal/roles/state"
 	sessionmanager "personal-website-v2/identity/src/internal/sessions/manager"
+	sessionrevocation "personal-website-v2/identity/src/internal/sessions/revocation"
+	sessionrevocationnotification "personal-website-v2/identity/src/internal/sessions/revocation/notification"
 	useragentmanager "personal-website-v2/identity/src/internal/useragents/manager"
 	usermanager "personal-website-v2/identity/src/internal/users/manager"
 	"personal-website-v2/pkg/actions"
 	actionlogging "personal-website-v2/pkg/actions/logging"
 	"personal-website-v2/pkg/app"
+	appresources "personal-website-v2/pkg/app/resources"
 	"personal-website-v2/pkg/app/service"
 	"personal-website-v2/pkg/app/service/config"
 	actionencoding "personal-website-v2/pkg/app/service/helper/loggingerror/encoding/actions"
@@ -72,8 +134,10 @@ import (
 	appcontrollers "personal-website-v2/pkg/app/service/net/http/server/controllers/app"
 	"personal-website-v2/pkg/base/env"
 	"personal-website-v2/pkg/base/nullable"
+	kafkacomponent "personal-website-v2/pkg/components/kafka"
 	"personal-website-v2/pkg/db/postgres"
 	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/health"
 	"personal-website-v2/pkg/identity"
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/adapters/console"
@@ -90,6 +154,8 @@ import (
 	httpserver "personal-website-v2/pkg/net/http/server"
 	httpserverlogging "personal-website-v2/pkg/net/http/server/logging"
 	httpserverrouting "personal-website-v2/pkg/net/http/server/routing"
+	"personal-website-v2/pkg/net/tlsconfig"
+	"personal-website-v2/pkg/services/emailnotifier"
 	"personal-website-v2/pkg/web/identity/authn/cookies"
 )
 
@@ -112,13 +178,17 @@ type Application struct {
 	fileLoggerFactory logging.LoggerFactory[*context.LogEntryContext]
 	fileLogger        logging.Logger[*context.LogEntryContext]
 	configPath        string
-	config            *config.AppConfig[*iappconfig.Apis, struct{}]
+	config            *config.AppConfig[*iappconfig.Apis, *iappconfig.Services]
 	isStarted         atomic.Bool
 	isStopped         bool
 	wg                sync.WaitGroup
 	mu                sync.Mutex
 	done              chan struct{}
 
+	resources appresources.AppResources
+
+	healthChecker *health.Checker
+
 	identityManager identity.IdentityManager
 
 	tranManager   *actions.TransactionManager
@@ -136,25 +206,58 @@ type Application struct {
 	appManagerService     *appmanager.AppManagerService
 	loggingManagerService *loggingmanager.LoggingManagerService
 
-	userManager                *usermanager.UserManager
-	userPersonalInfoManager    *usermanager.UserPersonalInfoManager
-	clientManager              *clientmanager.ClientManager
-	roleManager                *rolemanager.RoleManager
-	roleAssignmentManager      *rolemanager.RoleAssignmentManager
-	userRoleAssignmentManager  *rolemanager.UserRoleAssignmentManager
-	groupRoleAssignmentManager *rolemanager.GroupRoleAssignmentManager
-	userRoleManager            *rolemanager.UserRoleManager
-	groupRoleManager           *rolemanager.GroupRoleManager
-	rolesState                 *rolestate.RolesState
-	permissionManager          *permissionmanager.PermissionManager
-	permissionGroupManager     *permissionmanager.PermissionGroupManager
-	rolePermissionManager      *permissionmanager.RolePermissionManager
-	userAgentManager           *useragentmanager.UserAgentManager
-	userSessionManager         *sessionmanager.UserSessionManager
-	userAgentSessionManager    *sessionmanager.UserAgentSessionManager
-	authnManager               *authenticationmanager.AuthenticationManager
-	tekManager                 *authenticationmanager.TokenEncryptionKeyManager
-	authzManager               *authorizationmanager.AuthorizationManager
+	emailNotifier emailnotifier.EmailNotifier
+
+	userManager                         *usermanager.UserManager
+	userPersonalInfoManager             *usermanager.UserPersonalInfoManager
+	clientManager                       *clientmanager.ClientManager
+	serviceClientTokenManager           *clientmanager.ServiceClientTokenManager
+	userGroupManager                    *groupmanager.UserGroupManager
+	userGroupMemberManager              *groupmanager.UserGroupMemberManager
+	roleManager                         *rolemanager.RoleManager
+	roleAssignmentManager               *rolemanager.RoleAssignmentManager
+	userRoleAssignmentManager           *rolemanager.UserRoleAssignmentManager
+	groupRoleAssignmentManager          *rolemanager.GroupRoleAssignmentManager
+	clientRoleAssignmentManager         *rolemanager.ClientRoleAssignmentManager
+	userRoleManager                     *rolemanager.UserRoleManager
+	groupRoleManager                    *rolemanager.GroupRoleManager
+	roleInheritanceManager              *rolemanager.RoleInheritanceManager
+	rolesState                          *rolestate.RolesState
+	permissionManager                   *permissionmanager.PermissionManager
+	permissionGroupManager              *permissionmanager.PermissionGroupManager
+	rolePermissionManager               *permissionmanager.RolePermissionManager
+	resourceTypeManager                 *resourcemanager.ResourceTypeManager
+	resourceRoleAssignmentManager       *resourcemanager.ResourceRoleAssignmentManager
+	userAgentManager                    *useragentmanager.UserAgentManager
+	userSessionManager                  *sessionmanager.UserSessionManager
+	userAgentSessionManager             *sessionmanager.UserAgentSessionManager
+	authnManager                        *authenticationmanager.AuthenticationManager
+	tekManager                          *authenticationmanager.TokenEncryptionKeyManager
+	authzManager                        *authorizationmanager.AuthorizationManager
+	userCredentialManager               *credentialmanager.UserCredentialManager
+	signInManager                       *credentialmanager.SignInManager
+	lockoutManager                      *lockoutmanager.LockoutManager
+	unlockService                       *lockoutunlocking.UnlockService
+	roleExpirationService               *roleexpiration.ExpirationService
+	userMfaManager                      *mfamanager.UserMfaManager
+	mfaChallengeManager                 *mfamanager.MfaChallengeManager
+	activeSessionManager                *sessionmanager.ActiveSessionManager
+	oidcManager                         *oidcmanager.OidcManager
+	registrationManager                 *registrationmanager.RegistrationManager
+	impersonationManager                *impersonationmanager.ImpersonationManager
+	dataSubjectRequestManager           *datasubjectmanager.DataSubjectRequestManager
+	dataSubjectRequestProcessingService *datasubjectprocessing.ProcessingService
+	provisioningManager                 *provisioningmanager.ProvisioningManager
+	apiKeyManager                       *apikeymanager.ApiKeyManager
+
+	authzCache                    *authorizationcache.AuthorizationCache
+	authzCacheInvalidator         *authorizationcacheinvalidation.CacheInvalidator
+	authzCacheInvalidationService *authorizationcacheinvalidation.CacheInvalidationService
+
+	sessionRevocationList                *sessionrevocation.SessionRevocationList
+	sessionRevocationNotifier            *sessionrevocationnotification.SessionRevocationNotifier
+	sessionRevocationNotificationService *sessionrevocationnotification.SessionRevocationNotificationService
+	apiKeyRevocationNotifier             *apikeyrevocationnotification.ApiKeyRevocationNotifier
 }
 
 var _ app.Application = (*Application)(nil)
@@ -288,6 +391,8 @@ func (a *Application) Start() (err error) {
 	a.env = env.NewEnvironment(a.config.Env)
 	a.info = app.NewApplicationInfo(a.config.AppInfo.Id, a.config.AppInfo.GroupId, a.config.AppInfo.Version)
 
+	a.configureResources()
+
 	if err = a.configureGrpcLogging(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure gRPC logging: %w", err)
 	}
@@ -328,6 +433,14 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] configure: %w", err)
 	}
 
+	if err = a.authzCacheInvalidationService.Start(); err != nil {
+		return fmt.Errorf("[app.Application.Start] start an authorization cache invalidation service: %w", err)
+	}
+
+	if err = a.sessionRevocationNotificationService.Start(); err != nil {
+		return fmt.Errorf("[app.Application.Start] start a session revocation notification service: %w", err)
+	}
+
 	if err = a.configureIdentity(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure the identity: %w", err)
 	}
@@ -336,6 +449,20 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] init an identity manager: %w", err)
 	}
 
+	if err = a.unlockService.Start(); err != nil {
+		return fmt.Errorf("[app.Application.Start] start an unlock service: %w", err)
+	}
+
+	if err = a.roleExpirationService.Start(); err != nil {
+		return fmt.Errorf("[app.Application.Start] start a role assignment expiration service: %w", err)
+	}
+
+	if err = a.dataSubjectRequestProcessingService.Start(); err != nil {
+		return fmt.Errorf("[app.Application.Start] start a data subject request processing service: %w", err)
+	}
+
+	a.configureHealthChecks()
+
 	if err = a.configureHttpServer(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure an HTTP server: %w", err)
 	}
@@ -370,7 +497,7 @@ func (a *Application) loadConfig() error {
 		return fmt.Errorf("[app.Application.loadConfig] read a file: %w", err)
 	}
 
-	config := new(config.AppConfig[*iappconfig.Apis, struct{}])
+	config := new(config.AppConfig[*iappconfig.Apis, *iappconfig.Services])
 
 	if err = json.Unmarshal(c, config); err != nil {
 		return fmt.Errorf("[app.Application.loadConfig] unmarshal JSON-encoded data (config): %w", err)
@@ -380,11 +507,23 @@ func (a *Application) loadConfig() error {
 	return nil
 }
 
+func (a *Application) configureResources() {
+	if a.config.ResourceDir != nil {
+		a.resources = appresources.NewAppResources(*a.config.ResourceDir)
+	}
+}
+
 func (a *Application) startLoggingSession() error {
+	tlsc, err := a.config.Apis.Clients.LoggingManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startLoggingSession] get a TLS config of the logging manager service: %w", err)
+	}
+
 	c := &loggingmanager.LoggingManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.LoggingManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	lms := loggingmanager.NewLoggingManagerService(c)
 
@@ -422,10 +561,16 @@ func (a *Application) startLoggingSession() error {
 }
 
 func (a *Application) startSession() error {
+	tlsc, err := a.config.Apis.Clients.AppManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startSession] get a TLS config of the app manager service: %w", err)
+	}
+
 	c := &appmanager.AppManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.AppManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	ams := appmanager.NewAppManagerService(c)
 
@@ -614,7 +759,8 @@ func (a *Application) createFileLogAdapter(appInfo *info.AppInfo, loggingSession
 
 func (a *Application) configureIdentity() error {
 	im, err := iidentity.NewIdentityManager(
-		a.config.UserId, a.userManager, a.clientManager, a.roleManager, a.permissionManager, a.authnManager, a.authzManager, iidentity.Roles, iidentity.Permissions, a.loggerFactory,
+		a.config.UserId, a.userManager, a.clientManager, a.roleManager, a.permissionManager, a.authnManager, a.serviceClientTokenManager, a.activeSessionManager,
+		a.authzManager, a.resourceTypeManager, a.impersonationManager, a.apiKeyManager, iidentity.Roles, iidentity.Permissions, iidentity.ResourceTypes, a.loggerFactory,
 	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureIdentity] new identity manager: %w", err)
@@ -697,7 +843,19 @@ func (a *Application) configureDb() error {
 }
 
 func (a *Application) configure() error {
-	userManager, err := usermanager.NewUserManager(a.postgresManager.Stores.UserStore(), a.loggerFactory)
+	if err := a.configureAuthzCache(); err != nil {
+		return fmt.Errorf("[app.Application.configure] configure the authorization cache: %w", err)
+	}
+
+	if err := a.configureSessionRevocation(); err != nil {
+		return fmt.Errorf("[app.Application.configure] configure the revocation of the users' sessions: %w", err)
+	}
+
+	if err := a.configureApiKeyRevocation(); err != nil {
+		return fmt.Errorf("[app.Application.configure] configure the revocation of API keys: %w", err)
+	}
+
+	userManager, err := usermanager.NewUserManager(a.postgresManager.Stores.UserStore(), a.authzCacheInvalidator, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new user manager: %w", err)
 	}
@@ -707,11 +865,46 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] new manager of users' personal info: %w", err)
 	}
 
-	clientManager, err := clientmanager.NewClientManager(a.postgresManager.Stores.WebClientStore(), a.postgresManager.Stores.MobileClientStore(), a.loggerFactory)
+	scc := a.config.Services.Internal.ServiceClient
+	clientManagerConfig := &clientmanager.ClientManagerConfig{
+		SecretGracePeriod: time.Duration(scc.SecretGracePeriod) * time.Millisecond,
+	}
+	clientManager, err := clientmanager.NewClientManager(
+		clientManagerConfig,
+		a.postgresManager.Stores.WebClientStore(),
+		a.postgresManager.Stores.MobileClientStore(),
+		a.postgresManager.Stores.ServiceClientStore(),
+		a.postgresManager.Stores.ClientSecretStore(),
+		a.loggerFactory,
+	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new client manager: %w", err)
 	}
 
+	serviceClientTokenManagerConfig := &clientmanager.ServiceClientTokenManagerConfig{
+		TTL: time.Duration(scc.TokenTTL) * time.Millisecond,
+	}
+	serviceClientTokenManager, err := clientmanager.NewServiceClientTokenManager(
+		serviceClientTokenManagerConfig, clientManager, a.postgresManager.Stores.ServiceClientTokenStore(), a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new service client token manager: %w", err)
+	}
+
+	userGroupManager, err := groupmanager.NewUserGroupManager(
+		a.postgresManager.Stores.UserGroupStore(), a.postgresManager.Stores.UserGroupMemberStore(), a.authzCacheInvalidator, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new user group manager: %w", err)
+	}
+
+	userGroupMemberManager, err := groupmanager.NewUserGroupMemberManager(
+		userManager, a.postgresManager.Stores.UserGroupMemberStore(), a.authzCacheInvalidator, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new user group member manager: %w", err)
+	}
+
 	roleManager, err := rolemanager.NewRoleManager(a.postgresManager.Stores.RoleStore(), a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new role manager: %w", err)
@@ -732,13 +925,30 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] new group role assignment manager: %w", err)
 	}
 
+	clientRoleAssignmentManager, err := rolemanager.NewClientRoleAssignmentManager(a.postgresManager.Stores.ClientRoleAssignmentStore(), a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new client role assignment manager: %w", err)
+	}
+
 	roleAssignmentManager, err := rolemanager.NewRoleAssignmentManager(
-		rolesState, userRoleAssignmentManager, groupRoleAssignmentManager, a.postgresManager.Stores.RoleAssignmentStore(), a.loggerFactory,
+		rolesState, userRoleAssignmentManager, groupRoleAssignmentManager, clientRoleAssignmentManager, userGroupManager,
+		a.postgresManager.Stores.RoleAssignmentStore(), a.authzCacheInvalidator, a.loggerFactory,
 	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new role assignment manager: %w", err)
 	}
 
+	roleExpirationServiceConfig := &roleexpiration.ExpirationServiceConfig{
+		Interval: time.Duration(a.config.Services.Internal.RoleAssignment.ExpirationInterval) * time.Millisecond,
+		UserId:   a.config.UserId,
+	}
+	roleExpirationService, err := roleexpiration.NewExpirationService(
+		a.appSessionId.Value, a.tranManager, a.actionManager, roleAssignmentManager, roleExpirationServiceConfig, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new role assignment expiration service: %w", err)
+	}
+
 	userRoleManager, err := rolemanager.NewUserRoleManager(roleManager, userRoleAssignmentManager, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new user role manager: %w", err)
@@ -749,7 +959,14 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] new group role manager: %w", err)
 	}
 
-	rolePermissionManager, err := permissionmanager.NewRolePermissionManager(a.postgresManager.Stores.RolePermissionStore(), a.loggerFactory)
+	roleInheritanceManager, err := rolemanager.NewRoleInheritanceManager(a.postgresManager.Stores.RoleInheritanceStore(), a.authzCacheInvalidator, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new role inheritance manager: %w", err)
+	}
+
+	rolePermissionManager, err := permissionmanager.NewRolePermissionManager(
+		a.postgresManager.Stores.RolePermissionStore(), roleInheritanceManager, a.authzCacheInvalidator, a.loggerFactory,
+	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new role permission manager: %w", err)
 	}
@@ -764,6 +981,18 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] new permission group manager: %w", err)
 	}
 
+	resourceTypeManager, err := resourcemanager.NewResourceTypeManager(a.postgresManager.Stores.ResourceTypeStore(), a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new resource type manager: %w", err)
+	}
+
+	resourceRoleAssignmentManager, err := resourcemanager.NewResourceRoleAssignmentManager(
+		roleManager, resourceTypeManager, userGroupManager, a.postgresManager.Stores.ResourceRoleAssignmentStore(), a.authzCacheInvalidator, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new resource role assignment manager: %w", err)
+	}
+
 	userAgentManager, err := useragentmanager.NewUserAgentManager(
 		clientManager, a.postgresManager.Stores.WebUserAgentStore(), a.postgresManager.Stores.MobileUserAgentStore(), a.loggerFactory,
 	)
@@ -785,6 +1014,13 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] new user agent session manager: %w", err)
 	}
 
+	activeSessionManager, err := sessionmanager.NewActiveSessionManager(
+		clientManager, userSessionManager, userAgentSessionManager, a.sessionRevocationList, a.sessionRevocationNotifier, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new active session manager: %w", err)
+	}
+
 	tekManager, err := authenticationmanager.NewTokenEncryptionKeyManager(a.postgresManager.Stores.TokenEncryptionKeyStore(), a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new token encryption key manager: %w", err)
@@ -799,33 +1035,462 @@ func (a *Application) configure() error {
 		return fmt.Errorf("[app.Application.configure] init an authentication manager: %w", err)
 	}
 
-	authzManager, err := authorizationmanager.NewAuthorizationManager(userManager, clientManager, userRoleAssignmentManager, groupRoleAssignmentManager, rolePermissionManager, a.loggerFactory)
+	authzManager, err := authorizationmanager.NewAuthorizationManager(
+		userManager, clientManager, permissionManager, roleManager, roleAssignmentManager, userRoleAssignmentManager, groupRoleAssignmentManager,
+		clientRoleAssignmentManager, rolePermissionManager, userGroupMemberManager, resourceRoleAssignmentManager, a.authzCache, a.loggerFactory,
+	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configure] new authentication manager: %w", err)
 	}
 
+	userCredentialManager, err := credentialmanager.NewUserCredentialManager(a.postgresManager.Stores.UserCredentialStore(), a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new user credential manager: %w", err)
+	}
+
+	lc := a.config.Services.Internal.Lockout
+	lockoutManagerConfig := &lockoutmanager.LockoutManagerConfig{
+		Users:      toLockoutPolicy(lc.Users),
+		Clients:    toLockoutPolicy(lc.Clients),
+		UserAgents: toLockoutPolicy(lc.UserAgents),
+	}
+	lockoutManager, err := lockoutmanager.NewLockoutManager(
+		lockoutManagerConfig,
+		a.postgresManager.Stores.UserLockoutStore(),
+		a.postgresManager.Stores.WebClientLockoutStore(),
+		a.postgresManager.Stores.MobileClientLockoutStore(),
+		a.postgresManager.Stores.WebUserAgentLockoutStore(),
+		a.postgresManager.Stores.MobileUserAgentLockoutStore(),
+		userManager,
+		clientManager,
+		userAgentManager,
+		a.authzCacheInvalidator,
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new lockout manager: %w", err)
+	}
+
+	unlockServiceConfig := &lockoutunlocking.UnlockServiceConfig{
+		Interval: time.Duration(lc.UnlockInterval) * time.Millisecond,
+		UserId:   a.config.UserId,
+	}
+	unlockService, err := lockoutunlocking.NewUnlockService(
+		a.appSessionId.Value, a.tranManager, a.actionManager, lockoutManager, unlockServiceConfig, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new unlock service: %w", err)
+	}
+
+	mc := a.config.Services.Internal.Mfa
+	userMfaManagerConfig := &mfamanager.UserMfaManagerConfig{
+		Issuer: mc.Issuer,
+		Policy: toMfaPolicy(mc),
+	}
+	userMfaManager, err := mfamanager.NewUserMfaManager(
+		userMfaManagerConfig,
+		a.postgresManager.Stores.UserTotpStore(),
+		userManager,
+		roleManager,
+		userRoleAssignmentManager,
+		groupRoleAssignmentManager,
+		roleInheritanceManager,
+		userGroupMemberManager,
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new user MFA manager: %w", err)
+	}
+
+	mfaChallengeManagerConfig := &mfamanager.MfaChallengeManagerConfig{
+		TTL:               time.Duration(mc.ChallengeTTL) * time.Millisecond,
+		MaxFailedAttempts: mc.MaxChallengeAttempts,
+	}
+	mfaChallengeManager, err := mfamanager.NewMfaChallengeManager(
+		mfaChallengeManagerConfig, a.postgresManager.Stores.MfaChallengeStore(), userMfaManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new MFA challenge manager: %w", err)
+	}
+
+	oc := a.config.Services.Internal.Oidc
+	oidcSigningKey, err := a.loadOidcSigningKey(oc.SigningKeyFile)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] load an OIDC signing key: %w", err)
+	}
+
+	oidcClientRegistrationStore, err := oidcstores.NewInMemoryClientRegistrationStore(toOidcClientRegistrations(oc.Clients))
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new in-memory OIDC client registration store: %w", err)
+	}
+
+	oidcManagerConfig := &oidcmanager.OidcManagerConfig{
+		Issuer:               oc.Issuer,
+		SigningKey:           oidcSigningKey,
+		AuthorizationCodeTTL: time.Duration(oc.AuthorizationCodeTTL) * time.Millisecond,
+		AccessTokenTTL:       time.Duration(oc.AccessTokenTTL) * time.Millisecond,
+		RefreshTokenTTL:      time.Duration(oc.RefreshTokenTTL) * time.Millisecond,
+		ScopePermissions:     oc.ScopePermissions,
+	}
+	oidcManager, err := oidcmanager.NewOidcManager(
+		oidcManagerConfig,
+		clientManager,
+		userManager,
+		userPersonalInfoManager,
+		permissionManager,
+		authzManager,
+		a.sessionRevocationList,
+		oidcClientRegistrationStore,
+		a.postgresManager.Stores.OidcAuthorizationCodeStore(),
+		a.postgresManager.Stores.OidcRefreshTokenStore(),
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new OIDC manager: %w", err)
+	}
+
+	signInManager, err := credentialmanager.NewSignInManager(
+		userManager, userCredentialManager, userAgentManager, userSessionManager, userAgentSessionManager, authnManager, lockoutManager,
+		userMfaManager, mfaChallengeManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new sign-in manager: %w", err)
+	}
+
+	rs, err := a.resources.Get("notifications/email/templates")
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] get resources ('notifications/email/templates'): %w", err)
+	}
+
+	emailNotifier, err := emailnotifier.NewEmailNotifier(a.appSessionId.Value, rs, a.config.Services.EmailNotifier.Config(), a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new email notifier: %w", err)
+	}
+	a.emailNotifier = emailNotifier
+
+	rc := a.config.Services.Internal.Registration
+	registrationManagerConfig := &registrationmanager.RegistrationManagerConfig{
+		VerificationTokenTTL: time.Duration(rc.VerificationTokenTTL) * time.Millisecond,
+		RequireApproval:      rc.RequireApproval,
+		VerificationURL:      rc.VerificationURL,
+	}
+	registrationManager, err := registrationmanager.NewRegistrationManager(
+		a.config.UserId,
+		registrationManagerConfig,
+		userManager,
+		userCredentialManager,
+		a.postgresManager.Stores.RegistrationStore(),
+		emailNotifier,
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new registration manager: %w", err)
+	}
+
+	impersonationManagerConfig := &impersonationmanager.ImpersonationManagerConfig{
+		TokenTTL: time.Duration(a.config.Services.Internal.Impersonation.TokenTTL) * time.Millisecond,
+	}
+	impersonationManager, err := impersonationmanager.NewImpersonationManager(
+		impersonationManagerConfig,
+		userManager,
+		a.postgresManager.Stores.ImpersonationTokenStore(),
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new impersonation manager: %w", err)
+	}
+
+	apiKeyManager, err := apikeymanager.NewApiKeyManager(
+		userManager, clientManager, permissionManager, authzManager, a.postgresManager.Stores.ApiKeyStore(), a.apiKeyRevocationNotifier, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new API key manager: %w", err)
+	}
+
+	dataSubjectRequestManager, err := datasubjectmanager.NewDataSubjectRequestManager(
+		a.postgresManager.Stores.DataSubjectRequestStore(),
+		userManager,
+		userPersonalInfoManager,
+		userSessionManager,
+		userAgentSessionManager,
+		activeSessionManager,
+		userAgentManager,
+		userRoleAssignmentManager,
+		userGroupMemberManager,
+		apiKeyManager,
+		a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new data subject request manager: %w", err)
+	}
+
+	dataSubjectRequestProcessingServiceConfig := &datasubjectprocessing.ProcessingServiceConfig{
+		Interval:          time.Duration(a.config.Services.Internal.DataSubjectRequests.ProcessingInterval) * time.Millisecond,
+		MaxRequestsPerRun: a.config.Services.Internal.DataSubjectRequests.MaxRequestsPerRun,
+		UserId:            a.config.UserId,
+	}
+	dataSubjectRequestProcessingService, err := datasubjectprocessing.NewProcessingService(
+		a.appSessionId.Value, a.tranManager, a.actionManager, dataSubjectRequestManager, dataSubjectRequestProcessingServiceConfig, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new data subject request processing service: %w", err)
+	}
+
+	provisioningManager, err := provisioningmanager.NewProvisioningManager(
+		permissionGroupManager, permissionManager, roleManager, rolePermissionManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configure] new provisioning manager: %w", err)
+	}
+
 	a.userManager = userManager
 	a.userPersonalInfoManager = userPersonalInfoManager
 	a.clientManager = clientManager
+	a.serviceClientTokenManager = serviceClientTokenManager
+	a.userGroupManager = userGroupManager
+	a.userGroupMemberManager = userGroupMemberManager
 	a.roleManager = roleManager
 	a.roleAssignmentManager = roleAssignmentManager
 	a.userRoleAssignmentManager = userRoleAssignmentManager
 	a.groupRoleAssignmentManager = groupRoleAssignmentManager
+	a.clientRoleAssignmentManager = clientRoleAssignmentManager
 	a.userRoleManager = userRoleManager
 	a.groupRoleManager = groupRoleManager
+	a.roleInheritanceManager = roleInheritanceManager
 	a.rolesState = rolesState
 	a.permissionManager = permissionManager
 	a.permissionGroupManager = permissionGroupManager
 	a.rolePermissionManager = rolePermissionManager
+	a.resourceTypeManager = resourceTypeManager
+	a.resourceRoleAssignmentManager = resourceRoleAssignmentManager
 	a.userAgentManager = userAgentManager
 	a.userSessionManager = userSessionManager
 	a.userAgentSessionManager = userAgentSessionManager
+	a.activeSessionManager = activeSessionManager
 	a.authnManager = authnManager
 	a.tekManager = tekManager
 	a.authzManager = authzManager
+	a.userCredentialManager = userCredentialManager
+	a.signInManager = signInManager
+	a.lockoutManager = lockoutManager
+	a.unlockService = unlockService
+	a.roleExpirationService = roleExpirationService
+	a.userMfaManager = userMfaManager
+	a.mfaChallengeManager = mfaChallengeManager
+	a.oidcManager = oidcManager
+	a.registrationManager = registrationManager
+	a.impersonationManager = impersonationManager
+	a.dataSubjectRequestManager = dataSubjectRequestManager
+	a.dataSubjectRequestProcessingService = dataSubjectRequestProcessingService
+	a.provisioningManager = provisioningManager
+	a.apiKeyManager = apiKeyManager
+	return nil
+}
+
+func toLockoutPolicy(p *iappconfig.LockoutPolicy) *lockoutmodels.LockoutPolicy {
+	if p == nil || !p.Enabled {
+		return nil
+	}
+
+	return &lockoutmodels.LockoutPolicy{
+		MaxFailedAttempts:    p.MaxFailedAttempts,
+		FailureWindow:        time.Duration(p.FailureWindow) * time.Millisecond,
+		LockoutDuration:      time.Duration(p.LockoutDuration) * time.Millisecond,
+		MaxTemporaryLockouts: p.MaxTemporaryLockouts,
+	}
+}
+
+func toMfaPolicy(c *iappconfig.MfaServices) *mfamodels.MfaPolicy {
+	if len(c.RequiredUserGroups) == 0 && len(c.RequiredRoles) == 0 {
+		return nil
+	}
+
+	gs := make([]groupmodels.UserGroup, len(c.RequiredUserGroups))
+	for i := 0; i < len(c.RequiredUserGroups); i++ {
+		gs[i] = groupmodels.UserGroup(c.RequiredUserGroups[i])
+	}
+
+	return &mfamodels.MfaPolicy{
+		RequiredUserGroups: gs,
+		RequiredRoles:      c.RequiredRoles,
+	}
+}
+
+func toOidcClientRegistrations(cs []*iappconfig.OidcClient) []*oidcmodels.ClientRegistration {
+	rs := make([]*oidcmodels.ClientRegistration, len(cs))
+	for i := 0; i < len(cs); i++ {
+		rs[i] = &oidcmodels.ClientRegistration{
+			ClientId:     cs[i].ClientId,
+			RedirectURIs: cs[i].RedirectURIs,
+			Scopes:       cs[i].Scopes,
+		}
+	}
+	return rs
+}
+
+// loadOidcSigningKey loads the RSA private key (PKCS #1 or PKCS #8, PEM) that is used to sign OIDC tokens.
+// If the file isn't specified, then an ephemeral key is generated.
+func (a *Application) loadOidcSigningKey(file string) (*rsa.PrivateKey, error) {
+	if len(file) == 0 {
+		a.log(logging.LogLevelWarning, events.ApplicationEvent, nil,
+			"[app.Application.loadOidcSigningKey] OIDC signing key file isn't specified, an ephemeral key is generated (tokens are invalidated on restart)",
+		)
+
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, fmt.Errorf("[app.Application.loadOidcSigningKey] generate an RSA key: %w", err)
+		}
+		return k, nil
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.loadOidcSigningKey] read a file: %w", err)
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("[app.Application.loadOidcSigningKey] PEM block not found")
+	}
+
+	if k, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return k, nil
+	}
+
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.loadOidcSigningKey] parse a private key: %w", err)
+	}
+
+	rk, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("[app.Application.loadOidcSigningKey] private key isn't an RSA key")
+	}
+	return rk, nil
+}
+
+func (a *Application) configureAuthzCache() error {
+	cc := a.config.Services.Internal.Authorization.Cache
+	c, err := authorizationcache.NewAuthorizationCache(&authorizationcache.AuthorizationCacheConfig{
+		PermissionCapacity: cc.PermissionCapacity,
+		UserCapacity:       cc.UserCapacity,
+		GroupCapacity:      cc.GroupCapacity,
+		ClientCapacity:     cc.ClientCapacity,
+		TTL:                time.Duration(cc.TTL) * time.Millisecond,
+	})
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureAuthzCache] new authorization cache: %w", err)
+	}
+
+	ic := &authorizationcacheinvalidation.CacheInvalidatorConfig{
+		Kafka: &authorizationcacheinvalidation.CacheInvalidatorKafkaConfig{
+			Config:        cc.Invalidation.Kafka.ProducerConfig.Config(),
+			AsyncProducer: cc.Invalidation.Kafka.AsyncProducer,
+			Topic:         cc.Invalidation.Kafka.Topic,
+		},
+	}
+	i, err := authorizationcacheinvalidation.NewCacheInvalidator(a.appSessionId.Value, c, ic, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureAuthzCache] new authorization cache invalidator: %w", err)
+	}
+
+	sc := &authorizationcacheinvalidation.CacheInvalidationServiceConfig{
+		Kafka: &authorizationcacheinvalidation.CacheInvalidationServiceKafkaConfig{
+			Config: cc.Invalidation.Kafka.ConsumerConfig.Config(),
+			Topic:  cc.Invalidation.Kafka.Topic,
+		},
+	}
+	s, err := authorizationcacheinvalidation.NewCacheInvalidationService(a.appSessionId.Value, c, sc, a.loggerFactory)
+	if err != nil {
+		if err2 := i.Dispose(); err2 != nil {
+			a.log(logging.LogLevelError, events.ApplicationEvent, err2, "[app.Application.configureAuthzCache] dispose of the authorization cache invalidator")
+		}
+		return fmt.Errorf("[app.Application.configureAuthzCache] new authorization cache invalidation service: %w", err)
+	}
+
+	a.authzCache = c
+	a.authzCacheInvalidator = i
+	a.authzCacheInvalidationService = s
+	return nil
+}
+
+func (a *Application) configureSessionRevocation() error {
+	rc := a.config.Services.Internal.Sessions.Revocation
+	l, err := sessionrevocation.NewSessionRevocationList(&sessionrevocation.SessionRevocationListConfig{
+		TTL: time.Duration(rc.TTL) * time.Millisecond,
+	})
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureSessionRevocation] new session revocation list: %w", err)
+	}
+
+	nc := &sessionrevocationnotification.SessionRevocationNotifierConfig{
+		Kafka: &sessionrevocationnotification.SessionRevocationNotifierKafkaConfig{
+			Config:        rc.Kafka.ProducerConfig.Config(),
+			AsyncProducer: rc.Kafka.AsyncProducer,
+			Topic:         rc.Kafka.Topic,
+		},
+	}
+	n, err := sessionrevocationnotification.NewSessionRevocationNotifier(a.appSessionId.Value, nc, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureSessionRevocation] new session revocation notifier: %w", err)
+	}
+
+	sc := &sessionrevocationnotification.SessionRevocationNotificationServiceConfig{
+		Kafka: &sessionrevocationnotification.SessionRevocationNotificationServiceKafkaConfig{
+			Config: rc.Kafka.ConsumerConfig.Config(),
+			Topic:  rc.Kafka.Topic,
+		},
+	}
+	s, err := sessionrevocationnotification.NewSessionRevocationNotificationService(a.appSessionId.Value, l, sc, a.loggerFactory)
+	if err != nil {
+		if err2 := n.Dispose(); err2 != nil {
+			a.log(logging.LogLevelError, events.ApplicationEvent, err2, "[app.Application.configureSessionRevocation] dispose of the session revocation notifier")
+		}
+		return fmt.Errorf("[app.Application.configureSessionRevocation] new session revocation notification service: %w", err)
+	}
+
+	a.sessionRevocationList = l
+	a.sessionRevocationNotifier = n
+	a.sessionRevocationNotificationService = s
 	return nil
 }
 
+func (a *Application) configureApiKeyRevocation() error {
+	rc := a.config.Services.Internal.ApiKeys.Revocation
+	c := &apikeyrevocationnotification.ApiKeyRevocationNotifierConfig{
+		Kafka: &apikeyrevocationnotification.ApiKeyRevocationNotifierKafkaConfig{
+			Config:        rc.Kafka.ProducerConfig.Config(),
+			AsyncProducer: rc.Kafka.AsyncProducer,
+			Topic:         rc.Kafka.Topic,
+		},
+	}
+	n, err := apikeyrevocationnotification.NewApiKeyRevocationNotifier(a.appSessionId.Value, c, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureApiKeyRevocation] new API key revocation notifier: %w", err)
+	}
+
+	a.apiKeyRevocationNotifier = n
+	return nil
+}
+
+func (a *Application) configureHealthChecks() {
+	c := health.NewChecker(0)
+	c.Add("appSession", a.session.HealthCheck)
+	c.Add("loggingSession", a.loggingSession.HealthCheck)
+	c.Add("postgres", a.postgresManager.HealthCheck)
+	c.Add("kafka", kafkacomponent.HealthCheck)
+
+	if a.appManagerService != nil {
+		c.Add("appManagerService", a.appManagerService.HealthCheck)
+	}
+
+	if a.loggingManagerService != nil {
+		c.Add("loggingManagerService", a.loggingManagerService.HealthCheck)
+	}
+
+	a.healthChecker = c
+}
+
 func (a *Application) configureHttpServer() error {
 	var ac *cookies.CookieAuthnConfig
 	if a.config.Auth != nil && a.config.Auth.Authn != nil && a.config.Auth.Authn.Http != nil && a.config.Auth.Authn.Http.Cookies != nil {
@@ -850,6 +1515,12 @@ func (a *Application) configureHttpServer() error {
 		return fmt.Errorf("[app.Application.configureHttpServer] configure HTTP routing: %w", err)
 	}
 
+	if a.config.Net.Http.Server.HealthProbes {
+		if err := httpserver.AddHealthRoutes(router, a.healthChecker, a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] add the routes of the health probes: %w", err)
+		}
+	}
+
 	rpcb := httpserver.NewRequestPipelineConfigBuilder()
 	rpc := rpcb.SetPipelineLifetime(rpl).
 		UseAuthentication().
@@ -879,6 +1550,13 @@ func (a *Application) configureHttpServer() error {
 	}
 
 	a.httpServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Http.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Http.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] new TLS config: %w", err)
+		}
+	}
+
 	hsb := httpserver.NewHttpServerBuilder(httpServerId, a.appSessionId.Value, l, a.loggerFactory)
 	hsb.Configure(func(config *httpserver.HttpServerConfig) {
 		config.Addr = a.config.Net.Http.Server.Addr
@@ -886,6 +1564,7 @@ func (a *Application) configureHttpServer() error {
 		config.WriteTimeout = time.Duration(a.config.Net.Http.Server.WriteTimeout) * time.Millisecond
 		config.IdleTimeout = time.Duration(a.config.Net.Http.Server.IdleTimeout) * time.Millisecond
 		config.PipelineConfig = rpc
+		config.TLSConfig = tlsc
 	})
 
 	s, err := hsb.Build()
@@ -898,14 +1577,39 @@ func (a *Application) configureHttpServer() error {
 }
 
 func (a *Application) configureHttpRouting(router *httpserverrouting.Router) error {
-	ic := &appcontrollers.ApplicationControllerIdentityConfig{StopPermission: iidentity.PermissionApp_Stop}
+	ic := &appcontrollers.ApplicationControllerIdentityConfig{
+		StopPermission:       iidentity.PermissionApp_Stop,
+		GetMetricsPermission: iidentity.PermissionApp_GetMetrics,
+	}
 	appController, err := appcontrollers.NewApplicationController(a, a.appSessionId.Value, a.actionManager, a.identityManager, ic, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureHttpRouting] new application controller: %w", err)
 	}
 
+	authzCacheController, err := authorizationcontrollers.NewAuthzCacheController(a.appSessionId.Value, a.actionManager, a.identityManager, a.authzCache, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureHttpRouting] new authorization cache controller: %w", err)
+	}
+
+	oidcController, err := oidccontrollers.NewOidcController(
+		a.appSessionId.Value, a.actionManager, a.identityManager,
+		&oidccontrollers.OidcControllerConfig{LoginURL: a.config.Services.Internal.Oidc.LoginURL}, a.oidcManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureHttpRouting] new OIDC controller: %w", err)
+	}
+
+	// OIDC
+	router.AddGet("Oidc_GetProviderMetadata", oidcmodels.DiscoveryPath, oidcController.GetProviderMetadata)
+	router.AddGet("Oidc_GetJwks", oidcmodels.JwksPath, oidcController.GetJwks)
+	router.AddGet("Oidc_Authorize", oidcmodels.AuthorizationEndpointPath, oidcController.Authorize)
+	router.AddPost("Oidc_Token", oidcmodels.TokenEndpointPath, oidcController.Token)
+	router.AddGet("Oidc_GetUserInfo", oidcmodels.UserInfoEndpointPath, oidcController.GetUserInfo)
+
 	// private
 	router.AddPost("App_Stop", "/private/api/app/stop", appController.Stop)
+	router.AddGet("App_GetMetrics", "/metrics", appController.GetMetrics)
+	router.AddGet("AuthzCache_GetStats", "/private/api/authorization/cache/stats", authzCacheController.GetStats)
 	return nil
 }
 
@@ -934,6 +1638,7 @@ func (a *Application) configureGrpcServer() error {
 		UseAuthentication().
 		UseAuthorization().
 		UseErrorHandler().
+		UseHealthChecks(a.healthChecker).
 		Build()
 
 	c := &grpcserverlogging.LoggerConfig{
@@ -956,10 +1661,18 @@ func (a *Application) configureGrpcServer() error {
 	}
 
 	a.grpcServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Grpc.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Grpc.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureGrpcServer] new TLS config: %w", err)
+		}
+	}
+
 	sb := grpcserver.NewGrpcServerBuilder(grpcServerId, a.appSessionId.Value, l, a.loggerFactory)
 	sb.Configure(func(config *grpcserver.GrpcServerConfig) {
 		config.Addr = a.config.Net.Grpc.Server.Addr
 		config.PipelineConfig = rpc
+		config.TLSConfig = tlsc
 	})
 
 	if err := a.configureGrpcServices(sb); err != nil {
@@ -981,22 +1694,92 @@ func (a *Application) configureGrpcServices(b *grpcserver.GrpcServerBuilder) err
 		return fmt.Errorf("[app.Application.configureGrpcServices] new user service: %w", err)
 	}
 
+	userPersonalInfoService, err := userservices.NewUserPersonalInfoService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.userPersonalInfoManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user personal info service: %w", err)
+	}
+
 	clientService, err := clientservices.NewClientService(a.appSessionId.Value, a.actionManager, a.identityManager, a.clientManager, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureGrpcServices] new client service: %w", err)
 	}
 
+	userGroupService, err := groupservices.NewUserGroupService(a.appSessionId.Value, a.actionManager, a.identityManager, a.userGroupManager, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user group service: %w", err)
+	}
+
+	userGroupMemberService, err := groupservices.NewUserGroupMemberService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.userGroupMemberManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user group member service: %w", err)
+	}
+
 	roleService, err := roleservices.NewRoleService(a.appSessionId.Value, a.actionManager, a.identityManager, a.roleManager, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureGrpcServices] new role service: %w", err)
 	}
 
+	roleAssignmentService, err := roleservices.NewRoleAssignmentService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.roleAssignmentManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new role assignment service: %w", err)
+	}
+
+	userRoleAssignmentService, err := roleservices.NewUserRoleAssignmentService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.userRoleAssignmentManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user role assignment service: %w", err)
+	}
+
+	groupRoleAssignmentService, err := roleservices.NewGroupRoleAssignmentService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.groupRoleAssignmentManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new group role assignment service: %w", err)
+	}
+
+	roleInheritanceService, err := roleservices.NewRoleInheritanceService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.roleInheritanceManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new role inheritance service: %w", err)
+	}
+
 	permissionService, err := permissionservices.NewPermissionService(a.appSessionId.Value, a.actionManager, a.identityManager, a.permissionManager, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureGrpcServices] new permission service: %w", err)
 	}
 
-	authnService, err := authenticationservices.NewAuthenticationService(a.appSessionId.Value, a.actionManager, a.identityManager, a.authnManager, a.loggerFactory)
+	rolePermissionService, err := permissionservices.NewRolePermissionService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.rolePermissionManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new role permission service: %w", err)
+	}
+
+	resourceTypeService, err := resourceservices.NewResourceTypeService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.resourceTypeManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new resource type service: %w", err)
+	}
+
+	resourceRoleAssignmentService, err := resourceservices.NewResourceRoleAssignmentService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.resourceRoleAssignmentManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new resource role assignment service: %w", err)
+	}
+
+	authnService, err := authenticationservices.NewAuthenticationService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.authnManager, a.serviceClientTokenManager, a.activeSessionManager, a.loggerFactory,
+	)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureGrpcServices] new authentication service: %w", err)
 	}
@@ -1006,12 +1789,90 @@ func (a *Application) configureGrpcServices(b *grpcserver.GrpcServerBuilder) err
 		return fmt.Errorf("[app.Application.configureGrpcServices] new authorization service: %w", err)
 	}
 
+	userCredentialService, err := credentialservices.NewUserCredentialService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.userCredentialManager, a.signInManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user credential service: %w", err)
+	}
+
+	lockoutService, err := lockoutservices.NewLockoutService(a.appSessionId.Value, a.actionManager, a.identityManager, a.lockoutManager, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new lockout service: %w", err)
+	}
+
+	userMfaService, err := mfaservices.NewUserMfaService(a.appSessionId.Value, a.actionManager, a.identityManager, a.userMfaManager, a.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new user MFA service: %w", err)
+	}
+
+	activeSessionService, err := activesessionservices.NewActiveSessionService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.activeSessionManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new active session service: %w", err)
+	}
+
+	registrationService, err := registrationservices.NewRegistrationService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.registrationManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new registration service: %w", err)
+	}
+
+	impersonationService, err := impersonationservices.NewImpersonationService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.impersonationManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new impersonation service: %w", err)
+	}
+
+	dataSubjectRequestService, err := datasubjectservices.NewDataSubjectRequestService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.dataSubjectRequestManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new data subject request service: %w", err)
+	}
+
+	provisioningService, err := provisioningservices.NewProvisioningService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.provisioningManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new provisioning service: %w", err)
+	}
+
+	apiKeyService, err := apikeyservices.NewApiKeyService(
+		a.appSessionId.Value, a.actionManager, a.identityManager, a.apiKeyManager, a.loggerFactory,
+	)
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureGrpcServices] new API key service: %w", err)
+	}
+
 	b.AddService(&userspb.UserService_ServiceDesc, userService).
+		AddService(&personalinfopb.UserPersonalInfoService_ServiceDesc, userPersonalInfoService).
 		AddService(&clientspb.ClientService_ServiceDesc, clientService).
+		AddService(&usergroupspb.UserGroupService_ServiceDesc, userGroupService).
+		AddService(&memberspb.UserGroupMemberService_ServiceDesc, userGroupMemberService).
 		AddService(&rolespb.RoleService_ServiceDesc, roleService).
+		AddService(&assignmentspb.RoleAssignmentService_ServiceDesc, roleAssignmentService).
+		AddService(&userroleassignmentspb.UserRoleAssignmentService_ServiceDesc, userRoleAssignmentService).
+		AddService(&grouproleassignmentspb.GroupRoleAssignmentService_ServiceDesc, groupRoleAssignmentService).
+		AddService(&inheritancepb.RoleInheritanceService_ServiceDesc, roleInheritanceService).
 		AddService(&permissionspb.PermissionService_ServiceDesc, permissionService).
+		AddService(&rolepermissionspb.RolePermissionService_ServiceDesc, rolePermissionService).
+		AddService(&resourcespb.ResourceTypeService_ServiceDesc, resourceTypeService).
+		AddService(&resourceroleassignmentspb.ResourceRoleAssignmentService_ServiceDesc, resourceRoleAssignmentService).
 		AddService(&authenticationpb.AuthenticationService_ServiceDesc, authnService).
-		AddService(&authorizationpb.AuthorizationService_ServiceDesc, authzService)
+		AddService(&authorizationpb.AuthorizationService_ServiceDesc, authzService).
+		AddService(&credentialspb.UserCredentialService_ServiceDesc, userCredentialService).
+		AddService(&lockoutspb.LockoutService_ServiceDesc, lockoutService).
+		AddService(&mfapb.UserMfaService_ServiceDesc, userMfaService).
+		AddService(&activesessionspb.ActiveSessionService_ServiceDesc, activeSessionService).
+		AddService(&registrationpb.RegistrationService_ServiceDesc, registrationService).
+		AddService(&impersonationpb.ImpersonationService_ServiceDesc, impersonationService).
+		AddService(&datasubjectspb.DataSubjectRequestService_ServiceDesc, dataSubjectRequestService).
+		AddService(&provisioningpb.ProvisioningService_ServiceDesc, provisioningService).
+		AddService(&apikeyspb.ApiKeyService_ServiceDesc, apiKeyService)
 	return nil
 }
 
@@ -1120,6 +1981,24 @@ func (a *Application) stop(ctx *actions.OperationContext) {
 		}
 	}
 
+	if a.unlockService != nil && a.unlockService.IsStarted() {
+		if err := a.unlockService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the unlock service")
+		}
+	}
+
+	if a.roleExpirationService != nil && a.roleExpirationService.IsStarted() {
+		if err := a.roleExpirationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the role assignment expiration service")
+		}
+	}
+
+	if a.dataSubjectRequestProcessingService != nil && a.dataSubjectRequestProcessingService.IsStarted() {
+		if err := a.dataSubjectRequestProcessingService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the data subject request processing service")
+		}
+	}
+
 	if a.session != nil && a.session.IsStarted() {
 		if a.tranManager != nil {
 			a.tranManager.AllowToCreate(false)
@@ -1152,6 +2031,42 @@ func (a *Application) stop(ctx *actions.OperationContext) {
 		}
 	}
 
+	if a.authzCacheInvalidationService != nil && a.authzCacheInvalidationService.IsStarted() {
+		if err := a.authzCacheInvalidationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the authorization cache invalidation service")
+		}
+	}
+
+	if a.authzCacheInvalidator != nil {
+		if err := a.authzCacheInvalidator.Dispose(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the authorization cache invalidator")
+		}
+	}
+
+	if a.sessionRevocationNotificationService != nil && a.sessionRevocationNotificationService.IsStarted() {
+		if err := a.sessionRevocationNotificationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the session revocation notification service")
+		}
+	}
+
+	if a.sessionRevocationNotifier != nil {
+		if err := a.sessionRevocationNotifier.Dispose(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the session revocation notifier")
+		}
+	}
+
+	if a.apiKeyRevocationNotifier != nil {
+		if err := a.apiKeyRevocationNotifier.Dispose(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the API key revocation notifier")
+		}
+	}
+
+	if a.emailNotifier != nil {
+		if err := a.emailNotifier.Dispose(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the email notifier")
+		}
+	}
+
 	if a.postgresManager != nil {
 		a.postgresManager.Dispose()
 	}
@@ -16,6 +16,7 @@ package config
 
 import (
 	apiclientconfig "personal-website-v2/api-clients/config"
+	"personal-website-v2/pkg/app/service/config"
 )
 
 type Apis struct {
@@ -26,3 +27,236 @@ type ApiClients struct {
 	AppManagerService     *apiclientconfig.ServiceClientConfig `json:"appManagerService"`
 	LoggingManagerService *apiclientconfig.ServiceClientConfig `json:"loggingManagerService"`
 }
+
+type Services struct {
+	Internal      *InternalServices     `json:"internal"`
+	EmailNotifier *config.EmailNotifier `json:"emailNotifier"`
+}
+
+type InternalServices struct {
+	ApiKeys             *ApiKeyServices             `json:"apiKeys"`
+	Authorization       *AuthorizationServices      `json:"authorization"`
+	DataSubjectRequests *DataSubjectRequestServices `json:"dataSubjectRequests"`
+	Impersonation       *ImpersonationServices      `json:"impersonation"`
+	Lockout             *LockoutServices            `json:"lockout"`
+	Mfa                 *MfaServices                `json:"mfa"`
+	Oidc                *OidcServices               `json:"oidc"`
+	Registration        *RegistrationServices       `json:"registration"`
+	RoleAssignment      *RoleAssignmentServices     `json:"roleAssignment"`
+	ServiceClient       *ServiceClientServices      `json:"serviceClient"`
+	Sessions            *SessionServices            `json:"sessions"`
+}
+
+type ApiKeyServices struct {
+	Revocation *ApiKeyRevocation `json:"revocation"`
+}
+
+// The notification of the apps that cache API keys of the revocations of API keys.
+type ApiKeyRevocation struct {
+	Kafka *ApiKeyRevocationKafka `json:"kafka"`
+}
+
+type ApiKeyRevocationKafka struct {
+	// The Kafka config of the producer.
+	ProducerConfig *config.KafkaConfig `json:"producerConfig"`
+	AsyncProducer  bool                `json:"asyncProducer"`
+
+	// The topic to which revocations are sent.
+	Topic string `json:"topic"`
+}
+
+type AuthorizationServices struct {
+	Cache *AuthorizationCache `json:"cache"`
+}
+
+// The authorization cache.
+type AuthorizationCache struct {
+	// The maximum number of cached permissions.
+	PermissionCapacity int `json:"permissionCapacity"`
+
+	// The maximum number of cached users.
+	UserCapacity int `json:"userCapacity"`
+
+	// The maximum number of cached groups.
+	GroupCapacity int `json:"groupCapacity"`
+
+	// The maximum number of cached service clients.
+	ClientCapacity int `json:"clientCapacity"`
+
+	// The cached data lifetime (in milliseconds).
+	TTL int64 `json:"ttl"`
+
+	// The authorization cache invalidation across multiple app instances.
+	Invalidation *AuthorizationCacheInvalidation `json:"invalidation"`
+}
+
+type AuthorizationCacheInvalidation struct {
+	Kafka *AuthorizationCacheInvalidationKafka `json:"kafka"`
+}
+
+type AuthorizationCacheInvalidationKafka struct {
+	// The Kafka config of the producer.
+	ProducerConfig *config.KafkaConfig `json:"producerConfig"`
+	AsyncProducer  bool                `json:"asyncProducer"`
+
+	// The Kafka config of the consumer.
+	ConsumerConfig *config.KafkaConfig `json:"consumerConfig"`
+
+	// The topic to which cache invalidations are sent and from which they are consumed.
+	Topic string `json:"topic"`
+}
+
+type DataSubjectRequestServices struct {
+	// The interval between runs of the processing of data subject requests (in milliseconds).
+	ProcessingInterval int64 `json:"processingInterval"`
+
+	// The maximum number of data subject requests processed per run.
+	MaxRequestsPerRun int `json:"maxRequestsPerRun"`
+}
+
+type ImpersonationServices struct {
+	// The lifetime of an impersonation token (in milliseconds).
+	TokenTTL int64 `json:"tokenTTL"`
+}
+
+type LockoutServices struct {
+	// The lockout policy of users.
+	Users *LockoutPolicy `json:"users"`
+
+	// The lockout policy of clients.
+	Clients *LockoutPolicy `json:"clients"`
+
+	// The lockout policy of user agents.
+	UserAgents *LockoutPolicy `json:"userAgents"`
+
+	// The interval between unlocks of users, clients and user agents
+	// whose temporary lockouts have expired (in milliseconds).
+	UnlockInterval int64 `json:"unlockInterval"`
+}
+
+type LockoutPolicy struct {
+	Enabled bool `json:"enabled"`
+
+	// The number of failed attempts within the failure window after which
+	// the user, client or user agent is locked out.
+	MaxFailedAttempts int `json:"maxFailedAttempts"`
+
+	// The period during which failed attempts are counted (in milliseconds).
+	FailureWindow int64 `json:"failureWindow"`
+
+	// The duration of a temporary lockout (in milliseconds).
+	LockoutDuration int64 `json:"lockoutDuration"`
+
+	// The number of consecutive temporary lockouts after which the user, client or user agent
+	// is locked out until it is unlocked by the administrator. If it is 0, it is only locked out temporarily.
+	MaxTemporaryLockouts int `json:"maxTemporaryLockouts"`
+}
+
+type MfaServices struct {
+	// The issuer (e.g. the website name) displayed by authenticator apps.
+	Issuer string `json:"issuer"`
+
+	// The user groups whose users are required to use MFA.
+	RequiredUserGroups []uint64 `json:"requiredUserGroups"`
+
+	// The names of the roles whose users are required to use MFA.
+	RequiredRoles []string `json:"requiredRoles"`
+
+	// The lifetime of an MFA challenge (in milliseconds).
+	ChallengeTTL int64 `json:"challengeTTL"`
+
+	// The maximum number of failed attempts to complete an MFA challenge.
+	MaxChallengeAttempts int `json:"maxChallengeAttempts"`
+}
+
+type OidcServices struct {
+	// The issuer identifier (URL) of the OpenID provider.
+	Issuer string `json:"issuer"`
+
+	// The path to the PEM file of the RSA private key that is used to sign the tokens.
+	// If it is empty, then an ephemeral key is generated at startup (only for local development).
+	SigningKeyFile string `json:"signingKeyFile"`
+
+	// The lifetime of an authorization code (in milliseconds).
+	AuthorizationCodeTTL int64 `json:"authorizationCodeTTL"`
+
+	// The lifetime of an access token and an ID token (in milliseconds).
+	AccessTokenTTL int64 `json:"accessTokenTTL"`
+
+	// The lifetime of a refresh token (in milliseconds).
+	RefreshTokenTTL int64 `json:"refreshTokenTTL"`
+
+	// The names of the permissions that must be granted to the user for each scope.
+	ScopePermissions map[string][]string `json:"scopePermissions"`
+
+	// Optional. The URL of the login page to which an unauthenticated user is redirected.
+	LoginURL string `json:"loginURL"`
+
+	// The clients registered with the OpenID provider.
+	Clients []*OidcClient `json:"clients"`
+}
+
+type OidcClient struct {
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The redirect URIs registered for the client.
+	RedirectURIs []string `json:"redirectURIs"`
+
+	// The scopes that the client is allowed to request.
+	Scopes []string `json:"scopes"`
+}
+
+type RegistrationServices struct {
+	// The lifetime of an email verification token (in milliseconds).
+	VerificationTokenTTL int64 `json:"verificationTokenTTL"`
+
+	// If true, users must be approved by the administrator after their emails have been verified.
+	RequireApproval bool `json:"requireApproval"`
+
+	// The URL of the email verification page (e.g. on the website) to which the token is added
+	// as the 'token' query parameter.
+	VerificationURL string `json:"verificationURL"`
+}
+
+type RoleAssignmentServices struct {
+	// The interval between deactivations of role assignments
+	// whose validity period has ended (in milliseconds).
+	ExpirationInterval int64 `json:"expirationInterval"`
+}
+
+type ServiceClientServices struct {
+	// The period during which the previous secret of the service client remains valid
+	// after the secret rotation (in milliseconds).
+	SecretGracePeriod int64 `json:"secretGracePeriod"`
+
+	// The lifetime of an access token of the service client (in milliseconds).
+	TokenTTL int64 `json:"tokenTTL"`
+}
+
+type SessionServices struct {
+	Revocation *SessionRevocation `json:"revocation"`
+}
+
+// The revocation of the users' sessions.
+type SessionRevocation struct {
+	// The revocation lifetime (in milliseconds).
+	// It must not be less than the lifetime of the user's token.
+	TTL int64 `json:"ttl"`
+
+	// The notification of other app instances of the revocations.
+	Kafka *SessionRevocationKafka `json:"kafka"`
+}
+
+type SessionRevocationKafka struct {
+	// The Kafka config of the producer.
+	ProducerConfig *config.KafkaConfig `json:"producerConfig"`
+	AsyncProducer  bool                `json:"asyncProducer"`
+
+	// The Kafka config of the consumer.
+	ConsumerConfig *config.KafkaConfig `json:"consumerConfig"`
+
+	// The topic to which revocations are sent and from which they are consumed.
+	// The retention period of the topic must not be less than the revocation lifetime.
+	Topic string `json:"topic"`
+}
@@ -0,0 +1,431 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	apikeyspb "personal-website-v2/go-apis/identity/apikeys"
+	userspb "personal-website-v2/go-apis/identity/users"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/apikeys/converter"
+	"personal-website-v2/identity/src/api/grpc/apikeys/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/apikeys"
+	"personal-website-v2/identity/src/internal/apikeys/models"
+	apikeyoperations "personal-website-v2/identity/src/internal/apikeys/operations/apikeys"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ApiKeyService struct {
+	apikeyspb.UnimplementedApiKeyServiceServer
+	reqProcessor  *grpcserverhelper.RequestProcessor
+	apiKeyManager apikeys.ApiKeyManager
+	logger        logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewApiKeyService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	apiKeyManager apikeys.ApiKeyManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ApiKeyService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.apikeys.ApiKeyService")
+	if err != nil {
+		return nil, fmt.Errorf("[apikeys.NewApiKeyService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupApiKey,
+		OperationGroup: iactions.OperationGroupApiKey,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[apikeys.NewApiKeyService] new request processor: %w", err)
+	}
+
+	return &ApiKeyService{
+		reqProcessor:  p,
+		apiKeyManager: apiKeyManager,
+		logger:        l,
+	}, nil
+}
+
+// CreatePersonal creates a personal API key of the current user and returns it if the operation is successful.
+func (s *ApiKeyService) CreatePersonal(ctx context.Context, req *apikeyspb.CreatePersonalRequest) (*apikeyspb.CreatePersonalResponse, error) {
+	var res *apikeyspb.CreatePersonalResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_CreatePersonal,
+		iactions.OperationTypeApiKeyService_CreatePersonal,
+		[]string{iidentity.PermissionApiKey_ManagePersonal},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCreatePersonalRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, nil,
+					"[apikeys.ApiKeyService.CreatePersonal] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if !opCtx.OperationCtx.UserId.HasValue {
+				return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+			}
+
+			d := &apikeyoperations.CreateOperationData{
+				Name:   req.Name,
+				Type:   models.ApiKeyTypePersonal,
+				UserId: opCtx.OperationCtx.UserId,
+			}
+			if req.Permissions != nil {
+				d.PermissionIds = req.Permissions.PermissionIds
+			}
+			if req.ExpiresAt != nil {
+				t := req.ExpiresAt.AsTime()
+				d.ExpiresAt = &t
+			}
+
+			k, err := s.apiKeyManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.CreatePersonal] create a personal API key",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &apikeyspb.CreatePersonalResponse{
+				Id:  k.Id,
+				Key: []byte(k.Key),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateService creates an API key of the service client and returns it if the operation is successful.
+func (s *ApiKeyService) CreateService(ctx context.Context, req *apikeyspb.CreateServiceRequest) (*apikeyspb.CreateServiceResponse, error) {
+	var res *apikeyspb.CreateServiceResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_CreateService,
+		iactions.OperationTypeApiKeyService_CreateService,
+		[]string{iidentity.PermissionApiKey_Manage},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCreateServiceRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, nil,
+					"[apikeys.ApiKeyService.CreateService] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &apikeyoperations.CreateOperationData{
+				Name:     req.Name,
+				Type:     models.ApiKeyTypeService,
+				ClientId: nullable.NewNullable(req.ClientId),
+			}
+			if req.Permissions != nil {
+				d.PermissionIds = req.Permissions.PermissionIds
+			}
+			if req.ExpiresAt != nil {
+				t := req.ExpiresAt.AsTime()
+				d.ExpiresAt = &t
+			}
+
+			k, err := s.apiKeyManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.CreateService] create a service API key",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &apikeyspb.CreateServiceResponse{
+				Id:  k.Id,
+				Key: []byte(k.Key),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Revoke revokes an API key by the specified API key ID.
+func (s *ApiKeyService) Revoke(ctx context.Context, req *apikeyspb.RevokeRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_Revoke,
+		iactions.OperationTypeApiKeyService_Revoke,
+		[]string{iidentity.PermissionApiKey_Manage},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.apiKeyManager.Revoke(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.Revoke] revoke an API key",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokePersonal revokes a personal API key of the current user by the specified API key ID.
+func (s *ApiKeyService) RevokePersonal(ctx context.Context, req *apikeyspb.RevokePersonalRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_RevokePersonal,
+		iactions.OperationTypeApiKeyService_RevokePersonal,
+		[]string{iidentity.PermissionApiKey_ManagePersonal},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.apiKeyManager.RevokePersonal(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.RevokePersonal] revoke a personal API key",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetAllPersonal gets all personal API keys of the current user.
+func (s *ApiKeyService) GetAllPersonal(ctx context.Context, req *emptypb.Empty) (*apikeyspb.GetAllPersonalResponse, error) {
+	var res *apikeyspb.GetAllPersonalResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_GetAllPersonal,
+		iactions.OperationTypeApiKeyService_GetAllPersonal,
+		[]string{iidentity.PermissionApiKey_ManagePersonal},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if !opCtx.OperationCtx.UserId.HasValue {
+				return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+			}
+
+			ks, err := s.apiKeyManager.GetAllByUserId(opCtx.OperationCtx, opCtx.OperationCtx.UserId.Value)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.GetAllPersonal] get all API keys by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ks2 := make([]*apikeyspb.ApiKey, len(ks))
+			for i := 0; i < len(ks); i++ {
+				ks2[i] = converter.ConvertToApiApiKey(ks[i])
+			}
+
+			res = &apikeyspb.GetAllPersonalResponse{ApiKeys: ks2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByUserId gets all personal API keys of the user by the specified user ID.
+func (s *ApiKeyService) GetAllByUserId(ctx context.Context, req *apikeyspb.GetAllByUserIdRequest) (*apikeyspb.GetAllByUserIdResponse, error) {
+	var res *apikeyspb.GetAllByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_GetAllByUserId,
+		iactions.OperationTypeApiKeyService_GetAllByUserId,
+		[]string{iidentity.PermissionApiKey_Manage},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ks, err := s.apiKeyManager.GetAllByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.GetAllByUserId] get all API keys by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ks2 := make([]*apikeyspb.ApiKey, len(ks))
+			for i := 0; i < len(ks); i++ {
+				ks2[i] = converter.ConvertToApiApiKey(ks[i])
+			}
+
+			res = &apikeyspb.GetAllByUserIdResponse{ApiKeys: ks2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByClientId gets all API keys of the service client by the specified client ID.
+func (s *ApiKeyService) GetAllByClientId(ctx context.Context, req *apikeyspb.GetAllByClientIdRequest) (*apikeyspb.GetAllByClientIdResponse, error) {
+	var res *apikeyspb.GetAllByClientIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_GetAllByClientId,
+		iactions.OperationTypeApiKeyService_GetAllByClientId,
+		[]string{iidentity.PermissionApiKey_Manage},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ks, err := s.apiKeyManager.GetAllByClientId(opCtx.OperationCtx, req.ClientId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.GetAllByClientId] get all API keys by client id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ks2 := make([]*apikeyspb.ApiKey, len(ks))
+			for i := 0; i < len(ks); i++ {
+				ks2[i] = converter.ConvertToApiApiKey(ks[i])
+			}
+
+			res = &apikeyspb.GetAllByClientIdResponse{ApiKeys: ks2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Authenticate authenticates the owner of the specified API key.
+func (s *ApiKeyService) Authenticate(ctx context.Context, req *apikeyspb.AuthenticateRequest) (*apikeyspb.AuthenticateResponse, error) {
+	var res *apikeyspb.AuthenticateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_Authenticate,
+		iactions.OperationTypeApiKeyService_Authenticate,
+		[]string{iidentity.PermissionApiKey_Authenticate},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateAuthenticateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, nil,
+					"[apikeys.ApiKeyService.Authenticate] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			r, err := s.apiKeyManager.Authenticate(opCtx.OperationCtx, string(req.Key))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.Authenticate] authenticate the owner of an API key",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeInvalidAuthnToken {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidAuthnToken)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = toAuthenticateResponse(r)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AuthenticateById authenticates the owner of an API key by the specified API key ID.
+func (s *ApiKeyService) AuthenticateById(ctx context.Context, req *apikeyspb.AuthenticateByIdRequest) (*apikeyspb.AuthenticateResponse, error) {
+	var res *apikeyspb.AuthenticateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeApiKey_AuthenticateById,
+		iactions.OperationTypeApiKeyService_AuthenticateById,
+		[]string{iidentity.PermissionApiKey_Authenticate},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateAuthenticateByIdRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, nil,
+					"[apikeys.ApiKeyService.AuthenticateById] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			r, err := s.apiKeyManager.AuthenticateById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ApiKeyServiceEvent, err,
+					"[apikeys.ApiKeyService.AuthenticateById] authenticate the owner of an API key by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeInvalidAuthnToken {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidAuthnToken)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = toAuthenticateResponse(r)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toAuthenticateResponse(r *models.AuthenticationResult) *apikeyspb.AuthenticateResponse {
+	res := &apikeyspb.AuthenticateResponse{
+		ApiKeyId: r.ApiKeyId,
+		UserType: userspb.UserTypeEnum_UserType(r.UserType),
+	}
+	if r.UserId.HasValue {
+		res.UserId = wrapperspb.UInt64(r.UserId.Value)
+	}
+	if r.ClientId.HasValue {
+		res.ClientId = wrapperspb.UInt64(r.ClientId.Value)
+	}
+	if r.PermissionIds != nil {
+		res.Permissions = &apikeyspb.ApiKeyPermissions{PermissionIds: r.PermissionIds}
+	}
+	return res
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrorCodeClientNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrClientNotFound)
+		case ierrors.ErrorCodeInvalidClientId:
+			return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidClientId)
+		case ierrors.ErrorCodePermissionNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrPermissionNotFound)
+		case ierrors.ErrorCodePermissionNotGranted:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, iapierrors.ErrPermissionNotGranted)
+		case ierrors.ErrorCodeApiKeyNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrApiKeyNotFound)
+		case errors.ErrorCodeInvalidData:
+			return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
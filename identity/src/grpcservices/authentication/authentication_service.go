@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authenticationpb "personal-website-v2/go-apis/identity/authentication"
 	userspb "personal-website-v2/go-apis/identity/users"
@@ -26,9 +27,11 @@ import (
 	"personal-website-v2/identity/src/api/grpc/authentication/validation"
 	iactions "personal-website-v2/identity/src/internal/actions"
 	"personal-website-v2/identity/src/internal/authentication"
+	"personal-website-v2/identity/src/internal/clients"
 	ierrors "personal-website-v2/identity/src/internal/errors"
 	iidentity "personal-website-v2/identity/src/internal/identity"
 	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/sessions"
 	"personal-website-v2/pkg/actions"
 	apierrors "personal-website-v2/pkg/api/errors"
 	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
@@ -41,9 +44,11 @@ import (
 
 type AuthenticationService struct {
 	authenticationpb.UnimplementedAuthenticationServiceServer
-	reqProcessor          *grpcserverhelper.RequestProcessor
-	authenticationManager authentication.AuthenticationManager
-	logger                logging.Logger[*lcontext.LogEntryContext]
+	reqProcessor              *grpcserverhelper.RequestProcessor
+	authenticationManager     authentication.AuthenticationManager
+	serviceClientTokenManager clients.ServiceClientTokenManager
+	activeSessionManager      sessions.ActiveSessionManager
+	logger                    logging.Logger[*lcontext.LogEntryContext]
 }
 
 func NewAuthenticationService(
@@ -51,6 +56,8 @@ func NewAuthenticationService(
 	actionManager *actions.ActionManager,
 	identityManager identity.IdentityManager,
 	authenticationManager authentication.AuthenticationManager,
+	serviceClientTokenManager clients.ServiceClientTokenManager,
+	activeSessionManager sessions.ActiveSessionManager,
 	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
 ) (*AuthenticationService, error) {
 	l, err := loggerFactory.CreateLogger("grpcservices.authentication.AuthenticationService")
@@ -69,9 +76,11 @@ func NewAuthenticationService(
 	}
 
 	return &AuthenticationService{
-		reqProcessor:          p,
-		authenticationManager: authenticationManager,
-		logger:                l,
+		reqProcessor:              p,
+		authenticationManager:     authenticationManager,
+		serviceClientTokenManager: serviceClientTokenManager,
+		activeSessionManager:      activeSessionManager,
+		logger:                    l,
 	}, nil
 }
 
@@ -81,6 +90,14 @@ func (s *AuthenticationService) CreateUserToken(ctx context.Context, req *authen
 	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthentication_CreateUserToken, iactions.OperationTypeAuthenticationService_CreateUserToken,
 		[]string{iidentity.PermissionAuthentication_CreateUserToken},
 		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if s.activeSessionManager.IsSessionRevoked(req.UserSessionId) {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent,
+					"[authentication.AuthenticationService.CreateUserToken] user's session has been revoked",
+					logging.NewField("userSessionId", req.UserSessionId),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidUserSessionId)
+			}
+
 			t, err := s.authenticationManager.CreateUserToken(opCtx.OperationCtx, req.UserSessionId)
 			if err != nil {
 				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, err,
@@ -161,6 +178,22 @@ func (s *AuthenticationService) Authenticate(ctx context.Context, req *authentic
 				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
 			}
 
+			isRevoked, err := s.activeSessionManager.IsRevoked(opCtx.OperationCtx, r.UserId, r.ClientId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, err,
+					"[authentication.AuthenticationService.Authenticate] check if the user's session has been revoked",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if isRevoked {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent,
+					"[authentication.AuthenticationService.Authenticate] user's session has been revoked",
+					logging.NewField("userId", r.UserId),
+					logging.NewField("clientId", r.ClientId),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidUserAuthnToken)
+			}
+
 			res = &authenticationpb.AuthenticateResponse{
 				UserId:   r.UserId,
 				UserType: userspb.UserTypeEnum_UserType(r.UserType),
@@ -265,3 +298,82 @@ func (s *AuthenticationService) AuthenticateClient(ctx context.Context, req *aut
 	}
 	return res, nil
 }
+
+// CreateServiceClientToken creates an access token of the service client by the client credentials
+// and returns it if the operation is successful.
+func (s *AuthenticationService) CreateServiceClientToken(ctx context.Context, req *authenticationpb.CreateServiceClientTokenRequest) (*authenticationpb.CreateServiceClientTokenResponse, error) {
+	var res *authenticationpb.CreateServiceClientTokenResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthentication_CreateServiceClientToken,
+		iactions.OperationTypeAuthenticationService_CreateServiceClientToken,
+		[]string{iidentity.PermissionAuthentication_CreateServiceClientToken},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCreateServiceClientTokenRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, nil,
+					"[authentication.AuthenticationService.CreateServiceClientToken] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			t, err := s.serviceClientTokenManager.CreateToken(opCtx.OperationCtx, req.ClientId, req.ClientSecret)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeInvalidCredentials {
+					s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent,
+						"[authentication.AuthenticationService.CreateServiceClientToken] invalid credentials",
+					)
+					return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, iapierrors.ErrInvalidCredentials)
+				}
+
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, err,
+					"[authentication.AuthenticationService.CreateServiceClientToken] create a service client token",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &authenticationpb.CreateServiceClientTokenResponse{
+				Token:     []byte(t.Token),
+				ExpiresAt: timestamppb.New(t.ExpiresAt),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AuthenticateServiceClient authenticates a service client.
+func (s *AuthenticationService) AuthenticateServiceClient(ctx context.Context, req *authenticationpb.AuthenticateServiceClientRequest) (*authenticationpb.AuthenticateServiceClientResponse, error) {
+	var res *authenticationpb.AuthenticateServiceClientResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthentication_AuthenticateServiceClient,
+		iactions.OperationTypeAuthenticationService_AuthenticateServiceClient,
+		[]string{iidentity.PermissionAuthentication_AuthenticateServiceClient},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateAuthenticateServiceClientRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, nil,
+					"[authentication.AuthenticationService.AuthenticateServiceClient] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			clientId, err := s.serviceClientTokenManager.Authenticate(opCtx.OperationCtx, string(req.ClientToken))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthenticationServiceEvent, err,
+					"[authentication.AuthenticationService.AuthenticateServiceClient] authenticate a service client",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeInvalidClientAuthnToken {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidClientAuthnToken)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &authenticationpb.AuthenticateServiceClientResponse{ClientId: clientId}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
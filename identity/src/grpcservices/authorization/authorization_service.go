@@ -22,10 +22,14 @@ import (
 
 	authorizationpb "personal-website-v2/go-apis/identity/authorization"
 	groupspb "personal-website-v2/go-apis/identity/groups"
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
 	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/authorization/converter"
 	"personal-website-v2/identity/src/api/grpc/authorization/validation"
+	roleconverter "personal-website-v2/identity/src/api/grpc/roles/converter"
 	iactions "personal-website-v2/identity/src/internal/actions"
 	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/authorization/models"
 	ierrors "personal-website-v2/identity/src/internal/errors"
 	iidentity "personal-website-v2/identity/src/internal/identity"
 	"personal-website-v2/identity/src/internal/logging/events"
@@ -97,7 +101,19 @@ func (s *AuthorizationService) Authorize(ctx context.Context, req *authorization
 				clientId = nullable.NewNullable(req.ClientId.Value)
 			}
 
-			r, err := s.authorizationManager.Authorize(opCtx.OperationCtx, userId, clientId, req.RequiredPermissionIds)
+			var rs []*models.Resource
+			if len(req.Resources) > 0 {
+				rs = make([]*models.Resource, len(req.Resources))
+				for i := 0; i < len(req.Resources); i++ {
+					r := req.Resources[i]
+					rs[i] = &models.Resource{TypeId: r.TypeId, Id: r.Id}
+					if r.OwnerId != nil {
+						rs[i].OwnerId = nullable.NewNullable(r.OwnerId.Value)
+					}
+				}
+			}
+
+			r, err := s.authorizationManager.Authorize(opCtx.OperationCtx, userId, clientId, req.RequiredPermissionIds, rs)
 			if err != nil {
 				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthorizationServiceEvent, err,
 					"[authorization.AuthorizationService.Authorize] authorize a user",
@@ -140,3 +156,91 @@ func (s *AuthorizationService) Authorize(ctx context.Context, req *authorization
 	}
 	return res, nil
 }
+
+// Explain explains the authorization of a user (client) for the specified permissions regardless of the resources.
+func (s *AuthorizationService) Explain(ctx context.Context, req *authorizationpb.ExplainRequest) (*authorizationpb.ExplainResponse, error) {
+	var res *authorizationpb.ExplainResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthorization_Explain, iactions.OperationTypeAuthorizationService_Explain,
+		[]string{iidentity.PermissionAuthorization_Explain},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateExplainRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthorizationServiceEvent, nil,
+					"[authorization.AuthorizationService.Explain] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var userId, clientId nullable.Nullable[uint64]
+			if req.UserId != nil {
+				userId = nullable.NewNullable(req.UserId.Value)
+			}
+			if req.ClientId != nil {
+				clientId = nullable.NewNullable(req.ClientId.Value)
+			}
+
+			e, err := s.authorizationManager.Explain(opCtx.OperationCtx, userId, clientId, req.PermissionIds)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthorizationServiceEvent, err,
+					"[authorization.AuthorizationService.Explain] explain the authorization",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &authorizationpb.ExplainResponse{Explanation: converter.ConvertToApiAuthorizationExplanation(e)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllRoleAssignmentsByPermissionId gets all active role assignments of the roles that are granted
+// the specified permission, directly or by inheritance.
+func (s *AuthorizationService) GetAllRoleAssignmentsByPermissionId(ctx context.Context, req *authorizationpb.GetAllRoleAssignmentsByPermissionIdRequest,
+) (*authorizationpb.GetAllRoleAssignmentsByPermissionIdResponse, error) {
+	var res *authorizationpb.GetAllRoleAssignmentsByPermissionIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthorization_GetAllRoleAssignmentsByPermissionId,
+		iactions.OperationTypeAuthorizationService_GetAllRoleAssignmentsByPermissionId,
+		[]string{iidentity.PermissionAuthorization_GetAllRoleAssignmentsByPermission},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			as, err := s.authorizationManager.GetAllRoleAssignmentsByPermissionId(opCtx.OperationCtx, req.PermissionId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_AuthorizationServiceEvent, err,
+					"[authorization.AuthorizationService.GetAllRoleAssignmentsByPermissionId] get all role assignments by permission id",
+				)
+				return toGrpcError(err)
+			}
+
+			ras := make([]*assignmentspb.RoleAssignment, len(as))
+			for i := 0; i < len(as); i++ {
+				ras[i] = roleconverter.ConvertToApiRoleAssignment(as[i])
+			}
+
+			res = &authorizationpb.GetAllRoleAssignmentsByPermissionIdResponse{RoleAssignments: ras}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2 {
+		case ierrors.ErrUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrClientNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrClientNotFound)
+		case ierrors.ErrPermissionNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrPermissionNotFound)
+		}
+		if err2.Code() == errors.ErrorCodeInvalidData {
+			return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
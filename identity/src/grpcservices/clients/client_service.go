@@ -170,6 +170,87 @@ func (s *ClientService) CreateMobileClient(ctx context.Context, req *clientspb.C
 	return res, nil
 }
 
+// CreateServiceClient creates a service client and returns the client ID and secret if the operation is successful.
+func (s *ClientService) CreateServiceClient(ctx context.Context, req *clientspb.CreateServiceClientRequest) (*clientspb.CreateServiceClientResponse, error) {
+	var res *clientspb.CreateServiceClientResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeClient_CreateServiceClient, iactions.OperationTypeClientService_CreateServiceClient,
+		[]string{iidentity.PermissionClient_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCreateServiceClientRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ClientServiceEvent, nil,
+					"[clients.ClientService.CreateServiceClient] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &clientoperations.CreateServiceClientOperationData{
+				AppId: req.AppId,
+				IP:    req.Ip,
+			}
+
+			id, secret, err := s.clientManager.CreateServiceClient(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ClientServiceEvent, err,
+					"[clients.ClientService.CreateServiceClient] create a service client",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &clientspb.CreateServiceClientResponse{
+				Id:     id,
+				Secret: secret,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RotateServiceClientSecret generates a new secret of the service client and returns it if the operation is successful.
+// The previous secret remains valid during the grace period.
+func (s *ClientService) RotateServiceClientSecret(ctx context.Context, req *clientspb.RotateServiceClientSecretRequest) (*clientspb.RotateServiceClientSecretResponse, error) {
+	var res *clientspb.RotateServiceClientSecretResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeClient_RotateServiceClientSecret, iactions.OperationTypeClientService_RotateServiceClientSecret,
+		[]string{iidentity.PermissionClient_RotateSecret},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			secret, err := s.clientManager.RotateServiceClientSecret(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ClientServiceEvent, err,
+					"[clients.ClientService.RotateServiceClientSecret] rotate a service client secret",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2 {
+					case ierrors.ErrClientNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrClientNotFound)
+					case ierrors.ErrInvalidClientId:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidClientId)
+					}
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &clientspb.RotateServiceClientSecretResponse{Secret: secret}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // Delete deletes a client by the specified client ID.
 func (s *ClientService) Delete(ctx context.Context, req *clientspb.DeleteRequest) (*emptypb.Empty, error) {
 	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeClient_Delete, iactions.OperationTypeClientService_Delete,
@@ -0,0 +1,342 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	credentialspb "personal-website-v2/go-apis/identity/credentials"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/credentials/validation"
+	mfaconverter "personal-website-v2/identity/src/api/grpc/mfa/converter"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/credentials"
+	"personal-website-v2/identity/src/internal/credentials/operations/signin"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserCredentialService struct {
+	credentialspb.UnimplementedUserCredentialServiceServer
+	reqProcessor          *grpcserverhelper.RequestProcessor
+	userCredentialManager credentials.UserCredentialManager
+	signInManager         credentials.SignInManager
+	logger                logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserCredentialService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userCredentialManager credentials.UserCredentialManager,
+	signInManager credentials.SignInManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserCredentialService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.credentials.UserCredentialService")
+	if err != nil {
+		return nil, fmt.Errorf("[credentials.NewUserCredentialService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserCredential,
+		OperationGroup: iactions.OperationGroupUserCredential,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[credentials.NewUserCredentialService] new request processor: %w", err)
+	}
+
+	return &UserCredentialService{
+		reqProcessor:          p,
+		userCredentialManager: userCredentialManager,
+		signInManager:         signInManager,
+		logger:                l,
+	}, nil
+}
+
+// SetPassword sets (resets) a user's password by the specified user ID.
+func (s *UserCredentialService) SetPassword(ctx context.Context, req *credentialspb.SetPasswordRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserCredential_SetPassword, iactions.OperationTypeUserCredentialService_SetPassword,
+		[]string{iidentity.PermissionUserCredential_SetPassword},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateSetPasswordRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, nil,
+					"[credentials.UserCredentialService.SetPassword] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.userCredentialManager.SetPassword(opCtx.OperationCtx, req.UserId, req.Password); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+					"[credentials.UserCredentialService.SetPassword] set a user's password",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeUserNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ChangePassword changes a user's password by the specified user ID if the current password is valid.
+// Users can only change their own password.
+func (s *UserCredentialService) ChangePassword(ctx context.Context, req *credentialspb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserCredential_ChangePassword, iactions.OperationTypeUserCredentialService_ChangePassword,
+		[]string{iidentity.PermissionUserCredential_ChangePassword},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateChangePasswordRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, nil,
+					"[credentials.UserCredentialService.ChangePassword] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if !opCtx.OperationCtx.UserId.HasValue || opCtx.OperationCtx.UserId.Value != req.UserId {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+					"[credentials.UserCredentialService.ChangePassword] user can't change another user's password",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+			}
+
+			if err := s.userCredentialManager.ChangePassword(opCtx.OperationCtx, req.UserId, req.CurrentPassword, req.NewPassword); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+					"[credentials.UserCredentialService.ChangePassword] change a user's password",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeUserNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					case ierrors.ErrorCodeUserCredentialNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserCredentialNotFound)
+					case ierrors.ErrorCodeInvalidCredentials:
+						return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, iapierrors.ErrInvalidCredentials)
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SignInWithPassword signs in a user with a name or an email and a password, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+func (s *UserCredentialService) SignInWithPassword(ctx context.Context, req *credentialspb.SignInWithPasswordRequest) (*credentialspb.SignInWithPasswordResponse, error) {
+	var res *credentialspb.SignInWithPasswordResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserCredential_SignInWithPassword, iactions.OperationTypeUserCredentialService_SignInWithPassword,
+		[]string{iidentity.PermissionUserCredential_SignIn},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateSignInWithPasswordRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, nil,
+					"[credentials.UserCredentialService.SignInWithPassword] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &signin.SignInWithPasswordOperationData{
+				Password:  req.Password,
+				ClientId:  req.ClientId,
+				UserAgent: req.UserAgent,
+				IP:        req.Ip,
+			}
+			if req.Name != nil {
+				d.Name = nullable.NewNullable(req.Name.Value)
+			}
+			if req.Email != nil {
+				d.Email = nullable.NewNullable(req.Email.Value)
+			}
+			if req.AppId != nil {
+				d.AppId = nullable.NewNullable(req.AppId.Value)
+			}
+
+			r, err := s.signInManager.SignInWithPassword(opCtx.OperationCtx, d)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeInvalidCredentials:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.SignInWithPassword] invalid credentials",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, iapierrors.ErrInvalidCredentials)
+					case ierrors.ErrorCodeUserLockedOut:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.SignInWithPassword] user is locked out",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, iapierrors.ErrUserLockedOut)
+					case ierrors.ErrorCodeMfaEnrollmentRequired:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.SignInWithPassword] MFA enrollment required",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, iapierrors.ErrMfaEnrollmentRequired)
+					case errors.ErrorCodeInvalidData:
+						s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+							"[credentials.UserCredentialService.SignInWithPassword] sign in a user",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+							"[credentials.UserCredentialService.SignInWithPassword] sign in a user",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+					"[credentials.UserCredentialService.SignInWithPassword] sign in a user",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			if r.MfaRequired {
+				res = &credentialspb.SignInWithPasswordResponse{
+					UserId:                r.UserId,
+					MfaRequired:           true,
+					MfaChallengeToken:     r.MfaChallengeToken,
+					MfaChallengeExpiresAt: timestamppb.New(r.MfaChallengeExpiresAt),
+				}
+				return nil
+			}
+
+			res = &credentialspb.SignInWithPasswordResponse{
+				UserId:             r.UserId,
+				UserSessionId:      r.UserSessionId,
+				UserAgentId:        r.UserAgentId,
+				UserAgentSessionId: r.UserAgentSessionId,
+				Token:              r.UserToken,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CompleteSignIn completes the sign-in of a user with the MFA challenge, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+func (s *UserCredentialService) CompleteSignIn(ctx context.Context, req *credentialspb.CompleteSignInRequest) (*credentialspb.CompleteSignInResponse, error) {
+	var res *credentialspb.CompleteSignInResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserCredential_CompleteSignIn, iactions.OperationTypeUserCredentialService_CompleteSignIn,
+		[]string{iidentity.PermissionUserCredential_SignIn},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCompleteSignInRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, nil,
+					"[credentials.UserCredentialService.CompleteSignIn] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &signin.CompleteSignInOperationData{
+				ChallengeToken: req.ChallengeToken,
+				Method:         mfaconverter.ConvertToMfaMethod(req.Method),
+				Code:           req.Code,
+				IP:             req.Ip,
+			}
+
+			r, err := s.signInManager.CompleteSignIn(opCtx.OperationCtx, d)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeMfaChallengeNotFound:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.CompleteSignIn] MFA challenge not found",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, iapierrors.ErrMfaChallengeNotFound)
+					case ierrors.ErrorCodeInvalidMfaCode:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.CompleteSignIn] invalid MFA code",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, iapierrors.ErrInvalidMfaCode)
+					case ierrors.ErrorCodeUserLockedOut:
+						s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent,
+							"[credentials.UserCredentialService.CompleteSignIn] user is locked out",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, iapierrors.ErrUserLockedOut)
+					case errors.ErrorCodeInvalidData:
+						s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+							"[credentials.UserCredentialService.CompleteSignIn] complete the sign-in of a user",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+							"[credentials.UserCredentialService.CompleteSignIn] complete the sign-in of a user",
+						)
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserCredentialServiceEvent, err,
+					"[credentials.UserCredentialService.CompleteSignIn] complete the sign-in of a user",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &credentialspb.CompleteSignInResponse{
+				UserId:             r.UserId,
+				UserSessionId:      r.UserSessionId,
+				UserAgentId:        r.UserAgentId,
+				UserAgentSessionId: r.UserAgentSessionId,
+				Token:              r.UserToken,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasubjects
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	datasubjectspb "personal-website-v2/go-apis/identity/datasubjects"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/datasubjects/converter"
+	datasubjectvalidation "personal-website-v2/identity/src/api/grpc/datasubjects/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/datasubjects"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type DataSubjectRequestService struct {
+	datasubjectspb.UnimplementedDataSubjectRequestServiceServer
+	reqProcessor   *grpcserverhelper.RequestProcessor
+	requestManager datasubjects.DataSubjectRequestManager
+	logger         logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewDataSubjectRequestService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	requestManager datasubjects.DataSubjectRequestManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*DataSubjectRequestService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.datasubjects.DataSubjectRequestService")
+	if err != nil {
+		return nil, fmt.Errorf("[datasubjects.NewDataSubjectRequestService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupDataSubjectRequest,
+		OperationGroup: iactions.OperationGroupDataSubjectRequest,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[datasubjects.NewDataSubjectRequestService] new request processor: %w", err)
+	}
+
+	return &DataSubjectRequestService{
+		reqProcessor:   p,
+		requestManager: requestManager,
+		logger:         l,
+	}, nil
+}
+
+// CreateExport creates a request to export all personal data of the user and returns the request ID
+// if the operation is successful.
+func (s *DataSubjectRequestService) CreateExport(ctx context.Context, req *datasubjectspb.CreateExportRequest) (*datasubjectspb.CreateExportResponse, error) {
+	var res *datasubjectspb.CreateExportResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeDataSubjectRequest_CreateExport,
+		iactions.OperationTypeDataSubjectRequestService_CreateExport,
+		[]string{iidentity.PermissionDataSubjectRequest_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := datasubjectvalidation.ValidateCreateExportRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, nil,
+					"[datasubjects.DataSubjectRequestService.CreateExport] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			id, err := s.requestManager.CreateExport(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.CreateExport] create an export request",
+				)
+				return s.createGrpcError(err)
+			}
+
+			res = &datasubjectspb.CreateExportResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateErasure creates a request to erase the personal data of the user and returns the request ID
+// if the operation is successful.
+func (s *DataSubjectRequestService) CreateErasure(ctx context.Context, req *datasubjectspb.CreateErasureRequest) (*datasubjectspb.CreateErasureResponse, error) {
+	var res *datasubjectspb.CreateErasureResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeDataSubjectRequest_CreateErasure,
+		iactions.OperationTypeDataSubjectRequestService_CreateErasure,
+		[]string{iidentity.PermissionDataSubjectRequest_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := datasubjectvalidation.ValidateCreateErasureRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, nil,
+					"[datasubjects.DataSubjectRequestService.CreateErasure] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			id, err := s.requestManager.CreateErasure(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.CreateErasure] create an erasure request",
+				)
+				return s.createGrpcError(err)
+			}
+
+			res = &datasubjectspb.CreateErasureResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetById gets a request and its steps by the specified request ID.
+func (s *DataSubjectRequestService) GetById(ctx context.Context, req *datasubjectspb.GetByIdRequest) (*datasubjectspb.GetByIdResponse, error) {
+	var res *datasubjectspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeDataSubjectRequest_GetById, iactions.OperationTypeDataSubjectRequestService_GetById,
+		[]string{iidentity.PermissionDataSubjectRequest_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			r, err := s.requestManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.GetById] find a request by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if r == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent,
+					"[datasubjects.DataSubjectRequestService.GetById] request not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrDataSubjectRequestNotFound)
+			}
+
+			steps, err := s.requestManager.GetAllStepsByRequestId(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.GetById] get all steps by request id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			steps2 := make([]*datasubjectspb.DataSubjectRequestStep, len(steps))
+			for i := 0; i < len(steps); i++ {
+				steps2[i] = converter.ConvertToApiDataSubjectRequestStep(steps[i])
+			}
+
+			res = &datasubjectspb.GetByIdResponse{
+				Request: converter.ConvertToApiDataSubjectRequest(r),
+				Steps:   steps2,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByUserId gets all requests by the specified user ID.
+func (s *DataSubjectRequestService) GetAllByUserId(ctx context.Context, req *datasubjectspb.GetAllByUserIdRequest,
+) (*datasubjectspb.GetAllByUserIdResponse, error) {
+	var res *datasubjectspb.GetAllByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeDataSubjectRequest_GetAllByUserId,
+		iactions.OperationTypeDataSubjectRequestService_GetAllByUserId,
+		[]string{iidentity.PermissionDataSubjectRequest_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			rs, err := s.requestManager.GetAllByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.GetAllByUserId] get all requests by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			rs2 := make([]*datasubjectspb.DataSubjectRequest, len(rs))
+			for i := 0; i < len(rs); i++ {
+				rs2[i] = converter.ConvertToApiDataSubjectRequest(rs[i])
+			}
+
+			res = &datasubjectspb.GetAllByUserIdResponse{Requests: rs2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetExportData gets the data (JSON archive) of the completed export request.
+func (s *DataSubjectRequestService) GetExportData(ctx context.Context, req *datasubjectspb.GetExportDataRequest) (*datasubjectspb.GetExportDataResponse, error) {
+	var res *datasubjectspb.GetExportDataResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeDataSubjectRequest_GetExportData,
+		iactions.OperationTypeDataSubjectRequestService_GetExportData,
+		[]string{iidentity.PermissionDataSubjectRequest_GetExportData},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			data, err := s.requestManager.GetExportData(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_DataSubjectRequestServiceEvent, err,
+					"[datasubjects.DataSubjectRequestService.GetExportData] get export data",
+				)
+				return s.createGrpcError(err)
+			}
+
+			res = &datasubjectspb.GetExportDataResponse{Data: data}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *DataSubjectRequestService) createGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrorCodeDataSubjectRequestAlreadyExists:
+			return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrDataSubjectRequestAlreadyExists)
+		case ierrors.ErrorCodeDataSubjectRequestNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrDataSubjectRequestNotFound)
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
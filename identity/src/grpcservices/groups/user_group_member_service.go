@@ -0,0 +1,249 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	memberspb "personal-website-v2/go-apis/identity/groups/members"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/groups/converter"
+	membervalidation "personal-website-v2/identity/src/api/grpc/groups/validation/members"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/models"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserGroupMemberService struct {
+	memberspb.UnimplementedUserGroupMemberServiceServer
+	reqProcessor           *grpcserverhelper.RequestProcessor
+	userGroupMemberManager groups.UserGroupMemberManager
+	logger                 logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserGroupMemberService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userGroupMemberManager groups.UserGroupMemberManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserGroupMemberService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.groups.UserGroupMemberService")
+	if err != nil {
+		return nil, fmt.Errorf("[groups.NewUserGroupMemberService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserGroupMember,
+		OperationGroup: iactions.OperationGroupUserGroupMember,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[groups.NewUserGroupMemberService] new request processor: %w", err)
+	}
+
+	return &UserGroupMemberService{
+		reqProcessor:           p,
+		userGroupMemberManager: userGroupMemberManager,
+		logger:                 l,
+	}, nil
+}
+
+// Add adds a user to the user group.
+func (s *UserGroupMemberService) Add(ctx context.Context, req *memberspb.AddRequest) (*emptypb.Empty, error) {
+	// the members of a group can also be managed by a user who has the permission only for this group
+	err := s.reqProcessor.ProcessWithAuthnCheckAndResourceAuthz(ctx, iactions.ActionTypeUserGroupMember_Add, iactions.OperationTypeUserGroupMemberService_Add,
+		[]string{iidentity.PermissionUserGroupMember_Update},
+		[]*identity.Resource{identity.NewResource(iidentity.ResourceTypeUserGroup, req.GroupId, nullable.Nullable[uint64]{})},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := membervalidation.ValidateAddRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, nil,
+					"[groups.UserGroupMemberService.Add] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.userGroupMemberManager.Add(opCtx.OperationCtx, models.UserGroup(req.GroupId), req.UserId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, err,
+					"[groups.UserGroupMemberService.Add] add a user to the user group",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodeUserGroupNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+					case ierrors.ErrorCodeUserNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					case ierrors.ErrorCodeUserGroupMemberAlreadyExists:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUserGroupMemberAlreadyExists)
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Remove removes a user from the user group.
+func (s *UserGroupMemberService) Remove(ctx context.Context, req *memberspb.RemoveRequest) (*emptypb.Empty, error) {
+	// the members of a group can also be managed by a user who has the permission only for this group
+	err := s.reqProcessor.ProcessWithAuthnCheckAndResourceAuthz(ctx, iactions.ActionTypeUserGroupMember_Remove, iactions.OperationTypeUserGroupMemberService_Remove,
+		[]string{iidentity.PermissionUserGroupMember_Update},
+		[]*identity.Resource{identity.NewResource(iidentity.ResourceTypeUserGroup, req.GroupId, nullable.Nullable[uint64]{})},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := membervalidation.ValidateRemoveRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, nil,
+					"[groups.UserGroupMemberService.Remove] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.userGroupMemberManager.Remove(opCtx.OperationCtx, models.UserGroup(req.GroupId), req.UserId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, err,
+					"[groups.UserGroupMemberService.Remove] remove a user from the user group",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodeUserGroupMemberNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupMemberNotFound)
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetAllByGroupId gets all members of the user group by the specified user group ID.
+func (s *UserGroupMemberService) GetAllByGroupId(ctx context.Context, req *memberspb.GetAllByGroupIdRequest) (*memberspb.GetAllByGroupIdResponse, error) {
+	var res *memberspb.GetAllByGroupIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroupMember_GetAllByGroupId, iactions.OperationTypeUserGroupMemberService_GetAllByGroupId,
+		[]string{iidentity.PermissionUserGroupMember_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ms, err := s.userGroupMemberManager.GetAllByGroupId(opCtx.OperationCtx, models.UserGroup(req.GroupId))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, err,
+					"[groups.UserGroupMemberService.GetAllByGroupId] get all members of the user group by group id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ms2 := make([]*memberspb.UserGroupMember, len(ms))
+			for i := 0; i < len(ms); i++ {
+				ms2[i] = converter.ConvertToApiUserGroupMember(ms[i])
+			}
+
+			res = &memberspb.GetAllByGroupIdResponse{Members: ms2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+// by the specified user ID.
+func (s *UserGroupMemberService) GetAllGroupIdsByUserId(ctx context.Context, req *memberspb.GetAllGroupIdsByUserIdRequest) (*memberspb.GetAllGroupIdsByUserIdResponse, error) {
+	var res *memberspb.GetAllGroupIdsByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroupMember_GetAllGroupIdsByUserId, iactions.OperationTypeUserGroupMemberService_GetAllGroupIdsByUserId,
+		[]string{iidentity.PermissionUserGroupMember_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.userGroupMemberManager.GetAllGroupIdsByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, err,
+					"[groups.UserGroupMemberService.GetAllGroupIdsByUserId] get all user group ids by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ids2 := make([]uint64, len(ids))
+			for i := 0; i < len(ids); i++ {
+				ids2[i] = uint64(ids[i])
+			}
+
+			res = &memberspb.GetAllGroupIdsByUserIdResponse{GroupIds: ids2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsMember returns true if the user is a member of the user group.
+func (s *UserGroupMemberService) IsMember(ctx context.Context, req *memberspb.IsMemberRequest) (*memberspb.IsMemberResponse, error) {
+	var res *memberspb.IsMemberResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroupMember_IsMember, iactions.OperationTypeUserGroupMemberService_IsMember,
+		[]string{iidentity.PermissionUserGroupMember_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := membervalidation.ValidateIsMemberRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, nil,
+					"[groups.UserGroupMemberService.IsMember] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			isMember, err := s.userGroupMemberManager.IsMember(opCtx.OperationCtx, models.UserGroup(req.GroupId), req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupMemberServiceEvent, err,
+					"[groups.UserGroupMemberService.IsMember] user is a member of the user group",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &memberspb.IsMemberResponse{IsMember: isMember}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
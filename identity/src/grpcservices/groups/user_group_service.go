@@ -0,0 +1,329 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	usergroupspb "personal-website-v2/go-apis/identity/groups/usergroups"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/groups/converter"
+	usergroupvalidation "personal-website-v2/identity/src/api/grpc/groups/validation/usergroups"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/models"
+	usergroupoperations "personal-website-v2/identity/src/internal/groups/operations/usergroups"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserGroupService struct {
+	usergroupspb.UnimplementedUserGroupServiceServer
+	reqProcessor     *grpcserverhelper.RequestProcessor
+	userGroupManager groups.UserGroupManager
+	logger           logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserGroupService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userGroupManager groups.UserGroupManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserGroupService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.groups.UserGroupService")
+	if err != nil {
+		return nil, fmt.Errorf("[groups.NewUserGroupService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserGroup,
+		OperationGroup: iactions.OperationGroupUserGroup,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[groups.NewUserGroupService] new request processor: %w", err)
+	}
+
+	return &UserGroupService{
+		reqProcessor:     p,
+		userGroupManager: userGroupManager,
+		logger:           l,
+	}, nil
+}
+
+// Create creates a user group and returns the user group ID if the operation is successful.
+func (s *UserGroupService) Create(ctx context.Context, req *usergroupspb.CreateRequest) (*usergroupspb.CreateResponse, error) {
+	var res *usergroupspb.CreateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_Create, iactions.OperationTypeUserGroupService_Create,
+		[]string{iidentity.PermissionUserGroup_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := usergroupvalidation.ValidateCreateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, nil,
+					"[groups.UserGroupService.Create] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &usergroupoperations.CreateOperationData{
+				Name:        req.Name,
+				Description: req.Description,
+			}
+
+			id, err := s.userGroupManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.Create] create a user group",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrUserGroupAlreadyExists {
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUserGroupAlreadyExists)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidData {
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &usergroupspb.CreateResponse{Id: uint64(id)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update updates a user group by the specified user group ID.
+func (s *UserGroupService) Update(ctx context.Context, req *usergroupspb.UpdateRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_Update, iactions.OperationTypeUserGroupService_Update,
+		[]string{iidentity.PermissionUserGroup_Update},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := usergroupvalidation.ValidateUpdateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, nil,
+					"[groups.UserGroupService.Update] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &usergroupoperations.UpdateOperationData{
+				Name:        req.Name,
+				Description: req.Description,
+			}
+
+			if err := s.userGroupManager.Update(opCtx.OperationCtx, models.UserGroup(req.Id), d); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.Update] update a user group",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodeUserGroupNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+					case ierrors.ErrorCodeUserGroupAlreadyExists:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUserGroupAlreadyExists)
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Delete deletes a user group by the specified user group ID.
+func (s *UserGroupService) Delete(ctx context.Context, req *usergroupspb.DeleteRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_Delete, iactions.OperationTypeUserGroupService_Delete,
+		[]string{iidentity.PermissionUserGroup_Delete},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.userGroupManager.Delete(opCtx.OperationCtx, models.UserGroup(req.Id)); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.Delete] delete a user group",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrUserGroupNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidOperation {
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetById gets a user group by the specified user group ID.
+func (s *UserGroupService) GetById(ctx context.Context, req *usergroupspb.GetByIdRequest) (*usergroupspb.GetByIdResponse, error) {
+	var res *usergroupspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_GetById, iactions.OperationTypeUserGroupService_GetById,
+		[]string{iidentity.PermissionUserGroup_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			g, err := s.userGroupManager.FindById(opCtx.OperationCtx, models.UserGroup(req.Id))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.GetById] find a user group by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if g == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent,
+					"[groups.UserGroupService.GetById] user group not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+			}
+
+			res = &usergroupspb.GetByIdResponse{Group: converter.ConvertToApiUserGroup(g)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByName gets a user group by the specified user group name.
+func (s *UserGroupService) GetByName(ctx context.Context, req *usergroupspb.GetByNameRequest) (*usergroupspb.GetByNameResponse, error) {
+	var res *usergroupspb.GetByNameResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_GetByName, iactions.OperationTypeUserGroupService_GetByName,
+		[]string{iidentity.PermissionUserGroup_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := usergroupvalidation.ValidateGetByNameRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, nil,
+					"[groups.UserGroupService.GetByName] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			g, err := s.userGroupManager.FindByName(opCtx.OperationCtx, req.Name)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.GetByName] find a user group by name",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if g == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent,
+					"[groups.UserGroupService.GetByName] user group not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+			}
+
+			res = &usergroupspb.GetByNameResponse{Group: converter.ConvertToApiUserGroup(g)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAll gets all user groups.
+func (s *UserGroupService) GetAll(ctx context.Context, req *usergroupspb.GetAllRequest) (*usergroupspb.GetAllResponse, error) {
+	var res *usergroupspb.GetAllResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_GetAll, iactions.OperationTypeUserGroupService_GetAll,
+		[]string{iidentity.PermissionUserGroup_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			gs, err := s.userGroupManager.GetAll(opCtx.OperationCtx)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.GetAll] get all user groups",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			gs2 := make([]*usergroupspb.UserGroup, len(gs))
+			for i := 0; i < len(gs); i++ {
+				gs2[i] = converter.ConvertToApiUserGroup(gs[i])
+			}
+
+			res = &usergroupspb.GetAllResponse{Groups: gs2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exists returns true if the user group exists.
+func (s *UserGroupService) Exists(ctx context.Context, req *usergroupspb.ExistsRequest) (*usergroupspb.ExistsResponse, error) {
+	var res *usergroupspb.ExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserGroup_Exists, iactions.OperationTypeUserGroupService_Exists,
+		[]string{iidentity.PermissionUserGroup_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := usergroupvalidation.ValidateExistsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, nil,
+					"[groups.UserGroupService.Exists] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			exists, err := s.userGroupManager.Exists(opCtx.OperationCtx, req.Name)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserGroupServiceEvent, err,
+					"[groups.UserGroupService.Exists] user group exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &usergroupspb.ExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
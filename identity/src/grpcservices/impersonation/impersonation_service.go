@@ -0,0 +1,182 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impersonation
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	impersonationpb "personal-website-v2/go-apis/identity/impersonation"
+	userspb "personal-website-v2/go-apis/identity/users"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/impersonation/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/impersonation"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ImpersonationService struct {
+	impersonationpb.UnimplementedImpersonationServiceServer
+	reqProcessor         *grpcserverhelper.RequestProcessor
+	impersonationManager impersonation.ImpersonationManager
+	logger               logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewImpersonationService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	impersonationManager impersonation.ImpersonationManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ImpersonationService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.impersonation.ImpersonationService")
+	if err != nil {
+		return nil, fmt.Errorf("[impersonation.NewImpersonationService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupImpersonation,
+		OperationGroup: iactions.OperationGroupImpersonation,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[impersonation.NewImpersonationService] new request processor: %w", err)
+	}
+
+	return &ImpersonationService{
+		reqProcessor:         p,
+		impersonationManager: impersonationManager,
+		logger:               l,
+	}, nil
+}
+
+// CreateToken creates an impersonation token that allows the current user (impersonator)
+// to act on behalf of the specified user.
+func (s *ImpersonationService) CreateToken(ctx context.Context, req *impersonationpb.CreateTokenRequest) (*impersonationpb.CreateTokenResponse, error) {
+	var res *impersonationpb.CreateTokenResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeImpersonation_CreateToken,
+		iactions.OperationTypeImpersonationService_CreateToken,
+		[]string{iidentity.PermissionImpersonation_Impersonate},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			t, err := s.impersonationManager.CreateToken(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ImpersonationServiceEvent, err,
+					"[impersonation.ImpersonationService.CreateToken] create an impersonation token",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeUserNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					case ierrors.ErrorCodeImpersonationNotAllowed:
+						return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, iapierrors.ErrImpersonationNotAllowed)
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &impersonationpb.CreateTokenResponse{
+				Id:        t.Id,
+				Token:     []byte(t.Token),
+				ExpiresAt: timestamppb.New(t.ExpiresAt),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Authenticate authenticates the impersonated user by the specified impersonation token.
+func (s *ImpersonationService) Authenticate(ctx context.Context, req *impersonationpb.AuthenticateRequest) (*impersonationpb.AuthenticateResponse, error) {
+	var res *impersonationpb.AuthenticateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeImpersonation_Authenticate,
+		iactions.OperationTypeImpersonationService_Authenticate,
+		[]string{iidentity.PermissionImpersonation_Authenticate},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateAuthenticateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ImpersonationServiceEvent, nil,
+					"[impersonation.ImpersonationService.Authenticate] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			r, err := s.impersonationManager.Authenticate(opCtx.OperationCtx, string(req.Token))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ImpersonationServiceEvent, err,
+					"[impersonation.ImpersonationService.Authenticate] authenticate an impersonated user",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeInvalidUserAuthnToken {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidUserAuthnToken)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &impersonationpb.AuthenticateResponse{
+				UserId:         r.UserId,
+				UserType:       userspb.UserTypeEnum_UserType(r.UserType),
+				ImpersonatorId: r.ImpersonatorId,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RevokeToken revokes an impersonation token by the specified token ID.
+func (s *ImpersonationService) RevokeToken(ctx context.Context, req *impersonationpb.RevokeTokenRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeImpersonation_RevokeToken,
+		iactions.OperationTypeImpersonationService_RevokeToken,
+		[]string{iidentity.PermissionImpersonation_Impersonate},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.impersonationManager.RevokeToken(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ImpersonationServiceEvent, err,
+					"[impersonation.ImpersonationService.RevokeToken] revoke an impersonation token",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeImpersonationTokenNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrImpersonationTokenNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockouts
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	lockoutspb "personal-website-v2/go-apis/identity/lockouts"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/lockouts/converter"
+	"personal-website-v2/identity/src/api/grpc/lockouts/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/lockouts"
+	"personal-website-v2/identity/src/internal/lockouts/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type LockoutService struct {
+	lockoutspb.UnimplementedLockoutServiceServer
+	reqProcessor   *grpcserverhelper.RequestProcessor
+	lockoutManager lockouts.LockoutManager
+	logger         logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewLockoutService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	lockoutManager lockouts.LockoutManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*LockoutService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.lockouts.LockoutService")
+	if err != nil {
+		return nil, fmt.Errorf("[lockouts.NewLockoutService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupLockout,
+		OperationGroup: iactions.OperationGroupLockout,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[lockouts.NewLockoutService] new request processor: %w", err)
+	}
+
+	return &LockoutService{
+		reqProcessor:   p,
+		lockoutManager: lockoutManager,
+		logger:         l,
+	}, nil
+}
+
+// GetInfo gets lockout info of the user, client or user agent by the specified ID.
+func (s *LockoutService) GetInfo(ctx context.Context, req *lockoutspb.GetInfoRequest) (*lockoutspb.GetInfoResponse, error) {
+	var res *lockoutspb.GetInfoResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeLockout_GetInfo, iactions.OperationTypeLockoutService_GetInfo,
+		[]string{iidentity.PermissionLockout_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetInfoRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_LockoutServiceEvent, nil,
+					"[lockouts.LockoutService.GetInfo] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			info, err := s.lockoutManager.GetInfo(opCtx.OperationCtx, models.TargetType(req.TargetType), req.TargetId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_LockoutServiceEvent, err,
+					"[lockouts.LockoutService.GetInfo] get lockout info",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &lockoutspb.GetInfoResponse{Info: converter.ConvertToApiLockoutInfo(info)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Unlock unlocks the locked out user, client or user agent by the specified ID.
+func (s *LockoutService) Unlock(ctx context.Context, req *lockoutspb.UnlockRequest) (*lockoutspb.UnlockResponse, error) {
+	var res *lockoutspb.UnlockResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeLockout_Unlock, iactions.OperationTypeLockoutService_Unlock,
+		[]string{iidentity.PermissionLockout_Unlock},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateUnlockRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_LockoutServiceEvent, nil,
+					"[lockouts.LockoutService.Unlock] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			unlocked, err := s.lockoutManager.Unlock(opCtx.OperationCtx, models.TargetType(req.TargetType), req.TargetId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_LockoutServiceEvent, err,
+					"[lockouts.LockoutService.Unlock] unlock",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &lockoutspb.UnlockResponse{Unlocked: unlocked}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrorCodeClientNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrClientNotFound)
+		case ierrors.ErrorCodeUserAgentNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserAgentNotFound)
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
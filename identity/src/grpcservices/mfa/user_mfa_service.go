@@ -0,0 +1,273 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	mfapb "personal-website-v2/go-apis/identity/mfa"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/mfa/converter"
+	"personal-website-v2/identity/src/api/grpc/mfa/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserMfaService struct {
+	mfapb.UnimplementedUserMfaServiceServer
+	reqProcessor   *grpcserverhelper.RequestProcessor
+	userMfaManager mfa.UserMfaManager
+	logger         logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserMfaService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userMfaManager mfa.UserMfaManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserMfaService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.mfa.UserMfaService")
+	if err != nil {
+		return nil, fmt.Errorf("[mfa.NewUserMfaService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserMfa,
+		OperationGroup: iactions.OperationGroupUserMfa,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[mfa.NewUserMfaService] new request processor: %w", err)
+	}
+
+	return &UserMfaService{
+		reqProcessor:   p,
+		userMfaManager: userMfaManager,
+		logger:         l,
+	}, nil
+}
+
+// StartTotpEnrollment starts the TOTP enrollment of the user by the specified user ID and returns
+// the TOTP secret and the provisioning URI.
+// Users can only enroll themselves.
+func (s *UserMfaService) StartTotpEnrollment(ctx context.Context, req *mfapb.StartTotpEnrollmentRequest) (*mfapb.StartTotpEnrollmentResponse, error) {
+	var res *mfapb.StartTotpEnrollmentResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserMfa_StartTotpEnrollment, iactions.OperationTypeUserMfaService_StartTotpEnrollment,
+		[]string{iidentity.PermissionUserMfa_Enroll},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateStartTotpEnrollmentRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, nil,
+					"[mfa.UserMfaService.StartTotpEnrollment] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[mfa.UserMfaService.StartTotpEnrollment] user can't enroll another user"); err != nil {
+				return err
+			}
+
+			e, err := s.userMfaManager.StartTotpEnrollment(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, err,
+					"[mfa.UserMfaService.StartTotpEnrollment] start the TOTP enrollment",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &mfapb.StartTotpEnrollmentResponse{
+				Secret:          e.Secret,
+				ProvisioningUri: e.ProvisioningURI,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConfirmTotpEnrollment enables TOTP of the user by the specified user ID if the code is valid
+// and returns the user's recovery codes. The recovery codes are only returned once.
+// Users can only enroll themselves.
+func (s *UserMfaService) ConfirmTotpEnrollment(ctx context.Context, req *mfapb.ConfirmTotpEnrollmentRequest) (*mfapb.ConfirmTotpEnrollmentResponse, error) {
+	var res *mfapb.ConfirmTotpEnrollmentResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserMfa_ConfirmTotpEnrollment, iactions.OperationTypeUserMfaService_ConfirmTotpEnrollment,
+		[]string{iidentity.PermissionUserMfa_Enroll},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateConfirmTotpEnrollmentRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, nil,
+					"[mfa.UserMfaService.ConfirmTotpEnrollment] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[mfa.UserMfaService.ConfirmTotpEnrollment] user can't enroll another user"); err != nil {
+				return err
+			}
+
+			rcs, err := s.userMfaManager.ConfirmTotpEnrollment(opCtx.OperationCtx, req.UserId, req.Code)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, err,
+					"[mfa.UserMfaService.ConfirmTotpEnrollment] confirm the TOTP enrollment",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &mfapb.ConfirmTotpEnrollmentResponse{RecoveryCodes: rcs}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DisableTotp disables TOTP and deletes the recovery codes of the user by the specified user ID.
+func (s *UserMfaService) DisableTotp(ctx context.Context, req *mfapb.DisableTotpRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserMfa_DisableTotp, iactions.OperationTypeUserMfaService_DisableTotp,
+		[]string{iidentity.PermissionUserMfa_Disable},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateDisableTotpRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, nil,
+					"[mfa.UserMfaService.DisableTotp] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.userMfaManager.DisableTotp(opCtx.OperationCtx, req.UserId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, err,
+					"[mfa.UserMfaService.DisableTotp] disable TOTP",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user by the specified user ID
+// and returns the new recovery codes.
+// Users can only regenerate their own recovery codes.
+func (s *UserMfaService) RegenerateRecoveryCodes(ctx context.Context, req *mfapb.RegenerateRecoveryCodesRequest) (*mfapb.RegenerateRecoveryCodesResponse, error) {
+	var res *mfapb.RegenerateRecoveryCodesResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserMfa_RegenerateRecoveryCodes, iactions.OperationTypeUserMfaService_RegenerateRecoveryCodes,
+		[]string{iidentity.PermissionUserMfa_Enroll},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateRegenerateRecoveryCodesRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, nil,
+					"[mfa.UserMfaService.RegenerateRecoveryCodes] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[mfa.UserMfaService.RegenerateRecoveryCodes] user can't regenerate another user's recovery codes"); err != nil {
+				return err
+			}
+
+			rcs, err := s.userMfaManager.RegenerateRecoveryCodes(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, err,
+					"[mfa.UserMfaService.RegenerateRecoveryCodes] regenerate recovery codes",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &mfapb.RegenerateRecoveryCodesResponse{RecoveryCodes: rcs}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatus gets the MFA status of the user by the specified user ID.
+func (s *UserMfaService) GetStatus(ctx context.Context, req *mfapb.GetStatusRequest) (*mfapb.GetStatusResponse, error) {
+	var res *mfapb.GetStatusResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserMfa_GetStatus, iactions.OperationTypeUserMfaService_GetStatus,
+		[]string{iidentity.PermissionUserMfa_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetStatusRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, nil,
+					"[mfa.UserMfaService.GetStatus] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			status, err := s.userMfaManager.GetStatus(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, err,
+					"[mfa.UserMfaService.GetStatus] get the MFA status",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &mfapb.GetStatusResponse{Status: converter.ConvertToApiUserMfaStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *UserMfaService) checkSelf(opCtx *grpcserverhelper.GrpcOperationContext, userId uint64, msg string) error {
+	if !opCtx.OperationCtx.UserId.HasValue || opCtx.OperationCtx.UserId.Value != userId {
+		s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserMfaServiceEvent, msg)
+		return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+	}
+	return nil
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrorCodeUserTotpNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserTotpNotFound)
+		case ierrors.ErrorCodeInvalidMfaCode:
+			return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, iapierrors.ErrInvalidMfaCode)
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
@@ -0,0 +1,397 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rolepermissionspb "personal-website-v2/go-apis/identity/permissions/rolepermissions"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	rolepermissionvalidation "personal-website-v2/identity/src/api/grpc/permissions/validation/rolepermissions"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/permissions"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type RolePermissionService struct {
+	rolepermissionspb.UnimplementedRolePermissionServiceServer
+	reqProcessor          *grpcserverhelper.RequestProcessor
+	rolePermissionManager permissions.RolePermissionManager
+	logger                logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewRolePermissionService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	rolePermissionManager permissions.RolePermissionManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*RolePermissionService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.permissions.RolePermissionService")
+	if err != nil {
+		return nil, fmt.Errorf("[permissions.NewRolePermissionService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupRolePermission,
+		OperationGroup: iactions.OperationGroupRolePermission,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[permissions.NewRolePermissionService] new request processor: %w", err)
+	}
+
+	return &RolePermissionService{
+		reqProcessor:          p,
+		rolePermissionManager: rolePermissionManager,
+		logger:                l,
+	}, nil
+}
+
+// Grant grants permissions to the role.
+func (s *RolePermissionService) Grant(ctx context.Context, req *rolepermissionspb.GrantRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_Grant, iactions.OperationTypeRolePermissionService_Grant,
+		[]string{iidentity.PermissionRolePermission_Grant},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolepermissionvalidation.ValidateGrantRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, nil,
+					"[permissions.RolePermissionService.Grant] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.rolePermissionManager.Grant(opCtx.OperationCtx, req.RoleId, req.PermissionIds); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.Grant] grant permissions to the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodePermissionNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotFound, err2.Message()))
+					case ierrors.ErrorCodePermissionAlreadyGranted:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionAlreadyGranted, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Revoke revokes permissions from the role.
+func (s *RolePermissionService) Revoke(ctx context.Context, req *rolepermissionspb.RevokeRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_Revoke, iactions.OperationTypeRolePermissionService_Revoke,
+		[]string{iidentity.PermissionRolePermission_Revoke},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolepermissionvalidation.ValidateRevokeRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, nil,
+					"[permissions.RolePermissionService.Revoke] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.rolePermissionManager.Revoke(opCtx.OperationCtx, req.RoleId, req.PermissionIds); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.Revoke] revoke permissions from the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodePermissionNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotFound, err2.Message()))
+					case ierrors.ErrorCodePermissionNotGranted:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotGranted, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeAll revokes all permissions from the role.
+func (s *RolePermissionService) RevokeAll(ctx context.Context, req *rolepermissionspb.RevokeAllRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_RevokeAll, iactions.OperationTypeRolePermissionService_RevokeAll,
+		[]string{iidentity.PermissionRolePermission_RevokeAll},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.rolePermissionManager.RevokeAll(opCtx.OperationCtx, req.RoleId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.RevokeAll] revoke all permissions from the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodePermissionNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotFound, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeFromAll revokes permissions from all roles.
+func (s *RolePermissionService) RevokeFromAll(ctx context.Context, req *rolepermissionspb.RevokeFromAllRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_RevokeFromAll, iactions.OperationTypeRolePermissionService_RevokeFromAll,
+		[]string{iidentity.PermissionRolePermission_RevokeFromAll},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolepermissionvalidation.ValidateRevokeFromAllRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, nil,
+					"[permissions.RolePermissionService.RevokeFromAll] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.rolePermissionManager.RevokeFromAll(opCtx.OperationCtx, req.PermissionIds); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.RevokeFromAll] revoke permissions from all roles",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodePermissionNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotFound, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Update updates permissions of the role.
+func (s *RolePermissionService) Update(ctx context.Context, req *rolepermissionspb.UpdateRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_Update, iactions.OperationTypeRolePermissionService_Update,
+		[]string{iidentity.PermissionRolePermission_Update},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolepermissionvalidation.ValidateUpdateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, nil,
+					"[permissions.RolePermissionService.Update] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.rolePermissionManager.Update(opCtx.OperationCtx, req.RoleId, req.PermissionIdsToGrant, req.PermissionIdsToRevoke); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.Update] update permissions of the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodePermissionNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotFound, err2.Message()))
+					case ierrors.ErrorCodePermissionAlreadyGranted:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionAlreadyGranted, err2.Message()))
+					case ierrors.ErrorCodePermissionNotGranted:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(iapierrors.ApiErrorCodePermissionNotGranted, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// IsGranted returns true if the permission is granted to the role.
+func (s *RolePermissionService) IsGranted(ctx context.Context, req *rolepermissionspb.IsGrantedRequest) (*rolepermissionspb.IsGrantedResponse, error) {
+	var res *rolepermissionspb.IsGrantedResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_IsGranted, iactions.OperationTypeRolePermissionService_IsGranted,
+		[]string{iidentity.PermissionRolePermission_IsGranted},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			isGranted, err := s.rolePermissionManager.IsGranted(opCtx.OperationCtx, req.RoleId, req.PermissionId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.IsGranted] is permission granted to the role",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolepermissionspb.IsGrantedResponse{IsGranted: isGranted}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AreGranted returns true if all permissions are granted to the role.
+func (s *RolePermissionService) AreGranted(ctx context.Context, req *rolepermissionspb.AreGrantedRequest) (*rolepermissionspb.AreGrantedResponse, error) {
+	var res *rolepermissionspb.AreGrantedResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_AreGranted, iactions.OperationTypeRolePermissionService_AreGranted,
+		[]string{iidentity.PermissionRolePermission_AreGranted},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolepermissionvalidation.ValidateAreGrantedRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, nil,
+					"[permissions.RolePermissionService.AreGranted] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			areGranted, err := s.rolePermissionManager.AreGranted(opCtx.OperationCtx, req.RoleId, req.PermissionIds)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.AreGranted] are all permissions granted to the role",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolepermissionspb.AreGrantedResponse{AreGranted: areGranted}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllPermissionIdsByRoleId gets all IDs of the permissions granted to the role by the specified role ID.
+func (s *RolePermissionService) GetAllPermissionIdsByRoleId(ctx context.Context, req *rolepermissionspb.GetAllPermissionIdsByRoleIdRequest) (*rolepermissionspb.GetAllPermissionIdsByRoleIdResponse, error) {
+	var res *rolepermissionspb.GetAllPermissionIdsByRoleIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_GetAllPermissionIdsByRoleId, iactions.OperationTypeRolePermissionService_GetAllPermissionIdsByRoleId,
+		[]string{iidentity.PermissionRolePermission_GetAllPermissionIdsBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.rolePermissionManager.GetAllPermissionIdsByRoleId(opCtx.OperationCtx, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.GetAllPermissionIdsByRoleId] get all permission ids by role id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolepermissionspb.GetAllPermissionIdsByRoleIdResponse{PermissionIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission,
+// including the roles that inherit the permission from their parent roles.
+func (s *RolePermissionService) GetAllRoleIdsByPermissionId(ctx context.Context, req *rolepermissionspb.GetAllRoleIdsByPermissionIdRequest) (*rolepermissionspb.GetAllRoleIdsByPermissionIdResponse, error) {
+	var res *rolepermissionspb.GetAllRoleIdsByPermissionIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_GetAllRoleIdsByPermissionId, iactions.OperationTypeRolePermissionService_GetAllRoleIdsByPermissionId,
+		[]string{iidentity.PermissionRolePermission_GetAllRoleIdsBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.rolePermissionManager.GetAllRoleIdsByPermissionId(opCtx.OperationCtx, req.PermissionId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.GetAllRoleIdsByPermissionId] get all role ids by permission id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolepermissionspb.GetAllRoleIdsByPermissionIdResponse{RoleIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllEffectivePermissionIdsByRoleId gets all IDs of the permissions granted to the role
+// and the permissions inherited from its parent roles by the specified role ID.
+func (s *RolePermissionService) GetAllEffectivePermissionIdsByRoleId(ctx context.Context, req *rolepermissionspb.GetAllEffectivePermissionIdsByRoleIdRequest) (*rolepermissionspb.GetAllEffectivePermissionIdsByRoleIdResponse, error) {
+	var res *rolepermissionspb.GetAllEffectivePermissionIdsByRoleIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRolePermission_GetAllEffectivePermissionIdsByRoleId,
+		iactions.OperationTypeRolePermissionService_GetAllEffectivePermissionIdsByRoleId,
+		[]string{iidentity.PermissionRolePermission_GetAllPermissionIdsBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.rolePermissionManager.GetAllEffectivePermissionIdsByRoleId(opCtx.OperationCtx, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RolePermissionServiceEvent, err,
+					"[permissions.RolePermissionService.GetAllEffectivePermissionIdsByRoleId] get all effective permission ids by role id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolepermissionspb.GetAllEffectivePermissionIdsByRoleIdResponse{PermissionIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
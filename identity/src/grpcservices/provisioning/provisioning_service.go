@@ -0,0 +1,153 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	"personal-website-v2/identity/src/api/grpc/provisioning/converter"
+	"personal-website-v2/identity/src/api/grpc/provisioning/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/provisioning"
+	"personal-website-v2/identity/src/internal/provisioning/models"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ProvisioningService struct {
+	provisioningpb.UnimplementedProvisioningServiceServer
+	reqProcessor        *grpcserverhelper.RequestProcessor
+	provisioningManager provisioning.ProvisioningManager
+	logger              logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewProvisioningService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	provisioningManager provisioning.ProvisioningManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ProvisioningService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.provisioning.ProvisioningService")
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.NewProvisioningService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupProvisioning,
+		OperationGroup: iactions.OperationGroupProvisioning,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.NewProvisioningService] new request processor: %w", err)
+	}
+
+	return &ProvisioningService{
+		reqProcessor:        p,
+		provisioningManager: provisioningManager,
+		logger:              l,
+	}, nil
+}
+
+// Plan plans the reconciliation of the manifest and returns the changes that would be applied
+// without applying them.
+func (s *ProvisioningService) Plan(ctx context.Context, req *provisioningpb.PlanRequest) (*provisioningpb.PlanResponse, error) {
+	var res *provisioningpb.PlanResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeProvisioning_Plan, iactions.OperationTypeProvisioningService_Plan,
+		[]string{iidentity.PermissionProvisioning_Plan},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidatePlanRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ProvisioningServiceEvent, nil,
+					"[provisioning.ProvisioningService.Plan] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			cs, err := s.provisioningManager.Plan(opCtx.OperationCtx, converter.ConvertToManifest(req.Manifest))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ProvisioningServiceEvent, err,
+					"[provisioning.ProvisioningService.Plan] plan the reconciliation of the manifest",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &provisioningpb.PlanResponse{Changes: convertToApiChanges(cs)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Apply reconciles the manifest and returns the applied changes if the operation is successful.
+func (s *ProvisioningService) Apply(ctx context.Context, req *provisioningpb.ApplyRequest) (*provisioningpb.ApplyResponse, error) {
+	var res *provisioningpb.ApplyResponse
+	err := s.reqProcessor.ProcessSensitiveWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeProvisioning_Apply, iactions.OperationTypeProvisioningService_Apply,
+		[]string{iidentity.PermissionProvisioning_Apply},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateApplyRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ProvisioningServiceEvent, nil,
+					"[provisioning.ProvisioningService.Apply] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			cs, err := s.provisioningManager.Apply(opCtx.OperationCtx, converter.ConvertToManifest(req.Manifest))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ProvisioningServiceEvent, err,
+					"[provisioning.ProvisioningService.Apply] apply the manifest",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &provisioningpb.ApplyResponse{Changes: convertToApiChanges(cs)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func convertToApiChanges(cs []*models.Change) []*provisioningpb.Change {
+	cs2 := make([]*provisioningpb.Change, len(cs))
+	for i := 0; i < len(cs); i++ {
+		cs2[i] = converter.ConvertToApiChange(cs[i])
+	}
+	return cs2
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+		return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	registrationpb "personal-website-v2/go-apis/identity/registration"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/registration/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/registration"
+	registrationoperations "personal-website-v2/identity/src/internal/registration/operations/registration"
+	"personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type RegistrationService struct {
+	registrationpb.UnimplementedRegistrationServiceServer
+	reqProcessor        *grpcserverhelper.RequestProcessor
+	registrationManager registration.RegistrationManager
+	logger              logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewRegistrationService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	registrationManager registration.RegistrationManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*RegistrationService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.registration.RegistrationService")
+	if err != nil {
+		return nil, fmt.Errorf("[registration.NewRegistrationService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupRegistration,
+		OperationGroup: iactions.OperationGroupRegistration,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[registration.NewRegistrationService] new request processor: %w", err)
+	}
+
+	return &RegistrationService{
+		reqProcessor:        p,
+		registrationManager: registrationManager,
+		logger:              l,
+	}, nil
+}
+
+// Register registers a user and sends an email with the verification link to the user.
+// If a user with the same name or email already exists, an email informing of it is sent instead.
+func (s *RegistrationService) Register(ctx context.Context, req *registrationpb.RegisterRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRegistration_Register, iactions.OperationTypeRegistrationService_Register,
+		[]string{iidentity.PermissionRegistration_Register},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateRegisterRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, nil,
+					"[registration.RegistrationService.Register] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var birthDate nullable.Nullable[time.Time]
+			if req.BirthDate != nil {
+				birthDate = nullable.NewNullable(req.BirthDate.AsTime())
+			}
+
+			d := &registrationoperations.RegisterOperationData{
+				Name:        req.Name,
+				Email:       req.Email,
+				Password:    req.Password,
+				FirstName:   req.FirstName,
+				LastName:    req.LastName,
+				DisplayName: req.DisplayName,
+				BirthDate:   birthDate,
+				Gender:      models.Gender(req.Gender),
+			}
+
+			if err := s.registrationManager.Register(opCtx.OperationCtx, d); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, err,
+					"[registration.RegistrationService.Register] register a user",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// VerifyEmail verifies the user's email by the specified token and returns the user ID.
+func (s *RegistrationService) VerifyEmail(ctx context.Context, req *registrationpb.VerifyEmailRequest) (*registrationpb.VerifyEmailResponse, error) {
+	var res *registrationpb.VerifyEmailResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRegistration_VerifyEmail, iactions.OperationTypeRegistrationService_VerifyEmail,
+		[]string{iidentity.PermissionRegistration_Register},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateVerifyEmailRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, nil,
+					"[registration.RegistrationService.VerifyEmail] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			userId, err := s.registrationManager.VerifyEmail(opCtx.OperationCtx, req.Token)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, err,
+					"[registration.RegistrationService.VerifyEmail] verify the user's email",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &registrationpb.VerifyEmailResponse{UserId: userId}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ResendVerificationEmail sends a new verification email to the user with the specified email
+// if the user's email hasn't been verified yet.
+func (s *RegistrationService) ResendVerificationEmail(ctx context.Context, req *registrationpb.ResendVerificationEmailRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRegistration_ResendVerificationEmail,
+		iactions.OperationTypeRegistrationService_ResendVerificationEmail,
+		[]string{iidentity.PermissionRegistration_Register},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateResendVerificationEmailRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, nil,
+					"[registration.RegistrationService.ResendVerificationEmail] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.registrationManager.ResendVerificationEmail(opCtx.OperationCtx, req.Email); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, err,
+					"[registration.RegistrationService.ResendVerificationEmail] resend a verification email",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// Approve approves the user whose email has been verified.
+func (s *RegistrationService) Approve(ctx context.Context, req *registrationpb.ApproveRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRegistration_Approve, iactions.OperationTypeRegistrationService_Approve,
+		[]string{iidentity.PermissionRegistration_Approve},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateApproveRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, nil,
+					"[registration.RegistrationService.Approve] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.registrationManager.Approve(opCtx.OperationCtx, req.UserId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RegistrationServiceEvent, err,
+					"[registration.RegistrationService.Approve] approve a user",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUsernameAlreadyExists:
+			return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUsernameAlreadyExists)
+		case ierrors.ErrorCodeUserEmailAlreadyExists:
+			return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUserEmailAlreadyExists)
+		case ierrors.ErrorCodeUserNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+		case ierrors.ErrorCodeEmailVerificationTokenNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrEmailVerificationTokenNotFound)
+		case errors.ErrorCodeInvalidData:
+			return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	roleassignmentspb "personal-website-v2/go-apis/identity/resources/roleassignments"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/resources/converter"
+	roleassignmentvalidation "personal-website-v2/identity/src/api/grpc/resources/validation/roleassignments"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/resources"
+	resourcemodels "personal-website-v2/identity/src/internal/resources/models"
+	"personal-website-v2/identity/src/internal/resources/operations/resourceroleassignments"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ResourceRoleAssignmentService struct {
+	roleassignmentspb.UnimplementedResourceRoleAssignmentServiceServer
+	reqProcessor                  *grpcserverhelper.RequestProcessor
+	resourceRoleAssignmentManager resources.ResourceRoleAssignmentManager
+	logger                        logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewResourceRoleAssignmentService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	resourceRoleAssignmentManager resources.ResourceRoleAssignmentManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ResourceRoleAssignmentService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.resources.ResourceRoleAssignmentService")
+	if err != nil {
+		return nil, fmt.Errorf("[resources.NewResourceRoleAssignmentService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupResourceRoleAssignment,
+		OperationGroup: iactions.OperationGroupResourceRoleAssignment,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[resources.NewResourceRoleAssignmentService] new request processor: %w", err)
+	}
+
+	return &ResourceRoleAssignmentService{
+		reqProcessor:                  p,
+		resourceRoleAssignmentManager: resourceRoleAssignmentManager,
+		logger:                        l,
+	}, nil
+}
+
+// Create creates a resource role assignment and returns the resource role assignment ID
+// if the operation is successful.
+func (s *ResourceRoleAssignmentService) Create(ctx context.Context, req *roleassignmentspb.CreateRequest) (*roleassignmentspb.CreateResponse, error) {
+	var res *roleassignmentspb.CreateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceRoleAssignment_Create, iactions.OperationTypeResourceRoleAssignmentService_Create,
+		[]string{iidentity.PermissionResourceRoleAssignment_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := roleassignmentvalidation.ValidateCreateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, nil,
+					"[resources.ResourceRoleAssignmentService.Create] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			d := &resourceroleassignments.CreateOperationData{
+				RoleId:         req.RoleId,
+				AssignedTo:     req.AssignedTo,
+				AssigneeType:   rolemodels.AssigneeType(req.AssigneeType),
+				ResourceTypeId: req.ResourceTypeId,
+				OwnerRule:      resourcemodels.OwnerRule(req.OwnerRule),
+			}
+			if req.ResourceId != nil {
+				d.ResourceId = nullable.NewNullable(req.ResourceId.Value)
+			}
+			if req.Description != nil {
+				d.Description = nullable.NewNullable(req.Description.Value)
+			}
+
+			id, err := s.resourceRoleAssignmentManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, err,
+					"[resources.ResourceRoleAssignmentService.Create] create a resource role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeResourceRoleAssignmentAlreadyExists:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrResourceRoleAssignmentAlreadyExists)
+					case ierrors.ErrorCodeRoleNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+					case ierrors.ErrorCodeResourceTypeNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrResourceTypeNotFound)
+					case ierrors.ErrorCodeUserGroupNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserGroupNotFound)
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &roleassignmentspb.CreateResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete deletes a resource role assignment by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentService) Delete(ctx context.Context, req *roleassignmentspb.DeleteRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceRoleAssignment_Delete, iactions.OperationTypeResourceRoleAssignmentService_Delete,
+		[]string{iidentity.PermissionResourceRoleAssignment_Delete},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.resourceRoleAssignmentManager.Delete(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, err,
+					"[resources.ResourceRoleAssignmentService.Delete] delete a resource role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeResourceRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrResourceRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetById gets a resource role assignment by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentService) GetById(ctx context.Context, req *roleassignmentspb.GetByIdRequest) (*roleassignmentspb.GetByIdResponse, error) {
+	var res *roleassignmentspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceRoleAssignment_GetById, iactions.OperationTypeResourceRoleAssignmentService_GetById,
+		[]string{iidentity.PermissionResourceRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.resourceRoleAssignmentManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, err,
+					"[resources.ResourceRoleAssignmentService.GetById] find a resource role assignment by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent,
+					"[resources.ResourceRoleAssignmentService.GetById] resource role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrResourceRoleAssignmentNotFound)
+			}
+
+			res = &roleassignmentspb.GetByIdResponse{Assignment: converter.ConvertToApiResourceRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByAssignee gets all resource role assignments by the specified assignee.
+func (s *ResourceRoleAssignmentService) GetAllByAssignee(ctx context.Context, req *roleassignmentspb.GetAllByAssigneeRequest,
+) (*roleassignmentspb.GetAllByAssigneeResponse, error) {
+	var res *roleassignmentspb.GetAllByAssigneeResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceRoleAssignment_GetAllByAssignee,
+		iactions.OperationTypeResourceRoleAssignmentService_GetAllByAssignee,
+		[]string{iidentity.PermissionResourceRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := roleassignmentvalidation.ValidateGetAllByAssigneeRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, nil,
+					"[resources.ResourceRoleAssignmentService.GetAllByAssignee] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			as, err := s.resourceRoleAssignmentManager.GetAllByAssignee(opCtx.OperationCtx, req.AssigneeId, rolemodels.AssigneeType(req.AssigneeType))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceRoleAssignmentServiceEvent, err,
+					"[resources.ResourceRoleAssignmentService.GetAllByAssignee] get all resource role assignments by assignee",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			as2 := make([]*roleassignmentspb.ResourceRoleAssignment, len(as))
+			for i := 0; i < len(as); i++ {
+				as2[i] = converter.ConvertToApiResourceRoleAssignment(as[i])
+			}
+
+			res = &roleassignmentspb.GetAllByAssigneeResponse{Assignments: as2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
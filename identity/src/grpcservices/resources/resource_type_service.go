@@ -0,0 +1,175 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	resourcespb "personal-website-v2/go-apis/identity/resources"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/resources/converter"
+	resourcetypevalidation "personal-website-v2/identity/src/api/grpc/resources/validation/resourcetypes"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/resources"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ResourceTypeService struct {
+	resourcespb.UnimplementedResourceTypeServiceServer
+	reqProcessor        *grpcserverhelper.RequestProcessor
+	resourceTypeManager resources.ResourceTypeManager
+	logger              logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewResourceTypeService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	resourceTypeManager resources.ResourceTypeManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ResourceTypeService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.resources.ResourceTypeService")
+	if err != nil {
+		return nil, fmt.Errorf("[resources.NewResourceTypeService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupResourceType,
+		OperationGroup: iactions.OperationGroupResourceType,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[resources.NewResourceTypeService] new request processor: %w", err)
+	}
+
+	return &ResourceTypeService{
+		reqProcessor:        p,
+		resourceTypeManager: resourceTypeManager,
+		logger:              l,
+	}, nil
+}
+
+// Register registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (s *ResourceTypeService) Register(ctx context.Context, req *resourcespb.RegisterRequest) (*resourcespb.RegisterResponse, error) {
+	var res *resourcespb.RegisterResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceType_Register, iactions.OperationTypeResourceTypeService_Register,
+		[]string{iidentity.PermissionResourceType_Register},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := resourcetypevalidation.ValidateRegisterRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceTypeServiceEvent, nil,
+					"[resources.ResourceTypeService.Register] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			ts, err := s.resourceTypeManager.RegisterWithContext(opCtx.OperationCtx, req.Names)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceTypeServiceEvent, err,
+					"[resources.ResourceTypeService.Register] register resource types",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ts2 := make([]*resourcespb.ResourceType, len(ts))
+			for i := 0; i < len(ts); i++ {
+				ts2[i] = converter.ConvertToApiResourceType(ts[i])
+			}
+
+			res = &resourcespb.RegisterResponse{ResourceTypes: ts2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetById gets a resource type by the specified resource type ID.
+func (s *ResourceTypeService) GetById(ctx context.Context, req *resourcespb.GetByIdRequest) (*resourcespb.GetByIdResponse, error) {
+	var res *resourcespb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceType_GetById, iactions.OperationTypeResourceTypeService_GetById,
+		[]string{iidentity.PermissionResourceType_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			t, err := s.resourceTypeManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceTypeServiceEvent, err,
+					"[resources.ResourceTypeService.GetById] find a resource type by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if t == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceTypeServiceEvent,
+					"[resources.ResourceTypeService.GetById] resource type not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrResourceTypeNotFound)
+			}
+
+			res = &resourcespb.GetByIdResponse{ResourceType: converter.ConvertToApiResourceType(t)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAll gets all resource types.
+func (s *ResourceTypeService) GetAll(ctx context.Context, req *resourcespb.GetAllRequest) (*resourcespb.GetAllResponse, error) {
+	var res *resourcespb.GetAllResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeResourceType_GetAll, iactions.OperationTypeResourceTypeService_GetAll,
+		[]string{iidentity.PermissionResourceType_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ts, err := s.resourceTypeManager.GetAll(opCtx.OperationCtx)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ResourceTypeServiceEvent, err,
+					"[resources.ResourceTypeService.GetAll] get all resource types",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			ts2 := make([]*resourcespb.ResourceType, len(ts))
+			for i := 0; i < len(ts); i++ {
+				ts2[i] = converter.ConvertToApiResourceType(ts[i])
+			}
+
+			res = &resourcespb.GetAllResponse{ResourceTypes: ts2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
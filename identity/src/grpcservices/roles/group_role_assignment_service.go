@@ -0,0 +1,352 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	grouproleassignmentspb "personal-website-v2/go-apis/identity/roles/grouproleassignments"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/roles/converter"
+	grouproleassignmentvalidation "personal-website-v2/identity/src/api/grpc/roles/validation/grouproleassignments"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type GroupRoleAssignmentService struct {
+	grouproleassignmentspb.UnimplementedGroupRoleAssignmentServiceServer
+	reqProcessor               *grpcserverhelper.RequestProcessor
+	groupRoleAssignmentManager roles.GroupRoleAssignmentManager
+	logger                     logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewGroupRoleAssignmentService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	groupRoleAssignmentManager roles.GroupRoleAssignmentManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*GroupRoleAssignmentService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.roles.GroupRoleAssignmentService")
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewGroupRoleAssignmentService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupGroupRoleAssignment,
+		OperationGroup: iactions.OperationGroupGroupRoleAssignment,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewGroupRoleAssignmentService] new request processor: %w", err)
+	}
+
+	return &GroupRoleAssignmentService{
+		reqProcessor:               p,
+		groupRoleAssignmentManager: groupRoleAssignmentManager,
+		logger:                     l,
+	}, nil
+}
+
+// GetById gets a group role assignment by the specified group role assignment ID.
+func (s *GroupRoleAssignmentService) GetById(ctx context.Context, req *grouproleassignmentspb.GetByIdRequest) (*grouproleassignmentspb.GetByIdResponse, error) {
+	var res *grouproleassignmentspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetById, iactions.OperationTypeGroupRoleAssignmentService_GetById,
+		[]string{iidentity.PermissionGroupRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.groupRoleAssignmentManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetById] find a group role assignment by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent,
+					"[roles.GroupRoleAssignmentService.GetById] group role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &grouproleassignmentspb.GetByIdResponse{Assignment: converter.ConvertToApiGroupRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByRoleAssignmentId gets a group role assignment by the specified role assignment ID.
+func (s *GroupRoleAssignmentService) GetByRoleAssignmentId(ctx context.Context, req *grouproleassignmentspb.GetByRoleAssignmentIdRequest) (*grouproleassignmentspb.GetByRoleAssignmentIdResponse, error) {
+	var res *grouproleassignmentspb.GetByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetByRoleAssignmentId, iactions.OperationTypeGroupRoleAssignmentService_GetByRoleAssignmentId,
+		[]string{iidentity.PermissionGroupRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.groupRoleAssignmentManager.FindByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetByRoleAssignmentId] find a group role assignment by role assignment id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent,
+					"[roles.GroupRoleAssignmentService.GetByRoleAssignmentId] group role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &grouproleassignmentspb.GetByRoleAssignmentIdResponse{Assignment: converter.ConvertToApiGroupRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByGroup gets all role assignments of the group by the specified group.
+func (s *GroupRoleAssignmentService) GetAllByGroup(ctx context.Context, req *grouproleassignmentspb.GetAllByGroupRequest) (*grouproleassignmentspb.GetAllByGroupResponse, error) {
+	var res *grouproleassignmentspb.GetAllByGroupResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetAllByGroup, iactions.OperationTypeGroupRoleAssignmentService_GetAllByGroup,
+		[]string{iidentity.PermissionGroupRoleAssignment_GetAllBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := grouproleassignmentvalidation.ValidateGetAllByGroupRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, nil,
+					"[roles.GroupRoleAssignmentService.GetAllByGroup] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			as, err := s.groupRoleAssignmentManager.GetAllByGroup(opCtx.OperationCtx, groupmodels.UserGroup(req.Group))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetAllByGroup] get all role assignments of the group by group",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			as2 := make([]*grouproleassignmentspb.GroupRoleAssignment, len(as))
+			for i := 0; i < len(as); i++ {
+				as2[i] = converter.ConvertToApiGroupRoleAssignment(as[i])
+			}
+
+			res = &grouproleassignmentspb.GetAllByGroupResponse{Assignments: as2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exists returns true if the group role assignment exists.
+func (s *GroupRoleAssignmentService) Exists(ctx context.Context, req *grouproleassignmentspb.ExistsRequest) (*grouproleassignmentspb.ExistsResponse, error) {
+	var res *grouproleassignmentspb.ExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_Exists, iactions.OperationTypeGroupRoleAssignmentService_Exists,
+		[]string{iidentity.PermissionGroupRoleAssignment_Exists},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := grouproleassignmentvalidation.ValidateExistsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, nil,
+					"[roles.GroupRoleAssignmentService.Exists] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			exists, err := s.groupRoleAssignmentManager.Exists(opCtx.OperationCtx, groupmodels.UserGroup(req.Group), req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.Exists] group role assignment exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.ExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsAssigned returns true if the role is assigned to the group.
+func (s *GroupRoleAssignmentService) IsAssigned(ctx context.Context, req *grouproleassignmentspb.IsAssignedRequest) (*grouproleassignmentspb.IsAssignedResponse, error) {
+	var res *grouproleassignmentspb.IsAssignedResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_IsAssigned, iactions.OperationTypeGroupRoleAssignmentService_IsAssigned,
+		[]string{iidentity.PermissionGroupRoleAssignment_IsAssigned},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := grouproleassignmentvalidation.ValidateIsAssignedRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, nil,
+					"[roles.GroupRoleAssignmentService.IsAssigned] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			isAssigned, err := s.groupRoleAssignmentManager.IsAssigned(opCtx.OperationCtx, groupmodels.UserGroup(req.Group), req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.IsAssigned] is the role assigned to the group",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.IsAssignedResponse{IsAssigned: isAssigned}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIdByRoleAssignmentId gets the group role assignment ID by the specified role assignment ID.
+func (s *GroupRoleAssignmentService) GetIdByRoleAssignmentId(ctx context.Context, req *grouproleassignmentspb.GetIdByRoleAssignmentIdRequest) (*grouproleassignmentspb.GetIdByRoleAssignmentIdResponse, error) {
+	var res *grouproleassignmentspb.GetIdByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetIdByRoleAssignmentId, iactions.OperationTypeGroupRoleAssignmentService_GetIdByRoleAssignmentId,
+		[]string{iidentity.PermissionGroupRoleAssignment_GetId},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			id, err := s.groupRoleAssignmentManager.GetIdByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetIdByRoleAssignmentId] get the group role assignment id by role assignment id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.GetIdByRoleAssignmentIdResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusById gets a group role assignment status by the specified group role assignment ID.
+func (s *GroupRoleAssignmentService) GetStatusById(ctx context.Context, req *grouproleassignmentspb.GetStatusByIdRequest) (*grouproleassignmentspb.GetStatusByIdResponse, error) {
+	var res *grouproleassignmentspb.GetStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetStatusById, iactions.OperationTypeGroupRoleAssignmentService_GetStatusById,
+		[]string{iidentity.PermissionGroupRoleAssignment_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.groupRoleAssignmentManager.GetStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetStatusById] get a group role assignment status by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.GetStatusByIdResponse{Status: grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusByRoleAssignmentId gets a group role assignment status by the specified role assignment ID.
+func (s *GroupRoleAssignmentService) GetStatusByRoleAssignmentId(ctx context.Context, req *grouproleassignmentspb.GetStatusByRoleAssignmentIdRequest) (*grouproleassignmentspb.GetStatusByRoleAssignmentIdResponse, error) {
+	var res *grouproleassignmentspb.GetStatusByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetStatusByRoleAssignmentId, iactions.OperationTypeGroupRoleAssignmentService_GetStatusByRoleAssignmentId,
+		[]string{iidentity.PermissionGroupRoleAssignment_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.groupRoleAssignmentManager.GetStatusByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetStatusByRoleAssignmentId] get a group role assignment status by role assignment id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.GetStatusByRoleAssignmentIdResponse{Status: grouproleassignmentspb.GroupRoleAssignmentStatusEnum_GroupRoleAssignmentStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetGroupRoleIdsByGroup gets the IDs of the roles assigned to the group by the specified group.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (s *GroupRoleAssignmentService) GetGroupRoleIdsByGroup(ctx context.Context, req *grouproleassignmentspb.GetGroupRoleIdsByGroupRequest) (*grouproleassignmentspb.GetGroupRoleIdsByGroupResponse, error) {
+	var res *grouproleassignmentspb.GetGroupRoleIdsByGroupResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeGroupRoleAssignment_GetGroupRoleIdsByGroup, iactions.OperationTypeGroupRoleAssignmentService_GetGroupRoleIdsByGroup,
+		[]string{iidentity.PermissionGroupRoleAssignment_GetGroupRoleIds},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := grouproleassignmentvalidation.ValidateGetGroupRoleIdsByGroupRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, nil,
+					"[roles.GroupRoleAssignmentService.GetGroupRoleIdsByGroup] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			ids, err := s.groupRoleAssignmentManager.GetGroupRoleIdsByGroup(opCtx.OperationCtx, groupmodels.UserGroup(req.Group), req.RoleFilter)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_GroupRoleAssignmentServiceEvent, err,
+					"[roles.GroupRoleAssignmentService.GetGroupRoleIdsByGroup] get the ids of the roles assigned to the group by group",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &grouproleassignmentspb.GetGroupRoleIdsByGroupResponse{RoleIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
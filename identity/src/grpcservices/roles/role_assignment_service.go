@@ -0,0 +1,498 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	assignmentspb "personal-website-v2/go-apis/identity/roles/assignments"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/roles/converter"
+	assignmentvalidation "personal-website-v2/identity/src/api/grpc/roles/validation/assignments"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/roles/models"
+	assignmentoperations "personal-website-v2/identity/src/internal/roles/operations/assignments"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type RoleAssignmentService struct {
+	assignmentspb.UnimplementedRoleAssignmentServiceServer
+	reqProcessor          *grpcserverhelper.RequestProcessor
+	roleAssignmentManager roles.RoleAssignmentManager
+	logger                logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewRoleAssignmentService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	roleAssignmentManager roles.RoleAssignmentManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*RoleAssignmentService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.roles.RoleAssignmentService")
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewRoleAssignmentService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupRoleAssignment,
+		OperationGroup: iactions.OperationGroupRoleAssignment,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewRoleAssignmentService] new request processor: %w", err)
+	}
+
+	return &RoleAssignmentService{
+		reqProcessor:          p,
+		roleAssignmentManager: roleAssignmentManager,
+		logger:                l,
+	}, nil
+}
+
+// Create creates a role assignment and returns the role assignment ID if the operation is successful.
+func (s *RoleAssignmentService) Create(ctx context.Context, req *assignmentspb.CreateRequest) (*assignmentspb.CreateResponse, error) {
+	var res *assignmentspb.CreateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_Create, iactions.OperationTypeRoleAssignmentService_Create,
+		[]string{iidentity.PermissionRoleAssignment_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateCreateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.Create] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var description nullable.Nullable[string]
+			if req.Description != nil {
+				description = nullable.NewNullable(req.Description.Value)
+			}
+
+			d := &assignmentoperations.CreateOperationData{
+				RoleId:       req.RoleId,
+				AssignedTo:   req.AssignedTo,
+				AssigneeType: models.AssigneeType(req.AssigneeType),
+				Description:  description,
+			}
+			if req.ValidFrom != nil {
+				d.ValidFrom = nullable.NewNullable(req.ValidFrom.AsTime())
+			}
+			if req.ValidUntil != nil {
+				d.ValidUntil = nullable.NewNullable(req.ValidUntil.AsTime())
+			}
+
+			id, err := s.roleAssignmentManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.Create] create a role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2 {
+					case ierrors.ErrRoleAssignmentAlreadyExists:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrRoleAssignmentAlreadyExists)
+					case ierrors.ErrRoleAlreadyAssigned:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrRoleAlreadyAssigned)
+					case ierrors.ErrRoleNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+					case ierrors.ErrRoleInfoNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleInfoNotFound)
+					}
+
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.CreateResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete deletes a role assignment by the specified role assignment ID.
+func (s *RoleAssignmentService) Delete(ctx context.Context, req *assignmentspb.DeleteRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_Delete, iactions.OperationTypeRoleAssignmentService_Delete,
+		[]string{iidentity.PermissionRoleAssignment_Delete},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.roleAssignmentManager.Delete(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.Delete] delete a role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrRoleAssignmentNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidOperation {
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Extend extends the validity period of an active role assignment by the specified role assignment ID.
+func (s *RoleAssignmentService) Extend(ctx context.Context, req *assignmentspb.ExtendRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_Extend, iactions.OperationTypeRoleAssignmentService_Extend,
+		[]string{iidentity.PermissionRoleAssignment_Extend},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateExtendRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.Extend] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.roleAssignmentManager.Extend(opCtx.OperationCtx, req.Id, req.ValidUntil.AsTime()); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.Extend] extend a role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrRoleAssignmentNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+					}
+
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Revoke revokes an active role assignment by the specified role assignment ID before its validity period ends.
+func (s *RoleAssignmentService) Revoke(ctx context.Context, req *assignmentspb.RevokeRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_Revoke, iactions.OperationTypeRoleAssignmentService_Revoke,
+		[]string{iidentity.PermissionRoleAssignment_Revoke},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.roleAssignmentManager.Revoke(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.Revoke] revoke a role assignment",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrRoleAssignmentNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidOperation {
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetById gets a role assignment by the specified role assignment ID.
+func (s *RoleAssignmentService) GetById(ctx context.Context, req *assignmentspb.GetByIdRequest) (*assignmentspb.GetByIdResponse, error) {
+	var res *assignmentspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetById, iactions.OperationTypeRoleAssignmentService_GetById,
+		[]string{iidentity.PermissionRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.roleAssignmentManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetById] find a role assignment by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent,
+					"[roles.RoleAssignmentService.GetById] role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &assignmentspb.GetByIdResponse{Assignment: converter.ConvertToApiRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByRoleIdAndAssignee gets a role assignment by the specified role ID and assignee.
+func (s *RoleAssignmentService) GetByRoleIdAndAssignee(ctx context.Context, req *assignmentspb.GetByRoleIdAndAssigneeRequest) (*assignmentspb.GetByRoleIdAndAssigneeResponse, error) {
+	var res *assignmentspb.GetByRoleIdAndAssigneeResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetByRoleIdAndAssignee, iactions.OperationTypeRoleAssignmentService_GetByRoleIdAndAssignee,
+		[]string{iidentity.PermissionRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateGetByRoleIdAndAssigneeRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.GetByRoleIdAndAssignee] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			a, err := s.roleAssignmentManager.FindByRoleIdAndAssignee(opCtx.OperationCtx, req.RoleId, req.AssigneeId, models.AssigneeType(req.AssigneeType))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetByRoleIdAndAssignee] find a role assignment by role id and assignee",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent,
+					"[roles.RoleAssignmentService.GetByRoleIdAndAssignee] role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &assignmentspb.GetByRoleIdAndAssigneeResponse{Assignment: converter.ConvertToApiRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exists returns true if the role assignment exists.
+func (s *RoleAssignmentService) Exists(ctx context.Context, req *assignmentspb.ExistsRequest) (*assignmentspb.ExistsResponse, error) {
+	var res *assignmentspb.ExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_Exists, iactions.OperationTypeRoleAssignmentService_Exists,
+		[]string{iidentity.PermissionRoleAssignment_Exists},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateExistsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.Exists] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			exists, err := s.roleAssignmentManager.Exists(opCtx.OperationCtx, req.RoleId, req.AssigneeId, models.AssigneeType(req.AssigneeType))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.Exists] role assignment exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.ExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsAssigned returns true if the role is assigned.
+func (s *RoleAssignmentService) IsAssigned(ctx context.Context, req *assignmentspb.IsAssignedRequest) (*assignmentspb.IsAssignedResponse, error) {
+	var res *assignmentspb.IsAssignedResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_IsAssigned, iactions.OperationTypeRoleAssignmentService_IsAssigned,
+		[]string{iidentity.PermissionRoleAssignment_IsAssigned},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateIsAssignedRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.IsAssigned] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			isAssigned, err := s.roleAssignmentManager.IsAssigned(opCtx.OperationCtx, req.RoleId, req.AssigneeId, models.AssigneeType(req.AssigneeType))
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.IsAssigned] is the role assigned",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.IsAssignedResponse{IsAssigned: isAssigned}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAssigneeTypeById gets a role assignment assignee type by the specified role assignment ID.
+func (s *RoleAssignmentService) GetAssigneeTypeById(ctx context.Context, req *assignmentspb.GetAssigneeTypeByIdRequest) (*assignmentspb.GetAssigneeTypeByIdResponse, error) {
+	var res *assignmentspb.GetAssigneeTypeByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetAssigneeTypeById, iactions.OperationTypeRoleAssignmentService_GetAssigneeTypeById,
+		[]string{iidentity.PermissionRoleAssignment_GetAssigneeType},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			t, err := s.roleAssignmentManager.GetAssigneeTypeById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetAssigneeTypeById] get a role assignment assignee type by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.GetAssigneeTypeByIdResponse{AssigneeType: assignmentspb.AssigneeTypeEnum_AssigneeType(t)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusById gets a role assignment status by the specified role assignment ID.
+func (s *RoleAssignmentService) GetStatusById(ctx context.Context, req *assignmentspb.GetStatusByIdRequest) (*assignmentspb.GetStatusByIdResponse, error) {
+	var res *assignmentspb.GetStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetStatusById, iactions.OperationTypeRoleAssignmentService_GetStatusById,
+		[]string{iidentity.PermissionRoleAssignment_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.roleAssignmentManager.GetStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetStatusById] get a role assignment status by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.GetStatusByIdResponse{Status: assignmentspb.RoleAssignmentStatusEnum_RoleAssignmentStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetRoleIdAndAssigneeById gets the role ID and assignee by the specified role assignment ID.
+func (s *RoleAssignmentService) GetRoleIdAndAssigneeById(ctx context.Context, req *assignmentspb.GetRoleIdAndAssigneeByIdRequest) (*assignmentspb.GetRoleIdAndAssigneeByIdResponse, error) {
+	var res *assignmentspb.GetRoleIdAndAssigneeByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetRoleIdAndAssigneeById, iactions.OperationTypeRoleAssignmentService_GetRoleIdAndAssigneeById,
+		[]string{iidentity.PermissionRoleAssignment_GetRoleIdAndAssignee},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			r, err := s.roleAssignmentManager.GetRoleIdAndAssigneeById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetRoleIdAndAssigneeById] get the role id and assignee by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.GetRoleIdAndAssigneeByIdResponse{
+				RoleId:       r.RoleId,
+				AssigneeId:   r.AssignedTo,
+				AssigneeType: assignmentspb.AssigneeTypeEnum_AssigneeType(r.AssigneeType),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllExpiringWithin gets all active role assignments whose validity period ends within the specified period.
+func (s *RoleAssignmentService) GetAllExpiringWithin(ctx context.Context, req *assignmentspb.GetAllExpiringWithinRequest) (*assignmentspb.GetAllExpiringWithinResponse, error) {
+	var res *assignmentspb.GetAllExpiringWithinResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleAssignment_GetAllExpiringWithin, iactions.OperationTypeRoleAssignmentService_GetAllExpiringWithin,
+		[]string{iidentity.PermissionRoleAssignment_GetAllExpiring},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := assignmentvalidation.ValidateGetAllExpiringWithinRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, nil,
+					"[roles.RoleAssignmentService.GetAllExpiringWithin] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			as, err := s.roleAssignmentManager.GetAllExpiringWithin(opCtx.OperationCtx, req.Period.AsDuration())
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleAssignmentServiceEvent, err,
+					"[roles.RoleAssignmentService.GetAllExpiringWithin] get all role assignments expiring within the specified period",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &assignmentspb.GetAllExpiringWithinResponse{Assignments: make([]*assignmentspb.RoleAssignment, len(as))}
+			for i := 0; i < len(as); i++ {
+				res.Assignments[i] = converter.ConvertToApiRoleAssignment(as[i])
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
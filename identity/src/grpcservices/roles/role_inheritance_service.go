@@ -0,0 +1,193 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	inheritancepb "personal-website-v2/go-apis/identity/roles/inheritance"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	inheritancevalidation "personal-website-v2/identity/src/api/grpc/roles/validation/inheritance"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type RoleInheritanceService struct {
+	inheritancepb.UnimplementedRoleInheritanceServiceServer
+	reqProcessor           *grpcserverhelper.RequestProcessor
+	roleInheritanceManager roles.RoleInheritanceManager
+	logger                 logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewRoleInheritanceService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	roleInheritanceManager roles.RoleInheritanceManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*RoleInheritanceService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.roles.RoleInheritanceService")
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewRoleInheritanceService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupRoleInheritance,
+		OperationGroup: iactions.OperationGroupRoleInheritance,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewRoleInheritanceService] new request processor: %w", err)
+	}
+
+	return &RoleInheritanceService{
+		reqProcessor:           p,
+		roleInheritanceManager: roleInheritanceManager,
+		logger:                 l,
+	}, nil
+}
+
+// AddParent adds a parent role to the role.
+func (s *RoleInheritanceService) AddParent(ctx context.Context, req *inheritancepb.AddParentRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleInheritance_AddParent, iactions.OperationTypeRoleInheritanceService_AddParent,
+		[]string{iidentity.PermissionRoleInheritance_Update},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := inheritancevalidation.ValidateAddParentRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleInheritanceServiceEvent, nil,
+					"[roles.RoleInheritanceService.AddParent] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.roleInheritanceManager.AddParent(opCtx.OperationCtx, req.RoleId, req.ParentRoleId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleInheritanceServiceEvent, err,
+					"[roles.RoleInheritanceService.AddParent] add a parent role to the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case errors.ErrorCodeInvalidOperation:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					case ierrors.ErrorCodeRoleNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodeRoleNotFound, err2.Message()))
+					case ierrors.ErrorCodeRoleParentAlreadyAdded:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrRoleParentAlreadyAdded)
+					case ierrors.ErrorCodeRoleInheritanceCycle:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, iapierrors.ErrRoleInheritanceCycle)
+					case ierrors.ErrorCodeRoleInheritanceDepthExceeded:
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, iapierrors.ErrRoleInheritanceDepthExceeded)
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveParent removes a parent role from the role.
+func (s *RoleInheritanceService) RemoveParent(ctx context.Context, req *inheritancepb.RemoveParentRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleInheritance_RemoveParent, iactions.OperationTypeRoleInheritanceService_RemoveParent,
+		[]string{iidentity.PermissionRoleInheritance_Update},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.roleInheritanceManager.RemoveParent(opCtx.OperationCtx, req.RoleId, req.ParentRoleId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleInheritanceServiceEvent, err,
+					"[roles.RoleInheritanceService.RemoveParent] remove a parent role from the role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeRoleParentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleParentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetParentRoleIds gets the IDs of the parent roles of the role by the specified role ID.
+func (s *RoleInheritanceService) GetParentRoleIds(ctx context.Context, req *inheritancepb.GetParentRoleIdsRequest) (*inheritancepb.GetParentRoleIdsResponse, error) {
+	var res *inheritancepb.GetParentRoleIdsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleInheritance_GetParentRoleIds, iactions.OperationTypeRoleInheritanceService_GetParentRoleIds,
+		[]string{iidentity.PermissionRoleInheritance_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.roleInheritanceManager.GetParentRoleIds(opCtx.OperationCtx, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleInheritanceServiceEvent, err,
+					"[roles.RoleInheritanceService.GetParentRoleIds] get parent role ids",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &inheritancepb.GetParentRoleIdsResponse{RoleIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllAncestorRoleIds gets the IDs of all roles from which the role inherits (directly or indirectly)
+// by the specified role ID.
+func (s *RoleInheritanceService) GetAllAncestorRoleIds(ctx context.Context, req *inheritancepb.GetAllAncestorRoleIdsRequest) (*inheritancepb.GetAllAncestorRoleIdsResponse, error) {
+	var res *inheritancepb.GetAllAncestorRoleIdsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRoleInheritance_GetAllAncestorRoleIds, iactions.OperationTypeRoleInheritanceService_GetAllAncestorRoleIds,
+		[]string{iidentity.PermissionRoleInheritance_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.roleInheritanceManager.GetAllAncestorRoleIds(opCtx.OperationCtx, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleInheritanceServiceEvent, err,
+					"[roles.RoleInheritanceService.GetAllAncestorRoleIds] get all ancestor role ids",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &inheritancepb.GetAllAncestorRoleIdsResponse{RoleIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
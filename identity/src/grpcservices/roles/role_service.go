@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	rolespb "personal-website-v2/go-apis/identity/roles"
 	iapierrors "personal-website-v2/identity/src/api/errors"
@@ -29,9 +30,12 @@ import (
 	iidentity "personal-website-v2/identity/src/internal/identity"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/roles/models"
+	roleoperations "personal-website-v2/identity/src/internal/roles/operations/roles"
 	"personal-website-v2/pkg/actions"
 	apierrors "personal-website-v2/pkg/api/errors"
 	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
 	"personal-website-v2/pkg/errors"
 	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
 	"personal-website-v2/pkg/identity"
@@ -75,6 +79,210 @@ func NewRoleService(
 	}, nil
 }
 
+// Create creates a role and returns the role ID if the operation is successful.
+func (s *RoleService) Create(ctx context.Context, req *rolespb.CreateRequest) (*rolespb.CreateResponse, error) {
+	var res *rolespb.CreateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_Create, iactions.OperationTypeRoleService_Create,
+		[]string{iidentity.PermissionRole_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolevalidation.ValidateCreateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, nil,
+					"[roles.RoleService.Create] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var appId nullable.Nullable[uint64]
+			if req.AppId != nil {
+				appId = nullable.NewNullable(req.AppId.Value)
+			}
+
+			var appGroupId nullable.Nullable[uint64]
+			if req.AppGroupId != nil {
+				appGroupId = nullable.NewNullable(req.AppGroupId.Value)
+			}
+
+			d := &roleoperations.CreateOperationData{
+				Name:        req.Name,
+				Type:        models.RoleType(req.Type),
+				Title:       req.Title,
+				AppId:       appId,
+				AppGroupId:  appGroupId,
+				Description: req.Description,
+			}
+
+			id, err := s.roleManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.Create] create a role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrRoleAlreadyExists {
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrRoleAlreadyExists)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidData {
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolespb.CreateResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete deletes a role by the specified role ID.
+func (s *RoleService) Delete(ctx context.Context, req *rolespb.DeleteRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_Delete, iactions.OperationTypeRoleService_Delete,
+		[]string{iidentity.PermissionRole_Delete},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.roleManager.Delete(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.Delete] delete a role",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrRoleNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidOperation {
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetById gets a role by the specified role ID.
+func (s *RoleService) GetById(ctx context.Context, req *rolespb.GetByIdRequest) (*rolespb.GetByIdResponse, error) {
+	var res *rolespb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_GetById, iactions.OperationTypeRoleService_GetById,
+		[]string{iidentity.PermissionRole_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			r, err := s.roleManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.GetById] find a role by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if r == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent,
+					"[roles.RoleService.GetById] role not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+			}
+
+			res = &rolespb.GetByIdResponse{Role: converter.ConvertToApiRole(r)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByName gets a role by the specified role name.
+func (s *RoleService) GetByName(ctx context.Context, req *rolespb.GetByNameRequest) (*rolespb.GetByNameResponse, error) {
+	var res *rolespb.GetByNameResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_GetByName, iactions.OperationTypeRoleService_GetByName,
+		[]string{iidentity.PermissionRole_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolevalidation.ValidateGetByNameRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, nil,
+					"[roles.RoleService.GetByName] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			r, err := s.roleManager.FindByName(opCtx.OperationCtx, req.Name)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.GetByName] find a role by name",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if r == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent,
+					"[roles.RoleService.GetByName] role not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+			}
+
+			res = &rolespb.GetByNameResponse{Role: converter.ConvertToApiRole(r)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByIds gets all roles by the specified role IDs.
+func (s *RoleService) GetAllByIds(ctx context.Context, req *rolespb.GetAllByIdsRequest) (*rolespb.GetAllByIdsResponse, error) {
+	var res *rolespb.GetAllByIdsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_GetAllByIds, iactions.OperationTypeRoleService_GetAllByIds,
+		[]string{iidentity.PermissionRole_GetAllBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolevalidation.ValidateGetAllByIdsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, nil,
+					"[roles.RoleService.GetAllByIds] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			rs, err := s.roleManager.GetAllByIds(opCtx.OperationCtx, req.Ids)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.GetAllByIds] get all roles by ids",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case errors.ErrorCodeInvalidData:
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					case ierrors.ErrorCodeRoleNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, apierrors.NewApiError(iapierrors.ApiErrorCodeRoleNotFound, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			rs2 := make([]*rolespb.Role, len(rs))
+			for i := 0; i < len(rs); i++ {
+				rs2[i] = converter.ConvertToApiRole(rs[i])
+			}
+
+			res = &rolespb.GetAllByIdsResponse{Roles: rs2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetAllByNames gets all roles by the specified role names.
 func (s *RoleService) GetAllByNames(ctx context.Context, req *rolespb.GetAllByNamesRequest) (*rolespb.GetAllByNamesResponse, error) {
 	var res *rolespb.GetAllByNamesResponse
@@ -119,3 +327,90 @@ func (s *RoleService) GetAllByNames(ctx context.Context, req *rolespb.GetAllByNa
 	}
 	return res, nil
 }
+
+// Exists returns true if the role exists.
+func (s *RoleService) Exists(ctx context.Context, req *rolespb.ExistsRequest) (*rolespb.ExistsResponse, error) {
+	var res *rolespb.ExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_Exists, iactions.OperationTypeRoleService_Exists,
+		[]string{iidentity.PermissionRole_Exists},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := rolevalidation.ValidateExistsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, nil,
+					"[roles.RoleService.Exists] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			exists, err := s.roleManager.Exists(opCtx.OperationCtx, req.Name)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.Exists] role exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolespb.ExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTypeById gets a role type by the specified role ID.
+func (s *RoleService) GetTypeById(ctx context.Context, req *rolespb.GetTypeByIdRequest) (*rolespb.GetTypeByIdResponse, error) {
+	var res *rolespb.GetTypeByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_GetTypeById, iactions.OperationTypeRoleService_GetTypeById,
+		[]string{iidentity.PermissionRole_GetType},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			t, err := s.roleManager.GetTypeById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.GetTypeById] get a role type by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolespb.GetTypeByIdResponse{Type: rolespb.RoleTypeEnum_RoleType(t)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusById gets a role status by the specified role ID.
+func (s *RoleService) GetStatusById(ctx context.Context, req *rolespb.GetStatusByIdRequest) (*rolespb.GetStatusByIdResponse, error) {
+	var res *rolespb.GetStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeRole_GetStatusById, iactions.OperationTypeRoleService_GetStatusById,
+		[]string{iidentity.PermissionRole_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.roleManager.GetStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_RoleServiceEvent, err,
+					"[roles.RoleService.GetStatusById] get a role status by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &rolespb.GetStatusByIdResponse{Status: rolespb.RoleStatusEnum_RoleStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,322 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	userroleassignmentspb "personal-website-v2/go-apis/identity/roles/userroleassignments"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/roles/converter"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserRoleAssignmentService struct {
+	userroleassignmentspb.UnimplementedUserRoleAssignmentServiceServer
+	reqProcessor              *grpcserverhelper.RequestProcessor
+	userRoleAssignmentManager roles.UserRoleAssignmentManager
+	logger                    logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserRoleAssignmentService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userRoleAssignmentManager roles.UserRoleAssignmentManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserRoleAssignmentService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.roles.UserRoleAssignmentService")
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewUserRoleAssignmentService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserRoleAssignment,
+		OperationGroup: iactions.OperationGroupUserRoleAssignment,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[roles.NewUserRoleAssignmentService] new request processor: %w", err)
+	}
+
+	return &UserRoleAssignmentService{
+		reqProcessor:              p,
+		userRoleAssignmentManager: userRoleAssignmentManager,
+		logger:                    l,
+	}, nil
+}
+
+// GetById gets a user's role assignment by the specified user's role assignment ID.
+func (s *UserRoleAssignmentService) GetById(ctx context.Context, req *userroleassignmentspb.GetByIdRequest) (*userroleassignmentspb.GetByIdResponse, error) {
+	var res *userroleassignmentspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetById, iactions.OperationTypeUserRoleAssignmentService_GetById,
+		[]string{iidentity.PermissionUserRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.userRoleAssignmentManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetById] find a user's role assignment by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent,
+					"[roles.UserRoleAssignmentService.GetById] user's role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &userroleassignmentspb.GetByIdResponse{Assignment: converter.ConvertToApiUserRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByRoleAssignmentId gets a user's role assignment by the specified role assignment ID.
+func (s *UserRoleAssignmentService) GetByRoleAssignmentId(ctx context.Context, req *userroleassignmentspb.GetByRoleAssignmentIdRequest) (*userroleassignmentspb.GetByRoleAssignmentIdResponse, error) {
+	var res *userroleassignmentspb.GetByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetByRoleAssignmentId, iactions.OperationTypeUserRoleAssignmentService_GetByRoleAssignmentId,
+		[]string{iidentity.PermissionUserRoleAssignment_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			a, err := s.userRoleAssignmentManager.FindByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetByRoleAssignmentId] find a user's role assignment by role assignment id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if a == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent,
+					"[roles.UserRoleAssignmentService.GetByRoleAssignmentId] user's role assignment not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+			}
+
+			res = &userroleassignmentspb.GetByRoleAssignmentIdResponse{Assignment: converter.ConvertToApiUserRoleAssignment(a)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllByUserId gets all user's role assignments by the specified user ID.
+func (s *UserRoleAssignmentService) GetAllByUserId(ctx context.Context, req *userroleassignmentspb.GetAllByUserIdRequest) (*userroleassignmentspb.GetAllByUserIdResponse, error) {
+	var res *userroleassignmentspb.GetAllByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetAllByUserId, iactions.OperationTypeUserRoleAssignmentService_GetAllByUserId,
+		[]string{iidentity.PermissionUserRoleAssignment_GetAllBy},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			as, err := s.userRoleAssignmentManager.GetAllByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetAllByUserId] get all user's role assignments by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			as2 := make([]*userroleassignmentspb.UserRoleAssignment, len(as))
+			for i := 0; i < len(as); i++ {
+				as2[i] = converter.ConvertToApiUserRoleAssignment(as[i])
+			}
+
+			res = &userroleassignmentspb.GetAllByUserIdResponse{Assignments: as2}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exists returns true if the user's role assignment exists.
+func (s *UserRoleAssignmentService) Exists(ctx context.Context, req *userroleassignmentspb.ExistsRequest) (*userroleassignmentspb.ExistsResponse, error) {
+	var res *userroleassignmentspb.ExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_Exists, iactions.OperationTypeUserRoleAssignmentService_Exists,
+		[]string{iidentity.PermissionUserRoleAssignment_Exists},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			exists, err := s.userRoleAssignmentManager.Exists(opCtx.OperationCtx, req.UserId, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.Exists] user's role assignment exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.ExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsAssigned returns true if the role is assigned to the user.
+func (s *UserRoleAssignmentService) IsAssigned(ctx context.Context, req *userroleassignmentspb.IsAssignedRequest) (*userroleassignmentspb.IsAssignedResponse, error) {
+	var res *userroleassignmentspb.IsAssignedResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_IsAssigned, iactions.OperationTypeUserRoleAssignmentService_IsAssigned,
+		[]string{iidentity.PermissionUserRoleAssignment_IsAssigned},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			isAssigned, err := s.userRoleAssignmentManager.IsAssigned(opCtx.OperationCtx, req.UserId, req.RoleId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.IsAssigned] is the role assigned to the user",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.IsAssignedResponse{IsAssigned: isAssigned}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIdByRoleAssignmentId gets the user's role assignment ID by the specified role assignment ID.
+func (s *UserRoleAssignmentService) GetIdByRoleAssignmentId(ctx context.Context, req *userroleassignmentspb.GetIdByRoleAssignmentIdRequest) (*userroleassignmentspb.GetIdByRoleAssignmentIdResponse, error) {
+	var res *userroleassignmentspb.GetIdByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetIdByRoleAssignmentId, iactions.OperationTypeUserRoleAssignmentService_GetIdByRoleAssignmentId,
+		[]string{iidentity.PermissionUserRoleAssignment_GetId},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			id, err := s.userRoleAssignmentManager.GetIdByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetIdByRoleAssignmentId] get the user's role assignment id by role assignment id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.GetIdByRoleAssignmentIdResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusById gets a user's role assignment status by the specified user's role assignment ID.
+func (s *UserRoleAssignmentService) GetStatusById(ctx context.Context, req *userroleassignmentspb.GetStatusByIdRequest) (*userroleassignmentspb.GetStatusByIdResponse, error) {
+	var res *userroleassignmentspb.GetStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetStatusById, iactions.OperationTypeUserRoleAssignmentService_GetStatusById,
+		[]string{iidentity.PermissionUserRoleAssignment_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.userRoleAssignmentManager.GetStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetStatusById] get a user's role assignment status by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.GetStatusByIdResponse{Status: userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusByRoleAssignmentId gets a user's role assignment status by the specified role assignment ID.
+func (s *UserRoleAssignmentService) GetStatusByRoleAssignmentId(ctx context.Context, req *userroleassignmentspb.GetStatusByRoleAssignmentIdRequest) (*userroleassignmentspb.GetStatusByRoleAssignmentIdResponse, error) {
+	var res *userroleassignmentspb.GetStatusByRoleAssignmentIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetStatusByRoleAssignmentId, iactions.OperationTypeUserRoleAssignmentService_GetStatusByRoleAssignmentId,
+		[]string{iidentity.PermissionUserRoleAssignment_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.userRoleAssignmentManager.GetStatusByRoleAssignmentId(opCtx.OperationCtx, req.RoleAssignmentId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetStatusByRoleAssignmentId] get a user's role assignment status by role assignment id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrRoleAssignmentNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrRoleAssignmentNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.GetStatusByRoleAssignmentIdResponse{Status: userroleassignmentspb.UserRoleAssignmentStatusEnum_UserRoleAssignmentStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetUserRoleIdsByUserId gets the IDs of the roles assigned to the user by the specified user ID.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (s *UserRoleAssignmentService) GetUserRoleIdsByUserId(ctx context.Context, req *userroleassignmentspb.GetUserRoleIdsByUserIdRequest) (*userroleassignmentspb.GetUserRoleIdsByUserIdResponse, error) {
+	var res *userroleassignmentspb.GetUserRoleIdsByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserRoleAssignment_GetUserRoleIdsByUserId, iactions.OperationTypeUserRoleAssignmentService_GetUserRoleIdsByUserId,
+		[]string{iidentity.PermissionUserRoleAssignment_GetUserRoleIds},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			ids, err := s.userRoleAssignmentManager.GetUserRoleIdsByUserId(opCtx.OperationCtx, req.UserId, req.RoleFilter)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserRoleAssignmentServiceEvent, err,
+					"[roles.UserRoleAssignmentService.GetUserRoleIdsByUserId] get the ids of the roles assigned to the user by user id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userroleassignmentspb.GetUserRoleIdsByUserIdResponse{RoleIds: ids}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
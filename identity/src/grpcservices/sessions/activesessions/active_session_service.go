@@ -0,0 +1,205 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activesessions
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	activesessionspb "personal-website-v2/go-apis/identity/sessions/activesessions"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/sessions/activesessions/converter"
+	"personal-website-v2/identity/src/api/grpc/sessions/activesessions/validation"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ActiveSessionService struct {
+	activesessionspb.UnimplementedActiveSessionServiceServer
+	reqProcessor         *grpcserverhelper.RequestProcessor
+	activeSessionManager sessions.ActiveSessionManager
+	logger               logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewActiveSessionService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	activeSessionManager sessions.ActiveSessionManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ActiveSessionService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.sessions.activesessions.ActiveSessionService")
+	if err != nil {
+		return nil, fmt.Errorf("[activesessions.NewActiveSessionService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupActiveSession,
+		OperationGroup: iactions.OperationGroupActiveSession,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[activesessions.NewActiveSessionService] new request processor: %w", err)
+	}
+
+	return &ActiveSessionService{
+		reqProcessor:         p,
+		activeSessionManager: activeSessionManager,
+		logger:               l,
+	}, nil
+}
+
+// GetAllByUserId gets all active sessions of the user by the specified user ID.
+// Users can only get their own sessions.
+func (s *ActiveSessionService) GetAllByUserId(ctx context.Context, req *activesessionspb.GetAllByUserIdRequest) (*activesessionspb.GetAllByUserIdResponse, error) {
+	var res *activesessionspb.GetAllByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeActiveSession_GetAllByUserId, iactions.OperationTypeActiveSessionService_GetAllByUserId,
+		[]string{iidentity.PermissionActiveSession_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetAllByUserIdRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, nil,
+					"[activesessions.ActiveSessionService.GetAllByUserId] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[activesessions.ActiveSessionService.GetAllByUserId] user can't get sessions of another user"); err != nil {
+				return err
+			}
+
+			ss, err := s.activeSessionManager.GetAllByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, err,
+					"[activesessions.ActiveSessionService.GetAllByUserId] get all active sessions of the user by user id",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &activesessionspb.GetAllByUserIdResponse{Sessions: make([]*activesessionspb.ActiveSession, len(ss))}
+			for i := 0; i < len(ss); i++ {
+				res.Sessions[i] = converter.ConvertToApiActiveSession(ss[i], opCtx.OperationCtx.ClientId.Value)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Revoke revokes the user's session by the specified user ID and user's session ID.
+// Users can only revoke their own sessions.
+func (s *ActiveSessionService) Revoke(ctx context.Context, req *activesessionspb.RevokeRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeActiveSession_Revoke, iactions.OperationTypeActiveSessionService_Revoke,
+		[]string{iidentity.PermissionActiveSession_Revoke},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateRevokeRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, nil,
+					"[activesessions.ActiveSessionService.Revoke] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[activesessions.ActiveSessionService.Revoke] user can't revoke sessions of another user"); err != nil {
+				return err
+			}
+
+			if err := s.activeSessionManager.Revoke(opCtx.OperationCtx, req.UserId, req.SessionId); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, err,
+					"[activesessions.ActiveSessionService.Revoke] revoke a user's session",
+				)
+				return toGrpcError(err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// RevokeAllOther revokes all active sessions of the user except the session of the client that made the request
+// and returns the number of revoked sessions. If the request was made without a client, then all sessions are revoked.
+// Users can only revoke their own sessions.
+func (s *ActiveSessionService) RevokeAllOther(ctx context.Context, req *activesessionspb.RevokeAllOtherRequest) (*activesessionspb.RevokeAllOtherResponse, error) {
+	var res *activesessionspb.RevokeAllOtherResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeActiveSession_RevokeAllOther, iactions.OperationTypeActiveSessionService_RevokeAllOther,
+		[]string{iidentity.PermissionActiveSession_Revoke},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateRevokeAllOtherRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, nil,
+					"[activesessions.ActiveSessionService.RevokeAllOther] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			if err := s.checkSelf(opCtx, req.UserId, "[activesessions.ActiveSessionService.RevokeAllOther] user can't revoke sessions of another user"); err != nil {
+				return err
+			}
+
+			n, err := s.activeSessionManager.RevokeAllOther(opCtx.OperationCtx, req.UserId, opCtx.OperationCtx.ClientId.Value)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, err,
+					"[activesessions.ActiveSessionService.RevokeAllOther] revoke all other sessions of the user",
+				)
+				return toGrpcError(err)
+			}
+
+			res = &activesessionspb.RevokeAllOtherResponse{Count: int32(n)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ActiveSessionService) checkSelf(opCtx *grpcserverhelper.GrpcOperationContext, userId uint64, msg string) error {
+	if !opCtx.OperationCtx.UserId.HasValue || opCtx.OperationCtx.UserId.Value != userId {
+		s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_ActiveSessionServiceEvent, msg)
+		return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+	}
+	return nil
+}
+
+func toGrpcError(err error) error {
+	if err2 := errors.Unwrap(err); err2 != nil {
+		switch err2.Code() {
+		case ierrors.ErrorCodeUserSessionNotFound:
+			return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserSessionNotFound)
+		case errors.ErrorCodeInvalidOperation:
+			return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+		}
+	}
+	return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+}
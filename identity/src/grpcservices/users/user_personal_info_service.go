@@ -0,0 +1,103 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	personalinfopb "personal-website-v2/go-apis/identity/users/personalinfo"
+	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/users/converter"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/users"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/errors"
+	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UserPersonalInfoService struct {
+	personalinfopb.UnimplementedUserPersonalInfoServiceServer
+	reqProcessor            *grpcserverhelper.RequestProcessor
+	userPersonalInfoManager users.UserPersonalInfoManager
+	logger                  logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewUserPersonalInfoService(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	userPersonalInfoManager users.UserPersonalInfoManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UserPersonalInfoService, error) {
+	l, err := loggerFactory.CreateLogger("grpcservices.users.UserPersonalInfoService")
+	if err != nil {
+		return nil, fmt.Errorf("[users.NewUserPersonalInfoService] create a logger: %w", err)
+	}
+
+	c := &grpcserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupUserPersonalInfo,
+		OperationGroup: iactions.OperationGroupUserPersonalInfo,
+		StopAppIfError: true,
+	}
+	p, err := grpcserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[users.NewUserPersonalInfoService] new request processor: %w", err)
+	}
+
+	return &UserPersonalInfoService{
+		reqProcessor:            p,
+		userPersonalInfoManager: userPersonalInfoManager,
+		logger:                  l,
+	}, nil
+}
+
+// GetByUserId gets user's personal info by the specified user ID.
+func (s *UserPersonalInfoService) GetByUserId(ctx context.Context, req *personalinfopb.GetByUserIdRequest) (*personalinfopb.GetByUserIdResponse, error) {
+	var res *personalinfopb.GetByUserIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUserPersonalInfo_GetByUserId, iactions.OperationTypeUserPersonalInfoService_GetByUserId,
+		[]string{iidentity.PermissionUserPersonalInfo_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			i, err := s.userPersonalInfoManager.GetByUserId(opCtx.OperationCtx, req.UserId)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserPersonalInfoServiceEvent, err,
+					"[users.UserPersonalInfoService.GetByUserId] get user's personal info by user id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserPersonalInfoNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserPersonalInfoNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &personalinfopb.GetByUserIdResponse{Info: converter.ConvertToApiPersonalInfo(i)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -17,19 +17,28 @@ package users
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
 
+	groupspb "personal-website-v2/go-apis/identity/groups"
 	userspb "personal-website-v2/go-apis/identity/users"
 	iapierrors "personal-website-v2/identity/src/api/errors"
+	"personal-website-v2/identity/src/api/grpc/users/converter"
+	"personal-website-v2/identity/src/api/grpc/users/validation"
 	iactions "personal-website-v2/identity/src/internal/actions"
 	ierrors "personal-website-v2/identity/src/internal/errors"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	iidentity "personal-website-v2/identity/src/internal/identity"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/users"
+	"personal-website-v2/identity/src/internal/users/models"
+	useroperations "personal-website-v2/identity/src/internal/users/operations/users"
 	"personal-website-v2/pkg/actions"
 	apierrors "personal-website-v2/pkg/api/errors"
 	apigrpcerrors "personal-website-v2/pkg/api/grpc/errors"
+	"personal-website-v2/pkg/base/nullable"
 	"personal-website-v2/pkg/errors"
 	grpcserverhelper "personal-website-v2/pkg/helper/net/grpc/server"
 	"personal-website-v2/pkg/identity"
@@ -73,6 +82,431 @@ func NewUserService(
 	}, nil
 }
 
+// Create creates a user and returns the user ID if the operation is successful.
+func (s *UserService) Create(ctx context.Context, req *userspb.CreateRequest) (*userspb.CreateResponse, error) {
+	var res *userspb.CreateResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_Create, iactions.OperationTypeUserService_Create,
+		[]string{iidentity.PermissionUser_Create},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateCreateRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.Create] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var email nullable.Nullable[string]
+			if req.Email != nil {
+				email = nullable.NewNullable(req.Email.Value)
+			}
+
+			var birthDate nullable.Nullable[time.Time]
+			if req.BirthDate != nil {
+				birthDate = nullable.NewNullable(req.BirthDate.AsTime())
+			}
+
+			d := &useroperations.CreateOperationData{
+				Type:        models.UserType(req.Type),
+				Group:       groupmodels.UserGroup(req.Group),
+				Status:      models.UserStatus(req.Status),
+				Email:       email,
+				FirstName:   req.FirstName,
+				LastName:    req.LastName,
+				DisplayName: req.DisplayName,
+				BirthDate:   birthDate,
+				Gender:      models.Gender(req.Gender),
+			}
+
+			id, err := s.userManager.Create(opCtx.OperationCtx, d)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.Create] create a user",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrUserEmailAlreadyExists {
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUserEmailAlreadyExists)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidData {
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.CreateResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete deletes a user by the specified user ID.
+func (s *UserService) Delete(ctx context.Context, req *userspb.DeleteRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_Delete, iactions.OperationTypeUserService_Delete,
+		[]string{iidentity.PermissionUser_Delete},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := s.userManager.Delete(opCtx.OperationCtx, req.Id); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.Delete] delete a user",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					if err2 == ierrors.ErrUserNotFound {
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidOperation {
+						return apigrpcerrors.CreateGrpcError(codes.FailedPrecondition, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidOperation, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetById gets a user by the specified user ID.
+func (s *UserService) GetById(ctx context.Context, req *userspb.GetByIdRequest) (*userspb.GetByIdResponse, error) {
+	var res *userspb.GetByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetById, iactions.OperationTypeUserService_GetById,
+		[]string{iidentity.PermissionUser_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			u, err := s.userManager.FindById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetById] find a user by id",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if u == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent,
+					"[users.UserService.GetById] user not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+			}
+
+			res = &userspb.GetByIdResponse{User: converter.ConvertToApiUser(u)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByName gets a user by the specified user name.
+func (s *UserService) GetByName(ctx context.Context, req *userspb.GetByNameRequest) (*userspb.GetByNameResponse, error) {
+	var res *userspb.GetByNameResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetByName, iactions.OperationTypeUserService_GetByName,
+		[]string{iidentity.PermissionUser_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetByNameRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.GetByName] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			u, err := s.userManager.FindByName(opCtx.OperationCtx, req.Name, req.IsCaseSensitive)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetByName] find a user by name",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if u == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent,
+					"[users.UserService.GetByName] user not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+			}
+
+			res = &userspb.GetByNameResponse{User: converter.ConvertToApiUser(u)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByEmail gets a user by the specified user's email.
+func (s *UserService) GetByEmail(ctx context.Context, req *userspb.GetByEmailRequest) (*userspb.GetByEmailResponse, error) {
+	var res *userspb.GetByEmailResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetByEmail, iactions.OperationTypeUserService_GetByEmail,
+		[]string{iidentity.PermissionUser_Get},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetByEmailRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.GetByEmail] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			u, err := s.userManager.FindByEmail(opCtx.OperationCtx, req.Email, req.IsCaseSensitive)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetByEmail] find a user by email",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == errors.ErrorCodeInvalidData {
+					return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			if u == nil {
+				s.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent,
+					"[users.UserService.GetByEmail] user not found",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+			}
+
+			res = &userspb.GetByEmailResponse{User: converter.ConvertToApiUser(u)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIdByName gets the user ID by the specified user name.
+func (s *UserService) GetIdByName(ctx context.Context, req *userspb.GetIdByNameRequest) (*userspb.GetIdByNameResponse, error) {
+	var res *userspb.GetIdByNameResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetIdByName, iactions.OperationTypeUserService_GetIdByName,
+		[]string{iidentity.PermissionUser_GetId},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateGetIdByNameRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.GetIdByName] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			id, err := s.userManager.GetIdByName(opCtx.OperationCtx, req.Name, req.IsCaseSensitive)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetIdByName] get the user id by name",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetIdByNameResponse{Id: id}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetNameById gets a user name by the specified user ID.
+func (s *UserService) GetNameById(ctx context.Context, req *userspb.GetNameByIdRequest) (*userspb.GetNameByIdResponse, error) {
+	var res *userspb.GetNameByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetNameById, iactions.OperationTypeUserService_GetNameById,
+		[]string{iidentity.PermissionUser_GetName},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			n, err := s.userManager.GetNameById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetNameById] get a user name by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetNameByIdResponse{Name: n.Value}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetNameById sets a user name by the specified user ID.
+func (s *UserService) SetNameById(ctx context.Context, req *userspb.SetNameByIdRequest) (*emptypb.Empty, error) {
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_SetNameById, iactions.OperationTypeUserService_SetNameById,
+		[]string{iidentity.PermissionUser_SetName},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateSetNameByIdRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.SetNameById] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			var name nullable.Nullable[string]
+			if req.Name != nil {
+				name = nullable.NewNullable(req.Name.Value)
+			}
+
+			if err := s.userManager.SetNameById(opCtx.OperationCtx, req.Id, name); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.SetNameById] set a user name by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2 {
+					case ierrors.ErrUserNotFound:
+						return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+					case ierrors.ErrUsernameAlreadyExists:
+						return apigrpcerrors.CreateGrpcError(codes.AlreadyExists, iapierrors.ErrUsernameAlreadyExists)
+					}
+					if err2.Code() == errors.ErrorCodeInvalidData {
+						return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidData, err2.Message()))
+					}
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// NameExists returns true if the user name exists.
+func (s *UserService) NameExists(ctx context.Context, req *userspb.NameExistsRequest) (*userspb.NameExistsResponse, error) {
+	var res *userspb.NameExistsResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_NameExists, iactions.OperationTypeUserService_NameExists,
+		[]string{iidentity.PermissionUser_NameExists},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			if err := validation.ValidateNameExistsRequest(req); err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, nil,
+					"[users.UserService.NameExists] "+err.Message(),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.InvalidArgument, err)
+			}
+
+			exists, err := s.userManager.NameExists(opCtx.OperationCtx, req.Name)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.NameExists] user name exists",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.NameExistsResponse{Exists: exists}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTypeById gets a user's type by the specified user ID.
+func (s *UserService) GetTypeById(ctx context.Context, req *userspb.GetTypeByIdRequest) (*userspb.GetTypeByIdResponse, error) {
+	var res *userspb.GetTypeByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetTypeById, iactions.OperationTypeUserService_GetTypeById,
+		[]string{iidentity.PermissionUser_GetType},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			t, err := s.userManager.GetTypeById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetTypeById] get a user's type by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetTypeByIdResponse{Type: userspb.UserTypeEnum_UserType(t)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetGroupById gets a user's group by the specified user ID.
+func (s *UserService) GetGroupById(ctx context.Context, req *userspb.GetGroupByIdRequest) (*userspb.GetGroupByIdResponse, error) {
+	var res *userspb.GetGroupByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetGroupById, iactions.OperationTypeUserService_GetGroupById,
+		[]string{iidentity.PermissionUser_GetGroup},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			g, err := s.userManager.GetGroupById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetGroupById] get a user's group by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetGroupByIdResponse{Group: groupspb.UserGroup(g)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetStatusById gets a user's status by the specified user ID.
+func (s *UserService) GetStatusById(ctx context.Context, req *userspb.GetStatusByIdRequest) (*userspb.GetStatusByIdResponse, error) {
+	var res *userspb.GetStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetStatusById, iactions.OperationTypeUserService_GetStatusById,
+		[]string{iidentity.PermissionUser_GetStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			status, err := s.userManager.GetStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetStatusById] get a user's status by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetStatusByIdResponse{Status: userspb.UserStatus(status)}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetTypeAndStatusById gets a type and a status of the user by the specified user ID.
 func (s *UserService) GetTypeAndStatusById(ctx context.Context, req *userspb.GetTypeAndStatusByIdRequest) (*userspb.GetTypeAndStatusByIdResponse, error) {
 	var res *userspb.GetTypeAndStatusByIdResponse
@@ -103,3 +537,34 @@ func (s *UserService) GetTypeAndStatusById(ctx context.Context, req *userspb.Get
 	}
 	return res, nil
 }
+
+// GetGroupAndStatusById gets a group and a status of the user by the specified user ID.
+func (s *UserService) GetGroupAndStatusById(ctx context.Context, req *userspb.GetGroupAndStatusByIdRequest) (*userspb.GetGroupAndStatusByIdResponse, error) {
+	var res *userspb.GetGroupAndStatusByIdResponse
+	err := s.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeUser_GetGroupAndStatusById, iactions.OperationTypeUserService_GetGroupAndStatusById,
+		[]string{iidentity.PermissionUser_GetGroupAndStatus},
+		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
+			g, status, err := s.userManager.GetGroupAndStatusById(opCtx.OperationCtx, req.Id)
+			if err != nil {
+				s.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.GrpcServices_UserServiceEvent, err,
+					"[users.UserService.GetGroupAndStatusById] get a group and a status of the user by id",
+				)
+
+				if err2 := errors.Unwrap(err); err2 == ierrors.ErrUserNotFound {
+					return apigrpcerrors.CreateGrpcError(codes.NotFound, iapierrors.ErrUserNotFound)
+				}
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			res = &userspb.GetGroupAndStatusByIdResponse{
+				Group:  groupspb.UserGroup(g),
+				Status: userspb.UserStatus(status),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorization
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	iidentity "personal-website-v2/identity/src/internal/identity"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	apihttp "personal-website-v2/pkg/api/http"
+	httpserverhelper "personal-website-v2/pkg/helper/net/http/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/net/http/server"
+)
+
+// AuthzCacheController is an authorization cache controller.
+type AuthzCacheController struct {
+	reqProcessor *httpserverhelper.RequestProcessor
+	authzCache   authorization.AuthorizationCache
+	logger       logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewAuthzCacheController(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	authzCache authorization.AuthorizationCache,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*AuthzCacheController, error) {
+	l, err := loggerFactory.CreateLogger("httpcontrollers.authorization.AuthzCacheController")
+	if err != nil {
+		return nil, fmt.Errorf("[authorization.NewAuthzCacheController] create a logger: %w", err)
+	}
+
+	c := &httpserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupAuthorization,
+		OperationGroup: iactions.OperationGroupAuthorizationCache,
+		StopAppIfError: true,
+	}
+	p, err := httpserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[authorization.NewAuthzCacheController] new request processor: %w", err)
+	}
+
+	return &AuthzCacheController{
+		reqProcessor: p,
+		authzCache:   authzCache,
+		logger:       l,
+	}, nil
+}
+
+// GetStats gets the authorization cache statistics (hits, misses, etc.).
+//
+//	[GET] /private/api/authorization/cache/stats
+func (c *AuthzCacheController) GetStats(ctx *server.HttpContext) {
+	c.reqProcessor.ProcessWithAuthnCheckAndAuthz(ctx, iactions.ActionTypeAuthorization_GetCacheStats, iactions.OperationTypeAuthzCacheController_GetStats,
+		[]string{iidentity.PermissionAuthorization_GetCacheStats},
+		func(opCtx *actions.OperationContext) bool {
+			ctx.Response.Writer.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+
+			if err := apihttp.Ok(ctx, c.authzCache.Stats()); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_AuthzCacheControllerEvent, err,
+					"[authorization.AuthzCacheController.GetStats] write Ok",
+				)
+				return false
+			}
+			return true
+		},
+	)
+}
@@ -0,0 +1,435 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/oidc"
+	"personal-website-v2/identity/src/internal/oidc/models"
+	"personal-website-v2/pkg/actions"
+	apierrors "personal-website-v2/pkg/api/errors"
+	apihttp "personal-website-v2/pkg/api/http"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	httpserverhelper "personal-website-v2/pkg/helper/net/http/server"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/net/http/server"
+)
+
+// The OAuth 2.0 error codes (RFC 6749, section 4.1.2.1, 5.2 and OpenID Connect Core 1.0, section 3.1.2.6).
+const (
+	errorInvalidRequest          = "invalid_request"
+	errorInvalidClient           = "invalid_client"
+	errorInvalidGrant            = "invalid_grant"
+	errorInvalidScope            = "invalid_scope"
+	errorAccessDenied            = "access_denied"
+	errorUnsupportedResponseType = "unsupported_response_type"
+	errorUnsupportedGrantType    = "unsupported_grant_type"
+	errorInvalidToken            = "invalid_token"
+	errorServerError             = "server_error"
+	errorLoginRequired           = "login_required"
+)
+
+type OidcControllerConfig struct {
+	// Optional. The URL of the login page. If it is specified, an unauthenticated user
+	// is redirected to the login page with the 'returnUrl' query parameter.
+	LoginURL string
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	IdToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// OidcController is an OpenID Connect provider controller.
+type OidcController struct {
+	reqProcessor *httpserverhelper.RequestProcessor
+	config       *OidcControllerConfig
+	oidcManager  oidc.OidcManager
+	logger       logging.Logger[*lcontext.LogEntryContext]
+}
+
+func NewOidcController(
+	appSessionId uint64,
+	actionManager *actions.ActionManager,
+	identityManager identity.IdentityManager,
+	config *OidcControllerConfig,
+	oidcManager oidc.OidcManager,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*OidcController, error) {
+	l, err := loggerFactory.CreateLogger("httpcontrollers.oidc.OidcController")
+	if err != nil {
+		return nil, fmt.Errorf("[oidc.NewOidcController] create a logger: %w", err)
+	}
+
+	c := &httpserverhelper.RequestProcessorConfig{
+		ActionGroup:    iactions.ActionGroupOidc,
+		OperationGroup: iactions.OperationGroupOidc,
+		StopAppIfError: true,
+	}
+	p, err := httpserverhelper.NewRequestProcessor(appSessionId, actionManager, identityManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[oidc.NewOidcController] new request processor: %w", err)
+	}
+
+	return &OidcController{
+		reqProcessor: p,
+		config:       config,
+		oidcManager:  oidcManager,
+		logger:       l,
+	}, nil
+}
+
+// GetProviderMetadata gets the OpenID provider metadata (discovery document).
+//
+//	[GET] /.well-known/openid-configuration
+func (c *OidcController) GetProviderMetadata(ctx *server.HttpContext) {
+	c.reqProcessor.Process(ctx, iactions.ActionTypeOidc_GetProviderMetadata, iactions.OperationTypeOidcController_GetProviderMetadata,
+		func(opCtx *actions.OperationContext) bool {
+			if err := writeJSON(ctx, http.StatusOK, c.oidcManager.GetProviderMetadata()); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.GetProviderMetadata] write JSON",
+				)
+				return false
+			}
+			return true
+		},
+	)
+}
+
+// GetJwks gets the JWK Set containing the public keys that are used to verify the tokens.
+//
+//	[GET] /oauth2/jwks
+func (c *OidcController) GetJwks(ctx *server.HttpContext) {
+	c.reqProcessor.Process(ctx, iactions.ActionTypeOidc_GetJwks, iactions.OperationTypeOidcController_GetJwks,
+		func(opCtx *actions.OperationContext) bool {
+			if err := writeJSON(ctx, http.StatusOK, c.oidcManager.GetJwks()); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.GetJwks] write JSON",
+				)
+				return false
+			}
+			return true
+		},
+	)
+}
+
+// Authorize authorizes the client (the authorization code flow with PKCE) and redirects the user agent
+// to the redirect URI of the client with an authorization code or an error.
+//
+//	[GET] /oauth2/authorize?response_type=code&client_id={clientId}&redirect_uri={redirectURI}&scope={scope}
+//		&state={state}&nonce={nonce}&code_challenge={codeChallenge}&code_challenge_method=S256
+func (c *OidcController) Authorize(ctx *server.HttpContext) {
+	c.reqProcessor.Process(ctx, iactions.ActionTypeOidc_Authorize, iactions.OperationTypeOidcController_Authorize,
+		func(opCtx *actions.OperationContext) bool {
+			q := ctx.Request.URL.Query()
+			clientId, err := strconv.ParseUint(q.Get("client_id"), 10, 64)
+			if err != nil {
+				c.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent,
+					"[oidc.OidcController.Authorize] invalid client_id",
+				)
+
+				if err := apihttp.BadRequest(ctx, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidQueryString, "invalid client_id")); err != nil {
+					c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+						"[oidc.OidcController.Authorize] write BadRequest",
+					)
+				}
+				return false
+			}
+
+			redirectURI := q.Get("redirect_uri")
+
+			// the user agent must not be redirected to an unverified redirect URI
+			if err = c.oidcManager.ValidateRedirectURI(opCtx, clientId, redirectURI); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.Authorize] validate a redirect URI",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil &&
+					(err2.Code() == ierrors.ErrorCodeOidcInvalidClient || err2.Code() == ierrors.ErrorCodeOidcInvalidRedirectURI) {
+					if err := apihttp.BadRequest(ctx, apierrors.NewApiError(apierrors.ApiErrorCodeInvalidQueryString, err2.Message())); err != nil {
+						c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+							"[oidc.OidcController.Authorize] write BadRequest",
+						)
+					}
+				} else if err := apihttp.InternalServerError(ctx); err != nil {
+					c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+						"[oidc.OidcController.Authorize] write InternalServerError",
+					)
+				}
+				return false
+			}
+
+			state := q.Get("state")
+
+			if !ctx.User.IsAuthenticated() || !ctx.User.UserId().HasValue {
+				if len(c.config.LoginURL) > 0 {
+					http.Redirect(ctx.Response.Writer, ctx.Request, c.config.LoginURL+"?returnUrl="+url.QueryEscape(ctx.Request.URL.RequestURI()), http.StatusFound)
+					return true
+				}
+				return c.redirectWithError(ctx, opCtx, redirectURI, state, errorLoginRequired, "user not authenticated")
+			}
+
+			if q.Get("response_type") != models.ResponseTypeCode {
+				return c.redirectWithError(ctx, opCtx, redirectURI, state, errorUnsupportedResponseType, "")
+			}
+
+			req := &models.AuthorizationRequest{
+				ClientId:            clientId,
+				RedirectURI:         redirectURI,
+				Scopes:              strings.Fields(q.Get("scope")),
+				CodeChallenge:       q.Get("code_challenge"),
+				CodeChallengeMethod: q.Get("code_challenge_method"),
+			}
+
+			if q.Has("nonce") {
+				req.Nonce = nullable.NewNullable(q.Get("nonce"))
+			}
+
+			code, err := c.oidcManager.Authorize(opCtx, ctx.User.UserId().Value, req)
+			if err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.Authorize] authorize a client",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeOidcInvalidRequest:
+						return c.redirectWithError(ctx, opCtx, redirectURI, state, errorInvalidRequest, err2.Message())
+					case ierrors.ErrorCodeOidcInvalidScope:
+						return c.redirectWithError(ctx, opCtx, redirectURI, state, errorInvalidScope, err2.Message())
+					case ierrors.ErrorCodeOidcAccessDenied:
+						return c.redirectWithError(ctx, opCtx, redirectURI, state, errorAccessDenied, err2.Message())
+					}
+				}
+				c.redirectWithError(ctx, opCtx, redirectURI, state, errorServerError, "")
+				return false
+			}
+
+			v := url.Values{}
+			v.Set("code", code)
+			if len(state) > 0 {
+				v.Set("state", state)
+			}
+
+			http.Redirect(ctx.Response.Writer, ctx.Request, appendQuery(redirectURI, v), http.StatusFound)
+			return true
+		},
+	)
+}
+
+// Token exchanges an authorization code or a refresh token for tokens.
+// The client authenticates using HTTP Basic authentication or the request body.
+//
+//	[POST] /oauth2/token
+func (c *OidcController) Token(ctx *server.HttpContext) {
+	c.reqProcessor.Process(ctx, iactions.ActionTypeOidc_Token, iactions.OperationTypeOidcController_Token,
+		func(opCtx *actions.OperationContext) bool {
+			if err := ctx.Request.ParseForm(); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.Token] parse a form",
+				)
+				return c.writeError(ctx, opCtx, http.StatusBadRequest, errorInvalidRequest, "invalid request body")
+			}
+
+			f := ctx.Request.PostForm
+			id, secret, ok := ctx.Request.BasicAuth()
+			if !ok {
+				id, secret = f.Get("client_id"), f.Get("client_secret")
+			}
+
+			clientId, err := strconv.ParseUint(id, 10, 64)
+			if err != nil {
+				return c.writeError(ctx, opCtx, http.StatusUnauthorized, errorInvalidClient, "invalid client_id")
+			}
+
+			var t *models.Tokens
+			switch f.Get("grant_type") {
+			case models.GrantTypeAuthorizationCode:
+				t, err = c.oidcManager.ExchangeAuthorizationCode(opCtx, &models.AuthorizationCodeGrant{
+					ClientId:     clientId,
+					ClientSecret: secret,
+					Code:         f.Get("code"),
+					RedirectURI:  f.Get("redirect_uri"),
+					CodeVerifier: f.Get("code_verifier"),
+				})
+			case models.GrantTypeRefreshToken:
+				t, err = c.oidcManager.RefreshToken(opCtx, &models.RefreshTokenGrant{
+					ClientId:     clientId,
+					ClientSecret: secret,
+					RefreshToken: f.Get("refresh_token"),
+					Scopes:       strings.Fields(f.Get("scope")),
+				})
+			default:
+				return c.writeError(ctx, opCtx, http.StatusBadRequest, errorUnsupportedGrantType, "")
+			}
+
+			if err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.Token] issue tokens",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil {
+					switch err2.Code() {
+					case ierrors.ErrorCodeOidcInvalidClient:
+						ctx.Response.Writer.Header().Set("WWW-Authenticate", `Basic realm="oidc"`)
+						return c.writeError(ctx, opCtx, http.StatusUnauthorized, errorInvalidClient, err2.Message())
+					case ierrors.ErrorCodeOidcInvalidGrant:
+						return c.writeError(ctx, opCtx, http.StatusBadRequest, errorInvalidGrant, err2.Message())
+					case ierrors.ErrorCodeOidcInvalidScope:
+						return c.writeError(ctx, opCtx, http.StatusBadRequest, errorInvalidScope, err2.Message())
+					}
+				}
+				c.writeError(ctx, opCtx, http.StatusInternalServerError, errorServerError, "")
+				return false
+			}
+
+			r := &tokenResponse{
+				AccessToken:  t.AccessToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int64(t.ExpiresIn.Seconds()),
+				IdToken:      t.IdToken,
+				RefreshToken: t.RefreshToken,
+				Scope:        strings.Join(t.Scopes, " "),
+			}
+
+			if err := writeJSON(ctx, http.StatusOK, r); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.Token] write JSON",
+				)
+				return false
+			}
+			return true
+		},
+	)
+}
+
+// GetUserInfo gets the claims about the user by the access token passed in the Authorization header.
+//
+//	[GET] /oauth2/userinfo
+func (c *OidcController) GetUserInfo(ctx *server.HttpContext) {
+	c.reqProcessor.Process(ctx, iactions.ActionTypeOidc_GetUserInfo, iactions.OperationTypeOidcController_GetUserInfo,
+		func(opCtx *actions.OperationContext) bool {
+			token, ok := strings.CutPrefix(ctx.Request.Header.Get("Authorization"), "Bearer ")
+			if !ok || len(token) == 0 {
+				ctx.Response.Writer.Header().Set("WWW-Authenticate", `Bearer realm="oidc"`)
+				return c.writeError(ctx, opCtx, http.StatusUnauthorized, errorInvalidRequest, "access token is missing")
+			}
+
+			info, err := c.oidcManager.GetUserInfo(opCtx, token)
+			if err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.GetUserInfo] get user info",
+				)
+
+				if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeOidcInvalidAccessToken {
+					ctx.Response.Writer.Header().Set("WWW-Authenticate", `Bearer realm="oidc", error="invalid_token"`)
+					return c.writeError(ctx, opCtx, http.StatusUnauthorized, errorInvalidToken, err2.Message())
+				}
+				c.writeError(ctx, opCtx, http.StatusInternalServerError, errorServerError, "")
+				return false
+			}
+
+			if err := writeJSON(ctx, http.StatusOK, info); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+					"[oidc.OidcController.GetUserInfo] write JSON",
+				)
+				return false
+			}
+			return true
+		},
+	)
+}
+
+// redirectWithError redirects the user agent to the redirect URI with an error (the authorization error response).
+// It returns true, because the error is an expected result of the authorization request.
+func (c *OidcController) redirectWithError(ctx *server.HttpContext, opCtx *actions.OperationContext, redirectURI, state, errCode, errDescription string) bool {
+	c.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent,
+		"[oidc.OidcController.redirectWithError] authorization request has been rejected",
+		logging.NewField("error", errCode),
+		logging.NewField("errorDescription", errDescription),
+	)
+
+	v := url.Values{}
+	v.Set("error", errCode)
+	if len(errDescription) > 0 {
+		v.Set("error_description", errDescription)
+	}
+	if len(state) > 0 {
+		v.Set("state", state)
+	}
+
+	http.Redirect(ctx.Response.Writer, ctx.Request, appendQuery(redirectURI, v), http.StatusFound)
+	return true
+}
+
+// writeError writes the OAuth 2.0 error response. It returns true if the error has been written.
+func (c *OidcController) writeError(ctx *server.HttpContext, opCtx *actions.OperationContext, statusCode int, errCode, errDescription string) bool {
+	if err := writeJSON(ctx, statusCode, &errorResponse{Error: errCode, ErrorDescription: errDescription}); err != nil {
+		c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_OidcControllerEvent, err,
+			"[oidc.OidcController.writeError] write JSON",
+		)
+		return false
+	}
+	return true
+}
+
+// writeJSON writes the data as is (without the API response envelope), as required by OAuth 2.0 and OpenID Connect.
+func writeJSON(ctx *server.HttpContext, statusCode int, data any) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		_ = apihttp.InternalServerError(ctx)
+		return fmt.Errorf("[oidc.writeJSON] marshal the data to JSON: %w", err)
+	}
+
+	h := ctx.Response.Writer.Header()
+	h.Set("Cache-Control", "no-store")
+	h.Set("Pragma", "no-cache")
+	h.Set("Content-Type", "application/json; charset=UTF-8")
+	h.Set("X-Content-Type-Options", "nosniff")
+	ctx.Response.Writer.WriteHeader(statusCode)
+
+	if _, err := ctx.Response.Writer.Write(b); err != nil {
+		return fmt.Errorf("[oidc.writeJSON] write data: %w", err)
+	}
+	return nil
+}
+
+func appendQuery(uri string, v url.Values) string {
+	if strings.Contains(uri, "?") {
+		return uri + "&" + v.Encode()
+	}
+	return uri + "?" + v.Encode()
+}
@@ -42,4 +42,21 @@ const (
 	ActionGroupGroupRole           actions.ActionGroup = 1016
 	ActionGroupRolePermission      actions.ActionGroup = 1017
 	ActionGroupUserPersonalInfo    actions.ActionGroup = 1018
+	ActionGroupUserCredential      actions.ActionGroup = 1019
+	ActionGroupLockout             actions.ActionGroup = 1020
+	ActionGroupUserMfa             actions.ActionGroup = 1021
+	ActionGroupActiveSession       actions.ActionGroup = 1022
+	ActionGroupOidc                actions.ActionGroup = 1023
+	ActionGroupRegistration        actions.ActionGroup = 1024
+	ActionGroupRoleInheritance     actions.ActionGroup = 1025
+	ActionGroupUserGroupMember     actions.ActionGroup = 1026
+	ActionGroupResourceType        actions.ActionGroup = 1027
+
+	// Resource role assignment action group.
+	ActionGroupResourceRoleAssignment actions.ActionGroup = 1028
+
+	ActionGroupImpersonation      actions.ActionGroup = 1029
+	ActionGroupDataSubjectRequest actions.ActionGroup = 1030
+	ActionGroupProvisioning       actions.ActionGroup = 1031
+	ActionGroupApiKey             actions.ActionGroup = 1032
 )
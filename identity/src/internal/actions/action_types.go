@@ -40,15 +40,24 @@ const (
 	ActionTypeUser_GetGroupAndStatusById actions.ActionType = 11013
 
 	// Client action types (11200-11399).
-	ActionTypeClient_Create             actions.ActionType = 11200
-	ActionTypeClient_CreateWebClient    actions.ActionType = 11201
-	ActionTypeClient_CreateMobileClient actions.ActionType = 11202
-	ActionTypeClient_Delete             actions.ActionType = 11202
-	ActionTypeClient_GetById            actions.ActionType = 11203
-	ActionTypeClient_GetTypeById        actions.ActionType = 11204
-	ActionTypeClient_GetStatusById      actions.ActionType = 11205
+	ActionTypeClient_Create                    actions.ActionType = 11200
+	ActionTypeClient_CreateWebClient           actions.ActionType = 11201
+	ActionTypeClient_CreateMobileClient        actions.ActionType = 11202
+	ActionTypeClient_Delete                    actions.ActionType = 11202
+	ActionTypeClient_GetById                   actions.ActionType = 11203
+	ActionTypeClient_GetTypeById               actions.ActionType = 11204
+	ActionTypeClient_GetStatusById             actions.ActionType = 11205
+	ActionTypeClient_CreateServiceClient       actions.ActionType = 11206
+	ActionTypeClient_RotateServiceClientSecret actions.ActionType = 11207
 
 	// UserGroup action types (11400-11599).
+	ActionTypeUserGroup_Create    actions.ActionType = 11400
+	ActionTypeUserGroup_Update    actions.ActionType = 11401
+	ActionTypeUserGroup_Delete    actions.ActionType = 11402
+	ActionTypeUserGroup_GetById   actions.ActionType = 11403
+	ActionTypeUserGroup_GetByName actions.ActionType = 11404
+	ActionTypeUserGroup_GetAll    actions.ActionType = 11405
+	ActionTypeUserGroup_Exists    actions.ActionType = 11406
 
 	// Role action types (11600-11799).
 	ActionTypeRole_Create        actions.ActionType = 11600
@@ -134,14 +143,19 @@ const (
 	ActionTypeUserAgentSession_GetStatusById               actions.ActionType = 12615
 
 	// Authentication action types (12800-12999).
-	ActionTypeAuthentication_CreateUserToken    actions.ActionType = 12800
-	ActionTypeAuthentication_CreateClientToken  actions.ActionType = 12801
-	ActionTypeAuthentication_Authenticate       actions.ActionType = 12802
-	ActionTypeAuthentication_AuthenticateUser   actions.ActionType = 12803
-	ActionTypeAuthentication_AuthenticateClient actions.ActionType = 12804
+	ActionTypeAuthentication_CreateUserToken           actions.ActionType = 12800
+	ActionTypeAuthentication_CreateClientToken         actions.ActionType = 12801
+	ActionTypeAuthentication_Authenticate              actions.ActionType = 12802
+	ActionTypeAuthentication_AuthenticateUser          actions.ActionType = 12803
+	ActionTypeAuthentication_AuthenticateClient        actions.ActionType = 12804
+	ActionTypeAuthentication_CreateServiceClientToken  actions.ActionType = 12805
+	ActionTypeAuthentication_AuthenticateServiceClient actions.ActionType = 12806
 
 	// Authorization action types (13000-13199).
-	ActionTypeAuthorization_Authorize actions.ActionType = 13000
+	ActionTypeAuthorization_Authorize                           actions.ActionType = 13000
+	ActionTypeAuthorization_GetCacheStats                       actions.ActionType = 13001
+	ActionTypeAuthorization_Explain                             actions.ActionType = 13002
+	ActionTypeAuthorization_GetAllRoleAssignmentsByPermissionId actions.ActionType = 13003
 
 	// Authentication token encryption key action types (13200-13399).
 
@@ -155,6 +169,11 @@ const (
 	ActionTypeRoleAssignment_GetAssigneeTypeById      actions.ActionType = 13406
 	ActionTypeRoleAssignment_GetStatusById            actions.ActionType = 13407
 	ActionTypeRoleAssignment_GetRoleIdAndAssigneeById actions.ActionType = 13408
+	ActionTypeRoleAssignment_Extend                   actions.ActionType = 13409
+	ActionTypeRoleAssignment_Revoke                   actions.ActionType = 13410
+	ActionTypeRoleAssignment_GetAllExpiringWithin     actions.ActionType = 13411
+	ActionTypeRoleAssignment_GetAllExpiredIds         actions.ActionType = 13412
+	ActionTypeRoleAssignment_Expire                   actions.ActionType = 13413
 
 	// UserRoleAssignment action types (13600-13799).
 	ActionTypeUserRoleAssignment_Create                      actions.ActionType = 13600
@@ -199,19 +218,110 @@ const (
 	ActionTypeRolesState_DecrExistingAssignments actions.ActionType = 14405
 
 	// RolePermission action types (14600-14799).
-	ActionTypeRolePermission_Grant                       actions.ActionType = 14600
-	ActionTypeRolePermission_Revoke                      actions.ActionType = 14601
-	ActionTypeRolePermission_RevokeAll                   actions.ActionType = 14602
-	ActionTypeRolePermission_RevokeFromAll               actions.ActionType = 14603
-	ActionTypeRolePermission_Update                      actions.ActionType = 14604
-	ActionTypeRolePermission_IsGranted                   actions.ActionType = 14605
-	ActionTypeRolePermission_AreGranted                  actions.ActionType = 14606
-	ActionTypeRolePermission_GetAllPermissionIdsByRoleId actions.ActionType = 14607
-	ActionTypeRolePermission_GetAllRoleIdsByPermissionId actions.ActionType = 14608
+	ActionTypeRolePermission_Grant                                actions.ActionType = 14600
+	ActionTypeRolePermission_Revoke                               actions.ActionType = 14601
+	ActionTypeRolePermission_RevokeAll                            actions.ActionType = 14602
+	ActionTypeRolePermission_RevokeFromAll                        actions.ActionType = 14603
+	ActionTypeRolePermission_Update                               actions.ActionType = 14604
+	ActionTypeRolePermission_IsGranted                            actions.ActionType = 14605
+	ActionTypeRolePermission_AreGranted                           actions.ActionType = 14606
+	ActionTypeRolePermission_GetAllPermissionIdsByRoleId          actions.ActionType = 14607
+	ActionTypeRolePermission_GetAllRoleIdsByPermissionId          actions.ActionType = 14608
+	ActionTypeRolePermission_GetAllEffectivePermissionIdsByRoleId actions.ActionType = 14609
 
 	// UserPersonalInfo action types (14800-14999).
 	ActionTypeUserPersonalInfo_Create      actions.ActionType = 14800
 	ActionTypeUserPersonalInfo_Delete      actions.ActionType = 14801
 	ActionTypeUserPersonalInfo_GetById     actions.ActionType = 14802
 	ActionTypeUserPersonalInfo_GetByUserId actions.ActionType = 14803
+
+	// UserCredential action types (15000-15199).
+	ActionTypeUserCredential_SetPassword        actions.ActionType = 15000
+	ActionTypeUserCredential_ChangePassword     actions.ActionType = 15001
+	ActionTypeUserCredential_SignInWithPassword actions.ActionType = 15002
+	ActionTypeUserCredential_CompleteSignIn     actions.ActionType = 15003
+
+	// Lockout action types (15200-15399).
+	ActionTypeLockout_GetInfo       actions.ActionType = 15200
+	ActionTypeLockout_Unlock        actions.ActionType = 15201
+	ActionTypeLockout_UnlockExpired actions.ActionType = 15202
+
+	// UserMfa action types (15400-15599).
+	ActionTypeUserMfa_StartTotpEnrollment     actions.ActionType = 15400
+	ActionTypeUserMfa_ConfirmTotpEnrollment   actions.ActionType = 15401
+	ActionTypeUserMfa_DisableTotp             actions.ActionType = 15402
+	ActionTypeUserMfa_RegenerateRecoveryCodes actions.ActionType = 15403
+	ActionTypeUserMfa_GetStatus               actions.ActionType = 15404
+
+	// ActiveSession action types (15800-15999).
+	ActionTypeActiveSession_GetAllByUserId actions.ActionType = 15800
+	ActionTypeActiveSession_Revoke         actions.ActionType = 15801
+	ActionTypeActiveSession_RevokeAllOther actions.ActionType = 15802
+
+	// Oidc action types (16000-16199).
+	ActionTypeOidc_GetProviderMetadata actions.ActionType = 16000
+	ActionTypeOidc_GetJwks             actions.ActionType = 16001
+	ActionTypeOidc_Authorize           actions.ActionType = 16002
+	ActionTypeOidc_Token               actions.ActionType = 16003
+	ActionTypeOidc_GetUserInfo         actions.ActionType = 16004
+
+	// Registration action types (16200-16399).
+	ActionTypeRegistration_Register                actions.ActionType = 16200
+	ActionTypeRegistration_VerifyEmail             actions.ActionType = 16201
+	ActionTypeRegistration_ResendVerificationEmail actions.ActionType = 16202
+	ActionTypeRegistration_Approve                 actions.ActionType = 16203
+
+	// RoleInheritance action types (16400-16599).
+	ActionTypeRoleInheritance_AddParent             actions.ActionType = 16400
+	ActionTypeRoleInheritance_RemoveParent          actions.ActionType = 16401
+	ActionTypeRoleInheritance_GetParentRoleIds      actions.ActionType = 16402
+	ActionTypeRoleInheritance_GetAllAncestorRoleIds actions.ActionType = 16403
+
+	// UserGroupMember action types (16600-16799).
+	ActionTypeUserGroupMember_Add                    actions.ActionType = 16600
+	ActionTypeUserGroupMember_Remove                 actions.ActionType = 16601
+	ActionTypeUserGroupMember_GetAllByGroupId        actions.ActionType = 16602
+	ActionTypeUserGroupMember_GetAllGroupIdsByUserId actions.ActionType = 16603
+	ActionTypeUserGroupMember_IsMember               actions.ActionType = 16604
+
+	// ResourceType action types (16800-16999).
+	ActionTypeResourceType_Register actions.ActionType = 16800
+	ActionTypeResourceType_GetById  actions.ActionType = 16801
+	ActionTypeResourceType_GetAll   actions.ActionType = 16802
+
+	// ResourceRoleAssignment action types (17000-17199).
+	ActionTypeResourceRoleAssignment_Create           actions.ActionType = 17000
+	ActionTypeResourceRoleAssignment_Delete           actions.ActionType = 17001
+	ActionTypeResourceRoleAssignment_GetById          actions.ActionType = 17002
+	ActionTypeResourceRoleAssignment_GetAllByAssignee actions.ActionType = 17003
+
+	// Impersonation action types (17200-17399).
+	ActionTypeImpersonation_CreateToken  actions.ActionType = 17200
+	ActionTypeImpersonation_Authenticate actions.ActionType = 17201
+	ActionTypeImpersonation_RevokeToken  actions.ActionType = 17202
+
+	// DataSubjectRequest action types (17400-17599).
+	ActionTypeDataSubjectRequest_CreateExport       actions.ActionType = 17400
+	ActionTypeDataSubjectRequest_CreateErasure      actions.ActionType = 17401
+	ActionTypeDataSubjectRequest_GetById            actions.ActionType = 17402
+	ActionTypeDataSubjectRequest_GetAllByUserId     actions.ActionType = 17403
+	ActionTypeDataSubjectRequest_GetExportData      actions.ActionType = 17404
+	ActionTypeDataSubjectRequest_GetAllIdsToProcess actions.ActionType = 17405
+	ActionTypeDataSubjectRequest_ProcessStep        actions.ActionType = 17406
+	ActionTypeDataSubjectRequest_GetAllSteps        actions.ActionType = 17407
+
+	// Provisioning action types (17600-17799).
+	ActionTypeProvisioning_Plan  actions.ActionType = 17600
+	ActionTypeProvisioning_Apply actions.ActionType = 17601
+
+	// ApiKey action types (17800-17999).
+	ActionTypeApiKey_CreatePersonal   actions.ActionType = 17800
+	ActionTypeApiKey_CreateService    actions.ActionType = 17801
+	ActionTypeApiKey_Revoke           actions.ActionType = 17802
+	ActionTypeApiKey_RevokePersonal   actions.ActionType = 17803
+	ActionTypeApiKey_GetAllPersonal   actions.ActionType = 17804
+	ActionTypeApiKey_GetAllByUserId   actions.ActionType = 17805
+	ActionTypeApiKey_GetAllByClientId actions.ActionType = 17806
+	ActionTypeApiKey_Authenticate     actions.ActionType = 17807
+	ActionTypeApiKey_AuthenticateById actions.ActionType = 17808
 )
@@ -35,11 +35,31 @@ const (
 	// Authentication token encryption key operation group.
 	OperationGroupAuthnTokenEncryptionKey actions.OperationGroup = 1011
 
-	OperationGroupRoleAssignment      actions.OperationGroup = 1012
-	OperationGroupUserRoleAssignment  actions.OperationGroup = 1013
-	OperationGroupGroupRoleAssignment actions.OperationGroup = 1014
-	OperationGroupUserRole            actions.OperationGroup = 1015
-	OperationGroupGroupRole           actions.OperationGroup = 1016
-	OperationGroupRolePermission      actions.OperationGroup = 1017
-	OperationGroupUserPersonalInfo    actions.OperationGroup = 1018
+	OperationGroupRoleAssignment       actions.OperationGroup = 1012
+	OperationGroupUserRoleAssignment   actions.OperationGroup = 1013
+	OperationGroupGroupRoleAssignment  actions.OperationGroup = 1014
+	OperationGroupUserRole             actions.OperationGroup = 1015
+	OperationGroupGroupRole            actions.OperationGroup = 1016
+	OperationGroupRolePermission       actions.OperationGroup = 1017
+	OperationGroupUserPersonalInfo     actions.OperationGroup = 1018
+	OperationGroupAuthorizationCache   actions.OperationGroup = 1019
+	OperationGroupUserCredential       actions.OperationGroup = 1020
+	OperationGroupLockout              actions.OperationGroup = 1021
+	OperationGroupUserMfa              actions.OperationGroup = 1022
+	OperationGroupServiceClient        actions.OperationGroup = 1023
+	OperationGroupClientRoleAssignment actions.OperationGroup = 1024
+	OperationGroupActiveSession        actions.OperationGroup = 1025
+	OperationGroupOidc                 actions.OperationGroup = 1026
+	OperationGroupRegistration         actions.OperationGroup = 1027
+	OperationGroupRoleInheritance      actions.OperationGroup = 1028
+	OperationGroupUserGroupMember      actions.OperationGroup = 1029
+	OperationGroupResourceType         actions.OperationGroup = 1030
+
+	// Resource role assignment operation group.
+	OperationGroupResourceRoleAssignment actions.OperationGroup = 1031
+
+	OperationGroupImpersonation      actions.OperationGroup = 1032
+	OperationGroupDataSubjectRequest actions.OperationGroup = 1033
+	OperationGroupProvisioning       actions.OperationGroup = 1034
+	OperationGroupApiKey             actions.OperationGroup = 1035
 )
@@ -38,16 +38,28 @@ const (
 	OperationTypeUserManager_GetStatusById         actions.OperationType = 11011
 	OperationTypeUserManager_GetTypeAndStatusById  actions.OperationType = 11012
 	OperationTypeUserManager_GetGroupAndStatusById actions.OperationType = 11013
+	OperationTypeUserManager_Anonymize             actions.OperationType = 11014
 
 	// ClientManager operation types (11200-11399).
-	OperationTypeClientManager_Create             actions.OperationType = 11200
-	OperationTypeClientManager_CreateWebClient    actions.OperationType = 11201
-	OperationTypeClientManager_CreateMobileClient actions.OperationType = 11202
-	OperationTypeClientManager_Delete             actions.OperationType = 11202
-	OperationTypeClientManager_FindById           actions.OperationType = 11203
-	OperationTypeClientManager_GetStatusById      actions.OperationType = 11204
+	OperationTypeClientManager_Create                    actions.OperationType = 11200
+	OperationTypeClientManager_CreateWebClient           actions.OperationType = 11201
+	OperationTypeClientManager_CreateMobileClient        actions.OperationType = 11202
+	OperationTypeClientManager_Delete                    actions.OperationType = 11202
+	OperationTypeClientManager_FindById                  actions.OperationType = 11203
+	OperationTypeClientManager_GetStatusById             actions.OperationType = 11204
+	OperationTypeClientManager_CreateServiceClient       actions.OperationType = 11205
+	OperationTypeClientManager_RotateServiceClientSecret actions.OperationType = 11206
+	OperationTypeClientManager_VerifyServiceClientSecret actions.OperationType = 11207
 
 	// UserGroupManager operation types (11400-11499).
+	OperationTypeUserGroupManager_Create     actions.OperationType = 11400
+	OperationTypeUserGroupManager_Update     actions.OperationType = 11401
+	OperationTypeUserGroupManager_Delete     actions.OperationType = 11402
+	OperationTypeUserGroupManager_FindById   actions.OperationType = 11403
+	OperationTypeUserGroupManager_FindByName actions.OperationType = 11404
+	OperationTypeUserGroupManager_GetAll     actions.OperationType = 11405
+	OperationTypeUserGroupManager_Exists     actions.OperationType = 11406
+	OperationTypeUserGroupManager_ExistsById actions.OperationType = 11407
 
 	// RoleManager operation types (11500-11599).
 	OperationTypeRoleManager_Create        actions.OperationType = 11500
@@ -140,7 +152,9 @@ const (
 	OperationTypeAuthenticationManager_AuthenticateClient actions.OperationType = 12404
 
 	// AuthorizationManager operation types (12500-12599).
-	OperationTypeAuthorizationManager_Authorize actions.OperationType = 12500
+	OperationTypeAuthorizationManager_Authorize                           actions.OperationType = 12500
+	OperationTypeAuthorizationManager_Explain                             actions.OperationType = 12501
+	OperationTypeAuthorizationManager_GetAllRoleAssignmentsByPermissionId actions.OperationType = 12502
 
 	// Authentication TokenEncryptionKeyManager operation types (12600-12699).
 	OperationTypeAuthnTokenEncryptionKeyManager_FindById                         actions.OperationType = 12600
@@ -160,34 +174,46 @@ const (
 	OperationTypeRoleAssignmentManager_GetAssigneeTypeById      actions.OperationType = 12706
 	OperationTypeRoleAssignmentManager_GetStatusById            actions.OperationType = 12707
 	OperationTypeRoleAssignmentManager_GetRoleIdAndAssigneeById actions.OperationType = 12708
+	OperationTypeRoleAssignmentManager_Extend                   actions.OperationType = 12709
+	OperationTypeRoleAssignmentManager_Revoke                   actions.OperationType = 12710
+	OperationTypeRoleAssignmentManager_Expire                   actions.OperationType = 12711
+	OperationTypeRoleAssignmentManager_GetAllExpiredIds         actions.OperationType = 12712
+	OperationTypeRoleAssignmentManager_GetAllExpiringWithin     actions.OperationType = 12713
+	OperationTypeRoleAssignmentManager_GetAllActiveByRoleIds    actions.OperationType = 12714
 
 	// UserRoleAssignmentManager operation types (12800-12899).
-	OperationTypeUserRoleAssignmentManager_Create                      actions.OperationType = 12800
-	OperationTypeUserRoleAssignmentManager_Delete                      actions.OperationType = 12801
-	OperationTypeUserRoleAssignmentManager_DeleteByRoleAssignmentId    actions.OperationType = 12802
-	OperationTypeUserRoleAssignmentManager_FindById                    actions.OperationType = 12803
-	OperationTypeUserRoleAssignmentManager_FindByRoleAssignmentId      actions.OperationType = 12804
-	OperationTypeUserRoleAssignmentManager_GetAllByUserId              actions.OperationType = 12805
-	OperationTypeUserRoleAssignmentManager_Exists                      actions.OperationType = 12806
-	OperationTypeUserRoleAssignmentManager_IsAssigned                  actions.OperationType = 12807
-	OperationTypeUserRoleAssignmentManager_GetIdByRoleAssignmentId     actions.OperationType = 12808
-	OperationTypeUserRoleAssignmentManager_GetStatusById               actions.OperationType = 12809
-	OperationTypeUserRoleAssignmentManager_GetStatusByRoleAssignmentId actions.OperationType = 12810
-	OperationTypeUserRoleAssignmentManager_GetUserRoleIdsByUserId      actions.OperationType = 12811
+	OperationTypeUserRoleAssignmentManager_Create                             actions.OperationType = 12800
+	OperationTypeUserRoleAssignmentManager_Delete                             actions.OperationType = 12801
+	OperationTypeUserRoleAssignmentManager_DeleteByRoleAssignmentId           actions.OperationType = 12802
+	OperationTypeUserRoleAssignmentManager_FindById                           actions.OperationType = 12803
+	OperationTypeUserRoleAssignmentManager_FindByRoleAssignmentId             actions.OperationType = 12804
+	OperationTypeUserRoleAssignmentManager_GetAllByUserId                     actions.OperationType = 12805
+	OperationTypeUserRoleAssignmentManager_Exists                             actions.OperationType = 12806
+	OperationTypeUserRoleAssignmentManager_IsAssigned                         actions.OperationType = 12807
+	OperationTypeUserRoleAssignmentManager_GetIdByRoleAssignmentId            actions.OperationType = 12808
+	OperationTypeUserRoleAssignmentManager_GetStatusById                      actions.OperationType = 12809
+	OperationTypeUserRoleAssignmentManager_GetStatusByRoleAssignmentId        actions.OperationType = 12810
+	OperationTypeUserRoleAssignmentManager_GetUserRoleIdsByUserId             actions.OperationType = 12811
+	OperationTypeUserRoleAssignmentManager_UpdateValidUntilByRoleAssignmentId actions.OperationType = 12812
+	OperationTypeUserRoleAssignmentManager_DeactivateByRoleAssignmentId       actions.OperationType = 12813
+	OperationTypeUserRoleAssignmentManager_GetNextRoleChangeTimeByUserId      actions.OperationType = 12814
 
 	// GroupRoleAssignmentManager operation types (12900-12999).
-	OperationTypeGroupRoleAssignmentManager_Create                      actions.OperationType = 12900
-	OperationTypeGroupRoleAssignmentManager_Delete                      actions.OperationType = 12901
-	OperationTypeGroupRoleAssignmentManager_DeleteByRoleAssignmentId    actions.OperationType = 12902
-	OperationTypeGroupRoleAssignmentManager_FindById                    actions.OperationType = 12903
-	OperationTypeGroupRoleAssignmentManager_FindByRoleAssignmentId      actions.OperationType = 12904
-	OperationTypeGroupRoleAssignmentManager_GetAllByGroup               actions.OperationType = 12905
-	OperationTypeGroupRoleAssignmentManager_Exists                      actions.OperationType = 12906
-	OperationTypeGroupRoleAssignmentManager_IsAssigned                  actions.OperationType = 12907
-	OperationTypeGroupRoleAssignmentManager_GetIdByRoleAssignmentId     actions.OperationType = 12908
-	OperationTypeGroupRoleAssignmentManager_GetStatusById               actions.OperationType = 12909
-	OperationTypeGroupRoleAssignmentManager_GetStatusByRoleAssignmentId actions.OperationType = 12910
-	OperationTypeGroupRoleAssignmentManager_GetGroupRoleIdsByGroup      actions.OperationType = 12911
+	OperationTypeGroupRoleAssignmentManager_Create                             actions.OperationType = 12900
+	OperationTypeGroupRoleAssignmentManager_Delete                             actions.OperationType = 12901
+	OperationTypeGroupRoleAssignmentManager_DeleteByRoleAssignmentId           actions.OperationType = 12902
+	OperationTypeGroupRoleAssignmentManager_FindById                           actions.OperationType = 12903
+	OperationTypeGroupRoleAssignmentManager_FindByRoleAssignmentId             actions.OperationType = 12904
+	OperationTypeGroupRoleAssignmentManager_GetAllByGroup                      actions.OperationType = 12905
+	OperationTypeGroupRoleAssignmentManager_Exists                             actions.OperationType = 12906
+	OperationTypeGroupRoleAssignmentManager_IsAssigned                         actions.OperationType = 12907
+	OperationTypeGroupRoleAssignmentManager_GetIdByRoleAssignmentId            actions.OperationType = 12908
+	OperationTypeGroupRoleAssignmentManager_GetStatusById                      actions.OperationType = 12909
+	OperationTypeGroupRoleAssignmentManager_GetStatusByRoleAssignmentId        actions.OperationType = 12910
+	OperationTypeGroupRoleAssignmentManager_GetGroupRoleIdsByGroup             actions.OperationType = 12911
+	OperationTypeGroupRoleAssignmentManager_UpdateValidUntilByRoleAssignmentId actions.OperationType = 12912
+	OperationTypeGroupRoleAssignmentManager_DeactivateByRoleAssignmentId       actions.OperationType = 12913
+	OperationTypeGroupRoleAssignmentManager_GetNextRoleChangeTimeByGroup       actions.OperationType = 12914
 
 	// UserRoleManager operation types (13000-13099).
 	OperationTypeUserRoleManager_GetAllRolesByUserId actions.OperationType = 13000
@@ -204,15 +230,16 @@ const (
 	OperationTypeRolesState_DecrExistingAssignments actions.OperationType = 13205
 
 	// RolePermissionManager operation types (13300-13399).
-	OperationTypeRolePermissionManager_Grant                       actions.OperationType = 13300
-	OperationTypeRolePermissionManager_Revoke                      actions.OperationType = 13301
-	OperationTypeRolePermissionManager_RevokeAll                   actions.OperationType = 13302
-	OperationTypeRolePermissionManager_RevokeFromAll               actions.OperationType = 13303
-	OperationTypeRolePermissionManager_Update                      actions.OperationType = 13304
-	OperationTypeRolePermissionManager_IsGranted                   actions.OperationType = 13305
-	OperationTypeRolePermissionManager_AreGranted                  actions.OperationType = 13306
-	OperationTypeRolePermissionManager_GetAllPermissionIdsByRoleId actions.OperationType = 13307
-	OperationTypeRolePermissionManager_GetAllRoleIdsByPermissionId actions.OperationType = 13308
+	OperationTypeRolePermissionManager_Grant                                actions.OperationType = 13300
+	OperationTypeRolePermissionManager_Revoke                               actions.OperationType = 13301
+	OperationTypeRolePermissionManager_RevokeAll                            actions.OperationType = 13302
+	OperationTypeRolePermissionManager_RevokeFromAll                        actions.OperationType = 13303
+	OperationTypeRolePermissionManager_Update                               actions.OperationType = 13304
+	OperationTypeRolePermissionManager_IsGranted                            actions.OperationType = 13305
+	OperationTypeRolePermissionManager_AreGranted                           actions.OperationType = 13306
+	OperationTypeRolePermissionManager_GetAllPermissionIdsByRoleId          actions.OperationType = 13307
+	OperationTypeRolePermissionManager_GetAllRoleIdsByPermissionId          actions.OperationType = 13308
+	OperationTypeRolePermissionManager_GetAllEffectivePermissionIdsByRoleId actions.OperationType = 13309
 
 	// UserPersonalInfoManager operation types (13400-13499).
 	OperationTypeUserPersonalInfoManager_Create      actions.OperationType = 13400
@@ -220,6 +247,140 @@ const (
 	OperationTypeUserPersonalInfoManager_FindById    actions.OperationType = 13402
 	OperationTypeUserPersonalInfoManager_GetByUserId actions.OperationType = 13403
 
+	// UserCredentialManager operation types (13500-13599).
+	OperationTypeUserCredentialManager_SetPassword    actions.OperationType = 13500
+	OperationTypeUserCredentialManager_ChangePassword actions.OperationType = 13501
+	OperationTypeUserCredentialManager_VerifyPassword actions.OperationType = 13502
+
+	// SignInManager operation types (13600-13699).
+	OperationTypeSignInManager_SignInWithPassword actions.OperationType = 13600
+	OperationTypeSignInManager_CompleteSignIn     actions.OperationType = 13601
+
+	// LockoutManager operation types (13700-13799).
+	OperationTypeLockoutManager_RegisterFailedAttempt actions.OperationType = 13700
+	OperationTypeLockoutManager_ResetFailedAttempts   actions.OperationType = 13701
+	OperationTypeLockoutManager_Unlock                actions.OperationType = 13702
+	OperationTypeLockoutManager_UnlockIfExpired       actions.OperationType = 13703
+	OperationTypeLockoutManager_GetInfo               actions.OperationType = 13704
+	OperationTypeLockoutManager_UnlockAllExpired      actions.OperationType = 13705
+
+	// UnlockService operation types (13800-13899).
+	OperationTypeUnlockService_UnlockAllExpired actions.OperationType = 13800
+
+	// UserMfaManager operation types (13900-13999).
+	OperationTypeUserMfaManager_StartTotpEnrollment     actions.OperationType = 13900
+	OperationTypeUserMfaManager_ConfirmTotpEnrollment   actions.OperationType = 13901
+	OperationTypeUserMfaManager_DisableTotp             actions.OperationType = 13902
+	OperationTypeUserMfaManager_RegenerateRecoveryCodes actions.OperationType = 13903
+	OperationTypeUserMfaManager_GetStatus               actions.OperationType = 13904
+	OperationTypeUserMfaManager_IsRequired              actions.OperationType = 13905
+	OperationTypeUserMfaManager_Verify                  actions.OperationType = 13906
+	OperationTypeUserMfaManager_IsTotpEnabled           actions.OperationType = 13907
+
+	// MfaChallengeManager operation types (14000-14099).
+	OperationTypeMfaChallengeManager_Create      actions.OperationType = 14000
+	OperationTypeMfaChallengeManager_Verify      actions.OperationType = 14001
+	OperationTypeMfaChallengeManager_FindByToken actions.OperationType = 14002
+
+	// ServiceClientTokenManager operation types (14100-14199).
+	OperationTypeServiceClientTokenManager_CreateToken  actions.OperationType = 14100
+	OperationTypeServiceClientTokenManager_Authenticate actions.OperationType = 14101
+
+	// ClientRoleAssignmentManager operation types (14200-14299).
+	OperationTypeClientRoleAssignmentManager_Create                      actions.OperationType = 14200
+	OperationTypeClientRoleAssignmentManager_Delete                      actions.OperationType = 14201
+	OperationTypeClientRoleAssignmentManager_DeleteByRoleAssignmentId    actions.OperationType = 14202
+	OperationTypeClientRoleAssignmentManager_FindById                    actions.OperationType = 14203
+	OperationTypeClientRoleAssignmentManager_FindByRoleAssignmentId      actions.OperationType = 14204
+	OperationTypeClientRoleAssignmentManager_GetAllByClientId            actions.OperationType = 14205
+	OperationTypeClientRoleAssignmentManager_Exists                      actions.OperationType = 14206
+	OperationTypeClientRoleAssignmentManager_IsAssigned                  actions.OperationType = 14207
+	OperationTypeClientRoleAssignmentManager_GetIdByRoleAssignmentId     actions.OperationType = 14208
+	OperationTypeClientRoleAssignmentManager_GetStatusById               actions.OperationType = 14209
+	OperationTypeClientRoleAssignmentManager_GetStatusByRoleAssignmentId actions.OperationType = 14210
+	OperationTypeClientRoleAssignmentManager_GetClientRoleIdsByClientId  actions.OperationType = 14211
+
+	// ActiveSessionManager operation types (14300-14399).
+	OperationTypeActiveSessionManager_GetAllByUserId actions.OperationType = 14300
+	OperationTypeActiveSessionManager_Revoke         actions.OperationType = 14301
+	OperationTypeActiveSessionManager_RevokeAllOther actions.OperationType = 14302
+	OperationTypeActiveSessionManager_IsRevoked      actions.OperationType = 14303
+
+	// OidcManager operation types (14400-14499).
+	OperationTypeOidcManager_Authorize                 actions.OperationType = 14400
+	OperationTypeOidcManager_ExchangeAuthorizationCode actions.OperationType = 14401
+	OperationTypeOidcManager_RefreshToken              actions.OperationType = 14402
+	OperationTypeOidcManager_GetUserInfo               actions.OperationType = 14403
+	OperationTypeOidcManager_ValidateRedirectURI       actions.OperationType = 14404
+
+	// RegistrationManager operation types (14500-14599).
+	OperationTypeRegistrationManager_Register                actions.OperationType = 14500
+	OperationTypeRegistrationManager_VerifyEmail             actions.OperationType = 14501
+	OperationTypeRegistrationManager_ResendVerificationEmail actions.OperationType = 14502
+	OperationTypeRegistrationManager_Approve                 actions.OperationType = 14503
+
+	// RoleInheritanceManager operation types (14600-14699).
+	OperationTypeRoleInheritanceManager_AddParent               actions.OperationType = 14600
+	OperationTypeRoleInheritanceManager_RemoveParent            actions.OperationType = 14601
+	OperationTypeRoleInheritanceManager_GetParentRoleIds        actions.OperationType = 14602
+	OperationTypeRoleInheritanceManager_GetAllAncestorRoleIds   actions.OperationType = 14603
+	OperationTypeRoleInheritanceManager_GetAllDescendantRoleIds actions.OperationType = 14604
+
+	// RoleAssignmentExpirationService operation types (14700-14799).
+	OperationTypeRoleAssignmentExpirationService_GetAllExpiredIds actions.OperationType = 14700
+	OperationTypeRoleAssignmentExpirationService_Expire           actions.OperationType = 14701
+
+	// UserGroupMemberManager operation types (14800-14899).
+	OperationTypeUserGroupMemberManager_Add                    actions.OperationType = 14800
+	OperationTypeUserGroupMemberManager_Remove                 actions.OperationType = 14801
+	OperationTypeUserGroupMemberManager_GetAllByGroupId        actions.OperationType = 14802
+	OperationTypeUserGroupMemberManager_GetAllGroupIdsByUserId actions.OperationType = 14803
+	OperationTypeUserGroupMemberManager_IsMember               actions.OperationType = 14804
+
+	// ResourceTypeManager operation types (14900-14999).
+	OperationTypeResourceTypeManager_Register actions.OperationType = 14900
+	OperationTypeResourceTypeManager_FindById actions.OperationType = 14901
+	OperationTypeResourceTypeManager_GetAll   actions.OperationType = 14902
+
+	// ResourceRoleAssignmentManager operation types (15000-15099).
+	OperationTypeResourceRoleAssignmentManager_Create           actions.OperationType = 15000
+	OperationTypeResourceRoleAssignmentManager_Delete           actions.OperationType = 15001
+	OperationTypeResourceRoleAssignmentManager_FindById         actions.OperationType = 15002
+	OperationTypeResourceRoleAssignmentManager_GetAllByAssignee actions.OperationType = 15003
+
+	// ImpersonationManager operation types (15100-15199).
+	OperationTypeImpersonationManager_CreateToken  actions.OperationType = 15100
+	OperationTypeImpersonationManager_Authenticate actions.OperationType = 15101
+	OperationTypeImpersonationManager_RevokeToken  actions.OperationType = 15102
+
+	// DataSubjectRequestManager operation types (15200-15299).
+	OperationTypeDataSubjectRequestManager_CreateExport           actions.OperationType = 15200
+	OperationTypeDataSubjectRequestManager_CreateErasure          actions.OperationType = 15201
+	OperationTypeDataSubjectRequestManager_FindById               actions.OperationType = 15202
+	OperationTypeDataSubjectRequestManager_GetAllByUserId         actions.OperationType = 15203
+	OperationTypeDataSubjectRequestManager_GetAllStepsByRequestId actions.OperationType = 15204
+	OperationTypeDataSubjectRequestManager_GetExportData          actions.OperationType = 15205
+	OperationTypeDataSubjectRequestManager_GetAllIdsToProcess     actions.OperationType = 15206
+	OperationTypeDataSubjectRequestManager_ProcessStep            actions.OperationType = 15207
+
+	// DataSubjectRequestProcessingService operation types (15300-15399).
+	OperationTypeDataSubjectRequestProcessingService_GetAllIdsToProcess     actions.OperationType = 15300
+	OperationTypeDataSubjectRequestProcessingService_GetAllStepsByRequestId actions.OperationType = 15301
+	OperationTypeDataSubjectRequestProcessingService_ProcessStep            actions.OperationType = 15302
+
+	// ProvisioningManager operation types (15400-15499).
+	OperationTypeProvisioningManager_Plan  actions.OperationType = 15400
+	OperationTypeProvisioningManager_Apply actions.OperationType = 15401
+
+	// ApiKeyManager operation types (15500-15599).
+	OperationTypeApiKeyManager_Create           actions.OperationType = 15500
+	OperationTypeApiKeyManager_Revoke           actions.OperationType = 15501
+	OperationTypeApiKeyManager_RevokePersonal   actions.OperationType = 15502
+	OperationTypeApiKeyManager_GetAllByUserId   actions.OperationType = 15503
+	OperationTypeApiKeyManager_GetAllByClientId actions.OperationType = 15504
+	OperationTypeApiKeyManager_Authenticate     actions.OperationType = 15505
+	OperationTypeApiKeyManager_AuthenticateById actions.OperationType = 15506
+
 	// UserStore operation types (31000-31199).
 	OperationTypeUserStore_Create                actions.OperationType = 31000
 	OperationTypeUserStore_StartDeleting         actions.OperationType = 31001
@@ -236,6 +397,7 @@ const (
 	OperationTypeUserStore_GetStatusById         actions.OperationType = 31012
 	OperationTypeUserStore_GetTypeAndStatusById  actions.OperationType = 31013
 	OperationTypeUserStore_GetGroupAndStatusById actions.OperationType = 31014
+	OperationTypeUserStore_Anonymize             actions.OperationType = 31015
 
 	// ClientStore operation types (31200-31399).
 	OperationTypeClientStore_Create        actions.OperationType = 31200
@@ -259,6 +421,14 @@ const (
 	OperationTypeMobileClientStore_GetStatusById actions.OperationType = 31604
 
 	// UserGroupStore operation types (31800-31899).
+	OperationTypeUserGroupStore_Create     actions.OperationType = 31800
+	OperationTypeUserGroupStore_Update     actions.OperationType = 31801
+	OperationTypeUserGroupStore_Delete     actions.OperationType = 31802
+	OperationTypeUserGroupStore_FindById   actions.OperationType = 31803
+	OperationTypeUserGroupStore_FindByName actions.OperationType = 31804
+	OperationTypeUserGroupStore_GetAll     actions.OperationType = 31805
+	OperationTypeUserGroupStore_Exists     actions.OperationType = 31806
+	OperationTypeUserGroupStore_ExistsById actions.OperationType = 31807
 
 	// RoleStore operation types (31900-31999).
 	OperationTypeRoleStore_Create        actions.OperationType = 31900
@@ -458,36 +628,48 @@ const (
 	OperationTypeRoleAssignmentStore_GetAssigneeTypeById      actions.OperationType = 34307
 	OperationTypeRoleAssignmentStore_GetStatusById            actions.OperationType = 34308
 	OperationTypeRoleAssignmentStore_GetRoleIdAndAssigneeById actions.OperationType = 34309
+	OperationTypeRoleAssignmentStore_Extend                   actions.OperationType = 34310
+	OperationTypeRoleAssignmentStore_Revoke                   actions.OperationType = 34311
+	OperationTypeRoleAssignmentStore_Expire                   actions.OperationType = 34312
+	OperationTypeRoleAssignmentStore_GetAllExpiredIds         actions.OperationType = 34313
+	OperationTypeRoleAssignmentStore_GetAllExpiringBefore     actions.OperationType = 34314
+	OperationTypeRoleAssignmentStore_GetAllActiveByRoleIds    actions.OperationType = 34315
 
 	// UserRoleAssignmentStore operation types (34400-34499).
-	OperationTypeUserRoleAssignmentStore_Create                      actions.OperationType = 34400
-	OperationTypeUserRoleAssignmentStore_StartDeleting               actions.OperationType = 34401
-	OperationTypeUserRoleAssignmentStore_Delete                      actions.OperationType = 34402
-	OperationTypeUserRoleAssignmentStore_DeleteByRoleAssignmentId    actions.OperationType = 34403
-	OperationTypeUserRoleAssignmentStore_FindById                    actions.OperationType = 34404
-	OperationTypeUserRoleAssignmentStore_FindByRoleAssignmentId      actions.OperationType = 34405
-	OperationTypeUserRoleAssignmentStore_GetAllByUserId              actions.OperationType = 34406
-	OperationTypeUserRoleAssignmentStore_Exists                      actions.OperationType = 34407
-	OperationTypeUserRoleAssignmentStore_IsAssigned                  actions.OperationType = 34408
-	OperationTypeUserRoleAssignmentStore_GetIdByRoleAssignmentId     actions.OperationType = 34409
-	OperationTypeUserRoleAssignmentStore_GetStatusById               actions.OperationType = 34410
-	OperationTypeUserRoleAssignmentStore_GetStatusByRoleAssignmentId actions.OperationType = 34411
-	OperationTypeUserRoleAssignmentStore_GetUserRoleIdsByUserId      actions.OperationType = 34412
+	OperationTypeUserRoleAssignmentStore_Create                             actions.OperationType = 34400
+	OperationTypeUserRoleAssignmentStore_StartDeleting                      actions.OperationType = 34401
+	OperationTypeUserRoleAssignmentStore_Delete                             actions.OperationType = 34402
+	OperationTypeUserRoleAssignmentStore_DeleteByRoleAssignmentId           actions.OperationType = 34403
+	OperationTypeUserRoleAssignmentStore_FindById                           actions.OperationType = 34404
+	OperationTypeUserRoleAssignmentStore_FindByRoleAssignmentId             actions.OperationType = 34405
+	OperationTypeUserRoleAssignmentStore_GetAllByUserId                     actions.OperationType = 34406
+	OperationTypeUserRoleAssignmentStore_Exists                             actions.OperationType = 34407
+	OperationTypeUserRoleAssignmentStore_IsAssigned                         actions.OperationType = 34408
+	OperationTypeUserRoleAssignmentStore_GetIdByRoleAssignmentId            actions.OperationType = 34409
+	OperationTypeUserRoleAssignmentStore_GetStatusById                      actions.OperationType = 34410
+	OperationTypeUserRoleAssignmentStore_GetStatusByRoleAssignmentId        actions.OperationType = 34411
+	OperationTypeUserRoleAssignmentStore_GetUserRoleIdsByUserId             actions.OperationType = 34412
+	OperationTypeUserRoleAssignmentStore_UpdateValidUntilByRoleAssignmentId actions.OperationType = 34413
+	OperationTypeUserRoleAssignmentStore_DeactivateByRoleAssignmentId       actions.OperationType = 34414
+	OperationTypeUserRoleAssignmentStore_GetNextRoleChangeTimeByUserId      actions.OperationType = 34415
 
 	// GroupRoleAssignmentStore operation types (34500-34599).
-	OperationTypeGroupRoleAssignmentStore_Create                      actions.OperationType = 34500
-	OperationTypeGroupRoleAssignmentStore_StartDeleting               actions.OperationType = 34501
-	OperationTypeGroupRoleAssignmentStore_Delete                      actions.OperationType = 34502
-	OperationTypeGroupRoleAssignmentStore_DeleteByRoleAssignmentId    actions.OperationType = 34503
-	OperationTypeGroupRoleAssignmentStore_FindById                    actions.OperationType = 34504
-	OperationTypeGroupRoleAssignmentStore_FindByRoleAssignmentId      actions.OperationType = 34505
-	OperationTypeGroupRoleAssignmentStore_GetAllByGroup               actions.OperationType = 34506
-	OperationTypeGroupRoleAssignmentStore_Exists                      actions.OperationType = 34507
-	OperationTypeGroupRoleAssignmentStore_IsAssigned                  actions.OperationType = 34508
-	OperationTypeGroupRoleAssignmentStore_GetIdByRoleAssignmentId     actions.OperationType = 34509
-	OperationTypeGroupRoleAssignmentStore_GetStatusById               actions.OperationType = 34510
-	OperationTypeGroupRoleAssignmentStore_GetStatusByRoleAssignmentId actions.OperationType = 34511
-	OperationTypeGroupRoleAssignmentStore_GetGroupRoleIdsByGroup      actions.OperationType = 34512
+	OperationTypeGroupRoleAssignmentStore_Create                             actions.OperationType = 34500
+	OperationTypeGroupRoleAssignmentStore_StartDeleting                      actions.OperationType = 34501
+	OperationTypeGroupRoleAssignmentStore_Delete                             actions.OperationType = 34502
+	OperationTypeGroupRoleAssignmentStore_DeleteByRoleAssignmentId           actions.OperationType = 34503
+	OperationTypeGroupRoleAssignmentStore_FindById                           actions.OperationType = 34504
+	OperationTypeGroupRoleAssignmentStore_FindByRoleAssignmentId             actions.OperationType = 34505
+	OperationTypeGroupRoleAssignmentStore_GetAllByGroup                      actions.OperationType = 34506
+	OperationTypeGroupRoleAssignmentStore_Exists                             actions.OperationType = 34507
+	OperationTypeGroupRoleAssignmentStore_IsAssigned                         actions.OperationType = 34508
+	OperationTypeGroupRoleAssignmentStore_GetIdByRoleAssignmentId            actions.OperationType = 34509
+	OperationTypeGroupRoleAssignmentStore_GetStatusById                      actions.OperationType = 34510
+	OperationTypeGroupRoleAssignmentStore_GetStatusByRoleAssignmentId        actions.OperationType = 34511
+	OperationTypeGroupRoleAssignmentStore_GetGroupRoleIdsByGroup             actions.OperationType = 34512
+	OperationTypeGroupRoleAssignmentStore_UpdateValidUntilByRoleAssignmentId actions.OperationType = 34513
+	OperationTypeGroupRoleAssignmentStore_DeactivateByRoleAssignmentId       actions.OperationType = 34514
+	OperationTypeGroupRoleAssignmentStore_GetNextRoleChangeTimeByGroup       actions.OperationType = 34515
 
 	// UserRoleStore operation types (34600-34699).
 	// GroupRoleStore operation types (34700-34799).
@@ -501,15 +683,16 @@ const (
 	OperationTypeRolesStateStore_DecrExistingAssignments actions.OperationType = 34805
 
 	// RolePermissionStore operation types (34900-34999).
-	OperationTypeRolePermissionStore_Grant                       actions.OperationType = 34900
-	OperationTypeRolePermissionStore_Revoke                      actions.OperationType = 34901
-	OperationTypeRolePermissionStore_RevokeAll                   actions.OperationType = 34902
-	OperationTypeRolePermissionStore_RevokeFromAll               actions.OperationType = 34903
-	OperationTypeRolePermissionStore_Update                      actions.OperationType = 34904
-	OperationTypeRolePermissionStore_IsGranted                   actions.OperationType = 34905
-	OperationTypeRolePermissionStore_AreGranted                  actions.OperationType = 34906
-	OperationTypeRolePermissionStore_GetAllPermissionIdsByRoleId actions.OperationType = 34907
-	OperationTypeRolePermissionStore_GetAllRoleIdsByPermissionId actions.OperationType = 34908
+	OperationTypeRolePermissionStore_Grant                        actions.OperationType = 34900
+	OperationTypeRolePermissionStore_Revoke                       actions.OperationType = 34901
+	OperationTypeRolePermissionStore_RevokeAll                    actions.OperationType = 34902
+	OperationTypeRolePermissionStore_RevokeFromAll                actions.OperationType = 34903
+	OperationTypeRolePermissionStore_Update                       actions.OperationType = 34904
+	OperationTypeRolePermissionStore_IsGranted                    actions.OperationType = 34905
+	OperationTypeRolePermissionStore_AreGranted                   actions.OperationType = 34906
+	OperationTypeRolePermissionStore_GetAllPermissionIdsByRoleId  actions.OperationType = 34907
+	OperationTypeRolePermissionStore_GetAllRoleIdsByPermissionId  actions.OperationType = 34908
+	OperationTypeRolePermissionStore_GetAllPermissionIdsByRoleIds actions.OperationType = 34909
 
 	// UserPersonalInfoStore operation types (35000-35099).
 	OperationTypeUserPersonalInfoStore_Create        actions.OperationType = 35000
@@ -518,8 +701,172 @@ const (
 	OperationTypeUserPersonalInfoStore_FindById      actions.OperationType = 35003
 	OperationTypeUserPersonalInfoStore_GetByUserId   actions.OperationType = 35004
 
+	// UserCredentialStore operation types (35100-35199).
+	OperationTypeUserCredentialStore_SetPassword  actions.OperationType = 35100
+	OperationTypeUserCredentialStore_FindByUserId actions.OperationType = 35101
+
+	// UserLockoutStore operation types (35200-35299).
+	OperationTypeUserLockoutStore_RegisterFailedAttempt actions.OperationType = 35200
+	OperationTypeUserLockoutStore_ResetFailedAttempts   actions.OperationType = 35201
+	OperationTypeUserLockoutStore_Unlock                actions.OperationType = 35202
+	OperationTypeUserLockoutStore_FindById              actions.OperationType = 35203
+	OperationTypeUserLockoutStore_UnlockAllExpired      actions.OperationType = 35204
+
+	// WebClientLockoutStore operation types (35300-35399).
+	OperationTypeWebClientLockoutStore_RegisterFailedAttempt actions.OperationType = 35300
+	OperationTypeWebClientLockoutStore_ResetFailedAttempts   actions.OperationType = 35301
+	OperationTypeWebClientLockoutStore_Unlock                actions.OperationType = 35302
+	OperationTypeWebClientLockoutStore_FindById              actions.OperationType = 35303
+	OperationTypeWebClientLockoutStore_UnlockAllExpired      actions.OperationType = 35304
+
+	// MobileClientLockoutStore operation types (35400-35499).
+	OperationTypeMobileClientLockoutStore_RegisterFailedAttempt actions.OperationType = 35400
+	OperationTypeMobileClientLockoutStore_ResetFailedAttempts   actions.OperationType = 35401
+	OperationTypeMobileClientLockoutStore_Unlock                actions.OperationType = 35402
+	OperationTypeMobileClientLockoutStore_FindById              actions.OperationType = 35403
+	OperationTypeMobileClientLockoutStore_UnlockAllExpired      actions.OperationType = 35404
+
+	// WebUserAgentLockoutStore operation types (35500-35599).
+	OperationTypeWebUserAgentLockoutStore_RegisterFailedAttempt actions.OperationType = 35500
+	OperationTypeWebUserAgentLockoutStore_ResetFailedAttempts   actions.OperationType = 35501
+	OperationTypeWebUserAgentLockoutStore_Unlock                actions.OperationType = 35502
+	OperationTypeWebUserAgentLockoutStore_FindById              actions.OperationType = 35503
+	OperationTypeWebUserAgentLockoutStore_UnlockAllExpired      actions.OperationType = 35504
+
+	// MobileUserAgentLockoutStore operation types (35600-35699).
+	OperationTypeMobileUserAgentLockoutStore_RegisterFailedAttempt actions.OperationType = 35600
+	OperationTypeMobileUserAgentLockoutStore_ResetFailedAttempts   actions.OperationType = 35601
+	OperationTypeMobileUserAgentLockoutStore_Unlock                actions.OperationType = 35602
+	OperationTypeMobileUserAgentLockoutStore_FindById              actions.OperationType = 35603
+	OperationTypeMobileUserAgentLockoutStore_UnlockAllExpired      actions.OperationType = 35604
+
+	// UserTotpStore operation types (35700-35799).
+	OperationTypeUserTotpStore_CreatePending        actions.OperationType = 35700
+	OperationTypeUserTotpStore_Enable               actions.OperationType = 35701
+	OperationTypeUserTotpStore_Delete               actions.OperationType = 35702
+	OperationTypeUserTotpStore_FindByUserId         actions.OperationType = 35703
+	OperationTypeUserTotpStore_SetLastUsedStep      actions.OperationType = 35704
+	OperationTypeUserTotpStore_ReplaceRecoveryCodes actions.OperationType = 35705
+	OperationTypeUserTotpStore_UseRecoveryCode      actions.OperationType = 35706
+	OperationTypeUserTotpStore_CountRecoveryCodes   actions.OperationType = 35707
+
+	// MfaChallengeStore operation types (35800-35899).
+	OperationTypeMfaChallengeStore_Create                actions.OperationType = 35800
+	OperationTypeMfaChallengeStore_FindByTokenHash       actions.OperationType = 35801
+	OperationTypeMfaChallengeStore_RegisterFailedAttempt actions.OperationType = 35802
+	OperationTypeMfaChallengeStore_Delete                actions.OperationType = 35803
+
+	// ServiceClientStore operation types (35900-35999).
+	OperationTypeServiceClientStore_Create        actions.OperationType = 35900
+	OperationTypeServiceClientStore_StartDeleting actions.OperationType = 35901
+	OperationTypeServiceClientStore_Delete        actions.OperationType = 35902
+	OperationTypeServiceClientStore_FindById      actions.OperationType = 35903
+	OperationTypeServiceClientStore_GetStatusById actions.OperationType = 35904
+
+	// ClientSecretStore operation types (36000-36099).
+	OperationTypeClientSecretStore_Rotate         actions.OperationType = 36000
+	OperationTypeClientSecretStore_FindByClientId actions.OperationType = 36001
+
+	// ServiceClientTokenStore operation types (36100-36199).
+	OperationTypeServiceClientTokenStore_Create          actions.OperationType = 36100
+	OperationTypeServiceClientTokenStore_FindByTokenHash actions.OperationType = 36101
+
+	// ClientRoleAssignmentStore operation types (36200-36299).
+	OperationTypeClientRoleAssignmentStore_Create                      actions.OperationType = 36200
+	OperationTypeClientRoleAssignmentStore_StartDeleting               actions.OperationType = 36201
+	OperationTypeClientRoleAssignmentStore_Delete                      actions.OperationType = 36202
+	OperationTypeClientRoleAssignmentStore_DeleteByRoleAssignmentId    actions.OperationType = 36203
+	OperationTypeClientRoleAssignmentStore_FindById                    actions.OperationType = 36204
+	OperationTypeClientRoleAssignmentStore_FindByRoleAssignmentId      actions.OperationType = 36205
+	OperationTypeClientRoleAssignmentStore_GetAllByClientId            actions.OperationType = 36206
+	OperationTypeClientRoleAssignmentStore_Exists                      actions.OperationType = 36207
+	OperationTypeClientRoleAssignmentStore_IsAssigned                  actions.OperationType = 36208
+	OperationTypeClientRoleAssignmentStore_GetIdByRoleAssignmentId     actions.OperationType = 36209
+	OperationTypeClientRoleAssignmentStore_GetStatusById               actions.OperationType = 36210
+	OperationTypeClientRoleAssignmentStore_GetStatusByRoleAssignmentId actions.OperationType = 36211
+	OperationTypeClientRoleAssignmentStore_GetClientRoleIdsByClientId  actions.OperationType = 36212
+
+	// OidcAuthorizationCodeStore operation types (36300-36399).
+	OperationTypeOidcAuthorizationCodeStore_Create         actions.OperationType = 36300
+	OperationTypeOidcAuthorizationCodeStore_FindByCodeHash actions.OperationType = 36301
+	OperationTypeOidcAuthorizationCodeStore_Delete         actions.OperationType = 36302
+
+	// OidcRefreshTokenStore operation types (36400-36499).
+	OperationTypeOidcRefreshTokenStore_Create                       actions.OperationType = 36400
+	OperationTypeOidcRefreshTokenStore_FindByTokenHash              actions.OperationType = 36401
+	OperationTypeOidcRefreshTokenStore_Delete                       actions.OperationType = 36402
+	OperationTypeOidcRefreshTokenStore_DeleteAllByUserIdAndClientId actions.OperationType = 36403
+
+	// RegistrationStore operation types (36500-36599).
+	OperationTypeRegistrationStore_CreateEmailVerificationToken actions.OperationType = 36500
+	OperationTypeRegistrationStore_VerifyEmail                  actions.OperationType = 36501
+	OperationTypeRegistrationStore_Approve                      actions.OperationType = 36502
+
+	// RoleInheritanceStore operation types (36600-36699).
+	OperationTypeRoleInheritanceStore_AddParent               actions.OperationType = 36600
+	OperationTypeRoleInheritanceStore_RemoveParent            actions.OperationType = 36601
+	OperationTypeRoleInheritanceStore_GetParentRoleIds        actions.OperationType = 36602
+	OperationTypeRoleInheritanceStore_GetAllAncestorRoleIds   actions.OperationType = 36603
+	OperationTypeRoleInheritanceStore_GetAllDescendantRoleIds actions.OperationType = 36604
+
+	// UserGroupMemberStore operation types (36700-36799).
+	OperationTypeUserGroupMemberStore_Add                    actions.OperationType = 36700
+	OperationTypeUserGroupMemberStore_Remove                 actions.OperationType = 36701
+	OperationTypeUserGroupMemberStore_GetAllByGroupId        actions.OperationType = 36702
+	OperationTypeUserGroupMemberStore_GetAllGroupIdsByUserId actions.OperationType = 36703
+	OperationTypeUserGroupMemberStore_IsMember               actions.OperationType = 36704
+
+	// ResourceTypeStore operation types (36800-36899).
+	OperationTypeResourceTypeStore_Register actions.OperationType = 36800
+	OperationTypeResourceTypeStore_FindById actions.OperationType = 36801
+	OperationTypeResourceTypeStore_GetAll   actions.OperationType = 36802
+
+	// ResourceRoleAssignmentStore operation types (36900-36999).
+	OperationTypeResourceRoleAssignmentStore_Create           actions.OperationType = 36900
+	OperationTypeResourceRoleAssignmentStore_Delete           actions.OperationType = 36901
+	OperationTypeResourceRoleAssignmentStore_FindById         actions.OperationType = 36902
+	OperationTypeResourceRoleAssignmentStore_GetAllByAssignee actions.OperationType = 36903
+
+	// ImpersonationTokenStore operation types (37000-37099).
+	OperationTypeImpersonationTokenStore_Create          actions.OperationType = 37000
+	OperationTypeImpersonationTokenStore_Delete          actions.OperationType = 37001
+	OperationTypeImpersonationTokenStore_FindByTokenHash actions.OperationType = 37002
+
+	// DataSubjectRequestStore operation types (37100-37199).
+	OperationTypeDataSubjectRequestStore_Create                 actions.OperationType = 37100
+	OperationTypeDataSubjectRequestStore_StartStep              actions.OperationType = 37101
+	OperationTypeDataSubjectRequestStore_CompleteStep           actions.OperationType = 37102
+	OperationTypeDataSubjectRequestStore_FailStep               actions.OperationType = 37103
+	OperationTypeDataSubjectRequestStore_FindById               actions.OperationType = 37104
+	OperationTypeDataSubjectRequestStore_GetAllByUserId         actions.OperationType = 37105
+	OperationTypeDataSubjectRequestStore_GetAllStepsByRequestId actions.OperationType = 37106
+	OperationTypeDataSubjectRequestStore_GetAllExportSections   actions.OperationType = 37107
+	OperationTypeDataSubjectRequestStore_GetAllIdsToProcess     actions.OperationType = 37108
+
+	// ApiKeyStore operation types (37200-37299).
+	OperationTypeApiKeyStore_Create           actions.OperationType = 37200
+	OperationTypeApiKeyStore_Revoke           actions.OperationType = 37201
+	OperationTypeApiKeyStore_FindByPrefix     actions.OperationType = 37202
+	OperationTypeApiKeyStore_GetAllByUserId   actions.OperationType = 37203
+	OperationTypeApiKeyStore_GetAllByClientId actions.OperationType = 37204
+	OperationTypeApiKeyStore_UpdateLastUsedAt actions.OperationType = 37205
+	OperationTypeApiKeyStore_FindById         actions.OperationType = 37206
+
 	// caching (50000-69999)
 
+	// AuthorizationCacheInvalidator operation types (50000-50099).
+	OperationTypeAuthorizationCacheInvalidator_InvalidatePermissions    actions.OperationType = 50000
+	OperationTypeAuthorizationCacheInvalidator_InvalidateAllPermissions actions.OperationType = 50001
+	OperationTypeAuthorizationCacheInvalidator_InvalidateUser           actions.OperationType = 50002
+	OperationTypeAuthorizationCacheInvalidator_InvalidateGroup          actions.OperationType = 50003
+	OperationTypeAuthorizationCacheInvalidator_InvalidateClient         actions.OperationType = 50004
+
+	// SessionRevocationNotifier operation types (50100-50199).
+	OperationTypeSessionRevocationNotifier_Notify actions.OperationType = 50100
+
+	// ApiKeyRevocationNotifier operation types (50200-50299).
+	OperationTypeApiKeyRevocationNotifier_Notify actions.OperationType = 50200
+
 	// [HTTP] app.AppController operation types (100000-100999).
 
 	// [HTTP] UserController operation types (101000-101199).
@@ -528,6 +875,16 @@ const (
 	// [HTTP] ClientController operation types (101200-101399).
 	OperationTypeClientController_GetById actions.OperationType = 101200
 
+	// [HTTP] AuthzCacheController operation types (101400-101599).
+	OperationTypeAuthzCacheController_GetStats actions.OperationType = 101400
+
+	// [HTTP] OidcController operation types (101600-101799).
+	OperationTypeOidcController_GetProviderMetadata actions.OperationType = 101600
+	OperationTypeOidcController_GetJwks             actions.OperationType = 101601
+	OperationTypeOidcController_Authorize           actions.OperationType = 101602
+	OperationTypeOidcController_Token               actions.OperationType = 101603
+	OperationTypeOidcController_GetUserInfo         actions.OperationType = 101604
+
 	// [gRPC] app.AppService operation types (200000-200999)
 
 	// [gRPC] UserService operation types (201000-201199).
@@ -547,15 +904,24 @@ const (
 	OperationTypeUserService_GetGroupAndStatusById actions.OperationType = 201013
 
 	// [gRPC] ClientService operation types (201200-201399).
-	OperationTypeClientService_Create             actions.OperationType = 201200
-	OperationTypeClientService_CreateWebClient    actions.OperationType = 201201
-	OperationTypeClientService_CreateMobileClient actions.OperationType = 201202
-	OperationTypeClientService_Delete             actions.OperationType = 201202
-	OperationTypeClientService_GetById            actions.OperationType = 201203
-	OperationTypeClientService_GetTypeById        actions.OperationType = 201204
-	OperationTypeClientService_GetStatusById      actions.OperationType = 201205
+	OperationTypeClientService_Create                    actions.OperationType = 201200
+	OperationTypeClientService_CreateWebClient           actions.OperationType = 201201
+	OperationTypeClientService_CreateMobileClient        actions.OperationType = 201202
+	OperationTypeClientService_Delete                    actions.OperationType = 201202
+	OperationTypeClientService_GetById                   actions.OperationType = 201203
+	OperationTypeClientService_GetTypeById               actions.OperationType = 201204
+	OperationTypeClientService_GetStatusById             actions.OperationType = 201205
+	OperationTypeClientService_CreateServiceClient       actions.OperationType = 201206
+	OperationTypeClientService_RotateServiceClientSecret actions.OperationType = 201207
 
 	// [gRPC] UserGroupService operation types (201400-201599).
+	OperationTypeUserGroupService_Create    actions.OperationType = 201400
+	OperationTypeUserGroupService_Update    actions.OperationType = 201401
+	OperationTypeUserGroupService_Delete    actions.OperationType = 201402
+	OperationTypeUserGroupService_GetById   actions.OperationType = 201403
+	OperationTypeUserGroupService_GetByName actions.OperationType = 201404
+	OperationTypeUserGroupService_GetAll    actions.OperationType = 201405
+	OperationTypeUserGroupService_Exists    actions.OperationType = 201406
 
 	// [gRPC] RoleService operation types (201600-201799).
 	OperationTypeRoleService_Create        actions.OperationType = 201600
@@ -641,14 +1007,18 @@ const (
 	OperationTypeUserAgentSessionService_GetStatusById               actions.OperationType = 202615
 
 	// [gRPC] AuthenticationService operation types (202800-202999).
-	OperationTypeAuthenticationService_CreateUserToken    actions.OperationType = 202800
-	OperationTypeAuthenticationService_CreateClientToken  actions.OperationType = 202801
-	OperationTypeAuthenticationService_Authenticate       actions.OperationType = 202802
-	OperationTypeAuthenticationService_AuthenticateUser   actions.OperationType = 202803
-	OperationTypeAuthenticationService_AuthenticateClient actions.OperationType = 202804
+	OperationTypeAuthenticationService_CreateUserToken           actions.OperationType = 202800
+	OperationTypeAuthenticationService_CreateClientToken         actions.OperationType = 202801
+	OperationTypeAuthenticationService_Authenticate              actions.OperationType = 202802
+	OperationTypeAuthenticationService_AuthenticateUser          actions.OperationType = 202803
+	OperationTypeAuthenticationService_AuthenticateClient        actions.OperationType = 202804
+	OperationTypeAuthenticationService_CreateServiceClientToken  actions.OperationType = 202805
+	OperationTypeAuthenticationService_AuthenticateServiceClient actions.OperationType = 202806
 
 	// [gRPC] AuthorizationService operation types (203000-203199).
-	OperationTypeAuthorizationService_Authorize actions.OperationType = 203000
+	OperationTypeAuthorizationService_Authorize                           actions.OperationType = 203000
+	OperationTypeAuthorizationService_Explain                             actions.OperationType = 203001
+	OperationTypeAuthorizationService_GetAllRoleAssignmentsByPermissionId actions.OperationType = 203002
 
 	// [gRPC] Authentication token encryption key service operation types (203200-203399).
 
@@ -662,6 +1032,9 @@ const (
 	OperationTypeRoleAssignmentService_GetAssigneeTypeById      actions.OperationType = 203406
 	OperationTypeRoleAssignmentService_GetStatusById            actions.OperationType = 203407
 	OperationTypeRoleAssignmentService_GetRoleIdAndAssigneeById actions.OperationType = 203408
+	OperationTypeRoleAssignmentService_Extend                   actions.OperationType = 203409
+	OperationTypeRoleAssignmentService_Revoke                   actions.OperationType = 203410
+	OperationTypeRoleAssignmentService_GetAllExpiringWithin     actions.OperationType = 203411
 
 	// [gRPC] UserRoleAssignmentService operation types (203600-203799).
 	OperationTypeUserRoleAssignmentService_Create                      actions.OperationType = 203600
@@ -698,19 +1071,99 @@ const (
 	OperationTypeGroupRoleService_GetAllRolesByGroup actions.OperationType = 204200
 
 	// [gRPC] RolePermissionService operation types (204400-204599).
-	OperationTypeRolePermissionService_Grant                       actions.OperationType = 204400
-	OperationTypeRolePermissionService_Revoke                      actions.OperationType = 204401
-	OperationTypeRolePermissionService_RevokeAll                   actions.OperationType = 204402
-	OperationTypeRolePermissionService_RevokeFromAll               actions.OperationType = 204403
-	OperationTypeRolePermissionService_Update                      actions.OperationType = 204404
-	OperationTypeRolePermissionService_IsGranted                   actions.OperationType = 204405
-	OperationTypeRolePermissionService_AreGranted                  actions.OperationType = 204406
-	OperationTypeRolePermissionService_GetAllPermissionIdsByRoleId actions.OperationType = 204407
-	OperationTypeRolePermissionService_GetAllRoleIdsByPermissionId actions.OperationType = 204408
+	OperationTypeRolePermissionService_Grant                                actions.OperationType = 204400
+	OperationTypeRolePermissionService_Revoke                               actions.OperationType = 204401
+	OperationTypeRolePermissionService_RevokeAll                            actions.OperationType = 204402
+	OperationTypeRolePermissionService_RevokeFromAll                        actions.OperationType = 204403
+	OperationTypeRolePermissionService_Update                               actions.OperationType = 204404
+	OperationTypeRolePermissionService_IsGranted                            actions.OperationType = 204405
+	OperationTypeRolePermissionService_AreGranted                           actions.OperationType = 204406
+	OperationTypeRolePermissionService_GetAllPermissionIdsByRoleId          actions.OperationType = 204407
+	OperationTypeRolePermissionService_GetAllRoleIdsByPermissionId          actions.OperationType = 204408
+	OperationTypeRolePermissionService_GetAllEffectivePermissionIdsByRoleId actions.OperationType = 204409
 
 	// [gRPC] UserPersonalInfoService operation types (204600-204799).
 	OperationTypeUserPersonalInfoService_Create      actions.OperationType = 204600
 	OperationTypeUserPersonalInfoService_Delete      actions.OperationType = 204601
 	OperationTypeUserPersonalInfoService_GetById     actions.OperationType = 204602
 	OperationTypeUserPersonalInfoService_GetByUserId actions.OperationType = 204603
+
+	// [gRPC] UserCredentialService operation types (204800-204999).
+	OperationTypeUserCredentialService_SetPassword        actions.OperationType = 204800
+	OperationTypeUserCredentialService_ChangePassword     actions.OperationType = 204801
+	OperationTypeUserCredentialService_SignInWithPassword actions.OperationType = 204802
+	OperationTypeUserCredentialService_CompleteSignIn     actions.OperationType = 204803
+
+	// [gRPC] LockoutService operation types (205000-205199).
+	OperationTypeLockoutService_GetInfo actions.OperationType = 205000
+	OperationTypeLockoutService_Unlock  actions.OperationType = 205001
+
+	// [gRPC] UserMfaService operation types (205200-205399).
+	OperationTypeUserMfaService_StartTotpEnrollment     actions.OperationType = 205200
+	OperationTypeUserMfaService_ConfirmTotpEnrollment   actions.OperationType = 205201
+	OperationTypeUserMfaService_DisableTotp             actions.OperationType = 205202
+	OperationTypeUserMfaService_RegenerateRecoveryCodes actions.OperationType = 205203
+	OperationTypeUserMfaService_GetStatus               actions.OperationType = 205204
+
+	// [gRPC] ActiveSessionService operation types (205400-205599).
+	OperationTypeActiveSessionService_GetAllByUserId actions.OperationType = 205400
+	OperationTypeActiveSessionService_Revoke         actions.OperationType = 205401
+	OperationTypeActiveSessionService_RevokeAllOther actions.OperationType = 205402
+
+	// [gRPC] RegistrationService operation types (205600-205799).
+	OperationTypeRegistrationService_Register                actions.OperationType = 205600
+	OperationTypeRegistrationService_VerifyEmail             actions.OperationType = 205601
+	OperationTypeRegistrationService_ResendVerificationEmail actions.OperationType = 205602
+	OperationTypeRegistrationService_Approve                 actions.OperationType = 205603
+
+	// [gRPC] RoleInheritanceService operation types (205800-205999).
+	OperationTypeRoleInheritanceService_AddParent             actions.OperationType = 205800
+	OperationTypeRoleInheritanceService_RemoveParent          actions.OperationType = 205801
+	OperationTypeRoleInheritanceService_GetParentRoleIds      actions.OperationType = 205802
+	OperationTypeRoleInheritanceService_GetAllAncestorRoleIds actions.OperationType = 205803
+
+	// [gRPC] UserGroupMemberService operation types (206000-206199).
+	OperationTypeUserGroupMemberService_Add                    actions.OperationType = 206000
+	OperationTypeUserGroupMemberService_Remove                 actions.OperationType = 206001
+	OperationTypeUserGroupMemberService_GetAllByGroupId        actions.OperationType = 206002
+	OperationTypeUserGroupMemberService_GetAllGroupIdsByUserId actions.OperationType = 206003
+	OperationTypeUserGroupMemberService_IsMember               actions.OperationType = 206004
+
+	// [gRPC] ResourceTypeService operation types (206200-206399).
+	OperationTypeResourceTypeService_Register actions.OperationType = 206200
+	OperationTypeResourceTypeService_GetById  actions.OperationType = 206201
+	OperationTypeResourceTypeService_GetAll   actions.OperationType = 206202
+
+	// [gRPC] ResourceRoleAssignmentService operation types (206400-206599).
+	OperationTypeResourceRoleAssignmentService_Create           actions.OperationType = 206400
+	OperationTypeResourceRoleAssignmentService_Delete           actions.OperationType = 206401
+	OperationTypeResourceRoleAssignmentService_GetById          actions.OperationType = 206402
+	OperationTypeResourceRoleAssignmentService_GetAllByAssignee actions.OperationType = 206403
+
+	// [gRPC] ImpersonationService operation types (206600-206799).
+	OperationTypeImpersonationService_CreateToken  actions.OperationType = 206600
+	OperationTypeImpersonationService_Authenticate actions.OperationType = 206601
+	OperationTypeImpersonationService_RevokeToken  actions.OperationType = 206602
+
+	// [gRPC] DataSubjectRequestService operation types (206800-206999).
+	OperationTypeDataSubjectRequestService_CreateExport   actions.OperationType = 206800
+	OperationTypeDataSubjectRequestService_CreateErasure  actions.OperationType = 206801
+	OperationTypeDataSubjectRequestService_GetById        actions.OperationType = 206802
+	OperationTypeDataSubjectRequestService_GetAllByUserId actions.OperationType = 206803
+	OperationTypeDataSubjectRequestService_GetExportData  actions.OperationType = 206804
+
+	// [gRPC] ProvisioningService operation types (207000-207199).
+	OperationTypeProvisioningService_Plan  actions.OperationType = 207000
+	OperationTypeProvisioningService_Apply actions.OperationType = 207001
+
+	// [gRPC] ApiKeyService operation types (207200-207399).
+	OperationTypeApiKeyService_CreatePersonal   actions.OperationType = 207200
+	OperationTypeApiKeyService_CreateService    actions.OperationType = 207201
+	OperationTypeApiKeyService_Revoke           actions.OperationType = 207202
+	OperationTypeApiKeyService_RevokePersonal   actions.OperationType = 207203
+	OperationTypeApiKeyService_GetAllPersonal   actions.OperationType = 207204
+	OperationTypeApiKeyService_GetAllByUserId   actions.OperationType = 207205
+	OperationTypeApiKeyService_GetAllByClientId actions.OperationType = 207206
+	OperationTypeApiKeyService_Authenticate     actions.OperationType = 207207
+	OperationTypeApiKeyService_AuthenticateById actions.OperationType = 207208
 )
@@ -0,0 +1,76 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/apikeys/models"
+)
+
+type ApiKey struct {
+	// The unique ID to identify the API key.
+	Id uint64 `db:"id"`
+
+	// The API key name.
+	Name string `db:"name"`
+
+	// The API key type.
+	Type models.ApiKeyType `db:"type"`
+
+	// The ID of the user who owns the key if it is a personal API key.
+	UserId *uint64 `db:"user_id"`
+
+	// The ID of the service client that owns the key if it is a service API key.
+	ClientId *uint64 `db:"client_id"`
+
+	// The prefix of the key that is used to look it up.
+	Prefix string `db:"prefix"`
+
+	// The SHA-256 hash of the key.
+	// It isn't exported with the user's data.
+	KeyHash []byte `db:"key_hash" json:"-"`
+
+	// The IDs of the permissions the key is restricted to.
+	// If it is nil, the key isn't restricted and has all the permissions of its owner.
+	PermissionIds []uint64 `db:"permission_ids"`
+
+	// It stores the date and time at which the key was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the key.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the key expires.
+	ExpiresAt *time.Time `db:"expires_at"`
+
+	// It stores the date and time at which the key was last used.
+	LastUsedAt *time.Time `db:"last_used_at"`
+
+	// The API key status.
+	Status models.ApiKeyStatus `db:"status"`
+
+	// It stores the date and time at which the key status was updated.
+	StatusUpdatedAt time.Time `db:"status_updated_at"`
+
+	// The user ID to identify the user who updated the key status.
+	StatusUpdatedBy uint64 `db:"status_updated_by"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
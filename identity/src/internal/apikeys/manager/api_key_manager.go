@@ -0,0 +1,434 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/apikeys"
+	"personal-website-v2/identity/src/internal/apikeys/dbmodels"
+	"personal-website-v2/identity/src/internal/apikeys/models"
+	apikeyoperations "personal-website-v2/identity/src/internal/apikeys/operations/apikeys"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/clients"
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/permissions"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The size of the prefix of an API key (in bytes).
+	apiKeyPrefixSize = 9
+
+	// The size of the secret part of an API key (in bytes).
+	apiKeySecretSize = 32
+
+	// The minimum interval between updates of the time of the last use of an API key.
+	lastUsedAtUpdateInterval = time.Minute
+)
+
+// ApiKeyManager is an API key manager.
+type ApiKeyManager struct {
+	opExecutor           *actionhelper.OperationExecutor
+	userManager          users.UserManager
+	clientManager        clients.ClientManager
+	permissionManager    permissions.PermissionManager
+	authorizationManager authorization.AuthorizationManager
+	apiKeyStore          apikeys.ApiKeyStore
+	revocationNotifier   apikeys.ApiKeyRevocationNotifier
+	logger               logging.Logger[*context.LogEntryContext]
+}
+
+var _ apikeys.ApiKeyManager = (*ApiKeyManager)(nil)
+
+func NewApiKeyManager(
+	userManager users.UserManager,
+	clientManager clients.ClientManager,
+	permissionManager permissions.PermissionManager,
+	authorizationManager authorization.AuthorizationManager,
+	apiKeyStore apikeys.ApiKeyStore,
+	revocationNotifier apikeys.ApiKeyRevocationNotifier,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ApiKeyManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.apikeys.manager.ApiKeyManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewApiKeyManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupApiKey,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewApiKeyManager] new operation executor: %w", err)
+	}
+
+	return &ApiKeyManager{
+		opExecutor:           e,
+		userManager:          userManager,
+		clientManager:        clientManager,
+		permissionManager:    permissionManager,
+		authorizationManager: authorizationManager,
+		apiKeyStore:          apiKeyStore,
+		revocationNotifier:   revocationNotifier,
+		logger:               l,
+	}, nil
+}
+
+// Create creates an API key and returns it if the operation is successful.
+// The key is returned only once, only its hash is stored.
+func (m *ApiKeyManager) Create(ctx *actions.OperationContext, data *apikeyoperations.CreateOperationData) (*models.ApiKey, error) {
+	var k *models.ApiKey
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return err
+			}
+
+			if data.Type == models.ApiKeyTypeService {
+				if t, err := m.clientManager.GetTypeById(data.ClientId.Value); err != nil || t != clientmodels.ClientTypeService {
+					return ierrors.ErrInvalidClientId
+				}
+
+				s, err := m.clientManager.GetStatusById(opCtx, data.ClientId.Value)
+				if err != nil {
+					return fmt.Errorf("[manager.ApiKeyManager.Create] get a client status by id: %w", err)
+				}
+
+				if s != clientmodels.ClientStatusActive {
+					return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid client status (%v)", s))
+				}
+			}
+
+			if data.PermissionIds != nil {
+				if err := m.checkPermissions(opCtx, data); err != nil {
+					return fmt.Errorf("[manager.ApiKeyManager.Create] check permissions: %w", err)
+				}
+			}
+
+			prefix, err := generateRandomString(apiKeyPrefixSize)
+			if err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Create] generate a key prefix: %w", err)
+			}
+
+			secret, err := generateRandomString(apiKeySecretSize)
+			if err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Create] generate a key secret: %w", err)
+			}
+
+			// only the hash of the key is stored, the key is looked up by its prefix
+			key := identity.ApiKeyPrefix + prefix + "." + secret
+			id, err := m.apiKeyStore.Create(opCtx, data, prefix, hashApiKey(key))
+			if err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Create] create an API key: %w", err)
+			}
+
+			k = &models.ApiKey{
+				Id:        id,
+				Key:       key,
+				ExpiresAt: data.ExpiresAt,
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ApiKeyEvent,
+				"[manager.ApiKeyManager.Create] API key has been created",
+				logging.NewField("id", id),
+				logging.NewField("type", data.Type),
+				logging.NewField("userId", data.UserId.Ptr()),
+				logging.NewField("clientId", data.ClientId.Ptr()),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ApiKeyManager.Create] execute an operation: %w", err)
+	}
+	return k, nil
+}
+
+// checkPermissions checks that all permissions the key is restricted to exist
+// and are granted to the owner of the key (a user or a service client),
+// so that the key can't have more permissions than its owner.
+func (m *ApiKeyManager) checkPermissions(ctx *actions.OperationContext, data *apikeyoperations.CreateOperationData) error {
+	ids := make(map[uint64]bool, len(data.PermissionIds))
+	for _, id := range data.PermissionIds {
+		ids[id] = true
+	}
+
+	ps, err := m.permissionManager.GetAllByIds(ctx, data.PermissionIds)
+	if err != nil {
+		return fmt.Errorf("[manager.ApiKeyManager.checkPermissions] get all permissions by ids: %w", err)
+	}
+
+	if len(ps) != len(ids) {
+		return ierrors.ErrPermissionNotFound
+	}
+
+	// it returns ierrors.ErrPermissionNotGranted if any of the permissions isn't granted to the owner
+	if _, err = m.authorizationManager.Authorize(ctx, data.UserId, data.ClientId, data.PermissionIds, nil); err != nil {
+		return fmt.Errorf("[manager.ApiKeyManager.checkPermissions] authorize the owner of the key: %w", err)
+	}
+	return nil
+}
+
+// Revoke revokes an API key by the specified API key ID.
+func (m *ApiKeyManager) Revoke(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_Revoke, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.apiKeyStore.Revoke(opCtx, id, nullable.Nullable[uint64]{}); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Revoke] revoke an API key: %w", err)
+			}
+
+			if err := m.revocationNotifier.Notify(opCtx, []uint64{id}, time.Now()); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Revoke] notify the apps of the revocation: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ApiKeyEvent,
+				"[manager.ApiKeyManager.Revoke] API key has been revoked",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ApiKeyManager.Revoke] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// RevokePersonal revokes the current user's personal API key by the specified API key ID.
+func (m *ApiKeyManager) RevokePersonal(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_RevokePersonal, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if !opCtx.UserId.HasValue {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "user isn't authenticated")
+			}
+
+			if err := m.apiKeyStore.Revoke(opCtx, id, opCtx.UserId); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.RevokePersonal] revoke an API key: %w", err)
+			}
+
+			if err := m.revocationNotifier.Notify(opCtx, []uint64{id}, time.Now()); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.RevokePersonal] notify the apps of the revocation: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ApiKeyEvent,
+				"[manager.ApiKeyManager.RevokePersonal] personal API key has been revoked",
+				logging.NewField("id", id),
+				logging.NewField("userId", opCtx.UserId.Value),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ApiKeyManager.RevokePersonal] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// GetAllByUserId gets all personal API keys of the user by the specified user ID.
+func (m *ApiKeyManager) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.ApiKey, error) {
+	var ks []*dbmodels.ApiKey
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_GetAllByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ks, err = m.apiKeyStore.GetAllByUserId(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.GetAllByUserId] get all API keys by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ApiKeyManager.GetAllByUserId] execute an operation: %w", err)
+	}
+	return ks, nil
+}
+
+// GetAllByClientId gets all API keys of the service client by the specified client ID.
+func (m *ApiKeyManager) GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ApiKey, error) {
+	var ks []*dbmodels.ApiKey
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_GetAllByClientId, []*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ks, err = m.apiKeyStore.GetAllByClientId(opCtx, clientId); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.GetAllByClientId] get all API keys by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ApiKeyManager.GetAllByClientId] execute an operation: %w", err)
+	}
+	return ks, nil
+}
+
+// Authenticate authenticates the owner of the specified API key.
+func (m *ApiKeyManager) Authenticate(ctx *actions.OperationContext, key string) (*models.AuthenticationResult, error) {
+	var r *models.AuthenticationResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_Authenticate, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			if !strings.HasPrefix(key, identity.ApiKeyPrefix) {
+				return ierrors.ErrInvalidAuthnToken
+			}
+
+			prefix, _, ok := strings.Cut(key[len(identity.ApiKeyPrefix):], ".")
+			if !ok || len(prefix) == 0 {
+				return ierrors.ErrInvalidAuthnToken
+			}
+
+			k, err := m.apiKeyStore.FindByPrefix(opCtx, prefix)
+			if err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Authenticate] find an API key by prefix: %w", err)
+			}
+
+			if k == nil || subtle.ConstantTimeCompare(hashApiKey(key), k.KeyHash) != 1 {
+				return ierrors.ErrInvalidAuthnToken
+			}
+
+			if r, err = m.authenticate(opCtx, k); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.Authenticate] authenticate the owner of an API key: %w", err)
+			}
+
+			// the key has already been authenticated, so an error is only logged
+			if err = m.apiKeyStore.UpdateLastUsedAt(opCtx, k.Id, lastUsedAtUpdateInterval); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.ApiKeyEvent, err,
+					"[manager.ApiKeyManager.Authenticate] update the time of the last use of the API key",
+					logging.NewField("id", k.Id),
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ApiKeyManager.Authenticate] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// AuthenticateById authenticates the owner of the API key by the specified API key ID.
+// It's used to re-apply the restriction of an API key that has already been authenticated
+// by the app that received it.
+func (m *ApiKeyManager) AuthenticateById(ctx *actions.OperationContext, id uint64) (*models.AuthenticationResult, error) {
+	var r *models.AuthenticationResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyManager_AuthenticateById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			k, err := m.apiKeyStore.FindById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.AuthenticateById] find an API key by id: %w", err)
+			}
+
+			if k == nil {
+				return ierrors.ErrInvalidAuthnToken
+			}
+
+			if r, err = m.authenticate(opCtx, k); err != nil {
+				return fmt.Errorf("[manager.ApiKeyManager.AuthenticateById] authenticate the owner of an API key: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ApiKeyManager.AuthenticateById] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// authenticate checks the status and the expiration time of the specified API key
+// and the status of its owner. It returns ierrors.ErrInvalidAuthnToken if the key
+// or its owner is invalid.
+func (m *ApiKeyManager) authenticate(opCtx *actions.OperationContext, k *dbmodels.ApiKey) (*models.AuthenticationResult, error) {
+	if k.Status != models.ApiKeyStatusActive || k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return nil, ierrors.ErrInvalidAuthnToken
+	}
+
+	r := &models.AuthenticationResult{
+		ApiKeyId:      k.Id,
+		ApiKeyType:    k.Type,
+		PermissionIds: k.PermissionIds,
+	}
+
+	switch k.Type {
+	case models.ApiKeyTypePersonal:
+		t, s, err := m.userManager.GetTypeAndStatusById(opCtx, *k.UserId)
+		if err != nil {
+			if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserNotFound {
+				return nil, fmt.Errorf("[manager.ApiKeyManager.authenticate] get a type and a status of the user by id: %w", err)
+			}
+			return nil, ierrors.ErrInvalidAuthnToken
+		}
+
+		if s != usermodels.UserStatusActive {
+			return nil, ierrors.ErrInvalidAuthnToken
+		}
+
+		r.UserId = nullable.NewNullable(*k.UserId)
+		r.UserType = t
+	case models.ApiKeyTypeService:
+		s, err := m.clientManager.GetStatusById(opCtx, *k.ClientId)
+		if err != nil {
+			if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeClientNotFound {
+				return nil, fmt.Errorf("[manager.ApiKeyManager.authenticate] get a client status by id: %w", err)
+			}
+			return nil, ierrors.ErrInvalidAuthnToken
+		}
+
+		if s != clientmodels.ClientStatusActive {
+			return nil, ierrors.ErrInvalidAuthnToken
+		}
+
+		r.ClientId = nullable.NewNullable(*k.ClientId)
+	default:
+		return nil, fmt.Errorf("[manager.ApiKeyManager.authenticate] invalid API key type (%v)", k.Type)
+	}
+	return r, nil
+}
+
+func generateRandomString(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashApiKey(key string) []byte {
+	h := sha256.Sum256([]byte(key))
+	return h[:]
+}
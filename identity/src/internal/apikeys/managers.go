@@ -0,0 +1,49 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"personal-website-v2/identity/src/internal/apikeys/dbmodels"
+	"personal-website-v2/identity/src/internal/apikeys/models"
+	"personal-website-v2/identity/src/internal/apikeys/operations/apikeys"
+	"personal-website-v2/pkg/actions"
+)
+
+// ApiKeyManager is an API key manager.
+type ApiKeyManager interface {
+	// Create creates an API key and returns it if the operation is successful.
+	// The key is returned only once, only its hash is stored.
+	Create(ctx *actions.OperationContext, data *apikeys.CreateOperationData) (*models.ApiKey, error)
+
+	// Revoke revokes an API key by the specified API key ID.
+	Revoke(ctx *actions.OperationContext, id uint64) error
+
+	// RevokePersonal revokes the current user's personal API key by the specified API key ID.
+	RevokePersonal(ctx *actions.OperationContext, id uint64) error
+
+	// GetAllByUserId gets all personal API keys of the user by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.ApiKey, error)
+
+	// GetAllByClientId gets all API keys of the service client by the specified client ID.
+	GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ApiKey, error)
+
+	// Authenticate authenticates the owner of the specified API key.
+	Authenticate(ctx *actions.OperationContext, key string) (*models.AuthenticationResult, error)
+
+	// AuthenticateById authenticates the owner of the API key by the specified API key ID.
+	// It's used to re-apply the restriction of an API key that has already been authenticated
+	// by the app that received it.
+	AuthenticateById(ctx *actions.OperationContext, id uint64) (*models.AuthenticationResult, error)
+}
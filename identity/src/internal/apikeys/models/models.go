@@ -0,0 +1,92 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+// The API key type.
+type ApiKeyType uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	// The personal API key of a user.
+	ApiKeyTypePersonal ApiKeyType = 1
+
+	// The API key of a service client.
+	ApiKeyTypeService ApiKeyType = 2
+)
+
+func (t ApiKeyType) IsValid() bool {
+	return t == ApiKeyTypePersonal || t == ApiKeyTypeService
+}
+
+func (t ApiKeyType) String() string {
+	switch t {
+	case ApiKeyTypePersonal:
+		return "personal"
+	case ApiKeyTypeService:
+		return "service"
+	}
+	return fmt.Sprintf("ApiKeyType(%d)", t)
+}
+
+// The API key status.
+type ApiKeyStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	ApiKeyStatusActive  ApiKeyStatus = 1
+	ApiKeyStatusRevoked ApiKeyStatus = 2
+)
+
+type ApiKey struct {
+	// The unique ID to identify the API key.
+	Id uint64
+
+	// The API key. It is returned only once, when the key is created.
+	Key string
+
+	// The date and time at which the key expires.
+	ExpiresAt *time.Time
+}
+
+type AuthenticationResult struct {
+	// The API key ID.
+	ApiKeyId uint64
+
+	// The API key type.
+	ApiKeyType ApiKeyType
+
+	// The ID of the user who owns the personal API key.
+	UserId nullable.Nullable[uint64]
+
+	// The type of the user who owns the personal API key.
+	UserType usermodels.UserType
+
+	// The ID of the service client that owns the service API key.
+	ClientId nullable.Nullable[uint64]
+
+	// The IDs of the permissions the key is restricted to.
+	// If it is nil, the key isn't restricted and has all the permissions of its owner.
+	PermissionIds []uint64
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/apikeys/models"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+type CreateOperationData struct {
+	// The API key name.
+	Name string `json:"name"`
+
+	// The API key type.
+	Type models.ApiKeyType `json:"type"`
+
+	// The ID of the user who owns the key. It must be specified if the key is a personal API key.
+	UserId nullable.Nullable[uint64] `json:"userId"`
+
+	// The ID of the service client that owns the key. It must be specified if the key is a service API key.
+	ClientId nullable.Nullable[uint64] `json:"clientId"`
+
+	// The IDs of the permissions the key is restricted to.
+	// If it is nil, the key isn't restricted and has all the permissions of its owner.
+	PermissionIds []uint64 `json:"permissionIds"`
+
+	// The date and time at which the key expires. If it is nil, the key doesn't expire.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (d *CreateOperationData) Validate() *errors.Error {
+	if strings.IsEmptyOrWhitespace(d.Name) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "name is empty")
+	}
+	if !d.Type.IsValid() {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid type")
+	}
+
+	switch d.Type {
+	case models.ApiKeyTypePersonal:
+		if !d.UserId.HasValue || d.ClientId.HasValue {
+			return errors.NewError(errors.ErrorCodeInvalidData, "personal API key must be owned by a user")
+		}
+	case models.ApiKeyTypeService:
+		if d.UserId.HasValue || !d.ClientId.HasValue {
+			return errors.NewError(errors.ErrorCodeInvalidData, "service API key must be owned by a service client")
+		}
+	}
+
+	if d.PermissionIds != nil && len(d.PermissionIds) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "number of permission ids is 0")
+	}
+	return nil
+}
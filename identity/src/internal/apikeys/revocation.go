@@ -0,0 +1,27 @@
+// Copyright 2024 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"time"
+
+	"personal-website-v2/pkg/actions"
+)
+
+// ApiKeyRevocationNotifier notifies the apps that cache API keys of the revocation of API keys.
+type ApiKeyRevocationNotifier interface {
+	// Notify notifies the apps of the revocation of the specified API keys.
+	Notify(ctx *actions.OperationContext, apiKeyIds []uint64, revokedAt time.Time) error
+}
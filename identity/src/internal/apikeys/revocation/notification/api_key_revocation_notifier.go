@@ -0,0 +1,216 @@
+// Copyright 2024 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apikeyspb "personal-website-v2/go-data/identity/apikeys"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/apikeys"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	defaultProducerKafkaClientId = "IdentityApiKeyRevocationNotifier"
+)
+
+type ApiKeyRevocationNotifierConfig struct {
+	Kafka *ApiKeyRevocationNotifierKafkaConfig
+}
+
+type ApiKeyRevocationNotifierKafkaConfig struct {
+	Config        *kafka.Config
+	AsyncProducer bool
+
+	// The topic to which revocations are sent.
+	Topic string
+}
+
+// ApiKeyRevocationNotifier sends the revocations of API keys to Kafka
+// to notify the apps that cache API keys of the revocations.
+type ApiKeyRevocationNotifier struct {
+	appSessionId    uint64
+	config          *ApiKeyRevocationNotifierConfig
+	opExecutor      *actionhelper.OperationExecutor
+	kMsgIdGenerator *kafka.MessageIdGenerator
+	producer        kafka.Producer
+	logger          logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx       *lcontext.LogEntryContext
+	disposed        atomic.Bool
+}
+
+var _ apikeys.ApiKeyRevocationNotifier = (*ApiKeyRevocationNotifier)(nil)
+
+func NewApiKeyRevocationNotifier(
+	appSessionId uint64,
+	config *ApiKeyRevocationNotifierConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ApiKeyRevocationNotifier, error) {
+	l, err := loggerFactory.CreateLogger("internal.apikeys.revocation.notification.ApiKeyRevocationNotifier")
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewApiKeyRevocationNotifier] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupApiKey,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewApiKeyRevocationNotifier] new operation executor: %w", err)
+	}
+
+	n := &ApiKeyRevocationNotifier{
+		appSessionId: appSessionId,
+		config:       config,
+		opExecutor:   e,
+		logger:       l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}
+
+	if config.Kafka.Config.Producer.OnCompletion == nil {
+		config.Kafka.Config.Producer.OnCompletion = n.onCompletion
+	}
+	if len(config.Kafka.Config.ClientId) == 0 {
+		config.Kafka.Config.ClientId = defaultProducerKafkaClientId
+	}
+
+	p, err := kafka.NewProducer(config.Kafka.Config, config.Kafka.AsyncProducer)
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewApiKeyRevocationNotifier] new producer: %w", err)
+	}
+
+	kMsgIdGenerator, err := kafka.NewMessageIdGenerator(appSessionId, uint32(runtime.NumCPU()*2))
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewApiKeyRevocationNotifier] new message id generator: %w", err)
+	}
+
+	n.kMsgIdGenerator = kMsgIdGenerator
+	n.producer = p
+	return n, nil
+}
+
+// Notify notifies the apps of the revocation of the specified API keys.
+func (n *ApiKeyRevocationNotifier) Notify(ctx *actions.OperationContext, apiKeyIds []uint64, revokedAt time.Time) error {
+	if n.disposed.Load() {
+		return errors.New("[notification.ApiKeyRevocationNotifier.Notify] ApiKeyRevocationNotifier was disposed")
+	}
+
+	err := n.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyRevocationNotifier_Notify,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("apiKeyIds", apiKeyIds),
+			actions.NewOperationParam("revokedAt", revokedAt),
+		},
+		func(opCtx *actions.OperationContext) error {
+			if len(apiKeyIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of API key ids is 0")
+			}
+
+			r := &apikeyspb.ApiKeyRevocation{
+				ApiKeyIds: apiKeyIds,
+				CreatedAt: timestamppb.New(revokedAt),
+			}
+			if err := n.send(opCtx, r); err != nil {
+				return fmt.Errorf("[notification.ApiKeyRevocationNotifier.Notify] send a revocation: %w", err)
+			}
+
+			n.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.ApiKeyEvent,
+				"[notification.ApiKeyRevocationNotifier.Notify] revocation of API keys has been sent",
+				logging.NewField("apiKeyIds", apiKeyIds),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[notification.ApiKeyRevocationNotifier.Notify] execute an operation: %w", err)
+	}
+	return nil
+}
+
+func (n *ApiKeyRevocationNotifier) send(ctx *actions.OperationContext, r *apikeyspb.ApiKeyRevocation) error {
+	tranId := ctx.Transaction.Id()
+	r.Metadata = &apikeyspb.ApiKeyRevocationMetadata{
+		AppSessionId: n.appSessionId,
+		TranId:       tranId.String(),
+	}
+
+	b, err := proto.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("[notification.ApiKeyRevocationNotifier.send] marshal a revocation to Protobuf: %w", err)
+	}
+
+	msgId, err := n.kMsgIdGenerator.Get()
+	if err != nil {
+		return fmt.Errorf("[notification.ApiKeyRevocationNotifier.send] get id from kMsgIdGenerator: %w", err)
+	}
+
+	msg := &kafka.ProducerMessage{
+		Topic:    n.config.Kafka.Topic,
+		Headers:  kafka.RecordHeaders{metadata.MessageIdHeader(msgId)},
+		Key:      tranId[:],
+		Value:    b,
+		Metadata: r,
+	}
+
+	if err = n.producer.SendMessage(msg); err != nil {
+		return fmt.Errorf("[notification.ApiKeyRevocationNotifier.send] send a message: %w", err)
+	}
+	return nil
+}
+
+func (n *ApiKeyRevocationNotifier) onCompletion(msg *kafka.ProducerMessage, err error) {
+	if err == nil {
+		return
+	}
+
+	r := msg.Metadata.(*apikeyspb.ApiKeyRevocation)
+	n.logger.ErrorWithEvent(n.loggerCtx, events.ApiKeyEvent, err,
+		"[notification.ApiKeyRevocationNotifier.onCompletion] an error occurred while sending a revocation to kafka",
+		logging.NewField("apiKeyIds", r.ApiKeyIds),
+	)
+}
+
+// Dispose disposes of the ApiKeyRevocationNotifier.
+func (n *ApiKeyRevocationNotifier) Dispose() error {
+	if n.disposed.Load() {
+		return nil
+	}
+
+	if err := n.producer.Close(); err != nil {
+		return fmt.Errorf("[notification.ApiKeyRevocationNotifier.Dispose] close a producer: %w", err)
+	}
+
+	n.disposed.Store(true)
+	return nil
+}
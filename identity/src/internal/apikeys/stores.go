@@ -0,0 +1,49 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/apikeys/dbmodels"
+	"personal-website-v2/identity/src/internal/apikeys/operations/apikeys"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type ApiKeyStore interface {
+	// Create creates an API key and returns the API key ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *apikeys.CreateOperationData, prefix string, keyHash []byte) (uint64, error)
+
+	// Revoke revokes an API key by the specified API key ID. If userId has a value,
+	// only the personal API key of the user with the specified ID can be revoked.
+	Revoke(ctx *actions.OperationContext, id uint64, userId nullable.Nullable[uint64]) error
+
+	// FindByPrefix finds and returns an API key, if any, by the specified key prefix.
+	FindByPrefix(ctx *actions.OperationContext, prefix string) (*dbmodels.ApiKey, error)
+
+	// FindById finds and returns an API key, if any, by the specified API key ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ApiKey, error)
+
+	// GetAllByUserId gets all personal API keys of the user by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.ApiKey, error)
+
+	// GetAllByClientId gets all API keys of the service client by the specified client ID.
+	GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ApiKey, error)
+
+	// UpdateLastUsedAt updates the date and time at which the API key was last used
+	// if it was last updated more than minInterval ago.
+	UpdateLastUsedAt(ctx *actions.OperationContext, id uint64, minInterval time.Duration) error
+}
@@ -0,0 +1,282 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/apikeys"
+	"personal-website-v2/identity/src/internal/apikeys/dbmodels"
+	apikeyoperations "personal-website-v2/identity/src/internal/apikeys/operations/apikeys"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	apiKeysTable = "public.api_keys"
+)
+
+// ApiKeyStore is a store of API keys.
+type ApiKeyStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ApiKey]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ apikeys.ApiKeyStore = (*ApiKeyStore)(nil)
+
+func NewApiKeyStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ApiKeyStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.apikeys.stores.ApiKeyStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewApiKeyStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupApiKey,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewApiKeyStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewApiKeyStore] new TxManager: %w", err)
+	}
+
+	return &ApiKeyStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ApiKey](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates an API key and returns the API key ID if the operation is successful.
+func (s *ApiKeyStore) Create(ctx *actions.OperationContext, data *apikeyoperations.CreateOperationData, prefix string, keyHash []byte) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_Create,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data), actions.NewOperationParam("prefix", prefix)},
+		func(opCtx *actions.OperationContext) error {
+			var expiresAt *time.Time
+			if data.ExpiresAt != nil {
+				t := data.ExpiresAt.UTC()
+				expiresAt = &t
+			}
+
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_api_key(IN _name, IN _type, IN _user_id, IN _client_id, IN _prefix, IN _key_hash, IN _permission_ids,
+				// IN _expires_at, IN _created_by, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_api_key($1, $2, $3, $4, $5, $6, $7, $8, $9, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.Name, data.Type, data.UserId.Ptr(), data.ClientId.Ptr(), prefix, keyHash, data.PermissionIds,
+					expiresAt, opCtx.UserId.Ptr(),
+				)
+
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ApiKeyStore.Create] execute a query (create_api_key): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ApiKeyStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ApiKeyStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Revoke revokes an API key by the specified API key ID. If userId has a value,
+// only the personal API key of the user with the specified ID can be revoked.
+func (s *ApiKeyStore) Revoke(ctx *actions.OperationContext, id uint64, userId nullable.Nullable[uint64]) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_Revoke,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("userId", userId.Ptr())},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.revoke_api_key(IN _id, IN _user_id, IN _revoked_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.revoke_api_key($1, $2, $3, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id, userId.Ptr(), opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ApiKeyStore.Revoke] execute a query (revoke_api_key): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeApiKeyNotFound:
+					return ierrors.ErrApiKeyNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ApiKeyStore.Revoke] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.Revoke] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ApiKeyStore.Revoke] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindByPrefix finds and returns an API key, if any, by the specified key prefix.
+func (s *ApiKeyStore) FindByPrefix(ctx *actions.OperationContext, prefix string) (*dbmodels.ApiKey, error) {
+	var k *dbmodels.ApiKey
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_FindByPrefix, []*actions.OperationParam{actions.NewOperationParam("prefix", prefix)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + apiKeysTable + " WHERE prefix = $1 LIMIT 1"
+			var err error
+			if k, err = s.store.Find(opCtx.Ctx, query, prefix); err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.FindByPrefix] find an API key by prefix: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ApiKeyStore.FindByPrefix] execute an operation: %w", err)
+	}
+	return k, nil
+}
+
+// FindById finds and returns an API key, if any, by the specified API key ID.
+func (s *ApiKeyStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ApiKey, error) {
+	var k *dbmodels.ApiKey
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + apiKeysTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if k, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.FindById] find an API key by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ApiKeyStore.FindById] execute an operation: %w", err)
+	}
+	return k, nil
+}
+
+// GetAllByUserId gets all personal API keys of the user by the specified user ID.
+func (s *ApiKeyStore) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.ApiKey, error) {
+	var ks []*dbmodels.ApiKey
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_GetAllByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + apiKeysTable + " WHERE user_id = $1 ORDER BY id"
+			var err error
+			if ks, err = s.store.FindAll(opCtx.Ctx, query, userId); err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.GetAllByUserId] find all API keys by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ApiKeyStore.GetAllByUserId] execute an operation: %w", err)
+	}
+	return ks, nil
+}
+
+// GetAllByClientId gets all API keys of the service client by the specified client ID.
+func (s *ApiKeyStore) GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ApiKey, error) {
+	var ks []*dbmodels.ApiKey
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_GetAllByClientId, []*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + apiKeysTable + " WHERE client_id = $1 ORDER BY id"
+			var err error
+			if ks, err = s.store.FindAll(opCtx.Ctx, query, clientId); err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.GetAllByClientId] find all API keys by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ApiKeyStore.GetAllByClientId] execute an operation: %w", err)
+	}
+	return ks, nil
+}
+
+// UpdateLastUsedAt updates the date and time at which the API key was last used
+// if it was last updated more than minInterval ago.
+func (s *ApiKeyStore) UpdateLastUsedAt(ctx *actions.OperationContext, id uint64, minInterval time.Duration) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeApiKeyStore_UpdateLastUsedAt,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("minInterval", minInterval)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.update_api_key_last_used_at(IN _id, IN _min_interval, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.update_api_key_last_used_at($1, $2, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id, minInterval).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ApiKeyStore.UpdateLastUsedAt] execute a query (update_api_key_last_used_at): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.ApiKeyStore.UpdateLastUsedAt] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ApiKeyStore.UpdateLastUsedAt] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ApiKeyStore.UpdateLastUsedAt] execute an operation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authorization
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/authorization/models"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// AuthorizationCache is an in-process cache of the data used for authorization.
+// If the data is missing from the cache, then it is loaded using the specified load function
+// and added to the cache.
+type AuthorizationCache interface {
+	// GetRoleIdsByPermissionId gets the IDs of the roles that have the specified permission.
+	GetRoleIdsByPermissionId(permissionId uint64, load func() ([]uint64, error)) ([]uint64, error)
+
+	// GetUserRoleIds gets the IDs of all the user's roles (the roles assigned to the user).
+	// The load function also returns the time of the next scheduled change of the roles, if any;
+	// the loaded role IDs aren't cached after that time.
+	GetUserRoleIds(userId uint64, load func() ([]uint64, *time.Time, error)) ([]uint64, error)
+
+	// GetGroupRoleIds gets the IDs of all the group's roles (the roles assigned to the group).
+	// The load function also returns the time of the next scheduled change of the roles, if any;
+	// the loaded role IDs aren't cached after that time.
+	GetGroupRoleIds(group groupmodels.UserGroup, load func() ([]uint64, *time.Time, error)) ([]uint64, error)
+
+	// GetClientRoleIds gets the IDs of all the service client's roles (the roles assigned to the client).
+	GetClientRoleIds(clientId uint64, load func() ([]uint64, error)) ([]uint64, error)
+
+	// GetUserGroupAndStatus gets a group and a status of the user.
+	GetUserGroupAndStatus(userId uint64, load func() (groupmodels.UserGroup, usermodels.UserStatus, error)) (groupmodels.UserGroup, usermodels.UserStatus, error)
+
+	// GetUserMemberGroups gets the IDs of the user groups created by users of which the user is a member.
+	GetUserMemberGroups(userId uint64, load func() ([]groupmodels.UserGroup, error)) ([]groupmodels.UserGroup, error)
+
+	// GetUserResourceRoles gets the user's resource roles (the roles assigned to the user for resources).
+	GetUserResourceRoles(userId uint64, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error)
+
+	// GetGroupResourceRoles gets the group's resource roles (the roles assigned to the group for resources).
+	GetGroupResourceRoles(group groupmodels.UserGroup, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error)
+
+	// GetClientResourceRoles gets the service client's resource roles (the roles assigned to the client for resources).
+	GetClientResourceRoles(clientId uint64, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error)
+
+	// InvalidatePermissions invalidates the cached roles of the specified permissions.
+	InvalidatePermissions(permissionIds []uint64)
+
+	// InvalidateAllPermissions invalidates the cached roles of all permissions.
+	InvalidateAllPermissions()
+
+	// InvalidateUser invalidates the cached roles, resource roles, group, status and member groups of the user.
+	InvalidateUser(userId uint64)
+
+	// InvalidateGroup invalidates the cached roles and resource roles of the group.
+	InvalidateGroup(group groupmodels.UserGroup)
+
+	// InvalidateClient invalidates the cached roles and resource roles of the service client.
+	InvalidateClient(clientId uint64)
+
+	// InvalidateAll invalidates all cached data.
+	InvalidateAll()
+
+	// Stats returns the cache statistics.
+	Stats() *models.AuthorizationCacheStats
+}
+
+// AuthorizationCacheInvalidator invalidates the authorization cache of the current app instance
+// and notifies other app instances of the invalidation.
+type AuthorizationCacheInvalidator interface {
+	// InvalidatePermissions invalidates the cached roles of the specified permissions.
+	InvalidatePermissions(ctx *actions.OperationContext, permissionIds []uint64) error
+
+	// InvalidateAllPermissions invalidates the cached roles of all permissions.
+	InvalidateAllPermissions(ctx *actions.OperationContext) error
+
+	// InvalidateUser invalidates the cached roles, resource roles, group, status and member groups of the user.
+	InvalidateUser(ctx *actions.OperationContext, userId uint64) error
+
+	// InvalidateGroup invalidates the cached roles and resource roles of the group.
+	InvalidateGroup(ctx *actions.OperationContext, group groupmodels.UserGroup) error
+
+	// InvalidateClient invalidates the cached roles and resource roles of the service client.
+	InvalidateClient(ctx *actions.OperationContext, clientId uint64) error
+}
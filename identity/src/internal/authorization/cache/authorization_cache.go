@@ -0,0 +1,313 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/authorization/models"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/base/cache"
+)
+
+type AuthorizationCacheConfig struct {
+	// The maximum number of cached permissions.
+	PermissionCapacity int
+
+	// The maximum number of cached users.
+	UserCapacity int
+
+	// The maximum number of cached groups.
+	GroupCapacity int
+
+	// The maximum number of cached service clients.
+	ClientCapacity int
+
+	// The cached data lifetime.
+	TTL time.Duration
+}
+
+type userGroupAndStatus struct {
+	group  groupmodels.UserGroup
+	status usermodels.UserStatus
+}
+
+// AuthorizationCache is an in-process cache of the data used for authorization.
+//
+// The cached role ID slices are shared and must not be modified.
+type AuthorizationCache struct {
+	permissionRoles       *cache.LRUCache[uint64, []uint64]                              // map[PermissionId]RoleIds
+	userRoles             *cache.LRUCache[uint64, []uint64]                              // map[UserId]RoleIds
+	groupRoles            *cache.LRUCache[groupmodels.UserGroup, []uint64]               // map[UserGroup]RoleIds
+	clientRoles           *cache.LRUCache[uint64, []uint64]                              // map[ClientId]RoleIds
+	userGroupsAndStatuses *cache.LRUCache[uint64, *userGroupAndStatus]                   // map[UserId]GroupAndStatus
+	userMemberGroups      *cache.LRUCache[uint64, []groupmodels.UserGroup]               // map[UserId]MemberGroups
+	userResourceRoles     *cache.LRUCache[uint64, []*models.ResourceRole]                // map[UserId]ResourceRoles
+	groupResourceRoles    *cache.LRUCache[groupmodels.UserGroup, []*models.ResourceRole] // map[UserGroup]ResourceRoles
+	clientResourceRoles   *cache.LRUCache[uint64, []*models.ResourceRole]                // map[ClientId]ResourceRoles
+}
+
+var _ authorization.AuthorizationCache = (*AuthorizationCache)(nil)
+
+func NewAuthorizationCache(config *AuthorizationCacheConfig) (*AuthorizationCache, error) {
+	prs, err := cache.NewLRUCache[uint64, []uint64](config.PermissionCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of permission roles: %w", err)
+	}
+
+	urs, err := cache.NewLRUCache[uint64, []uint64](config.UserCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of user roles: %w", err)
+	}
+
+	grs, err := cache.NewLRUCache[groupmodels.UserGroup, []uint64](config.GroupCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of group roles: %w", err)
+	}
+
+	crs, err := cache.NewLRUCache[uint64, []uint64](config.ClientCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of client roles: %w", err)
+	}
+
+	ugss, err := cache.NewLRUCache[uint64, *userGroupAndStatus](config.UserCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of users' groups and statuses: %w", err)
+	}
+
+	umgs, err := cache.NewLRUCache[uint64, []groupmodels.UserGroup](config.UserCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of users' member groups: %w", err)
+	}
+
+	urrs, err := cache.NewLRUCache[uint64, []*models.ResourceRole](config.UserCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of user resource roles: %w", err)
+	}
+
+	grrs, err := cache.NewLRUCache[groupmodels.UserGroup, []*models.ResourceRole](config.GroupCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of group resource roles: %w", err)
+	}
+
+	crrs, err := cache.NewLRUCache[uint64, []*models.ResourceRole](config.ClientCapacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.NewAuthorizationCache] new cache of client resource roles: %w", err)
+	}
+
+	return &AuthorizationCache{
+		permissionRoles:       prs,
+		userRoles:             urs,
+		groupRoles:            grs,
+		clientRoles:           crs,
+		userGroupsAndStatuses: ugss,
+		userMemberGroups:      umgs,
+		userResourceRoles:     urrs,
+		groupResourceRoles:    grrs,
+		clientResourceRoles:   crrs,
+	}, nil
+}
+
+// GetRoleIdsByPermissionId gets the IDs of the roles that have the specified permission.
+func (c *AuthorizationCache) GetRoleIdsByPermissionId(permissionId uint64, load func() ([]uint64, error)) ([]uint64, error) {
+	ids, err := getOrLoad(c.permissionRoles, permissionId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetRoleIdsByPermissionId] get or load role ids: %w", err)
+	}
+	return ids, nil
+}
+
+// GetUserRoleIds gets the IDs of all the user's roles (the roles assigned to the user).
+// The load function also returns the time of the next scheduled change of the roles, if any;
+// the loaded role IDs aren't cached after that time.
+func (c *AuthorizationCache) GetUserRoleIds(userId uint64, load func() ([]uint64, *time.Time, error)) ([]uint64, error) {
+	ids, err := getOrLoadWithExpiration(c.userRoles, userId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetUserRoleIds] get or load role ids: %w", err)
+	}
+	return ids, nil
+}
+
+// GetGroupRoleIds gets the IDs of all the group's roles (the roles assigned to the group).
+// The load function also returns the time of the next scheduled change of the roles, if any;
+// the loaded role IDs aren't cached after that time.
+func (c *AuthorizationCache) GetGroupRoleIds(group groupmodels.UserGroup, load func() ([]uint64, *time.Time, error)) ([]uint64, error) {
+	ids, err := getOrLoadWithExpiration(c.groupRoles, group, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetGroupRoleIds] get or load role ids: %w", err)
+	}
+	return ids, nil
+}
+
+// GetClientRoleIds gets the IDs of all the service client's roles (the roles assigned to the client).
+func (c *AuthorizationCache) GetClientRoleIds(clientId uint64, load func() ([]uint64, error)) ([]uint64, error) {
+	ids, err := getOrLoad(c.clientRoles, clientId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetClientRoleIds] get or load role ids: %w", err)
+	}
+	return ids, nil
+}
+
+// GetUserGroupAndStatus gets a group and a status of the user.
+func (c *AuthorizationCache) GetUserGroupAndStatus(userId uint64, load func() (groupmodels.UserGroup, usermodels.UserStatus, error)) (groupmodels.UserGroup, usermodels.UserStatus, error) {
+	gs, err := getOrLoad(c.userGroupsAndStatuses, userId, func() (*userGroupAndStatus, error) {
+		g, s, err := load()
+		if err != nil {
+			return nil, err
+		}
+		return &userGroupAndStatus{group: g, status: s}, nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("[cache.AuthorizationCache.GetUserGroupAndStatus] get or load a group and a status of the user: %w", err)
+	}
+	return gs.group, gs.status, nil
+}
+
+// GetUserMemberGroups gets the IDs of the user groups created by users of which the user is a member.
+func (c *AuthorizationCache) GetUserMemberGroups(userId uint64, load func() ([]groupmodels.UserGroup, error)) ([]groupmodels.UserGroup, error) {
+	gs, err := getOrLoad(c.userMemberGroups, userId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetUserMemberGroups] get or load member groups of the user: %w", err)
+	}
+	return gs, nil
+}
+
+// GetUserResourceRoles gets the user's resource roles (the roles assigned to the user for resources).
+func (c *AuthorizationCache) GetUserResourceRoles(userId uint64, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error) {
+	rs, err := getOrLoad(c.userResourceRoles, userId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetUserResourceRoles] get or load resource roles: %w", err)
+	}
+	return rs, nil
+}
+
+// GetGroupResourceRoles gets the group's resource roles (the roles assigned to the group for resources).
+func (c *AuthorizationCache) GetGroupResourceRoles(group groupmodels.UserGroup, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error) {
+	rs, err := getOrLoad(c.groupResourceRoles, group, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetGroupResourceRoles] get or load resource roles: %w", err)
+	}
+	return rs, nil
+}
+
+// GetClientResourceRoles gets the service client's resource roles (the roles assigned to the client for resources).
+func (c *AuthorizationCache) GetClientResourceRoles(clientId uint64, load func() ([]*models.ResourceRole, error)) ([]*models.ResourceRole, error) {
+	rs, err := getOrLoad(c.clientResourceRoles, clientId, load)
+	if err != nil {
+		return nil, fmt.Errorf("[cache.AuthorizationCache.GetClientResourceRoles] get or load resource roles: %w", err)
+	}
+	return rs, nil
+}
+
+// getOrLoad gets a value from the cache or loads it if it is missing from the cache.
+// The loaded value isn't added to the cache if the cache has been invalidated during loading.
+func getOrLoad[TKey comparable, TValue any](c *cache.LRUCache[TKey, TValue], key TKey, load func() (TValue, error)) (TValue, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	g := c.Generation()
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	c.AddIfGeneration(key, v, g)
+	return v, nil
+}
+
+// getOrLoadWithExpiration is like getOrLoad, but the loaded value expires at the time returned
+// by the load function, if any, or after the TTL, whichever is earlier.
+func getOrLoadWithExpiration[TKey comparable, TValue any](c *cache.LRUCache[TKey, TValue], key TKey, load func() (TValue, *time.Time, error)) (TValue, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	g := c.Generation()
+	v, expiresAt, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	if expiresAt != nil {
+		c.AddIfGenerationWithExpiration(key, v, g, *expiresAt)
+	} else {
+		c.AddIfGeneration(key, v, g)
+	}
+	return v, nil
+}
+
+// InvalidatePermissions invalidates the cached roles of the specified permissions.
+func (c *AuthorizationCache) InvalidatePermissions(permissionIds []uint64) {
+	for _, id := range permissionIds {
+		c.permissionRoles.Remove(id)
+	}
+}
+
+// InvalidateAllPermissions invalidates the cached roles of all permissions.
+func (c *AuthorizationCache) InvalidateAllPermissions() {
+	c.permissionRoles.Clear()
+}
+
+// InvalidateUser invalidates the cached roles, resource roles, group, status and member groups of the user.
+func (c *AuthorizationCache) InvalidateUser(userId uint64) {
+	c.userRoles.Remove(userId)
+	c.userResourceRoles.Remove(userId)
+	c.userGroupsAndStatuses.Remove(userId)
+	c.userMemberGroups.Remove(userId)
+}
+
+// InvalidateGroup invalidates the cached roles and resource roles of the group.
+func (c *AuthorizationCache) InvalidateGroup(group groupmodels.UserGroup) {
+	c.groupRoles.Remove(group)
+	c.groupResourceRoles.Remove(group)
+}
+
+// InvalidateClient invalidates the cached roles and resource roles of the service client.
+func (c *AuthorizationCache) InvalidateClient(clientId uint64) {
+	c.clientRoles.Remove(clientId)
+	c.clientResourceRoles.Remove(clientId)
+}
+
+// InvalidateAll invalidates all cached data.
+func (c *AuthorizationCache) InvalidateAll() {
+	c.permissionRoles.Clear()
+	c.userRoles.Clear()
+	c.groupRoles.Clear()
+	c.clientRoles.Clear()
+	c.userGroupsAndStatuses.Clear()
+	c.userMemberGroups.Clear()
+	c.userResourceRoles.Clear()
+	c.groupResourceRoles.Clear()
+	c.clientResourceRoles.Clear()
+}
+
+// Stats returns the cache statistics.
+func (c *AuthorizationCache) Stats() *models.AuthorizationCacheStats {
+	return &models.AuthorizationCacheStats{
+		PermissionRoles:       c.permissionRoles.Stats(),
+		UserRoles:             c.userRoles.Stats(),
+		GroupRoles:            c.groupRoles.Stats(),
+		ClientRoles:           c.clientRoles.Stats(),
+		UserGroupsAndStatuses: c.userGroupsAndStatuses.Stats(),
+		UserMemberGroups:      c.userMemberGroups.Stats(),
+		UserResourceRoles:     c.userResourceRoles.Stats(),
+		GroupResourceRoles:    c.groupResourceRoles.Stats(),
+		ClientResourceRoles:   c.clientResourceRoles.Stats(),
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invalidation
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/proto"
+
+	authorizationpb "personal-website-v2/go-data/identity/authorization"
+	"personal-website-v2/identity/src/internal/authorization"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	saramautil "personal-website-v2/pkg/components/kafka/utils/sarama"
+	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	defaultConsumerKafkaClientId = "IdentityAuthzCacheInvalidation"
+)
+
+type CacheInvalidationServiceConfig struct {
+	Kafka *CacheInvalidationServiceKafkaConfig
+}
+
+type CacheInvalidationServiceKafkaConfig struct {
+	Config *kafka.Config
+
+	// The topic from which cache invalidations are consumed.
+	Topic string
+}
+
+// CacheInvalidationService consumes cache invalidations sent by other app instances
+// and invalidates the authorization cache of the current app instance.
+//
+// Each app instance must receive all cache invalidations, therefore the consumer group isn't used,
+// and all partitions of the topic are consumed starting from the newest offset.
+type CacheInvalidationService struct {
+	appSessionId       uint64
+	cache              authorization.AuthorizationCache
+	config             *CacheInvalidationServiceConfig
+	consumer           sarama.Consumer
+	partitionConsumers []sarama.PartitionConsumer
+	logger             logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx          *lcontext.LogEntryContext
+	isStarted          atomic.Bool
+	isStopped          bool
+	mu                 sync.Mutex
+	wg                 sync.WaitGroup
+}
+
+func NewCacheInvalidationService(
+	appSessionId uint64,
+	cache authorization.AuthorizationCache,
+	config *CacheInvalidationServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*CacheInvalidationService, error) {
+	l, err := loggerFactory.CreateLogger("internal.authorization.cache.invalidation.CacheInvalidationService")
+	if err != nil {
+		return nil, fmt.Errorf("[invalidation.NewCacheInvalidationService] create a logger: %w", err)
+	}
+
+	return &CacheInvalidationService{
+		appSessionId: appSessionId,
+		cache:        cache,
+		config:       config,
+		logger:       l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}, nil
+}
+
+func (s *CacheInvalidationService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the CacheInvalidationService.
+func (s *CacheInvalidationService) Start() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[invalidation.CacheInvalidationService.Start] CacheInvalidationService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[invalidation.CacheInvalidationService.Start] CacheInvalidationService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, "[invalidation.CacheInvalidationService.Start] starting the CacheInvalidationService...")
+
+	c, err := s.config.Kafka.Config.SaramaConfig()
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidationService.Start] get a sarama config: %w", err)
+	}
+
+	if len(s.config.Kafka.Config.ClientId) == 0 {
+		c.ClientID = defaultConsumerKafkaClientId
+	}
+
+	consumer, err := sarama.NewConsumer(s.config.Kafka.Config.Addrs, c)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidationService.Start] new consumer: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			s.closeConsumers(consumer, s.partitionConsumers)
+			s.partitionConsumers = nil
+		}
+	}()
+
+	ps, err := consumer.Partitions(s.config.Kafka.Topic)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidationService.Start] get the partition ids of the topic: %w", err)
+	}
+
+	s.partitionConsumers = make([]sarama.PartitionConsumer, 0, len(ps))
+	for _, p := range ps {
+		pc, err := consumer.ConsumePartition(s.config.Kafka.Topic, p, sarama.OffsetNewest)
+		if err != nil {
+			return fmt.Errorf("[invalidation.CacheInvalidationService.Start] consume a partition: %w", err)
+		}
+		s.partitionConsumers = append(s.partitionConsumers, pc)
+	}
+
+	s.consumer = consumer
+	s.wg.Add(len(s.partitionConsumers))
+	for _, pc := range s.partitionConsumers {
+		go s.consumePartition(pc)
+	}
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, "[invalidation.CacheInvalidationService.Start] CacheInvalidationService has been started",
+		logging.NewField("topic", s.config.Kafka.Topic),
+		logging.NewField("partitions", ps),
+	)
+	return nil
+}
+
+// Stop stops the CacheInvalidationService.
+func (s *CacheInvalidationService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[invalidation.CacheInvalidationService.Stop] CacheInvalidationService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, "[invalidation.CacheInvalidationService.Stop] stopping the CacheInvalidationService...")
+	s.closeConsumers(s.consumer, s.partitionConsumers)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, "[invalidation.CacheInvalidationService.Stop] CacheInvalidationService has been stopped")
+	return nil
+}
+
+func (s *CacheInvalidationService) closeConsumers(consumer sarama.Consumer, partitionConsumers []sarama.PartitionConsumer) {
+	for _, pc := range partitionConsumers {
+		// the Messages and Errors channels are closed after the partition consumer is closed
+		pc.AsyncClose()
+	}
+
+	if err := consumer.Close(); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, err, "[invalidation.CacheInvalidationService.closeConsumers] close a consumer")
+	}
+}
+
+func (s *CacheInvalidationService) consumePartition(pc sarama.PartitionConsumer) {
+	defer s.wg.Done()
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.AuthorizationCacheEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[invalidation.CacheInvalidationService.consumePartition] panic: ", p.Value), p.StackTrace),
+			"[invalidation.CacheInvalidationService.consumePartition] panic while consuming a partition",
+		)
+		// the cache may be stale
+		s.cache.InvalidateAll()
+	})
+
+	errCh := pc.Errors()
+	msgCh := pc.Messages()
+	for errCh != nil || msgCh != nil {
+		select {
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			s.logger.ErrorWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, err,
+				"[invalidation.CacheInvalidationService.consumePartition] error while consuming a partition",
+			)
+			// cache invalidations may have been missed
+			s.cache.InvalidateAll()
+		case msg, ok := <-msgCh:
+			if !ok {
+				msgCh = nil
+				continue
+			}
+			s.processMessage(msg)
+		}
+	}
+}
+
+func (s *CacheInvalidationService) processMessage(msg *sarama.ConsumerMessage) {
+	fs := []*logging.Field{
+		logging.NewField("topic", msg.Topic),
+		logging.NewField("partition", msg.Partition),
+		logging.NewField("offset", msg.Offset),
+		nil,
+	}
+
+	if msgIdH := saramautil.GetHeader(msg.Headers, metadata.MessageIdMDKey); msgIdH != nil {
+		if msgId, err := metadata.DecodeMessageId(msgIdH.Value); err != nil {
+			s.logger.ErrorWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, err,
+				"[invalidation.CacheInvalidationService.processMessage] decode the message id", fs[:3]...,
+			)
+			fs = fs[:3]
+		} else {
+			fs[3] = logging.NewField("_msgId", msgId)
+		}
+	} else {
+		fs = fs[:3]
+	}
+
+	inv := new(authorizationpb.CacheInvalidation)
+	if err := proto.Unmarshal(msg.Value, inv); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.AuthorizationCacheEvent, err,
+			"[invalidation.CacheInvalidationService.processMessage] unmarshal the Protobuf-encoded cache invalidation", fs...,
+		)
+		s.cache.InvalidateAll()
+		return
+	}
+
+	if inv.Metadata != nil && inv.Metadata.AppSessionId == s.appSessionId {
+		// the cache of the current app instance has already been invalidated
+		return
+	}
+
+	switch inv.Type {
+	case authorizationpb.CacheInvalidationTypeEnum_PERMISSIONS:
+		s.cache.InvalidatePermissions(inv.PermissionIds)
+	case authorizationpb.CacheInvalidationTypeEnum_ALL_PERMISSIONS:
+		s.cache.InvalidateAllPermissions()
+	case authorizationpb.CacheInvalidationTypeEnum_USER:
+		s.cache.InvalidateUser(inv.UserId)
+	case authorizationpb.CacheInvalidationTypeEnum_GROUP:
+		s.cache.InvalidateGroup(groupmodels.UserGroup(inv.Group))
+	case authorizationpb.CacheInvalidationTypeEnum_CLIENT:
+		s.cache.InvalidateClient(inv.ClientId)
+	default:
+		s.cache.InvalidateAll()
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.AuthorizationCacheEvent,
+		"[invalidation.CacheInvalidationService.processMessage] cache has been invalidated",
+		append(fs,
+			logging.NewField("type", inv.Type),
+			logging.NewField("permissionIds", inv.PermissionIds),
+			logging.NewField("userId", inv.UserId),
+			logging.NewField("group", inv.Group),
+			logging.NewField("clientId", inv.ClientId),
+		)...,
+	)
+}
@@ -0,0 +1,342 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invalidation
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authorizationpb "personal-website-v2/go-data/identity/authorization"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/datetime"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	defaultProducerKafkaClientId = "IdentityAuthzCacheInvalidator"
+)
+
+type CacheInvalidatorConfig struct {
+	Kafka *CacheInvalidatorKafkaConfig
+}
+
+type CacheInvalidatorKafkaConfig struct {
+	Config        *kafka.Config
+	AsyncProducer bool
+
+	// The topic to which cache invalidations are sent.
+	Topic string
+}
+
+// CacheInvalidator invalidates the authorization cache of the current app instance
+// and sends cache invalidations to Kafka to notify other app instances of the invalidation.
+type CacheInvalidator struct {
+	appSessionId    uint64
+	cache           authorization.AuthorizationCache
+	config          *CacheInvalidatorConfig
+	opExecutor      *actionhelper.OperationExecutor
+	kMsgIdGenerator *kafka.MessageIdGenerator
+	producer        kafka.Producer
+	logger          logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx       *lcontext.LogEntryContext
+	disposed        atomic.Bool
+}
+
+var _ authorization.AuthorizationCacheInvalidator = (*CacheInvalidator)(nil)
+
+func NewCacheInvalidator(
+	appSessionId uint64,
+	cache authorization.AuthorizationCache,
+	config *CacheInvalidatorConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*CacheInvalidator, error) {
+	l, err := loggerFactory.CreateLogger("internal.authorization.cache.invalidation.CacheInvalidator")
+	if err != nil {
+		return nil, fmt.Errorf("[invalidation.NewCacheInvalidator] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCacheStorage,
+		DefaultGroup:    iactions.OperationGroupAuthorizationCache,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[invalidation.NewCacheInvalidator] new operation executor: %w", err)
+	}
+
+	i := &CacheInvalidator{
+		appSessionId: appSessionId,
+		cache:        cache,
+		config:       config,
+		opExecutor:   e,
+		logger:       l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}
+
+	if config.Kafka.Config.Producer.OnCompletion == nil {
+		config.Kafka.Config.Producer.OnCompletion = i.onCompletion
+	}
+	if len(config.Kafka.Config.ClientId) == 0 {
+		config.Kafka.Config.ClientId = defaultProducerKafkaClientId
+	}
+
+	p, err := kafka.NewProducer(config.Kafka.Config, config.Kafka.AsyncProducer)
+	if err != nil {
+		return nil, fmt.Errorf("[invalidation.NewCacheInvalidator] new producer: %w", err)
+	}
+
+	kMsgIdGenerator, err := kafka.NewMessageIdGenerator(appSessionId, uint32(runtime.NumCPU()*2))
+	if err != nil {
+		return nil, fmt.Errorf("[invalidation.NewCacheInvalidator] new message id generator: %w", err)
+	}
+
+	i.kMsgIdGenerator = kMsgIdGenerator
+	i.producer = p
+	return i, nil
+}
+
+// InvalidatePermissions invalidates the cached roles of the specified permissions.
+func (i *CacheInvalidator) InvalidatePermissions(ctx *actions.OperationContext, permissionIds []uint64) error {
+	if i.disposed.Load() {
+		return errors.New("[invalidation.CacheInvalidator.InvalidatePermissions] CacheInvalidator was disposed")
+	}
+
+	err := i.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationCacheInvalidator_InvalidatePermissions,
+		[]*actions.OperationParam{actions.NewOperationParam("permissionIds", permissionIds)},
+		func(opCtx *actions.OperationContext) error {
+			if len(permissionIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of permission ids is 0")
+			}
+
+			i.cache.InvalidatePermissions(permissionIds)
+			inv := &authorizationpb.CacheInvalidation{
+				Type:          authorizationpb.CacheInvalidationTypeEnum_PERMISSIONS,
+				PermissionIds: permissionIds,
+			}
+			if err := i.send(opCtx, inv); err != nil {
+				return fmt.Errorf("[invalidation.CacheInvalidator.InvalidatePermissions] send a cache invalidation: %w", err)
+			}
+
+			i.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.AuthorizationCacheEvent,
+				"[invalidation.CacheInvalidator.InvalidatePermissions] cached roles of the permissions have been invalidated",
+				logging.NewField("permissionIds", permissionIds),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.InvalidatePermissions] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// InvalidateAllPermissions invalidates the cached roles of all permissions.
+func (i *CacheInvalidator) InvalidateAllPermissions(ctx *actions.OperationContext) error {
+	if i.disposed.Load() {
+		return errors.New("[invalidation.CacheInvalidator.InvalidateAllPermissions] CacheInvalidator was disposed")
+	}
+
+	err := i.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationCacheInvalidator_InvalidateAllPermissions, nil,
+		func(opCtx *actions.OperationContext) error {
+			i.cache.InvalidateAllPermissions()
+			inv := &authorizationpb.CacheInvalidation{Type: authorizationpb.CacheInvalidationTypeEnum_ALL_PERMISSIONS}
+			if err := i.send(opCtx, inv); err != nil {
+				return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateAllPermissions] send a cache invalidation: %w", err)
+			}
+
+			i.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.AuthorizationCacheEvent,
+				"[invalidation.CacheInvalidator.InvalidateAllPermissions] cached roles of all permissions have been invalidated",
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateAllPermissions] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// InvalidateUser invalidates the cached roles, resource roles, group, status and member groups of the user.
+func (i *CacheInvalidator) InvalidateUser(ctx *actions.OperationContext, userId uint64) error {
+	if i.disposed.Load() {
+		return errors.New("[invalidation.CacheInvalidator.InvalidateUser] CacheInvalidator was disposed")
+	}
+
+	err := i.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationCacheInvalidator_InvalidateUser,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			i.cache.InvalidateUser(userId)
+			inv := &authorizationpb.CacheInvalidation{
+				Type:   authorizationpb.CacheInvalidationTypeEnum_USER,
+				UserId: userId,
+			}
+			if err := i.send(opCtx, inv); err != nil {
+				return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateUser] send a cache invalidation: %w", err)
+			}
+
+			i.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.AuthorizationCacheEvent,
+				"[invalidation.CacheInvalidator.InvalidateUser] cached data of the user has been invalidated",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateUser] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// InvalidateGroup invalidates the cached roles and resource roles of the group.
+func (i *CacheInvalidator) InvalidateGroup(ctx *actions.OperationContext, group groupmodels.UserGroup) error {
+	if i.disposed.Load() {
+		return errors.New("[invalidation.CacheInvalidator.InvalidateGroup] CacheInvalidator was disposed")
+	}
+
+	err := i.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationCacheInvalidator_InvalidateGroup,
+		[]*actions.OperationParam{actions.NewOperationParam("group", group)},
+		func(opCtx *actions.OperationContext) error {
+			i.cache.InvalidateGroup(group)
+			inv := &authorizationpb.CacheInvalidation{
+				Type:  authorizationpb.CacheInvalidationTypeEnum_GROUP,
+				Group: uint64(group),
+			}
+			if err := i.send(opCtx, inv); err != nil {
+				return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateGroup] send a cache invalidation: %w", err)
+			}
+
+			i.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.AuthorizationCacheEvent,
+				"[invalidation.CacheInvalidator.InvalidateGroup] cached roles of the group have been invalidated",
+				logging.NewField("group", group),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateGroup] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// InvalidateClient invalidates the cached roles and resource roles of the service client.
+func (i *CacheInvalidator) InvalidateClient(ctx *actions.OperationContext, clientId uint64) error {
+	if i.disposed.Load() {
+		return errors.New("[invalidation.CacheInvalidator.InvalidateClient] CacheInvalidator was disposed")
+	}
+
+	err := i.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationCacheInvalidator_InvalidateClient,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			i.cache.InvalidateClient(clientId)
+			inv := &authorizationpb.CacheInvalidation{
+				Type:     authorizationpb.CacheInvalidationTypeEnum_CLIENT,
+				ClientId: clientId,
+			}
+			if err := i.send(opCtx, inv); err != nil {
+				return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateClient] send a cache invalidation: %w", err)
+			}
+
+			i.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.AuthorizationCacheEvent,
+				"[invalidation.CacheInvalidator.InvalidateClient] cached roles of the client have been invalidated",
+				logging.NewField("clientId", clientId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.InvalidateClient] execute an operation: %w", err)
+	}
+	return nil
+}
+
+func (i *CacheInvalidator) send(ctx *actions.OperationContext, inv *authorizationpb.CacheInvalidation) error {
+	tranId := ctx.Transaction.Id()
+	inv.CreatedAt = timestamppb.New(datetime.Now())
+	inv.Metadata = &authorizationpb.CacheInvalidationMetadata{
+		AppSessionId: i.appSessionId,
+		TranId:       tranId.String(),
+	}
+
+	b, err := proto.Marshal(inv)
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.send] marshal a cache invalidation to Protobuf: %w", err)
+	}
+
+	msgId, err := i.kMsgIdGenerator.Get()
+	if err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.send] get id from kMsgIdGenerator: %w", err)
+	}
+
+	msg := &kafka.ProducerMessage{
+		Topic:    i.config.Kafka.Topic,
+		Headers:  kafka.RecordHeaders{metadata.MessageIdHeader(msgId)},
+		Key:      tranId[:],
+		Value:    b,
+		Metadata: inv,
+	}
+
+	if err = i.producer.SendMessage(msg); err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.send] send a message: %w", err)
+	}
+	return nil
+}
+
+func (i *CacheInvalidator) onCompletion(msg *kafka.ProducerMessage, err error) {
+	if err == nil {
+		return
+	}
+
+	inv := msg.Metadata.(*authorizationpb.CacheInvalidation)
+	i.logger.ErrorWithEvent(i.loggerCtx, events.AuthorizationCacheEvent, err,
+		"[invalidation.CacheInvalidator.onCompletion] an error occurred while sending a cache invalidation to kafka",
+		logging.NewField("type", inv.Type),
+		logging.NewField("permissionIds", inv.PermissionIds),
+		logging.NewField("userId", inv.UserId),
+		logging.NewField("group", inv.Group),
+		logging.NewField("clientId", inv.ClientId),
+	)
+}
+
+// Dispose disposes of the CacheInvalidator.
+func (i *CacheInvalidator) Dispose() error {
+	if i.disposed.Load() {
+		return nil
+	}
+
+	if err := i.producer.Close(); err != nil {
+		return fmt.Errorf("[invalidation.CacheInvalidator.Dispose] close a producer: %w", err)
+	}
+
+	i.disposed.Store(true)
+	return nil
+}
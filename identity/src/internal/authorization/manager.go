@@ -16,6 +16,7 @@ package authorization
 
 import (
 	"personal-website-v2/identity/src/internal/authorization/models"
+	roledbmodels "personal-website-v2/identity/src/internal/roles/dbmodels"
 	"personal-website-v2/pkg/actions"
 	"personal-website-v2/pkg/base/nullable"
 )
@@ -23,5 +24,16 @@ import (
 // AuthorizationManager is an authorization manager.
 type AuthorizationManager interface {
 	// Authorize authorizes a user and returns the authorization result if the operation is successful.
-	Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64) (*models.AuthorizationResult, error)
+	// If resources are specified, then a permission that isn't granted to the user (client) regardless of the resources
+	// must be granted for each of the resources by the resource role assignments.
+	Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64, resources []*models.Resource,
+	) (*models.AuthorizationResult, error)
+
+	// Explain explains the authorization of a user (client) for the specified permissions regardless of the resources.
+	// Unlike Authorize, it doesn't use the authorization cache and doesn't fail if a permission isn't granted.
+	Explain(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], permissionIds []uint64) (*models.AuthorizationExplanation, error)
+
+	// GetAllRoleAssignmentsByPermissionId gets all active role assignments of the roles that are granted
+	// the specified permission, directly or by inheritance.
+	GetAllRoleAssignmentsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]*roledbmodels.RoleAssignment, error)
 }
@@ -19,6 +19,9 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
+
+	"golang.org/x/exp/slices"
 
 	iactions "personal-website-v2/identity/src/internal/actions"
 	"personal-website-v2/identity/src/internal/authorization"
@@ -26,10 +29,16 @@ import (
 	"personal-website-v2/identity/src/internal/clients"
 	clientmodels "personal-website-v2/identity/src/internal/clients/models"
 	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
 	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/permissions"
+	permissiondbmodels "personal-website-v2/identity/src/internal/permissions/dbmodels"
+	"personal-website-v2/identity/src/internal/resources"
+	resourcemodels "personal-website-v2/identity/src/internal/resources/models"
 	"personal-website-v2/identity/src/internal/roles"
+	roledbmodels "personal-website-v2/identity/src/internal/roles/dbmodels"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
 	"personal-website-v2/identity/src/internal/users"
 	usermodels "personal-website-v2/identity/src/internal/users/models"
 	"personal-website-v2/pkg/actions"
@@ -50,9 +59,16 @@ type AuthorizationManager struct {
 	opExecutor            *actionhelper.OperationExecutor
 	userManager           users.UserManager
 	clientManager         clients.ClientManager
+	permissionManager     permissions.PermissionManager
+	roleManager           roles.RoleManager
+	raManager             roles.RoleAssignmentManager
 	uraManager            roles.UserRoleAssignmentManager
 	graManager            roles.GroupRoleAssignmentManager
+	craManager            roles.ClientRoleAssignmentManager
 	rolePermissionManager permissions.RolePermissionManager
+	groupMemberManager    groups.UserGroupMemberManager
+	rraManager            resources.ResourceRoleAssignmentManager
+	cache                 authorization.AuthorizationCache
 	logger                logging.Logger[*context.LogEntryContext]
 }
 
@@ -61,9 +77,16 @@ var _ authorization.AuthorizationManager = (*AuthorizationManager)(nil)
 func NewAuthorizationManager(
 	userManager users.UserManager,
 	clientManager clients.ClientManager,
+	permissionManager permissions.PermissionManager,
+	roleManager roles.RoleManager,
+	raManager roles.RoleAssignmentManager,
 	uraManager roles.UserRoleAssignmentManager,
 	graManager roles.GroupRoleAssignmentManager,
+	craManager roles.ClientRoleAssignmentManager,
 	rolePermissionManager permissions.RolePermissionManager,
+	groupMemberManager groups.UserGroupMemberManager,
+	rraManager resources.ResourceRoleAssignmentManager,
+	cache authorization.AuthorizationCache,
 	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
 ) (*AuthorizationManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.authorization.manager.AuthorizationManager")
@@ -86,32 +109,53 @@ func NewAuthorizationManager(
 		opExecutor:            e,
 		userManager:           userManager,
 		clientManager:         clientManager,
+		permissionManager:     permissionManager,
+		roleManager:           roleManager,
+		raManager:             raManager,
 		uraManager:            uraManager,
 		graManager:            graManager,
+		craManager:            craManager,
 		rolePermissionManager: rolePermissionManager,
+		groupMemberManager:    groupMemberManager,
+		rraManager:            rraManager,
+		cache:                 cache,
 		logger:                l,
 	}, nil
 }
 
 // Authorize authorizes a user and returns the authorization result if the operation is successful.
-func (m *AuthorizationManager) Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64) (*models.AuthorizationResult, error) {
+// If resources are specified, then a permission that isn't granted to the user (client) regardless of the resources
+// must be granted for each of the resources by the resource role assignments.
+func (m *AuthorizationManager) Authorize(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64,
+	resources []*models.Resource,
+) (*models.AuthorizationResult, error) {
 	var result *models.AuthorizationResult
 	err := m.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationManager_Authorize,
 		[]*actions.OperationParam{
 			actions.NewOperationParam("userId", userId.Ptr()),
 			actions.NewOperationParam("clientId", clientId.Ptr()),
 			actions.NewOperationParam("requiredPermissionIds", requiredPermissionIds),
+			actions.NewOperationParam("resources", resources),
 		},
 		func(opCtx *actions.OperationContext) error {
 			if len(requiredPermissionIds) == 0 {
 				return errs.NewError(errs.ErrorCodeInvalidData, "number of required permission ids is 0")
 			}
+			for _, r := range resources {
+				if r == nil || r.TypeId == 0 {
+					return errs.NewError(errs.ErrorCodeInvalidData, "invalid resource")
+				}
+			}
 
 			var err error
 			if userId.HasValue {
-				if result, err = m.authorizeUser(opCtx, userId.Value, requiredPermissionIds); err != nil {
+				if result, err = m.authorizeUser(opCtx, userId.Value, requiredPermissionIds, resources); err != nil {
 					return fmt.Errorf("[manager.AuthorizationManager.Authorize] authorize a user: %w", err)
 				}
+			} else if clientId.HasValue && clientmodels.ClientType(byte(clientId.Value)) == clientmodels.ClientTypeService {
+				if result, err = m.authorizeServiceClient(opCtx, clientId.Value, requiredPermissionIds, resources); err != nil {
+					return fmt.Errorf("[manager.AuthorizationManager.Authorize] authorize a service client: %w", err)
+				}
 			} else if result, err = m.authorizeAsAnonymousUser(opCtx, clientId, requiredPermissionIds); err != nil {
 				return fmt.Errorf("[manager.AuthorizationManager.Authorize] authorize as an anonymous user: %w", err)
 			}
@@ -122,6 +166,7 @@ func (m *AuthorizationManager) Authorize(ctx *actions.OperationContext, userId,
 				"[manager.AuthorizationManager.Authorize] user has been authorized",
 				logging.NewField("userId", userId.Ptr()),
 				logging.NewField("clientId", clientId.Ptr()),
+				logging.NewField("resources", resources),
 				logging.NewField("userGroup", result.Group),
 				logging.NewField("permissionRoles", result.PermissionRoles),
 			)
@@ -134,8 +179,173 @@ func (m *AuthorizationManager) Authorize(ctx *actions.OperationContext, userId,
 	return result, nil
 }
 
-func (m *AuthorizationManager) authorizeUser(ctx *actions.OperationContext, userId uint64, requiredPermissionIds []uint64) (*models.AuthorizationResult, error) {
-	ug, us, err := m.userManager.GetGroupAndStatusById(ctx, userId)
+// Explain explains the authorization of a user (client) for the specified permissions regardless of the resources.
+// Unlike Authorize, it doesn't use the authorization cache and doesn't fail if a permission isn't granted.
+func (m *AuthorizationManager) Explain(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64], permissionIds []uint64,
+) (*models.AuthorizationExplanation, error) {
+	var e *models.AuthorizationExplanation
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationManager_Explain,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId.Ptr()),
+			actions.NewOperationParam("clientId", clientId.Ptr()),
+			actions.NewOperationParam("permissionIds", permissionIds),
+		},
+		func(opCtx *actions.OperationContext) error {
+			if len(permissionIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of permission ids is 0")
+			}
+
+			e = &models.AuthorizationExplanation{}
+			isAnonymous := true
+			isActive := true
+			var ras map[uint64][]*models.RoleAssignmentExplanation // the role assignments by role ID
+
+			if userId.HasValue {
+				ug, us, err := m.userManager.GetGroupAndStatusById(opCtx, userId.Value)
+				if err != nil {
+					return fmt.Errorf("[manager.AuthorizationManager.Explain] get a group and a status of the user by id: %w", err)
+				}
+
+				mgs, err := m.groupMemberManager.GetAllGroupIdsByUserId(opCtx, userId.Value)
+				if err != nil {
+					return fmt.Errorf("[manager.AuthorizationManager.Explain] get all group ids by user id: %w", err)
+				}
+
+				e.Group = ug
+				e.MemberGroups = mgs
+				e.UserStatus = nullable.NewNullable(us)
+				isAnonymous = false
+				isActive = us == usermodels.UserStatusActive
+
+				gs := make([]groupmodels.UserGroup, 0, len(mgs)+1)
+				gs = append(gs, ug)
+				gs = append(gs, mgs...)
+
+				if ras, err = m.getUserAndGroupRoleAssignments(opCtx, userId.Value, gs); err != nil {
+					return fmt.Errorf("[manager.AuthorizationManager.Explain] get user and group role assignments: %w", err)
+				}
+			} else {
+				if clientId.HasValue {
+					cs, err := m.clientManager.GetStatusById(opCtx, clientId.Value)
+					if err != nil {
+						return fmt.Errorf("[manager.AuthorizationManager.Explain] get a client status by id: %w", err)
+					}
+					e.ClientStatus = nullable.NewNullable(cs)
+					isActive = cs == clientmodels.ClientStatusActive
+				}
+
+				if clientId.HasValue && clientmodels.ClientType(byte(clientId.Value)) == clientmodels.ClientTypeService {
+					// service clients don't belong to any user group
+					isAnonymous = false
+
+					var err error
+					if ras, err = m.getClientRoleAssignments(opCtx, clientId.Value); err != nil {
+						return fmt.Errorf("[manager.AuthorizationManager.Explain] get client role assignments: %w", err)
+					}
+				} else {
+					e.Group = groupmodels.UserGroupAnonymousUsers
+				}
+			}
+
+			ps, err := m.permissionManager.GetAllByIds(opCtx, permissionIds)
+			if err != nil {
+				return fmt.Errorf("[manager.AuthorizationManager.Explain] get all permissions by ids: %w", err)
+			}
+
+			e.Permissions = make([]*models.PermissionExplanation, len(permissionIds))
+			for i, pid := range permissionIds {
+				pe := &models.PermissionExplanation{PermissionId: pid}
+				e.Permissions[i] = pe
+
+				idx := slices.IndexFunc(ps, func(p *permissiondbmodels.Permission) bool { return p.Id == pid })
+				if idx < 0 {
+					pe.MissingLink = models.MissingLinkPermission
+					continue
+				}
+
+				pe.PermissionName = ps[idx].Name
+				pe.PermissionStatus = ps[idx].Status
+				if pe.Roles, err = m.explainRoles(opCtx, pid, ras); err != nil {
+					return fmt.Errorf("[manager.AuthorizationManager.Explain] explain roles: %w", err)
+				}
+
+				if len(pe.Roles) == 0 {
+					pe.MissingLink = models.MissingLinkRole
+					continue
+				}
+
+				var hasRole bool
+				if isAnonymous {
+					hasRole = slices.ContainsFunc(pe.Roles, func(r *models.RoleExplanation) bool { return r.RoleId == anonymousUserRoleId })
+				} else {
+					hasRole = slices.ContainsFunc(pe.Roles, func(r *models.RoleExplanation) bool {
+						return slices.ContainsFunc(r.Assignments, func(a *models.RoleAssignmentExplanation) bool { return a.Effective })
+					})
+				}
+
+				if !hasRole {
+					pe.MissingLink = models.MissingLinkRoleAssignment
+				} else if !isActive {
+					pe.MissingLink = models.MissingLinkAssigneeStatus
+				} else {
+					pe.Granted = true
+				}
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.AuthorizationEvent,
+				"[manager.AuthorizationManager.Explain] authorization has been explained",
+				logging.NewField("userId", userId.Ptr()),
+				logging.NewField("clientId", clientId.Ptr()),
+				logging.NewField("permissionIds", permissionIds),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.Explain] execute an operation: %w", err)
+	}
+	return e, nil
+}
+
+// GetAllRoleAssignmentsByPermissionId gets all active role assignments of the roles that are granted
+// the specified permission, directly or by inheritance.
+func (m *AuthorizationManager) GetAllRoleAssignmentsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]*roledbmodels.RoleAssignment, error) {
+	var as []*roledbmodels.RoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeAuthorizationManager_GetAllRoleAssignmentsByPermissionId,
+		[]*actions.OperationParam{actions.NewOperationParam("permissionId", permissionId)},
+		func(opCtx *actions.OperationContext) error {
+			// the permission must exist
+			if _, err := m.permissionManager.GetStatusById(opCtx, permissionId); err != nil {
+				return fmt.Errorf("[manager.AuthorizationManager.GetAllRoleAssignmentsByPermissionId] get a permission status by id: %w", err)
+			}
+
+			rIds, err := m.rolePermissionManager.GetAllRoleIdsByPermissionId(opCtx, permissionId)
+			if err != nil {
+				return fmt.Errorf("[manager.AuthorizationManager.GetAllRoleAssignmentsByPermissionId] get all role ids by permission id: %w", err)
+			}
+			if len(rIds) == 0 {
+				return nil
+			}
+
+			if as, err = m.raManager.GetAllActiveByRoleIds(opCtx, rIds); err != nil {
+				return fmt.Errorf("[manager.AuthorizationManager.GetAllRoleAssignmentsByPermissionId] get all active role assignments by role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.GetAllRoleAssignmentsByPermissionId] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+func (m *AuthorizationManager) authorizeUser(ctx *actions.OperationContext, userId uint64, requiredPermissionIds []uint64, resources []*models.Resource,
+) (*models.AuthorizationResult, error) {
+	ug, us, err := m.cache.GetUserGroupAndStatus(userId, func() (groupmodels.UserGroup, usermodels.UserStatus, error) {
+		return m.userManager.GetGroupAndStatusById(ctx, userId)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get a group and a status of the user by id: %w", err)
 	}
@@ -143,112 +353,68 @@ func (m *AuthorizationManager) authorizeUser(ctx *actions.OperationContext, user
 		return nil, errs.NewError(errs.ErrorCodeInvalidOperation, fmt.Sprintf("invalid user's status (%v)", us))
 	}
 
-	pslen := len(requiredPermissionIds)
-	prs := make([][]uint64, pslen)
+	mgs, err := m.cache.GetUserMemberGroups(userId, func() ([]groupmodels.UserGroup, error) {
+		return m.groupMemberManager.GetAllGroupIdsByUserId(ctx, userId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get member groups of the user: %w", err)
+	}
 
-	if pslen == 1 {
-		if prs[0], err = m.rolePermissionManager.GetAllRoleIdsByPermissionId(ctx, requiredPermissionIds[0]); err != nil {
-			return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get all role ids by permission id: %w", err)
-		}
-		if len(prs[0]) == 0 {
-			// no roles with the required permission
+	// the user's group precedes the groups of which the user is a member
+	gs := make([]groupmodels.UserGroup, 0, len(mgs)+1)
+	gs = append(gs, ug)
+	gs = append(gs, mgs...)
+
+	// prs contains both the roles that are granted the required permissions and the roles
+	// that inherit them, so the user's and group's roles are checked against the effective permissions
+	prs, err := m.getAllRoleIdsByPermissionIds(ctx, requiredPermissionIds)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get all role ids by permission ids: %w", err)
+	}
+
+	pslen := len(requiredPermissionIds)
+	for i := 0; i < pslen; i++ {
+		if len(prs[i]) == 0 {
+			// no roles with the required permission(s)
 			return nil, ierrors.ErrPermissionNotGranted
 		}
+	}
 
-		if prs[0], err = m.getCombinedUserAndGroupRoles(ctx, userId, ug, prs[0]); err != nil {
+	prs2 := make([]*models.PermissionWithRoles, 0, pslen)
+	var rrs []*models.ResourceRole // the resource roles of the user and groups, loaded if needed
+	for i := 0; i < pslen; i++ {
+		rs, err := m.getCombinedUserAndGroupRoles(ctx, userId, gs, prs[i])
+		if err != nil {
 			return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get combined user and group roles: %w", err)
-		} else if len(prs[0]) == 0 {
-			// none of the necessary roles are assigned to the user and group
-			return nil, ierrors.ErrPermissionNotGranted
 		}
-	} else {
-		var areGranted, hasErr atomic.Bool
-		var wg sync.WaitGroup
-		areGranted.Store(true)
-		wg.Add(pslen)
-
-		for i := 0; i < pslen; i++ {
-			go func(idx int) {
-				defer wg.Done()
-				defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
-					hasErr.Store(true)
-					m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent,
-						errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[manager.AuthorizationManager.authorizeUser] panic: ", p.Value), p.StackTrace),
-						"[manager.AuthorizationManager.authorizeUser] an error occurred while getting all role ids by permission id",
-					)
-				})
-
-				var err error
-				if prs[idx], err = m.rolePermissionManager.GetAllRoleIdsByPermissionId(ctx, requiredPermissionIds[idx]); err != nil {
-					hasErr.Store(true)
-					m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent, err,
-						"[manager.AuthorizationManager.authorizeUser] get all role ids by permission id",
-						logging.NewField("permissionId", requiredPermissionIds[idx]),
-					)
-				} else if len(prs[idx]) == 0 {
-					areGranted.Store(false)
-				}
-			}(i)
+		if len(rs) > 0 {
+			prs2 = append(prs2, &models.PermissionWithRoles{PermissionId: requiredPermissionIds[i], RoleIds: rs})
+			continue
 		}
-		wg.Wait()
-
-		if hasErr.Load() {
-			return nil, errors.New("[manager.AuthorizationManager.authorizeUser] an error occurred while getting all role ids by permission id")
-		}
-		if !areGranted.Load() {
-			// no roles with the required permission(s)
+		if len(resources) == 0 {
+			// none of the necessary roles are assigned to the user and groups
 			return nil, ierrors.ErrPermissionNotGranted
 		}
 
-		wg.Add(pslen)
-		for i := 0; i < pslen; i++ {
-			go func(idx int) {
-				defer wg.Done()
-				defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
-					hasErr.Store(true)
-					m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent,
-						errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[manager.AuthorizationManager.authorizeUser] panic: ", p.Value), p.StackTrace),
-						"[manager.AuthorizationManager.authorizeUser] an error occurred while getting combined user and group roles",
-					)
-				})
-
-				rf := prs[idx]
-				var err error
-				if prs[idx], err = m.getCombinedUserAndGroupRoles(ctx, userId, ug, rf); err != nil {
-					hasErr.Store(true)
-					m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent, err,
-						"[manager.AuthorizationManager.authorizeUser] get combined user and group roles",
-						logging.NewField("userId", userId),
-						logging.NewField("group", ug),
-						logging.NewField("roleFilter", rf),
-					)
-				} else if len(prs[idx]) == 0 {
-					areGranted.Store(false)
-				}
-			}(i)
-		}
-		wg.Wait()
-
-		if hasErr.Load() {
-			return nil, errors.New("[manager.AuthorizationManager.authorizeUser] an error occurred while getting combined user and group roles")
+		if rrs == nil {
+			if rrs, err = m.getCombinedUserAndGroupResourceRoles(ctx, userId, gs); err != nil {
+				return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeUser] get combined user and group resource roles: %w", err)
+			}
 		}
-		if !areGranted.Load() {
-			// no roles with the required permission(s)
+		if !isGrantedForResources(rrs, prs[i], resources, nullable.NewNullable(userId)) {
+			// none of the necessary roles are assigned to the user and groups for at least one of the resources
 			return nil, ierrors.ErrPermissionNotGranted
 		}
 	}
 
-	prs2 := make([]*models.PermissionWithRoles, pslen)
-	for i := 0; i < pslen; i++ {
-		prs2[i] = &models.PermissionWithRoles{PermissionId: requiredPermissionIds[i], RoleIds: prs[i]}
-	}
-
 	return &models.AuthorizationResult{
 		Group:           ug,
 		PermissionRoles: prs2,
 	}, nil
 }
 
+// authorizeAsAnonymousUser authorizes an anonymous user.
+// Anonymous users have no resource roles, so only the permissions granted regardless of the resources are checked.
 func (m *AuthorizationManager) authorizeAsAnonymousUser(ctx *actions.OperationContext, clientId nullable.Nullable[uint64], requiredPermissionIds []uint64) (*models.AuthorizationResult, error) {
 	if clientId.HasValue {
 		cs, err := m.clientManager.GetStatusById(ctx, clientId.Value)
@@ -260,92 +426,397 @@ func (m *AuthorizationManager) authorizeAsAnonymousUser(ctx *actions.OperationCo
 		}
 	}
 
-	areGranted, err := m.rolePermissionManager.AreGranted(ctx, anonymousUserRoleId, requiredPermissionIds)
+	prs, err := m.getAllRoleIdsByPermissionIds(ctx, requiredPermissionIds)
 	if err != nil {
-		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeAsAnonymousUser] are all required permissions granted to the role: %w", err)
-	}
-	if !areGranted {
-		return nil, ierrors.ErrPermissionNotGranted
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeAsAnonymousUser] get all role ids by permission ids: %w", err)
 	}
 
 	pslen := len(requiredPermissionIds)
-	prs := make([]*models.PermissionWithRoles, pslen)
+	prs2 := make([]*models.PermissionWithRoles, pslen)
 	for i := 0; i < pslen; i++ {
-		prs[i] = &models.PermissionWithRoles{PermissionId: requiredPermissionIds[i], RoleIds: []uint64{anonymousUserRoleId}}
+		if !slices.Contains(prs[i], anonymousUserRoleId) {
+			return nil, ierrors.ErrPermissionNotGranted
+		}
+		prs2[i] = &models.PermissionWithRoles{PermissionId: requiredPermissionIds[i], RoleIds: []uint64{anonymousUserRoleId}}
 	}
 
 	return &models.AuthorizationResult{
 		Group:           groupmodels.UserGroupAnonymousUsers,
-		PermissionRoles: prs,
+		PermissionRoles: prs2,
 	}, nil
 }
 
-func (m *AuthorizationManager) getCombinedUserAndGroupRoles(ctx *actions.OperationContext, userId uint64, group groupmodels.UserGroup, roleFilter []uint64) ([]uint64, error) {
-	urIds, err := m.uraManager.GetUserRoleIdsByUserId(ctx, userId, roleFilter)
+func (m *AuthorizationManager) authorizeServiceClient(ctx *actions.OperationContext, clientId uint64, requiredPermissionIds []uint64, resources []*models.Resource,
+) (*models.AuthorizationResult, error) {
+	cs, err := m.clientManager.GetStatusById(ctx, clientId)
 	if err != nil {
-		return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupRoles] get user's role ids by user id: %w", err)
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeServiceClient] get a client status by id: %w", err)
+	}
+	if cs != clientmodels.ClientStatusActive {
+		return nil, errs.NewError(errs.ErrorCodeInvalidOperation, fmt.Sprintf("invalid client status (%v)", cs))
+	}
+
+	prs, err := m.getAllRoleIdsByPermissionIds(ctx, requiredPermissionIds)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeServiceClient] get all role ids by permission ids: %w", err)
+	}
+
+	crIds, err := m.cache.GetClientRoleIds(clientId, func() ([]uint64, error) {
+		return m.craManager.GetClientRoleIdsByClientId(ctx, clientId, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeServiceClient] get client's role ids by client id: %w", err)
 	}
 
-	urIdsLen := len(urIds)
-	roleFilterLen := len(roleFilter)
+	pslen := len(requiredPermissionIds)
+	prs2 := make([]*models.PermissionWithRoles, 0, pslen)
+	var crrs []*models.ResourceRole // the resource roles of the client, loaded if needed
+	for i := 0; i < pslen; i++ {
+		var rs []uint64
+		for _, id := range prs[i] {
+			if slices.Contains(crIds, id) {
+				rs = append(rs, id)
+			}
+		}
+		if len(rs) > 0 {
+			prs2 = append(prs2, &models.PermissionWithRoles{PermissionId: requiredPermissionIds[i], RoleIds: rs})
+			continue
+		}
+		if len(resources) == 0 {
+			// none of the necessary roles are assigned to the client
+			return nil, ierrors.ErrPermissionNotGranted
+		}
 
-	if urIdsLen > roleFilterLen {
-		return nil, errs.NewError(errs.ErrorCodeInternalError, "number of user's roles is greater than the roles in the filter")
+		if crrs == nil {
+			if crrs, err = m.cache.GetClientResourceRoles(clientId, func() ([]*models.ResourceRole, error) {
+				return m.loadResourceRoles(ctx, clientId, rolemodels.AssigneeTypeClient)
+			}); err != nil {
+				return nil, fmt.Errorf("[manager.AuthorizationManager.authorizeServiceClient] get client's resource roles: %w", err)
+			}
+		}
+		// the owner rule doesn't apply to service clients
+		if !isGrantedForResources(crrs, prs[i], resources, nullable.Nullable[uint64]{}) {
+			// none of the necessary roles are assigned to the client for at least one of the resources
+			return nil, ierrors.ErrPermissionNotGranted
+		}
 	}
-	if urIdsLen == roleFilterLen {
-		return urIds, nil
+
+	// service clients don't belong to any user group
+	return &models.AuthorizationResult{PermissionRoles: prs2}, nil
+}
+
+// getAllRoleIdsByPermissionIds gets the IDs of the roles that have the specified permissions,
+// including the roles that inherit the permissions from their parent roles.
+// The role IDs that are missing from the cache are loaded concurrently.
+func (m *AuthorizationManager) getAllRoleIdsByPermissionIds(ctx *actions.OperationContext, permissionIds []uint64) ([][]uint64, error) {
+	pslen := len(permissionIds)
+	prs := make([][]uint64, pslen)
+
+	if pslen == 1 {
+		var err error
+		if prs[0], err = m.getAllRoleIdsByPermissionId(ctx, permissionIds[0]); err != nil {
+			return nil, fmt.Errorf("[manager.AuthorizationManager.getAllRoleIdsByPermissionIds] get all role ids by permission id: %w", err)
+		}
+		return prs, nil
 	}
 
-	var rs, rf []uint64
-	rflen := roleFilterLen - urIdsLen
-	idx := 0
+	var hasErr atomic.Bool
+	var wg sync.WaitGroup
+	wg.Add(pslen)
 
-	if urIdsLen > 0 {
-		rs = make([]uint64, roleFilterLen)
-		rf = make([]uint64, rflen)
-		rfIdx := 0
-	MainLoop:
-		for i := 0; i < roleFilterLen; i++ {
-			id := roleFilter[i]
+	for i := 0; i < pslen; i++ {
+		go func(idx int) {
+			defer wg.Done()
+			defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+				hasErr.Store(true)
+				m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent,
+					errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[manager.AuthorizationManager.getAllRoleIdsByPermissionIds] panic: ", p.Value), p.StackTrace),
+					"[manager.AuthorizationManager.getAllRoleIdsByPermissionIds] an error occurred while getting all role ids by permission id",
+				)
+			})
 
-			if idx+rflen < roleFilterLen {
-				for j := 0; j < urIdsLen; j++ {
-					if urIds[j] == id {
-						rs[idx] = id
-						idx++
-						continue MainLoop
-					}
-				}
+			var err error
+			if prs[idx], err = m.getAllRoleIdsByPermissionId(ctx, permissionIds[idx]); err != nil {
+				hasErr.Store(true)
+				m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.AuthorizationEvent, err,
+					"[manager.AuthorizationManager.getAllRoleIdsByPermissionIds] get all role ids by permission id",
+					logging.NewField("permissionId", permissionIds[idx]),
+				)
 			}
-			if rfIdx == rflen {
-				return nil, errs.NewError(errs.ErrorCodeInternalError, "invalid user's roles")
+		}(i)
+	}
+	wg.Wait()
+
+	if hasErr.Load() {
+		return nil, errors.New("[manager.AuthorizationManager.getAllRoleIdsByPermissionIds] an error occurred while getting all role ids by permission id")
+	}
+	return prs, nil
+}
+
+func (m *AuthorizationManager) getAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error) {
+	ids, err := m.cache.GetRoleIdsByPermissionId(permissionId, func() ([]uint64, error) {
+		return m.rolePermissionManager.GetAllRoleIdsByPermissionId(ctx, permissionId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.getAllRoleIdsByPermissionId] get role ids by permission id: %w", err)
+	}
+	return ids, nil
+}
+
+// getCombinedUserAndGroupRoles returns the roles from the filter that are assigned to the user or any of the groups.
+// The user's roles precede the groups' roles, which are in the order of the groups.
+func (m *AuthorizationManager) getCombinedUserAndGroupRoles(ctx *actions.OperationContext, userId uint64, userGroups []groupmodels.UserGroup, roleFilter []uint64) ([]uint64, error) {
+	urIds, err := m.cache.GetUserRoleIds(userId, func() ([]uint64, *time.Time, error) {
+		return m.loadUserRoleIds(ctx, userId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupRoles] get user's role ids by user id: %w", err)
+	}
+
+	rs := make([]uint64, 0, len(roleFilter))
+	var rf []uint64 // the roles from the filter that aren't assigned to the user
+	for _, id := range roleFilter {
+		if slices.Contains(urIds, id) {
+			rs = append(rs, id)
+		} else {
+			rf = append(rf, id)
+		}
+	}
+
+	for _, group := range userGroups {
+		if len(rf) == 0 {
+			break
+		}
+
+		grIds, err := m.cache.GetGroupRoleIds(group, func() ([]uint64, *time.Time, error) {
+			return m.loadGroupRoleIds(ctx, group)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupRoles] get role ids of the group by group: %w", err)
+		}
+
+		rf2 := rf[:0] // the roles from the filter that aren't assigned to the user and groups checked so far
+		for _, id := range rf {
+			if slices.Contains(grIds, id) {
+				rs = append(rs, id)
+			} else {
+				rf2 = append(rf2, id)
 			}
+		}
+		rf = rf2
+	}
+	return rs, nil
+}
+
+// loadUserRoleIds loads the IDs of the roles assigned to the user and the time of the next scheduled change
+// of the user's roles, if any. The time is loaded first, so that a change that takes effect during loading
+// isn't missed.
+func (m *AuthorizationManager) loadUserRoleIds(ctx *actions.OperationContext, userId uint64) ([]uint64, *time.Time, error) {
+	t, err := m.uraManager.GetNextRoleChangeTimeByUserId(ctx, userId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[manager.AuthorizationManager.loadUserRoleIds] get the time of the next change of the user's roles by user id: %w", err)
+	}
+
+	ids, err := m.uraManager.GetUserRoleIdsByUserId(ctx, userId, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[manager.AuthorizationManager.loadUserRoleIds] get user's role ids by user id: %w", err)
+	}
+	return ids, t, nil
+}
 
-			rf[rfIdx] = id
-			rfIdx++
+// loadGroupRoleIds loads the IDs of the roles assigned to the group and the time of the next scheduled change
+// of the group's roles, if any. The time is loaded first, so that a change that takes effect during loading
+// isn't missed.
+func (m *AuthorizationManager) loadGroupRoleIds(ctx *actions.OperationContext, group groupmodels.UserGroup) ([]uint64, *time.Time, error) {
+	t, err := m.graManager.GetNextRoleChangeTimeByGroup(ctx, group)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[manager.AuthorizationManager.loadGroupRoleIds] get the time of the next change of the group's roles by group: %w", err)
+	}
+
+	ids, err := m.graManager.GetGroupRoleIdsByGroup(ctx, group, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[manager.AuthorizationManager.loadGroupRoleIds] get role ids of the group by group: %w", err)
+	}
+	return ids, t, nil
+}
+
+// getCombinedUserAndGroupResourceRoles returns the resource roles of the user and the groups.
+// The returned slice is never nil.
+func (m *AuthorizationManager) getCombinedUserAndGroupResourceRoles(ctx *actions.OperationContext, userId uint64, userGroups []groupmodels.UserGroup,
+) ([]*models.ResourceRole, error) {
+	urrs, err := m.cache.GetUserResourceRoles(userId, func() ([]*models.ResourceRole, error) {
+		return m.loadResourceRoles(ctx, userId, rolemodels.AssigneeTypeUser)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupResourceRoles] get user's resource roles: %w", err)
+	}
+
+	// the cached slices must not be modified
+	rrs := make([]*models.ResourceRole, 0, len(urrs))
+	rrs = append(rrs, urrs...)
+
+	for _, group := range userGroups {
+		grrs, err := m.cache.GetGroupResourceRoles(group, func() ([]*models.ResourceRole, error) {
+			return m.loadResourceRoles(ctx, uint64(group), rolemodels.AssigneeTypeGroup)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupResourceRoles] get resource roles of the group: %w", err)
 		}
-	} else {
-		rf = roleFilter
+		rrs = append(rrs, grrs...)
 	}
+	return rrs, nil
+}
 
-	grIds, err := m.graManager.GetGroupRoleIdsByGroup(ctx, group, rf)
+// loadResourceRoles loads the resource roles of the assignee.
+// The returned slice is never nil, so the empty result is cached as well.
+func (m *AuthorizationManager) loadResourceRoles(ctx *actions.OperationContext, assigneeId uint64, assigneeType rolemodels.AssigneeType) ([]*models.ResourceRole, error) {
+	as, err := m.rraManager.GetAllByAssignee(ctx, assigneeId, assigneeType)
 	if err != nil {
-		return nil, fmt.Errorf("[manager.AuthorizationManager.getCombinedUserAndGroupRoles] get role ids of the group by group: %w", err)
+		return nil, fmt.Errorf("[manager.AuthorizationManager.loadResourceRoles] get all resource role assignments by assignee: %w", err)
+	}
+
+	rrs := make([]*models.ResourceRole, len(as))
+	for i, a := range as {
+		rrs[i] = &models.ResourceRole{
+			RoleId:         a.RoleId,
+			ResourceTypeId: a.ResourceTypeId,
+			ResourceId:     nullable.FromPtr(a.ResourceId),
+			OwnerRule:      a.OwnerRule,
+		}
 	}
+	return rrs, nil
+}
 
-	grIdsLen := len(grIds)
-	if grIdsLen > rflen {
-		return nil, errs.NewError(errs.ErrorCodeInternalError, "number of group roles is greater than the roles in the filter")
+// explainRoles explains the roles that are granted the specified permission, directly or by inheritance.
+// roleAssignments contains the role assignments of the user, the user's groups or the client by role ID.
+func (m *AuthorizationManager) explainRoles(ctx *actions.OperationContext, permissionId uint64, roleAssignments map[uint64][]*models.RoleAssignmentExplanation,
+) ([]*models.RoleExplanation, error) {
+	rIds, err := m.rolePermissionManager.GetAllRoleIdsByPermissionId(ctx, permissionId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.explainRoles] get all role ids by permission id: %w", err)
 	}
-	if grIdsLen == 0 {
-		return rs[:idx], nil // rs can be uninitialized if idx is 0 (urIdsLen is 0)
+	if len(rIds) == 0 {
+		return nil, nil
+	}
+
+	rs, err := m.roleManager.GetAllByIds(ctx, rIds)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.explainRoles] get all roles by ids: %w", err)
 	}
 
-	// if len(rs) is 0, then idx is also 0
-	if len(rs) == 0 {
-		rs = make([]uint64, grIdsLen)
+	res := make([]*models.RoleExplanation, len(rs))
+	for i, r := range rs {
+		// the role inherits the permission if it isn't granted to the role directly
+		granted, err := m.rolePermissionManager.IsGranted(ctx, r.Id, permissionId)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.AuthorizationManager.explainRoles] is the permission granted to the role: %w", err)
+		}
+
+		res[i] = &models.RoleExplanation{
+			RoleId:          r.Id,
+			RoleName:        r.Name,
+			RoleStatus:      r.Status,
+			GrantedDirectly: granted,
+			Assignments:     roleAssignments[r.Id],
+		}
 	}
+	return res, nil
+}
 
-	copy(rs[idx:], grIds)
-	return rs[:idx+grIdsLen], nil
+// getUserAndGroupRoleAssignments gets the role assignments of the user and the groups by role ID.
+// The deleted role assignments are omitted.
+func (m *AuthorizationManager) getUserAndGroupRoleAssignments(ctx *actions.OperationContext, userId uint64, userGroups []groupmodels.UserGroup,
+) (map[uint64][]*models.RoleAssignmentExplanation, error) {
+	uras, err := m.uraManager.GetAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.getUserAndGroupRoleAssignments] get all user's role assignments by user id: %w", err)
+	}
+
+	now := time.Now()
+	ras := make(map[uint64][]*models.RoleAssignmentExplanation)
+	for _, a := range uras {
+		if a.Status != rolemodels.UserRoleAssignmentStatusDeleted {
+			ras[a.RoleId] = append(ras[a.RoleId], newRoleAssignmentExplanation(
+				a.RoleAssignmentId, rolemodels.AssigneeTypeUser, a.UserId, rolemodels.RoleAssignmentStatus(a.Status), a.ValidFrom, a.ValidUntil, now,
+			))
+		}
+	}
+
+	for _, group := range userGroups {
+		gras, err := m.graManager.GetAllByGroup(ctx, group)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.AuthorizationManager.getUserAndGroupRoleAssignments] get all role assignments of the group by group: %w", err)
+		}
+
+		for _, a := range gras {
+			if a.Status != rolemodels.GroupRoleAssignmentStatusDeleted {
+				ras[a.RoleId] = append(ras[a.RoleId], newRoleAssignmentExplanation(
+					a.RoleAssignmentId, rolemodels.AssigneeTypeGroup, uint64(a.Group), rolemodels.RoleAssignmentStatus(a.Status), a.ValidFrom, a.ValidUntil, now,
+				))
+			}
+		}
+	}
+	return ras, nil
+}
+
+// getClientRoleAssignments gets the role assignments of the client by role ID.
+// The deleted role assignments are omitted.
+func (m *AuthorizationManager) getClientRoleAssignments(ctx *actions.OperationContext, clientId uint64) (map[uint64][]*models.RoleAssignmentExplanation, error) {
+	cras, err := m.craManager.GetAllByClientId(ctx, clientId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.AuthorizationManager.getClientRoleAssignments] get all client's role assignments by client id: %w", err)
+	}
+
+	now := time.Now()
+	ras := make(map[uint64][]*models.RoleAssignmentExplanation)
+	for _, a := range cras {
+		if a.Status != rolemodels.ClientRoleAssignmentStatusDeleted {
+			// client role assignments have no validity period
+			ras[a.RoleId] = append(ras[a.RoleId], newRoleAssignmentExplanation(
+				a.RoleAssignmentId, rolemodels.AssigneeTypeClient, a.ClientId, rolemodels.RoleAssignmentStatus(a.Status), nil, nil, now,
+			))
+		}
+	}
+	return ras, nil
+}
+
+// newRoleAssignmentExplanation creates a role assignment explanation.
+// The role assignment is effective if it is active and its validity period includes the specified time.
+// The statuses of the user, group and client role assignments have the same values as the role assignment statuses.
+func newRoleAssignmentExplanation(roleAssignmentId uint64, assigneeType rolemodels.AssigneeType, assigneeId uint64, status rolemodels.RoleAssignmentStatus,
+	validFrom, validUntil *time.Time, t time.Time,
+) *models.RoleAssignmentExplanation {
+	return &models.RoleAssignmentExplanation{
+		RoleAssignmentId: roleAssignmentId,
+		AssigneeType:     assigneeType,
+		AssigneeId:       assigneeId,
+		Status:           status,
+		ValidFrom:        validFrom,
+		ValidUntil:       validUntil,
+		Effective: status == rolemodels.RoleAssignmentStatusActive &&
+			(validFrom == nil || !validFrom.After(t)) && (validUntil == nil || validUntil.After(t)),
+	}
+}
+
+// isGrantedForResources returns true if, for each of the resources, any of the resource roles
+// that are in the role filter is assigned for the resource.
+// The CreatedBySelf owner rule is satisfied if the resource owner is the specified user.
+func isGrantedForResources(resourceRoles []*models.ResourceRole, roleFilter []uint64, resources []*models.Resource, userId nullable.Nullable[uint64]) bool {
+	for _, r := range resources {
+		granted := false
+		for _, rr := range resourceRoles {
+			if rr.ResourceTypeId != r.TypeId || !slices.Contains(roleFilter, rr.RoleId) {
+				continue
+			}
+			if rr.ResourceId.HasValue && rr.ResourceId.Value == r.Id ||
+				rr.OwnerRule == resourcemodels.OwnerRuleCreatedBySelf && userId.HasValue && r.OwnerId.HasValue && r.OwnerId.Value == userId.Value {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return false
+		}
+	}
+	return true
 }
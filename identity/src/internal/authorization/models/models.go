@@ -15,7 +15,16 @@
 package models
 
 import (
+	"time"
+
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
 	"personal-website-v2/identity/src/internal/groups/models"
+	permissionmodels "personal-website-v2/identity/src/internal/permissions/models"
+	resourcemodels "personal-website-v2/identity/src/internal/resources/models"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/base/cache"
+	"personal-website-v2/pkg/base/nullable"
 )
 
 // The authorization result.
@@ -24,6 +33,7 @@ type AuthorizationResult struct {
 	Group models.UserGroup
 
 	// The roles of permissions.
+	// The permissions that are granted only for the specified resources are omitted.
 	PermissionRoles []*PermissionWithRoles
 }
 
@@ -35,3 +45,167 @@ type PermissionWithRoles struct {
 	// The role IDs.
 	RoleIds []uint64 `json:"roleIds"`
 }
+
+// The resource against which the permissions are checked.
+type Resource struct {
+	// The resource type ID.
+	TypeId uint64 `json:"typeId"`
+
+	// The resource ID.
+	Id uint64 `json:"id"`
+
+	// The ID of the user who created the resource (the owner of the resource), if known.
+	OwnerId nullable.Nullable[uint64] `json:"ownerId"`
+}
+
+// The role assigned to the user, group or service client for a specific resource
+// or for the resources that satisfy the owner rule.
+type ResourceRole struct {
+	// The role ID.
+	RoleId uint64
+
+	// The resource type ID.
+	ResourceTypeId uint64
+
+	// The resource ID if the role is assigned for a specific resource.
+	ResourceId nullable.Nullable[uint64]
+
+	// The resource owner rule.
+	OwnerRule resourcemodels.OwnerRule
+}
+
+// The explanation of the authorization of a user (client).
+// It describes how each of the permissions is granted to the user (client) or why it isn't granted.
+type AuthorizationExplanation struct {
+	// The user's group.
+	Group models.UserGroup
+
+	// The groups of which the user is a member.
+	MemberGroups []models.UserGroup
+
+	// The user's status if the user is specified.
+	UserStatus nullable.Nullable[usermodels.UserStatus]
+
+	// The client status if the client is specified.
+	ClientStatus nullable.Nullable[clientmodels.ClientStatus]
+
+	// The explanations of the permissions in the order of the specified permissions.
+	Permissions []*PermissionExplanation
+}
+
+// The explanation of a permission.
+type PermissionExplanation struct {
+	// The permission ID.
+	PermissionId uint64
+
+	// The permission name if the permission exists.
+	PermissionName string
+
+	// The permission status if the permission exists.
+	PermissionStatus permissionmodels.PermissionStatus
+
+	// Indicates whether the permission is granted regardless of the resources.
+	Granted bool
+
+	// The link that is missing if the permission isn't granted.
+	MissingLink MissingLink
+
+	// The roles that are granted the permission, directly or by inheritance.
+	Roles []*RoleExplanation
+}
+
+// The missing link in the chain "user (client) -> role assignment -> role -> permission".
+// If several links are missing, the one closest to the permission is reported.
+type MissingLink uint8
+
+const (
+	// The permission is granted.
+	MissingLinkNone MissingLink = 0
+
+	// The permission doesn't exist.
+	MissingLinkPermission MissingLink = 1
+
+	// The permission isn't granted to any role.
+	MissingLinkRole MissingLink = 2
+
+	// None of the roles that are granted the permission are assigned to the user, the user's groups or the client,
+	// or none of their assignments are effective. Anonymous users only have the anonymous user role.
+	MissingLinkRoleAssignment MissingLink = 3
+
+	// The user (client) isn't active.
+	MissingLinkAssigneeStatus MissingLink = 4
+)
+
+// The explanation of a role that is granted a permission.
+type RoleExplanation struct {
+	// The role ID.
+	RoleId uint64
+
+	// The role name.
+	RoleName string
+
+	// The role status.
+	RoleStatus rolemodels.RoleStatus
+
+	// Indicates whether the permission is granted to the role directly.
+	// Otherwise, the role inherits the permission from a parent role.
+	GrantedDirectly bool
+
+	// The assignments of the role to the user, the user's groups or the client, including inactive ones.
+	Assignments []*RoleAssignmentExplanation
+}
+
+// The explanation of a role assignment.
+type RoleAssignmentExplanation struct {
+	// The role assignment ID.
+	RoleAssignmentId uint64
+
+	// The assignee type.
+	AssigneeType rolemodels.AssigneeType
+
+	// The assignee ID (user ID, group or client ID).
+	AssigneeId uint64
+
+	// The role assignment status.
+	Status rolemodels.RoleAssignmentStatus
+
+	// Optional. It stores the date and time from which the role assignment is valid.
+	ValidFrom *time.Time
+
+	// Optional. It stores the date and time until which the role assignment is valid.
+	ValidUntil *time.Time
+
+	// Indicates whether the role assignment is taken into account by the authorization,
+	// that is, it is active and its validity period includes the current time.
+	Effective bool
+}
+
+// The authorization cache statistics.
+type AuthorizationCacheStats struct {
+	// The statistics of the cache of permission roles.
+	PermissionRoles *cache.LRUCacheStats `json:"permissionRoles"`
+
+	// The statistics of the cache of user roles.
+	UserRoles *cache.LRUCacheStats `json:"userRoles"`
+
+	// The statistics of the cache of group roles.
+	GroupRoles *cache.LRUCacheStats `json:"groupRoles"`
+
+	// The statistics of the cache of client roles.
+	ClientRoles *cache.LRUCacheStats `json:"clientRoles"`
+
+	// The statistics of the cache of users' groups and statuses.
+	UserGroupsAndStatuses *cache.LRUCacheStats `json:"userGroupsAndStatuses"`
+
+	// The statistics of the cache of users' member groups.
+	UserMemberGroups *cache.LRUCacheStats `json:"userMemberGroups"`
+
+	// The statistics of the cache of user resource roles.
+	UserResourceRoles *cache.LRUCacheStats `json:"userResourceRoles"`
+
+	// The statistics of the cache of group resource roles.
+	GroupResourceRoles *cache.LRUCacheStats `json:"groupResourceRoles"`
+
+	// The statistics of the cache of client resource roles.
+	ClientResourceRoles *cache.LRUCacheStats `json:"clientResourceRoles"`
+}
@@ -73,3 +73,58 @@ type Client struct {
 	// row timestamp
 	Timestamp time.Time `db:"_timestamp"`
 }
+
+// The secret of the service client.
+type ClientSecret struct {
+	// The client ID.
+	Id uint64 `db:"id"`
+
+	// It stores the date and time at which the client secret was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the client secret.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the client secret was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the client secret.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The SHA-256 hash of the current secret.
+	SecretHash []byte `db:"secret_hash"`
+
+	// It stores the date and time at which the current secret was created.
+	SecretCreatedAt time.Time `db:"secret_created_at"`
+
+	// Optional. The SHA-256 hash of the previous secret that remains valid
+	// during the grace period after the secret rotation.
+	PreviousSecretHash []byte `db:"previous_secret_hash"`
+
+	// Optional. It stores the date and time at which the previous secret expires.
+	PreviousSecretExpiresAt *time.Time `db:"previous_secret_expires_at"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+// The access token of the service client.
+type ServiceClientToken struct {
+	// The unique ID to identify the token.
+	Id uint64 `db:"id"`
+
+	// The client ID.
+	ClientId uint64 `db:"client_id"`
+
+	// The SHA-256 hash of the token.
+	TokenHash []byte `db:"token_hash"`
+
+	// It stores the date and time at which the token was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the token expires.
+	ExpiresAt time.Time `db:"expires_at"`
+}
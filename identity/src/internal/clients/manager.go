@@ -29,6 +29,16 @@ type ClientManager interface {
 	// CreateMobileClient creates a mobile client and returns the client ID if the operation is successful.
 	CreateMobileClient(ctx *actions.OperationContext, data *clients.CreateMobileClientOperationData) (uint64, error)
 
+	// CreateServiceClient creates a service client and returns the client ID and secret if the operation is successful.
+	CreateServiceClient(ctx *actions.OperationContext, data *clients.CreateServiceClientOperationData) (uint64, string, error)
+
+	// RotateServiceClientSecret generates a new secret of the service client and returns it if the operation is successful.
+	// The previous secret remains valid during the grace period.
+	RotateServiceClientSecret(ctx *actions.OperationContext, id uint64) (string, error)
+
+	// VerifyServiceClientSecret returns true if the specified secret of the service client is valid.
+	VerifyServiceClientSecret(ctx *actions.OperationContext, id uint64, secret string) (bool, error)
+
 	// Delete deletes a client by the specified client ID.
 	Delete(ctx *actions.OperationContext, id uint64) error
 
@@ -41,3 +51,13 @@ type ClientManager interface {
 	// GetStatusById gets a client status by the specified client ID.
 	GetStatusById(ctx *actions.OperationContext, id uint64) (models.ClientStatus, error)
 }
+
+// ServiceClientTokenManager is a manager of the access tokens of service clients.
+type ServiceClientTokenManager interface {
+	// CreateToken creates an access token of the service client if the specified secret is valid.
+	CreateToken(ctx *actions.OperationContext, clientId uint64, secret string) (*models.ServiceClientToken, error)
+
+	// Authenticate authenticates a service client by the specified token and returns the client ID
+	// if the operation is successful.
+	Authenticate(ctx *actions.OperationContext, token string) (uint64, error)
+}
@@ -15,7 +15,12 @@
 package manager
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -34,17 +39,38 @@ import (
 	"personal-website-v2/pkg/logging/context"
 )
 
+const (
+	// The size of a service client secret (in bytes).
+	clientSecretSize = 32
+)
+
+type ClientManagerConfig struct {
+	// The period during which the previous secret of the service client remains valid
+	// after the secret rotation.
+	SecretGracePeriod time.Duration
+}
+
 // ClientManager is a client manager.
 type ClientManager struct {
-	opExecutor        *actionhelper.OperationExecutor
-	webClientStore    clients.ClientStore
-	mobileClientStore clients.ClientStore
-	logger            logging.Logger[*context.LogEntryContext]
+	opExecutor         *actionhelper.OperationExecutor
+	config             *ClientManagerConfig
+	webClientStore     clients.ClientStore
+	mobileClientStore  clients.ClientStore
+	serviceClientStore clients.ClientStore
+	clientSecretStore  clients.ClientSecretStore
+	logger             logging.Logger[*context.LogEntryContext]
 }
 
 var _ clients.ClientManager = (*ClientManager)(nil)
 
-func NewClientManager(webClientStore clients.ClientStore, mobileClientStore clients.ClientStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*ClientManager, error) {
+func NewClientManager(
+	config *ClientManagerConfig,
+	webClientStore clients.ClientStore,
+	mobileClientStore clients.ClientStore,
+	serviceClientStore clients.ClientStore,
+	clientSecretStore clients.ClientSecretStore,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ClientManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.clients.manager.ClientManager")
 	if err != nil {
 		return nil, fmt.Errorf("[manager.NewClientManager] create a logger: %w", err)
@@ -62,10 +88,13 @@ func NewClientManager(webClientStore clients.ClientStore, mobileClientStore clie
 	}
 
 	return &ClientManager{
-		opExecutor:        e,
-		webClientStore:    webClientStore,
-		mobileClientStore: mobileClientStore,
-		logger:            l,
+		opExecutor:         e,
+		config:             config,
+		webClientStore:     webClientStore,
+		mobileClientStore:  mobileClientStore,
+		serviceClientStore: serviceClientStore,
+		clientSecretStore:  clientSecretStore,
+		logger:             l,
 	}, nil
 }
 
@@ -143,6 +172,138 @@ func (m *ClientManager) CreateMobileClient(ctx *actions.OperationContext, data *
 	return id, nil
 }
 
+// CreateServiceClient creates a service client and returns the client ID and secret if the operation is successful.
+func (m *ClientManager) CreateServiceClient(ctx *actions.OperationContext, data *clientoperations.CreateServiceClientOperationData) (uint64, string, error) {
+	var id uint64
+	var secret string
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientManager_CreateServiceClient,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.ClientManager.CreateServiceClient] validate data: %w", err)
+			}
+
+			d := &clientoperations.CreateOperationData{
+				Status: models.ClientStatusActive,
+				AppId:  nullable.NewNullable(data.AppId),
+				IP:     data.IP,
+			}
+
+			var err error
+			if id, err = m.serviceClientStore.Create(opCtx, d); err != nil {
+				return fmt.Errorf("[manager.ClientManager.CreateServiceClient] create a service client: %w", err)
+			}
+
+			if secret, err = m.rotateSecret(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientManager.CreateServiceClient] rotate a client secret: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientEvent,
+				"[manager.ClientManager.CreateServiceClient] service client has been created",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, "", fmt.Errorf("[manager.ClientManager.CreateServiceClient] execute an operation: %w", err)
+	}
+	return id, secret, nil
+}
+
+// RotateServiceClientSecret generates a new secret of the service client and returns it if the operation is successful.
+// The previous secret remains valid during the grace period.
+func (m *ClientManager) RotateServiceClientSecret(ctx *actions.OperationContext, id uint64) (string, error) {
+	var secret string
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientManager_RotateServiceClientSecret,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.GetTypeById(id)
+			if err != nil {
+				return fmt.Errorf("[manager.ClientManager.RotateServiceClientSecret] get a client type by id: %w", err)
+			}
+
+			if t != models.ClientTypeService {
+				return errors.ErrInvalidClientId
+			}
+
+			if secret, err = m.rotateSecret(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientManager.RotateServiceClientSecret] rotate a client secret: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientEvent,
+				"[manager.ClientManager.RotateServiceClientSecret] service client secret has been rotated",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("[manager.ClientManager.RotateServiceClientSecret] execute an operation: %w", err)
+	}
+	return secret, nil
+}
+
+func (m *ClientManager) rotateSecret(ctx *actions.OperationContext, id uint64) (string, error) {
+	b := make([]byte, clientSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("[manager.ClientManager.rotateSecret] generate a secret: %w", err)
+	}
+
+	// only the hash of the secret is stored
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	if err := m.clientSecretStore.Rotate(ctx, id, hashClientSecret(secret), m.config.SecretGracePeriod); err != nil {
+		return "", fmt.Errorf("[manager.ClientManager.rotateSecret] rotate a client secret: %w", err)
+	}
+	return secret, nil
+}
+
+// VerifyServiceClientSecret returns true if the specified secret of the service client is valid.
+func (m *ClientManager) VerifyServiceClientSecret(ctx *actions.OperationContext, id uint64, secret string) (bool, error) {
+	var valid bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientManager_VerifyServiceClientSecret,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.GetTypeById(id)
+			if err != nil {
+				return fmt.Errorf("[manager.ClientManager.VerifyServiceClientSecret] get a client type by id: %w", err)
+			}
+
+			if t != models.ClientTypeService {
+				return nil
+			}
+
+			cs, err := m.clientSecretStore.FindByClientId(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.ClientManager.VerifyServiceClientSecret] find a client secret by client id: %w", err)
+			}
+
+			if cs == nil {
+				return nil
+			}
+
+			h := hashClientSecret(secret)
+			if subtle.ConstantTimeCompare(h, cs.SecretHash) == 1 {
+				valid = true
+				return nil
+			}
+
+			// the previous secret remains valid during the grace period
+			valid = cs.PreviousSecretHash != nil && cs.PreviousSecretExpiresAt != nil && cs.PreviousSecretExpiresAt.After(time.Now()) &&
+				subtle.ConstantTimeCompare(h, cs.PreviousSecretHash) == 1
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.ClientManager.VerifyServiceClientSecret] execute an operation: %w", err)
+	}
+	return valid, nil
+}
+
 // Delete deletes a client by the specified client ID.
 func (m *ClientManager) Delete(ctx *actions.OperationContext, id uint64) error {
 	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientManager_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
@@ -158,6 +319,8 @@ func (m *ClientManager) Delete(ctx *actions.OperationContext, id uint64) error {
 				store = m.webClientStore
 			case models.ClientTypeMobile:
 				store = m.mobileClientStore
+			case models.ClientTypeService:
+				store = m.serviceClientStore
 			default:
 				return fmt.Errorf("[manager.ClientManager.Delete] '%s' client type isn't supported", t)
 			}
@@ -231,6 +394,10 @@ func (m *ClientManager) FindById(ctx *actions.OperationContext, id uint64) (*dbm
 		if c, err = m.mobileClientStore.FindById(ctx2, id); err != nil {
 			return nil, fmt.Errorf("[manager.ClientManager.FindById] find a mobile client by id: %w", err)
 		}
+	case models.ClientTypeService:
+		if c, err = m.serviceClientStore.FindById(ctx2, id); err != nil {
+			return nil, fmt.Errorf("[manager.ClientManager.FindById] find a service client by id: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("[manager.ClientManager.FindById] '%s' client type isn't supported", t)
 	}
@@ -294,6 +461,10 @@ func (m *ClientManager) GetStatusById(ctx *actions.OperationContext, id uint64)
 		if s, err = m.mobileClientStore.GetStatusById(ctx2, id); err != nil {
 			return models.ClientStatusNew, fmt.Errorf("[manager.ClientManager.GetStatusById] get a mobile client status by id: %w", err)
 		}
+	case models.ClientTypeService:
+		if s, err = m.serviceClientStore.GetStatusById(ctx2, id); err != nil {
+			return models.ClientStatusNew, fmt.Errorf("[manager.ClientManager.GetStatusById] get a service client status by id: %w", err)
+		}
 	default:
 		return models.ClientStatusNew, fmt.Errorf("[manager.ClientManager.GetStatusById] '%s' client type isn't supported", t)
 	}
@@ -301,3 +472,8 @@ func (m *ClientManager) GetStatusById(ctx *actions.OperationContext, id uint64)
 	succeeded = true
 	return s, nil
 }
+
+func hashClientSecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
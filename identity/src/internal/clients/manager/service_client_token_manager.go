@@ -0,0 +1,205 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/clients"
+	"personal-website-v2/identity/src/internal/clients/models"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The size of a service client token (in bytes), excluding the prefix.
+	serviceClientTokenSize = 32
+)
+
+type ServiceClientTokenManagerConfig struct {
+	// The lifetime of an access token of the service client.
+	TTL time.Duration
+}
+
+// ServiceClientTokenManager is a manager of the access tokens of service clients.
+type ServiceClientTokenManager struct {
+	opExecutor              *actionhelper.OperationExecutor
+	config                  *ServiceClientTokenManagerConfig
+	clientManager           clients.ClientManager
+	serviceClientTokenStore clients.ServiceClientTokenStore
+	logger                  logging.Logger[*context.LogEntryContext]
+}
+
+var _ clients.ServiceClientTokenManager = (*ServiceClientTokenManager)(nil)
+
+func NewServiceClientTokenManager(
+	config *ServiceClientTokenManagerConfig,
+	clientManager clients.ClientManager,
+	serviceClientTokenStore clients.ServiceClientTokenStore,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ServiceClientTokenManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.clients.manager.ServiceClientTokenManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewServiceClientTokenManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupServiceClient,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewServiceClientTokenManager] new operation executor: %w", err)
+	}
+
+	return &ServiceClientTokenManager{
+		opExecutor:              e,
+		config:                  config,
+		clientManager:           clientManager,
+		serviceClientTokenStore: serviceClientTokenStore,
+		logger:                  l,
+	}, nil
+}
+
+// CreateToken creates an access token of the service client if the specified secret is valid.
+func (m *ServiceClientTokenManager) CreateToken(ctx *actions.OperationContext, clientId uint64, secret string) (*models.ServiceClientToken, error) {
+	var t *models.ServiceClientToken
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeServiceClientTokenManager_CreateToken,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			if ct, err := m.clientManager.GetTypeById(clientId); err != nil || ct != models.ClientTypeService {
+				return ierrors.ErrInvalidCredentials
+			}
+
+			s, err := m.clientManager.GetStatusById(opCtx, clientId)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeClientNotFound {
+					return fmt.Errorf("[manager.ServiceClientTokenManager.CreateToken] get a client status by id: %w", err)
+				}
+				return ierrors.ErrInvalidCredentials
+			}
+
+			if s != models.ClientStatusActive {
+				return ierrors.ErrInvalidCredentials
+			}
+
+			valid, err := m.clientManager.VerifyServiceClientSecret(opCtx, clientId, secret)
+			if err != nil {
+				return fmt.Errorf("[manager.ServiceClientTokenManager.CreateToken] verify a client secret: %w", err)
+			}
+
+			if !valid {
+				m.logger.WarningWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.ClientEvent,
+					"[manager.ServiceClientTokenManager.CreateToken] invalid client secret",
+					logging.NewField("clientId", clientId),
+				)
+				return ierrors.ErrInvalidCredentials
+			}
+
+			b := make([]byte, serviceClientTokenSize)
+			if _, err := rand.Read(b); err != nil {
+				return fmt.Errorf("[manager.ServiceClientTokenManager.CreateToken] generate a token: %w", err)
+			}
+
+			// only the hash of the token is stored
+			token := identity.ServiceClientTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+			expiresAt := time.Now().Add(m.config.TTL)
+			id, err := m.serviceClientTokenStore.Create(opCtx, clientId, hashServiceClientToken(token), m.config.TTL)
+			if err != nil {
+				return fmt.Errorf("[manager.ServiceClientTokenManager.CreateToken] create a token: %w", err)
+			}
+
+			t = &models.ServiceClientToken{
+				Token:     token,
+				ExpiresAt: expiresAt,
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientEvent,
+				"[manager.ServiceClientTokenManager.CreateToken] service client token has been created",
+				logging.NewField("id", id),
+				logging.NewField("clientId", clientId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ServiceClientTokenManager.CreateToken] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// Authenticate authenticates a service client by the specified token and returns the client ID
+// if the operation is successful.
+func (m *ServiceClientTokenManager) Authenticate(ctx *actions.OperationContext, token string) (uint64, error) {
+	var clientId uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeServiceClientTokenManager_Authenticate, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			if !strings.HasPrefix(token, identity.ServiceClientTokenPrefix) {
+				return ierrors.ErrInvalidClientAuthnToken
+			}
+
+			t, err := m.serviceClientTokenStore.FindByTokenHash(opCtx, hashServiceClientToken(token))
+			if err != nil {
+				return fmt.Errorf("[manager.ServiceClientTokenManager.Authenticate] find a token by token hash: %w", err)
+			}
+
+			if t == nil || !t.ExpiresAt.After(time.Now()) {
+				return ierrors.ErrInvalidClientAuthnToken
+			}
+
+			s, err := m.clientManager.GetStatusById(opCtx, t.ClientId)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeClientNotFound {
+					return fmt.Errorf("[manager.ServiceClientTokenManager.Authenticate] get a client status by id: %w", err)
+				}
+				return ierrors.ErrInvalidClientAuthnToken
+			}
+
+			if s != models.ClientStatusActive {
+				return ierrors.ErrInvalidClientAuthnToken
+			}
+
+			clientId = t.ClientId
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ServiceClientTokenManager.Authenticate] execute an operation: %w", err)
+	}
+	return clientId, nil
+}
+
+func hashServiceClientToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -14,7 +14,10 @@
 
 package models
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // The client type.
 type ClientType uint8
@@ -26,10 +29,13 @@ const (
 
 	// For mobile apps.
 	ClientTypeMobile ClientType = 2
+
+	// For backend services (machine-to-machine).
+	ClientTypeService ClientType = 3
 )
 
 func (t ClientType) IsValid() bool {
-	return t == ClientTypeWeb || t == ClientTypeMobile
+	return t == ClientTypeWeb || t == ClientTypeMobile || t == ClientTypeService
 }
 
 func (t ClientType) String() string {
@@ -38,6 +44,8 @@ func (t ClientType) String() string {
 		return "web"
 	case ClientTypeMobile:
 		return "mobile"
+	case ClientTypeService:
+		return "service"
 	}
 	return fmt.Sprintf("ClientType(%d)", t)
 }
@@ -57,3 +65,12 @@ const (
 	ClientStatusDeleting             ClientStatus = 7
 	ClientStatusDeleted              ClientStatus = 8
 )
+
+// The access token of the service client.
+type ServiceClientToken struct {
+	// The token that is used to authenticate the service client.
+	Token string
+
+	// The date and time at which the token expires.
+	ExpiresAt time.Time
+}
@@ -73,3 +73,18 @@ func (d *CreateMobileClientOperationData) Validate() *errors.Error {
 	}
 	return nil
 }
+
+type CreateServiceClientOperationData struct {
+	// The app ID.
+	AppId uint64 `json:"appId"`
+
+	// The IP address.
+	IP string `json:"ip"`
+}
+
+func (d *CreateServiceClientOperationData) Validate() *errors.Error {
+	if strings.IsEmptyOrWhitespace(d.IP) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
@@ -15,6 +15,8 @@
 package clients
 
 import (
+	"time"
+
 	"personal-website-v2/identity/src/internal/clients/dbmodels"
 	"personal-website-v2/identity/src/internal/clients/models"
 	"personal-website-v2/identity/src/internal/clients/operations/clients"
@@ -37,3 +39,22 @@ type ClientStore interface {
 	// GetStatusById gets a client status by the specified client ID.
 	GetStatusById(ctx *actions.OperationContext, id uint64) (models.ClientStatus, error)
 }
+
+// ClientSecretStore is a store of the secrets of service clients.
+type ClientSecretStore interface {
+	// Rotate replaces the secret of the service client with the secret with the specified hash.
+	// The previous secret, if any, remains valid during the specified grace period.
+	Rotate(ctx *actions.OperationContext, clientId uint64, secretHash []byte, gracePeriod time.Duration) error
+
+	// FindByClientId finds and returns a client secret, if any, by the specified client ID.
+	FindByClientId(ctx *actions.OperationContext, clientId uint64) (*dbmodels.ClientSecret, error)
+}
+
+// ServiceClientTokenStore is a store of the access tokens of service clients.
+type ServiceClientTokenStore interface {
+	// Create creates a token and returns the token ID if the operation is successful.
+	Create(ctx *actions.OperationContext, clientId uint64, tokenHash []byte, ttl time.Duration) (uint64, error)
+
+	// FindByTokenHash finds and returns a token, if any, by the specified token hash.
+	FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.ServiceClientToken, error)
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/clients"
+	"personal-website-v2/identity/src/internal/clients/dbmodels"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	clientSecretsTable = "public.client_secrets"
+)
+
+// ClientSecretStore is a store of the secrets of service clients.
+type ClientSecretStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ClientSecret]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ clients.ClientSecretStore = (*ClientSecretStore)(nil)
+
+func NewClientSecretStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ClientSecretStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.clients.stores.ClientSecretStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientSecretStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupServiceClient,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientSecretStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientSecretStore] new TxManager: %w", err)
+	}
+
+	return &ClientSecretStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ClientSecret](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Rotate replaces the secret of the service client with the secret with the specified hash.
+// The previous secret, if any, remains valid during the specified grace period.
+func (s *ClientSecretStore) Rotate(ctx *actions.OperationContext, clientId uint64, secretHash []byte, gracePeriod time.Duration) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientSecretStore_Rotate,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("gracePeriod", gracePeriod)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.rotate_client_secret(IN _client_id, IN _secret_hash, IN _previous_secret_ttl, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.rotate_client_secret($1, $2, $3, $4, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, clientId, secretHash, gracePeriod, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ClientSecretStore.Rotate] execute a query (rotate_client_secret): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeClientNotFound:
+					return ierrors.ErrClientNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ClientSecretStore.Rotate] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ClientSecretStore.Rotate] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ClientSecretStore.Rotate] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindByClientId finds and returns a client secret, if any, by the specified client ID.
+func (s *ClientSecretStore) FindByClientId(ctx *actions.OperationContext, clientId uint64) (*dbmodels.ClientSecret, error) {
+	var cs *dbmodels.ClientSecret
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientSecretStore_FindByClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + clientSecretsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if cs, err = s.store.Find(opCtx.Ctx, query, clientId); err != nil {
+				return fmt.Errorf("[stores.ClientSecretStore.FindByClientId] find a client secret by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ClientSecretStore.FindByClientId] execute an operation: %w", err)
+	}
+	return cs, nil
+}
@@ -61,6 +61,14 @@ var mobileClientStoreOpTypes = []actions.OperationType{
 	opTypeClientStore_GetStatusById: iactions.OperationTypeMobileClientStore_GetStatusById,
 }
 
+var serviceClientStoreOpTypes = []actions.OperationType{
+	opTypeClientStore_Create:        iactions.OperationTypeServiceClientStore_Create,
+	opTypeClientStore_StartDeleting: iactions.OperationTypeServiceClientStore_StartDeleting,
+	opTypeClientStore_Delete:        iactions.OperationTypeServiceClientStore_Delete,
+	opTypeClientStore_FindById:      iactions.OperationTypeServiceClientStore_FindById,
+	opTypeClientStore_GetStatusById: iactions.OperationTypeServiceClientStore_GetStatusById,
+}
+
 const (
 	clientsTable = "public.clients"
 )
@@ -83,6 +91,8 @@ func NewClientStore(ctype models.ClientType, db *postgres.Database, loggerFactor
 		opTypes = webClientStoreOpTypes
 	case models.ClientTypeMobile:
 		opTypes = mobileClientStoreOpTypes
+	case models.ClientTypeService:
+		opTypes = serviceClientStoreOpTypes
 	default:
 		return nil, fmt.Errorf("[stores.NewClientStore] '%s' client type isn't supported", ctype)
 	}
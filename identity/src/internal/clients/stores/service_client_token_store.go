@@ -0,0 +1,132 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/clients"
+	"personal-website-v2/identity/src/internal/clients/dbmodels"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	clientTokensTable = "public.client_tokens"
+)
+
+// ServiceClientTokenStore is a store of the access tokens of service clients.
+type ServiceClientTokenStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ServiceClientToken]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ clients.ServiceClientTokenStore = (*ServiceClientTokenStore)(nil)
+
+func NewServiceClientTokenStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ServiceClientTokenStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.clients.stores.ServiceClientTokenStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewServiceClientTokenStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupServiceClient,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewServiceClientTokenStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewServiceClientTokenStore] new TxManager: %w", err)
+	}
+
+	return &ServiceClientTokenStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ServiceClientToken](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a token and returns the token ID if the operation is successful.
+func (s *ServiceClientTokenStore) Create(ctx *actions.OperationContext, clientId uint64, tokenHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeServiceClientTokenStore_Create,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("ttl", ttl)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_client_token(IN _client_id, IN _token_hash, IN _ttl, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_client_token($1, $2, $3, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, clientId, tokenHash, ttl).Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ServiceClientTokenStore.Create] execute a query (create_client_token): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.ServiceClientTokenStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ServiceClientTokenStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ServiceClientTokenStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindByTokenHash finds and returns a token, if any, by the specified token hash.
+func (s *ServiceClientTokenStore) FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.ServiceClientToken, error) {
+	var t *dbmodels.ServiceClientToken
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeServiceClientTokenStore_FindByTokenHash, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + clientTokensTable + " WHERE token_hash = $1 LIMIT 1"
+			var err error
+			if t, err = s.store.Find(opCtx.Ctx, query, tokenHash); err != nil {
+				return fmt.Errorf("[stores.ServiceClientTokenStore.FindByTokenHash] find a token by token hash: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ServiceClientTokenStore.FindByTokenHash] execute an operation: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import "time"
+
+type UserCredential struct {
+	// The unique ID to identify the user's credentials.
+	Id uint64 `db:"id"`
+
+	// The user ID who owns the credentials.
+	UserId uint64 `db:"user_id"`
+
+	// It stores the date and time at which the credentials were created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the credentials.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the credentials were updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the credentials.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The password hash (argon2id or bcrypt).
+	PasswordHash string `db:"password_hash"`
+
+	// It stores the date and time at which the password was updated.
+	PasswordUpdatedAt time.Time `db:"password_updated_at"`
+
+	// The user ID to identify the user who updated the password.
+	PasswordUpdatedBy uint64 `db:"password_updated_by"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
@@ -0,0 +1,498 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authentication"
+	"personal-website-v2/identity/src/internal/credentials"
+	"personal-website-v2/identity/src/internal/credentials/models"
+	"personal-website-v2/identity/src/internal/credentials/operations/signin"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/lockouts"
+	lockoutmodels "personal-website-v2/identity/src/internal/lockouts/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/identity/src/internal/mfa/operations/challenges"
+	"personal-website-v2/identity/src/internal/sessions"
+	sessionmodels "personal-website-v2/identity/src/internal/sessions/models"
+	"personal-website-v2/identity/src/internal/sessions/operations/useragentsessions"
+	"personal-website-v2/identity/src/internal/sessions/operations/usersessions"
+	"personal-website-v2/identity/src/internal/useragents"
+	useragentdbmodels "personal-website-v2/identity/src/internal/useragents/dbmodels"
+	useragentoperations "personal-website-v2/identity/src/internal/useragents/operations/useragents"
+	"personal-website-v2/identity/src/internal/users"
+	userdbmodels "personal-website-v2/identity/src/internal/users/dbmodels"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/crypto/passwords"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// SignInManager is a sign-in manager.
+type SignInManager struct {
+	opExecutor              *actionhelper.OperationExecutor
+	userManager             users.UserManager
+	userCredentialManager   credentials.UserCredentialManager
+	userAgentManager        useragents.UserAgentManager
+	userSessionManager      sessions.UserSessionManager
+	userAgentSessionManager sessions.UserAgentSessionManager
+	authenticationManager   authentication.AuthenticationManager
+	lockoutManager          lockouts.LockoutManager
+	userMfaManager          mfa.UserMfaManager
+	mfaChallengeManager     mfa.MfaChallengeManager
+	// dummyPasswordHash is used to verify a password if the user or user's credentials
+	// aren't found so that the response time doesn't reveal whether the user exists.
+	dummyPasswordHash string
+	logger            logging.Logger[*context.LogEntryContext]
+}
+
+var _ credentials.SignInManager = (*SignInManager)(nil)
+
+func NewSignInManager(
+	userManager users.UserManager,
+	userCredentialManager credentials.UserCredentialManager,
+	userAgentManager useragents.UserAgentManager,
+	userSessionManager sessions.UserSessionManager,
+	userAgentSessionManager sessions.UserAgentSessionManager,
+	authenticationManager authentication.AuthenticationManager,
+	lockoutManager lockouts.LockoutManager,
+	userMfaManager mfa.UserMfaManager,
+	mfaChallengeManager mfa.MfaChallengeManager,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*SignInManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.credentials.manager.SignInManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewSignInManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserCredential,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewSignInManager] new operation executor: %w", err)
+	}
+
+	h, err := passwords.Hash("dummy-password")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewSignInManager] hash a dummy password: %w", err)
+	}
+
+	return &SignInManager{
+		opExecutor:              e,
+		userManager:             userManager,
+		userCredentialManager:   userCredentialManager,
+		userAgentManager:        userAgentManager,
+		userSessionManager:      userSessionManager,
+		userAgentSessionManager: userAgentSessionManager,
+		authenticationManager:   authenticationManager,
+		lockoutManager:          lockoutManager,
+		userMfaManager:          userMfaManager,
+		mfaChallengeManager:     mfaChallengeManager,
+		dummyPasswordHash:       h,
+		logger:                  l,
+	}, nil
+}
+
+// SignInWithPassword signs in a user with a name or an email and a password, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+// If MFA is required, then only the MFA challenge is created and the sign-in must be completed
+// using CompleteSignIn.
+func (m *SignInManager) SignInWithPassword(ctx *actions.OperationContext, data *signin.SignInWithPasswordOperationData) (*models.SignInResult, error) {
+	var r *models.SignInResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeSignInManager_SignInWithPassword,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] validate data: %w", err)
+			}
+
+			var u *userdbmodels.User
+			var err error
+			if data.Name.HasValue {
+				if u, err = m.userManager.FindByName(opCtx, strings.TrimSpace(data.Name.Value), false); err != nil {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] find a user by name: %w", err)
+				}
+			} else if u, err = m.userManager.FindByEmail(opCtx, strings.TrimSpace(data.Email.Value), false); err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] find a user by email: %w", err)
+			}
+
+			if u == nil {
+				m.verifyDummyPassword(data.Password)
+				if err = m.registerFailedAttempts(opCtx, data.ClientId, nil, nil); err != nil {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] register failed attempts: %w", err)
+				}
+				return ierrors.ErrInvalidCredentials
+			}
+
+			// the lockout is checked before the password is verified so that the password
+			// can't be guessed while the user is locked out
+			if err = m.checkUserLockout(opCtx, u); err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] check the user's lockout: %w", err)
+			}
+
+			ua, err := m.userAgentManager.FindByUserIdAndClientId(opCtx, u.Id, data.ClientId)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] find a user agent by user id and client id: %w", err)
+			}
+
+			ok, err := m.userCredentialManager.VerifyPassword(opCtx, u.Id, data.Password)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserCredentialNotFound {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] verify a password: %w", err)
+				}
+				m.verifyDummyPassword(data.Password)
+			}
+
+			if !ok {
+				m.logger.WarningWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.UserCredentialEvent,
+					"[manager.SignInManager.SignInWithPassword] invalid credentials",
+					logging.NewField("userId", u.Id),
+				)
+
+				if err = m.registerFailedAttempts(opCtx, data.ClientId, u, ua); err != nil {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] register failed attempts: %w", err)
+				}
+				return ierrors.ErrInvalidCredentials
+			}
+
+			if u.Status != usermodels.UserStatusActive {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid user's status (%v)", u.Status))
+			}
+
+			mfaEnabled, err := m.userMfaManager.IsTotpEnabled(opCtx, u.Id)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] is TOTP enabled: %w", err)
+			}
+
+			if !mfaEnabled {
+				mfaRequired, err := m.userMfaManager.IsRequired(opCtx, u.Id, u.Group)
+				if err != nil {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] is MFA required: %w", err)
+				}
+
+				if mfaRequired {
+					m.logger.WarningWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.UserCredentialEvent,
+						"[manager.SignInManager.SignInWithPassword] MFA is required, but the user hasn't enrolled",
+						logging.NewField("userId", u.Id),
+					)
+					return ierrors.ErrMfaEnrollmentRequired
+				}
+			} else {
+				// the sessions and the user's token are created after the MFA challenge is completed
+				cd := &challenges.CreateOperationData{
+					UserId:    u.Id,
+					ClientId:  data.ClientId,
+					AppId:     data.AppId,
+					UserAgent: data.UserAgent,
+					IP:        data.IP,
+				}
+				c, err := m.mfaChallengeManager.Create(opCtx, cd)
+				if err != nil {
+					return fmt.Errorf("[manager.SignInManager.SignInWithPassword] create an MFA challenge: %w", err)
+				}
+
+				r = &models.SignInResult{
+					UserId:                u.Id,
+					MfaRequired:           true,
+					MfaChallengeToken:     c.Token,
+					MfaChallengeExpiresAt: c.ExpiresAt,
+				}
+
+				m.logger.InfoWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.UserCredentialEvent,
+					"[manager.SignInManager.SignInWithPassword] MFA is required to complete the sign-in",
+					logging.NewField("userId", u.Id),
+				)
+				return nil
+			}
+
+			sd := &signInData{
+				ClientId:  data.ClientId,
+				AppId:     data.AppId,
+				UserAgent: data.UserAgent,
+				IP:        data.IP,
+			}
+			if r, err = m.signIn(opCtx, u, ua, sd); err != nil {
+				return fmt.Errorf("[manager.SignInManager.SignInWithPassword] sign in a user: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.SignInWithPassword] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// CompleteSignIn completes the sign-in of a user with the MFA challenge, creates and starts
+// a user's web session and a web session of the user agent, and returns the result of the sign-in
+// (including the user's token) if the operation is successful.
+func (m *SignInManager) CompleteSignIn(ctx *actions.OperationContext, data *signin.CompleteSignInOperationData) (*models.SignInResult, error) {
+	var r *models.SignInResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeSignInManager_CompleteSignIn,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] validate data: %w", err)
+			}
+
+			c, err := m.mfaChallengeManager.FindByToken(opCtx, data.ChallengeToken)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] find an MFA challenge by token: %w", err)
+			}
+
+			if c == nil {
+				return ierrors.ErrMfaChallengeNotFound
+			}
+
+			u, err := m.userManager.FindById(opCtx, c.UserId)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] find a user by id: %w", err)
+			}
+
+			if u == nil {
+				return ierrors.ErrUserNotFound
+			}
+
+			// the lockout is checked before the verification so that the code can't be guessed
+			// while the user is locked out and the challenge and the code aren't used up
+			if err = m.checkUserLockout(opCtx, u); err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] check the user's lockout: %w", err)
+			}
+
+			c, valid, err := m.mfaChallengeManager.Verify(opCtx, data.ChallengeToken, data.Method, data.Code)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] verify an MFA challenge: %w", err)
+			}
+
+			ua, err := m.userAgentManager.FindByUserIdAndClientId(opCtx, u.Id, c.ClientId)
+			if err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] find a user agent by user id and client id: %w", err)
+			}
+
+			if !valid {
+				m.logger.WarningWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.UserCredentialEvent,
+					"[manager.SignInManager.CompleteSignIn] invalid MFA code",
+					logging.NewField("userId", u.Id),
+					logging.NewField("method", data.Method),
+				)
+
+				if err = m.registerFailedAttempts(opCtx, c.ClientId, u, ua); err != nil {
+					return fmt.Errorf("[manager.SignInManager.CompleteSignIn] register failed attempts: %w", err)
+				}
+				return ierrors.ErrInvalidMfaCode
+			}
+
+			if u.Status != usermodels.UserStatusActive {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid user's status (%v)", u.Status))
+			}
+
+			sd := &signInData{
+				ClientId:  c.ClientId,
+				AppId:     nullable.FromPtr(c.AppId),
+				UserAgent: c.UserAgent,
+				IP:        data.IP,
+			}
+			if r, err = m.signIn(opCtx, u, ua, sd); err != nil {
+				return fmt.Errorf("[manager.SignInManager.CompleteSignIn] sign in a user: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.CompleteSignIn] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+type signInData struct {
+	ClientId  uint64
+	AppId     nullable.Nullable[uint64]
+	UserAgent string
+	IP        string
+}
+
+// signIn resets the failed attempts of the user and the user agent, creates and starts
+// a user's web session and a web session of the user agent, and creates a user's token.
+// The user's credentials (and MFA) must be verified before.
+func (m *SignInManager) signIn(ctx *actions.OperationContext, u *userdbmodels.User, ua *useragentdbmodels.UserAgent, data *signInData,
+) (*models.SignInResult, error) {
+	if err := m.lockoutManager.ResetFailedAttempts(ctx, lockoutmodels.TargetTypeUser, u.Id); err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.signIn] reset failed attempts of the user: %w", err)
+	}
+
+	var uaId uint64
+	var err error
+	if ua != nil {
+		uaId = ua.Id
+		if err = m.lockoutManager.ResetFailedAttempts(ctx, lockoutmodels.TargetTypeUserAgent, ua.Id); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] reset failed attempts of the user agent: %w", err)
+		}
+	} else {
+		d := &useragentoperations.CreateWebUserAgentOperationData{
+			UserId:    u.Id,
+			ClientId:  data.ClientId,
+			AppId:     data.AppId,
+			UserAgent: data.UserAgent,
+		}
+		if uaId, err = m.userAgentManager.CreateWebUserAgent(ctx, d); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] create a web user agent: %w", err)
+		}
+	}
+
+	uas, err := m.userAgentSessionManager.FindByUserIdAndClientId(ctx, u.Id, data.ClientId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.signIn] find a user agent session by user id and client id: %w", err)
+	}
+
+	if uas != nil && uas.Status == sessionmodels.UserAgentSessionStatusActive {
+		// the user agent is already signed in, the previous sessions are ended
+		if err = m.userAgentSessionManager.Terminate(ctx, uas.Id, false); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] terminate a user agent session: %w", err)
+		}
+
+		if err = m.userSessionManager.Terminate(ctx, uas.UserSessionId); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] terminate a user's session: %w", err)
+		}
+	}
+
+	usd := &usersessions.CreateAndStartWebSessionOperationData{
+		UserId:      u.Id,
+		ClientId:    data.ClientId,
+		UserAgentId: uaId,
+		AppId:       data.AppId,
+		FirstIP:     data.IP,
+	}
+	usId, err := m.userSessionManager.CreateAndStartWebSession(ctx, usd)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.signIn] create and start a user's web session: %w", err)
+	}
+
+	var uasId uint64
+	if uas != nil {
+		uasId = uas.Id
+		if err = m.userAgentSessionManager.Start(ctx, uasId, usId, data.IP); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] start a user agent session: %w", err)
+		}
+	} else {
+		uasd := &useragentsessions.CreateAndStartOperationData{
+			UserId:        u.Id,
+			ClientId:      data.ClientId,
+			UserAgentId:   uaId,
+			UserSessionId: usId,
+			IP:            data.IP,
+		}
+		if uasId, err = m.userAgentSessionManager.CreateAndStartWebSession(ctx, uasd); err != nil {
+			return nil, fmt.Errorf("[manager.SignInManager.signIn] create and start a web session of the user agent: %w", err)
+		}
+	}
+
+	t, err := m.authenticationManager.CreateUserToken(ctx, usId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.SignInManager.signIn] create a user's token: %w", err)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.UserCredentialEvent,
+		"[manager.SignInManager.signIn] user has signed in",
+		logging.NewField("userId", u.Id),
+		logging.NewField("userSessionId", usId),
+		logging.NewField("userAgentSessionId", uasId),
+	)
+
+	return &models.SignInResult{
+		UserId:             u.Id,
+		UserSessionId:      usId,
+		UserAgentId:        uaId,
+		UserAgentSessionId: uasId,
+		UserToken:          t,
+	}, nil
+}
+
+// checkUserLockout returns ierrors.ErrUserLockedOut if the user is locked out.
+// If the user's temporary lockout has expired, then the user is unlocked.
+func (m *SignInManager) checkUserLockout(ctx *actions.OperationContext, u *userdbmodels.User) error {
+	switch u.Status {
+	case usermodels.UserStatusLockedOut:
+	case usermodels.UserStatusTemporarilyLockedOut:
+		unlocked, err := m.lockoutManager.UnlockIfExpired(ctx, lockoutmodels.TargetTypeUser, u.Id)
+		if err != nil {
+			return fmt.Errorf("[manager.SignInManager.checkUserLockout] unlock the user if the lockout has expired: %w", err)
+		}
+
+		if unlocked {
+			u.Status = usermodels.UserStatusActive
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	m.logger.WarningWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.UserCredentialEvent,
+		"[manager.SignInManager.checkUserLockout] user is locked out",
+		logging.NewField("userId", u.Id),
+		logging.NewField("status", u.Status),
+	)
+	return ierrors.ErrUserLockedOut
+}
+
+// registerFailedAttempts registers a failed sign-in attempt of the client and,
+// if they exist, the user and the user agent.
+func (m *SignInManager) registerFailedAttempts(ctx *actions.OperationContext, clientId uint64, u *userdbmodels.User, ua *useragentdbmodels.UserAgent) error {
+	if _, err := m.lockoutManager.RegisterFailedAttempt(ctx, lockoutmodels.TargetTypeClient, clientId); err != nil {
+		return fmt.Errorf("[manager.SignInManager.registerFailedAttempts] register a failed attempt of the client: %w", err)
+	}
+
+	if u != nil {
+		if _, err := m.lockoutManager.RegisterFailedAttempt(ctx, lockoutmodels.TargetTypeUser, u.Id); err != nil {
+			return fmt.Errorf("[manager.SignInManager.registerFailedAttempts] register a failed attempt of the user: %w", err)
+		}
+	}
+
+	if ua != nil {
+		if _, err := m.lockoutManager.RegisterFailedAttempt(ctx, lockoutmodels.TargetTypeUserAgent, ua.Id); err != nil {
+			return fmt.Errorf("[manager.SignInManager.registerFailedAttempts] register a failed attempt of the user agent: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *SignInManager) verifyDummyPassword(password string) {
+	// the result is ignored
+	passwords.Verify(password, m.dummyPasswordHash)
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/credentials"
+	"personal-website-v2/identity/src/internal/credentials/models"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/crypto/passwords"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// UserCredentialManager is a manager of users' credentials.
+type UserCredentialManager struct {
+	opExecutor          *actionhelper.OperationExecutor
+	userCredentialStore credentials.UserCredentialStore
+	logger              logging.Logger[*context.LogEntryContext]
+}
+
+var _ credentials.UserCredentialManager = (*UserCredentialManager)(nil)
+
+func NewUserCredentialManager(userCredentialStore credentials.UserCredentialStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*UserCredentialManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.credentials.manager.UserCredentialManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserCredentialManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserCredential,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserCredentialManager] new operation executor: %w", err)
+	}
+
+	return &UserCredentialManager{
+		opExecutor:          e,
+		userCredentialStore: userCredentialStore,
+		logger:              l,
+	}, nil
+}
+
+// SetPassword sets (resets) a user's password by the specified user ID.
+func (m *UserCredentialManager) SetPassword(ctx *actions.OperationContext, userId uint64, password string) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserCredentialManager_SetPassword, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if err := validatePassword(password); err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.SetPassword] validate a password: %w", err)
+			}
+
+			if err := m.setPassword(opCtx, userId, password); err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.SetPassword] set a password: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserCredentialEvent,
+				"[manager.UserCredentialManager.SetPassword] user's password has been set",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserCredentialManager.SetPassword] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// ChangePassword changes a user's password by the specified user ID
+// if the current password is valid.
+func (m *UserCredentialManager) ChangePassword(ctx *actions.OperationContext, userId uint64, currentPassword, newPassword string) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserCredentialManager_ChangePassword, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if err := validatePassword(newPassword); err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.ChangePassword] validate a new password: %w", err)
+			}
+
+			ok, err := m.VerifyPassword(opCtx, userId, currentPassword)
+			if err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.ChangePassword] verify a password: %w", err)
+			}
+
+			if !ok {
+				return ierrors.ErrInvalidCredentials
+			}
+
+			if err := m.setPassword(opCtx, userId, newPassword); err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.ChangePassword] set a password: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserCredentialEvent,
+				"[manager.UserCredentialManager.ChangePassword] user's password has been changed",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserCredentialManager.ChangePassword] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// VerifyPassword returns true if the password matches the user's password.
+func (m *UserCredentialManager) VerifyPassword(ctx *actions.OperationContext, userId uint64, password string) (bool, error) {
+	var ok bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserCredentialManager_VerifyPassword, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			c, err := m.userCredentialStore.FindByUserId(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.VerifyPassword] find user's credentials by user id: %w", err)
+			}
+
+			if c == nil {
+				return ierrors.ErrUserCredentialNotFound
+			}
+
+			if ok, err = passwords.Verify(password, c.PasswordHash); err != nil {
+				return fmt.Errorf("[manager.UserCredentialManager.VerifyPassword] verify a password: %w", err)
+			}
+
+			// the hash was created with outdated params (or a legacy algorithm),
+			// the password is rehashed with the current params
+			if ok && passwords.NeedsRehash(c.PasswordHash, passwords.DefaultArgon2idParams) {
+				if err := m.setPassword(opCtx, userId, password); err != nil {
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.UserCredentialEvent, err,
+						"[manager.UserCredentialManager.VerifyPassword] rehash a password",
+						logging.NewField("userId", userId),
+					)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserCredentialManager.VerifyPassword] execute an operation: %w", err)
+	}
+	return ok, nil
+}
+
+func (m *UserCredentialManager) setPassword(ctx *actions.OperationContext, userId uint64, password string) error {
+	h, err := passwords.Hash(password)
+	if err != nil {
+		return fmt.Errorf("[manager.UserCredentialManager.setPassword] hash a password: %w", err)
+	}
+
+	if err = m.userCredentialStore.SetPassword(ctx, userId, h); err != nil {
+		return fmt.Errorf("[manager.UserCredentialManager.setPassword] set a password hash: %w", err)
+	}
+	return nil
+}
+
+func validatePassword(password string) *errors.Error {
+	if n := utf8.RuneCountInString(password); n < models.PasswordMinLength {
+		return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("password must be at least %d characters", models.PasswordMinLength))
+	} else if n > models.PasswordMaxLength {
+		return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("password must be at most %d characters", models.PasswordMaxLength))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"personal-website-v2/identity/src/internal/credentials/models"
+	"personal-website-v2/identity/src/internal/credentials/operations/signin"
+	"personal-website-v2/pkg/actions"
+)
+
+type UserCredentialManager interface {
+	// SetPassword sets (resets) a user's password by the specified user ID.
+	SetPassword(ctx *actions.OperationContext, userId uint64, password string) error
+
+	// ChangePassword changes a user's password by the specified user ID
+	// if the current password is valid.
+	ChangePassword(ctx *actions.OperationContext, userId uint64, currentPassword, newPassword string) error
+
+	// VerifyPassword returns true if the password matches the user's password.
+	VerifyPassword(ctx *actions.OperationContext, userId uint64, password string) (bool, error)
+}
+
+type SignInManager interface {
+	// SignInWithPassword signs in a user with a name or an email and a password, creates and starts
+	// a user's web session and a web session of the user agent, and returns the result of the sign-in
+	// (including the user's token) if the operation is successful.
+	// If MFA is required, then only the MFA challenge is created and the sign-in must be completed
+	// using CompleteSignIn.
+	SignInWithPassword(ctx *actions.OperationContext, data *signin.SignInWithPasswordOperationData) (*models.SignInResult, error)
+
+	// CompleteSignIn completes the sign-in of a user with the MFA challenge, creates and starts
+	// a user's web session and a web session of the user agent, and returns the result of the sign-in
+	// (including the user's token) if the operation is successful.
+	CompleteSignIn(ctx *actions.OperationContext, data *signin.CompleteSignInOperationData) (*models.SignInResult, error)
+}
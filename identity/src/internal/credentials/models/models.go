@@ -0,0 +1,52 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "time"
+
+const (
+	// The minimum length of a password (in characters).
+	PasswordMinLength = 8
+
+	// The maximum length of a password (in characters).
+	PasswordMaxLength = 128
+)
+
+type SignInResult struct {
+	// The user ID.
+	UserId uint64
+
+	// The user's session ID.
+	UserSessionId uint64
+
+	// The user agent ID.
+	UserAgentId uint64
+
+	// The user agent session ID.
+	UserAgentSessionId uint64
+
+	// The user's token.
+	UserToken []byte
+
+	// True if MFA is required to complete the sign-in. If it is true, the sessions and
+	// the user's token aren't created until the MFA challenge is completed.
+	MfaRequired bool
+
+	// The MFA challenge token (if MFA is required).
+	MfaChallengeToken string
+
+	// The date and time at which the MFA challenge expires (if MFA is required).
+	MfaChallengeExpiresAt time.Time
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signin
+
+import (
+	mfamodels "personal-website-v2/identity/src/internal/mfa/models"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+type SignInWithPasswordOperationData struct {
+	// The user name.
+	Name nullable.Nullable[string] `json:"name"`
+
+	// The user's email.
+	Email nullable.Nullable[string] `json:"email"`
+
+	// The user's password.
+	Password string `json:"-"`
+
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The app ID.
+	AppId nullable.Nullable[uint64] `json:"appId"`
+
+	// The User-Agent.
+	UserAgent string `json:"userAgent"`
+
+	// The IP address (sign-in IP address).
+	IP string `json:"ip"`
+}
+
+func (d *SignInWithPasswordOperationData) Validate() *errors.Error {
+	if d.Name.HasValue == d.Email.HasValue {
+		return errors.NewError(errors.ErrorCodeInvalidData, "either name or email must be specified")
+	}
+	if d.Name.HasValue && strings.IsEmptyOrWhitespace(d.Name.Value) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "name is empty")
+	}
+	if d.Email.HasValue && strings.IsEmptyOrWhitespace(d.Email.Value) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "email is empty")
+	}
+	if len(d.Password) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "password is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.UserAgent) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "userAgent is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.IP) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
+
+type CompleteSignInOperationData struct {
+	// The MFA challenge token.
+	ChallengeToken string `json:"-"`
+
+	// The MFA method.
+	Method mfamodels.MfaMethod `json:"method"`
+
+	// The TOTP code or the recovery code.
+	Code string `json:"-"`
+
+	// The IP address (sign-in IP address).
+	IP string `json:"ip"`
+}
+
+func (d *CompleteSignInOperationData) Validate() *errors.Error {
+	if len(d.ChallengeToken) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "challengeToken is empty")
+	}
+	if d.Method != mfamodels.MfaMethodTotp && d.Method != mfamodels.MfaMethodRecoveryCode {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid method")
+	}
+	if strings.IsEmptyOrWhitespace(d.Code) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "code is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.IP) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"personal-website-v2/identity/src/internal/credentials/dbmodels"
+	"personal-website-v2/pkg/actions"
+)
+
+type UserCredentialStore interface {
+	// SetPassword sets a password hash of the user by the specified user ID.
+	SetPassword(ctx *actions.OperationContext, userId uint64, passwordHash string) error
+
+	// FindByUserId finds and returns user's credentials, if any, by the specified user ID.
+	FindByUserId(ctx *actions.OperationContext, userId uint64) (*dbmodels.UserCredential, error)
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/credentials"
+	"personal-website-v2/identity/src/internal/credentials/dbmodels"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	userCredentialsTable = "public.user_credentials"
+)
+
+// UserCredentialStore is a store of users' credentials.
+type UserCredentialStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.UserCredential]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ credentials.UserCredentialStore = (*UserCredentialStore)(nil)
+
+func NewUserCredentialStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*UserCredentialStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.credentials.stores.UserCredentialStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserCredentialStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupUserCredential,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserCredentialStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserCredentialStore] new TxManager: %w", err)
+	}
+
+	return &UserCredentialStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.UserCredential](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// SetPassword sets a password hash of the user by the specified user ID.
+func (s *UserCredentialStore) SetPassword(ctx *actions.OperationContext, userId uint64, passwordHash string) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserCredentialStore_SetPassword, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.set_user_password(IN _user_id, IN _password_hash, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.set_user_password($1, $2, $3, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, passwordHash, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserCredentialStore.SetPassword] execute a query (set_user_password): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserCredentialStore.SetPassword] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserCredentialStore.SetPassword] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserCredentialStore.SetPassword] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindByUserId finds and returns user's credentials, if any, by the specified user ID.
+func (s *UserCredentialStore) FindByUserId(ctx *actions.OperationContext, userId uint64) (*dbmodels.UserCredential, error) {
+	var c *dbmodels.UserCredential
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserCredentialStore_FindByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + userCredentialsTable + " WHERE user_id = $1 LIMIT 1"
+			var err error
+			if c, err = s.store.Find(opCtx.Ctx, query, userId); err != nil {
+				return fmt.Errorf("[stores.UserCredentialStore.FindByUserId] find user's credentials by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserCredentialStore.FindByUserId] execute an operation: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+)
+
+type DataSubjectRequest struct {
+	// The unique ID to identify the request.
+	Id uint64 `db:"id"`
+
+	// The ID of the user (data subject) whose data the request concerns.
+	UserId uint64 `db:"user_id"`
+
+	// The request type.
+	Type models.DataSubjectRequestType `db:"type"`
+
+	// It stores the date and time at which the request was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the creator of the request.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the request was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the request.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The request status.
+	Status models.DataSubjectRequestStatus `db:"status"`
+
+	// It stores the date and time at which the request status was updated.
+	StatusUpdatedAt time.Time `db:"status_updated_at"`
+
+	// The user ID to identify the user who updated the request status.
+	StatusUpdatedBy uint64 `db:"status_updated_by"`
+
+	// The request status comment.
+	StatusComment *string `db:"status_comment"`
+
+	// It stores the date and time at which the request was completed.
+	CompletedAt *time.Time `db:"completed_at"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+type DataSubjectRequestStep struct {
+	// The unique ID to identify the request step.
+	Id uint64 `db:"id"`
+
+	// The request ID.
+	RequestId uint64 `db:"request_id"`
+
+	// The step.
+	Step models.DataSubjectRequestStep `db:"step"`
+
+	// The sequence number of the step within the request.
+	SeqNum uint16 `db:"seq_num"`
+
+	// The step status.
+	Status models.DataSubjectRequestStepStatus `db:"status"`
+
+	// The ID of the action within which the step was (is being) performed.
+	ActionId uuid.NullUUID `db:"action_id"`
+
+	// It stores the date and time at which the step was started.
+	StartedAt *time.Time `db:"started_at"`
+
+	// It stores the date and time at which the step was completed or failed.
+	CompletedAt *time.Time `db:"completed_at"`
+
+	// The error message if the step failed.
+	ErrorMessage *string `db:"error_message"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+type DataSubjectRequestExportSection struct {
+	// The unique ID to identify the export section.
+	Id uint64 `db:"id"`
+
+	// The request ID.
+	RequestId uint64 `db:"request_id"`
+
+	// The export step that created the section.
+	Step models.DataSubjectRequestStep `db:"step"`
+
+	// The section data (JSON).
+	Data []byte `db:"data"`
+
+	// It stores the date and time at which the section was created.
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,469 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/apikeys"
+	apikeymodels "personal-website-v2/identity/src/internal/apikeys/models"
+	"personal-website-v2/identity/src/internal/datasubjects"
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/identity/src/internal/useragents"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// DataSubjectRequestManager is a manager of data subject requests.
+type DataSubjectRequestManager struct {
+	opExecutor                *actionhelper.OperationExecutor
+	requestStore              datasubjects.DataSubjectRequestStore
+	userManager               users.UserManager
+	userPersonalInfoManager   users.UserPersonalInfoManager
+	userSessionManager        sessions.UserSessionManager
+	userAgentSessionManager   sessions.UserAgentSessionManager
+	activeSessionManager      sessions.ActiveSessionManager
+	userAgentManager          useragents.UserAgentManager
+	userRoleAssignmentManager roles.UserRoleAssignmentManager
+	userGroupMemberManager    groups.UserGroupMemberManager
+	apiKeyManager             apikeys.ApiKeyManager
+	logger                    logging.Logger[*context.LogEntryContext]
+}
+
+var _ datasubjects.DataSubjectRequestManager = (*DataSubjectRequestManager)(nil)
+
+func NewDataSubjectRequestManager(
+	requestStore datasubjects.DataSubjectRequestStore,
+	userManager users.UserManager,
+	userPersonalInfoManager users.UserPersonalInfoManager,
+	userSessionManager sessions.UserSessionManager,
+	userAgentSessionManager sessions.UserAgentSessionManager,
+	activeSessionManager sessions.ActiveSessionManager,
+	userAgentManager useragents.UserAgentManager,
+	userRoleAssignmentManager roles.UserRoleAssignmentManager,
+	userGroupMemberManager groups.UserGroupMemberManager,
+	apiKeyManager apikeys.ApiKeyManager,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*DataSubjectRequestManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.datasubjects.manager.DataSubjectRequestManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewDataSubjectRequestManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupDataSubjectRequest,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewDataSubjectRequestManager] new operation executor: %w", err)
+	}
+
+	return &DataSubjectRequestManager{
+		opExecutor:                e,
+		requestStore:              requestStore,
+		userManager:               userManager,
+		userPersonalInfoManager:   userPersonalInfoManager,
+		userSessionManager:        userSessionManager,
+		userAgentSessionManager:   userAgentSessionManager,
+		activeSessionManager:      activeSessionManager,
+		userAgentManager:          userAgentManager,
+		userRoleAssignmentManager: userRoleAssignmentManager,
+		userGroupMemberManager:    userGroupMemberManager,
+		apiKeyManager:             apiKeyManager,
+		logger:                    l,
+	}, nil
+}
+
+// CreateExport creates a request to export all personal data of the user
+// and returns the request ID if the operation is successful.
+func (m *DataSubjectRequestManager) CreateExport(ctx *actions.OperationContext, userId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_CreateExport,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.requestStore.Create(opCtx, userId, models.DataSubjectRequestTypeExport, models.ExportSteps); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.CreateExport] create a request: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.DataSubjectRequestEvent,
+				"[manager.DataSubjectRequestManager.CreateExport] export request has been created",
+				logging.NewField("id", id),
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.DataSubjectRequestManager.CreateExport] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// CreateErasure creates a request to erase the personal data of the user
+// and returns the request ID if the operation is successful.
+func (m *DataSubjectRequestManager) CreateErasure(ctx *actions.OperationContext, userId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_CreateErasure,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.userManager.GetTypeById(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.CreateErasure] get a user's type by id: %w", err)
+			}
+
+			// the data of system users isn't personal data
+			if t != usermodels.UserTypeUser {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid user's type (%v)", t))
+			}
+
+			if id, err = m.requestStore.Create(opCtx, userId, models.DataSubjectRequestTypeErasure, models.ErasureSteps); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.CreateErasure] create a request: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.DataSubjectRequestEvent,
+				"[manager.DataSubjectRequestManager.CreateErasure] erasure request has been created",
+				logging.NewField("id", id),
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.DataSubjectRequestManager.CreateErasure] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindById finds and returns a data subject request, if any, by the specified request ID.
+func (m *DataSubjectRequestManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.DataSubjectRequest, error) {
+	var r *dbmodels.DataSubjectRequest
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if r, err = m.requestStore.FindById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.FindById] find a request by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.FindById] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// GetAllByUserId gets all data subject requests by the specified user ID.
+func (m *DataSubjectRequestManager) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.DataSubjectRequest, error) {
+	var rs []*dbmodels.DataSubjectRequest
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_GetAllByUserId,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if rs, err = m.requestStore.GetAllByUserId(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetAllByUserId] get all requests by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.GetAllByUserId] execute an operation: %w", err)
+	}
+	return rs, nil
+}
+
+// GetAllStepsByRequestId gets all steps of the data subject request by the specified request ID.
+func (m *DataSubjectRequestManager) GetAllStepsByRequestId(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestStep, error) {
+	var steps []*dbmodels.DataSubjectRequestStep
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_GetAllStepsByRequestId,
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if steps, err = m.requestStore.GetAllStepsByRequestId(opCtx, requestId); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetAllStepsByRequestId] get all steps by request id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.GetAllStepsByRequestId] execute an operation: %w", err)
+	}
+	return steps, nil
+}
+
+// exportArchive is the JSON archive of the user's personal data.
+type exportArchive struct {
+	RequestId   uint64                     `json:"requestId"`
+	UserId      uint64                     `json:"userId"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	CompletedAt time.Time                  `json:"completedAt"`
+	Sections    map[string]json.RawMessage `json:"sections"`
+}
+
+// GetExportData gets the data (JSON archive) of the completed export request by the specified request ID.
+func (m *DataSubjectRequestManager) GetExportData(ctx *actions.OperationContext, id uint64) ([]byte, error) {
+	var data []byte
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_GetExportData, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.requestStore.FindById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetExportData] find a request by id: %w", err)
+			}
+			if r == nil {
+				return ierrors.ErrDataSubjectRequestNotFound
+			}
+
+			if r.Type != models.DataSubjectRequestTypeExport {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid request's type (%v)", r.Type))
+			}
+			if r.Status != models.DataSubjectRequestStatusCompleted || r.CompletedAt == nil {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid request's status (%v)", r.Status))
+			}
+
+			ss, err := m.requestStore.GetAllExportSections(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetExportData] get all export sections: %w", err)
+			}
+
+			// the sections are deleted when the user's data is erased
+			if len(ss) == 0 {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "export data has been erased")
+			}
+
+			a := &exportArchive{
+				RequestId:   r.Id,
+				UserId:      r.UserId,
+				CreatedAt:   r.CreatedAt,
+				CompletedAt: *r.CompletedAt,
+				Sections:    make(map[string]json.RawMessage, len(ss)),
+			}
+			for _, s := range ss {
+				a.Sections[s.Step.String()] = s.Data
+			}
+
+			if data, err = json.Marshal(a); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetExportData] marshal an archive to JSON: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.GetExportData] execute an operation: %w", err)
+	}
+	return data, nil
+}
+
+// GetAllIdsToProcess gets the IDs of the data subject requests that are new or in progress.
+func (m *DataSubjectRequestManager) GetAllIdsToProcess(ctx *actions.OperationContext, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_GetAllIdsToProcess,
+		[]*actions.OperationParam{actions.NewOperationParam("limit", limit)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.requestStore.GetAllIdsToProcess(opCtx, limit); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.GetAllIdsToProcess] get all request ids to process: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.GetAllIdsToProcess] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// ProcessStep performs the step of the data subject request. The step is started within
+// the current action and is completed or marked as failed depending on the result.
+func (m *DataSubjectRequestManager) ProcessStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestManager_ProcessStep,
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId), actions.NewOperationParam("step", step)},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.requestStore.FindById(opCtx, requestId)
+			if err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.ProcessStep] find a request by id: %w", err)
+			}
+			if r == nil {
+				return ierrors.ErrDataSubjectRequestNotFound
+			}
+
+			// the action ID is saved so that it can be proven when and how the step was performed
+			if err = m.requestStore.StartStep(opCtx, requestId, step, opCtx.Action.Id()); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.ProcessStep] start a step: %w", err)
+			}
+
+			data, err := m.performStep(opCtx, r.UserId, step)
+			if err != nil {
+				if err2 := m.requestStore.FailStep(opCtx, requestId, step, err.Error()); err2 != nil {
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.DataSubjectRequestEvent, err2,
+						"[manager.DataSubjectRequestManager.ProcessStep] fail a step",
+						logging.NewField("requestId", requestId),
+						logging.NewField("step", step),
+					)
+				}
+				return fmt.Errorf("[manager.DataSubjectRequestManager.ProcessStep] perform a step: %w", err)
+			}
+
+			if err = m.requestStore.CompleteStep(opCtx, requestId, step, data); err != nil {
+				return fmt.Errorf("[manager.DataSubjectRequestManager.ProcessStep] complete a step: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.DataSubjectRequestEvent,
+				"[manager.DataSubjectRequestManager.ProcessStep] step has been completed",
+				logging.NewField("requestId", requestId),
+				logging.NewField("userId", r.UserId),
+				logging.NewField("step", step),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.DataSubjectRequestManager.ProcessStep] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// performStep performs the step of the data subject request and returns the export section (JSON),
+// if the step is an export step.
+func (m *DataSubjectRequestManager) performStep(ctx *actions.OperationContext, userId uint64, step models.DataSubjectRequestStep) ([]byte, error) {
+	var v any
+	var err error
+
+	switch step {
+	case models.DataSubjectRequestStepExportUser:
+		if v, err = m.userManager.FindById(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] find a user by id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportPersonalInfo:
+		if v, err = m.userPersonalInfoManager.GetByUserId(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get user's personal info by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportUserSessions:
+		if v, err = m.userSessionManager.GetAllByUserId(ctx, userId, false); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all user's sessions by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportUserAgentSessions:
+		if v, err = m.userAgentSessionManager.GetAllByUserId(ctx, userId, false); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all user agent sessions by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportUserAgents:
+		if v, err = m.userAgentManager.GetAllByUserId(ctx, userId, false); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all user agents by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportRoleAssignments:
+		if v, err = m.userRoleAssignmentManager.GetAllByUserId(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all user's role assignments by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportUserGroups:
+		if v, err = m.userGroupMemberManager.GetAllGroupIdsByUserId(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all group ids by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepExportApiKeys:
+		if v, err = m.apiKeyManager.GetAllByUserId(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] get all personal API keys by user id: %w", err)
+		}
+	case models.DataSubjectRequestStepRevokeSessions:
+		return nil, m.revokeSessions(ctx, userId)
+	case models.DataSubjectRequestStepRevokeApiKeys:
+		return nil, m.revokeApiKeys(ctx, userId)
+	case models.DataSubjectRequestStepAnonymizeUser:
+		if err = m.userManager.Anonymize(ctx, userId); err != nil {
+			return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] anonymize a user: %w", err)
+		}
+		return nil, nil
+	case models.DataSubjectRequestStepDeleteUser:
+		return nil, m.deleteUser(ctx, userId)
+	default:
+		return nil, errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("unknown step (%v)", step))
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.DataSubjectRequestManager.performStep] marshal a section to JSON: %w", err)
+	}
+	return b, nil
+}
+
+func (m *DataSubjectRequestManager) revokeSessions(ctx *actions.OperationContext, userId uint64) error {
+	ss, err := m.activeSessionManager.GetAllByUserId(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("[manager.DataSubjectRequestManager.revokeSessions] get all active sessions by user id: %w", err)
+	}
+
+	for _, s := range ss {
+		if err = m.activeSessionManager.Revoke(ctx, userId, s.Id); err != nil {
+			return fmt.Errorf("[manager.DataSubjectRequestManager.revokeSessions] revoke a session: %w", err)
+		}
+	}
+	return nil
+}
+
+// revokeApiKeys revokes the user's active API keys so that the apps that cache them are notified.
+// The keys are deleted when the user is anonymized.
+func (m *DataSubjectRequestManager) revokeApiKeys(ctx *actions.OperationContext, userId uint64) error {
+	ks, err := m.apiKeyManager.GetAllByUserId(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("[manager.DataSubjectRequestManager.revokeApiKeys] get all personal API keys by user id: %w", err)
+	}
+
+	for _, k := range ks {
+		if k.Status != apikeymodels.ApiKeyStatusActive {
+			continue
+		}
+		if err = m.apiKeyManager.Revoke(ctx, k.Id); err != nil {
+			return fmt.Errorf("[manager.DataSubjectRequestManager.revokeApiKeys] revoke an API key: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *DataSubjectRequestManager) deleteUser(ctx *actions.OperationContext, userId uint64) error {
+	s, err := m.userManager.GetStatusById(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("[manager.DataSubjectRequestManager.deleteUser] get a user's status by id: %w", err)
+	}
+
+	// the user could have been deleted before the erasure request was created
+	if s == usermodels.UserStatusDeleted {
+		return nil
+	}
+
+	if err = m.userManager.Delete(ctx, userId); err != nil {
+		return fmt.Errorf("[manager.DataSubjectRequestManager.deleteUser] delete a user: %w", err)
+	}
+	return nil
+}
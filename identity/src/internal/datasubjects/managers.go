@@ -0,0 +1,51 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasubjects
+
+import (
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// DataSubjectRequestManager is a manager of data subject requests (export and erasure of users' personal data).
+type DataSubjectRequestManager interface {
+	// CreateExport creates a request to export all personal data of the user
+	// and returns the request ID if the operation is successful.
+	CreateExport(ctx *actions.OperationContext, userId uint64) (uint64, error)
+
+	// CreateErasure creates a request to erase the personal data of the user
+	// and returns the request ID if the operation is successful.
+	CreateErasure(ctx *actions.OperationContext, userId uint64) (uint64, error)
+
+	// FindById finds and returns a data subject request, if any, by the specified request ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.DataSubjectRequest, error)
+
+	// GetAllByUserId gets all data subject requests by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.DataSubjectRequest, error)
+
+	// GetAllStepsByRequestId gets all steps of the data subject request by the specified request ID.
+	GetAllStepsByRequestId(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestStep, error)
+
+	// GetExportData gets the data (JSON archive) of the completed export request by the specified request ID.
+	GetExportData(ctx *actions.OperationContext, id uint64) ([]byte, error)
+
+	// GetAllIdsToProcess gets the IDs of the data subject requests that are new or in progress.
+	GetAllIdsToProcess(ctx *actions.OperationContext, limit int) ([]uint64, error)
+
+	// ProcessStep performs the step of the data subject request. The step is started within
+	// the current action and is completed or marked as failed depending on the result.
+	ProcessStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep) error
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "fmt"
+
+// The data subject request type.
+type DataSubjectRequestType uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	// Export of all personal data held about the user.
+	DataSubjectRequestTypeExport DataSubjectRequestType = 1
+
+	// Erasure (anonymisation and deletion) of the user's personal data.
+	DataSubjectRequestTypeErasure DataSubjectRequestType = 2
+)
+
+func (t DataSubjectRequestType) IsValid() bool {
+	return t == DataSubjectRequestTypeExport || t == DataSubjectRequestTypeErasure
+}
+
+func (t DataSubjectRequestType) String() string {
+	switch t {
+	case DataSubjectRequestTypeExport:
+		return "export"
+	case DataSubjectRequestTypeErasure:
+		return "erasure"
+	}
+	return fmt.Sprintf("DataSubjectRequestType(%d)", t)
+}
+
+// The data subject request status.
+type DataSubjectRequestStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	DataSubjectRequestStatusNew        DataSubjectRequestStatus = 1
+	DataSubjectRequestStatusInProgress DataSubjectRequestStatus = 2
+	DataSubjectRequestStatusCompleted  DataSubjectRequestStatus = 3
+	DataSubjectRequestStatusFailed     DataSubjectRequestStatus = 4
+)
+
+// The step of the data subject request.
+type DataSubjectRequestStep uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	// Export steps.
+	DataSubjectRequestStepExportUser              DataSubjectRequestStep = 1
+	DataSubjectRequestStepExportPersonalInfo      DataSubjectRequestStep = 2
+	DataSubjectRequestStepExportUserSessions      DataSubjectRequestStep = 3
+	DataSubjectRequestStepExportUserAgentSessions DataSubjectRequestStep = 4
+	DataSubjectRequestStepExportUserAgents        DataSubjectRequestStep = 5
+	DataSubjectRequestStepExportRoleAssignments   DataSubjectRequestStep = 6
+	DataSubjectRequestStepExportUserGroups        DataSubjectRequestStep = 7
+	DataSubjectRequestStepExportApiKeys           DataSubjectRequestStep = 8
+
+	// Erasure steps.
+	DataSubjectRequestStepRevokeSessions DataSubjectRequestStep = 101
+	DataSubjectRequestStepAnonymizeUser  DataSubjectRequestStep = 102
+	DataSubjectRequestStepDeleteUser     DataSubjectRequestStep = 103
+	DataSubjectRequestStepRevokeApiKeys  DataSubjectRequestStep = 104
+)
+
+// ExportSteps contains the steps of the export request in the order in which they are performed.
+var ExportSteps = []DataSubjectRequestStep{
+	DataSubjectRequestStepExportUser,
+	DataSubjectRequestStepExportPersonalInfo,
+	DataSubjectRequestStepExportUserSessions,
+	DataSubjectRequestStepExportUserAgentSessions,
+	DataSubjectRequestStepExportUserAgents,
+	DataSubjectRequestStepExportRoleAssignments,
+	DataSubjectRequestStepExportUserGroups,
+	DataSubjectRequestStepExportApiKeys,
+}
+
+// ErasureSteps contains the steps of the erasure request in the order in which they are performed.
+var ErasureSteps = []DataSubjectRequestStep{
+	DataSubjectRequestStepRevokeSessions,
+	DataSubjectRequestStepRevokeApiKeys,
+	DataSubjectRequestStepAnonymizeUser,
+	DataSubjectRequestStepDeleteUser,
+}
+
+func (s DataSubjectRequestStep) String() string {
+	switch s {
+	case DataSubjectRequestStepExportUser:
+		return "user"
+	case DataSubjectRequestStepExportPersonalInfo:
+		return "personalInfo"
+	case DataSubjectRequestStepExportUserSessions:
+		return "userSessions"
+	case DataSubjectRequestStepExportUserAgentSessions:
+		return "userAgentSessions"
+	case DataSubjectRequestStepExportUserAgents:
+		return "userAgents"
+	case DataSubjectRequestStepExportRoleAssignments:
+		return "roleAssignments"
+	case DataSubjectRequestStepExportUserGroups:
+		return "userGroups"
+	case DataSubjectRequestStepExportApiKeys:
+		return "apiKeys"
+	case DataSubjectRequestStepRevokeSessions:
+		return "revokeSessions"
+	case DataSubjectRequestStepAnonymizeUser:
+		return "anonymizeUser"
+	case DataSubjectRequestStepDeleteUser:
+		return "deleteUser"
+	case DataSubjectRequestStepRevokeApiKeys:
+		return "revokeApiKeys"
+	}
+	return fmt.Sprintf("DataSubjectRequestStep(%d)", s)
+}
+
+// The status of the data subject request step.
+type DataSubjectRequestStepStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	DataSubjectRequestStepStatusPending    DataSubjectRequestStepStatus = 1
+	DataSubjectRequestStepStatusInProgress DataSubjectRequestStepStatus = 2
+	DataSubjectRequestStepStatusCompleted  DataSubjectRequestStepStatus = 3
+	DataSubjectRequestStepStatusFailed     DataSubjectRequestStepStatus = 4
+)
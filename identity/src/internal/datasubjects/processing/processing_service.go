@@ -0,0 +1,319 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/datasubjects"
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	logginghelper "personal-website-v2/pkg/helper/logging"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type ProcessingServiceConfig struct {
+	// The interval between runs of the processing of data subject requests.
+	Interval time.Duration
+
+	// The maximum number of requests processed per run.
+	MaxRequestsPerRun int
+
+	// The user ID (app user) on behalf of which data subject requests are processed.
+	UserId uint64
+}
+
+// ProcessingService periodically processes new and unfinished data subject requests.
+// Each step of a request is performed in a separate action, the ID of which is saved
+// in the step, so that the completion of the request can be proven.
+type ProcessingService struct {
+	appSessionId   uint64
+	tranManager    *actions.TransactionManager
+	actionExecutor *actionhelper.ActionExecutor
+	requestManager datasubjects.DataSubjectRequestManager
+	config         *ProcessingServiceConfig
+	logger         logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx      *lcontext.LogEntryContext
+	isStarted      atomic.Bool
+	isStopped      bool
+	mu             sync.Mutex
+	done           chan struct{}
+	wg             sync.WaitGroup
+}
+
+func NewProcessingService(
+	appSessionId uint64,
+	tranManager *actions.TransactionManager,
+	actionManager *actions.ActionManager,
+	requestManager datasubjects.DataSubjectRequestManager,
+	config *ProcessingServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ProcessingService, error) {
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("[processing.NewProcessingService] invalid interval: %v", config.Interval)
+	}
+	if config.MaxRequestsPerRun <= 0 {
+		return nil, fmt.Errorf("[processing.NewProcessingService] invalid max number of requests per run: %d", config.MaxRequestsPerRun)
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.datasubjects.processing.ProcessingService")
+	if err != nil {
+		return nil, fmt.Errorf("[processing.NewProcessingService] create a logger: %w", err)
+	}
+
+	c := &actionhelper.ActionExecutorConfig{
+		ActionCategory:    actions.ActionCategoryCommon,
+		ActionGroup:       iactions.ActionGroupDataSubjectRequest,
+		OperationCategory: actions.OperationCategoryCommon,
+		OperationGroup:    iactions.OperationGroupDataSubjectRequest,
+		StopAppIfError:    true,
+	}
+	e, err := actionhelper.NewActionExecutor(appSessionId, actionManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[processing.NewProcessingService] new action executor: %w", err)
+	}
+
+	return &ProcessingService{
+		appSessionId:   appSessionId,
+		tranManager:    tranManager,
+		actionExecutor: e,
+		requestManager: requestManager,
+		config:         config,
+		logger:         l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *ProcessingService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the ProcessingService.
+func (s *ProcessingService) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[processing.ProcessingService.Start] ProcessingService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[processing.ProcessingService.Start] ProcessingService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, "[processing.ProcessingService.Start] starting the ProcessingService...")
+
+	s.wg.Add(1)
+	go s.run()
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, "[processing.ProcessingService.Start] ProcessingService has been started",
+		logging.NewField("interval", s.config.Interval),
+	)
+	return nil
+}
+
+// Stop stops the ProcessingService.
+func (s *ProcessingService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[processing.ProcessingService.Stop] ProcessingService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, "[processing.ProcessingService.Stop] stopping the ProcessingService...")
+	close(s.done)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, "[processing.ProcessingService.Stop] ProcessingService has been stopped")
+	return nil
+}
+
+func (s *ProcessingService) run() {
+	defer s.wg.Done()
+
+	t := time.NewTicker(s.config.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			s.processAll()
+		}
+	}
+}
+
+func (s *ProcessingService) processAll() {
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.DataSubjectRequestEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[processing.ProcessingService.processAll] panic: ", p.Value), p.StackTrace),
+			"[processing.ProcessingService.processAll] panic while processing data subject requests",
+		)
+	})
+
+	ids, err := s.getAllIdsToProcess()
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, err, "[processing.ProcessingService.processAll] get all request ids to process")
+		return
+	}
+
+	for _, id := range ids {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		s.process(id)
+	}
+}
+
+func (s *ProcessingService) getAllIdsToProcess() ([]uint64, error) {
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		return nil, fmt.Errorf("[processing.ProcessingService.getAllIdsToProcess] create and start a transaction: %w", err)
+	}
+
+	var ids []uint64
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeDataSubjectRequest_GetAllIdsToProcess, uuid.NullUUID{}, true,
+		iactions.OperationTypeDataSubjectRequestProcessingService_GetAllIdsToProcess, uuid.NullUUID{}, nil,
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			var err error
+			if ids, err = s.requestManager.GetAllIdsToProcess(ctx, s.config.MaxRequestsPerRun); err != nil {
+				return fmt.Errorf("[processing.ProcessingService.getAllIdsToProcess] get all request ids to process: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[processing.ProcessingService.getAllIdsToProcess] execute an action: %w", err)
+	}
+	return ids, nil
+}
+
+// process performs the unfinished steps of the request in order. It stops at the first failed step.
+func (s *ProcessingService) process(requestId uint64) {
+	steps, err := s.getAllSteps(requestId)
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, err, "[processing.ProcessingService.process] get all steps of the request",
+			logging.NewField("requestId", requestId),
+		)
+		return
+	}
+
+	for _, step := range steps {
+		if step.Status == models.DataSubjectRequestStepStatusCompleted {
+			continue
+		}
+		if step.Status == models.DataSubjectRequestStepStatusFailed {
+			return
+		}
+
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		// each step is performed in a separate action
+		if !s.processStep(requestId, step.Step) {
+			return
+		}
+	}
+}
+
+func (s *ProcessingService) getAllSteps(requestId uint64) ([]*dbmodels.DataSubjectRequestStep, error) {
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		return nil, fmt.Errorf("[processing.ProcessingService.getAllSteps] create and start a transaction: %w", err)
+	}
+
+	var steps []*dbmodels.DataSubjectRequestStep
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeDataSubjectRequest_GetAllSteps, uuid.NullUUID{}, true,
+		iactions.OperationTypeDataSubjectRequestProcessingService_GetAllStepsByRequestId, uuid.NullUUID{},
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId)},
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			var err error
+			if steps, err = s.requestManager.GetAllStepsByRequestId(ctx, requestId); err != nil {
+				return fmt.Errorf("[processing.ProcessingService.getAllSteps] get all steps by request id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[processing.ProcessingService.getAllSteps] execute an action: %w", err)
+	}
+	return steps, nil
+}
+
+// processStep performs the step of the request and returns true if the step has been completed.
+func (s *ProcessingService) processStep(requestId uint64, step models.DataSubjectRequestStep) bool {
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.DataSubjectRequestEvent, err, "[processing.ProcessingService.processStep] create and start a transaction",
+			logging.NewField("requestId", requestId),
+			logging.NewField("step", step),
+		)
+		return false
+	}
+
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeDataSubjectRequest_ProcessStep, uuid.NullUUID{}, true,
+		iactions.OperationTypeDataSubjectRequestProcessingService_ProcessStep, uuid.NullUUID{},
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId), actions.NewOperationParam("step", step)},
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			if err := s.requestManager.ProcessStep(ctx, requestId, step); err != nil {
+				return fmt.Errorf("[processing.ProcessingService.processStep] process a step: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		s.logger.ErrorWithEvent(logginghelper.CreateLogEntryContext(s.appSessionId, t, nil, nil), events.DataSubjectRequestEvent, err,
+			"[processing.ProcessingService.processStep] execute an action",
+			logging.NewField("requestId", requestId),
+			logging.NewField("step", step),
+		)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasubjects
+
+import (
+	"github.com/google/uuid"
+
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// DataSubjectRequestStore is a store of data subject requests (export and erasure of users' personal data).
+type DataSubjectRequestStore interface {
+	// Create creates a data subject request with the specified steps and returns the request ID
+	// if the operation is successful.
+	Create(ctx *actions.OperationContext, userId uint64, t models.DataSubjectRequestType, steps []models.DataSubjectRequestStep) (uint64, error)
+
+	// StartStep starts the step of the data subject request.
+	//	actionId - the ID of the action within which the step is performed.
+	StartStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, actionId uuid.UUID) error
+
+	// CompleteStep completes the step of the data subject request.
+	// If data isn't nil, then it is saved as the export section of the step.
+	// The request is completed when all its steps are completed.
+	CompleteStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, data []byte) error
+
+	// FailStep marks the step and the data subject request as failed.
+	FailStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, errMsg string) error
+
+	// FindById finds and returns a data subject request, if any, by the specified request ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.DataSubjectRequest, error)
+
+	// GetAllByUserId gets all data subject requests by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.DataSubjectRequest, error)
+
+	// GetAllStepsByRequestId gets all steps of the data subject request by the specified request ID.
+	// The steps are sorted in the order in which they are performed.
+	GetAllStepsByRequestId(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestStep, error)
+
+	// GetAllExportSections gets all export sections of the data subject request by the specified request ID.
+	GetAllExportSections(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestExportSection, error)
+
+	// GetAllIdsToProcess gets the IDs of the data subject requests that are new or in progress.
+	GetAllIdsToProcess(ctx *actions.OperationContext, limit int) ([]uint64, error)
+}
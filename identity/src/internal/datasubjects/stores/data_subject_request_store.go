@@ -0,0 +1,381 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/datasubjects"
+	"personal-website-v2/identity/src/internal/datasubjects/dbmodels"
+	"personal-website-v2/identity/src/internal/datasubjects/models"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	dataSubjectRequestsTable              = "public.data_subject_requests"
+	dataSubjectRequestStepsTable          = "public.data_subject_request_steps"
+	dataSubjectRequestExportSectionsTable = "public.data_subject_request_export_sections"
+)
+
+// DataSubjectRequestStore is a store of data subject requests.
+type DataSubjectRequestStore struct {
+	db           *postgres.Database
+	opExecutor   *actionhelper.OperationExecutor
+	store        *postgres.Store[dbmodels.DataSubjectRequest]
+	stepStore    *postgres.Store[dbmodels.DataSubjectRequestStep]
+	sectionStore *postgres.Store[dbmodels.DataSubjectRequestExportSection]
+	txManager    *postgres.TxManager
+	logger       logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ datasubjects.DataSubjectRequestStore = (*DataSubjectRequestStore)(nil)
+
+func NewDataSubjectRequestStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*DataSubjectRequestStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.datasubjects.stores.DataSubjectRequestStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewDataSubjectRequestStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupDataSubjectRequest,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewDataSubjectRequestStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewDataSubjectRequestStore] new TxManager: %w", err)
+	}
+
+	return &DataSubjectRequestStore{
+		db:           db,
+		opExecutor:   e,
+		store:        postgres.NewStore[dbmodels.DataSubjectRequest](db),
+		stepStore:    postgres.NewStore[dbmodels.DataSubjectRequestStep](db),
+		sectionStore: postgres.NewStore[dbmodels.DataSubjectRequestExportSection](db),
+		txManager:    txm,
+		logger:       l,
+	}, nil
+}
+
+// Create creates a data subject request with the specified steps and returns the request ID
+// if the operation is successful.
+func (s *DataSubjectRequestStore) Create(ctx *actions.OperationContext, userId uint64, t models.DataSubjectRequestType, steps []models.DataSubjectRequestStep) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_Create,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId),
+			actions.NewOperationParam("type", t),
+			actions.NewOperationParam("steps", steps),
+		},
+		func(opCtx *actions.OperationContext) error {
+			// smallint[]
+			steps2 := make([]int16, len(steps))
+			for i := 0; i < len(steps); i++ {
+				steps2[i] = int16(steps[i])
+			}
+
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_data_subject_request(IN _user_id, IN _type, IN _steps, IN _created_by, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_data_subject_request($1, $2, $3, $4, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, t, steps2, opCtx.UserId.Ptr()).Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.DataSubjectRequestStore.Create] execute a query (create_data_subject_request): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				case idberrors.DbErrorCodeDataSubjectRequestAlreadyExists:
+					return ierrors.ErrDataSubjectRequestAlreadyExists
+				}
+				// unknown error
+				return fmt.Errorf("[stores.DataSubjectRequestStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.DataSubjectRequestStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// StartStep starts the step of the data subject request.
+//
+//	actionId - the ID of the action within which the step is performed.
+func (s *DataSubjectRequestStore) StartStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, actionId uuid.UUID) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_StartStep,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("requestId", requestId),
+			actions.NewOperationParam("step", step),
+			actions.NewOperationParam("actionId", actionId),
+		},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.start_data_subject_request_step(IN _request_id, IN _step, IN _action_id, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.start_data_subject_request_step($1, $2, $3, $4, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, requestId, step, actionId, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.DataSubjectRequestStore.StartStep] execute a query (start_data_subject_request_step): %w", err)
+				}
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeDataSubjectRequestNotFound:
+					return ierrors.ErrDataSubjectRequestNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.DataSubjectRequestStore.StartStep] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.StartStep] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.DataSubjectRequestStore.StartStep] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// CompleteStep completes the step of the data subject request.
+// If data isn't nil, then it is saved as the export section of the step.
+// The request is completed when all its steps are completed.
+func (s *DataSubjectRequestStore) CompleteStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, data []byte) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_CompleteStep,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("requestId", requestId),
+			actions.NewOperationParam("step", step),
+			actions.NewOperationParam("dataSize", len(data)),
+		},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.complete_data_subject_request_step(IN _request_id, IN _step, IN _data, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.complete_data_subject_request_step($1, $2, $3, $4, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, requestId, step, data, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.DataSubjectRequestStore.CompleteStep] execute a query (complete_data_subject_request_step): %w", err)
+				}
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeDataSubjectRequestNotFound:
+					return ierrors.ErrDataSubjectRequestNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.DataSubjectRequestStore.CompleteStep] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.CompleteStep] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.DataSubjectRequestStore.CompleteStep] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FailStep marks the step and the data subject request as failed.
+func (s *DataSubjectRequestStore) FailStep(ctx *actions.OperationContext, requestId uint64, step models.DataSubjectRequestStep, errMsg string) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_FailStep,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("requestId", requestId),
+			actions.NewOperationParam("step", step),
+			actions.NewOperationParam("errMsg", errMsg),
+		},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg2 string
+				// PROCEDURE: public.fail_data_subject_request_step(IN _request_id, IN _step, IN _error_message, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.fail_data_subject_request_step($1, $2, $3, $4, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, requestId, step, errMsg, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg2); err != nil {
+					return fmt.Errorf("[stores.DataSubjectRequestStore.FailStep] execute a query (fail_data_subject_request_step): %w", err)
+				}
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg2)
+				case idberrors.DbErrorCodeDataSubjectRequestNotFound:
+					return ierrors.ErrDataSubjectRequestNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.DataSubjectRequestStore.FailStep] invalid operation: %w", dberrors.NewDbError(errCode, errMsg2))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.FailStep] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.DataSubjectRequestStore.FailStep] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindById finds and returns a data subject request, if any, by the specified request ID.
+func (s *DataSubjectRequestStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.DataSubjectRequest, error) {
+	var r *dbmodels.DataSubjectRequest
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + dataSubjectRequestsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if r, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.FindById] find a request by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.DataSubjectRequestStore.FindById] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// GetAllByUserId gets all data subject requests by the specified user ID.
+func (s *DataSubjectRequestStore) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*dbmodels.DataSubjectRequest, error) {
+	var rs []*dbmodels.DataSubjectRequest
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_GetAllByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + dataSubjectRequestsTable + " WHERE user_id = $1 ORDER BY id"
+			var err error
+			if rs, err = s.store.FindAll(opCtx.Ctx, query, userId); err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllByUserId] find all requests by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.DataSubjectRequestStore.GetAllByUserId] execute an operation: %w", err)
+	}
+	return rs, nil
+}
+
+// GetAllStepsByRequestId gets all steps of the data subject request by the specified request ID.
+// The steps are sorted in the order in which they are performed.
+func (s *DataSubjectRequestStore) GetAllStepsByRequestId(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestStep, error) {
+	var steps []*dbmodels.DataSubjectRequestStep
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_GetAllStepsByRequestId,
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + dataSubjectRequestStepsTable + " WHERE request_id = $1 ORDER BY seq_num"
+			var err error
+			if steps, err = s.stepStore.FindAll(opCtx.Ctx, query, requestId); err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllStepsByRequestId] find all steps by request id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.DataSubjectRequestStore.GetAllStepsByRequestId] execute an operation: %w", err)
+	}
+	return steps, nil
+}
+
+// GetAllExportSections gets all export sections of the data subject request by the specified request ID.
+func (s *DataSubjectRequestStore) GetAllExportSections(ctx *actions.OperationContext, requestId uint64) ([]*dbmodels.DataSubjectRequestExportSection, error) {
+	var ss []*dbmodels.DataSubjectRequestExportSection
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_GetAllExportSections,
+		[]*actions.OperationParam{actions.NewOperationParam("requestId", requestId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + dataSubjectRequestExportSectionsTable + " WHERE request_id = $1 ORDER BY step"
+			var err error
+			if ss, err = s.sectionStore.FindAll(opCtx.Ctx, query, requestId); err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllExportSections] find all export sections by request id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.DataSubjectRequestStore.GetAllExportSections] execute an operation: %w", err)
+	}
+	return ss, nil
+}
+
+// GetAllIdsToProcess gets the IDs of the data subject requests that are new or in progress.
+func (s *DataSubjectRequestStore) GetAllIdsToProcess(ctx *actions.OperationContext, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeDataSubjectRequestStore_GetAllIdsToProcess, []*actions.OperationParam{actions.NewOperationParam("limit", limit)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllIdsToProcess] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT id FROM " + dataSubjectRequestsTable + " WHERE status IN ($1, $2) ORDER BY id LIMIT $3"
+
+			rows, err := conn.Query(opCtx.Ctx, query, models.DataSubjectRequestStatusNew, models.DataSubjectRequestStatusInProgress, limit)
+			if err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllIdsToProcess] execute a query: %w", err)
+			}
+			defer rows.Close()
+
+			if ids, err = pgx.CollectRows(rows, pgx.RowTo[uint64]); err != nil {
+				return fmt.Errorf("[stores.DataSubjectRequestStore.GetAllIdsToProcess] collect rows: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.DataSubjectRequestStore.GetAllIdsToProcess] execute an operation: %w", err)
+	}
+	return ids, nil
+}
@@ -32,6 +32,16 @@ const (
 	DbErrorCodeInvalidClientId errors.DbErrorCode = 11201
 
 	// User group error codes (11400-11599).
+	DbErrorCodeUserGroupNotFound errors.DbErrorCode = 11400
+
+	// The user group with the same name already exists.
+	DbErrorCodeUserGroupAlreadyExists errors.DbErrorCode = 11401
+
+	// The user isn't a member of the group.
+	DbErrorCodeUserGroupMemberNotFound errors.DbErrorCode = 11402
+
+	// The user is already a member of the group.
+	DbErrorCodeUserGroupMemberAlreadyExists errors.DbErrorCode = 11403
 
 	// Role error codes (11600-11799).
 	DbErrorCodeRoleNotFound errors.DbErrorCode = 11600
@@ -91,4 +101,53 @@ const (
 
 	// Role already assigned (to the user or group).
 	DbErrorCodeRoleAlreadyAssigned errors.DbErrorCode = 13402
+
+	// User credential error codes (15000-15199).
+	DbErrorCodeUserCredentialNotFound errors.DbErrorCode = 15000
+
+	// MFA error codes (15400-15599).
+	DbErrorCodeUserTotpNotFound errors.DbErrorCode = 15400
+
+	// Registration error codes (15800-15999).
+	// Email verification token not found or expired.
+	DbErrorCodeEmailVerificationTokenNotFound errors.DbErrorCode = 15800
+
+	// Role inheritance error codes (16000-16199).
+	// The role already inherits from the parent role.
+	DbErrorCodeRoleParentAlreadyAdded errors.DbErrorCode = 16000
+
+	// The role doesn't inherit from the parent role.
+	DbErrorCodeRoleParentNotFound errors.DbErrorCode = 16001
+
+	// Adding the parent role would create a cycle in the role hierarchy.
+	DbErrorCodeRoleInheritanceCycle errors.DbErrorCode = 16002
+
+	// Adding the parent role would exceed the maximum depth of the role hierarchy.
+	DbErrorCodeRoleInheritanceDepthExceeded errors.DbErrorCode = 16003
+
+	// Resource error codes (16200-16399).
+	DbErrorCodeResourceTypeNotFound errors.DbErrorCode = 16200
+
+	// Resource role assignment not found.
+	DbErrorCodeResourceRoleAssignmentNotFound errors.DbErrorCode = 16201
+
+	// Resource role assignment already exists.
+	DbErrorCodeResourceRoleAssignmentAlreadyExists errors.DbErrorCode = 16202
+
+	// Impersonation error codes (16400-16599).
+	DbErrorCodeImpersonationNotAllowed errors.DbErrorCode = 16400
+
+	// Impersonation token not found.
+	DbErrorCodeImpersonationTokenNotFound errors.DbErrorCode = 16401
+
+	// Data subject request error codes (16600-16799).
+	// An unfinished data subject request of the same type already exists.
+	DbErrorCodeDataSubjectRequestAlreadyExists errors.DbErrorCode = 16600
+
+	// Data subject request not found.
+	DbErrorCodeDataSubjectRequestNotFound errors.DbErrorCode = 16601
+
+	// API key error codes (16800-16999).
+	// API key not found.
+	DbErrorCodeApiKeyNotFound errors.DbErrorCode = 16800
 )
@@ -18,10 +18,20 @@ import (
 	"errors"
 	"fmt"
 
+	apikeystores "personal-website-v2/identity/src/internal/apikeys/stores"
 	authenticationstores "personal-website-v2/identity/src/internal/authentication/stores"
 	clientmodels "personal-website-v2/identity/src/internal/clients/models"
 	clientstores "personal-website-v2/identity/src/internal/clients/stores"
+	credentialstores "personal-website-v2/identity/src/internal/credentials/stores"
+	datasubjectstores "personal-website-v2/identity/src/internal/datasubjects/stores"
+	groupstores "personal-website-v2/identity/src/internal/groups/stores"
+	impersonationstores "personal-website-v2/identity/src/internal/impersonation/stores"
+	lockoutstores "personal-website-v2/identity/src/internal/lockouts/stores"
+	mfastores "personal-website-v2/identity/src/internal/mfa/stores"
+	oidcstores "personal-website-v2/identity/src/internal/oidc/stores"
 	permissionstores "personal-website-v2/identity/src/internal/permissions/stores"
+	registrationstores "personal-website-v2/identity/src/internal/registration/stores"
+	resourcestores "personal-website-v2/identity/src/internal/resources/stores"
 	rolestores "personal-website-v2/identity/src/internal/roles/stores"
 	sessionmodels "personal-website-v2/identity/src/internal/sessions/models"
 	sessionstores "personal-website-v2/identity/src/internal/sessions/stores"
@@ -36,31 +46,36 @@ import (
 const (
 	// identityCategory = "Identity"
 
-	// UserStore, UserPersonalInfoStore, UserRoleAssignmentStore.
+	// UserStore, UserPersonalInfoStore, UserRoleAssignmentStore, UserCredentialStore, UserLockoutStore,
+	// UserTotpStore, MfaChallengeStore, OidcAuthorizationCodeStore, OidcRefreshTokenStore, RegistrationStore,
+	// ImpersonationTokenStore, DataSubjectRequestStore, ApiKeyStore.
 	userCategory = "User"
 
-	// WebClientStore.
+	// WebClientStore, WebClientLockoutStore.
 	webClientCategory = "WebClient"
 
-	// MobileClientStore.
+	// MobileClientStore, MobileClientLockoutStore.
 	mobileClientCategory = "MobileClient"
 
-	// GroupRoleAssignmentStore.
+	// ServiceClientStore, ClientSecretStore, ServiceClientTokenStore, ClientRoleAssignmentStore.
+	serviceClientCategory = "ServiceClient"
+
+	// UserGroupStore, UserGroupMemberStore, GroupRoleAssignmentStore.
 	userGroupCategory = "UserGroup"
 
 	// RoleStore, RolesStateStore.
 	roleCategory = "Role"
 
-	// RoleAssignmentStore.
+	// RoleAssignmentStore, ResourceRoleAssignmentStore.
 	roleAssignmentCategory = "RoleAssignment"
 
-	// PermissionStore, PermissionGroupStore, RolePermissionStore.
+	// PermissionStore, PermissionGroupStore, RolePermissionStore, ResourceTypeStore.
 	permissionCategory = "Permission"
 
-	// WebUserAgentStore, UserAgentWebSessionStore.
+	// WebUserAgentStore, UserAgentWebSessionStore, WebUserAgentLockoutStore.
 	webUserAgentCategory = "WebUserAgent"
 
-	// MobileUserAgentStore, UserAgentMobileSessionStore.
+	// MobileUserAgentStore, UserAgentMobileSessionStore, MobileUserAgentLockoutStore.
 	mobileUserAgentCategory = "MobileUserAgent"
 
 	// UserWebSessionStore.
@@ -76,16 +91,26 @@ const (
 type Stores interface {
 	UserStore() *userstores.UserStore
 	UserPersonalInfoStore() *userstores.UserPersonalInfoStore
+	UserCredentialStore() *credentialstores.UserCredentialStore
 	WebClientStore() *clientstores.ClientStore
 	MobileClientStore() *clientstores.ClientStore
+	ServiceClientStore() *clientstores.ClientStore
+	ClientSecretStore() *clientstores.ClientSecretStore
+	ServiceClientTokenStore() *clientstores.ServiceClientTokenStore
 	RoleStore() *rolestores.RoleStore
 	RoleAssignmentStore() *rolestores.RoleAssignmentStore
 	UserRoleAssignmentStore() *rolestores.UserRoleAssignmentStore
 	GroupRoleAssignmentStore() *rolestores.GroupRoleAssignmentStore
+	ClientRoleAssignmentStore() *rolestores.ClientRoleAssignmentStore
 	RolesStateStore() *rolestores.RolesStateStore
+	RoleInheritanceStore() *rolestores.RoleInheritanceStore
+	UserGroupStore() *groupstores.UserGroupStore
+	UserGroupMemberStore() *groupstores.UserGroupMemberStore
 	PermissionStore() *permissionstores.PermissionStore
 	PermissionGroupStore() *permissionstores.PermissionGroupStore
 	RolePermissionStore() *permissionstores.RolePermissionStore
+	ResourceTypeStore() *resourcestores.ResourceTypeStore
+	ResourceRoleAssignmentStore() *resourcestores.ResourceRoleAssignmentStore
 	WebUserAgentStore() *useragentstores.UserAgentStore
 	MobileUserAgentStore() *useragentstores.UserAgentStore
 	UserWebSessionStore() *sessionstores.UserSessionStore
@@ -93,6 +118,19 @@ type Stores interface {
 	UserAgentWebSessionStore() *sessionstores.UserAgentSessionStore
 	UserAgentMobileSessionStore() *sessionstores.UserAgentSessionStore
 	TokenEncryptionKeyStore() *authenticationstores.TokenEncryptionKeyStore
+	UserLockoutStore() *lockoutstores.LockoutStore
+	WebClientLockoutStore() *lockoutstores.LockoutStore
+	MobileClientLockoutStore() *lockoutstores.LockoutStore
+	WebUserAgentLockoutStore() *lockoutstores.LockoutStore
+	MobileUserAgentLockoutStore() *lockoutstores.LockoutStore
+	UserTotpStore() *mfastores.UserTotpStore
+	MfaChallengeStore() *mfastores.MfaChallengeStore
+	OidcAuthorizationCodeStore() *oidcstores.AuthorizationCodeStore
+	OidcRefreshTokenStore() *oidcstores.RefreshTokenStore
+	RegistrationStore() *registrationstores.RegistrationStore
+	ImpersonationTokenStore() *impersonationstores.ImpersonationTokenStore
+	DataSubjectRequestStore() *datasubjectstores.DataSubjectRequestStore
+	ApiKeyStore() *apikeystores.ApiKeyStore
 	Init(databases map[string]*postgres.Database) error
 }
 
@@ -101,16 +139,26 @@ var _ postgres.Stores = (Stores)(nil)
 type stores struct {
 	userStore                   *userstores.UserStore
 	userPersonalInfoStore       *userstores.UserPersonalInfoStore
+	userCredentialStore         *credentialstores.UserCredentialStore
 	webClientStore              *clientstores.ClientStore
 	mobileClientStore           *clientstores.ClientStore
+	serviceClientStore          *clientstores.ClientStore
+	clientSecretStore           *clientstores.ClientSecretStore
+	serviceClientTokenStore     *clientstores.ServiceClientTokenStore
 	roleStore                   *rolestores.RoleStore
 	roleAssignmentStore         *rolestores.RoleAssignmentStore
 	userRoleAssignmentStore     *rolestores.UserRoleAssignmentStore
 	groupRoleAssignmentStore    *rolestores.GroupRoleAssignmentStore
+	clientRoleAssignmentStore   *rolestores.ClientRoleAssignmentStore
 	rolesStateStore             *rolestores.RolesStateStore
+	roleInheritanceStore        *rolestores.RoleInheritanceStore
+	userGroupStore              *groupstores.UserGroupStore
+	userGroupMemberStore        *groupstores.UserGroupMemberStore
 	permissionStore             *permissionstores.PermissionStore
 	permissionGroupStore        *permissionstores.PermissionGroupStore
 	rolePermissionStore         *permissionstores.RolePermissionStore
+	resourceTypeStore           *resourcestores.ResourceTypeStore
+	resourceRoleAssignmentStore *resourcestores.ResourceRoleAssignmentStore
 	webUserAgentStore           *useragentstores.UserAgentStore
 	mobileUserAgentStore        *useragentstores.UserAgentStore
 	userWebSessionStore         *sessionstores.UserSessionStore
@@ -118,6 +166,19 @@ type stores struct {
 	userAgentWebSessionStore    *sessionstores.UserAgentSessionStore
 	userAgentMobileSessionStore *sessionstores.UserAgentSessionStore
 	tokenEncryptionKeyStore     *authenticationstores.TokenEncryptionKeyStore
+	userLockoutStore            *lockoutstores.LockoutStore
+	webClientLockoutStore       *lockoutstores.LockoutStore
+	mobileClientLockoutStore    *lockoutstores.LockoutStore
+	webUserAgentLockoutStore    *lockoutstores.LockoutStore
+	mobileUserAgentLockoutStore *lockoutstores.LockoutStore
+	userTotpStore               *mfastores.UserTotpStore
+	mfaChallengeStore           *mfastores.MfaChallengeStore
+	oidcAuthorizationCodeStore  *oidcstores.AuthorizationCodeStore
+	oidcRefreshTokenStore       *oidcstores.RefreshTokenStore
+	registrationStore           *registrationstores.RegistrationStore
+	impersonationTokenStore     *impersonationstores.ImpersonationTokenStore
+	dataSubjectRequestStore     *datasubjectstores.DataSubjectRequestStore
+	apiKeyStore                 *apikeystores.ApiKeyStore
 	loggerFactory               logging.LoggerFactory[*context.LogEntryContext]
 	isInitialized               bool
 }
@@ -138,6 +199,10 @@ func (s *stores) UserPersonalInfoStore() *userstores.UserPersonalInfoStore {
 	return s.userPersonalInfoStore
 }
 
+func (s *stores) UserCredentialStore() *credentialstores.UserCredentialStore {
+	return s.userCredentialStore
+}
+
 func (s *stores) WebClientStore() *clientstores.ClientStore {
 	return s.webClientStore
 }
@@ -146,6 +211,18 @@ func (s *stores) MobileClientStore() *clientstores.ClientStore {
 	return s.mobileClientStore
 }
 
+func (s *stores) ServiceClientStore() *clientstores.ClientStore {
+	return s.serviceClientStore
+}
+
+func (s *stores) ClientSecretStore() *clientstores.ClientSecretStore {
+	return s.clientSecretStore
+}
+
+func (s *stores) ServiceClientTokenStore() *clientstores.ServiceClientTokenStore {
+	return s.serviceClientTokenStore
+}
+
 func (s *stores) RoleStore() *rolestores.RoleStore {
 	return s.roleStore
 }
@@ -162,10 +239,26 @@ func (s *stores) GroupRoleAssignmentStore() *rolestores.GroupRoleAssignmentStore
 	return s.groupRoleAssignmentStore
 }
 
+func (s *stores) ClientRoleAssignmentStore() *rolestores.ClientRoleAssignmentStore {
+	return s.clientRoleAssignmentStore
+}
+
 func (s *stores) RolesStateStore() *rolestores.RolesStateStore {
 	return s.rolesStateStore
 }
 
+func (s *stores) RoleInheritanceStore() *rolestores.RoleInheritanceStore {
+	return s.roleInheritanceStore
+}
+
+func (s *stores) UserGroupStore() *groupstores.UserGroupStore {
+	return s.userGroupStore
+}
+
+func (s *stores) UserGroupMemberStore() *groupstores.UserGroupMemberStore {
+	return s.userGroupMemberStore
+}
+
 func (s *stores) PermissionStore() *permissionstores.PermissionStore {
 	return s.permissionStore
 }
@@ -178,6 +271,14 @@ func (s *stores) RolePermissionStore() *permissionstores.RolePermissionStore {
 	return s.rolePermissionStore
 }
 
+func (s *stores) ResourceTypeStore() *resourcestores.ResourceTypeStore {
+	return s.resourceTypeStore
+}
+
+func (s *stores) ResourceRoleAssignmentStore() *resourcestores.ResourceRoleAssignmentStore {
+	return s.resourceRoleAssignmentStore
+}
+
 func (s *stores) WebUserAgentStore() *useragentstores.UserAgentStore {
 	return s.webUserAgentStore
 }
@@ -206,6 +307,58 @@ func (s *stores) TokenEncryptionKeyStore() *authenticationstores.TokenEncryption
 	return s.tokenEncryptionKeyStore
 }
 
+func (s *stores) UserLockoutStore() *lockoutstores.LockoutStore {
+	return s.userLockoutStore
+}
+
+func (s *stores) WebClientLockoutStore() *lockoutstores.LockoutStore {
+	return s.webClientLockoutStore
+}
+
+func (s *stores) MobileClientLockoutStore() *lockoutstores.LockoutStore {
+	return s.mobileClientLockoutStore
+}
+
+func (s *stores) WebUserAgentLockoutStore() *lockoutstores.LockoutStore {
+	return s.webUserAgentLockoutStore
+}
+
+func (s *stores) MobileUserAgentLockoutStore() *lockoutstores.LockoutStore {
+	return s.mobileUserAgentLockoutStore
+}
+
+func (s *stores) UserTotpStore() *mfastores.UserTotpStore {
+	return s.userTotpStore
+}
+
+func (s *stores) MfaChallengeStore() *mfastores.MfaChallengeStore {
+	return s.mfaChallengeStore
+}
+
+func (s *stores) OidcAuthorizationCodeStore() *oidcstores.AuthorizationCodeStore {
+	return s.oidcAuthorizationCodeStore
+}
+
+func (s *stores) OidcRefreshTokenStore() *oidcstores.RefreshTokenStore {
+	return s.oidcRefreshTokenStore
+}
+
+func (s *stores) RegistrationStore() *registrationstores.RegistrationStore {
+	return s.registrationStore
+}
+
+func (s *stores) ImpersonationTokenStore() *impersonationstores.ImpersonationTokenStore {
+	return s.impersonationTokenStore
+}
+
+func (s *stores) DataSubjectRequestStore() *datasubjectstores.DataSubjectRequestStore {
+	return s.dataSubjectRequestStore
+}
+
+func (s *stores) ApiKeyStore() *apikeystores.ApiKeyStore {
+	return s.apiKeyStore
+}
+
 // databases: map[DataCategory]Database
 func (s *stores) Init(databases map[string]*postgres.Database) error {
 	if s.isInitialized {
@@ -232,6 +385,56 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new user role assignment store: %w", err)
 	}
 
+	userCredentialStore, err := credentialstores.NewUserCredentialStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new user credential store: %w", err)
+	}
+
+	userLockoutStore, err := lockoutstores.NewUserLockoutStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new user lockout store: %w", err)
+	}
+
+	userTotpStore, err := mfastores.NewUserTotpStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new user TOTP store: %w", err)
+	}
+
+	mfaChallengeStore, err := mfastores.NewMfaChallengeStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new MFA challenge store: %w", err)
+	}
+
+	oidcAuthorizationCodeStore, err := oidcstores.NewAuthorizationCodeStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new OIDC authorization code store: %w", err)
+	}
+
+	oidcRefreshTokenStore, err := oidcstores.NewRefreshTokenStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new OIDC refresh token store: %w", err)
+	}
+
+	registrationStore, err := registrationstores.NewRegistrationStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new registration store: %w", err)
+	}
+
+	impersonationTokenStore, err := impersonationstores.NewImpersonationTokenStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new impersonation token store: %w", err)
+	}
+
+	dataSubjectRequestStore, err := datasubjectstores.NewDataSubjectRequestStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new data subject request store: %w", err)
+	}
+
+	apiKeyStore, err := apikeystores.NewApiKeyStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new API key store: %w", err)
+	}
+
 	database, ok = databases[webClientCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", webClientCategory)
@@ -242,6 +445,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new web client store: %w", err)
 	}
 
+	webClientLockoutStore, err := lockoutstores.NewClientLockoutStore(clientmodels.ClientTypeWeb, database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new web client lockout store: %w", err)
+	}
+
 	database, ok = databases[mobileClientCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", mobileClientCategory)
@@ -252,6 +460,36 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new mobile client store: %w", err)
 	}
 
+	mobileClientLockoutStore, err := lockoutstores.NewClientLockoutStore(clientmodels.ClientTypeMobile, database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new mobile client lockout store: %w", err)
+	}
+
+	database, ok = databases[serviceClientCategory]
+	if !ok {
+		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", serviceClientCategory)
+	}
+
+	serviceClientStore, err := clientstores.NewClientStore(clientmodels.ClientTypeService, database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new service client store: %w", err)
+	}
+
+	clientSecretStore, err := clientstores.NewClientSecretStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new client secret store: %w", err)
+	}
+
+	serviceClientTokenStore, err := clientstores.NewServiceClientTokenStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new service client token store: %w", err)
+	}
+
+	clientRoleAssignmentStore, err := rolestores.NewClientRoleAssignmentStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new client role assignment store: %w", err)
+	}
+
 	database, ok = databases[userGroupCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", userGroupCategory)
@@ -262,6 +500,16 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new group role assignment store: %w", err)
 	}
 
+	userGroupStore, err := groupstores.NewUserGroupStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new user group store: %w", err)
+	}
+
+	userGroupMemberStore, err := groupstores.NewUserGroupMemberStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new user group member store: %w", err)
+	}
+
 	database, ok = databases[roleCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", roleCategory)
@@ -277,6 +525,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new store of the state of roles: %w", err)
 	}
 
+	roleInheritanceStore, err := rolestores.NewRoleInheritanceStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new role inheritance store: %w", err)
+	}
+
 	database, ok = databases[roleAssignmentCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", roleAssignmentCategory)
@@ -287,6 +540,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new role assignment store: %w", err)
 	}
 
+	resourceRoleAssignmentStore, err := resourcestores.NewResourceRoleAssignmentStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new resource role assignment store: %w", err)
+	}
+
 	database, ok = databases[permissionCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", permissionCategory)
@@ -307,6 +565,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new role permission store: %w", err)
 	}
 
+	resourceTypeStore, err := resourcestores.NewResourceTypeStore(database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new resource type store: %w", err)
+	}
+
 	database, ok = databases[webUserAgentCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", webUserAgentCategory)
@@ -322,6 +585,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new store of web sessions of user agents: %w", err)
 	}
 
+	webUserAgentLockoutStore, err := lockoutstores.NewUserAgentLockoutStore(useragentmodels.UserAgentTypeWeb, database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new web user agent lockout store: %w", err)
+	}
+
 	database, ok = databases[mobileUserAgentCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", mobileUserAgentCategory)
@@ -337,6 +605,11 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 		return fmt.Errorf("[postgres.stores.Init] new store of mobile sessions of user agents: %w", err)
 	}
 
+	mobileUserAgentLockoutStore, err := lockoutstores.NewUserAgentLockoutStore(useragentmodels.UserAgentTypeMobile, database, s.loggerFactory)
+	if err != nil {
+		return fmt.Errorf("[postgres.stores.Init] new mobile user agent lockout store: %w", err)
+	}
+
 	database, ok = databases[userWebSessionCategory]
 	if !ok {
 		return fmt.Errorf("[postgres.stores.Init] database not found for the category '%s'", userWebSessionCategory)
@@ -369,16 +642,26 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 
 	s.userStore = userStore
 	s.userPersonalInfoStore = userPersonalInfoStore
+	s.userCredentialStore = userCredentialStore
 	s.webClientStore = webClientStore
 	s.mobileClientStore = mobileClientStore
+	s.serviceClientStore = serviceClientStore
+	s.clientSecretStore = clientSecretStore
+	s.serviceClientTokenStore = serviceClientTokenStore
 	s.roleStore = roleStore
 	s.roleAssignmentStore = roleAssignmentStore
 	s.userRoleAssignmentStore = userRoleAssignmentStore
 	s.groupRoleAssignmentStore = groupRoleAssignmentStore
+	s.clientRoleAssignmentStore = clientRoleAssignmentStore
 	s.rolesStateStore = rolesStateStore
+	s.roleInheritanceStore = roleInheritanceStore
+	s.userGroupStore = userGroupStore
+	s.userGroupMemberStore = userGroupMemberStore
 	s.permissionStore = permissionStore
 	s.permissionGroupStore = permissionGroupStore
 	s.rolePermissionStore = rolePermissionStore
+	s.resourceTypeStore = resourceTypeStore
+	s.resourceRoleAssignmentStore = resourceRoleAssignmentStore
 	s.webUserAgentStore = webUserAgentStore
 	s.mobileUserAgentStore = mobileUserAgentStore
 	s.userWebSessionStore = userWebSessionStore
@@ -386,6 +669,19 @@ func (s *stores) Init(databases map[string]*postgres.Database) error {
 	s.userAgentWebSessionStore = userAgentWebSessionStore
 	s.userAgentMobileSessionStore = userAgentMobileSessionStore
 	s.tokenEncryptionKeyStore = tokenEncryptionKeyStore
+	s.userLockoutStore = userLockoutStore
+	s.webClientLockoutStore = webClientLockoutStore
+	s.mobileClientLockoutStore = mobileClientLockoutStore
+	s.webUserAgentLockoutStore = webUserAgentLockoutStore
+	s.mobileUserAgentLockoutStore = mobileUserAgentLockoutStore
+	s.userTotpStore = userTotpStore
+	s.mfaChallengeStore = mfaChallengeStore
+	s.oidcAuthorizationCodeStore = oidcAuthorizationCodeStore
+	s.oidcRefreshTokenStore = oidcRefreshTokenStore
+	s.registrationStore = registrationStore
+	s.impersonationTokenStore = impersonationTokenStore
+	s.dataSubjectRequestStore = dataSubjectRequestStore
+	s.apiKeyStore = apiKeyStore
 	s.isInitialized = true
 	return nil
 }
@@ -33,6 +33,16 @@ const (
 	ErrorCodeInvalidClientId errors.ErrorCode = 31201
 
 	// User group error codes (31400-31599).
+	ErrorCodeUserGroupNotFound errors.ErrorCode = 31400
+
+	// The user group with the same name already exists.
+	ErrorCodeUserGroupAlreadyExists errors.ErrorCode = 31401
+
+	// The user isn't a member of the group.
+	ErrorCodeUserGroupMemberNotFound errors.ErrorCode = 31402
+
+	// The user is already a member of the group.
+	ErrorCodeUserGroupMemberAlreadyExists errors.ErrorCode = 31403
 
 	// Role error codes (31600-31799).
 	ErrorCodeRoleNotFound      errors.ErrorCode = 31600
@@ -90,6 +100,96 @@ const (
 
 	// Role already assigned (to the user or group).
 	ErrorCodeRoleAlreadyAssigned errors.ErrorCode = 33402
+
+	// User credential error codes (35000-35199).
+	ErrorCodeUserCredentialNotFound errors.ErrorCode = 35000
+
+	// Invalid user name, email, password or client secret.
+	ErrorCodeInvalidCredentials errors.ErrorCode = 35001
+
+	// Lockout error codes (35200-35399).
+	ErrorCodeUserLockedOut      errors.ErrorCode = 35200
+	ErrorCodeClientLockedOut    errors.ErrorCode = 35201
+	ErrorCodeUserAgentLockedOut errors.ErrorCode = 35202
+
+	// MFA error codes (35400-35599).
+	ErrorCodeUserTotpNotFound errors.ErrorCode = 35400
+
+	// Invalid TOTP code or recovery code.
+	ErrorCodeInvalidMfaCode errors.ErrorCode = 35401
+
+	// MFA challenge not found or expired.
+	ErrorCodeMfaChallengeNotFound errors.ErrorCode = 35402
+
+	// MFA is required for the user, but the user hasn't enrolled in MFA.
+	ErrorCodeMfaEnrollmentRequired errors.ErrorCode = 35403
+
+	// OIDC error codes (35600-35799).
+	// They correspond to the OAuth 2.0 error codes (RFC 6749, sections 4.1.2.1 and 5.2).
+
+	// Invalid authorization or token request (missing or invalid parameter, unsupported response type, etc.).
+	ErrorCodeOidcInvalidRequest errors.ErrorCode = 35600
+
+	// Unknown client, client authentication failed or the client isn't registered as an OIDC client.
+	ErrorCodeOidcInvalidClient errors.ErrorCode = 35601
+
+	// The redirect URI isn't registered for the client.
+	ErrorCodeOidcInvalidRedirectURI errors.ErrorCode = 35602
+
+	// Invalid, expired or already used authorization code or refresh token.
+	ErrorCodeOidcInvalidGrant errors.ErrorCode = 35603
+
+	// The requested scope is invalid, unknown or not allowed for the client.
+	ErrorCodeOidcInvalidScope errors.ErrorCode = 35604
+
+	// The user doesn't have the permissions required for any of the requested scopes.
+	ErrorCodeOidcAccessDenied errors.ErrorCode = 35605
+
+	// Invalid or expired access token.
+	ErrorCodeOidcInvalidAccessToken errors.ErrorCode = 35606
+
+	// Registration error codes (35800-35999).
+	// Email verification token not found, expired or already used.
+	ErrorCodeEmailVerificationTokenNotFound errors.ErrorCode = 35800
+
+	// Role inheritance error codes (36000-36199).
+	// The role already inherits from the parent role.
+	ErrorCodeRoleParentAlreadyAdded errors.ErrorCode = 36000
+
+	// The role doesn't inherit from the parent role.
+	ErrorCodeRoleParentNotFound errors.ErrorCode = 36001
+
+	// Adding the parent role would create a cycle in the role hierarchy.
+	ErrorCodeRoleInheritanceCycle errors.ErrorCode = 36002
+
+	// Adding the parent role would exceed the maximum depth of the role hierarchy.
+	ErrorCodeRoleInheritanceDepthExceeded errors.ErrorCode = 36003
+
+	// Resource error codes (36200-36399).
+	ErrorCodeResourceTypeNotFound errors.ErrorCode = 36200
+
+	// Resource role assignment not found.
+	ErrorCodeResourceRoleAssignmentNotFound errors.ErrorCode = 36201
+
+	// Resource role assignment already exists.
+	ErrorCodeResourceRoleAssignmentAlreadyExists errors.ErrorCode = 36202
+
+	// Impersonation error codes (36400-36599).
+	ErrorCodeImpersonationNotAllowed errors.ErrorCode = 36400
+
+	// Impersonation token not found.
+	ErrorCodeImpersonationTokenNotFound errors.ErrorCode = 36401
+
+	// Data subject request error codes (36600-36799).
+	// An unfinished data subject request of the same type already exists.
+	ErrorCodeDataSubjectRequestAlreadyExists errors.ErrorCode = 36600
+
+	// Data subject request not found.
+	ErrorCodeDataSubjectRequestNotFound errors.ErrorCode = 36601
+
+	// API key error codes (36800-36999).
+	// API key not found.
+	ErrorCodeApiKeyNotFound errors.ErrorCode = 36800
 )
 
 var (
@@ -104,6 +204,14 @@ var (
 	ErrInvalidClientId = errors.NewError(ErrorCodeInvalidClientId, "invalid client id")
 
 	// User group errors.
+	ErrUserGroupNotFound      = errors.NewError(ErrorCodeUserGroupNotFound, "user group not found")
+	ErrUserGroupAlreadyExists = errors.NewError(ErrorCodeUserGroupAlreadyExists, "user group with the same name already exists")
+
+	// The user isn't a member of the group.
+	ErrUserGroupMemberNotFound = errors.NewError(ErrorCodeUserGroupMemberNotFound, "user isn't a member of the group")
+
+	// The user is already a member of the group.
+	ErrUserGroupMemberAlreadyExists = errors.NewError(ErrorCodeUserGroupMemberAlreadyExists, "user is already a member of the group")
 
 	// Role errors.
 	ErrRoleNotFound      = errors.NewError(ErrorCodeRoleNotFound, "role not found")
@@ -159,4 +267,75 @@ var (
 
 	// Role already assigned (to the user or group).
 	ErrRoleAlreadyAssigned = errors.NewError(ErrorCodeRoleAlreadyAssigned, "role already assigned")
+
+	// User credential errors.
+	ErrUserCredentialNotFound = errors.NewError(ErrorCodeUserCredentialNotFound, "user's credentials not found")
+
+	// Invalid user name, email, password or client secret.
+	ErrInvalidCredentials = errors.NewError(ErrorCodeInvalidCredentials, "invalid credentials")
+
+	// Lockout errors.
+	ErrUserLockedOut      = errors.NewError(ErrorCodeUserLockedOut, "user is locked out")
+	ErrClientLockedOut    = errors.NewError(ErrorCodeClientLockedOut, "client is locked out")
+	ErrUserAgentLockedOut = errors.NewError(ErrorCodeUserAgentLockedOut, "user agent is locked out")
+
+	// MFA errors.
+	ErrUserTotpNotFound = errors.NewError(ErrorCodeUserTotpNotFound, "user's TOTP not found")
+
+	// Invalid TOTP code or recovery code.
+	ErrInvalidMfaCode = errors.NewError(ErrorCodeInvalidMfaCode, "invalid MFA code")
+
+	// MFA challenge not found or expired.
+	ErrMfaChallengeNotFound = errors.NewError(ErrorCodeMfaChallengeNotFound, "MFA challenge not found")
+
+	// MFA is required for the user, but the user hasn't enrolled in MFA.
+	ErrMfaEnrollmentRequired = errors.NewError(ErrorCodeMfaEnrollmentRequired, "MFA enrollment required")
+
+	// OIDC errors.
+	ErrOidcInvalidRequest     = errors.NewError(ErrorCodeOidcInvalidRequest, "invalid request")
+	ErrOidcInvalidClient      = errors.NewError(ErrorCodeOidcInvalidClient, "invalid client")
+	ErrOidcInvalidRedirectURI = errors.NewError(ErrorCodeOidcInvalidRedirectURI, "invalid redirect URI")
+	ErrOidcInvalidGrant       = errors.NewError(ErrorCodeOidcInvalidGrant, "invalid grant")
+	ErrOidcInvalidScope       = errors.NewError(ErrorCodeOidcInvalidScope, "invalid scope")
+	ErrOidcAccessDenied       = errors.NewError(ErrorCodeOidcAccessDenied, "access denied")
+	ErrOidcInvalidAccessToken = errors.NewError(ErrorCodeOidcInvalidAccessToken, "invalid access token")
+
+	// Registration errors.
+	// Email verification token not found, expired or already used.
+	ErrEmailVerificationTokenNotFound = errors.NewError(ErrorCodeEmailVerificationTokenNotFound, "email verification token not found")
+
+	// Role inheritance errors.
+	// The role already inherits from the parent role.
+	ErrRoleParentAlreadyAdded = errors.NewError(ErrorCodeRoleParentAlreadyAdded, "role already inherits from the parent role")
+
+	// The role doesn't inherit from the parent role.
+	ErrRoleParentNotFound = errors.NewError(ErrorCodeRoleParentNotFound, "role doesn't inherit from the parent role")
+
+	// Adding the parent role would create a cycle in the role hierarchy.
+	ErrRoleInheritanceCycle = errors.NewError(ErrorCodeRoleInheritanceCycle, "role inheritance cycle")
+
+	// Adding the parent role would exceed the maximum depth of the role hierarchy.
+	ErrRoleInheritanceDepthExceeded = errors.NewError(ErrorCodeRoleInheritanceDepthExceeded, "maximum depth of the role hierarchy exceeded")
+
+	// Resource errors.
+	ErrResourceTypeNotFound = errors.NewError(ErrorCodeResourceTypeNotFound, "resource type not found")
+
+	// Resource role assignment not found.
+	ErrResourceRoleAssignmentNotFound = errors.NewError(ErrorCodeResourceRoleAssignmentNotFound, "resource role assignment not found")
+
+	// Resource role assignment already exists.
+	ErrResourceRoleAssignmentAlreadyExists = errors.NewError(ErrorCodeResourceRoleAssignmentAlreadyExists, "resource role assignment with the same params already exists")
+
+	// Impersonation errors.
+	ErrImpersonationNotAllowed = errors.NewError(ErrorCodeImpersonationNotAllowed, "impersonation of the user isn't allowed")
+
+	// Impersonation token not found.
+	ErrImpersonationTokenNotFound = errors.NewError(ErrorCodeImpersonationTokenNotFound, "impersonation token not found")
+
+	// Data subject request errors.
+	ErrDataSubjectRequestAlreadyExists = errors.NewError(ErrorCodeDataSubjectRequestAlreadyExists, "unfinished data subject request of the same type already exists")
+	ErrDataSubjectRequestNotFound      = errors.NewError(ErrorCodeDataSubjectRequestNotFound, "data subject request not found")
+
+	// API key errors.
+	ErrApiKeyNotFound = errors.NewError(ErrorCodeApiKeyNotFound, "API key not found")
 )
@@ -0,0 +1,78 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/groups/models"
+)
+
+// The user group.
+type UserGroup struct {
+	// The unique ID to identify the user group.
+	Id models.UserGroup `db:"id"`
+
+	// The unique name to identify the user group.
+	Name string `db:"name"`
+
+	// It indicates whether the user group is built-in.
+	IsBuiltIn bool `db:"is_built_in"`
+
+	// It stores the date and time at which the user group was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the user group.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the user group was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the user group.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The user group description.
+	Description string `db:"description"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+// The user group member.
+type UserGroupMember struct {
+	// The unique ID to identify the user group member.
+	Id uint64 `db:"id"`
+
+	// The user group ID.
+	GroupId models.UserGroup `db:"group_id"`
+
+	// The user ID.
+	UserId uint64 `db:"user_id"`
+
+	// It stores the date and time at which the user was added to the group.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who added the user to the group.
+	CreatedBy uint64 `db:"created_by"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
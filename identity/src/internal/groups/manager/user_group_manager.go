@@ -0,0 +1,273 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/groups/operations/usergroups"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// UserGroupManager is a user group manager.
+type UserGroupManager struct {
+	opExecutor            *actionhelper.OperationExecutor
+	userGroupStore        groups.UserGroupStore
+	userGroupMemberStore  groups.UserGroupMemberStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
+}
+
+var _ groups.UserGroupManager = (*UserGroupManager)(nil)
+
+func NewUserGroupManager(
+	userGroupStore groups.UserGroupStore,
+	userGroupMemberStore groups.UserGroupMemberStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*UserGroupManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.groups.manager.UserGroupManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserGroupManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserGroup,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserGroupManager] new operation executor: %w", err)
+	}
+
+	return &UserGroupManager{
+		opExecutor:            e,
+		userGroupStore:        userGroupStore,
+		userGroupMemberStore:  userGroupMemberStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
+	}, nil
+}
+
+// Create creates a user group and returns the user group ID if the operation is successful.
+func (m *UserGroupManager) Create(ctx *actions.OperationContext, data *usergroups.CreateOperationData) (models.UserGroup, error) {
+	var id models.UserGroup
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Create] validate data: %w", err)
+			}
+
+			var err error
+			if id, err = m.userGroupStore.Create(opCtx, data); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Create] create a user group: %w", err)
+			}
+
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.UserGroupEvent, "[manager.UserGroupManager.Create] user group has been created",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.UserGroupManager.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Update updates a user group by the specified user group ID.
+func (m *UserGroupManager) Update(ctx *actions.OperationContext, id models.UserGroup, data *usergroups.UpdateOperationData) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_Update,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Update] validate data: %w", err)
+			}
+			if id.IsBuiltIn() {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "built-in user group can't be updated")
+			}
+
+			if err := m.userGroupStore.Update(opCtx, id, data); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Update] update a user group: %w", err)
+			}
+
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.UserGroupEvent, "[manager.UserGroupManager.Update] user group has been updated",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserGroupManager.Update] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a user group by the specified user group ID.
+func (m *UserGroupManager) Delete(ctx *actions.OperationContext, id models.UserGroup) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if id.IsBuiltIn() {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "built-in user group can't be deleted")
+			}
+
+			// the members are deleted together with the group
+			members, err := m.userGroupMemberStore.GetAllByGroupId(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Delete] get all members by group id: %w", err)
+			}
+
+			if err = m.userGroupStore.Delete(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Delete] delete a user group: %w", err)
+			}
+
+			leCtx := opCtx.CreateLogEntryContext()
+			m.logger.InfoWithEvent(leCtx, events.UserGroupEvent, "[manager.UserGroupManager.Delete] user group has been deleted",
+				logging.NewField("id", id),
+			)
+
+			if err = m.authzCacheInvalidator.InvalidateGroup(opCtx, id); err != nil {
+				m.logger.ErrorWithEvent(leCtx, events.UserGroupEvent, err, "[manager.UserGroupManager.Delete] invalidate the authorization cache",
+					logging.NewField("id", id),
+				)
+			}
+
+			for _, member := range members {
+				if err = m.authzCacheInvalidator.InvalidateUser(opCtx, member.UserId); err != nil {
+					m.logger.ErrorWithEvent(leCtx, events.UserGroupEvent, err, "[manager.UserGroupManager.Delete] invalidate the authorization cache",
+						logging.NewField("id", id),
+						logging.NewField("userId", member.UserId),
+					)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserGroupManager.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindById finds and returns a user group, if any, by the specified user group ID.
+func (m *UserGroupManager) FindById(ctx *actions.OperationContext, id models.UserGroup) (*dbmodels.UserGroup, error) {
+	var g *dbmodels.UserGroup
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if g, err = m.userGroupStore.FindById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.FindById] find a user group by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserGroupManager.FindById] execute an operation: %w", err)
+	}
+	return g, nil
+}
+
+// FindByName finds and returns a user group, if any, by the specified user group name.
+func (m *UserGroupManager) FindByName(ctx *actions.OperationContext, name string) (*dbmodels.UserGroup, error) {
+	var g *dbmodels.UserGroup
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_FindByName, []*actions.OperationParam{actions.NewOperationParam("name", name)},
+		func(opCtx *actions.OperationContext) error {
+			if strings.IsEmptyOrWhitespace(name) {
+				return errors.NewError(errors.ErrorCodeInvalidData, "name is empty")
+			}
+
+			var err error
+			if g, err = m.userGroupStore.FindByName(opCtx, name); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.FindByName] find a user group by name: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserGroupManager.FindByName] execute an operation: %w", err)
+	}
+	return g, nil
+}
+
+// GetAll gets all user groups.
+func (m *UserGroupManager) GetAll(ctx *actions.OperationContext) ([]*dbmodels.UserGroup, error) {
+	var gs []*dbmodels.UserGroup
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_GetAll, nil,
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if gs, err = m.userGroupStore.GetAll(opCtx); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.GetAll] get all user groups: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserGroupManager.GetAll] execute an operation: %w", err)
+	}
+	return gs, nil
+}
+
+// Exists returns true if the user group exists.
+func (m *UserGroupManager) Exists(ctx *actions.OperationContext, name string) (bool, error) {
+	var exists bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_Exists, []*actions.OperationParam{actions.NewOperationParam("name", name)},
+		func(opCtx *actions.OperationContext) error {
+			if strings.IsEmptyOrWhitespace(name) {
+				return errors.NewError(errors.ErrorCodeInvalidData, "name is empty")
+			}
+
+			var err error
+			if exists, err = m.userGroupStore.Exists(opCtx, name); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.Exists] user group exists: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserGroupManager.Exists] execute an operation: %w", err)
+	}
+	return exists, nil
+}
+
+// ExistsById returns true if the user group with the specified ID exists.
+func (m *UserGroupManager) ExistsById(ctx *actions.OperationContext, id models.UserGroup) (bool, error) {
+	var exists bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupManager_ExistsById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if exists, err = m.userGroupStore.ExistsById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.UserGroupManager.ExistsById] user group exists: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserGroupManager.ExistsById] execute an operation: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// UserGroupMemberManager is a user group member manager.
+type UserGroupMemberManager struct {
+	opExecutor            *actionhelper.OperationExecutor
+	userManager           users.UserManager
+	userGroupMemberStore  groups.UserGroupMemberStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
+}
+
+var _ groups.UserGroupMemberManager = (*UserGroupMemberManager)(nil)
+
+func NewUserGroupMemberManager(
+	userManager users.UserManager,
+	userGroupMemberStore groups.UserGroupMemberStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*UserGroupMemberManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.groups.manager.UserGroupMemberManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserGroupMemberManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserGroupMember,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserGroupMemberManager] new operation executor: %w", err)
+	}
+
+	return &UserGroupMemberManager{
+		opExecutor:            e,
+		userManager:           userManager,
+		userGroupMemberStore:  userGroupMemberStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
+	}, nil
+}
+
+// Add adds a user to the user group.
+func (m *UserGroupMemberManager) Add(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberManager_Add,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if groupId.IsBuiltIn() {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "members of the built-in user group can't be managed")
+			}
+
+			status, err := m.userManager.GetStatusById(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.Add] get a user's status by id: %w", err)
+			}
+			if status == usermodels.UserStatusDeleting || status == usermodels.UserStatusDeleted {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid user status (%v)", status))
+			}
+
+			id, err := m.userGroupMemberStore.Add(opCtx, groupId, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.Add] add a user to the user group: %w", err)
+			}
+
+			leCtx := opCtx.CreateLogEntryContext()
+			if err = m.authzCacheInvalidator.InvalidateUser(opCtx, userId); err != nil {
+				m.logger.ErrorWithEvent(leCtx, events.UserGroupMemberEvent, err, "[manager.UserGroupMemberManager.Add] invalidate the authorization cache",
+					logging.NewField("groupId", groupId),
+					logging.NewField("userId", userId),
+				)
+			}
+
+			m.logger.InfoWithEvent(leCtx, events.UserGroupMemberEvent, "[manager.UserGroupMemberManager.Add] user has been added to the user group",
+				logging.NewField("id", id),
+				logging.NewField("groupId", groupId),
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserGroupMemberManager.Add] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Remove removes a user from the user group.
+func (m *UserGroupMemberManager) Remove(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberManager_Remove,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if groupId.IsBuiltIn() {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "members of the built-in user group can't be managed")
+			}
+
+			if err := m.userGroupMemberStore.Remove(opCtx, groupId, userId); err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.Remove] remove a user from the user group: %w", err)
+			}
+
+			leCtx := opCtx.CreateLogEntryContext()
+			if err := m.authzCacheInvalidator.InvalidateUser(opCtx, userId); err != nil {
+				m.logger.ErrorWithEvent(leCtx, events.UserGroupMemberEvent, err, "[manager.UserGroupMemberManager.Remove] invalidate the authorization cache",
+					logging.NewField("groupId", groupId),
+					logging.NewField("userId", userId),
+				)
+			}
+
+			m.logger.InfoWithEvent(leCtx, events.UserGroupMemberEvent, "[manager.UserGroupMemberManager.Remove] user has been removed from the user group",
+				logging.NewField("groupId", groupId),
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserGroupMemberManager.Remove] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// GetAllByGroupId gets all members of the user group by the specified user group ID.
+func (m *UserGroupMemberManager) GetAllByGroupId(ctx *actions.OperationContext, groupId models.UserGroup) ([]*dbmodels.UserGroupMember, error) {
+	var ms []*dbmodels.UserGroupMember
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberManager_GetAllByGroupId, []*actions.OperationParam{actions.NewOperationParam("groupId", groupId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ms, err = m.userGroupMemberStore.GetAllByGroupId(opCtx, groupId); err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.GetAllByGroupId] get all members by group id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserGroupMemberManager.GetAllByGroupId] execute an operation: %w", err)
+	}
+	return ms, nil
+}
+
+// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+// by the specified user ID.
+func (m *UserGroupMemberManager) GetAllGroupIdsByUserId(ctx *actions.OperationContext, userId uint64) ([]models.UserGroup, error) {
+	var ids []models.UserGroup
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberManager_GetAllGroupIdsByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.userGroupMemberStore.GetAllGroupIdsByUserId(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.GetAllGroupIdsByUserId] get all group ids by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserGroupMemberManager.GetAllGroupIdsByUserId] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// IsMember returns true if the user is a member of the user group.
+func (m *UserGroupMemberManager) IsMember(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) (bool, error) {
+	var isMember bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberManager_IsMember,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if isMember, err = m.userGroupMemberStore.IsMember(opCtx, groupId, userId); err != nil {
+				return fmt.Errorf("[manager.UserGroupMemberManager.IsMember] is member: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserGroupMemberManager.IsMember] execute an operation: %w", err)
+	}
+	return isMember, nil
+}
@@ -17,11 +17,14 @@ package models
 import "fmt"
 
 // The user's group.
+// The built-in groups have fixed IDs; the IDs of the groups created by users start from 1001.
 type UserGroup uint64
 
 const (
 	// Unspecified = 0 // Do not use.
 
+	// The built-in groups.
+
 	UserGroupAnonymousUsers UserGroup = 1
 	UserGroupSuperusers     UserGroup = 2
 	UserGroupSystemUsers    UserGroup = 3
@@ -41,11 +44,16 @@ var userGroupNames = [...]string{
 }
 
 func (g UserGroup) IsValid() bool {
+	return g > 0
+}
+
+// IsBuiltIn returns true if the group is one of the built-in groups.
+func (g UserGroup) IsBuiltIn() bool {
 	return g > 0 && int(g) < len(userGroupNames)
 }
 
 func (g UserGroup) String() string {
-	if g.IsValid() {
+	if g.IsBuiltIn() {
 		return userGroupNames[g]
 	}
 	return fmt.Sprintf("UserGroup(%d)", g)
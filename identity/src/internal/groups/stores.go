@@ -0,0 +1,68 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groups
+
+import (
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/groups/operations/usergroups"
+	"personal-website-v2/pkg/actions"
+)
+
+// UserGroupStore is a user group store.
+type UserGroupStore interface {
+	// Create creates a user group and returns the user group ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *usergroups.CreateOperationData) (models.UserGroup, error)
+
+	// Update updates a user group by the specified user group ID.
+	Update(ctx *actions.OperationContext, id models.UserGroup, data *usergroups.UpdateOperationData) error
+
+	// Delete deletes a user group by the specified user group ID.
+	Delete(ctx *actions.OperationContext, id models.UserGroup) error
+
+	// FindById finds and returns a user group, if any, by the specified user group ID.
+	FindById(ctx *actions.OperationContext, id models.UserGroup) (*dbmodels.UserGroup, error)
+
+	// FindByName finds and returns a user group, if any, by the specified user group name.
+	FindByName(ctx *actions.OperationContext, name string) (*dbmodels.UserGroup, error)
+
+	// GetAll gets all user groups.
+	GetAll(ctx *actions.OperationContext) ([]*dbmodels.UserGroup, error)
+
+	// Exists returns true if the user group exists.
+	Exists(ctx *actions.OperationContext, name string) (bool, error)
+
+	// ExistsById returns true if the user group with the specified ID exists.
+	ExistsById(ctx *actions.OperationContext, id models.UserGroup) (bool, error)
+}
+
+// UserGroupMemberStore is a user group member store.
+type UserGroupMemberStore interface {
+	// Add adds a user to the user group and returns the user group member ID if the operation is successful.
+	Add(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) (uint64, error)
+
+	// Remove removes a user from the user group.
+	Remove(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) error
+
+	// GetAllByGroupId gets all members of the user group by the specified user group ID.
+	GetAllByGroupId(ctx *actions.OperationContext, groupId models.UserGroup) ([]*dbmodels.UserGroupMember, error)
+
+	// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+	// by the specified user ID.
+	GetAllGroupIdsByUserId(ctx *actions.OperationContext, userId uint64) ([]models.UserGroup, error)
+
+	// IsMember returns true if the user is a member of the user group.
+	IsMember(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) (bool, error)
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	userGroupMembersTable = "public.user_group_members"
+)
+
+// UserGroupMemberStore is a user group member store.
+type UserGroupMemberStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.UserGroupMember]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ groups.UserGroupMemberStore = (*UserGroupMemberStore)(nil)
+
+func NewUserGroupMemberStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*UserGroupMemberStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.groups.stores.UserGroupMemberStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupMemberStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupUserGroupMember,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupMemberStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupMemberStore] new TxManager: %w", err)
+	}
+
+	return &UserGroupMemberStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.UserGroupMember](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Add adds a user to the user group and returns the user group member ID if the operation is successful.
+func (s *UserGroupMemberStore) Add(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberStore_Add,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.add_user_group_member(IN _group_id, IN _user_id, IN _created_by, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.add_user_group_member($1, $2, $3, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, groupId, userId, opCtx.UserId.Ptr())
+
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserGroupMemberStore.Add] execute a query (add_user_group_member): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserGroupNotFound:
+					return ierrors.ErrUserGroupNotFound
+				case idberrors.DbErrorCodeUserGroupMemberAlreadyExists:
+					return ierrors.ErrUserGroupMemberAlreadyExists
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserGroupMemberStore.Add] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.Add] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.UserGroupMemberStore.Add] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Remove removes a user from the user group.
+func (s *UserGroupMemberStore) Remove(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberStore_Remove,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.remove_user_group_member(IN _group_id, IN _user_id, OUT err_code, OUT err_msg)
+				const query = "CALL public.remove_user_group_member($1, $2, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, groupId, userId).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserGroupMemberStore.Remove] execute a query (remove_user_group_member): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserGroupMemberNotFound:
+					return ierrors.ErrUserGroupMemberNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserGroupMemberStore.Remove] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.Remove] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserGroupMemberStore.Remove] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// GetAllByGroupId gets all members of the user group by the specified user group ID.
+func (s *UserGroupMemberStore) GetAllByGroupId(ctx *actions.OperationContext, groupId models.UserGroup) ([]*dbmodels.UserGroupMember, error) {
+	var ms []*dbmodels.UserGroupMember
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberStore_GetAllByGroupId, []*actions.OperationParam{actions.NewOperationParam("groupId", groupId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + userGroupMembersTable + " WHERE group_id = $1"
+			var err error
+			if ms, err = s.store.FindAll(opCtx.Ctx, query, groupId); err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.GetAllByGroupId] find all members by group id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserGroupMemberStore.GetAllByGroupId] execute an operation: %w", err)
+	}
+	return ms, nil
+}
+
+// GetAllGroupIdsByUserId gets the IDs of all user groups of which the user is a member
+// by the specified user ID.
+func (s *UserGroupMemberStore) GetAllGroupIdsByUserId(ctx *actions.OperationContext, userId uint64) ([]models.UserGroup, error) {
+	var ids []models.UserGroup
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberStore_GetAllGroupIdsByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.GetAllGroupIdsByUserId] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT group_id FROM " + userGroupMembersTable + " WHERE user_id = $1"
+			rows, err := conn.Query(opCtx.Ctx, query, userId)
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.GetAllGroupIdsByUserId] execute a query: %w", err)
+			}
+			defer rows.Close()
+
+			if ids, err = pgx.CollectRows(rows, pgx.RowTo[models.UserGroup]); err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.GetAllGroupIdsByUserId] collect rows: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserGroupMemberStore.GetAllGroupIdsByUserId] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// IsMember returns true if the user is a member of the user group.
+func (s *UserGroupMemberStore) IsMember(ctx *actions.OperationContext, groupId models.UserGroup, userId uint64) (bool, error) {
+	var isMember bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupMemberStore_IsMember,
+		[]*actions.OperationParam{actions.NewOperationParam("groupId", groupId), actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.IsMember] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT EXISTS (SELECT 1 FROM " + userGroupMembersTable + " WHERE group_id = $1 AND user_id = $2 LIMIT 1)"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, groupId, userId).Scan(&isMember); err != nil {
+				return fmt.Errorf("[stores.UserGroupMemberStore.IsMember] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.UserGroupMemberStore.IsMember] execute an operation: %w", err)
+	}
+	return isMember, nil
+}
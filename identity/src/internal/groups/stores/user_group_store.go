@@ -0,0 +1,307 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	"personal-website-v2/identity/src/internal/groups/dbmodels"
+	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/groups/operations/usergroups"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	userGroupsTable = "public.user_groups"
+)
+
+// UserGroupStore is a user group store.
+type UserGroupStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.UserGroup]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ groups.UserGroupStore = (*UserGroupStore)(nil)
+
+func NewUserGroupStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*UserGroupStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.groups.stores.UserGroupStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupUserGroup,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserGroupStore] new TxManager: %w", err)
+	}
+
+	return &UserGroupStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.UserGroup](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a user group and returns the user group ID if the operation is successful.
+func (s *UserGroupStore) Create(ctx *actions.OperationContext, data *usergroups.CreateOperationData) (models.UserGroup, error) {
+	var id models.UserGroup
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_user_group(IN _name, IN _created_by, IN _description, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_user_group($1, $2, $3, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.Name, opCtx.UserId.Ptr(), data.Description)
+
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserGroupStore.Create] execute a query (create_user_group): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserGroupAlreadyExists:
+					return ierrors.ErrUserGroupAlreadyExists
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserGroupStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.UserGroupStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Update updates a user group by the specified user group ID.
+func (s *UserGroupStore) Update(ctx *actions.OperationContext, id models.UserGroup, data *usergroups.UpdateOperationData) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_Update,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.update_user_group(IN _id, IN _name, IN _description, IN _updated_by, OUT err_code, OUT err_msg)
+				const query = "CALL public.update_user_group($1, $2, $3, $4, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, data.Name, data.Description, opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserGroupStore.Update] execute a query (update_user_group): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserGroupNotFound:
+					return ierrors.ErrUserGroupNotFound
+				case idberrors.DbErrorCodeUserGroupAlreadyExists:
+					return ierrors.ErrUserGroupAlreadyExists
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserGroupStore.Update] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.Update] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserGroupStore.Update] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a user group by the specified user group ID.
+func (s *UserGroupStore) Delete(ctx *actions.OperationContext, id models.UserGroup) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_user_group(IN _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.delete_user_group($1, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserGroupStore.Delete] execute a query (delete_user_group): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserGroupNotFound:
+					return ierrors.ErrUserGroupNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserGroupStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserGroupStore.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindById finds and returns a user group, if any, by the specified user group ID.
+func (s *UserGroupStore) FindById(ctx *actions.OperationContext, id models.UserGroup) (*dbmodels.UserGroup, error) {
+	var g *dbmodels.UserGroup
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + userGroupsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if g, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.FindById] find a user group by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserGroupStore.FindById] execute an operation: %w", err)
+	}
+	return g, nil
+}
+
+// FindByName finds and returns a user group, if any, by the specified user group name.
+func (s *UserGroupStore) FindByName(ctx *actions.OperationContext, name string) (*dbmodels.UserGroup, error) {
+	var g *dbmodels.UserGroup
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_FindByName, []*actions.OperationParam{actions.NewOperationParam("name", name)},
+		func(opCtx *actions.OperationContext) error {
+			// must be case-sensitive
+			const query = "SELECT * FROM " + userGroupsTable + " WHERE name = $1 LIMIT 1"
+			var err error
+			if g, err = s.store.Find(opCtx.Ctx, query, name); err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.FindByName] find a user group by name: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserGroupStore.FindByName] execute an operation: %w", err)
+	}
+	return g, nil
+}
+
+// GetAll gets all user groups.
+func (s *UserGroupStore) GetAll(ctx *actions.OperationContext) ([]*dbmodels.UserGroup, error) {
+	var gs []*dbmodels.UserGroup
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_GetAll, nil,
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + userGroupsTable + " ORDER BY id"
+			var err error
+			if gs, err = s.store.FindAll(opCtx.Ctx, query); err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.GetAll] find all user groups: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserGroupStore.GetAll] execute an operation: %w", err)
+	}
+	return gs, nil
+}
+
+// Exists returns true if the user group exists.
+func (s *UserGroupStore) Exists(ctx *actions.OperationContext, name string) (bool, error) {
+	var exists bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_Exists, []*actions.OperationParam{actions.NewOperationParam("name", name)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.Exists] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			// FUNCTION: public.user_group_exists(_name) RETURNS boolean
+			const query = "SELECT public.user_group_exists($1)"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, name).Scan(&exists); err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.Exists] execute a query (user_group_exists): %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.UserGroupStore.Exists] execute an operation: %w", err)
+	}
+	return exists, nil
+}
+
+// ExistsById returns true if the user group with the specified ID exists.
+func (s *UserGroupStore) ExistsById(ctx *actions.OperationContext, id models.UserGroup) (bool, error) {
+	var exists bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserGroupStore_ExistsById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.ExistsById] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT EXISTS (SELECT 1 FROM " + userGroupsTable + " WHERE id = $1 LIMIT 1)"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, id).Scan(&exists); err != nil {
+				return fmt.Errorf("[stores.UserGroupStore.ExistsById] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.UserGroupStore.ExistsById] execute an operation: %w", err)
+	}
+	return exists, nil
+}
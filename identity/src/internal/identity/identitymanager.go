@@ -19,15 +19,20 @@ import (
 	"fmt"
 	"sync"
 
+	"personal-website-v2/identity/src/internal/apikeys"
 	"personal-website-v2/identity/src/internal/authentication"
 	"personal-website-v2/identity/src/internal/authorization"
+	authzmodels "personal-website-v2/identity/src/internal/authorization/models"
 	"personal-website-v2/identity/src/internal/clients"
 	clientmodels "personal-website-v2/identity/src/internal/clients/models"
 	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/impersonation"
 	"personal-website-v2/identity/src/internal/permissions"
 	permissiondbmodels "personal-website-v2/identity/src/internal/permissions/dbmodels"
+	"personal-website-v2/identity/src/internal/resources"
 	"personal-website-v2/identity/src/internal/roles"
 	roledbmodels "personal-website-v2/identity/src/internal/roles/dbmodels"
+	"personal-website-v2/identity/src/internal/sessions"
 	"personal-website-v2/identity/src/internal/users"
 	usermodels "personal-website-v2/identity/src/internal/users/models"
 	"personal-website-v2/pkg/actions"
@@ -42,21 +47,28 @@ import (
 )
 
 type identityManager struct {
-	appUserId             uint64
-	opExecutor            *actionhelper.OperationExecutor
-	userManager           users.UserManager
-	clientManager         clients.ClientManager
-	roleManager           roles.RoleManager
-	permissionManager     permissions.PermissionManager
-	authenticationManager authentication.AuthenticationManager
-	authorizationManager  authorization.AuthorizationManager
-	roleNames             []string
-	permissionNames       []string
-	roles                 map[uint64]*roledbmodels.Role
-	permissionsById       map[uint64]*permissiondbmodels.Permission
-	permissionIdsByName   map[string]uint64
-	logger                logging.Logger[*context.LogEntryContext]
-	isInitialized         bool
+	appUserId                 uint64
+	opExecutor                *actionhelper.OperationExecutor
+	userManager               users.UserManager
+	clientManager             clients.ClientManager
+	roleManager               roles.RoleManager
+	permissionManager         permissions.PermissionManager
+	authenticationManager     authentication.AuthenticationManager
+	serviceClientTokenManager clients.ServiceClientTokenManager
+	activeSessionManager      sessions.ActiveSessionManager
+	authorizationManager      authorization.AuthorizationManager
+	resourceTypeManager       resources.ResourceTypeManager
+	impersonationManager      impersonation.ImpersonationManager
+	apiKeyManager             apikeys.ApiKeyManager
+	roleNames                 []string
+	permissionNames           []string
+	resourceTypeNames         []string
+	roles                     map[uint64]*roledbmodels.Role
+	permissionsById           map[uint64]*permissiondbmodels.Permission
+	permissionIdsByName       map[string]uint64
+	resourceTypeIds           map[string]uint64
+	logger                    logging.Logger[*context.LogEntryContext]
+	isInitialized             bool
 }
 
 var _ identity.IdentityManager = (*identityManager)(nil)
@@ -68,9 +80,15 @@ func NewIdentityManager(
 	roleManager roles.RoleManager,
 	permissionManager permissions.PermissionManager,
 	authenticationManager authentication.AuthenticationManager,
+	serviceClientTokenManager clients.ServiceClientTokenManager,
+	activeSessionManager sessions.ActiveSessionManager,
 	authorizationManager authorization.AuthorizationManager,
+	resourceTypeManager resources.ResourceTypeManager,
+	impersonationManager impersonation.ImpersonationManager,
+	apiKeyManager apikeys.ApiKeyManager,
 	roles []string,
 	permissions []string,
+	resourceTypes []string,
 	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
 ) (identity.IdentityManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.identity.identityManager")
@@ -89,17 +107,23 @@ func NewIdentityManager(
 	}
 
 	return &identityManager{
-		appUserId:             appUserId,
-		opExecutor:            e,
-		userManager:           userManager,
-		clientManager:         clientManager,
-		roleManager:           roleManager,
-		permissionManager:     permissionManager,
-		authenticationManager: authenticationManager,
-		authorizationManager:  authorizationManager,
-		roleNames:             roles,
-		permissionNames:       permissions,
-		logger:                l,
+		appUserId:                 appUserId,
+		opExecutor:                e,
+		userManager:               userManager,
+		clientManager:             clientManager,
+		roleManager:               roleManager,
+		permissionManager:         permissionManager,
+		authenticationManager:     authenticationManager,
+		serviceClientTokenManager: serviceClientTokenManager,
+		activeSessionManager:      activeSessionManager,
+		authorizationManager:      authorizationManager,
+		resourceTypeManager:       resourceTypeManager,
+		impersonationManager:      impersonationManager,
+		apiKeyManager:             apiKeyManager,
+		roleNames:                 roles,
+		permissionNames:           permissions,
+		resourceTypeNames:         resourceTypes,
+		logger:                    l,
 	}, nil
 }
 
@@ -118,6 +142,18 @@ func (m *identityManager) Init() error {
 		return fmt.Errorf("[identity.identityManager.Init] get all permissions by names: %w", err)
 	}
 
+	rtidm := make(map[string]uint64, len(m.resourceTypeNames))
+	if len(m.resourceTypeNames) > 0 {
+		rts, err := m.resourceTypeManager.Register(m.resourceTypeNames, m.appUserId)
+		if err != nil {
+			return fmt.Errorf("[identity.identityManager.Init] register resource types: %w", err)
+		}
+
+		for _, rt := range rts {
+			rtidm[rt.Name] = rt.Id
+		}
+	}
+
 	rm := make(map[uint64]*roledbmodels.Role, len(rs))
 	for _, r := range rs {
 		rm[r.Id] = r
@@ -133,11 +169,13 @@ func (m *identityManager) Init() error {
 	m.roles = rm
 	m.permissionsById = pm
 	m.permissionIdsByName = pidm
+	m.resourceTypeIds = rtidm
 	m.isInitialized = true
 	return nil
 }
 
-func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64]) (identity.Identity, error) {
+func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId, impersonatorId, apiKeyId nullable.Nullable[uint64],
+) (identity.Identity, error) {
 	if !m.isInitialized {
 		return nil, errors.New("[identity.identityManager.AuthenticateById] identityManager not initialized")
 	}
@@ -145,17 +183,32 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *identity.DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateById,
-		[]*actions.OperationParam{actions.NewOperationParam("userId", userId.Ptr()), actions.NewOperationParam("clientId", clientId.Ptr())},
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId.Ptr()),
+			actions.NewOperationParam("clientId", clientId.Ptr()),
+			actions.NewOperationParam("impersonatorId", impersonatorId.Ptr()),
+			actions.NewOperationParam("apiKeyId", apiKeyId.Ptr()),
+		},
 		func(opCtx *actions.OperationContext) error {
+			if apiKeyId.HasValue {
+				var err error
+				if i, err = m.authenticateByApiKeyId(opCtx, apiKeyId.Value, userId, clientId); err != nil {
+					return fmt.Errorf("[identity.identityManager.AuthenticateById] authenticate a user or a service client by the API key id: %w", err)
+				}
+				return nil
+			}
+
 			if !userId.HasValue && !clientId.HasValue {
 				i = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
 				return nil
 			}
 
-			var userId2, clientId2 nullable.Nullable[uint64]
+			var userId2, clientId2, impersonatorId2 nullable.Nullable[uint64]
 			userType := usermodels.UserTypeUser
 			var wg sync.WaitGroup
 			var clientErr error
@@ -195,6 +248,8 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 				} else if s == usermodels.UserStatusActive {
 					userId2 = userId
 					userType = t
+					// the impersonation token has been verified by the app that received it
+					impersonatorId2 = impersonatorId
 				} else {
 					m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, "[identity.identityManager.AuthenticateById] invalid user's status",
 						logging.NewField("userStatus", s),
@@ -209,6 +264,20 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 				}
 			}
 
+			if impersonatorId2.HasValue {
+				i = identity.NewImpersonatedIdentity(userId2.Value, identity.UserType(userType), impersonatorId2.Value, clientId2)
+
+				m.logger.InfoWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.Identity_ImpersonatedUserAuthenticated,
+					"[identity.identityManager.AuthenticateById] impersonated user has been authenticated",
+					logging.NewField("userId", userId2.Value),
+					logging.NewField("impersonatorId", impersonatorId2.Value),
+					logging.NewField("clientId", clientId2.Ptr()),
+				)
+				return nil
+			}
+
 			i = identity.NewDefaultIdentity(userId2, identity.UserType(userType), clientId2)
 
 			if userId2.HasValue && clientId2.HasValue {
@@ -251,11 +320,18 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *identity.DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByToken, nil,
 		func(opCtx *actions.OperationContext) error {
-			if len(userToken) > 0 && len(clientToken) > 0 {
+			if identity.IsImpersonationToken(userToken) {
+				var err error
+				if i, err = m.authenticateImpersonatedUser(opCtx, userToken, clientToken); err != nil {
+					return fmt.Errorf("[identity.identityManager.AuthenticateByToken] authenticate an impersonated user: %w", err)
+				}
+			} else if len(userToken) > 0 && len(clientToken) > 0 {
 				if r, err := m.authenticationManager.Authenticate(opCtx, userToken, clientToken); err != nil {
 					msg := "[identity.identityManager.AuthenticateByToken] authenticate a user and a client"
 					if err2 := errs.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeInvalidAuthnToken &&
@@ -263,6 +339,16 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 						return fmt.Errorf("%s: %w", msg, err)
 					}
 					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+				} else if isRevoked, err := m.activeSessionManager.IsRevoked(opCtx, r.UserId, r.ClientId); err != nil {
+					return fmt.Errorf("[identity.identityManager.AuthenticateByToken] check if the user's session has been revoked: %w", err)
+				} else if isRevoked {
+					m.logger.WarningWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.IdentityEvent,
+						"[identity.identityManager.AuthenticateByToken] user's session has been revoked",
+						logging.NewField("userId", r.UserId),
+						logging.NewField("clientId", r.ClientId),
+					)
 				} else {
 					i = identity.NewDefaultIdentity(nullable.NewNullable(r.UserId), identity.UserType(r.UserType), nullable.NewNullable(r.ClientId))
 
@@ -292,6 +378,24 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 						logging.NewField("userId", r.UserId),
 					)
 				}
+			} else if identity.IsServiceClientToken(clientToken) {
+				if clientId, err := m.serviceClientTokenManager.Authenticate(opCtx, string(clientToken)); err != nil {
+					msg := "[identity.identityManager.AuthenticateByToken] authenticate a service client"
+					if err2 := errs.Unwrap(err); err2 == nil ||
+						err2.Code() != ierrors.ErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ErrorCodeInvalidClientAuthnToken {
+						return fmt.Errorf("%s: %w", msg, err)
+					}
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+				} else {
+					i = identity.NewServiceClientIdentity(clientId)
+
+					m.logger.InfoWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.Identity_ServiceClientAuthenticated,
+						"[identity.identityManager.AuthenticateByToken] service client has been authenticated",
+						logging.NewField("clientId", clientId),
+					)
+				}
 			} else if len(clientToken) > 0 {
 				if r, err := m.authenticationManager.AuthenticateClient(opCtx, clientToken); err != nil {
 					msg := "[identity.identityManager.AuthenticateByToken] authenticate a client"
@@ -320,6 +424,156 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 	return i, nil
 }
 
+// AuthenticateByApiKey authenticates a user or a service client by the API key.
+// It returns an anonymous identity if the API key is invalid.
+func (m *identityManager) AuthenticateByApiKey(ctx *actions.OperationContext, apiKey []byte) (identity.Identity, error) {
+	if !m.isInitialized {
+		return nil, errors.New("[identity.identityManager.AuthenticateByApiKey] identityManager not initialized")
+	}
+
+	ctx = ctx.Clone()
+	ctx.UserId = nullable.NewNullable(m.appUserId)
+	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
+
+	var i identity.Identity
+	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByApiKey, nil,
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.apiKeyManager.Authenticate(opCtx, string(apiKey))
+			if err != nil {
+				msg := "[identity.identityManager.AuthenticateByApiKey] authenticate an API key"
+				if err2 := errs.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeInvalidAuthnToken {
+					return fmt.Errorf("%s: %w", msg, err)
+				}
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+				i = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
+				return nil
+			}
+
+			i = identity.NewApiKeyIdentity(r.ApiKeyId, r.UserId, identity.UserType(r.UserType), r.ClientId, m.getApiKeyPermissionNames(r.PermissionIds))
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.Identity_ApiKeyAuthenticated,
+				"[identity.identityManager.AuthenticateByApiKey] API key has been authenticated",
+				logging.NewField("apiKeyId", r.ApiKeyId),
+				logging.NewField("userId", r.UserId.Ptr()),
+				logging.NewField("clientId", r.ClientId.Ptr()),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.identityManager.AuthenticateByApiKey] execute an operation: %w", err)
+	}
+	return i, nil
+}
+
+// authenticateByApiKeyId authenticates a user or a service client by the ID of the API key
+// that has already been authenticated by the app that received it, so that the restriction
+// of the key is applied in this app too. It returns an anonymous identity if the API key
+// is invalid or isn't owned by the specified user (service client).
+func (m *identityManager) authenticateByApiKeyId(opCtx *actions.OperationContext, apiKeyId uint64, userId, clientId nullable.Nullable[uint64],
+) (*identity.DefaultIdentity, error) {
+	r, err := m.apiKeyManager.AuthenticateById(opCtx, apiKeyId)
+	if err != nil {
+		msg := "[identity.identityManager.authenticateByApiKeyId] authenticate an API key by id"
+		if err2 := errs.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeInvalidAuthnToken {
+			return nil, fmt.Errorf("%s: %w", msg, err)
+		}
+		m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+		return identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{}), nil
+	}
+
+	if !r.UserId.Equals(userId) || !r.ClientId.Equals(clientId) {
+		m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent,
+			"[identity.identityManager.authenticateByApiKeyId] API key isn't owned by the user (service client)",
+			logging.NewField("apiKeyId", apiKeyId),
+			logging.NewField("userId", userId.Ptr()),
+			logging.NewField("clientId", clientId.Ptr()),
+		)
+		return identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{}), nil
+	}
+
+	i := identity.NewApiKeyIdentity(r.ApiKeyId, r.UserId, identity.UserType(r.UserType), r.ClientId, m.getApiKeyPermissionNames(r.PermissionIds))
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_ApiKeyAuthenticated,
+		"[identity.identityManager.authenticateByApiKeyId] API key has been authenticated by id",
+		logging.NewField("apiKeyId", r.ApiKeyId),
+		logging.NewField("userId", r.UserId.Ptr()),
+		logging.NewField("clientId", r.ClientId.Ptr()),
+	)
+	return i, nil
+}
+
+// getApiKeyPermissionNames returns the names of the permissions the API key is restricted to,
+// or nil if the key isn't restricted.
+func (m *identityManager) getApiKeyPermissionNames(permissionIds []uint64) []string {
+	if permissionIds == nil {
+		return nil
+	}
+
+	pns := make([]string, 0, len(permissionIds))
+	for _, pid := range permissionIds {
+		// permissions that are missing in identityManager aren't used by the app
+		if p, ok := m.permissionsById[pid]; ok {
+			pns = append(pns, p.Name)
+		}
+	}
+	return pns
+}
+
+// authenticateImpersonatedUser authenticates a user by the impersonation token and a client by the client token, if any.
+// It returns nil if the tokens are invalid.
+func (m *identityManager) authenticateImpersonatedUser(opCtx *actions.OperationContext, impersonationToken, clientToken []byte) (*identity.DefaultIdentity, error) {
+	// the impersonation tokens can't be used by service clients
+	if identity.IsServiceClientToken(clientToken) {
+		m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent,
+			"[identity.identityManager.authenticateImpersonatedUser] impersonation token is used with the service client's token",
+		)
+		return nil, nil
+	}
+
+	r, err := m.impersonationManager.Authenticate(opCtx, string(impersonationToken))
+	if err != nil {
+		msg := "[identity.identityManager.authenticateImpersonatedUser] authenticate an impersonated user"
+		if err2 := errs.Unwrap(err); err2 == nil ||
+			err2.Code() != ierrors.ErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ErrorCodeInvalidUserAuthnToken {
+			return nil, fmt.Errorf("%s: %w", msg, err)
+		}
+		m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+		return nil, nil
+	}
+
+	var clientId nullable.Nullable[uint64]
+	if len(clientToken) > 0 {
+		r2, err := m.authenticationManager.AuthenticateClient(opCtx, clientToken)
+		if err != nil {
+			msg := "[identity.identityManager.authenticateImpersonatedUser] authenticate a client"
+			if err2 := errs.Unwrap(err); err2 == nil ||
+				err2.Code() != ierrors.ErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ErrorCodeInvalidClientAuthnToken {
+				return nil, fmt.Errorf("%s: %w", msg, err)
+			}
+			m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+			return nil, nil
+		}
+		clientId = nullable.NewNullable(r2.ClientId)
+	}
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_ImpersonatedUserAuthenticated,
+		"[identity.identityManager.authenticateImpersonatedUser] impersonated user has been authenticated",
+		logging.NewField("userId", r.UserId),
+		logging.NewField("impersonatorId", r.ImpersonatorId),
+		logging.NewField("clientId", clientId.Ptr()),
+	)
+	return identity.NewImpersonatedIdentity(r.UserId, identity.UserType(r.UserType), r.ImpersonatorId, clientId), nil
+}
+
 func (m *identityManager) Authorize(ctx *actions.OperationContext, user identity.Identity, requiredPermissions []string) (bool, error) {
 	if !m.isInitialized {
 		return false, errors.New("[identity.identityManager.Authorize] identityManager not initialized")
@@ -328,6 +582,8 @@ func (m *identityManager) Authorize(ctx *actions.OperationContext, user identity
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	authorized := false
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_Authorize,
@@ -337,71 +593,146 @@ func (m *identityManager) Authorize(ctx *actions.OperationContext, user identity
 			actions.NewOperationParam("requiredPermissions", requiredPermissions),
 		},
 		func(opCtx *actions.OperationContext) error {
-			rpsLen := len(requiredPermissions)
-			if rpsLen == 0 {
-				return errs.NewError(errs.ErrorCodeInvalidData, "number of required permissions is 0")
+			var err error
+			if authorized, err = m.authorize(opCtx, user, requiredPermissions, nil); err != nil {
+				return fmt.Errorf("[identity.identityManager.Authorize] authorize a user: %w", err)
 			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[identity.identityManager.Authorize] execute an operation: %w", err)
+	}
+	return authorized, nil
+}
 
-			pids := make([]uint64, rpsLen)
-			for i := 0; i < rpsLen; i++ {
-				id, ok := m.permissionIdsByName[requiredPermissions[i]]
-				if !ok {
-					return fmt.Errorf("[identity.identityManager.Authorize] '%s' permission is missing in identityManager", requiredPermissions[i])
-				}
+// AuthorizeResources authorizes a user and checks the required permissions for the specified resources.
+// A permission is granted if it is granted globally or for all the specified resources.
+func (m *identityManager) AuthorizeResources(ctx *actions.OperationContext, user identity.Identity, requiredPermissions []string, resources []*identity.Resource,
+) (bool, error) {
+	if !m.isInitialized {
+		return false, errors.New("[identity.identityManager.AuthorizeResources] identityManager not initialized")
+	}
 
-				pids[i] = id
-			}
+	ctx = ctx.Clone()
+	ctx.UserId = nullable.NewNullable(m.appUserId)
+	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
-			r, err := m.authorizationManager.Authorize(opCtx, user.UserId(), user.ClientId(), pids)
-			if err != nil {
-				msg := "[identity.identityManager.Authorize] authorize a user"
-				if err2 := errs.Unwrap(err); err2 != nil && (err2.Code() == errs.ErrorCodeInvalidOperation || err2.Code() == ierrors.ErrorCodeUserNotFound ||
-					err2.Code() == ierrors.ErrorCodeClientNotFound || err2.Code() == ierrors.ErrorCodePermissionNotGranted) {
-					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
-					return nil
-				}
-				return fmt.Errorf("%s: %w", msg, err)
+	authorized := false
+	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthorizeResources,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", user.UserId().Ptr()),
+			actions.NewOperationParam("clientId", user.ClientId().Ptr()),
+			actions.NewOperationParam("requiredPermissions", requiredPermissions),
+			actions.NewOperationParam("resources", resources),
+		},
+		func(opCtx *actions.OperationContext) error {
+			if len(resources) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of resources is 0")
 			}
 
-			prs := make([]*identity.PermissionWithRoles, len(r.PermissionRoles))
-			for i := 0; i < len(r.PermissionRoles); i++ {
-				item := r.PermissionRoles[i]
-				p, ok := m.permissionsById[item.PermissionId]
-				if !ok {
-					return fmt.Errorf("[identity.identityManager.Authorize] permission (%d) is missing in identityManager", item.PermissionId)
+			rs := make([]*authzmodels.Resource, len(resources))
+			for i := 0; i < len(resources); i++ {
+				r := resources[i]
+				if r == nil {
+					return errs.NewError(errs.ErrorCodeInvalidData, "resource is nil")
 				}
 
-				rs := make([]string, len(item.RoleIds))
-				for j := 0; j < len(item.RoleIds); j++ {
-					r, ok := m.roles[item.RoleIds[j]]
-					if !ok {
-						return fmt.Errorf("[identity.identityManager.Authorize] role (%d) is missing in identityManager", item.RoleIds[j])
-					}
-
-					rs[j] = r.Name
+				tid, ok := m.resourceTypeIds[r.Type]
+				if !ok {
+					return fmt.Errorf("[identity.identityManager.AuthorizeResources] '%s' resource type is missing in identityManager", r.Type)
 				}
 
-				prs[i] = &identity.PermissionWithRoles{PermissionName: p.Name, RoleNames: rs}
+				rs[i] = &authzmodels.Resource{TypeId: tid, Id: r.Id, OwnerId: r.OwnerId}
 			}
 
-			user.SetUserGroup(identity.UserGroup(r.Group))
-			user.AddPermissionRoles(prs)
-			authorized = true
-
-			m.logger.InfoWithEvent(
-				opCtx.CreateLogEntryContext(),
-				events.Identity_UserAuthorized,
-				"[identity.identityManager.Authorize] user has been authorized",
-				logging.NewField("userId", user.UserId().Ptr()),
-				logging.NewField("clientId", user.ClientId().Ptr()),
-				logging.NewField("userGroup", user.UserGroup()),
-				logging.NewField("permissionRoles", prs),
-			)
+			var err error
+			if authorized, err = m.authorize(opCtx, user, requiredPermissions, rs); err != nil {
+				return fmt.Errorf("[identity.identityManager.AuthorizeResources] authorize a user: %w", err)
+			}
 			return nil
 		},
 	)
 	if err != nil {
-		return false, fmt.Errorf("[identity.identityManager.Authorize] execute an operation: %w", err)
+		return false, fmt.Errorf("[identity.identityManager.AuthorizeResources] execute an operation: %w", err)
 	}
 	return authorized, nil
 }
+
+func (m *identityManager) authorize(opCtx *actions.OperationContext, user identity.Identity, requiredPermissions []string, resources []*authzmodels.Resource,
+) (bool, error) {
+	rpsLen := len(requiredPermissions)
+	if rpsLen == 0 {
+		return false, errs.NewError(errs.ErrorCodeInvalidData, "number of required permissions is 0")
+	}
+
+	pids := make([]uint64, rpsLen)
+	for i := 0; i < rpsLen; i++ {
+		id, ok := m.permissionIdsByName[requiredPermissions[i]]
+		if !ok {
+			return false, fmt.Errorf("[identity.identityManager.authorize] '%s' permission is missing in identityManager", requiredPermissions[i])
+		}
+
+		pids[i] = id
+	}
+
+	if !user.IsAllowedByApiKey(requiredPermissions...) {
+		m.logger.WarningWithEvent(
+			opCtx.CreateLogEntryContext(),
+			events.IdentityEvent,
+			"[identity.identityManager.authorize] required permissions are restricted by the API key",
+			logging.NewField("apiKeyId", user.ApiKeyId().Ptr()),
+			logging.NewField("requiredPermissions", requiredPermissions),
+		)
+		return false, nil
+	}
+
+	r, err := m.authorizationManager.Authorize(opCtx, user.UserId(), user.ClientId(), pids, resources)
+	if err != nil {
+		msg := "[identity.identityManager.authorize] authorize a user"
+		if err2 := errs.Unwrap(err); err2 != nil && (err2.Code() == errs.ErrorCodeInvalidOperation || err2.Code() == ierrors.ErrorCodeUserNotFound ||
+			err2.Code() == ierrors.ErrorCodeClientNotFound || err2.Code() == ierrors.ErrorCodePermissionNotGranted) {
+			m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	prs := make([]*identity.PermissionWithRoles, len(r.PermissionRoles))
+	for i := 0; i < len(r.PermissionRoles); i++ {
+		item := r.PermissionRoles[i]
+		p, ok := m.permissionsById[item.PermissionId]
+		if !ok {
+			return false, fmt.Errorf("[identity.identityManager.authorize] permission (%d) is missing in identityManager", item.PermissionId)
+		}
+
+		rs := make([]string, len(item.RoleIds))
+		for j := 0; j < len(item.RoleIds); j++ {
+			r, ok := m.roles[item.RoleIds[j]]
+			if !ok {
+				return false, fmt.Errorf("[identity.identityManager.authorize] role (%d) is missing in identityManager", item.RoleIds[j])
+			}
+
+			rs[j] = r.Name
+		}
+
+		prs[i] = &identity.PermissionWithRoles{PermissionName: p.Name, RoleNames: rs}
+	}
+
+	user.SetUserGroup(identity.UserGroup(r.Group))
+	user.AddPermissionRoles(prs)
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_UserAuthorized,
+		"[identity.identityManager.authorize] user has been authorized",
+		logging.NewField("userId", user.UserId().Ptr()),
+		logging.NewField("clientId", user.ClientId().Ptr()),
+		logging.NewField("userGroup", user.UserGroup()),
+		logging.NewField("permissionRoles", prs),
+		logging.NewField("resources", resources),
+	)
+	return true, nil
+}
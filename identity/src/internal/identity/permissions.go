@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "identity.app.stop"
+	PermissionApp_Stop       = "identity.app.stop"
+	PermissionApp_GetMetrics = "identity.app.getMetrics"
 
 	// Authentication permissions.
 	PermissionAuthentication_CreateUserToken    = "identity.authentication.createUserToken"
@@ -24,15 +25,24 @@ const (
 	PermissionAuthentication_Authenticate       = "identity.authentication.authenticate"
 	PermissionAuthentication_AuthenticateUser   = "identity.authentication.authenticateUser"
 	PermissionAuthentication_AuthenticateClient = "identity.authentication.authenticateClient"
+	// CreateServiceClientToken (exchange of the client credentials of a service client for a token).
+	PermissionAuthentication_CreateServiceClientToken  = "identity.authentication.createServiceClientToken"
+	PermissionAuthentication_AuthenticateServiceClient = "identity.authentication.authenticateServiceClient"
 
 	// Authorization permissions.
-	PermissionAuthorization_Authorize = "identity.authorization.authorize"
+	PermissionAuthorization_Authorize     = "identity.authorization.authorize"
+	PermissionAuthorization_GetCacheStats = "identity.authorization.getCacheStats"
+	PermissionAuthorization_Explain       = "identity.authorization.explain"
+	// GetAllRoleAssignmentsByPermissionId.
+	PermissionAuthorization_GetAllRoleAssignmentsByPermission = "identity.authorization.getAllRoleAssignmentsByPermission"
 
 	// Client permissions.
 	//
-	// CreateWebClient, CreateMobileClient.
+	// CreateWebClient, CreateMobileClient, CreateServiceClient.
 	PermissionClient_Create = "identity.clients.create"
 	PermissionClient_Delete = "identity.clients.delete"
+	// RotateServiceClientSecret.
+	PermissionClient_RotateSecret = "identity.clients.rotateSecret"
 	// GetById.
 	PermissionClient_Get = "identity.clients.get"
 	// GetTypeById.
@@ -91,6 +101,8 @@ const (
 	// Role assignment permissions.
 	PermissionRoleAssignment_Create = "identity.roleAssignments.create"
 	PermissionRoleAssignment_Delete = "identity.roleAssignments.delete"
+	PermissionRoleAssignment_Extend = "identity.roleAssignments.extend"
+	PermissionRoleAssignment_Revoke = "identity.roleAssignments.revoke"
 	// GetById, GetByRoleIdAndAssignee.
 	PermissionRoleAssignment_Get        = "identity.roleAssignments.get"
 	PermissionRoleAssignment_Exists     = "identity.roleAssignments.exists"
@@ -101,6 +113,8 @@ const (
 	PermissionRoleAssignment_GetStatus = "identity.roleAssignments.getStatus"
 	// GetRoleIdAndAssigneeById.
 	PermissionRoleAssignment_GetRoleIdAndAssignee = "identity.roleAssignments.getRoleIdAndAssignee"
+	// GetAllExpiringWithin.
+	PermissionRoleAssignment_GetAllExpiring = "identity.roleAssignments.getAllExpiring"
 
 	// User role assignment permissions.
 	//
@@ -218,18 +232,135 @@ const (
 	//
 	// GetByUserId.
 	PermissionUserPersonalInfo_Get = "identity.userPersonalInfo.get"
+
+	// Permissions of users' credentials.
+	//
+	// SetPassword (reset of the user's password by the administrator).
+	PermissionUserCredential_SetPassword    = "identity.userCredentials.setPassword"
+	PermissionUserCredential_ChangePassword = "identity.userCredentials.changePassword"
+	// SignInWithPassword.
+	PermissionUserCredential_SignIn = "identity.userCredentials.signIn"
+
+	// Lockout permissions (users, clients and user agents).
+	//
+	// GetInfo.
+	PermissionLockout_Get    = "identity.lockouts.get"
+	PermissionLockout_Unlock = "identity.lockouts.unlock"
+
+	// Permissions of users' MFA.
+	//
+	// StartTotpEnrollment, ConfirmTotpEnrollment, RegenerateRecoveryCodes (users can only enroll themselves).
+	PermissionUserMfa_Enroll = "identity.userMfa.enroll"
+	// DisableTotp (reset of the user's MFA by the administrator).
+	PermissionUserMfa_Disable = "identity.userMfa.disable"
+	// GetStatus.
+	PermissionUserMfa_Get = "identity.userMfa.get"
+
+	// Permissions of users' active sessions (users can only manage their own sessions).
+	//
+	// GetAllByUserId.
+	PermissionActiveSession_Get = "identity.activeSessions.get"
+	// Revoke, RevokeAllOther.
+	PermissionActiveSession_Revoke = "identity.activeSessions.revoke"
+
+	// Registration permissions.
+	//
+	// Register, VerifyEmail, ResendVerificationEmail.
+	PermissionRegistration_Register = "identity.registration.register"
+	// Approve.
+	PermissionRegistration_Approve = "identity.registration.approve"
+
+	// Role inheritance permissions.
+	//
+	// AddParent, RemoveParent.
+	PermissionRoleInheritance_Update = "identity.roleInheritance.update"
+	// GetParentRoleIds, GetAllAncestorRoleIds.
+	PermissionRoleInheritance_Get = "identity.roleInheritance.get"
+
+	// User group permissions.
+	//
+	// Create.
+	PermissionUserGroup_Create = "identity.userGroups.create"
+	// Update.
+	PermissionUserGroup_Update = "identity.userGroups.update"
+	// Delete.
+	PermissionUserGroup_Delete = "identity.userGroups.delete"
+	// GetById, GetByName, GetAll, Exists.
+	PermissionUserGroup_Get = "identity.userGroups.get"
+
+	// User group member permissions.
+	//
+	// Add, Remove.
+	PermissionUserGroupMember_Update = "identity.userGroupMembers.update"
+	// GetAllByGroupId, GetAllGroupIdsByUserId, IsMember.
+	PermissionUserGroupMember_Get = "identity.userGroupMembers.get"
+
+	// Resource type permissions.
+	//
+	// Register.
+	PermissionResourceType_Register = "identity.resourceTypes.register"
+	// GetById, GetAll.
+	PermissionResourceType_Get = "identity.resourceTypes.get"
+
+	// Resource role assignment permissions.
+	//
+	// Create.
+	PermissionResourceRoleAssignment_Create = "identity.resourceRoleAssignments.create"
+	// Delete.
+	PermissionResourceRoleAssignment_Delete = "identity.resourceRoleAssignments.delete"
+	// GetById, GetAllByAssignee.
+	PermissionResourceRoleAssignment_Get = "identity.resourceRoleAssignments.get"
+
+	// Impersonation permissions.
+	//
+	// CreateToken, RevokeToken.
+	PermissionImpersonation_Impersonate = "identity.impersonation.impersonate"
+	// Authenticate.
+	PermissionImpersonation_Authenticate = "identity.impersonation.authenticate"
+
+	// Data subject request permissions.
+	//
+	// CreateExport, CreateErasure.
+	PermissionDataSubjectRequest_Create = "identity.dataSubjectRequests.create"
+	// GetById, GetAllByUserId.
+	PermissionDataSubjectRequest_Get = "identity.dataSubjectRequests.get"
+	// GetExportData.
+	PermissionDataSubjectRequest_GetExportData = "identity.dataSubjectRequests.getExportData"
+
+	// Provisioning permissions.
+	//
+	// Plan.
+	PermissionProvisioning_Plan = "identity.provisioning.plan"
+	// Apply.
+	PermissionProvisioning_Apply = "identity.provisioning.apply"
+
+	// API key permissions.
+	//
+	// CreatePersonal, RevokePersonal, GetAllPersonal.
+	PermissionApiKey_ManagePersonal = "identity.apiKeys.managePersonal"
+	// CreateService, Revoke, GetAllByUserId, GetAllByClientId.
+	PermissionApiKey_Manage = "identity.apiKeys.manage"
+	// Authenticate.
+	PermissionApiKey_Authenticate = "identity.apiKeys.authenticate"
 )
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionAuthentication_CreateUserToken,
 	PermissionAuthentication_CreateClientToken,
 	PermissionAuthentication_Authenticate,
 	PermissionAuthentication_AuthenticateUser,
 	PermissionAuthentication_AuthenticateClient,
+	PermissionAuthentication_CreateServiceClientToken,
+	PermissionAuthentication_AuthenticateServiceClient,
 	PermissionAuthorization_Authorize,
+	PermissionAuthorization_GetCacheStats,
+	PermissionAuthorization_Explain,
+	PermissionAuthorization_GetAllRoleAssignmentsByPermission,
 	PermissionClient_Create,
 	PermissionClient_Delete,
+	PermissionClient_RotateSecret,
 	PermissionClient_Get,
 	PermissionClient_GetType,
 	PermissionClient_GetStatus,
@@ -263,12 +394,15 @@ var Permissions = []string{
 	PermissionRole_GetStatus,
 	PermissionRoleAssignment_Create,
 	PermissionRoleAssignment_Delete,
+	PermissionRoleAssignment_Extend,
+	PermissionRoleAssignment_Revoke,
 	PermissionRoleAssignment_Get,
 	PermissionRoleAssignment_Exists,
 	PermissionRoleAssignment_IsAssigned,
 	PermissionRoleAssignment_GetAssigneeType,
 	PermissionRoleAssignment_GetStatus,
 	PermissionRoleAssignment_GetRoleIdAndAssignee,
+	PermissionRoleAssignment_GetAllExpiring,
 	PermissionUserRoleAssignment_Get,
 	PermissionUserRoleAssignment_GetAllBy,
 	PermissionUserRoleAssignment_Exists,
@@ -322,4 +456,39 @@ var Permissions = []string{
 	PermissionUser_GetTypeAndStatus,
 	PermissionUser_GetGroupAndStatus,
 	PermissionUserPersonalInfo_Get,
+	PermissionUserCredential_SetPassword,
+	PermissionUserCredential_ChangePassword,
+	PermissionUserCredential_SignIn,
+	PermissionLockout_Get,
+	PermissionLockout_Unlock,
+	PermissionUserMfa_Enroll,
+	PermissionUserMfa_Disable,
+	PermissionUserMfa_Get,
+	PermissionActiveSession_Get,
+	PermissionActiveSession_Revoke,
+	PermissionRegistration_Register,
+	PermissionRegistration_Approve,
+	PermissionRoleInheritance_Update,
+	PermissionRoleInheritance_Get,
+	PermissionUserGroup_Create,
+	PermissionUserGroup_Update,
+	PermissionUserGroup_Delete,
+	PermissionUserGroup_Get,
+	PermissionUserGroupMember_Update,
+	PermissionUserGroupMember_Get,
+	PermissionResourceType_Register,
+	PermissionResourceType_Get,
+	PermissionResourceRoleAssignment_Create,
+	PermissionResourceRoleAssignment_Delete,
+	PermissionResourceRoleAssignment_Get,
+	PermissionImpersonation_Impersonate,
+	PermissionImpersonation_Authenticate,
+	PermissionDataSubjectRequest_Create,
+	PermissionDataSubjectRequest_Get,
+	PermissionDataSubjectRequest_GetExportData,
+	PermissionProvisioning_Plan,
+	PermissionProvisioning_Apply,
+	PermissionApiKey_ManagePersonal,
+	PermissionApiKey_Manage,
+	PermissionApiKey_Authenticate,
 }
@@ -88,6 +88,57 @@ const (
 	// Roles of users' personal info.
 	RoleUserPersonalInfoAdmin  = "identity.userPersonalInfoAdmin"
 	RoleUserPersonalInfoViewer = "identity.userPersonalInfoViewer"
+
+	// Roles of users' credentials.
+	RoleUserCredentialAdmin = "identity.userCredentialAdmin"
+
+	// The role of services that sign in users and change their passwords
+	// on behalf of users (e.g. website).
+	RoleUserCredentialUser = "identity.userCredentialUser"
+
+	// Lockout roles.
+	RoleLockoutAdmin = "identity.lockoutAdmin"
+
+	// Roles of users' MFA.
+	RoleUserMfaAdmin = "identity.userMfaAdmin"
+
+	// The role of services that enroll users in MFA on behalf of users (e.g. website).
+	RoleUserMfaUser = "identity.userMfaUser"
+
+	// The role of services that manage active sessions of users on behalf of users (e.g. website).
+	RoleActiveSessionUser = "identity.activeSessionUser"
+
+	// Registration roles.
+	RoleRegistrationAdmin = "identity.registrationAdmin"
+
+	// The role of services that register users on behalf of users (e.g. website).
+	RoleRegistrationUser = "identity.registrationUser"
+
+	// Role inheritance roles.
+	RoleRoleInheritanceAdmin  = "identity.roleInheritanceAdmin"
+	RoleRoleInheritanceViewer = "identity.roleInheritanceViewer"
+
+	// User group roles.
+	RoleUserGroupAdmin  = "identity.userGroupAdmin"
+	RoleUserGroupViewer = "identity.userGroupViewer"
+
+	// Resource type roles.
+	RoleResourceTypeAdmin  = "identity.resourceTypeAdmin"
+	RoleResourceTypeViewer = "identity.resourceTypeViewer"
+
+	// Resource role assignment roles.
+	RoleResourceRoleAssignmentAdmin  = "identity.resourceRoleAssignmentAdmin"
+	RoleResourceRoleAssignmentViewer = "identity.resourceRoleAssignmentViewer"
+
+	// Impersonation roles.
+	RoleImpersonator = "identity.impersonator"
+
+	// Data subject request roles.
+	RoleDataSubjectRequestAdmin  = "identity.dataSubjectRequestAdmin"
+	RoleDataSubjectRequestViewer = "identity.dataSubjectRequestViewer"
+
+	// Provisioning roles.
+	RoleProvisioner = "identity.provisioner"
 )
 
 var Roles = []string{
@@ -132,4 +183,24 @@ var Roles = []string{
 	RoleUserViewer,
 	RoleUserPersonalInfoAdmin,
 	RoleUserPersonalInfoViewer,
+	RoleUserCredentialAdmin,
+	RoleUserCredentialUser,
+	RoleLockoutAdmin,
+	RoleUserMfaAdmin,
+	RoleUserMfaUser,
+	RoleActiveSessionUser,
+	RoleRegistrationAdmin,
+	RoleRegistrationUser,
+	RoleRoleInheritanceAdmin,
+	RoleRoleInheritanceViewer,
+	RoleUserGroupAdmin,
+	RoleUserGroupViewer,
+	RoleResourceTypeAdmin,
+	RoleResourceTypeViewer,
+	RoleResourceRoleAssignmentAdmin,
+	RoleResourceRoleAssignmentViewer,
+	RoleImpersonator,
+	RoleDataSubjectRequestAdmin,
+	RoleDataSubjectRequestViewer,
+	RoleProvisioner,
 }
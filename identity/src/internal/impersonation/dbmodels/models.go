@@ -0,0 +1,40 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+)
+
+// The impersonation token.
+type ImpersonationToken struct {
+	// The unique ID to identify the token.
+	Id uint64 `db:"id"`
+
+	// The ID of the impersonated user.
+	UserId uint64 `db:"user_id"`
+
+	// The ID of the user who impersonates the user.
+	ImpersonatorId uint64 `db:"impersonator_id"`
+
+	// The SHA-256 hash of the token.
+	TokenHash []byte `db:"token_hash"`
+
+	// It stores the date and time at which the token was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the token expires.
+	ExpiresAt time.Time `db:"expires_at"`
+}
@@ -0,0 +1,233 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/impersonation"
+	"personal-website-v2/identity/src/internal/impersonation/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The size of an impersonation token (in bytes), excluding the prefix.
+	impersonationTokenSize = 32
+)
+
+type ImpersonationManagerConfig struct {
+	// The lifetime of an impersonation token.
+	TokenTTL time.Duration
+}
+
+// ImpersonationManager is an impersonation manager.
+type ImpersonationManager struct {
+	opExecutor              *actionhelper.OperationExecutor
+	config                  *ImpersonationManagerConfig
+	userManager             users.UserManager
+	impersonationTokenStore impersonation.ImpersonationTokenStore
+	logger                  logging.Logger[*context.LogEntryContext]
+}
+
+var _ impersonation.ImpersonationManager = (*ImpersonationManager)(nil)
+
+func NewImpersonationManager(
+	config *ImpersonationManagerConfig,
+	userManager users.UserManager,
+	impersonationTokenStore impersonation.ImpersonationTokenStore,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ImpersonationManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.impersonation.manager.ImpersonationManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewImpersonationManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupImpersonation,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewImpersonationManager] new operation executor: %w", err)
+	}
+
+	return &ImpersonationManager{
+		opExecutor:              e,
+		config:                  config,
+		userManager:             userManager,
+		impersonationTokenStore: impersonationTokenStore,
+		logger:                  l,
+	}, nil
+}
+
+// CreateToken creates an impersonation token that allows the current user (impersonator)
+// to act on behalf of the user with the specified ID.
+func (m *ImpersonationManager) CreateToken(ctx *actions.OperationContext, userId uint64) (*models.ImpersonationToken, error) {
+	var t *models.ImpersonationToken
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationManager_CreateToken,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			// an impersonated user can't impersonate another user
+			if !opCtx.UserId.HasValue || opCtx.ImpersonatorId.HasValue {
+				return ierrors.ErrImpersonationNotAllowed
+			}
+
+			b := make([]byte, impersonationTokenSize)
+			if _, err := rand.Read(b); err != nil {
+				return fmt.Errorf("[manager.ImpersonationManager.CreateToken] generate a token: %w", err)
+			}
+
+			// only the hash of the token is stored
+			token := identity.ImpersonationTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+			expiresAt := time.Now().Add(m.config.TokenTTL)
+			id, err := m.impersonationTokenStore.Create(opCtx, userId, opCtx.UserId.Value, hashImpersonationToken(token), m.config.TokenTTL)
+			if err != nil {
+				return fmt.Errorf("[manager.ImpersonationManager.CreateToken] create a token: %w", err)
+			}
+
+			t = &models.ImpersonationToken{
+				Id:        id,
+				Token:     token,
+				ExpiresAt: expiresAt,
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ImpersonationEvent,
+				"[manager.ImpersonationManager.CreateToken] impersonation token has been created",
+				logging.NewField("id", id),
+				logging.NewField("userId", userId),
+				logging.NewField("impersonatorId", opCtx.UserId.Value),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ImpersonationManager.CreateToken] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// Authenticate authenticates the impersonated user by the specified impersonation token.
+func (m *ImpersonationManager) Authenticate(ctx *actions.OperationContext, token string) (*models.AuthenticationResult, error) {
+	var r *models.AuthenticationResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationManager_Authenticate, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			if !strings.HasPrefix(token, identity.ImpersonationTokenPrefix) {
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			t, err := m.impersonationTokenStore.FindByTokenHash(opCtx, hashImpersonationToken(token))
+			if err != nil {
+				return fmt.Errorf("[manager.ImpersonationManager.Authenticate] find a token by token hash: %w", err)
+			}
+
+			if t == nil || !t.ExpiresAt.After(time.Now()) {
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			// the impersonator must still be an active superuser
+			g, s, err := m.userManager.GetGroupAndStatusById(opCtx, t.ImpersonatorId)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserNotFound {
+					return fmt.Errorf("[manager.ImpersonationManager.Authenticate] get a group and a status of the impersonator by id: %w", err)
+				}
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			if g != groupmodels.UserGroupSuperusers || s != usermodels.UserStatusActive {
+				m.logger.WarningWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.ImpersonationEvent,
+					"[manager.ImpersonationManager.Authenticate] invalid impersonator's group or status",
+					logging.NewField("id", t.Id),
+					logging.NewField("impersonatorId", t.ImpersonatorId),
+					logging.NewField("group", g),
+					logging.NewField("status", s),
+				)
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			ut, s, err := m.userManager.GetTypeAndStatusById(opCtx, t.UserId)
+			if err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserNotFound {
+					return fmt.Errorf("[manager.ImpersonationManager.Authenticate] get a type and a status of the user by id: %w", err)
+				}
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			if s != usermodels.UserStatusActive {
+				return ierrors.ErrInvalidUserAuthnToken
+			}
+
+			r = &models.AuthenticationResult{
+				UserId:         t.UserId,
+				UserType:       ut,
+				ImpersonatorId: t.ImpersonatorId,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ImpersonationManager.Authenticate] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// RevokeToken revokes an impersonation token by the specified token ID.
+func (m *ImpersonationManager) RevokeToken(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationManager_RevokeToken, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.impersonationTokenStore.Delete(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ImpersonationManager.RevokeToken] delete a token: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ImpersonationEvent,
+				"[manager.ImpersonationManager.RevokeToken] impersonation token has been revoked",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ImpersonationManager.RevokeToken] execute an operation: %w", err)
+	}
+	return nil
+}
+
+func hashImpersonationToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
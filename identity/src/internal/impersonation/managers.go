@@ -0,0 +1,32 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impersonation
+
+import (
+	"personal-website-v2/identity/src/internal/impersonation/models"
+	"personal-website-v2/pkg/actions"
+)
+
+type ImpersonationManager interface {
+	// CreateToken creates an impersonation token that allows the current user (impersonator)
+	// to act on behalf of the user with the specified ID.
+	CreateToken(ctx *actions.OperationContext, userId uint64) (*models.ImpersonationToken, error)
+
+	// Authenticate authenticates the impersonated user by the specified impersonation token.
+	Authenticate(ctx *actions.OperationContext, token string) (*models.AuthenticationResult, error)
+
+	// RevokeToken revokes an impersonation token by the specified token ID.
+	RevokeToken(ctx *actions.OperationContext, id uint64) error
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+)
+
+type ImpersonationToken struct {
+	// The unique ID to identify the token.
+	Id uint64
+
+	// The token that is used to authenticate the impersonated user.
+	Token string
+
+	// The date and time at which the token expires.
+	ExpiresAt time.Time
+}
+
+type AuthenticationResult struct {
+	// The ID of the impersonated user.
+	UserId uint64
+
+	// The type of the impersonated user.
+	UserType usermodels.UserType
+
+	// The ID of the user who impersonates the user.
+	ImpersonatorId uint64
+}
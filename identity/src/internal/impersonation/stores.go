@@ -0,0 +1,33 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impersonation
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/impersonation/dbmodels"
+	"personal-website-v2/pkg/actions"
+)
+
+type ImpersonationTokenStore interface {
+	// Create creates an impersonation token and returns the token ID if the operation is successful.
+	Create(ctx *actions.OperationContext, userId, impersonatorId uint64, tokenHash []byte, ttl time.Duration) (uint64, error)
+
+	// Delete deletes an impersonation token by the specified token ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// FindByTokenHash finds and returns an impersonation token, if any, by the specified token hash.
+	FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.ImpersonationToken, error)
+}
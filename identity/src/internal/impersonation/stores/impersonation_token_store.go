@@ -0,0 +1,180 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/impersonation"
+	"personal-website-v2/identity/src/internal/impersonation/dbmodels"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	impersonationTokensTable = "public.impersonation_tokens"
+)
+
+// ImpersonationTokenStore is a store of impersonation tokens.
+type ImpersonationTokenStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ImpersonationToken]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ impersonation.ImpersonationTokenStore = (*ImpersonationTokenStore)(nil)
+
+func NewImpersonationTokenStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ImpersonationTokenStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.impersonation.stores.ImpersonationTokenStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewImpersonationTokenStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupImpersonation,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewImpersonationTokenStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewImpersonationTokenStore] new TxManager: %w", err)
+	}
+
+	return &ImpersonationTokenStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ImpersonationToken](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates an impersonation token and returns the token ID if the operation is successful.
+func (s *ImpersonationTokenStore) Create(ctx *actions.OperationContext, userId, impersonatorId uint64, tokenHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationTokenStore_Create,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId),
+			actions.NewOperationParam("impersonatorId", impersonatorId),
+			actions.NewOperationParam("ttl", ttl),
+		},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_impersonation_token(IN _user_id, IN _impersonator_id, IN _token_hash, IN _ttl, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_impersonation_token($1, $2, $3, $4, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, impersonatorId, tokenHash, ttl).Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ImpersonationTokenStore.Create] execute a query (create_impersonation_token): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				case idberrors.DbErrorCodeImpersonationNotAllowed:
+					return errs.NewError(ierrors.ErrorCodeImpersonationNotAllowed, errMsg)
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ImpersonationTokenStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ImpersonationTokenStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ImpersonationTokenStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Delete deletes an impersonation token by the specified token ID.
+func (s *ImpersonationTokenStore) Delete(ctx *actions.OperationContext, id uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationTokenStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_impersonation_token(IN _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_impersonation_token($1, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ImpersonationTokenStore.Delete] execute a query (delete_impersonation_token): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeImpersonationTokenNotFound:
+					return ierrors.ErrImpersonationTokenNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ImpersonationTokenStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ImpersonationTokenStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ImpersonationTokenStore.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindByTokenHash finds and returns an impersonation token, if any, by the specified token hash.
+func (s *ImpersonationTokenStore) FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.ImpersonationToken, error) {
+	var t *dbmodels.ImpersonationToken
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeImpersonationTokenStore_FindByTokenHash, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + impersonationTokensTable + " WHERE token_hash = $1 LIMIT 1"
+			var err error
+			if t, err = s.store.Find(opCtx.Ctx, query, tokenHash); err != nil {
+				return fmt.Errorf("[stores.ImpersonationTokenStore.FindByTokenHash] find a token by token hash: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ImpersonationTokenStore.FindByTokenHash] execute an operation: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import "time"
+
+type Lockout struct {
+	// The user, client or user agent ID.
+	Id uint64 `db:"id"`
+
+	// It stores the date and time at which the lockout info was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the lockout info was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The number of failed attempts within the current failure window.
+	FailedAttempts int `db:"failed_attempts"`
+
+	// Optional. The time of the first failed attempt within the current failure window.
+	FirstFailedAttemptAt *time.Time `db:"first_failed_attempt_at"`
+
+	// Optional. The time of the last failed attempt.
+	LastFailedAttemptAt *time.Time `db:"last_failed_attempt_at"`
+
+	// The number of consecutive lockouts.
+	LockoutCount int `db:"lockout_count"`
+
+	// Optional. The time of the last lockout.
+	LockedOutAt *time.Time `db:"locked_out_at"`
+
+	// Optional. The time until which the user, client or user agent is temporarily locked out.
+	LockedUntil *time.Time `db:"locked_until"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
@@ -0,0 +1,472 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/clients"
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
+	"personal-website-v2/identity/src/internal/lockouts"
+	"personal-website-v2/identity/src/internal/lockouts/dbmodels"
+	"personal-website-v2/identity/src/internal/lockouts/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/useragents"
+	useragentmodels "personal-website-v2/identity/src/internal/useragents/models"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The maximum number of users, clients or user agents unlocked in one batch.
+	unlockBatchSize = 100
+)
+
+type LockoutManagerConfig struct {
+	// Optional. The lockout policy of users. If it is nil, users aren't locked out.
+	Users *models.LockoutPolicy
+
+	// Optional. The lockout policy of clients. If it is nil, clients aren't locked out.
+	Clients *models.LockoutPolicy
+
+	// Optional. The lockout policy of user agents. If it is nil, user agents aren't locked out.
+	UserAgents *models.LockoutPolicy
+}
+
+// LockoutManager is a manager of lockouts of users, clients and user agents.
+type LockoutManager struct {
+	opExecutor                  *actionhelper.OperationExecutor
+	config                      *LockoutManagerConfig
+	userLockoutStore            lockouts.LockoutStore
+	webClientLockoutStore       lockouts.LockoutStore
+	mobileClientLockoutStore    lockouts.LockoutStore
+	webUserAgentLockoutStore    lockouts.LockoutStore
+	mobileUserAgentLockoutStore lockouts.LockoutStore
+	userManager                 users.UserManager
+	clientManager               clients.ClientManager
+	userAgentManager            useragents.UserAgentManager
+	authzCacheInvalidator       authorization.AuthorizationCacheInvalidator
+	logger                      logging.Logger[*context.LogEntryContext]
+}
+
+var _ lockouts.LockoutManager = (*LockoutManager)(nil)
+
+func NewLockoutManager(
+	config *LockoutManagerConfig,
+	userLockoutStore lockouts.LockoutStore,
+	webClientLockoutStore lockouts.LockoutStore,
+	mobileClientLockoutStore lockouts.LockoutStore,
+	webUserAgentLockoutStore lockouts.LockoutStore,
+	mobileUserAgentLockoutStore lockouts.LockoutStore,
+	userManager users.UserManager,
+	clientManager clients.ClientManager,
+	userAgentManager useragents.UserAgentManager,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*LockoutManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.lockouts.manager.LockoutManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewLockoutManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupLockout,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewLockoutManager] new operation executor: %w", err)
+	}
+
+	return &LockoutManager{
+		opExecutor:                  e,
+		config:                      config,
+		userLockoutStore:            userLockoutStore,
+		webClientLockoutStore:       webClientLockoutStore,
+		mobileClientLockoutStore:    mobileClientLockoutStore,
+		webUserAgentLockoutStore:    webUserAgentLockoutStore,
+		mobileUserAgentLockoutStore: mobileUserAgentLockoutStore,
+		userManager:                 userManager,
+		clientManager:               clientManager,
+		userAgentManager:            userAgentManager,
+		authzCacheInvalidator:       authzCacheInvalidator,
+		logger:                      l,
+	}, nil
+}
+
+// RegisterFailedAttempt registers a failed attempt (e.g. a failed authentication) of the user,
+// client or user agent by the specified ID, locks it out according to the lockout policy,
+// and returns the result of the failed attempt.
+func (m *LockoutManager) RegisterFailedAttempt(ctx *actions.OperationContext, t models.TargetType, id uint64) (*models.FailedAttemptResult, error) {
+	var r *models.FailedAttemptResult
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_RegisterFailedAttempt,
+		[]*actions.OperationParam{actions.NewOperationParam("targetType", t), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			p, err := m.getPolicy(t)
+			if err != nil {
+				return fmt.Errorf("[manager.LockoutManager.RegisterFailedAttempt] get a lockout policy: %w", err)
+			}
+
+			if p == nil {
+				// lockouts are disabled
+				r = &models.FailedAttemptResult{Status: models.LockoutStatusNotLockedOut}
+				return nil
+			}
+
+			s, err := m.getStore(t, id)
+			if err != nil {
+				return fmt.Errorf("[manager.LockoutManager.RegisterFailedAttempt] get a lockout store: %w", err)
+			} else if s == nil {
+				// lockouts aren't supported
+				r = &models.FailedAttemptResult{Status: models.LockoutStatusNotLockedOut}
+				return nil
+			}
+
+			if r, err = s.RegisterFailedAttempt(opCtx, id, p); err != nil {
+				return fmt.Errorf("[manager.LockoutManager.RegisterFailedAttempt] register a failed attempt of the %s: %w", t, err)
+			}
+
+			if !r.IsLockedOut {
+				return nil
+			}
+
+			if t == models.TargetTypeUser {
+				m.invalidateUser(opCtx, id)
+			}
+
+			m.logger.WarningWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.LockoutEvent,
+				fmt.Sprintf("[manager.LockoutManager.RegisterFailedAttempt] %s has been locked out", t),
+				logging.NewField("targetType", t),
+				logging.NewField("id", id),
+				logging.NewField("status", r.Status),
+				logging.NewField("lockedUntil", r.LockedUntil),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.LockoutManager.RegisterFailedAttempt] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// ResetFailedAttempts resets the failed attempts and the number of lockouts of the user,
+// client or user agent by the specified ID (e.g. after a successful authentication).
+func (m *LockoutManager) ResetFailedAttempts(ctx *actions.OperationContext, t models.TargetType, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_ResetFailedAttempts,
+		[]*actions.OperationParam{actions.NewOperationParam("targetType", t), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if p, err := m.getPolicy(t); err != nil {
+				return fmt.Errorf("[manager.LockoutManager.ResetFailedAttempts] get a lockout policy: %w", err)
+			} else if p == nil {
+				// lockouts are disabled
+				return nil
+			}
+
+			s, err := m.getStore(t, id)
+			if err != nil {
+				return fmt.Errorf("[manager.LockoutManager.ResetFailedAttempts] get a lockout store: %w", err)
+			} else if s == nil {
+				// lockouts aren't supported
+				return nil
+			}
+
+			if err = s.ResetFailedAttempts(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.LockoutManager.ResetFailedAttempts] reset failed attempts of the %s: %w", t, err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.LockoutManager.ResetFailedAttempts] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Unlock unlocks the locked out user, client or user agent by the specified ID
+// and returns true if it has been unlocked.
+func (m *LockoutManager) Unlock(ctx *actions.OperationContext, t models.TargetType, id uint64) (bool, error) {
+	var unlocked bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_Unlock,
+		[]*actions.OperationParam{actions.NewOperationParam("targetType", t), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if unlocked, err = m.unlock(opCtx, t, id, false); err != nil {
+				return fmt.Errorf("[manager.LockoutManager.Unlock] unlock the %s: %w", t, err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.LockoutManager.Unlock] execute an operation: %w", err)
+	}
+	return unlocked, nil
+}
+
+// UnlockIfExpired unlocks the user, client or user agent by the specified ID if its temporary lockout
+// has expired and returns true if it has been unlocked.
+func (m *LockoutManager) UnlockIfExpired(ctx *actions.OperationContext, t models.TargetType, id uint64) (bool, error) {
+	var unlocked bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_UnlockIfExpired,
+		[]*actions.OperationParam{actions.NewOperationParam("targetType", t), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if unlocked, err = m.unlock(opCtx, t, id, true); err != nil {
+				return fmt.Errorf("[manager.LockoutManager.UnlockIfExpired] unlock the %s: %w", t, err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.LockoutManager.UnlockIfExpired] execute an operation: %w", err)
+	}
+	return unlocked, nil
+}
+
+func (m *LockoutManager) unlock(ctx *actions.OperationContext, t models.TargetType, id uint64, onlyIfExpired bool) (bool, error) {
+	s, err := m.getStore(t, id)
+	if err != nil {
+		return false, fmt.Errorf("[manager.LockoutManager.unlock] get a lockout store: %w", err)
+	} else if s == nil {
+		// lockouts aren't supported
+		return false, nil
+	}
+
+	unlocked, err := s.Unlock(ctx, id, onlyIfExpired)
+	if err != nil {
+		return false, fmt.Errorf("[manager.LockoutManager.unlock] unlock the %s: %w", t, err)
+	}
+
+	if !unlocked {
+		return false, nil
+	}
+
+	if t == models.TargetTypeUser {
+		m.invalidateUser(ctx, id)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.LockoutEvent,
+		fmt.Sprintf("[manager.LockoutManager.unlock] %s has been unlocked", t),
+		logging.NewField("targetType", t),
+		logging.NewField("id", id),
+		logging.NewField("onlyIfExpired", onlyIfExpired),
+	)
+	return true, nil
+}
+
+// UnlockAllExpired unlocks all users, clients and user agents whose temporary lockouts have expired
+// and returns the number of unlocked ones.
+func (m *LockoutManager) UnlockAllExpired(ctx *actions.OperationContext) (int, error) {
+	var n int
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_UnlockAllExpired, nil,
+		func(opCtx *actions.OperationContext) error {
+			ss := []struct {
+				t models.TargetType
+				s lockouts.LockoutStore
+			}{
+				{models.TargetTypeUser, m.userLockoutStore},
+				{models.TargetTypeClient, m.webClientLockoutStore},
+				{models.TargetTypeClient, m.mobileClientLockoutStore},
+				{models.TargetTypeUserAgent, m.webUserAgentLockoutStore},
+				{models.TargetTypeUserAgent, m.mobileUserAgentLockoutStore},
+			}
+
+			for _, s := range ss {
+				for {
+					ids, err := s.s.UnlockAllExpired(opCtx, unlockBatchSize)
+					if err != nil {
+						return fmt.Errorf("[manager.LockoutManager.UnlockAllExpired] unlock %ss whose lockouts have expired: %w", s.t, err)
+					}
+
+					if len(ids) > 0 {
+						if s.t == models.TargetTypeUser {
+							for _, id := range ids {
+								m.invalidateUser(opCtx, id)
+							}
+						}
+
+						m.logger.InfoWithEvent(
+							opCtx.CreateLogEntryContext(),
+							events.LockoutEvent,
+							fmt.Sprintf("[manager.LockoutManager.UnlockAllExpired] %ss have been unlocked", s.t),
+							logging.NewField("targetType", s.t),
+							logging.NewField("ids", ids),
+						)
+					}
+
+					n += len(ids)
+					if len(ids) < unlockBatchSize {
+						break
+					}
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return n, fmt.Errorf("[manager.LockoutManager.UnlockAllExpired] execute an operation: %w", err)
+	}
+	return n, nil
+}
+
+// GetInfo gets lockout info of the user, client or user agent by the specified ID.
+func (m *LockoutManager) GetInfo(ctx *actions.OperationContext, t models.TargetType, id uint64) (*models.LockoutInfo, error) {
+	var info *models.LockoutInfo
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeLockoutManager_GetInfo,
+		[]*actions.OperationParam{actions.NewOperationParam("targetType", t), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var status models.LockoutStatus
+			switch t {
+			case models.TargetTypeUser:
+				s, err := m.userManager.GetStatusById(opCtx, id)
+				if err != nil {
+					return fmt.Errorf("[manager.LockoutManager.GetInfo] get a user's status by id: %w", err)
+				}
+				status = toLockoutStatus(s, usermodels.UserStatusLockedOut, usermodels.UserStatusTemporarilyLockedOut)
+			case models.TargetTypeClient:
+				s, err := m.clientManager.GetStatusById(opCtx, id)
+				if err != nil {
+					return fmt.Errorf("[manager.LockoutManager.GetInfo] get a client status by id: %w", err)
+				}
+				status = toLockoutStatus(s, clientmodels.ClientStatusLockedOut, clientmodels.ClientStatusTemporarilyLockedOut)
+			case models.TargetTypeUserAgent:
+				s, err := m.userAgentManager.GetStatusById(opCtx, id)
+				if err != nil {
+					return fmt.Errorf("[manager.LockoutManager.GetInfo] get a user agent status by id: %w", err)
+				}
+				status = toLockoutStatus(s, useragentmodels.UserAgentStatusLockedOut, useragentmodels.UserAgentStatusTemporarilyLockedOut)
+			default:
+				return fmt.Errorf("[manager.LockoutManager.GetInfo] '%s' target type isn't supported", t)
+			}
+
+			s, err := m.getStore(t, id)
+			if err != nil {
+				return fmt.Errorf("[manager.LockoutManager.GetInfo] get a lockout store: %w", err)
+			}
+
+			var l *dbmodels.Lockout
+			// if s is nil, then lockouts aren't supported
+			if s != nil {
+				if l, err = s.FindById(opCtx, id); err != nil {
+					return fmt.Errorf("[manager.LockoutManager.GetInfo] find a lockout of the %s by id: %w", t, err)
+				}
+			}
+
+			info = &models.LockoutInfo{
+				TargetType: t,
+				TargetId:   id,
+				Status:     status,
+			}
+
+			if l != nil {
+				info.FailedAttempts = l.FailedAttempts
+				info.FirstFailedAttemptAt = nullable.FromPtr(l.FirstFailedAttemptAt)
+				info.LastFailedAttemptAt = nullable.FromPtr(l.LastFailedAttemptAt)
+				info.LockoutCount = l.LockoutCount
+				info.LockedOutAt = nullable.FromPtr(l.LockedOutAt)
+				info.LockedUntil = nullable.FromPtr(l.LockedUntil)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.LockoutManager.GetInfo] execute an operation: %w", err)
+	}
+	return info, nil
+}
+
+func (m *LockoutManager) getPolicy(t models.TargetType) (*models.LockoutPolicy, error) {
+	switch t {
+	case models.TargetTypeUser:
+		return m.config.Users, nil
+	case models.TargetTypeClient:
+		return m.config.Clients, nil
+	case models.TargetTypeUserAgent:
+		return m.config.UserAgents, nil
+	}
+	return nil, fmt.Errorf("[manager.LockoutManager.getPolicy] '%s' target type isn't supported", t)
+}
+
+// getStore returns the lockout store of the user, client or user agent by the specified ID.
+// It returns nil and no error if lockouts of it aren't supported (e.g. service clients,
+// which authenticate with client credentials, not by signing in).
+func (m *LockoutManager) getStore(t models.TargetType, id uint64) (lockouts.LockoutStore, error) {
+	switch t {
+	case models.TargetTypeUser:
+		return m.userLockoutStore, nil
+	case models.TargetTypeClient:
+		ct, err := m.clientManager.GetTypeById(id)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.LockoutManager.getStore] get a client type by id: %w", err)
+		}
+
+		switch ct {
+		case clientmodels.ClientTypeWeb:
+			return m.webClientLockoutStore, nil
+		case clientmodels.ClientTypeMobile:
+			return m.mobileClientLockoutStore, nil
+		case clientmodels.ClientTypeService:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("[manager.LockoutManager.getStore] '%s' client type isn't supported", ct)
+	case models.TargetTypeUserAgent:
+		uat, err := m.userAgentManager.GetTypeById(id)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.LockoutManager.getStore] get a user agent type by id: %w", err)
+		}
+
+		switch uat {
+		case useragentmodels.UserAgentTypeWeb:
+			return m.webUserAgentLockoutStore, nil
+		case useragentmodels.UserAgentTypeMobile:
+			return m.mobileUserAgentLockoutStore, nil
+		}
+		return nil, fmt.Errorf("[manager.LockoutManager.getStore] '%s' user agent type isn't supported", uat)
+	}
+	return nil, fmt.Errorf("[manager.LockoutManager.getStore] '%s' target type isn't supported", t)
+}
+
+// invalidateUser invalidates the cached status of the user.
+func (m *LockoutManager) invalidateUser(ctx *actions.OperationContext, userId uint64) {
+	if err := m.authzCacheInvalidator.InvalidateUser(ctx, userId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.LockoutEvent, err,
+			"[manager.LockoutManager.invalidateUser] invalidate the authorization cache",
+			logging.NewField("userId", userId),
+		)
+	}
+}
+
+func toLockoutStatus[T ~uint8](status, lockedOut, temporarilyLockedOut T) models.LockoutStatus {
+	switch status {
+	case lockedOut:
+		return models.LockoutStatusLockedOut
+	case temporarilyLockedOut:
+		return models.LockoutStatusTemporarilyLockedOut
+	}
+	return models.LockoutStatusNotLockedOut
+}
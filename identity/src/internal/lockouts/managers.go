@@ -0,0 +1,46 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockouts
+
+import (
+	"personal-website-v2/identity/src/internal/lockouts/models"
+	"personal-website-v2/pkg/actions"
+)
+
+type LockoutManager interface {
+	// RegisterFailedAttempt registers a failed attempt (e.g. a failed authentication) of the user,
+	// client or user agent by the specified ID, locks it out according to the lockout policy,
+	// and returns the result of the failed attempt.
+	RegisterFailedAttempt(ctx *actions.OperationContext, t models.TargetType, id uint64) (*models.FailedAttemptResult, error)
+
+	// ResetFailedAttempts resets the failed attempts and the number of lockouts of the user,
+	// client or user agent by the specified ID (e.g. after a successful authentication).
+	ResetFailedAttempts(ctx *actions.OperationContext, t models.TargetType, id uint64) error
+
+	// Unlock unlocks the locked out user, client or user agent by the specified ID
+	// and returns true if it has been unlocked.
+	Unlock(ctx *actions.OperationContext, t models.TargetType, id uint64) (bool, error)
+
+	// UnlockIfExpired unlocks the user, client or user agent by the specified ID if its temporary lockout
+	// has expired and returns true if it has been unlocked.
+	UnlockIfExpired(ctx *actions.OperationContext, t models.TargetType, id uint64) (bool, error)
+
+	// UnlockAllExpired unlocks all users, clients and user agents whose temporary lockouts have expired
+	// and returns the number of unlocked ones.
+	UnlockAllExpired(ctx *actions.OperationContext) (int, error)
+
+	// GetInfo gets lockout info of the user, client or user agent by the specified ID.
+	GetInfo(ctx *actions.OperationContext, t models.TargetType, id uint64) (*models.LockoutInfo, error)
+}
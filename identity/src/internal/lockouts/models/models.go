@@ -0,0 +1,121 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"personal-website-v2/pkg/base/nullable"
+)
+
+// The lockout target type.
+type TargetType uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	TargetTypeUser      TargetType = 1
+	TargetTypeClient    TargetType = 2
+	TargetTypeUserAgent TargetType = 3
+)
+
+func (t TargetType) IsValid() bool {
+	return t == TargetTypeUser || t == TargetTypeClient || t == TargetTypeUserAgent
+}
+
+func (t TargetType) String() string {
+	switch t {
+	case TargetTypeUser:
+		return "user"
+	case TargetTypeClient:
+		return "client"
+	case TargetTypeUserAgent:
+		return "user agent"
+	}
+	return fmt.Sprintf("TargetType(%d)", t)
+}
+
+// The lockout status.
+type LockoutStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	LockoutStatusNotLockedOut LockoutStatus = 1
+
+	// The target is locked out until the lockout expires.
+	LockoutStatusTemporarilyLockedOut LockoutStatus = 2
+
+	// The target is locked out until it is unlocked by the administrator.
+	LockoutStatusLockedOut LockoutStatus = 3
+)
+
+// LockoutPolicy is a lockout policy of the users, clients or user agents.
+type LockoutPolicy struct {
+	// The number of failed attempts within the failure window after which
+	// the target is locked out.
+	MaxFailedAttempts int
+
+	// The period during which failed attempts are counted.
+	FailureWindow time.Duration
+
+	// The duration of a temporary lockout.
+	LockoutDuration time.Duration
+
+	// The number of consecutive temporary lockouts after which the target is locked out
+	// until it is unlocked by the administrator. If it is 0, the target is only locked out temporarily.
+	MaxTemporaryLockouts int
+}
+
+type FailedAttemptResult struct {
+	// The lockout status.
+	Status LockoutStatus
+
+	// True if the target has been locked out as a result of the failed attempt.
+	IsLockedOut bool
+
+	// Optional. The time until which the target is temporarily locked out.
+	LockedUntil nullable.Nullable[time.Time]
+}
+
+type LockoutInfo struct {
+	// The lockout target type.
+	TargetType TargetType
+
+	// The target ID (user, client or user agent ID).
+	TargetId uint64
+
+	// The lockout status.
+	Status LockoutStatus
+
+	// The number of failed attempts within the current failure window.
+	FailedAttempts int
+
+	// Optional. The time of the first failed attempt within the current failure window.
+	FirstFailedAttemptAt nullable.Nullable[time.Time]
+
+	// Optional. The time of the last failed attempt.
+	LastFailedAttemptAt nullable.Nullable[time.Time]
+
+	// The number of consecutive lockouts.
+	LockoutCount int
+
+	// Optional. The time of the last lockout.
+	LockedOutAt nullable.Nullable[time.Time]
+
+	// Optional. The time until which the target is temporarily locked out.
+	LockedUntil nullable.Nullable[time.Time]
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockouts
+
+import (
+	"personal-website-v2/identity/src/internal/lockouts/dbmodels"
+	"personal-website-v2/identity/src/internal/lockouts/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// LockoutStore is a store of lockouts of users, clients or user agents.
+type LockoutStore interface {
+	// RegisterFailedAttempt registers a failed attempt (e.g. a failed authentication) of the user,
+	// client or user agent by the specified ID, locks it out if the number of failed attempts
+	// reaches the limit of the policy, and returns the result of the failed attempt.
+	RegisterFailedAttempt(ctx *actions.OperationContext, id uint64, policy *models.LockoutPolicy) (*models.FailedAttemptResult, error)
+
+	// ResetFailedAttempts resets the failed attempts and the number of lockouts of the user,
+	// client or user agent by the specified ID.
+	ResetFailedAttempts(ctx *actions.OperationContext, id uint64) error
+
+	// Unlock unlocks the user, client or user agent by the specified ID and returns true
+	// if it has been unlocked. If onlyIfExpired is true, it is only unlocked if its temporary lockout
+	// has expired.
+	Unlock(ctx *actions.OperationContext, id uint64, onlyIfExpired bool) (bool, error)
+
+	// FindById finds and returns lockout info, if any, by the specified user, client or user agent ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.Lockout, error)
+
+	// UnlockAllExpired unlocks up to limit users, clients or user agents whose temporary lockouts
+	// have expired and returns their IDs.
+	UnlockAllExpired(ctx *actions.OperationContext, limit int) ([]uint64, error)
+}
@@ -0,0 +1,405 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/lockouts"
+	"personal-website-v2/identity/src/internal/lockouts/dbmodels"
+	"personal-website-v2/identity/src/internal/lockouts/models"
+	useragentmodels "personal-website-v2/identity/src/internal/useragents/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	opTypeLockoutStore_RegisterFailedAttempt = iota
+	opTypeLockoutStore_ResetFailedAttempts
+	opTypeLockoutStore_Unlock
+	opTypeLockoutStore_FindById
+	opTypeLockoutStore_UnlockAllExpired
+)
+
+var userLockoutStoreOpTypes = []actions.OperationType{
+	opTypeLockoutStore_RegisterFailedAttempt: iactions.OperationTypeUserLockoutStore_RegisterFailedAttempt,
+	opTypeLockoutStore_ResetFailedAttempts:   iactions.OperationTypeUserLockoutStore_ResetFailedAttempts,
+	opTypeLockoutStore_Unlock:                iactions.OperationTypeUserLockoutStore_Unlock,
+	opTypeLockoutStore_FindById:              iactions.OperationTypeUserLockoutStore_FindById,
+	opTypeLockoutStore_UnlockAllExpired:      iactions.OperationTypeUserLockoutStore_UnlockAllExpired,
+}
+
+var webClientLockoutStoreOpTypes = []actions.OperationType{
+	opTypeLockoutStore_RegisterFailedAttempt: iactions.OperationTypeWebClientLockoutStore_RegisterFailedAttempt,
+	opTypeLockoutStore_ResetFailedAttempts:   iactions.OperationTypeWebClientLockoutStore_ResetFailedAttempts,
+	opTypeLockoutStore_Unlock:                iactions.OperationTypeWebClientLockoutStore_Unlock,
+	opTypeLockoutStore_FindById:              iactions.OperationTypeWebClientLockoutStore_FindById,
+	opTypeLockoutStore_UnlockAllExpired:      iactions.OperationTypeWebClientLockoutStore_UnlockAllExpired,
+}
+
+var mobileClientLockoutStoreOpTypes = []actions.OperationType{
+	opTypeLockoutStore_RegisterFailedAttempt: iactions.OperationTypeMobileClientLockoutStore_RegisterFailedAttempt,
+	opTypeLockoutStore_ResetFailedAttempts:   iactions.OperationTypeMobileClientLockoutStore_ResetFailedAttempts,
+	opTypeLockoutStore_Unlock:                iactions.OperationTypeMobileClientLockoutStore_Unlock,
+	opTypeLockoutStore_FindById:              iactions.OperationTypeMobileClientLockoutStore_FindById,
+	opTypeLockoutStore_UnlockAllExpired:      iactions.OperationTypeMobileClientLockoutStore_UnlockAllExpired,
+}
+
+var webUserAgentLockoutStoreOpTypes = []actions.OperationType{
+	opTypeLockoutStore_RegisterFailedAttempt: iactions.OperationTypeWebUserAgentLockoutStore_RegisterFailedAttempt,
+	opTypeLockoutStore_ResetFailedAttempts:   iactions.OperationTypeWebUserAgentLockoutStore_ResetFailedAttempts,
+	opTypeLockoutStore_Unlock:                iactions.OperationTypeWebUserAgentLockoutStore_Unlock,
+	opTypeLockoutStore_FindById:              iactions.OperationTypeWebUserAgentLockoutStore_FindById,
+	opTypeLockoutStore_UnlockAllExpired:      iactions.OperationTypeWebUserAgentLockoutStore_UnlockAllExpired,
+}
+
+var mobileUserAgentLockoutStoreOpTypes = []actions.OperationType{
+	opTypeLockoutStore_RegisterFailedAttempt: iactions.OperationTypeMobileUserAgentLockoutStore_RegisterFailedAttempt,
+	opTypeLockoutStore_ResetFailedAttempts:   iactions.OperationTypeMobileUserAgentLockoutStore_ResetFailedAttempts,
+	opTypeLockoutStore_Unlock:                iactions.OperationTypeMobileUserAgentLockoutStore_Unlock,
+	opTypeLockoutStore_FindById:              iactions.OperationTypeMobileUserAgentLockoutStore_FindById,
+	opTypeLockoutStore_UnlockAllExpired:      iactions.OperationTypeMobileUserAgentLockoutStore_UnlockAllExpired,
+}
+
+const (
+	lockoutsTable = "public.lockouts"
+)
+
+// Statuses of users, clients and user agents.
+// See usermodels.UserStatus, clientmodels.ClientStatus and useragentmodels.UserAgentStatus.
+const (
+	targetStatusLockedOut            = 4
+	targetStatusTemporarilyLockedOut = 5
+)
+
+// LockoutStore is a store of lockouts of users, clients or user agents.
+// The lockouts are stored in the same database as the users, clients or user agents.
+type LockoutStore struct {
+	db            *postgres.Database
+	opExecutor    *actionhelper.OperationExecutor
+	store         *postgres.Store[dbmodels.Lockout]
+	txManager     *postgres.TxManager
+	logger        logging.Logger[*lcontext.LogEntryContext]
+	opTypes       []actions.OperationType
+	targetType    models.TargetType
+	notFoundDbErr dberrors.DbErrorCode
+	notFoundErr   *errors.Error
+}
+
+var _ lockouts.LockoutStore = (*LockoutStore)(nil)
+
+// NewUserLockoutStore returns a store of lockouts of users.
+func NewUserLockoutStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*LockoutStore, error) {
+	s, err := newLockoutStore(models.TargetTypeUser, userLockoutStoreOpTypes, db, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserLockoutStore] new lockout store: %w", err)
+	}
+	return s, nil
+}
+
+// NewClientLockoutStore returns a store of lockouts of clients of the specified type.
+func NewClientLockoutStore(ctype clientmodels.ClientType, db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*LockoutStore, error) {
+	var opTypes []actions.OperationType
+	switch ctype {
+	case clientmodels.ClientTypeWeb:
+		opTypes = webClientLockoutStoreOpTypes
+	case clientmodels.ClientTypeMobile:
+		opTypes = mobileClientLockoutStoreOpTypes
+	default:
+		return nil, fmt.Errorf("[stores.NewClientLockoutStore] '%s' client type isn't supported", ctype)
+	}
+
+	s, err := newLockoutStore(models.TargetTypeClient, opTypes, db, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientLockoutStore] new lockout store: %w", err)
+	}
+	return s, nil
+}
+
+// NewUserAgentLockoutStore returns a store of lockouts of user agents of the specified type.
+func NewUserAgentLockoutStore(uatype useragentmodels.UserAgentType, db *postgres.Database,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*LockoutStore, error) {
+	var opTypes []actions.OperationType
+	switch uatype {
+	case useragentmodels.UserAgentTypeWeb:
+		opTypes = webUserAgentLockoutStoreOpTypes
+	case useragentmodels.UserAgentTypeMobile:
+		opTypes = mobileUserAgentLockoutStoreOpTypes
+	default:
+		return nil, fmt.Errorf("[stores.NewUserAgentLockoutStore] '%s' user agent type isn't supported", uatype)
+	}
+
+	s, err := newLockoutStore(models.TargetTypeUserAgent, opTypes, db, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserAgentLockoutStore] new lockout store: %w", err)
+	}
+	return s, nil
+}
+
+func newLockoutStore(
+	t models.TargetType,
+	opTypes []actions.OperationType,
+	db *postgres.Database,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*LockoutStore, error) {
+	var notFoundDbErr dberrors.DbErrorCode
+	var notFoundErr *errors.Error
+	switch t {
+	case models.TargetTypeUser:
+		notFoundDbErr, notFoundErr = idberrors.DbErrorCodeUserNotFound, ierrors.ErrUserNotFound
+	case models.TargetTypeClient:
+		notFoundDbErr, notFoundErr = idberrors.DbErrorCodeClientNotFound, ierrors.ErrClientNotFound
+	case models.TargetTypeUserAgent:
+		notFoundDbErr, notFoundErr = idberrors.DbErrorCodeUserAgentNotFound, ierrors.ErrUserAgentNotFound
+	default:
+		return nil, fmt.Errorf("[stores.newLockoutStore] '%s' target type isn't supported", t)
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.lockouts.stores.LockoutStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.newLockoutStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupLockout,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.newLockoutStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.newLockoutStore] new TxManager: %w", err)
+	}
+
+	return &LockoutStore{
+		db:            db,
+		opExecutor:    e,
+		store:         postgres.NewStore[dbmodels.Lockout](db),
+		txManager:     txm,
+		logger:        l,
+		opTypes:       opTypes,
+		targetType:    t,
+		notFoundDbErr: notFoundDbErr,
+		notFoundErr:   notFoundErr,
+	}, nil
+}
+
+// RegisterFailedAttempt registers a failed attempt (e.g. a failed authentication) of the user,
+// client or user agent by the specified ID, locks it out if the number of failed attempts
+// reaches the limit of the policy, and returns the result of the failed attempt.
+func (s *LockoutStore) RegisterFailedAttempt(ctx *actions.OperationContext, id uint64, policy *models.LockoutPolicy) (*models.FailedAttemptResult, error) {
+	var r *models.FailedAttemptResult
+	err := s.opExecutor.Exec(ctx, s.opTypes[opTypeLockoutStore_RegisterFailedAttempt],
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("policy", policy)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var targetStatus uint8
+				var lockedUntil *time.Time
+				var isLockedOut bool
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.register_failed_attempt(IN _id, IN _max_failed_attempts, IN _failure_window, IN _lockout_duration,
+				// IN _max_temporary_lockouts, IN _updated_by, OUT _status, OUT _locked_until, OUT _is_locked_out, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.register_failed_attempt($1, $2, $3, $4, $5, $6, NULL, NULL, NULL, NULL, NULL)"
+				row := tx.QueryRow(txCtx, query, id, policy.MaxFailedAttempts, policy.FailureWindow, policy.LockoutDuration,
+					policy.MaxTemporaryLockouts, opCtx.UserId.Ptr(),
+				)
+
+				if err := row.Scan(&targetStatus, &lockedUntil, &isLockedOut, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.LockoutStore.RegisterFailedAttempt] execute a query (register_failed_attempt): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					r = &models.FailedAttemptResult{
+						Status:      toLockoutStatus(targetStatus),
+						IsLockedOut: isLockedOut,
+						LockedUntil: nullable.FromPtr(lockedUntil),
+					}
+					return nil
+				case s.notFoundDbErr:
+					return s.notFoundErr
+				}
+				// unknown error
+				return fmt.Errorf("[stores.LockoutStore.RegisterFailedAttempt] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.LockoutStore.RegisterFailedAttempt] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.LockoutStore.RegisterFailedAttempt] execute an operation: %w", err)
+	}
+	return r, nil
+}
+
+// ResetFailedAttempts resets the failed attempts and the number of lockouts of the user,
+// client or user agent by the specified ID.
+func (s *LockoutStore) ResetFailedAttempts(ctx *actions.OperationContext, id uint64) error {
+	err := s.opExecutor.Exec(ctx, s.opTypes[opTypeLockoutStore_ResetFailedAttempts], []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.reset_failed_attempts(IN _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.reset_failed_attempts($1, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.LockoutStore.ResetFailedAttempts] execute a query (reset_failed_attempts): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.LockoutStore.ResetFailedAttempts] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.LockoutStore.ResetFailedAttempts] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.LockoutStore.ResetFailedAttempts] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Unlock unlocks the user, client or user agent by the specified ID and returns true
+// if it has been unlocked. If onlyIfExpired is true, it is only unlocked if its temporary lockout
+// has expired.
+func (s *LockoutStore) Unlock(ctx *actions.OperationContext, id uint64, onlyIfExpired bool) (bool, error) {
+	var unlocked bool
+	err := s.opExecutor.Exec(ctx, s.opTypes[opTypeLockoutStore_Unlock],
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("onlyIfExpired", onlyIfExpired)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.unlock(IN _id, IN _only_if_expired, IN _updated_by, OUT _unlocked, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.unlock($1, $2, $3, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id, onlyIfExpired, opCtx.UserId.Ptr()).Scan(&unlocked, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.LockoutStore.Unlock] execute a query (unlock): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case s.notFoundDbErr:
+					return s.notFoundErr
+				}
+				// unknown error
+				return fmt.Errorf("[stores.LockoutStore.Unlock] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.LockoutStore.Unlock] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.LockoutStore.Unlock] execute an operation: %w", err)
+	}
+	return unlocked, nil
+}
+
+// FindById finds and returns lockout info, if any, by the specified user, client or user agent ID.
+func (s *LockoutStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.Lockout, error) {
+	var l *dbmodels.Lockout
+	err := s.opExecutor.Exec(ctx, s.opTypes[opTypeLockoutStore_FindById], []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + lockoutsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if l, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.LockoutStore.FindById] find a lockout by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.LockoutStore.FindById] execute an operation: %w", err)
+	}
+	return l, nil
+}
+
+// UnlockAllExpired unlocks up to limit users, clients or user agents whose temporary lockouts
+// have expired and returns their IDs.
+func (s *LockoutStore) UnlockAllExpired(ctx *actions.OperationContext, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, s.opTypes[opTypeLockoutStore_UnlockAllExpired], []*actions.OperationParam{actions.NewOperationParam("limit", limit)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				// FUNCTION: public.unlock_expired_lockouts(_updated_by, _limit)
+				// Minimum transaction isolation level: Read committed.
+				const query = "SELECT * FROM public.unlock_expired_lockouts($1, $2)"
+				rows, err := tx.Query(txCtx, query, opCtx.UserId.Ptr(), limit)
+				if err != nil {
+					return fmt.Errorf("[stores.LockoutStore.UnlockAllExpired] execute a query (unlock_expired_lockouts): %w", err)
+				}
+				defer rows.Close()
+
+				if ids, err = pgx.CollectRows(rows, pgx.RowTo[uint64]); err != nil {
+					return fmt.Errorf("[stores.LockoutStore.UnlockAllExpired] collect rows: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.LockoutStore.UnlockAllExpired] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.LockoutStore.UnlockAllExpired] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+func toLockoutStatus(targetStatus uint8) models.LockoutStatus {
+	switch targetStatus {
+	case targetStatusLockedOut:
+		return models.LockoutStatusLockedOut
+	case targetStatusTemporarilyLockedOut:
+		return models.LockoutStatusTemporarilyLockedOut
+	}
+	return models.LockoutStatusNotLockedOut
+}
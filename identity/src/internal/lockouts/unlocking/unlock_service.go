@@ -0,0 +1,208 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unlocking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/lockouts"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	logginghelper "personal-website-v2/pkg/helper/logging"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+type UnlockServiceConfig struct {
+	// The interval between unlocks of users, clients and user agents whose temporary lockouts have expired.
+	Interval time.Duration
+
+	// The user ID (app user) on behalf of which users, clients and user agents are unlocked.
+	UserId uint64
+}
+
+// UnlockService periodically unlocks users, clients and user agents whose temporary lockouts have expired.
+type UnlockService struct {
+	appSessionId   uint64
+	tranManager    *actions.TransactionManager
+	actionExecutor *actionhelper.ActionExecutor
+	lockoutManager lockouts.LockoutManager
+	config         *UnlockServiceConfig
+	logger         logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx      *lcontext.LogEntryContext
+	isStarted      atomic.Bool
+	isStopped      bool
+	mu             sync.Mutex
+	done           chan struct{}
+	wg             sync.WaitGroup
+}
+
+func NewUnlockService(
+	appSessionId uint64,
+	tranManager *actions.TransactionManager,
+	actionManager *actions.ActionManager,
+	lockoutManager lockouts.LockoutManager,
+	config *UnlockServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*UnlockService, error) {
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("[unlocking.NewUnlockService] invalid interval: %v", config.Interval)
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.lockouts.unlocking.UnlockService")
+	if err != nil {
+		return nil, fmt.Errorf("[unlocking.NewUnlockService] create a logger: %w", err)
+	}
+
+	c := &actionhelper.ActionExecutorConfig{
+		ActionCategory:    actions.ActionCategoryCommon,
+		ActionGroup:       iactions.ActionGroupLockout,
+		OperationCategory: actions.OperationCategoryCommon,
+		OperationGroup:    iactions.OperationGroupLockout,
+		StopAppIfError:    true,
+	}
+	e, err := actionhelper.NewActionExecutor(appSessionId, actionManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[unlocking.NewUnlockService] new action executor: %w", err)
+	}
+
+	return &UnlockService{
+		appSessionId:   appSessionId,
+		tranManager:    tranManager,
+		actionExecutor: e,
+		lockoutManager: lockoutManager,
+		config:         config,
+		logger:         l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *UnlockService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the UnlockService.
+func (s *UnlockService) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[unlocking.UnlockService.Start] UnlockService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[unlocking.UnlockService.Start] UnlockService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.LockoutEvent, "[unlocking.UnlockService.Start] starting the UnlockService...")
+
+	s.wg.Add(1)
+	go s.run()
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.LockoutEvent, "[unlocking.UnlockService.Start] UnlockService has been started",
+		logging.NewField("interval", s.config.Interval),
+	)
+	return nil
+}
+
+// Stop stops the UnlockService.
+func (s *UnlockService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[unlocking.UnlockService.Stop] UnlockService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.LockoutEvent, "[unlocking.UnlockService.Stop] stopping the UnlockService...")
+	close(s.done)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.LockoutEvent, "[unlocking.UnlockService.Stop] UnlockService has been stopped")
+	return nil
+}
+
+func (s *UnlockService) run() {
+	defer s.wg.Done()
+
+	t := time.NewTicker(s.config.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			s.unlockAllExpired()
+		}
+	}
+}
+
+func (s *UnlockService) unlockAllExpired() {
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.LockoutEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[unlocking.UnlockService.unlockAllExpired] panic: ", p.Value), p.StackTrace),
+			"[unlocking.UnlockService.unlockAllExpired] panic while unlocking",
+		)
+	})
+
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.LockoutEvent, err, "[unlocking.UnlockService.unlockAllExpired] create and start a transaction")
+		return
+	}
+
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeLockout_UnlockExpired, uuid.NullUUID{}, true,
+		iactions.OperationTypeUnlockService_UnlockAllExpired, uuid.NullUUID{}, nil,
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			n, err := s.lockoutManager.UnlockAllExpired(ctx)
+			if err != nil {
+				return fmt.Errorf("[unlocking.UnlockService.unlockAllExpired] unlock all users, clients and user agents whose lockouts have expired: %w", err)
+			}
+
+			if n > 0 {
+				s.logger.InfoWithEvent(ctx.CreateLogEntryContext(), events.LockoutEvent,
+					"[unlocking.UnlockService.unlockAllExpired] users, clients and user agents whose lockouts have expired have been unlocked",
+					logging.NewField("count", n),
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		s.logger.ErrorWithEvent(logginghelper.CreateLogEntryContext(s.appSessionId, t, nil, nil), events.LockoutEvent, err,
+			"[unlocking.UnlockService.unlockAllExpired] execute an action",
+		)
+	}
+}
@@ -35,12 +35,31 @@ const (
 	// Authentication token encryption key event group.
 	EventGroupAuthnTokenEncryptionKey logging.EventGroup = 1011
 
-	EventGroupRoleAssignment      logging.EventGroup = 1012
-	EventGroupUserRoleAssignment  logging.EventGroup = 1013
-	EventGroupGroupRoleAssignment logging.EventGroup = 1014
-	EventGroupUserRole            logging.EventGroup = 1015
-	EventGroupGroupRole           logging.EventGroup = 1016
-	EventGroupRolePermission      logging.EventGroup = 1017
+	EventGroupRoleAssignment       logging.EventGroup = 1012
+	EventGroupUserRoleAssignment   logging.EventGroup = 1013
+	EventGroupGroupRoleAssignment  logging.EventGroup = 1014
+	EventGroupUserRole             logging.EventGroup = 1015
+	EventGroupGroupRole            logging.EventGroup = 1016
+	EventGroupRolePermission       logging.EventGroup = 1017
+	EventGroupAuthorizationCache   logging.EventGroup = 1018
+	EventGroupUserCredential       logging.EventGroup = 1019
+	EventGroupLockout              logging.EventGroup = 1020
+	EventGroupUserMfa              logging.EventGroup = 1021
+	EventGroupClientRoleAssignment logging.EventGroup = 1022
+	EventGroupActiveSession        logging.EventGroup = 1023
+	EventGroupSessionRevocation    logging.EventGroup = 1024
+	EventGroupOidc                 logging.EventGroup = 1025
+	EventGroupRegistration         logging.EventGroup = 1026
+	EventGroupRoleInheritance      logging.EventGroup = 1027
+	EventGroupUserGroupMember      logging.EventGroup = 1028
+	EventGroupResourceType         logging.EventGroup = 1029
+
+	// Resource role assignment event group.
+	EventGroupResourceRoleAssignment logging.EventGroup = 1030
+	EventGroupImpersonation          logging.EventGroup = 1031
+	EventGroupDataSubjectRequest     logging.EventGroup = 1032
+	EventGroupProvisioning           logging.EventGroup = 1033
+	EventGroupApiKey                 logging.EventGroup = 1034
 
 	EventGroupUserStore             logging.EventGroup = 1050
 	EventGroupClientStore           logging.EventGroup = 1051
@@ -57,9 +76,29 @@ const (
 	// Authentication TokenEncryptionKeyStore event group.
 	EventGroupAuthnTokenEncryptionKeyStore logging.EventGroup = 1061
 
+	EventGroupUserCredentialStore  logging.EventGroup = 1062
+	EventGroupLockoutStore         logging.EventGroup = 1063
+	EventGroupUserMfaStore         logging.EventGroup = 1064
+	EventGroupOidcStore            logging.EventGroup = 1065
+	EventGroupRegistrationStore    logging.EventGroup = 1066
+	EventGroupRoleInheritanceStore logging.EventGroup = 1067
+	EventGroupUserGroupMemberStore logging.EventGroup = 1068
+	EventGroupResourceTypeStore    logging.EventGroup = 1069
+
+	// Resource role assignment store event group.
+	EventGroupResourceRoleAssignmentStore logging.EventGroup = 1070
+	EventGroupImpersonationTokenStore     logging.EventGroup = 1071
+	EventGroupDataSubjectRequestStore     logging.EventGroup = 1072
+	EventGroupApiKeyStore                 logging.EventGroup = 1073
+
 	EventGroupHttpControllers_UserController   logging.EventGroup = 2000
 	EventGroupHttpControllers_ClientController logging.EventGroup = 2001
 
+	// Authorization cache controller event group.
+	EventGroupHttpControllers_AuthzCacheController logging.EventGroup = 2002
+
+	EventGroupHttpControllers_OidcController logging.EventGroup = 2003
+
 	EventGroupGrpcServices_UserService             logging.EventGroup = 3000
 	EventGroupGrpcServices_ClientService           logging.EventGroup = 3001
 	EventGroupGrpcServices_UserGroupService        logging.EventGroup = 3002
@@ -82,4 +121,19 @@ const (
 	EventGroupGrpcServices_GroupRoleService           logging.EventGroup = 3016
 	EventGroupGrpcServices_RolePermissionService      logging.EventGroup = 3017
 	EventGroupGrpcServices_UserPersonalInfoService    logging.EventGroup = 3018
+	EventGroupGrpcServices_UserCredentialService      logging.EventGroup = 3019
+	EventGroupGrpcServices_LockoutService             logging.EventGroup = 3020
+	EventGroupGrpcServices_UserMfaService             logging.EventGroup = 3021
+	EventGroupGrpcServices_ActiveSessionService       logging.EventGroup = 3022
+	EventGroupGrpcServices_RegistrationService        logging.EventGroup = 3023
+	EventGroupGrpcServices_RoleInheritanceService     logging.EventGroup = 3024
+	EventGroupGrpcServices_UserGroupMemberService     logging.EventGroup = 3025
+	EventGroupGrpcServices_ResourceTypeService        logging.EventGroup = 3026
+
+	// Resource role assignment service.
+	EventGroupGrpcServices_ResourceRoleAssignmentService logging.EventGroup = 3027
+	EventGroupGrpcServices_ImpersonationService          logging.EventGroup = 3028
+	EventGroupGrpcServices_DataSubjectRequestService     logging.EventGroup = 3029
+	EventGroupGrpcServices_ProvisioningService           logging.EventGroup = 3030
+	EventGroupGrpcServices_ApiKeyService                 logging.EventGroup = 3031
 )
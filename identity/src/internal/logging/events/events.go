@@ -36,6 +36,7 @@ var (
 	ClientEvent = logging.NewEvent(0, "Client", logging.EventCategoryCommon, amlogging.EventGroupClient)
 
 	// UserGroup events (id: 0, 11400-11599).
+	UserGroupEvent = logging.NewEvent(0, "UserGroup", logging.EventCategoryCommon, amlogging.EventGroupUserGroup)
 
 	// Role events (id: 0, 11600-11799).
 	RoleEvent = logging.NewEvent(0, "Role", logging.EventCategoryCommon, amlogging.EventGroupRole)
@@ -82,6 +83,57 @@ var (
 	// RolePermission events (id: 0, 14400-14599).
 	RolePermissionEvent = logging.NewEvent(0, "RolePermission", logging.EventCategoryCommon, amlogging.EventGroupRolePermission)
 
+	// UserCredential events (id: 0, 15000-15199).
+	UserCredentialEvent = logging.NewEvent(0, "UserCredential", logging.EventCategoryCommon, amlogging.EventGroupUserCredential)
+
+	// Lockout events (id: 0, 15200-15399).
+	LockoutEvent = logging.NewEvent(0, "Lockout", logging.EventCategoryCommon, amlogging.EventGroupLockout)
+
+	// UserMfa events (id: 0, 15400-15599).
+	UserMfaEvent = logging.NewEvent(0, "UserMfa", logging.EventCategoryCommon, amlogging.EventGroupUserMfa)
+
+	// ClientRoleAssignment events (id: 0, 15600-15799).
+	ClientRoleAssignmentEvent = logging.NewEvent(0, "ClientRoleAssignment", logging.EventCategoryCommon, amlogging.EventGroupClientRoleAssignment)
+
+	// ActiveSession events (id: 0, 15800-15999).
+	ActiveSessionEvent = logging.NewEvent(0, "ActiveSession", logging.EventCategoryCommon, amlogging.EventGroupActiveSession)
+
+	// Oidc events (id: 0, 16000-16199).
+	OidcEvent = logging.NewEvent(0, "Oidc", logging.EventCategoryCommon, amlogging.EventGroupOidc)
+
+	// Registration events (id: 0, 16200-16399).
+	RegistrationEvent = logging.NewEvent(0, "Registration", logging.EventCategoryCommon, amlogging.EventGroupRegistration)
+
+	// RoleInheritance events (id: 0, 16400-16599).
+	RoleInheritanceEvent = logging.NewEvent(0, "RoleInheritance", logging.EventCategoryCommon, amlogging.EventGroupRoleInheritance)
+
+	// UserGroupMember events (id: 0, 16600-16799).
+	UserGroupMemberEvent = logging.NewEvent(0, "UserGroupMember", logging.EventCategoryCommon, amlogging.EventGroupUserGroupMember)
+
+	// ResourceType events (id: 0, 16800-16999).
+	ResourceTypeEvent = logging.NewEvent(0, "ResourceType", logging.EventCategoryCommon, amlogging.EventGroupResourceType)
+
+	// ResourceRoleAssignment events (id: 0, 17000-17199).
+	ResourceRoleAssignmentEvent = logging.NewEvent(0, "ResourceRoleAssignment", logging.EventCategoryCommon, amlogging.EventGroupResourceRoleAssignment)
+
+	// Impersonation events (id: 0, 17200-17399).
+	ImpersonationEvent = logging.NewEvent(0, "Impersonation", logging.EventCategoryCommon, amlogging.EventGroupImpersonation)
+
+	// DataSubjectRequest events (id: 0, 17400-17599).
+	DataSubjectRequestEvent = logging.NewEvent(0, "DataSubjectRequest", logging.EventCategoryCommon, amlogging.EventGroupDataSubjectRequest)
+
+	// Provisioning events (id: 0, 17600-17799).
+	ProvisioningEvent = logging.NewEvent(0, "Provisioning", logging.EventCategoryCommon, amlogging.EventGroupProvisioning)
+
+	// ApiKey events (id: 0, 17800-17999).
+	ApiKeyEvent = logging.NewEvent(0, "ApiKey", logging.EventCategoryCommon, amlogging.EventGroupApiKey)
+
+	// AuthorizationCache events (id: 0, 50000-50199).
+	AuthorizationCacheEvent = logging.NewEvent(0, "AuthorizationCache", logging.EventCategoryCommon, amlogging.EventGroupAuthorizationCache)
+
+	// SessionRevocation events (id: 0, 50200-50399).
+	SessionRevocationEvent = logging.NewEvent(0, "SessionRevocation", logging.EventCategoryCommon, amlogging.EventGroupSessionRevocation)
+
 	// ApplicationStore events (id: 0, 30000-30999).
 
 	// UserStore events (id: 0, 31000-31199).
@@ -91,6 +143,7 @@ var (
 	ClientStoreEvent = logging.NewEvent(0, "ClientStore", logging.EventCategoryDatabase, amlogging.EventGroupClientStore)
 
 	// UserGroupStore events (id: 0, 31400-31599).
+	UserGroupStoreEvent = logging.NewEvent(0, "UserGroupStore", logging.EventCategoryDatabase, amlogging.EventGroupUserGroupStore)
 
 	// RoleStore events (id: 0, 31600-31799).
 	RoleStoreEvent = logging.NewEvent(0, "RoleStore", logging.EventCategoryCommon, amlogging.EventGroupRoleStore)
@@ -119,6 +172,44 @@ var (
 	// Authentication TokenEncryptionKeyStore events (id: 0, 33200-33399).
 	AuthnTokenEncryptionKeyStoreEvent = logging.NewEvent(0, "AuthnTokenEncryptionKeyStore", logging.EventCategoryCommon, amlogging.EventGroupAuthnTokenEncryptionKeyStore)
 
+	// UserCredentialStore events (id: 0, 33400-33599).
+	UserCredentialStoreEvent = logging.NewEvent(0, "UserCredentialStore", logging.EventCategoryDatabase, amlogging.EventGroupUserCredentialStore)
+
+	// LockoutStore events (id: 0, 33600-33799).
+	LockoutStoreEvent = logging.NewEvent(0, "LockoutStore", logging.EventCategoryDatabase, amlogging.EventGroupLockoutStore)
+
+	// UserMfaStore events (id: 0, 33800-33999).
+	UserMfaStoreEvent = logging.NewEvent(0, "UserMfaStore", logging.EventCategoryDatabase, amlogging.EventGroupUserMfaStore)
+
+	// OidcStore events (id: 0, 34000-34199).
+	OidcStoreEvent = logging.NewEvent(0, "OidcStore", logging.EventCategoryDatabase, amlogging.EventGroupOidcStore)
+
+	// RegistrationStore events (id: 0, 34200-34399).
+	RegistrationStoreEvent = logging.NewEvent(0, "RegistrationStore", logging.EventCategoryDatabase, amlogging.EventGroupRegistrationStore)
+
+	// RoleInheritanceStore events (id: 0, 34400-34599).
+	RoleInheritanceStoreEvent = logging.NewEvent(0, "RoleInheritanceStore", logging.EventCategoryDatabase, amlogging.EventGroupRoleInheritanceStore)
+
+	// UserGroupMemberStore events (id: 0, 34600-34799).
+	UserGroupMemberStoreEvent = logging.NewEvent(0, "UserGroupMemberStore", logging.EventCategoryDatabase, amlogging.EventGroupUserGroupMemberStore)
+
+	// ResourceTypeStore events (id: 0, 34800-34999).
+	ResourceTypeStoreEvent = logging.NewEvent(0, "ResourceTypeStore", logging.EventCategoryDatabase, amlogging.EventGroupResourceTypeStore)
+
+	// ResourceRoleAssignmentStore events (id: 0, 35000-35199).
+	ResourceRoleAssignmentStoreEvent = logging.NewEvent(0, "ResourceRoleAssignmentStore", logging.EventCategoryDatabase,
+		amlogging.EventGroupResourceRoleAssignmentStore)
+
+	// ImpersonationTokenStore events (id: 0, 35200-35399).
+	ImpersonationTokenStoreEvent = logging.NewEvent(0, "ImpersonationTokenStore", logging.EventCategoryDatabase, amlogging.EventGroupImpersonationTokenStore)
+
+	// DataSubjectRequestStore events (id: 0, 35400-35599).
+	DataSubjectRequestStoreEvent = logging.NewEvent(0, "DataSubjectRequestStore", logging.EventCategoryDatabase,
+		amlogging.EventGroupDataSubjectRequestStore)
+
+	// ApiKeyStore events (id: 0, 35600-35799).
+	ApiKeyStoreEvent = logging.NewEvent(0, "ApiKeyStore", logging.EventCategoryDatabase, amlogging.EventGroupApiKeyStore)
+
 	// HttpControllers_ApplicationController events (id: 0, 100000-100999).
 
 	// HttpControllers_UserController events (id: 0, 101000-101199).
@@ -127,6 +218,12 @@ var (
 	// HttpControllers_ClientController events (id: 0, 101200-101399).
 	HttpControllers_ClientControllerEvent = logging.NewEvent(0, "HttpControllers_ClientController", logging.EventCategoryCommon, amlogging.EventGroupHttpControllers_ClientController)
 
+	// Authorization cache controller events (id: 0, 101400-101599).
+	HttpControllers_AuthzCacheControllerEvent = logging.NewEvent(0, "HttpControllers_AuthzCacheController", logging.EventCategoryCommon, amlogging.EventGroupHttpControllers_AuthzCacheController)
+
+	// HttpControllers_OidcController events (id: 0, 101600-101799).
+	HttpControllers_OidcControllerEvent = logging.NewEvent(0, "HttpControllers_OidcController", logging.EventCategoryCommon, amlogging.EventGroupHttpControllers_OidcController)
+
 	// GrpcServices_ApplicationService events (id: 0, 200000-200999).
 
 	// GrpcServices_UserService events (id: 0, 201000-201199).
@@ -136,6 +233,7 @@ var (
 	GrpcServices_ClientServiceEvent = logging.NewEvent(0, "GrpcServices_ClientService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_ClientService)
 
 	// GrpcServices_UserGroupService events (id: 0, 201400-201599).
+	GrpcServices_UserGroupServiceEvent = logging.NewEvent(0, "GrpcServices_UserGroupService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_UserGroupService)
 
 	// GrpcServices_RoleService events (id: 0, 201600-201799).
 	GrpcServices_RoleServiceEvent = logging.NewEvent(0, "GrpcServices_RoleService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_RoleService)
@@ -184,4 +282,48 @@ var (
 
 	// GrpcServices_UserPersonalInfoService events (id: 0, 204600-204799).
 	GrpcServices_UserPersonalInfoServiceEvent = logging.NewEvent(0, "GrpcServices_UserPersonalInfoService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_UserPersonalInfoService)
+
+	// GrpcServices_UserCredentialService events (id: 0, 204800-204999).
+	GrpcServices_UserCredentialServiceEvent = logging.NewEvent(0, "GrpcServices_UserCredentialService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_UserCredentialService)
+
+	// GrpcServices_LockoutService events (id: 0, 205000-205199).
+	GrpcServices_LockoutServiceEvent = logging.NewEvent(0, "GrpcServices_LockoutService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_LockoutService)
+
+	// GrpcServices_UserMfaService events (id: 0, 205200-205399).
+	GrpcServices_UserMfaServiceEvent = logging.NewEvent(0, "GrpcServices_UserMfaService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_UserMfaService)
+
+	// GrpcServices_ActiveSessionService events (id: 0, 205400-205599).
+	GrpcServices_ActiveSessionServiceEvent = logging.NewEvent(0, "GrpcServices_ActiveSessionService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_ActiveSessionService)
+
+	// GrpcServices_RegistrationService events (id: 0, 205600-205799).
+	GrpcServices_RegistrationServiceEvent = logging.NewEvent(0, "GrpcServices_RegistrationService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_RegistrationService)
+
+	// GrpcServices_RoleInheritanceService events (id: 0, 205800-205999).
+	GrpcServices_RoleInheritanceServiceEvent = logging.NewEvent(0, "GrpcServices_RoleInheritanceService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_RoleInheritanceService)
+
+	// GrpcServices_UserGroupMemberService events (id: 0, 206000-206199).
+	GrpcServices_UserGroupMemberServiceEvent = logging.NewEvent(0, "GrpcServices_UserGroupMemberService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_UserGroupMemberService)
+
+	// GrpcServices_ResourceTypeService events (id: 0, 206200-206399).
+	GrpcServices_ResourceTypeServiceEvent = logging.NewEvent(0, "GrpcServices_ResourceTypeService", logging.EventCategoryCommon, amlogging.EventGroupGrpcServices_ResourceTypeService)
+
+	// GrpcServices_ResourceRoleAssignmentService events (id: 0, 206400-206599).
+	GrpcServices_ResourceRoleAssignmentServiceEvent = logging.NewEvent(0, "GrpcServices_ResourceRoleAssignmentService", logging.EventCategoryCommon,
+		amlogging.EventGroupGrpcServices_ResourceRoleAssignmentService)
+
+	// GrpcServices_ImpersonationService events (id: 0, 206600-206799).
+	GrpcServices_ImpersonationServiceEvent = logging.NewEvent(0, "GrpcServices_ImpersonationService", logging.EventCategoryCommon,
+		amlogging.EventGroupGrpcServices_ImpersonationService)
+
+	// GrpcServices_DataSubjectRequestService events (id: 0, 206800-206999).
+	GrpcServices_DataSubjectRequestServiceEvent = logging.NewEvent(0, "GrpcServices_DataSubjectRequestService", logging.EventCategoryCommon,
+		amlogging.EventGroupGrpcServices_DataSubjectRequestService)
+
+	// GrpcServices_ProvisioningService events (id: 0, 207000-207199).
+	GrpcServices_ProvisioningServiceEvent = logging.NewEvent(0, "GrpcServices_ProvisioningService", logging.EventCategoryCommon,
+		amlogging.EventGroupGrpcServices_ProvisioningService)
+
+	// GrpcServices_ApiKeyService events (id: 0, 207200-207399).
+	GrpcServices_ApiKeyServiceEvent = logging.NewEvent(0, "GrpcServices_ApiKeyService", logging.EventCategoryCommon,
+		amlogging.EventGroupGrpcServices_ApiKeyService)
 )
@@ -0,0 +1,91 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/mfa/models"
+)
+
+type UserTotp struct {
+	// The unique ID to identify the user's TOTP.
+	Id uint64 `db:"id"`
+
+	// The user ID who owns the TOTP.
+	UserId uint64 `db:"user_id"`
+
+	// It stores the date and time at which the TOTP was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the TOTP.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the TOTP was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the TOTP.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The TOTP status.
+	Status models.TotpStatus `db:"status"`
+
+	// The TOTP secret.
+	Secret []byte `db:"secret"`
+
+	// Optional. It stores the date and time at which the TOTP was enabled.
+	EnabledAt *time.Time `db:"enabled_at"`
+
+	// Optional. The time step of the last used code.
+	LastUsedStep *uint64 `db:"last_used_step"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+type MfaChallenge struct {
+	// The unique ID to identify the MFA challenge.
+	Id uint64 `db:"id"`
+
+	// The user ID who must complete the challenge.
+	UserId uint64 `db:"user_id"`
+
+	// The SHA-256 hash of the challenge token.
+	TokenHash []byte `db:"token_hash"`
+
+	// The client ID.
+	ClientId uint64 `db:"client_id"`
+
+	// Optional. The app ID.
+	AppId *uint64 `db:"app_id"`
+
+	// The User-Agent.
+	UserAgent string `db:"user_agent"`
+
+	// The IP address (sign-in IP address).
+	IP string `db:"ip"`
+
+	// It stores the date and time at which the challenge was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the challenge expires.
+	ExpiresAt time.Time `db:"expires_at"`
+
+	// The number of failed attempts to complete the challenge.
+	FailedAttempts int `db:"failed_attempts"`
+}
@@ -0,0 +1,232 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/identity/src/internal/mfa/dbmodels"
+	"personal-website-v2/identity/src/internal/mfa/models"
+	"personal-website-v2/identity/src/internal/mfa/operations/challenges"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The size of an MFA challenge token (in bytes).
+	challengeTokenSize = 32
+)
+
+type MfaChallengeManagerConfig struct {
+	// The lifetime of an MFA challenge.
+	TTL time.Duration
+
+	// The maximum number of failed attempts to complete an MFA challenge.
+	MaxFailedAttempts int
+}
+
+// MfaChallengeManager is a manager of MFA challenges.
+type MfaChallengeManager struct {
+	opExecutor        *actionhelper.OperationExecutor
+	config            *MfaChallengeManagerConfig
+	mfaChallengeStore mfa.MfaChallengeStore
+	userMfaManager    mfa.UserMfaManager
+	logger            logging.Logger[*context.LogEntryContext]
+}
+
+var _ mfa.MfaChallengeManager = (*MfaChallengeManager)(nil)
+
+func NewMfaChallengeManager(
+	config *MfaChallengeManagerConfig,
+	mfaChallengeStore mfa.MfaChallengeStore,
+	userMfaManager mfa.UserMfaManager,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*MfaChallengeManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.mfa.manager.MfaChallengeManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewMfaChallengeManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserMfa,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewMfaChallengeManager] new operation executor: %w", err)
+	}
+
+	return &MfaChallengeManager{
+		opExecutor:        e,
+		config:            config,
+		mfaChallengeStore: mfaChallengeStore,
+		userMfaManager:    userMfaManager,
+		logger:            l,
+	}, nil
+}
+
+// Create creates an MFA challenge that must be completed to sign in the user.
+func (m *MfaChallengeManager) Create(ctx *actions.OperationContext, data *challenges.CreateOperationData) (*models.MfaChallenge, error) {
+	var c *models.MfaChallenge
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeManager_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return err
+			}
+
+			b := make([]byte, challengeTokenSize)
+			if _, err := rand.Read(b); err != nil {
+				return fmt.Errorf("[manager.MfaChallengeManager.Create] generate a token: %w", err)
+			}
+
+			// only the hash of the token is stored
+			t := base64.RawURLEncoding.EncodeToString(b)
+			expiresAt := time.Now().Add(m.config.TTL)
+			id, err := m.mfaChallengeStore.Create(opCtx, data, hashChallengeToken(t), m.config.TTL)
+			if err != nil {
+				return fmt.Errorf("[manager.MfaChallengeManager.Create] create an MFA challenge: %w", err)
+			}
+
+			c = &models.MfaChallenge{
+				Token:     t,
+				ExpiresAt: expiresAt,
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.MfaChallengeManager.Create] MFA challenge has been created",
+				logging.NewField("id", id),
+				logging.NewField("userId", data.UserId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.MfaChallengeManager.Create] execute an operation: %w", err)
+	}
+	return c, nil
+}
+
+// FindByToken finds and returns an unexpired MFA challenge, if any, by the specified token.
+// The challenge isn't modified.
+func (m *MfaChallengeManager) FindByToken(ctx *actions.OperationContext, token string) (*dbmodels.MfaChallenge, error) {
+	var c *dbmodels.MfaChallenge
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeManager_FindByToken, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if c, err = m.mfaChallengeStore.FindByTokenHash(opCtx, hashChallengeToken(token)); err != nil {
+				return fmt.Errorf("[manager.MfaChallengeManager.FindByToken] find an MFA challenge by token hash: %w", err)
+			}
+
+			if c != nil && !c.ExpiresAt.After(time.Now()) {
+				c = nil
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.MfaChallengeManager.FindByToken] execute an operation: %w", err)
+	}
+	return c, nil
+}
+
+// Verify verifies the code of the MFA challenge by the specified token and returns the challenge
+// and true if the code is valid. The challenge is deleted if the code is valid or after too many
+// failed attempts.
+func (m *MfaChallengeManager) Verify(ctx *actions.OperationContext, token string, method models.MfaMethod, code string) (*dbmodels.MfaChallenge, bool, error) {
+	var c *dbmodels.MfaChallenge
+	var valid bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeManager_Verify, []*actions.OperationParam{actions.NewOperationParam("method", method)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if c, err = m.mfaChallengeStore.FindByTokenHash(opCtx, hashChallengeToken(token)); err != nil {
+				return fmt.Errorf("[manager.MfaChallengeManager.Verify] find an MFA challenge by token hash: %w", err)
+			}
+
+			if c == nil || !c.ExpiresAt.After(time.Now()) {
+				return ierrors.ErrMfaChallengeNotFound
+			}
+
+			if valid, err = m.userMfaManager.Verify(opCtx, c.UserId, method, code); err != nil {
+				if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserTotpNotFound {
+					return fmt.Errorf("[manager.MfaChallengeManager.Verify] verify an MFA code: %w", err)
+				}
+				// TOTP has been disabled after the challenge was created
+				valid = false
+			}
+
+			if !valid {
+				deleted, err := m.mfaChallengeStore.RegisterFailedAttempt(opCtx, c.Id, m.config.MaxFailedAttempts)
+				if err != nil {
+					return fmt.Errorf("[manager.MfaChallengeManager.Verify] register a failed attempt: %w", err)
+				}
+
+				if deleted {
+					m.logger.WarningWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.UserMfaEvent,
+						"[manager.MfaChallengeManager.Verify] MFA challenge has been deleted after too many failed attempts",
+						logging.NewField("id", c.Id),
+						logging.NewField("userId", c.UserId),
+					)
+				}
+				return nil
+			}
+
+			// the challenge can only be completed once
+			deleted, err := m.mfaChallengeStore.Delete(opCtx, c.Id)
+			if err != nil {
+				return fmt.Errorf("[manager.MfaChallengeManager.Verify] delete an MFA challenge: %w", err)
+			}
+
+			if !deleted {
+				return ierrors.ErrMfaChallengeNotFound
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.MfaChallengeManager.Verify] MFA challenge has been completed",
+				logging.NewField("id", c.Id),
+				logging.NewField("userId", c.UserId),
+				logging.NewField("method", method),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("[manager.MfaChallengeManager.Verify] execute an operation: %w", err)
+	}
+	return c, valid, nil
+}
+
+func hashChallengeToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,489 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/identity/src/internal/mfa/models"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/users"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/crypto/totp"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type UserMfaManagerConfig struct {
+	// The issuer (e.g. the website name) displayed by authenticator apps.
+	Issuer string
+
+	// Optional. The MFA policy. If it is nil, MFA is only used by users who have enabled it.
+	Policy *models.MfaPolicy
+}
+
+// UserMfaManager is a manager of users' MFA.
+type UserMfaManager struct {
+	opExecutor                 *actionhelper.OperationExecutor
+	config                     *UserMfaManagerConfig
+	userTotpStore              mfa.UserTotpStore
+	userManager                users.UserManager
+	roleManager                roles.RoleManager
+	userRoleAssignmentManager  roles.UserRoleAssignmentManager
+	groupRoleAssignmentManager roles.GroupRoleAssignmentManager
+	roleInheritanceManager     roles.RoleInheritanceManager
+	groupMemberManager         groups.UserGroupMemberManager
+	logger                     logging.Logger[*context.LogEntryContext]
+}
+
+var _ mfa.UserMfaManager = (*UserMfaManager)(nil)
+
+func NewUserMfaManager(
+	config *UserMfaManagerConfig,
+	userTotpStore mfa.UserTotpStore,
+	userManager users.UserManager,
+	roleManager roles.RoleManager,
+	userRoleAssignmentManager roles.UserRoleAssignmentManager,
+	groupRoleAssignmentManager roles.GroupRoleAssignmentManager,
+	roleInheritanceManager roles.RoleInheritanceManager,
+	groupMemberManager groups.UserGroupMemberManager,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*UserMfaManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.mfa.manager.UserMfaManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserMfaManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupUserMfa,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewUserMfaManager] new operation executor: %w", err)
+	}
+
+	return &UserMfaManager{
+		opExecutor:                 e,
+		config:                     config,
+		userTotpStore:              userTotpStore,
+		userManager:                userManager,
+		roleManager:                roleManager,
+		userRoleAssignmentManager:  userRoleAssignmentManager,
+		groupRoleAssignmentManager: groupRoleAssignmentManager,
+		roleInheritanceManager:     roleInheritanceManager,
+		groupMemberManager:         groupMemberManager,
+		logger:                     l,
+	}, nil
+}
+
+// StartTotpEnrollment starts the TOTP enrollment of the user by the specified user ID
+// and returns the TOTP secret and the provisioning URI.
+func (m *UserMfaManager) StartTotpEnrollment(ctx *actions.OperationContext, userId uint64) (*models.TotpEnrollment, error) {
+	var e *models.TotpEnrollment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_StartTotpEnrollment, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			u, err := m.userManager.FindById(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.StartTotpEnrollment] find a user by id: %w", err)
+			}
+
+			if u == nil {
+				return ierrors.ErrUserNotFound
+			}
+
+			var accountName string
+			if u.Email != nil {
+				accountName = *u.Email
+			} else if u.Name != nil {
+				accountName = *u.Name
+			} else {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "user has neither name nor email")
+			}
+
+			secret, err := totp.GenerateSecret(totp.DefaultSecretSize)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.StartTotpEnrollment] generate a secret: %w", err)
+			}
+
+			if err = m.userTotpStore.CreatePending(opCtx, userId, secret); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.StartTotpEnrollment] create a pending TOTP: %w", err)
+			}
+
+			e = &models.TotpEnrollment{
+				Secret:          totp.EncodeSecret(secret),
+				ProvisioningURI: totp.ProvisioningURI(m.config.Issuer, accountName, secret, totp.DefaultParams),
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.UserMfaManager.StartTotpEnrollment] TOTP enrollment has been started",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserMfaManager.StartTotpEnrollment] execute an operation: %w", err)
+	}
+	return e, nil
+}
+
+// ConfirmTotpEnrollment enables TOTP of the user by the specified user ID if the code is valid
+// and returns the user's recovery codes. The recovery codes are only returned once.
+func (m *UserMfaManager) ConfirmTotpEnrollment(ctx *actions.OperationContext, userId uint64, code string) ([]string, error) {
+	var codes []string
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_ConfirmTotpEnrollment, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.userTotpStore.FindByUserId(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.ConfirmTotpEnrollment] find the user's TOTP by user id: %w", err)
+			}
+
+			if t == nil {
+				return ierrors.ErrUserTotpNotFound
+			}
+
+			if t.Status != models.TotpStatusPending {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("invalid TOTP status (%v)", t.Status))
+			}
+
+			step, ok := totp.Validate(code, t.Secret, time.Now(), totp.DefaultParams)
+			if !ok {
+				return ierrors.ErrInvalidMfaCode
+			}
+
+			var hashes [][]byte
+			if codes, hashes, err = generateRecoveryCodes(); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.ConfirmTotpEnrollment] generate recovery codes: %w", err)
+			}
+
+			if err = m.userTotpStore.Enable(opCtx, userId, step, hashes); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.ConfirmTotpEnrollment] enable TOTP: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.UserMfaManager.ConfirmTotpEnrollment] TOTP has been enabled",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserMfaManager.ConfirmTotpEnrollment] execute an operation: %w", err)
+	}
+	return codes, nil
+}
+
+// DisableTotp disables TOTP and deletes the recovery codes of the user by the specified user ID.
+func (m *UserMfaManager) DisableTotp(ctx *actions.OperationContext, userId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_DisableTotp, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.userTotpStore.Delete(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.DisableTotp] delete the user's TOTP: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.UserMfaManager.DisableTotp] TOTP has been disabled",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserMfaManager.DisableTotp] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user by the specified user ID
+// and returns the new recovery codes.
+func (m *UserMfaManager) RegenerateRecoveryCodes(ctx *actions.OperationContext, userId uint64) ([]string, error) {
+	var codes []string
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_RegenerateRecoveryCodes, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var hashes [][]byte
+			var err error
+			if codes, hashes, err = generateRecoveryCodes(); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.RegenerateRecoveryCodes] generate recovery codes: %w", err)
+			}
+
+			if err = m.userTotpStore.ReplaceRecoveryCodes(opCtx, userId, hashes); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.RegenerateRecoveryCodes] replace recovery codes: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserMfaEvent,
+				"[manager.UserMfaManager.RegenerateRecoveryCodes] recovery codes have been regenerated",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserMfaManager.RegenerateRecoveryCodes] execute an operation: %w", err)
+	}
+	return codes, nil
+}
+
+// GetStatus gets the MFA status of the user by the specified user ID.
+func (m *UserMfaManager) GetStatus(ctx *actions.OperationContext, userId uint64) (*models.UserMfaStatus, error) {
+	var s *models.UserMfaStatus
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_GetStatus, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			g, err := m.userManager.GetGroupById(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.GetStatus] get a user's group by id: %w", err)
+			}
+
+			s = new(models.UserMfaStatus)
+			if s.IsRequired, err = m.isRequired(opCtx, userId, g); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.GetStatus] is MFA required: %w", err)
+			}
+
+			t, err := m.userTotpStore.FindByUserId(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.GetStatus] find the user's TOTP by user id: %w", err)
+			}
+
+			if t == nil || t.Status != models.TotpStatusEnabled {
+				return nil
+			}
+
+			s.IsTotpEnabled = true
+			s.TotpEnabledAt = nullable.FromPtr(t.EnabledAt)
+			if s.RecoveryCodesLeft, err = m.userTotpStore.CountRecoveryCodes(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.GetStatus] count recovery codes: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserMfaManager.GetStatus] execute an operation: %w", err)
+	}
+	return s, nil
+}
+
+// IsTotpEnabled returns true if TOTP of the user is enabled.
+func (m *UserMfaManager) IsTotpEnabled(ctx *actions.OperationContext, userId uint64) (bool, error) {
+	var enabled bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_IsTotpEnabled, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.userTotpStore.FindByUserId(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.IsTotpEnabled] find the user's TOTP by user id: %w", err)
+			}
+
+			enabled = t != nil && t.Status == models.TotpStatusEnabled
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.IsTotpEnabled] execute an operation: %w", err)
+	}
+	return enabled, nil
+}
+
+// IsRequired returns true if MFA is required for the user by the policy.
+func (m *UserMfaManager) IsRequired(ctx *actions.OperationContext, userId uint64, group groupmodels.UserGroup) (bool, error) {
+	var required bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_IsRequired,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("group", group)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if required, err = m.isRequired(opCtx, userId, group); err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.IsRequired] is MFA required: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.IsRequired] execute an operation: %w", err)
+	}
+	return required, nil
+}
+
+func (m *UserMfaManager) isRequired(ctx *actions.OperationContext, userId uint64, group groupmodels.UserGroup) (bool, error) {
+	p := m.config.Policy
+	if p == nil {
+		return false, nil
+	}
+
+	mgs, err := m.groupMemberManager.GetAllGroupIdsByUserId(ctx, userId)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.isRequired] get the IDs of the groups of which the user is a member: %w", err)
+	}
+
+	// the user's group precedes the groups of which the user is a member
+	gs := make([]groupmodels.UserGroup, 0, len(mgs)+1)
+	gs = append(gs, group)
+	gs = append(gs, mgs...)
+
+	for _, g := range gs {
+		if slices.Contains(p.RequiredUserGroups, g) {
+			return true, nil
+		}
+	}
+
+	if len(p.RequiredRoles) == 0 {
+		return false, nil
+	}
+
+	rs, err := m.roleManager.GetAllByNamesWithContext(ctx, p.RequiredRoles)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.isRequired] get all roles by names: %w", err)
+	}
+
+	if len(rs) == 0 {
+		return false, nil
+	}
+
+	rids := make([]uint64, len(rs))
+	for i := 0; i < len(rs); i++ {
+		rids[i] = rs[i].Id
+	}
+
+	// the roles that inherit from the required roles require MFA as well
+	drids, err := m.roleInheritanceManager.GetAllDescendantRoleIds(ctx, rids)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.isRequired] get the IDs of all roles that inherit from the required roles: %w", err)
+	}
+	rids = append(rids, drids...)
+
+	ids, err := m.userRoleAssignmentManager.GetUserRoleIdsByUserId(ctx, userId, rids)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.isRequired] get the IDs of the user's roles: %w", err)
+	}
+
+	if len(ids) > 0 {
+		return true, nil
+	}
+
+	for _, g := range gs {
+		if ids, err = m.groupRoleAssignmentManager.GetGroupRoleIdsByGroup(ctx, g, rids); err != nil {
+			return false, fmt.Errorf("[manager.UserMfaManager.isRequired] get the IDs of the group's roles: %w", err)
+		}
+
+		if len(ids) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Verify returns true if the TOTP code or the recovery code of the user is valid.
+// The code can't be used again.
+func (m *UserMfaManager) Verify(ctx *actions.OperationContext, userId uint64, method models.MfaMethod, code string) (bool, error) {
+	var valid bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserMfaManager_Verify,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("method", method)},
+		func(opCtx *actions.OperationContext) error {
+			t, err := m.userTotpStore.FindByUserId(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.UserMfaManager.Verify] find the user's TOTP by user id: %w", err)
+			}
+
+			if t == nil || t.Status != models.TotpStatusEnabled {
+				return ierrors.ErrUserTotpNotFound
+			}
+
+			switch method {
+			case models.MfaMethodTotp:
+				step, ok := totp.Validate(code, t.Secret, time.Now(), totp.DefaultParams)
+				if !ok {
+					return nil
+				}
+				// a code of the same or earlier time step is rejected to prevent code reuse
+				if valid, err = m.userTotpStore.SetLastUsedStep(opCtx, userId, step); err != nil {
+					return fmt.Errorf("[manager.UserMfaManager.Verify] set the time step of the last used code: %w", err)
+				}
+			case models.MfaMethodRecoveryCode:
+				if valid, err = m.userTotpStore.UseRecoveryCode(opCtx, userId, hashRecoveryCode(normalizeRecoveryCode(code))); err != nil {
+					return fmt.Errorf("[manager.UserMfaManager.Verify] use a recovery code: %w", err)
+				}
+				if valid {
+					m.logger.InfoWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.UserMfaEvent,
+						"[manager.UserMfaManager.Verify] recovery code has been used",
+						logging.NewField("userId", userId),
+					)
+				}
+			default:
+				return errors.NewError(errors.ErrorCodeInvalidOperation, fmt.Sprintf("'%v' MFA method isn't supported", method))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.UserMfaManager.Verify] execute an operation: %w", err)
+	}
+	return valid, nil
+}
+
+// generateRecoveryCodes generates recovery codes and returns them (in the format "xxxxx-xxxxx")
+// and their hashes.
+func generateRecoveryCodes() ([]string, [][]byte, error) {
+	codes := make([]string, models.RecoveryCodeCount)
+	hashes := make([][]byte, models.RecoveryCodeCount)
+	b := make([]byte, recoveryCodeEncoding.DecodedLen(models.RecoveryCodeLength)+1)
+
+	for i := 0; i < models.RecoveryCodeCount; i++ {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("[manager.generateRecoveryCodes] generate random bytes: %w", err)
+		}
+
+		c := strings.ToLower(recoveryCodeEncoding.EncodeToString(b)[:models.RecoveryCodeLength])
+		codes[i] = c[:models.RecoveryCodeLength/2] + "-" + c[models.RecoveryCodeLength/2:]
+		hashes[i] = hashRecoveryCode(c)
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode removes separators from the recovery code and converts it to lower case.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+func hashRecoveryCode(code string) []byte {
+	h := sha256.Sum256([]byte(code))
+	return h[:]
+}
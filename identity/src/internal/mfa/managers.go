@@ -0,0 +1,69 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/mfa/dbmodels"
+	"personal-website-v2/identity/src/internal/mfa/models"
+	"personal-website-v2/identity/src/internal/mfa/operations/challenges"
+	"personal-website-v2/pkg/actions"
+)
+
+// UserMfaManager is a manager of users' MFA.
+type UserMfaManager interface {
+	// StartTotpEnrollment starts the TOTP enrollment of the user by the specified user ID
+	// and returns the TOTP secret and the provisioning URI.
+	StartTotpEnrollment(ctx *actions.OperationContext, userId uint64) (*models.TotpEnrollment, error)
+
+	// ConfirmTotpEnrollment enables TOTP of the user by the specified user ID if the code is valid
+	// and returns the user's recovery codes. The recovery codes are only returned once.
+	ConfirmTotpEnrollment(ctx *actions.OperationContext, userId uint64, code string) ([]string, error)
+
+	// DisableTotp disables TOTP and deletes the recovery codes of the user by the specified user ID.
+	DisableTotp(ctx *actions.OperationContext, userId uint64) error
+
+	// RegenerateRecoveryCodes replaces the recovery codes of the user by the specified user ID
+	// and returns the new recovery codes.
+	RegenerateRecoveryCodes(ctx *actions.OperationContext, userId uint64) ([]string, error)
+
+	// GetStatus gets the MFA status of the user by the specified user ID.
+	GetStatus(ctx *actions.OperationContext, userId uint64) (*models.UserMfaStatus, error)
+
+	// IsTotpEnabled returns true if TOTP of the user is enabled.
+	IsTotpEnabled(ctx *actions.OperationContext, userId uint64) (bool, error)
+
+	// IsRequired returns true if MFA is required for the user by the policy.
+	IsRequired(ctx *actions.OperationContext, userId uint64, group groupmodels.UserGroup) (bool, error)
+
+	// Verify returns true if the TOTP code or the recovery code of the user is valid.
+	// The code can't be used again.
+	Verify(ctx *actions.OperationContext, userId uint64, method models.MfaMethod, code string) (bool, error)
+}
+
+// MfaChallengeManager is a manager of MFA challenges.
+type MfaChallengeManager interface {
+	// Create creates an MFA challenge that must be completed to sign in the user.
+	Create(ctx *actions.OperationContext, data *challenges.CreateOperationData) (*models.MfaChallenge, error)
+
+	// FindByToken finds and returns an unexpired MFA challenge, if any, by the specified token.
+	// The challenge isn't modified.
+	FindByToken(ctx *actions.OperationContext, token string) (*dbmodels.MfaChallenge, error)
+
+	// Verify verifies the code of the MFA challenge by the specified token and returns the challenge
+	// and true if the code is valid. The challenge is deleted if the code is valid or after too many
+	// failed attempts.
+	Verify(ctx *actions.OperationContext, token string, method models.MfaMethod, code string) (*dbmodels.MfaChallenge, bool, error)
+}
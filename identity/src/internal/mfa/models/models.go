@@ -0,0 +1,91 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+const (
+	// The number of recovery codes generated for a user.
+	RecoveryCodeCount = 10
+
+	// The length of a recovery code (in characters, without separators).
+	RecoveryCodeLength = 10
+)
+
+// The TOTP status.
+type TotpStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	// The enrollment has been started, but not confirmed with a valid code.
+	TotpStatusPending TotpStatus = 1
+
+	TotpStatusEnabled TotpStatus = 2
+)
+
+// MfaPolicy is a policy that requires MFA for users of the specified groups or with the specified roles.
+type MfaPolicy struct {
+	// The user groups whose users are required to use MFA.
+	RequiredUserGroups []groupmodels.UserGroup
+
+	// The names of the roles whose users are required to use MFA.
+	RequiredRoles []string
+}
+
+type TotpEnrollment struct {
+	// The base32-encoded TOTP secret (for manual entry).
+	Secret string
+
+	// The provisioning URI (otpauth://) used by authenticator apps (usually as a QR code).
+	ProvisioningURI string
+}
+
+type UserMfaStatus struct {
+	// True if TOTP is enabled.
+	IsTotpEnabled bool
+
+	// Optional. It stores the date and time at which TOTP was enabled.
+	TotpEnabledAt nullable.Nullable[time.Time]
+
+	// The number of unused recovery codes.
+	RecoveryCodesLeft int
+
+	// True if MFA is required for the user by the policy.
+	IsRequired bool
+}
+
+// The MFA method used to complete an MFA challenge.
+type MfaMethod uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	MfaMethodTotp         MfaMethod = 1
+	MfaMethodRecoveryCode MfaMethod = 2
+)
+
+type MfaChallenge struct {
+	// The MFA challenge token that is used to complete the challenge.
+	Token string
+
+	// The date and time at which the challenge expires.
+	ExpiresAt time.Time
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package challenges
+
+import (
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+type CreateOperationData struct {
+	// The user ID.
+	UserId uint64 `json:"userId"`
+
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The app ID.
+	AppId nullable.Nullable[uint64] `json:"appId"`
+
+	// The User-Agent.
+	UserAgent string `json:"userAgent"`
+
+	// The IP address (sign-in IP address).
+	IP string `json:"ip"`
+}
+
+func (d *CreateOperationData) Validate() *errors.Error {
+	if strings.IsEmptyOrWhitespace(d.UserAgent) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "userAgent is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.IP) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "ip is empty")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/mfa/dbmodels"
+	"personal-website-v2/identity/src/internal/mfa/operations/challenges"
+	"personal-website-v2/pkg/actions"
+)
+
+// UserTotpStore is a store of users' TOTP and recovery codes.
+type UserTotpStore interface {
+	// CreatePending creates a pending TOTP of the user by the specified user ID or replaces
+	// the existing pending one.
+	CreatePending(ctx *actions.OperationContext, userId uint64, secret []byte) error
+
+	// Enable enables the pending TOTP of the user by the specified user ID, sets the time step
+	// of the last used code and replaces the user's recovery codes.
+	Enable(ctx *actions.OperationContext, userId uint64, lastUsedStep uint64, recoveryCodeHashes [][]byte) error
+
+	// Delete deletes the TOTP, recovery codes and MFA challenges of the user by the specified user ID.
+	Delete(ctx *actions.OperationContext, userId uint64) error
+
+	// FindByUserId finds and returns the user's TOTP, if any, by the specified user ID.
+	FindByUserId(ctx *actions.OperationContext, userId uint64) (*dbmodels.UserTotp, error)
+
+	// SetLastUsedStep sets the time step of the last used code and returns true if the step
+	// is later than the step of the last used code; otherwise, returns false (the code has already been used).
+	SetLastUsedStep(ctx *actions.OperationContext, userId uint64, step uint64) (bool, error)
+
+	// ReplaceRecoveryCodes replaces the recovery codes of the user by the specified user ID.
+	ReplaceRecoveryCodes(ctx *actions.OperationContext, userId uint64, recoveryCodeHashes [][]byte) error
+
+	// UseRecoveryCode marks the user's recovery code as used and returns true
+	// if the unused recovery code exists.
+	UseRecoveryCode(ctx *actions.OperationContext, userId uint64, codeHash []byte) (bool, error)
+
+	// CountRecoveryCodes returns the number of unused recovery codes of the user by the specified user ID.
+	CountRecoveryCodes(ctx *actions.OperationContext, userId uint64) (int, error)
+}
+
+// MfaChallengeStore is a store of MFA challenges.
+type MfaChallengeStore interface {
+	// Create creates an MFA challenge and returns the challenge ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *challenges.CreateOperationData, tokenHash []byte, ttl time.Duration) (uint64, error)
+
+	// FindByTokenHash finds and returns an MFA challenge, if any, by the specified token hash.
+	FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.MfaChallenge, error)
+
+	// RegisterFailedAttempt registers a failed attempt to complete the MFA challenge and
+	// deletes the challenge if the number of failed attempts reaches the limit.
+	// It returns true if the challenge has been deleted.
+	RegisterFailedAttempt(ctx *actions.OperationContext, id uint64, maxFailedAttempts int) (bool, error)
+
+	// Delete deletes the MFA challenge by the specified ID and returns true if it has been deleted.
+	Delete(ctx *actions.OperationContext, id uint64) (bool, error)
+}
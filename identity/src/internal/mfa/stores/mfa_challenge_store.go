@@ -0,0 +1,211 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/identity/src/internal/mfa/dbmodels"
+	"personal-website-v2/identity/src/internal/mfa/operations/challenges"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	mfaChallengesTable = "public.mfa_challenges"
+)
+
+// MfaChallengeStore is a store of MFA challenges.
+type MfaChallengeStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.MfaChallenge]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ mfa.MfaChallengeStore = (*MfaChallengeStore)(nil)
+
+func NewMfaChallengeStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*MfaChallengeStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.mfa.stores.MfaChallengeStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewMfaChallengeStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupUserMfa,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewMfaChallengeStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewMfaChallengeStore] new TxManager: %w", err)
+	}
+
+	return &MfaChallengeStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.MfaChallenge](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates an MFA challenge and returns the challenge ID if the operation is successful.
+func (s *MfaChallengeStore) Create(ctx *actions.OperationContext, data *challenges.CreateOperationData, tokenHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeStore_Create,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data), actions.NewOperationParam("ttl", ttl)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_mfa_challenge(IN _user_id, IN _token_hash, IN _client_id, IN _app_id, IN _user_agent, IN _ip, IN _ttl,
+				// OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_mfa_challenge($1, $2, $3, $4, $5, $6, $7, NULL, NULL, NULL)"
+
+				r := tx.QueryRow(txCtx, query, data.UserId, tokenHash, data.ClientId, data.AppId.Ptr(), data.UserAgent, data.IP, ttl)
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.MfaChallengeStore.Create] execute a query (create_mfa_challenge): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.MfaChallengeStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.MfaChallengeStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.MfaChallengeStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindByTokenHash finds and returns an MFA challenge, if any, by the specified token hash.
+func (s *MfaChallengeStore) FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.MfaChallenge, error) {
+	var c *dbmodels.MfaChallenge
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeStore_FindByTokenHash, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + mfaChallengesTable + " WHERE token_hash = $1 LIMIT 1"
+			var err error
+			if c, err = s.store.Find(opCtx.Ctx, query, tokenHash); err != nil {
+				return fmt.Errorf("[stores.MfaChallengeStore.FindByTokenHash] find an MFA challenge by token hash: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.MfaChallengeStore.FindByTokenHash] execute an operation: %w", err)
+	}
+	return c, nil
+}
+
+// RegisterFailedAttempt registers a failed attempt to complete the MFA challenge and
+// deletes the challenge if the number of failed attempts reaches the limit.
+// It returns true if the challenge has been deleted.
+func (s *MfaChallengeStore) RegisterFailedAttempt(ctx *actions.OperationContext, id uint64, maxFailedAttempts int) (bool, error) {
+	var isDeleted bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeStore_RegisterFailedAttempt,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("maxFailedAttempts", maxFailedAttempts)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.register_mfa_challenge_failed_attempt(IN _id, IN _max_failed_attempts, OUT _is_deleted, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.register_mfa_challenge_failed_attempt($1, $2, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id, maxFailedAttempts).Scan(&isDeleted, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.MfaChallengeStore.RegisterFailedAttempt] execute a query (register_mfa_challenge_failed_attempt): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.MfaChallengeStore.RegisterFailedAttempt] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.MfaChallengeStore.RegisterFailedAttempt] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.MfaChallengeStore.RegisterFailedAttempt] execute an operation: %w", err)
+	}
+	return isDeleted, nil
+}
+
+// Delete deletes the MFA challenge by the specified ID and returns true if it has been deleted.
+func (s *MfaChallengeStore) Delete(ctx *actions.OperationContext, id uint64) (bool, error) {
+	var isDeleted bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeMfaChallengeStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_mfa_challenge(IN _id, OUT _is_deleted, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_mfa_challenge($1, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&isDeleted, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.MfaChallengeStore.Delete] execute a query (delete_mfa_challenge): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.MfaChallengeStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.MfaChallengeStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.MfaChallengeStore.Delete] execute an operation: %w", err)
+	}
+	return isDeleted, nil
+}
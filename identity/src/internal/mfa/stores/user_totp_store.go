@@ -0,0 +1,353 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/mfa"
+	"personal-website-v2/identity/src/internal/mfa/dbmodels"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	userTotpTable          = "public.user_totp"
+	userRecoveryCodesTable = "public.user_recovery_codes"
+)
+
+// UserTotpStore is a store of users' TOTP and recovery codes.
+type UserTotpStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.UserTotp]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ mfa.UserTotpStore = (*UserTotpStore)(nil)
+
+func NewUserTotpStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*UserTotpStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.mfa.stores.UserTotpStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserTotpStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupUserMfa,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserTotpStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewUserTotpStore] new TxManager: %w", err)
+	}
+
+	return &UserTotpStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.UserTotp](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// CreatePending creates a pending TOTP of the user by the specified user ID or replaces
+// the existing pending one.
+func (s *UserTotpStore) CreatePending(ctx *actions.OperationContext, userId uint64, secret []byte) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_CreatePending, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_pending_user_totp(IN _user_id, IN _secret, IN _created_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_pending_user_totp($1, $2, $3, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, secret, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.CreatePending] execute a query (create_pending_user_totp): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserTotpStore.CreatePending] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.CreatePending] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserTotpStore.CreatePending] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Enable enables the pending TOTP of the user by the specified user ID, sets the time step
+// of the last used code and replaces the user's recovery codes.
+func (s *UserTotpStore) Enable(ctx *actions.OperationContext, userId uint64, lastUsedStep uint64, recoveryCodeHashes [][]byte) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_Enable, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.enable_user_totp(IN _user_id, IN _last_used_step, IN _recovery_code_hashes, IN _updated_by, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.enable_user_totp($1, $2, $3, $4, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, lastUsedStep, recoveryCodeHashes, opCtx.UserId.Ptr()).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.Enable] execute a query (enable_user_totp): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserTotpNotFound:
+					return ierrors.ErrUserTotpNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserTotpStore.Enable] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.Enable] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserTotpStore.Enable] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the TOTP, recovery codes and MFA challenges of the user by the specified user ID.
+func (s *UserTotpStore) Delete(ctx *actions.OperationContext, userId uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_Delete, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_user_totp(IN _user_id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_user_totp($1, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.Delete] execute a query (delete_user_totp): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserTotpNotFound:
+					return ierrors.ErrUserTotpNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserTotpStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserTotpStore.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindByUserId finds and returns the user's TOTP, if any, by the specified user ID.
+func (s *UserTotpStore) FindByUserId(ctx *actions.OperationContext, userId uint64) (*dbmodels.UserTotp, error) {
+	var t *dbmodels.UserTotp
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_FindByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + userTotpTable + " WHERE user_id = $1 LIMIT 1"
+			var err error
+			if t, err = s.store.Find(opCtx.Ctx, query, userId); err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.FindByUserId] find the user's TOTP by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserTotpStore.FindByUserId] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// SetLastUsedStep sets the time step of the last used code and returns true if the step
+// is later than the step of the last used code; otherwise, returns false (the code has already been used).
+func (s *UserTotpStore) SetLastUsedStep(ctx *actions.OperationContext, userId uint64, step uint64) (bool, error) {
+	var isSet bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_SetLastUsedStep,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("step", step)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.set_user_totp_last_used_step(IN _user_id, IN _step, OUT _is_set, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.set_user_totp_last_used_step($1, $2, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, step).Scan(&isSet, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.SetLastUsedStep] execute a query (set_user_totp_last_used_step): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserTotpNotFound:
+					return ierrors.ErrUserTotpNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserTotpStore.SetLastUsedStep] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.SetLastUsedStep] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.UserTotpStore.SetLastUsedStep] execute an operation: %w", err)
+	}
+	return isSet, nil
+}
+
+// ReplaceRecoveryCodes replaces the recovery codes of the user by the specified user ID.
+func (s *UserTotpStore) ReplaceRecoveryCodes(ctx *actions.OperationContext, userId uint64, recoveryCodeHashes [][]byte) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_ReplaceRecoveryCodes, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.replace_user_recovery_codes(IN _user_id, IN _recovery_code_hashes, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.replace_user_recovery_codes($1, $2, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, recoveryCodeHashes).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.ReplaceRecoveryCodes] execute a query (replace_user_recovery_codes): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserTotpNotFound:
+					return ierrors.ErrUserTotpNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserTotpStore.ReplaceRecoveryCodes] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.ReplaceRecoveryCodes] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.UserTotpStore.ReplaceRecoveryCodes] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode marks the user's recovery code as used and returns true
+// if the unused recovery code exists.
+func (s *UserTotpStore) UseRecoveryCode(ctx *actions.OperationContext, userId uint64, codeHash []byte) (bool, error) {
+	var isUsed bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_UseRecoveryCode, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.use_user_recovery_code(IN _user_id, IN _code_hash, OUT _is_used, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.use_user_recovery_code($1, $2, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, codeHash).Scan(&isUsed, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserTotpStore.UseRecoveryCode] execute a query (use_user_recovery_code): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.UserTotpStore.UseRecoveryCode] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.UseRecoveryCode] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.UserTotpStore.UseRecoveryCode] execute an operation: %w", err)
+	}
+	return isUsed, nil
+}
+
+// CountRecoveryCodes returns the number of unused recovery codes of the user by the specified user ID.
+func (s *UserTotpStore) CountRecoveryCodes(ctx *actions.OperationContext, userId uint64) (int, error) {
+	var count int
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserTotpStore_CountRecoveryCodes, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.CountRecoveryCodes] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT COUNT(*) FROM " + userRecoveryCodesTable + " WHERE user_id = $1 AND used_at IS NULL"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, userId).Scan(&count); err != nil {
+				return fmt.Errorf("[stores.UserTotpStore.CountRecoveryCodes] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.UserTotpStore.CountRecoveryCodes] execute an operation: %w", err)
+	}
+	return count, nil
+}
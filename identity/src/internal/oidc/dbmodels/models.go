@@ -0,0 +1,72 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import "time"
+
+type AuthorizationCode struct {
+	// The unique ID to identify the authorization code.
+	Id uint64 `db:"id"`
+
+	// The SHA-256 hash of the authorization code.
+	CodeHash []byte `db:"code_hash"`
+
+	// The client ID to which the code has been issued.
+	ClientId uint64 `db:"client_id"`
+
+	// The user ID who has authorized the client.
+	UserId uint64 `db:"user_id"`
+
+	// The redirect URI of the authorization request.
+	RedirectURI string `db:"redirect_uri"`
+
+	// The granted scopes.
+	Scopes []string `db:"scopes"`
+
+	// Optional. The nonce of the authorization request.
+	Nonce *string `db:"nonce"`
+
+	// The PKCE code challenge (S256).
+	CodeChallenge string `db:"code_challenge"`
+
+	// It stores the date and time at which the code was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the code expires.
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+type RefreshToken struct {
+	// The unique ID to identify the refresh token.
+	Id uint64 `db:"id"`
+
+	// The SHA-256 hash of the refresh token.
+	TokenHash []byte `db:"token_hash"`
+
+	// The client ID to which the token has been issued.
+	ClientId uint64 `db:"client_id"`
+
+	// The user ID who has authorized the client.
+	UserId uint64 `db:"user_id"`
+
+	// The granted scopes.
+	Scopes []string `db:"scopes"`
+
+	// It stores the date and time at which the token was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// It stores the date and time at which the token expires.
+	ExpiresAt time.Time `db:"expires_at"`
+}
@@ -0,0 +1,708 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/clients"
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/oidc"
+	"personal-website-v2/identity/src/internal/oidc/models"
+	"personal-website-v2/identity/src/internal/oidc/operations/codes"
+	"personal-website-v2/identity/src/internal/oidc/operations/tokens"
+	"personal-website-v2/identity/src/internal/permissions"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/identity/src/internal/users"
+	usermodels "personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/crypto/jwt"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	// The size of an authorization code and a refresh token (in bytes).
+	secretTokenSize = 32
+)
+
+var supportedScopes = []string{models.ScopeOpenId, models.ScopeProfile, models.ScopeEmail, models.ScopeOfflineAccess}
+
+type OidcManagerConfig struct {
+	// The issuer identifier (URL) of the OpenID provider.
+	Issuer string
+
+	// The private key that is used to sign the tokens.
+	SigningKey *rsa.PrivateKey
+
+	// The lifetime of an authorization code.
+	AuthorizationCodeTTL time.Duration
+
+	// The lifetime of an access token (and an ID token).
+	AccessTokenTTL time.Duration
+
+	// The lifetime of a refresh token.
+	RefreshTokenTTL time.Duration
+
+	// The names of the permissions that must be granted to the user for each scope.
+	// A scope without permissions can be granted to any active user.
+	ScopePermissions map[string][]string
+}
+
+type accessTokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	ClientId  string `json:"client_id"`
+	Scope     string `json:"scope"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	Id        string `json:"jti"`
+}
+
+type idTokenClaims struct {
+	Issuer          string  `json:"iss"`
+	Subject         string  `json:"sub"`
+	Audience        string  `json:"aud"`
+	IssuedAt        int64   `json:"iat"`
+	ExpiresAt       int64   `json:"exp"`
+	AuthorizedParty string  `json:"azp"`
+	Nonce           *string `json:"nonce,omitempty"`
+}
+
+// OidcManager is an OpenID Connect provider. It supports the authorization code flow with PKCE
+// and the refresh token grant.
+type OidcManager struct {
+	opExecutor              *actionhelper.OperationExecutor
+	config                  *OidcManagerConfig
+	keyId                   string
+	metadata                *models.ProviderMetadata
+	jwks                    *jwt.JWKSet
+	clientManager           clients.ClientManager
+	userManager             users.UserManager
+	userPersonalInfoManager users.UserPersonalInfoManager
+	permissionManager       permissions.PermissionManager
+	authzManager            authorization.AuthorizationManager
+	sessionRevocationList   sessions.SessionRevocationList
+	clientRegistrationStore oidc.ClientRegistrationStore
+	authorizationCodeStore  oidc.AuthorizationCodeStore
+	refreshTokenStore       oidc.RefreshTokenStore
+	logger                  logging.Logger[*context.LogEntryContext]
+}
+
+var _ oidc.OidcManager = (*OidcManager)(nil)
+
+func NewOidcManager(
+	config *OidcManagerConfig,
+	clientManager clients.ClientManager,
+	userManager users.UserManager,
+	userPersonalInfoManager users.UserPersonalInfoManager,
+	permissionManager permissions.PermissionManager,
+	authzManager authorization.AuthorizationManager,
+	sessionRevocationList sessions.SessionRevocationList,
+	clientRegistrationStore oidc.ClientRegistrationStore,
+	authorizationCodeStore oidc.AuthorizationCodeStore,
+	refreshTokenStore oidc.RefreshTokenStore,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*OidcManager, error) {
+	if config.SigningKey == nil {
+		return nil, errors.NewError(errors.ErrorCodeInvalidData, "[manager.NewOidcManager] signing key is nil")
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.oidc.manager.OidcManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewOidcManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupOidc,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewOidcManager] new operation executor: %w", err)
+	}
+
+	issuer := strings.TrimSuffix(config.Issuer, "/")
+	kid := jwt.Thumbprint(&config.SigningKey.PublicKey)
+
+	return &OidcManager{
+		opExecutor: e,
+		config:     config,
+		keyId:      kid,
+		metadata: &models.ProviderMetadata{
+			Issuer:                            issuer,
+			AuthorizationEndpoint:             issuer + models.AuthorizationEndpointPath,
+			TokenEndpoint:                     issuer + models.TokenEndpointPath,
+			UserInfoEndpoint:                  issuer + models.UserInfoEndpointPath,
+			JwksURI:                           issuer + models.JwksPath,
+			ScopesSupported:                   supportedScopes,
+			ResponseTypesSupported:            []string{models.ResponseTypeCode},
+			GrantTypesSupported:               []string{models.GrantTypeAuthorizationCode, models.GrantTypeRefreshToken},
+			SubjectTypesSupported:             []string{"public"},
+			IdTokenSigningAlgValuesSupported:  []string{jwt.AlgRS256},
+			TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+			CodeChallengeMethodsSupported:     []string{models.CodeChallengeMethodS256},
+			ClaimsSupported:                   []string{"iss", "sub", "aud", "iat", "exp", "azp", "nonce", "name", "given_name", "family_name", "preferred_username", "email"},
+		},
+		jwks:                    &jwt.JWKSet{Keys: []*jwt.JWK{jwt.NewRS256JWK(&config.SigningKey.PublicKey, kid)}},
+		clientManager:           clientManager,
+		userManager:             userManager,
+		userPersonalInfoManager: userPersonalInfoManager,
+		permissionManager:       permissionManager,
+		authzManager:            authzManager,
+		sessionRevocationList:   sessionRevocationList,
+		clientRegistrationStore: clientRegistrationStore,
+		authorizationCodeStore:  authorizationCodeStore,
+		refreshTokenStore:       refreshTokenStore,
+		logger:                  l,
+	}, nil
+}
+
+// GetProviderMetadata returns the OpenID provider metadata (discovery document).
+func (m *OidcManager) GetProviderMetadata() *models.ProviderMetadata {
+	return m.metadata
+}
+
+// GetJwks returns the JWK Set containing the public keys that are used to verify the tokens.
+func (m *OidcManager) GetJwks() *jwt.JWKSet {
+	return m.jwks
+}
+
+// ValidateRedirectURI returns an error if the client isn't registered or isn't active,
+// or the redirect URI isn't registered for the client.
+func (m *OidcManager) ValidateRedirectURI(ctx *actions.OperationContext, clientId uint64, redirectURI string) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeOidcManager_ValidateRedirectURI,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("redirectURI", redirectURI)},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.getClientRegistration(opCtx, clientId)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.ValidateRedirectURI] get a client registration: %w", err)
+			}
+
+			if !slices.Contains(r.RedirectURIs, redirectURI) {
+				return ierrors.ErrOidcInvalidRedirectURI
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.OidcManager.ValidateRedirectURI] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Authorize authorizes the client to access the user's resources and returns an authorization code
+// if the operation is successful. Only the scopes whose permissions are granted to the user are granted to the client.
+func (m *OidcManager) Authorize(ctx *actions.OperationContext, userId uint64, req *models.AuthorizationRequest) (string, error) {
+	var code string
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeOidcManager_Authorize,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId),
+			actions.NewOperationParam("clientId", req.ClientId),
+			actions.NewOperationParam("redirectURI", req.RedirectURI),
+			actions.NewOperationParam("scopes", req.Scopes),
+		},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.getClientRegistration(opCtx, req.ClientId)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.Authorize] get a client registration: %w", err)
+			}
+
+			if !slices.Contains(r.RedirectURIs, req.RedirectURI) {
+				return ierrors.ErrOidcInvalidRedirectURI
+			}
+
+			if req.CodeChallengeMethod != models.CodeChallengeMethodS256 || len(req.CodeChallenge) == 0 {
+				return errors.NewError(ierrors.ErrorCodeOidcInvalidRequest, "code challenge (S256) is required")
+			}
+
+			if len(req.Scopes) == 0 {
+				return errors.NewError(ierrors.ErrorCodeOidcInvalidScope, "number of scopes is 0")
+			}
+
+			for _, s := range req.Scopes {
+				if !slices.Contains(supportedScopes, s) || !slices.Contains(r.Scopes, s) {
+					return errors.NewError(ierrors.ErrorCodeOidcInvalidScope, fmt.Sprintf("scope '%s' isn't allowed", s))
+				}
+			}
+
+			scopes, err := m.getGrantedScopes(opCtx, userId, req.Scopes)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.Authorize] get granted scopes: %w", err)
+			}
+
+			if len(scopes) == 0 {
+				return ierrors.ErrOidcAccessDenied
+			}
+
+			if code, err = generateSecretToken(); err != nil {
+				return fmt.Errorf("[manager.OidcManager.Authorize] generate an authorization code: %w", err)
+			}
+
+			d := &codes.CreateOperationData{
+				ClientId:      req.ClientId,
+				UserId:        userId,
+				RedirectURI:   req.RedirectURI,
+				Scopes:        scopes,
+				Nonce:         req.Nonce,
+				CodeChallenge: req.CodeChallenge,
+			}
+
+			// only the hash of the code is stored
+			id, err := m.authorizationCodeStore.Create(opCtx, d, hashSecretToken(code), m.config.AuthorizationCodeTTL)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.Authorize] create an authorization code: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.OidcEvent,
+				"[manager.OidcManager.Authorize] client has been authorized",
+				logging.NewField("codeId", id),
+				logging.NewField("userId", userId),
+				logging.NewField("clientId", req.ClientId),
+				logging.NewField("scopes", scopes),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("[manager.OidcManager.Authorize] execute an operation: %w", err)
+	}
+	return code, nil
+}
+
+// ExchangeAuthorizationCode exchanges the authorization code for tokens.
+func (m *OidcManager) ExchangeAuthorizationCode(ctx *actions.OperationContext, grant *models.AuthorizationCodeGrant) (*models.Tokens, error) {
+	var t *models.Tokens
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeOidcManager_ExchangeAuthorizationCode,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", grant.ClientId), actions.NewOperationParam("redirectURI", grant.RedirectURI)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.authenticateClient(opCtx, grant.ClientId, grant.ClientSecret); err != nil {
+				return fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] authenticate a client: %w", err)
+			}
+
+			c, err := m.authorizationCodeStore.FindByCodeHash(opCtx, hashSecretToken(grant.Code))
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] find an authorization code by code hash: %w", err)
+			}
+
+			if c == nil {
+				return ierrors.ErrOidcInvalidGrant
+			}
+
+			// the code can only be used once
+			deleted, err := m.authorizationCodeStore.Delete(opCtx, c.Id)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] delete an authorization code: %w", err)
+			}
+
+			if !deleted || !c.ExpiresAt.After(time.Now()) || c.ClientId != grant.ClientId || c.RedirectURI != grant.RedirectURI ||
+				!verifyCodeChallenge(grant.CodeVerifier, c.CodeChallenge) {
+				return ierrors.ErrOidcInvalidGrant
+			}
+
+			if err = m.checkUserStatus(opCtx, c.UserId); err != nil {
+				return fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] check a user's status: %w", err)
+			}
+
+			var nonce nullable.Nullable[string]
+			if c.Nonce != nil {
+				nonce = nullable.NewNullable(*c.Nonce)
+			}
+
+			if t, err = m.issueTokens(opCtx, c.UserId, c.ClientId, c.Scopes, nonce); err != nil {
+				return fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] issue tokens: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.OidcEvent,
+				"[manager.OidcManager.ExchangeAuthorizationCode] authorization code has been exchanged for tokens",
+				logging.NewField("codeId", c.Id),
+				logging.NewField("userId", c.UserId),
+				logging.NewField("clientId", c.ClientId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.ExchangeAuthorizationCode] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// RefreshToken exchanges the refresh token for new tokens. The refresh token is rotated.
+func (m *OidcManager) RefreshToken(ctx *actions.OperationContext, grant *models.RefreshTokenGrant) (*models.Tokens, error) {
+	var t *models.Tokens
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeOidcManager_RefreshToken,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", grant.ClientId), actions.NewOperationParam("scopes", grant.Scopes)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.authenticateClient(opCtx, grant.ClientId, grant.ClientSecret); err != nil {
+				return fmt.Errorf("[manager.OidcManager.RefreshToken] authenticate a client: %w", err)
+			}
+
+			rt, err := m.refreshTokenStore.FindByTokenHash(opCtx, hashSecretToken(grant.RefreshToken))
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.RefreshToken] find a refresh token by token hash: %w", err)
+			}
+
+			if rt == nil || rt.ClientId != grant.ClientId {
+				return ierrors.ErrOidcInvalidGrant
+			}
+
+			// the refresh token is rotated
+			deleted, err := m.refreshTokenStore.Delete(opCtx, rt.Id)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.RefreshToken] delete a refresh token: %w", err)
+			}
+
+			if !deleted || !rt.ExpiresAt.After(time.Now()) {
+				return ierrors.ErrOidcInvalidGrant
+			}
+
+			if revokedAt, ok := m.sessionRevocationList.Get(rt.UserId, rt.ClientId); ok && !rt.CreatedAt.After(revokedAt) {
+				// the user's session of the client has been revoked
+				if err = m.refreshTokenStore.DeleteAllByUserIdAndClientId(opCtx, rt.UserId, rt.ClientId); err != nil {
+					return fmt.Errorf("[manager.OidcManager.RefreshToken] delete all refresh tokens by user id and client id: %w", err)
+				}
+				return ierrors.ErrOidcInvalidGrant
+			}
+
+			scopes := rt.Scopes
+			if len(grant.Scopes) > 0 {
+				for _, s := range grant.Scopes {
+					if !slices.Contains(rt.Scopes, s) {
+						return errors.NewError(ierrors.ErrorCodeOidcInvalidScope, fmt.Sprintf("scope '%s' hasn't been granted", s))
+					}
+				}
+				scopes = grant.Scopes
+			}
+
+			if err = m.checkUserStatus(opCtx, rt.UserId); err != nil {
+				return fmt.Errorf("[manager.OidcManager.RefreshToken] check a user's status: %w", err)
+			}
+
+			if t, err = m.issueTokens(opCtx, rt.UserId, rt.ClientId, scopes, nullable.Nullable[string]{}); err != nil {
+				return fmt.Errorf("[manager.OidcManager.RefreshToken] issue tokens: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.OidcEvent,
+				"[manager.OidcManager.RefreshToken] refresh token has been exchanged for new tokens",
+				logging.NewField("refreshTokenId", rt.Id),
+				logging.NewField("userId", rt.UserId),
+				logging.NewField("clientId", rt.ClientId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.RefreshToken] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// GetUserInfo returns the claims about the user by the specified access token.
+func (m *OidcManager) GetUserInfo(ctx *actions.OperationContext, accessToken string) (*models.UserInfo, error) {
+	var info *models.UserInfo
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeOidcManager_GetUserInfo, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			c := new(accessTokenClaims)
+			if err := jwt.VerifyRS256(accessToken, m.getPublicKey, c); err != nil {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			now := time.Now()
+			if c.Issuer != m.metadata.Issuer || c.Audience != m.metadata.Issuer || now.Unix() >= c.ExpiresAt {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			userId, err := strconv.ParseUint(c.Subject, 10, 64)
+			if err != nil {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			clientId, err := strconv.ParseUint(c.ClientId, 10, 64)
+			if err != nil {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			if revokedAt, ok := m.sessionRevocationList.Get(userId, clientId); ok && c.IssuedAt <= revokedAt.Unix() {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			scopes := strings.Fields(c.Scope)
+			if !slices.Contains(scopes, models.ScopeOpenId) {
+				return errors.NewError(ierrors.ErrorCodeOidcInvalidAccessToken, "scope 'openid' hasn't been granted")
+			}
+
+			u, err := m.userManager.FindById(opCtx, userId)
+			if err != nil {
+				return fmt.Errorf("[manager.OidcManager.GetUserInfo] find a user by id: %w", err)
+			}
+
+			if u == nil || u.Status != usermodels.UserStatusActive {
+				return ierrors.ErrOidcInvalidAccessToken
+			}
+
+			info = &models.UserInfo{Subject: c.Subject}
+
+			if slices.Contains(scopes, models.ScopeProfile) {
+				if u.Name != nil {
+					info.PreferredUsername = *u.Name
+				}
+
+				pi, err := m.userPersonalInfoManager.GetByUserId(opCtx, userId)
+				if err != nil {
+					if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodeUserPersonalInfoNotFound {
+						return fmt.Errorf("[manager.OidcManager.GetUserInfo] get user's personal info by user id: %w", err)
+					}
+				} else {
+					info.Name = pi.DisplayName
+					info.GivenName = pi.FirstName
+					info.FamilyName = pi.LastName
+				}
+			}
+
+			if slices.Contains(scopes, models.ScopeEmail) && u.Email != nil {
+				info.Email = *u.Email
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.GetUserInfo] execute an operation: %w", err)
+	}
+	return info, nil
+}
+
+// getClientRegistration returns the registration of the client if the client is active.
+func (m *OidcManager) getClientRegistration(ctx *actions.OperationContext, clientId uint64) (*models.ClientRegistration, error) {
+	r, err := m.clientRegistrationStore.FindByClientId(clientId)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.getClientRegistration] find a client registration by client id: %w", err)
+	}
+
+	if r == nil {
+		return nil, ierrors.ErrOidcInvalidClient
+	}
+
+	s, err := m.clientManager.GetStatusById(ctx, clientId)
+	if err != nil {
+		if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeClientNotFound {
+			return nil, ierrors.ErrOidcInvalidClient
+		}
+		return nil, fmt.Errorf("[manager.OidcManager.getClientRegistration] get a client status by id: %w", err)
+	}
+
+	if s != clientmodels.ClientStatusActive {
+		return nil, ierrors.ErrOidcInvalidClient
+	}
+	return r, nil
+}
+
+// authenticateClient authenticates the client at the token endpoint. Service clients are confidential
+// and must authenticate with their secret; web and mobile clients are public and must not send a secret.
+func (m *OidcManager) authenticateClient(ctx *actions.OperationContext, clientId uint64, secret string) error {
+	if _, err := m.getClientRegistration(ctx, clientId); err != nil {
+		return fmt.Errorf("[manager.OidcManager.authenticateClient] get a client registration: %w", err)
+	}
+
+	t, err := m.clientManager.GetTypeById(clientId)
+	if err != nil {
+		return ierrors.ErrOidcInvalidClient
+	}
+
+	if t != clientmodels.ClientTypeService {
+		if len(secret) > 0 {
+			return ierrors.ErrOidcInvalidClient
+		}
+		return nil
+	}
+
+	valid, err := m.clientManager.VerifyServiceClientSecret(ctx, clientId, secret)
+	if err != nil {
+		return fmt.Errorf("[manager.OidcManager.authenticateClient] verify a service client secret: %w", err)
+	}
+
+	if !valid {
+		return ierrors.ErrOidcInvalidClient
+	}
+	return nil
+}
+
+// getGrantedScopes returns the requested scopes whose permissions are granted to the user.
+func (m *OidcManager) getGrantedScopes(ctx *actions.OperationContext, userId uint64, scopes []string) ([]string, error) {
+	granted := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		pnames := m.config.ScopePermissions[s]
+		if len(pnames) == 0 {
+			granted = append(granted, s)
+			continue
+		}
+
+		ps, err := m.permissionManager.GetAllByNamesWithContext(ctx, pnames)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.OidcManager.getGrantedScopes] get all permissions by names: %w", err)
+		}
+
+		pids := make([]uint64, len(ps))
+		for i := 0; i < len(ps); i++ {
+			pids[i] = ps[i].Id
+		}
+
+		if _, err = m.authzManager.Authorize(ctx, nullable.NewNullable(userId), nullable.Nullable[uint64]{}, pids, nil); err != nil {
+			if err2 := errors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ErrorCodePermissionNotGranted {
+				return nil, fmt.Errorf("[manager.OidcManager.getGrantedScopes] authorize a user: %w", err)
+			}
+			continue
+		}
+		granted = append(granted, s)
+	}
+	return granted, nil
+}
+
+func (m *OidcManager) checkUserStatus(ctx *actions.OperationContext, userId uint64) error {
+	s, err := m.userManager.GetStatusById(ctx, userId)
+	if err != nil {
+		if err2 := errors.Unwrap(err); err2 != nil && err2.Code() == ierrors.ErrorCodeUserNotFound {
+			return ierrors.ErrOidcInvalidGrant
+		}
+		return fmt.Errorf("[manager.OidcManager.checkUserStatus] get a user's status by id: %w", err)
+	}
+
+	if s != usermodels.UserStatusActive {
+		return ierrors.ErrOidcInvalidGrant
+	}
+	return nil
+}
+
+func (m *OidcManager) issueTokens(ctx *actions.OperationContext, userId, clientId uint64, scopes []string, nonce nullable.Nullable[string]) (*models.Tokens, error) {
+	now := time.Now()
+	exp := now.Add(m.config.AccessTokenTTL).Unix()
+	sub := strconv.FormatUint(userId, 10)
+	cid := strconv.FormatUint(clientId, 10)
+
+	jti, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.issueTokens] new random uuid: %w", err)
+	}
+
+	at := &accessTokenClaims{
+		Issuer:    m.metadata.Issuer,
+		Subject:   sub,
+		Audience:  m.metadata.Issuer,
+		ClientId:  cid,
+		Scope:     strings.Join(scopes, " "),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: exp,
+		Id:        jti.String(),
+	}
+
+	t := &models.Tokens{
+		ExpiresIn: m.config.AccessTokenTTL,
+		Scopes:    scopes,
+	}
+
+	if t.AccessToken, err = jwt.SignRS256(at, m.config.SigningKey, m.keyId); err != nil {
+		return nil, fmt.Errorf("[manager.OidcManager.issueTokens] sign an access token: %w", err)
+	}
+
+	if slices.Contains(scopes, models.ScopeOpenId) {
+		it := &idTokenClaims{
+			Issuer:          m.metadata.Issuer,
+			Subject:         sub,
+			Audience:        cid,
+			IssuedAt:        now.Unix(),
+			ExpiresAt:       exp,
+			AuthorizedParty: cid,
+			Nonce:           nonce.Ptr(),
+		}
+
+		if t.IdToken, err = jwt.SignRS256(it, m.config.SigningKey, m.keyId); err != nil {
+			return nil, fmt.Errorf("[manager.OidcManager.issueTokens] sign an ID token: %w", err)
+		}
+	}
+
+	if slices.Contains(scopes, models.ScopeOfflineAccess) {
+		if t.RefreshToken, err = generateSecretToken(); err != nil {
+			return nil, fmt.Errorf("[manager.OidcManager.issueTokens] generate a refresh token: %w", err)
+		}
+
+		d := &tokens.CreateOperationData{
+			ClientId: clientId,
+			UserId:   userId,
+			Scopes:   scopes,
+		}
+
+		// only the hash of the token is stored
+		if _, err = m.refreshTokenStore.Create(ctx, d, hashSecretToken(t.RefreshToken), m.config.RefreshTokenTTL); err != nil {
+			return nil, fmt.Errorf("[manager.OidcManager.issueTokens] create a refresh token: %w", err)
+		}
+	}
+	return t, nil
+}
+
+func (m *OidcManager) getPublicKey(kid string) (*rsa.PublicKey, error) {
+	if kid != m.keyId {
+		return nil, jwt.ErrKeyNotFound
+	}
+	return &m.config.SigningKey.PublicKey, nil
+}
+
+func generateSecretToken() (string, error) {
+	b := make([]byte, secretTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSecretToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+// verifyCodeChallenge verifies the PKCE code verifier against the S256 code challenge (RFC 7636, section 4.6).
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	h := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(h[:])), []byte(challenge)) == 1
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"personal-website-v2/identity/src/internal/oidc/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/crypto/jwt"
+)
+
+// OidcManager is a manager of the OIDC/OAuth 2.0 authorization server.
+type OidcManager interface {
+	// GetProviderMetadata returns the OpenID provider metadata (discovery document).
+	GetProviderMetadata() *models.ProviderMetadata
+
+	// GetJwks returns the JWK Set containing the public keys that are used to verify the tokens.
+	GetJwks() *jwt.JWKSet
+
+	// ValidateRedirectURI returns an error if the client isn't registered or isn't active,
+	// or the redirect URI isn't registered for the client.
+	ValidateRedirectURI(ctx *actions.OperationContext, clientId uint64, redirectURI string) error
+
+	// Authorize authorizes the client to access the user's resources and returns an authorization code
+	// if the operation is successful. Only the scopes whose permissions are granted to the user are granted to the client.
+	Authorize(ctx *actions.OperationContext, userId uint64, req *models.AuthorizationRequest) (string, error)
+
+	// ExchangeAuthorizationCode exchanges the authorization code for tokens.
+	ExchangeAuthorizationCode(ctx *actions.OperationContext, grant *models.AuthorizationCodeGrant) (*models.Tokens, error)
+
+	// RefreshToken exchanges the refresh token for new tokens. The refresh token is rotated.
+	RefreshToken(ctx *actions.OperationContext, grant *models.RefreshTokenGrant) (*models.Tokens, error)
+
+	// GetUserInfo returns the claims about the user by the specified access token.
+	GetUserInfo(ctx *actions.OperationContext, accessToken string) (*models.UserInfo, error)
+}
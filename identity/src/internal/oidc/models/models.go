@@ -0,0 +1,164 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"personal-website-v2/pkg/base/nullable"
+)
+
+// The paths of the OIDC endpoints (relative to the issuer).
+const (
+	DiscoveryPath             = "/.well-known/openid-configuration"
+	JwksPath                  = "/oauth2/jwks"
+	AuthorizationEndpointPath = "/oauth2/authorize"
+	TokenEndpointPath         = "/oauth2/token"
+	UserInfoEndpointPath      = "/oauth2/userinfo"
+)
+
+// The scopes defined by OpenID Connect Core 1.0.
+const (
+	ScopeOpenId        = "openid"
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopeOfflineAccess = "offline_access"
+)
+
+const (
+	// The only supported response type (the authorization code flow).
+	ResponseTypeCode = "code"
+
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+
+	// The only supported PKCE code challenge method (RFC 7636).
+	CodeChallengeMethodS256 = "S256"
+)
+
+// ClientRegistration is the registration of the client (see ClientManager) as an OIDC client (relying party).
+type ClientRegistration struct {
+	// The client ID.
+	ClientId uint64
+
+	// The redirect URIs registered for the client. The redirect URI of the authorization request
+	// must exactly match one of them.
+	RedirectURIs []string
+
+	// The scopes that the client is allowed to request.
+	Scopes []string
+}
+
+type AuthorizationRequest struct {
+	// The client ID.
+	ClientId uint64
+
+	// The redirect URI to which the authorization response is sent.
+	RedirectURI string
+
+	// The requested scopes.
+	Scopes []string
+
+	// Optional. The value that is passed through unmodified to the ID token.
+	Nonce nullable.Nullable[string]
+
+	// The PKCE code challenge.
+	CodeChallenge string
+
+	// The PKCE code challenge method.
+	CodeChallengeMethod string
+}
+
+type AuthorizationCodeGrant struct {
+	// The client ID.
+	ClientId uint64
+
+	// The client secret. It is required for confidential (service) clients and must be empty for public clients.
+	ClientSecret string
+
+	// The authorization code.
+	Code string
+
+	// The redirect URI that was included in the authorization request.
+	RedirectURI string
+
+	// The PKCE code verifier.
+	CodeVerifier string
+}
+
+type RefreshTokenGrant struct {
+	// The client ID.
+	ClientId uint64
+
+	// The client secret. It is required for confidential (service) clients and must be empty for public clients.
+	ClientSecret string
+
+	// The refresh token.
+	RefreshToken string
+
+	// Optional. The requested scopes. They must not include any scope not originally granted.
+	// If it is empty, then the originally granted scopes are requested.
+	Scopes []string
+}
+
+// Tokens are the tokens issued by the token endpoint.
+type Tokens struct {
+	// The access token (JWT).
+	AccessToken string
+
+	// The lifetime of the access token.
+	ExpiresIn time.Duration
+
+	// Optional. The ID token (JWT). It is issued if the 'openid' scope has been granted.
+	IdToken string
+
+	// Optional. The refresh token. It is issued if the 'offline_access' scope has been granted.
+	RefreshToken string
+
+	// The granted scopes.
+	Scopes []string
+}
+
+// UserInfo contains the claims about the user (OpenID Connect Core 1.0, section 5.3.2).
+type UserInfo struct {
+	// The user ID.
+	Subject string `json:"sub"`
+
+	// The claims of the 'profile' scope.
+	Name              string `json:"name,omitempty"`
+	GivenName         string `json:"given_name,omitempty"`
+	FamilyName        string `json:"family_name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+
+	// The claims of the 'email' scope.
+	Email string `json:"email,omitempty"`
+}
+
+// ProviderMetadata is the OpenID provider metadata (OpenID Connect Discovery 1.0, section 3).
+type ProviderMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codes
+
+import (
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+type CreateOperationData struct {
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The user ID.
+	UserId uint64 `json:"userId"`
+
+	// The redirect URI of the authorization request.
+	RedirectURI string `json:"redirectURI"`
+
+	// The granted scopes.
+	Scopes []string `json:"scopes"`
+
+	// The nonce of the authorization request.
+	Nonce nullable.Nullable[string] `json:"nonce"`
+
+	// The PKCE code challenge (S256).
+	CodeChallenge string `json:"codeChallenge"`
+}
+
+func (d *CreateOperationData) Validate() *errors.Error {
+	if strings.IsEmptyOrWhitespace(d.RedirectURI) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "redirectURI is empty")
+	}
+	if len(d.Scopes) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "number of scopes is 0")
+	}
+	if strings.IsEmptyOrWhitespace(d.CodeChallenge) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "codeChallenge is empty")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokens
+
+import (
+	"personal-website-v2/pkg/errors"
+)
+
+type CreateOperationData struct {
+	// The client ID.
+	ClientId uint64 `json:"clientId"`
+
+	// The user ID.
+	UserId uint64 `json:"userId"`
+
+	// The granted scopes.
+	Scopes []string `json:"scopes"`
+}
+
+func (d *CreateOperationData) Validate() *errors.Error {
+	if len(d.Scopes) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "number of scopes is 0")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/oidc/dbmodels"
+	"personal-website-v2/identity/src/internal/oidc/models"
+	"personal-website-v2/identity/src/internal/oidc/operations/codes"
+	"personal-website-v2/identity/src/internal/oidc/operations/tokens"
+	"personal-website-v2/pkg/actions"
+)
+
+// ClientRegistrationStore is a store of the registrations of OIDC clients.
+type ClientRegistrationStore interface {
+	// FindByClientId finds and returns the client registration, if any, by the specified client ID.
+	FindByClientId(clientId uint64) (*models.ClientRegistration, error)
+}
+
+// AuthorizationCodeStore is a store of OIDC authorization codes.
+type AuthorizationCodeStore interface {
+	// Create creates an authorization code and returns the code ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *codes.CreateOperationData, codeHash []byte, ttl time.Duration) (uint64, error)
+
+	// FindByCodeHash finds and returns an authorization code, if any, by the specified code hash.
+	FindByCodeHash(ctx *actions.OperationContext, codeHash []byte) (*dbmodels.AuthorizationCode, error)
+
+	// Delete deletes the authorization code by the specified ID and returns true if it has been deleted.
+	Delete(ctx *actions.OperationContext, id uint64) (bool, error)
+}
+
+// RefreshTokenStore is a store of OIDC refresh tokens.
+type RefreshTokenStore interface {
+	// Create creates a refresh token and returns the token ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *tokens.CreateOperationData, tokenHash []byte, ttl time.Duration) (uint64, error)
+
+	// FindByTokenHash finds and returns a refresh token, if any, by the specified token hash.
+	FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.RefreshToken, error)
+
+	// Delete deletes the refresh token by the specified ID and returns true if it has been deleted.
+	Delete(ctx *actions.OperationContext, id uint64) (bool, error)
+
+	// DeleteAllByUserIdAndClientId deletes all refresh tokens of the user issued to the specified client.
+	DeleteAllByUserIdAndClientId(ctx *actions.OperationContext, userId, clientId uint64) error
+}
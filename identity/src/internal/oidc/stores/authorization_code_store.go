@@ -0,0 +1,174 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/oidc"
+	"personal-website-v2/identity/src/internal/oidc/dbmodels"
+	"personal-website-v2/identity/src/internal/oidc/operations/codes"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	authorizationCodesTable = "public.oidc_authorization_codes"
+)
+
+// AuthorizationCodeStore is a store of OIDC authorization codes.
+type AuthorizationCodeStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.AuthorizationCode]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ oidc.AuthorizationCodeStore = (*AuthorizationCodeStore)(nil)
+
+func NewAuthorizationCodeStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*AuthorizationCodeStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.oidc.stores.AuthorizationCodeStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewAuthorizationCodeStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupOidc,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewAuthorizationCodeStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewAuthorizationCodeStore] new TxManager: %w", err)
+	}
+
+	return &AuthorizationCodeStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.AuthorizationCode](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates an authorization code and returns the code ID if the operation is successful.
+func (s *AuthorizationCodeStore) Create(ctx *actions.OperationContext, data *codes.CreateOperationData, codeHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcAuthorizationCodeStore_Create,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data), actions.NewOperationParam("ttl", ttl)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_oidc_authorization_code(IN _code_hash, IN _client_id, IN _user_id, IN _redirect_uri, IN _scopes, IN _nonce,
+				// IN _code_challenge, IN _ttl, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_oidc_authorization_code($1, $2, $3, $4, $5, $6, $7, $8, NULL, NULL, NULL)"
+
+				r := tx.QueryRow(txCtx, query, codeHash, data.ClientId, data.UserId, data.RedirectURI, data.Scopes, data.Nonce.Ptr(), data.CodeChallenge, ttl)
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.AuthorizationCodeStore.Create] execute a query (create_oidc_authorization_code): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.AuthorizationCodeStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.AuthorizationCodeStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.AuthorizationCodeStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindByCodeHash finds and returns an authorization code, if any, by the specified code hash.
+func (s *AuthorizationCodeStore) FindByCodeHash(ctx *actions.OperationContext, codeHash []byte) (*dbmodels.AuthorizationCode, error) {
+	var c *dbmodels.AuthorizationCode
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcAuthorizationCodeStore_FindByCodeHash, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + authorizationCodesTable + " WHERE code_hash = $1 LIMIT 1"
+			var err error
+			if c, err = s.store.Find(opCtx.Ctx, query, codeHash); err != nil {
+				return fmt.Errorf("[stores.AuthorizationCodeStore.FindByCodeHash] find an authorization code by code hash: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.AuthorizationCodeStore.FindByCodeHash] execute an operation: %w", err)
+	}
+	return c, nil
+}
+
+// Delete deletes the authorization code by the specified ID and returns true if it has been deleted.
+func (s *AuthorizationCodeStore) Delete(ctx *actions.OperationContext, id uint64) (bool, error) {
+	var isDeleted bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcAuthorizationCodeStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_oidc_authorization_code(IN _id, OUT _is_deleted, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_oidc_authorization_code($1, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&isDeleted, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.AuthorizationCodeStore.Delete] execute a query (delete_oidc_authorization_code): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.AuthorizationCodeStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.AuthorizationCodeStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.AuthorizationCodeStore.Delete] execute an operation: %w", err)
+	}
+	return isDeleted, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"fmt"
+
+	"personal-website-v2/identity/src/internal/oidc"
+	"personal-website-v2/identity/src/internal/oidc/models"
+)
+
+// InMemoryClientRegistrationStore is an in-memory store of the registrations of OIDC clients.
+// The registrations are loaded once (e.g. from the app config) and aren't changed.
+type InMemoryClientRegistrationStore struct {
+	registrations map[uint64]*models.ClientRegistration // map[ClientId]ClientRegistration
+}
+
+var _ oidc.ClientRegistrationStore = (*InMemoryClientRegistrationStore)(nil)
+
+func NewInMemoryClientRegistrationStore(registrations []*models.ClientRegistration) (*InMemoryClientRegistrationStore, error) {
+	rs := make(map[uint64]*models.ClientRegistration, len(registrations))
+	for _, r := range registrations {
+		if r.ClientId == 0 {
+			return nil, fmt.Errorf("[stores.NewInMemoryClientRegistrationStore] invalid client id (%d)", r.ClientId)
+		}
+		if len(r.RedirectURIs) == 0 {
+			return nil, fmt.Errorf("[stores.NewInMemoryClientRegistrationStore] no redirect URIs registered for the client (%d)", r.ClientId)
+		}
+		if _, ok := rs[r.ClientId]; ok {
+			return nil, fmt.Errorf("[stores.NewInMemoryClientRegistrationStore] client (%d) has already been registered", r.ClientId)
+		}
+		rs[r.ClientId] = r
+	}
+
+	return &InMemoryClientRegistrationStore{
+		registrations: rs,
+	}, nil
+}
+
+// FindByClientId finds and returns the client registration, if any, by the specified client ID.
+func (s *InMemoryClientRegistrationStore) FindByClientId(clientId uint64) (*models.ClientRegistration, error) {
+	return s.registrations[clientId], nil
+}
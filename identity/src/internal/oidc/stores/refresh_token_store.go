@@ -0,0 +1,208 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/oidc"
+	"personal-website-v2/identity/src/internal/oidc/dbmodels"
+	"personal-website-v2/identity/src/internal/oidc/operations/tokens"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	refreshTokensTable = "public.oidc_refresh_tokens"
+)
+
+// RefreshTokenStore is a store of OIDC refresh tokens.
+type RefreshTokenStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.RefreshToken]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ oidc.RefreshTokenStore = (*RefreshTokenStore)(nil)
+
+func NewRefreshTokenStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*RefreshTokenStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.oidc.stores.RefreshTokenStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRefreshTokenStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupOidc,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRefreshTokenStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRefreshTokenStore] new TxManager: %w", err)
+	}
+
+	return &RefreshTokenStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.RefreshToken](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a refresh token and returns the token ID if the operation is successful.
+func (s *RefreshTokenStore) Create(ctx *actions.OperationContext, data *tokens.CreateOperationData, tokenHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcRefreshTokenStore_Create,
+		[]*actions.OperationParam{actions.NewOperationParam("data", data), actions.NewOperationParam("ttl", ttl)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_oidc_refresh_token(IN _token_hash, IN _client_id, IN _user_id, IN _scopes, IN _ttl,
+				// OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_oidc_refresh_token($1, $2, $3, $4, $5, NULL, NULL, NULL)"
+
+				r := tx.QueryRow(txCtx, query, tokenHash, data.ClientId, data.UserId, data.Scopes, ttl)
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RefreshTokenStore.Create] execute a query (create_oidc_refresh_token): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RefreshTokenStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RefreshTokenStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.RefreshTokenStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindByTokenHash finds and returns a refresh token, if any, by the specified token hash.
+func (s *RefreshTokenStore) FindByTokenHash(ctx *actions.OperationContext, tokenHash []byte) (*dbmodels.RefreshToken, error) {
+	var t *dbmodels.RefreshToken
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcRefreshTokenStore_FindByTokenHash, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + refreshTokensTable + " WHERE token_hash = $1 LIMIT 1"
+			var err error
+			if t, err = s.store.Find(opCtx.Ctx, query, tokenHash); err != nil {
+				return fmt.Errorf("[stores.RefreshTokenStore.FindByTokenHash] find a refresh token by token hash: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RefreshTokenStore.FindByTokenHash] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// Delete deletes the refresh token by the specified ID and returns true if it has been deleted.
+func (s *RefreshTokenStore) Delete(ctx *actions.OperationContext, id uint64) (bool, error) {
+	var isDeleted bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcRefreshTokenStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_oidc_refresh_token(IN _id, OUT _is_deleted, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_oidc_refresh_token($1, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&isDeleted, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RefreshTokenStore.Delete] execute a query (delete_oidc_refresh_token): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.RefreshTokenStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RefreshTokenStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.RefreshTokenStore.Delete] execute an operation: %w", err)
+	}
+	return isDeleted, nil
+}
+
+// DeleteAllByUserIdAndClientId deletes all refresh tokens of the user issued to the specified client.
+func (s *RefreshTokenStore) DeleteAllByUserIdAndClientId(ctx *actions.OperationContext, userId, clientId uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeOidcRefreshTokenStore_DeleteAllByUserIdAndClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_oidc_refresh_tokens(IN _user_id, IN _client_id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.delete_oidc_refresh_tokens($1, $2, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, clientId).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RefreshTokenStore.DeleteAllByUserIdAndClientId] execute a query (delete_oidc_refresh_tokens): %w", err)
+				}
+
+				if errCode != dberrors.DbErrorCodeNoError {
+					// unknown error
+					return fmt.Errorf("[stores.RefreshTokenStore.DeleteAllByUserIdAndClientId] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RefreshTokenStore.DeleteAllByUserIdAndClientId] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RefreshTokenStore.DeleteAllByUserIdAndClientId] execute an operation: %w", err)
+	}
+	return nil
+}
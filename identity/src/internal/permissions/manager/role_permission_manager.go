@@ -17,9 +17,13 @@ package manager
 import (
 	"fmt"
 
+	"golang.org/x/exp/slices"
+
 	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/permissions"
+	"personal-website-v2/identity/src/internal/roles"
 	"personal-website-v2/pkg/actions"
 	"personal-website-v2/pkg/errors"
 	actionhelper "personal-website-v2/pkg/helper/actions"
@@ -29,14 +33,21 @@ import (
 
 // RolePermissionManager is a role permission manager.
 type RolePermissionManager struct {
-	opExecutor          *actionhelper.OperationExecutor
-	rolePermissionStore permissions.RolePermissionStore
-	logger              logging.Logger[*context.LogEntryContext]
+	opExecutor             *actionhelper.OperationExecutor
+	rolePermissionStore    permissions.RolePermissionStore
+	roleInheritanceManager roles.RoleInheritanceManager
+	authzCacheInvalidator  authorization.AuthorizationCacheInvalidator
+	logger                 logging.Logger[*context.LogEntryContext]
 }
 
 var _ permissions.RolePermissionManager = (*RolePermissionManager)(nil)
 
-func NewRolePermissionManager(rolePermissionStore permissions.RolePermissionStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*RolePermissionManager, error) {
+func NewRolePermissionManager(
+	rolePermissionStore permissions.RolePermissionStore,
+	roleInheritanceManager roles.RoleInheritanceManager,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*RolePermissionManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.permissions.manager.RolePermissionManager")
 	if err != nil {
 		return nil, fmt.Errorf("[manager.NewRolePermissionManager] create a logger: %w", err)
@@ -54,9 +65,11 @@ func NewRolePermissionManager(rolePermissionStore permissions.RolePermissionStor
 	}
 
 	return &RolePermissionManager{
-		opExecutor:          e,
-		rolePermissionStore: rolePermissionStore,
-		logger:              l,
+		opExecutor:             e,
+		rolePermissionStore:    rolePermissionStore,
+		roleInheritanceManager: roleInheritanceManager,
+		authzCacheInvalidator:  authzCacheInvalidator,
+		logger:                 l,
 	}, nil
 }
 
@@ -73,6 +86,12 @@ func (m *RolePermissionManager) Grant(ctx *actions.OperationContext, roleId uint
 				return fmt.Errorf("[manager.RolePermissionManager.Grant] grant permissions to the role: %w", err)
 			}
 
+			if err := m.authzCacheInvalidator.InvalidatePermissions(opCtx, permissionIds); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RolePermissionEvent, err,
+					"[manager.RolePermissionManager.Grant] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.RolePermissionEvent,
@@ -102,6 +121,12 @@ func (m *RolePermissionManager) Revoke(ctx *actions.OperationContext, roleId uin
 				return fmt.Errorf("[manager.RolePermissionManager.Revoke] revoke permissions from the role: %w", err)
 			}
 
+			if err := m.authzCacheInvalidator.InvalidatePermissions(opCtx, permissionIds); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RolePermissionEvent, err,
+					"[manager.RolePermissionManager.Revoke] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.RolePermissionEvent,
@@ -126,6 +151,12 @@ func (m *RolePermissionManager) RevokeAll(ctx *actions.OperationContext, roleId
 				return fmt.Errorf("[manager.RolePermissionManager.RevokeAll] revoke all permissions from the role: %w", err)
 			}
 
+			if err := m.authzCacheInvalidator.InvalidateAllPermissions(opCtx); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RolePermissionEvent, err,
+					"[manager.RolePermissionManager.RevokeAll] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.RolePermissionEvent,
@@ -153,6 +184,12 @@ func (m *RolePermissionManager) RevokeFromAll(ctx *actions.OperationContext, per
 				return fmt.Errorf("[manager.RolePermissionManager.RevokeFromAll] revoke permissions from all roles: %w", err)
 			}
 
+			if err := m.authzCacheInvalidator.InvalidatePermissions(opCtx, permissionIds); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RolePermissionEvent, err,
+					"[manager.RolePermissionManager.RevokeFromAll] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.RolePermissionEvent,
@@ -185,6 +222,14 @@ func (m *RolePermissionManager) Update(ctx *actions.OperationContext, roleId uin
 				return fmt.Errorf("[manager.RolePermissionManager.Update] update permissions of the role: %w", err)
 			}
 
+			pids := make([]uint64, 0, len(permissionIdsToGrant)+len(permissionIdsToRevoke))
+			pids = append(append(pids, permissionIdsToGrant...), permissionIdsToRevoke...)
+			if err := m.authzCacheInvalidator.InvalidatePermissions(opCtx, pids); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RolePermissionEvent, err,
+					"[manager.RolePermissionManager.Update] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.RolePermissionEvent,
@@ -263,7 +308,8 @@ func (m *RolePermissionManager) GetAllPermissionIdsByRoleId(ctx *actions.Operati
 	return ids, nil
 }
 
-// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission.
+// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission,
+// including the roles that inherit the permission from their parent roles.
 func (m *RolePermissionManager) GetAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error) {
 	var ids []uint64
 	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRolePermissionManager_GetAllRoleIdsByPermissionId,
@@ -273,6 +319,21 @@ func (m *RolePermissionManager) GetAllRoleIdsByPermissionId(ctx *actions.Operati
 			if ids, err = m.rolePermissionStore.GetAllRoleIdsByPermissionId(opCtx, permissionId); err != nil {
 				return fmt.Errorf("[manager.RolePermissionManager.GetAllRoleIdsByPermissionId] get all role ids by permission id: %w", err)
 			}
+
+			if len(ids) == 0 {
+				return nil
+			}
+
+			rids, err := m.roleInheritanceManager.GetAllDescendantRoleIds(opCtx, ids)
+			if err != nil {
+				return fmt.Errorf("[manager.RolePermissionManager.GetAllRoleIdsByPermissionId] get all descendant role ids: %w", err)
+			}
+
+			for _, id := range rids {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
 			return nil
 		},
 	)
@@ -281,3 +342,27 @@ func (m *RolePermissionManager) GetAllRoleIdsByPermissionId(ctx *actions.Operati
 	}
 	return ids, nil
 }
+
+// GetAllEffectivePermissionIdsByRoleId gets all IDs of the permissions granted to the role
+// and the permissions inherited from its parent roles by the specified role ID.
+func (m *RolePermissionManager) GetAllEffectivePermissionIdsByRoleId(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRolePermissionManager_GetAllEffectivePermissionIdsByRoleId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			rids, err := m.roleInheritanceManager.GetAllAncestorRoleIds(opCtx, roleId)
+			if err != nil {
+				return fmt.Errorf("[manager.RolePermissionManager.GetAllEffectivePermissionIdsByRoleId] get all ancestor role ids: %w", err)
+			}
+
+			if ids, err = m.rolePermissionStore.GetAllPermissionIdsByRoleIds(opCtx, append(rids, roleId)); err != nil {
+				return fmt.Errorf("[manager.RolePermissionManager.GetAllEffectivePermissionIdsByRoleId] get all permission ids by role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RolePermissionManager.GetAllEffectivePermissionIdsByRoleId] execute an operation: %w", err)
+	}
+	return ids, nil
+}
@@ -105,6 +105,11 @@ type RolePermissionManager interface {
 	// GetAllPermissionIdsByRoleId gets all IDs of the permissions granted to the role by the specified role ID.
 	GetAllPermissionIdsByRoleId(ctx *actions.OperationContext, roleId uint64) ([]uint64, error)
 
-	// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission.
+	// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission,
+	// including the roles that inherit the permission from their parent roles.
 	GetAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error)
+
+	// GetAllEffectivePermissionIdsByRoleId gets all IDs of the permissions granted to the role
+	// and the permissions inherited from its parent roles by the specified role ID.
+	GetAllEffectivePermissionIdsByRoleId(ctx *actions.OperationContext, roleId uint64) ([]uint64, error)
 }
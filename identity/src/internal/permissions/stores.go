@@ -110,4 +110,7 @@ type RolePermissionStore interface {
 
 	// GetAllRoleIdsByPermissionId gets all IDs of the roles that are granted the specified permission.
 	GetAllRoleIdsByPermissionId(ctx *actions.OperationContext, permissionId uint64) ([]uint64, error)
+
+	// GetAllPermissionIdsByRoleIds gets all IDs of the permissions granted to any of the specified roles.
+	GetAllPermissionIdsByRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]uint64, error)
 }
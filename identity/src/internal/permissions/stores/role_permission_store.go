@@ -418,3 +418,38 @@ func (s *RolePermissionStore) GetAllRoleIdsByPermissionId(ctx *actions.Operation
 	}
 	return ids, nil
 }
+
+// GetAllPermissionIdsByRoleIds gets all IDs of the permissions granted to any of the specified roles.
+func (s *RolePermissionStore) GetAllPermissionIdsByRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRolePermissionStore_GetAllPermissionIdsByRoleIds,
+		[]*actions.OperationParam{actions.NewOperationParam("roleIds", roleIds)},
+		func(opCtx *actions.OperationContext) error {
+			if len(roleIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of role ids is 0")
+			}
+
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.RolePermissionStore.GetAllPermissionIdsByRoleIds] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT DISTINCT permission_id FROM " + rolePermissionsTable + " WHERE role_id = ANY($1) AND is_deleted IS FALSE"
+			rows, err := conn.Query(opCtx.Ctx, query, roleIds)
+			if err != nil {
+				return fmt.Errorf("[stores.RolePermissionStore.GetAllPermissionIdsByRoleIds] execute a query: %w", err)
+			}
+			defer rows.Close()
+
+			if ids, err = pgx.CollectRows(rows, pgx.RowTo[uint64]); err != nil {
+				return fmt.Errorf("[stores.RolePermissionStore.GetAllPermissionIdsByRoleIds] collect rows: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RolePermissionStore.GetAllPermissionIdsByRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,388 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/permissions"
+	groupoperations "personal-website-v2/identity/src/internal/permissions/operations/groups"
+	permissionoperations "personal-website-v2/identity/src/internal/permissions/operations/permissions"
+	"personal-website-v2/identity/src/internal/provisioning"
+	"personal-website-v2/identity/src/internal/provisioning/models"
+	"personal-website-v2/identity/src/internal/roles"
+	roleoperations "personal-website-v2/identity/src/internal/roles/operations/roles"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// ProvisioningManager is a manager that reconciles the manifests of the services
+// with the permission groups, permissions, roles and grants stored in the identity.
+type ProvisioningManager struct {
+	opExecutor             *actionhelper.OperationExecutor
+	permissionGroupManager permissions.PermissionGroupManager
+	permissionManager      permissions.PermissionManager
+	roleManager            roles.RoleManager
+	rolePermissionManager  permissions.RolePermissionManager
+	logger                 logging.Logger[*context.LogEntryContext]
+}
+
+var _ provisioning.ProvisioningManager = (*ProvisioningManager)(nil)
+
+func NewProvisioningManager(
+	permissionGroupManager permissions.PermissionGroupManager,
+	permissionManager permissions.PermissionManager,
+	roleManager roles.RoleManager,
+	rolePermissionManager permissions.RolePermissionManager,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ProvisioningManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.provisioning.manager.ProvisioningManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewProvisioningManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupProvisioning,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewProvisioningManager] new operation executor: %w", err)
+	}
+
+	return &ProvisioningManager{
+		opExecutor:             e,
+		permissionGroupManager: permissionGroupManager,
+		permissionManager:      permissionManager,
+		roleManager:            roleManager,
+		rolePermissionManager:  rolePermissionManager,
+		logger:                 l,
+	}, nil
+}
+
+// Plan returns the changes required to reconcile the manifest without applying them.
+func (m *ProvisioningManager) Plan(ctx *actions.OperationContext, manifest *models.Manifest) ([]*models.Change, error) {
+	var cs []*models.Change
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeProvisioningManager_Plan, nil,
+		func(opCtx *actions.OperationContext) error {
+			if err := manifest.Validate(); err != nil {
+				return err
+			}
+
+			p, err := m.plan(opCtx, manifest)
+			if err != nil {
+				return fmt.Errorf("[manager.ProvisioningManager.Plan] plan the reconciliation: %w", err)
+			}
+
+			cs = p.changes()
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ProvisioningManager.Plan] execute an operation: %w", err)
+	}
+	return cs, nil
+}
+
+// Apply reconciles the manifest and returns the applied changes if the operation is successful.
+// The changes aren't applied in a single transaction, but the reconciliation is idempotent,
+// so if the operation fails, it can be retried.
+func (m *ProvisioningManager) Apply(ctx *actions.OperationContext, manifest *models.Manifest) ([]*models.Change, error) {
+	var cs []*models.Change
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeProvisioningManager_Apply, nil,
+		func(opCtx *actions.OperationContext) error {
+			if err := manifest.Validate(); err != nil {
+				return err
+			}
+
+			p, err := m.plan(opCtx, manifest)
+			if err != nil {
+				return fmt.Errorf("[manager.ProvisioningManager.Apply] plan the reconciliation: %w", err)
+			}
+
+			for _, g := range p.groups {
+				d := &groupoperations.CreateOperationData{
+					Name:        g.Name,
+					Description: g.Description,
+				}
+				id, err := m.permissionGroupManager.Create(opCtx, d)
+				if err != nil {
+					return fmt.Errorf("[manager.ProvisioningManager.Apply] create a permission group: %w", err)
+				}
+				p.groupIds[g.Name] = id
+			}
+
+			for _, perm := range p.permissions {
+				d := &permissionoperations.CreateOperationData{
+					Name:        perm.Name,
+					GroupId:     p.groupIds[perm.Group],
+					Description: perm.Description,
+				}
+				id, err := m.permissionManager.Create(opCtx, d)
+				if err != nil {
+					return fmt.Errorf("[manager.ProvisioningManager.Apply] create a permission: %w", err)
+				}
+				p.permissionIds[perm.Name] = id
+			}
+
+			for _, r := range p.roles {
+				d := &roleoperations.CreateOperationData{
+					Name:        r.Name,
+					Type:        r.Type,
+					Title:       r.Title,
+					Description: r.Description,
+				}
+				id, err := m.roleManager.Create(opCtx, d)
+				if err != nil {
+					return fmt.Errorf("[manager.ProvisioningManager.Apply] create a role: %w", err)
+				}
+				p.roleIds[r.Name] = id
+			}
+
+			for _, g := range p.grants {
+				pids := make([]uint64, len(g.Permissions))
+				for i, pn := range g.Permissions {
+					pids[i] = p.permissionIds[pn]
+				}
+
+				if err := m.rolePermissionManager.Grant(opCtx, p.roleIds[g.Role], pids); err != nil {
+					return fmt.Errorf("[manager.ProvisioningManager.Apply] grant permissions to the role: %w", err)
+				}
+			}
+
+			cs = p.changes()
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.ProvisioningEvent,
+				"[manager.ProvisioningManager.Apply] manifest has been applied",
+				logging.NewField("changes", cs),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ProvisioningManager.Apply] execute an operation: %w", err)
+	}
+	return cs, nil
+}
+
+type plan struct {
+	// The permission groups to create.
+	groups []*models.ManifestPermissionGroup
+
+	// The permissions to create.
+	permissions []*models.ManifestPermission
+
+	// The roles to create.
+	roles []*models.ManifestRole
+
+	// The missing grants.
+	grants []*models.ManifestGrant
+
+	// The IDs of the existing permission groups, permissions and roles by their names.
+	groupIds      map[string]uint64
+	permissionIds map[string]uint64
+	roleIds       map[string]uint64
+}
+
+func (p *plan) changes() []*models.Change {
+	cs := make([]*models.Change, 0, len(p.groups)+len(p.permissions)+len(p.roles)+len(p.grants))
+	for _, g := range p.groups {
+		cs = append(cs, &models.Change{Type: models.ChangeTypeCreatePermissionGroup, Name: g.Name})
+	}
+	for _, perm := range p.permissions {
+		cs = append(cs, &models.Change{Type: models.ChangeTypeCreatePermission, Name: perm.Name})
+	}
+	for _, r := range p.roles {
+		cs = append(cs, &models.Change{Type: models.ChangeTypeCreateRole, Name: r.Name})
+	}
+	for _, g := range p.grants {
+		cs = append(cs, &models.Change{Type: models.ChangeTypeGrantPermissions, Name: g.Role, Permissions: g.Permissions})
+	}
+	return cs
+}
+
+// plan compares the manifest with the identity data and returns the plan of the reconciliation.
+// The manifest must be valid.
+func (m *ProvisioningManager) plan(ctx *actions.OperationContext, manifest *models.Manifest) (*plan, error) {
+	p := &plan{
+		groupIds:      make(map[string]uint64),
+		permissionIds: make(map[string]uint64),
+		roleIds:       make(map[string]uint64),
+	}
+
+	// permission groups
+	declaredGroups := make(map[string]struct{}, len(manifest.PermissionGroups))
+	gns := make([]string, 0, len(manifest.PermissionGroups)+len(manifest.Permissions))
+	for _, g := range manifest.PermissionGroups {
+		declaredGroups[g.Name] = struct{}{}
+		gns = append(gns, g.Name)
+	}
+	for _, perm := range manifest.Permissions {
+		if _, ok := declaredGroups[perm.Group]; !ok {
+			gns = append(gns, perm.Group)
+		}
+	}
+
+	if len(gns) > 0 {
+		gs, err := m.permissionGroupManager.GetAllByNames(ctx, gns)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.ProvisioningManager.plan] get all permission groups by names: %w", err)
+		}
+
+		for _, g := range gs {
+			p.groupIds[g.Name] = g.Id
+		}
+	}
+
+	for _, g := range manifest.PermissionGroups {
+		if _, ok := p.groupIds[g.Name]; !ok {
+			p.groups = append(p.groups, g)
+		}
+	}
+
+	// permissions
+	declaredPermissions := make(map[string]struct{}, len(manifest.Permissions))
+	pns := make([]string, 0, len(manifest.Permissions))
+	for _, perm := range manifest.Permissions {
+		if _, ok := p.groupIds[perm.Group]; !ok {
+			if _, ok = declaredGroups[perm.Group]; !ok {
+				return nil, errors.NewError(errors.ErrorCodeInvalidData,
+					fmt.Sprintf("permission group '%s' of the permission '%s' not found", perm.Group, perm.Name))
+			}
+		}
+
+		declaredPermissions[perm.Name] = struct{}{}
+		pns = append(pns, perm.Name)
+	}
+	for _, g := range manifest.Grants {
+		for _, pn := range g.Permissions {
+			if _, ok := declaredPermissions[pn]; !ok {
+				pns = append(pns, pn)
+			}
+		}
+	}
+
+	if len(pns) > 0 {
+		ps, err := m.permissionManager.GetAllByNamesWithContext(ctx, pns)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.ProvisioningManager.plan] get all permissions by names: %w", err)
+		}
+
+		for _, perm := range ps {
+			p.permissionIds[perm.Name] = perm.Id
+		}
+	}
+
+	for _, perm := range manifest.Permissions {
+		if _, ok := p.permissionIds[perm.Name]; !ok {
+			p.permissions = append(p.permissions, perm)
+		}
+	}
+
+	// roles
+	declaredRoles := make(map[string]struct{}, len(manifest.Roles))
+	rns := make([]string, 0, len(manifest.Roles)+len(manifest.Grants))
+	for _, r := range manifest.Roles {
+		declaredRoles[r.Name] = struct{}{}
+		rns = append(rns, r.Name)
+	}
+	for _, g := range manifest.Grants {
+		if _, ok := declaredRoles[g.Role]; !ok {
+			rns = append(rns, g.Role)
+		}
+	}
+
+	if len(rns) > 0 {
+		rs, err := m.roleManager.GetAllByNamesWithContext(ctx, rns)
+		if err != nil {
+			return nil, fmt.Errorf("[manager.ProvisioningManager.plan] get all roles by names: %w", err)
+		}
+
+		for _, r := range rs {
+			p.roleIds[r.Name] = r.Id
+		}
+	}
+
+	for _, r := range manifest.Roles {
+		if _, ok := p.roleIds[r.Name]; !ok {
+			p.roles = append(p.roles, r)
+		}
+	}
+
+	// grants
+	// the grants of the same role are merged
+	grantsByRole := make(map[string]*models.ManifestGrant, len(manifest.Grants))
+	grantedPermissions := make(map[string]map[string]struct{}, len(manifest.Grants))
+	for _, g := range manifest.Grants {
+		rid, roleExists := p.roleIds[g.Role]
+		if !roleExists {
+			if _, ok := declaredRoles[g.Role]; !ok {
+				return nil, errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("role '%s' not found", g.Role))
+			}
+		}
+
+		gps, ok := grantedPermissions[g.Role]
+		if !ok {
+			gps = make(map[string]struct{})
+			grantedPermissions[g.Role] = gps
+
+			if roleExists {
+				pids, err := m.rolePermissionManager.GetAllPermissionIdsByRoleId(ctx, rid)
+				if err != nil {
+					return nil, fmt.Errorf("[manager.ProvisioningManager.plan] get all permission ids by role id: %w", err)
+				}
+
+				pidm := make(map[uint64]struct{}, len(pids))
+				for _, pid := range pids {
+					pidm[pid] = struct{}{}
+				}
+
+				for pn, pid := range p.permissionIds {
+					if _, ok := pidm[pid]; ok {
+						gps[pn] = struct{}{}
+					}
+				}
+			}
+		}
+
+		for _, pn := range g.Permissions {
+			if _, ok := p.permissionIds[pn]; !ok {
+				if _, ok = declaredPermissions[pn]; !ok {
+					return nil, errors.NewError(errors.ErrorCodeInvalidData,
+						fmt.Sprintf("permission '%s' granted to the role '%s' not found", pn, g.Role))
+				}
+			}
+			if _, ok := gps[pn]; ok {
+				continue
+			}
+
+			gps[pn] = struct{}{}
+			mg := grantsByRole[g.Role]
+			if mg == nil {
+				mg = &models.ManifestGrant{Role: g.Role}
+				grantsByRole[g.Role] = mg
+				p.grants = append(p.grants, mg)
+			}
+			mg.Permissions = append(mg.Permissions, pn)
+		}
+	}
+	return p, nil
+}
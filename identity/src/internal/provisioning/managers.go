@@ -0,0 +1,31 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"personal-website-v2/identity/src/internal/provisioning/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// ProvisioningManager is a manager that reconciles the manifests of the services
+// with the permission groups, permissions, roles and grants stored in the identity.
+// The manifest is reconciled additively: the existing objects are never updated, revoked or deleted.
+type ProvisioningManager interface {
+	// Plan returns the changes required to reconcile the manifest without applying them.
+	Plan(ctx *actions.OperationContext, manifest *models.Manifest) ([]*models.Change, error)
+
+	// Apply reconciles the manifest and returns the applied changes if the operation is successful.
+	Apply(ctx *actions.OperationContext, manifest *models.Manifest) ([]*models.Change, error)
+}
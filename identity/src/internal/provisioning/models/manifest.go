@@ -0,0 +1,156 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+// Manifest declares the permission groups, permissions, roles and grants of the service.
+type Manifest struct {
+	// The permission groups.
+	PermissionGroups []*ManifestPermissionGroup `json:"permissionGroups"`
+
+	// The permissions.
+	Permissions []*ManifestPermission `json:"permissions"`
+
+	// The roles.
+	Roles []*ManifestRole `json:"roles"`
+
+	// The grants of the permissions to the roles.
+	Grants []*ManifestGrant `json:"grants"`
+}
+
+func (m *Manifest) Validate() *errors.Error {
+	if len(m.PermissionGroups) == 0 && len(m.Permissions) == 0 && len(m.Roles) == 0 && len(m.Grants) == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "manifest is empty")
+	}
+
+	gns := make(map[string]struct{}, len(m.PermissionGroups))
+	for _, g := range m.PermissionGroups {
+		if strings.IsEmptyOrWhitespace(g.Name) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "permission group name is empty")
+		}
+		if strings.IsEmptyOrWhitespace(g.Description) {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("description of the permission group '%s' is empty", g.Name))
+		}
+		if _, ok := gns[g.Name]; ok {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("duplicate permission group '%s'", g.Name))
+		}
+		gns[g.Name] = struct{}{}
+	}
+
+	pns := make(map[string]struct{}, len(m.Permissions))
+	for _, p := range m.Permissions {
+		if strings.IsEmptyOrWhitespace(p.Name) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "permission name is empty")
+		}
+		if strings.IsEmptyOrWhitespace(p.Group) {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("group of the permission '%s' is empty", p.Name))
+		}
+		if strings.IsEmptyOrWhitespace(p.Description) {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("description of the permission '%s' is empty", p.Name))
+		}
+		if _, ok := pns[p.Name]; ok {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("duplicate permission '%s'", p.Name))
+		}
+		pns[p.Name] = struct{}{}
+	}
+
+	rns := make(map[string]struct{}, len(m.Roles))
+	for _, r := range m.Roles {
+		if strings.IsEmptyOrWhitespace(r.Name) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "role name is empty")
+		}
+		if !r.Type.IsValid() {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("invalid type of the role '%s'", r.Name))
+		}
+		if strings.IsEmptyOrWhitespace(r.Title) {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("title of the role '%s' is empty", r.Name))
+		}
+		if strings.IsEmptyOrWhitespace(r.Description) {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("description of the role '%s' is empty", r.Name))
+		}
+		if _, ok := rns[r.Name]; ok {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("duplicate role '%s'", r.Name))
+		}
+		rns[r.Name] = struct{}{}
+	}
+
+	for _, g := range m.Grants {
+		if strings.IsEmptyOrWhitespace(g.Role) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "grant role is empty")
+		}
+		if len(g.Permissions) == 0 {
+			return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("number of permissions granted to the role '%s' is 0", g.Role))
+		}
+
+		for _, p := range g.Permissions {
+			if strings.IsEmptyOrWhitespace(p) {
+				return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("permission granted to the role '%s' is empty", g.Role))
+			}
+		}
+	}
+	return nil
+}
+
+// ManifestPermissionGroup is the permission group declared in the manifest.
+type ManifestPermissionGroup struct {
+	// The unique name to identify the permission group.
+	Name string `json:"name"`
+
+	// The permission group description.
+	Description string `json:"description"`
+}
+
+// ManifestPermission is the permission declared in the manifest.
+type ManifestPermission struct {
+	// The unique name to identify the permission.
+	Name string `json:"name"`
+
+	// The permission group name.
+	Group string `json:"group"`
+
+	// The permission description.
+	Description string `json:"description"`
+}
+
+// ManifestRole is the role declared in the manifest.
+type ManifestRole struct {
+	// The unique name to identify the role.
+	Name string `json:"name"`
+
+	// The role type.
+	Type rolemodels.RoleType `json:"type"`
+
+	// The role title.
+	Title string `json:"title"`
+
+	// The role description.
+	Description string `json:"description"`
+}
+
+// ManifestGrant is the grant of the permissions to the role declared in the manifest.
+type ManifestGrant struct {
+	// The role name.
+	Role string `json:"role"`
+
+	// The permission names.
+	Permissions []string `json:"permissions"`
+}
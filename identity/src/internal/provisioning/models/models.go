@@ -0,0 +1,56 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "fmt"
+
+// The type of the change required to reconcile the manifest with the identity data.
+type ChangeType uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	ChangeTypeCreatePermissionGroup ChangeType = 1
+	ChangeTypeCreatePermission      ChangeType = 2
+	ChangeTypeCreateRole            ChangeType = 3
+	ChangeTypeGrantPermissions      ChangeType = 4
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeTypeCreatePermissionGroup:
+		return "createPermissionGroup"
+	case ChangeTypeCreatePermission:
+		return "createPermission"
+	case ChangeTypeCreateRole:
+		return "createRole"
+	case ChangeTypeGrantPermissions:
+		return "grantPermissions"
+	}
+	return fmt.Sprintf("ChangeType(%d)", t)
+}
+
+// Change is the change required to reconcile the manifest with the identity data.
+type Change struct {
+	// The change type.
+	Type ChangeType `json:"type"`
+
+	// The name of the permission group, permission or role.
+	// For the grants it's the role name.
+	Name string `json:"name"`
+
+	// The names of the permissions to grant to the role (only for the grants).
+	Permissions []string `json:"permissions,omitempty"`
+}
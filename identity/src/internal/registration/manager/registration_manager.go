@@ -0,0 +1,380 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/credentials"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/registration"
+	"personal-website-v2/identity/src/internal/registration/notifications/email/existingaccount"
+	verificationnotifs "personal-website-v2/identity/src/internal/registration/notifications/email/verification"
+	registrationoperations "personal-website-v2/identity/src/internal/registration/operations/registration"
+	"personal-website-v2/identity/src/internal/users"
+	"personal-website-v2/identity/src/internal/users/models"
+	useroperations "personal-website-v2/identity/src/internal/users/operations/users"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/services/emailnotifier"
+)
+
+const (
+	// The size of an email verification token (in bytes).
+	verificationTokenSize = 32
+
+	// The name of the query parameter of the verification URL that contains the token.
+	verificationTokenParam = "token"
+)
+
+type RegistrationManagerConfig struct {
+	// The lifetime of an email verification token.
+	VerificationTokenTTL time.Duration
+
+	// If true, the user must be approved by the administrator after the email has been verified.
+	RequireApproval bool
+
+	// The URL of the email verification page. The token is added to the URL as the 'token' query parameter.
+	VerificationURL string
+}
+
+// RegistrationManager is a manager of the users' registration.
+type RegistrationManager struct {
+	appUserId             uint64
+	opExecutor            *actionhelper.OperationExecutor
+	config                *RegistrationManagerConfig
+	userManager           users.UserManager
+	userCredentialManager credentials.UserCredentialManager
+	registrationStore     registration.RegistrationStore
+	emailNotifier         emailnotifier.EmailNotifier
+	logger                logging.Logger[*context.LogEntryContext]
+}
+
+var _ registration.RegistrationManager = (*RegistrationManager)(nil)
+
+func NewRegistrationManager(
+	appUserId uint64,
+	config *RegistrationManagerConfig,
+	userManager users.UserManager,
+	userCredentialManager credentials.UserCredentialManager,
+	registrationStore registration.RegistrationStore,
+	emailNotifier emailnotifier.EmailNotifier,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*RegistrationManager, error) {
+	if _, err := url.Parse(config.VerificationURL); err != nil {
+		return nil, fmt.Errorf("[manager.NewRegistrationManager] parse the verification URL: %w", err)
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.registration.manager.RegistrationManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewRegistrationManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupRegistration,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewRegistrationManager] new operation executor: %w", err)
+	}
+
+	return &RegistrationManager{
+		appUserId:             appUserId,
+		opExecutor:            e,
+		config:                config,
+		userManager:           userManager,
+		userCredentialManager: userCredentialManager,
+		registrationStore:     registrationStore,
+		emailNotifier:         emailNotifier,
+		logger:                l,
+	}, nil
+}
+
+// Register creates a user with the status 'New', sets the user's name and password
+// and sends an email with the verification link to the user. If a user with the same
+// name or email already exists, then an email informing of it is sent instead
+// and no error is returned, so the caller isn't informed whether the user exists.
+func (m *RegistrationManager) Register(ctx *actions.OperationContext, data *registrationoperations.RegisterOperationData) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationManager_Register, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return err
+			}
+
+			u, err := m.userManager.FindByEmail(opCtx, data.Email, false)
+			if err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.Register] find a user by email: %w", err)
+			}
+
+			// the caller isn't informed whether the user exists
+			if u != nil {
+				m.sendNotification(opCtx, data.Email, existingaccount.AccountExistsNotifSubject, existingaccount.AccountExistsNotifTmplName,
+					existingaccount.NewAccountExistsNotifTmplData(data.Email),
+				)
+				return nil
+			}
+
+			exists, err := m.userManager.NameExists(opCtx, data.Name)
+			if err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.Register] user name exists: %w", err)
+			}
+
+			if exists {
+				m.sendNotification(opCtx, data.Email, existingaccount.UsernameTakenNotifSubject, existingaccount.UsernameTakenNotifTmplName,
+					existingaccount.NewUsernameTakenNotifTmplData(data.Name),
+				)
+				return nil
+			}
+
+			// the user is created on behalf of the app, because the user isn't authenticated
+			appCtx := m.appUserCtx(opCtx)
+			d := &useroperations.CreateOperationData{
+				Type:        models.UserTypeUser,
+				Group:       groupmodels.UserGroupUsers,
+				Status:      models.UserStatusNew,
+				Email:       nullable.NewNullable(data.Email),
+				FirstName:   data.FirstName,
+				LastName:    data.LastName,
+				DisplayName: data.DisplayName,
+				BirthDate:   data.BirthDate,
+				Gender:      data.Gender,
+			}
+
+			id, err := m.userManager.Create(appCtx, d)
+			if err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.Register] create a user: %w", err)
+			}
+
+			if err = m.setCredentials(appCtx, id, data); err != nil {
+				m.deleteUser(appCtx, id)
+				return fmt.Errorf("[manager.RegistrationManager.Register] set the user's credentials: %w", err)
+			}
+
+			if err = m.issueVerificationToken(appCtx, id, data.Name, data.Email); err != nil {
+				m.deleteUser(appCtx, id)
+				return fmt.Errorf("[manager.RegistrationManager.Register] issue a verification token: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RegistrationEvent,
+				"[manager.RegistrationManager.Register] user has been registered",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.Register] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// VerifyEmail verifies the user's email by the specified token and activates the user
+// or waits for approval if the approval is required. It returns the user ID.
+// The token can only be used once.
+func (m *RegistrationManager) VerifyEmail(ctx *actions.OperationContext, token string) (uint64, error) {
+	var userId uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationManager_VerifyEmail, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			var status models.UserStatus
+			var err error
+			if userId, status, err = m.registrationStore.VerifyEmail(opCtx, hashVerificationToken(token), m.config.RequireApproval); err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.VerifyEmail] verify the user's email: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RegistrationEvent,
+				"[manager.RegistrationManager.VerifyEmail] user's email has been verified",
+				logging.NewField("userId", userId),
+				logging.NewField("status", status),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.RegistrationManager.VerifyEmail] execute an operation: %w", err)
+	}
+	return userId, nil
+}
+
+// ResendVerificationEmail issues a new verification token and sends it to the user
+// with the specified email if the user's email hasn't been verified yet.
+func (m *RegistrationManager) ResendVerificationEmail(ctx *actions.OperationContext, email string) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationManager_ResendVerificationEmail, []*actions.OperationParam{},
+		func(opCtx *actions.OperationContext) error {
+			u, err := m.userManager.FindByEmail(opCtx, email, false)
+			if err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.ResendVerificationEmail] find a user by email: %w", err)
+			}
+
+			// the caller isn't informed whether the user exists
+			if u == nil || u.Status != models.UserStatusNew || u.Name == nil || u.Email == nil {
+				m.logger.WarningWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.RegistrationEvent,
+					"[manager.RegistrationManager.ResendVerificationEmail] user not found or the user's email has already been verified",
+				)
+				return nil
+			}
+
+			if err = m.issueVerificationToken(m.appUserCtx(opCtx), u.Id, *u.Name, *u.Email); err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.ResendVerificationEmail] issue a verification token: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.ResendVerificationEmail] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Approve approves the user (the user's status is changed from 'PendingApproval' to 'Active').
+func (m *RegistrationManager) Approve(ctx *actions.OperationContext, userId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationManager_Approve, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.registrationStore.Approve(opCtx, userId, "approved"); err != nil {
+				return fmt.Errorf("[manager.RegistrationManager.Approve] approve a user: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RegistrationEvent,
+				"[manager.RegistrationManager.Approve] user has been approved",
+				logging.NewField("userId", userId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.Approve] execute an operation: %w", err)
+	}
+	return nil
+}
+
+func (m *RegistrationManager) setCredentials(ctx *actions.OperationContext, userId uint64, data *registrationoperations.RegisterOperationData) error {
+	if err := m.userManager.SetNameById(ctx, userId, nullable.NewNullable(data.Name)); err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.setCredentials] set a user name by id: %w", err)
+	}
+
+	if err := m.userCredentialManager.SetPassword(ctx, userId, data.Password); err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.setCredentials] set a user's password: %w", err)
+	}
+	return nil
+}
+
+// deleteUser deletes the user whose registration failed. The error is only logged,
+// because the original error is returned to the caller.
+func (m *RegistrationManager) deleteUser(ctx *actions.OperationContext, userId uint64) {
+	if err := m.userManager.Delete(ctx, userId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RegistrationEvent, err,
+			"[manager.RegistrationManager.deleteUser] delete a user",
+			logging.NewField("userId", userId),
+		)
+	}
+}
+
+func (m *RegistrationManager) issueVerificationToken(ctx *actions.OperationContext, userId uint64, name, email string) error {
+	b := make([]byte, verificationTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.issueVerificationToken] generate a token: %w", err)
+	}
+
+	// only the hash of the token is stored
+	t := base64.RawURLEncoding.EncodeToString(b)
+	expiresAt := time.Now().Add(m.config.VerificationTokenTTL)
+	id, err := m.registrationStore.CreateEmailVerificationToken(ctx, userId, hashVerificationToken(t), m.config.VerificationTokenTTL)
+	if err != nil {
+		return fmt.Errorf("[manager.RegistrationManager.issueVerificationToken] create an email verification token: %w", err)
+	}
+
+	leCtx := ctx.CreateLogEntryContext()
+	tdata := verificationnotifs.NewEmailVerificationNotifTmplData(name, m.verificationURL(t), expiresAt)
+	notifId, err := m.emailNotifier.SendUsingTemplate(ctx, verificationnotifs.NotifGroup, []string{email},
+		verificationnotifs.EmailVerificationNotifSubject, verificationnotifs.EmailVerificationNotifTmplName, tdata,
+	)
+	if err != nil {
+		// the user can request a new verification email
+		m.logger.ErrorWithEvent(leCtx, events.RegistrationEvent, err,
+			"[manager.RegistrationManager.issueVerificationToken] send an email notification using a template",
+			logging.NewField("tokenId", id),
+			logging.NewField("userId", userId),
+		)
+		return nil
+	}
+
+	m.logger.InfoWithEvent(leCtx, events.RegistrationEvent, "[manager.RegistrationManager.issueVerificationToken] verification email has been sent",
+		logging.NewField("notificationId", notifId),
+		logging.NewField("tokenId", id),
+		logging.NewField("userId", userId),
+	)
+	return nil
+}
+
+// sendNotification sends an email notification to the specified email using the template.
+// The error is only logged, because the result of the registration mustn't depend on it.
+func (m *RegistrationManager) sendNotification(ctx *actions.OperationContext, email, subject, tmplName string, tdata any) {
+	leCtx := ctx.CreateLogEntryContext()
+	notifId, err := m.emailNotifier.SendUsingTemplate(ctx, existingaccount.NotifGroup, []string{email}, subject, tmplName, tdata)
+	if err != nil {
+		m.logger.ErrorWithEvent(leCtx, events.RegistrationEvent, err,
+			"[manager.RegistrationManager.sendNotification] send an email notification using a template",
+			logging.NewField("tmplName", tmplName),
+		)
+		return
+	}
+
+	m.logger.InfoWithEvent(leCtx, events.RegistrationEvent, "[manager.RegistrationManager.sendNotification] email notification has been sent",
+		logging.NewField("notificationId", notifId),
+		logging.NewField("tmplName", tmplName),
+	)
+}
+
+func (m *RegistrationManager) verificationURL(token string) string {
+	// the URL has been validated when the manager was created
+	u, _ := url.Parse(m.config.VerificationURL)
+	q := u.Query()
+	q.Set(verificationTokenParam, token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func (m *RegistrationManager) appUserCtx(ctx *actions.OperationContext) *actions.OperationContext {
+	if !ctx.UserId.HasValue || ctx.UserId.Value != m.appUserId {
+		ctx = ctx.Clone()
+		ctx.UserId = nullable.NewNullable(m.appUserId)
+	}
+	return ctx
+}
+
+func hashVerificationToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registration
+
+import (
+	"personal-website-v2/identity/src/internal/registration/operations/registration"
+	"personal-website-v2/pkg/actions"
+)
+
+// RegistrationManager is a manager of the users' registration.
+type RegistrationManager interface {
+	// Register creates a user with the status 'New', sets the user's name and password
+	// and sends an email with the verification link to the user. If a user with the same
+	// name or email already exists, then an email informing of it is sent instead
+	// and no error is returned, so the caller isn't informed whether the user exists.
+	Register(ctx *actions.OperationContext, data *registration.RegisterOperationData) error
+
+	// VerifyEmail verifies the user's email by the specified token and activates the user
+	// or waits for approval if the approval is required. It returns the user ID.
+	// The token can only be used once.
+	VerifyEmail(ctx *actions.OperationContext, token string) (uint64, error)
+
+	// ResendVerificationEmail issues a new verification token and sends it to the user
+	// with the specified email if the user's email hasn't been verified yet.
+	ResendVerificationEmail(ctx *actions.OperationContext, email string) error
+
+	// Approve approves the user (the user's status is changed from 'PendingApproval' to 'Active').
+	Approve(ctx *actions.OperationContext, userId uint64) error
+}
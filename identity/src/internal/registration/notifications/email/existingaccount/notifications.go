@@ -0,0 +1,47 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package existingaccount
+
+const (
+	NotifGroup = "identity.registration"
+
+	AccountExistsNotifName     = "Registration_AccountExists"
+	AccountExistsNotifSubject  = "[pw:identity.registration] You already have an account"
+	AccountExistsNotifTmplName = "Registration_AccountExists.html"
+
+	UsernameTakenNotifName     = "Registration_UsernameTaken"
+	UsernameTakenNotifSubject  = "[pw:identity.registration] Choose another user name"
+	UsernameTakenNotifTmplName = "Registration_UsernameTaken.html"
+)
+
+type AccountExistsNotifTmplData struct {
+	Email string
+}
+
+func NewAccountExistsNotifTmplData(email string) *AccountExistsNotifTmplData {
+	return &AccountExistsNotifTmplData{
+		Email: email,
+	}
+}
+
+type UsernameTakenNotifTmplData struct {
+	Name string
+}
+
+func NewUsernameTakenNotifTmplData(name string) *UsernameTakenNotifTmplData {
+	return &UsernameTakenNotifTmplData{
+		Name: name,
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import "time"
+
+const (
+	NotifGroup = "identity.registration"
+
+	EmailVerificationNotifName     = "Registration_EmailVerification"
+	EmailVerificationNotifSubject  = "[pw:identity.registration] Confirm your email address"
+	EmailVerificationNotifTmplName = "Registration_EmailVerification.html"
+)
+
+type EmailVerificationNotifTmplData struct {
+	Name            string
+	VerificationURL string
+	ExpiresAt       time.Time
+}
+
+func NewEmailVerificationNotifTmplData(name, verificationURL string, expiresAt time.Time) *EmailVerificationNotifTmplData {
+	return &EmailVerificationNotifTmplData{
+		Name:            name,
+		VerificationURL: verificationURL,
+		ExpiresAt:       expiresAt,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registration
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	credentialmodels "personal-website-v2/identity/src/internal/credentials/models"
+	"personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+)
+
+type RegisterOperationData struct {
+	// The user name.
+	Name string `json:"name"`
+
+	// The user's email.
+	Email string `json:"email"`
+
+	// The user's password.
+	Password string `json:"-"`
+
+	// The first name.
+	FirstName string `json:"firstName"`
+
+	// The last name.
+	LastName string `json:"lastName"`
+
+	// The display name.
+	DisplayName string `json:"displayName"`
+
+	// The user's date of birth.
+	BirthDate nullable.Nullable[time.Time] `json:"birthDate"`
+
+	// The user's gender.
+	Gender models.Gender `json:"gender"`
+}
+
+func (d *RegisterOperationData) Validate() *errors.Error {
+	if strings.IsEmptyOrWhitespace(d.Name) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "name is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.Email) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "email is empty")
+	}
+	// the password is validated before the user is created
+	if n := utf8.RuneCountInString(d.Password); n < credentialmodels.PasswordMinLength {
+		return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("password must be at least %d characters", credentialmodels.PasswordMinLength))
+	} else if n > credentialmodels.PasswordMaxLength {
+		return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("password must be at most %d characters", credentialmodels.PasswordMaxLength))
+	}
+	if strings.IsEmptyOrWhitespace(d.FirstName) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "firstName is empty")
+	}
+	if strings.IsEmptyOrWhitespace(d.LastName) {
+		return errors.NewError(errors.ErrorCodeInvalidData, "lastName is empty")
+	}
+	if !d.Gender.IsValid() {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid gender")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registration
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// RegistrationStore is a store of the users' registration data.
+type RegistrationStore interface {
+	// CreateEmailVerificationToken creates an email verification token of the user with the status 'New'
+	// and returns the token ID if the operation is successful. The previously issued tokens of the user
+	// are deleted.
+	CreateEmailVerificationToken(ctx *actions.OperationContext, userId uint64, tokenHash []byte, ttl time.Duration) (uint64, error)
+
+	// VerifyEmail deletes the email verification token by the specified token hash and
+	// changes the user's status from 'New' to 'PendingApproval' if the approval is required;
+	// otherwise, to 'Active'. It returns the user ID and the new user's status.
+	VerifyEmail(ctx *actions.OperationContext, tokenHash []byte, requireApproval bool) (uint64, models.UserStatus, error)
+
+	// Approve changes the user's status from 'PendingApproval' to 'Active'.
+	Approve(ctx *actions.OperationContext, userId uint64, statusComment string) error
+}
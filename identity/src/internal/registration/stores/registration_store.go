@@ -0,0 +1,196 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/registration"
+	"personal-website-v2/identity/src/internal/users/models"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+// RegistrationStore is a store of the users' registration data.
+type RegistrationStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ registration.RegistrationStore = (*RegistrationStore)(nil)
+
+func NewRegistrationStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*RegistrationStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.registration.stores.RegistrationStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRegistrationStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupRegistration,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRegistrationStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRegistrationStore] new TxManager: %w", err)
+	}
+
+	return &RegistrationStore{
+		db:         db,
+		opExecutor: e,
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// CreateEmailVerificationToken creates an email verification token of the user with the status 'New'
+// and returns the token ID if the operation is successful. The previously issued tokens of the user
+// are deleted.
+func (s *RegistrationStore) CreateEmailVerificationToken(ctx *actions.OperationContext, userId uint64, tokenHash []byte, ttl time.Duration) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationStore_CreateEmailVerificationToken,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("ttl", ttl)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_email_verification_token(IN _user_id, IN _token_hash, IN _ttl, OUT _id, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.create_email_verification_token($1, $2, $3, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, tokenHash, ttl).Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RegistrationStore.CreateEmailVerificationToken] execute a query (create_email_verification_token): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RegistrationStore.CreateEmailVerificationToken] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RegistrationStore.CreateEmailVerificationToken] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.RegistrationStore.CreateEmailVerificationToken] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// VerifyEmail deletes the email verification token by the specified token hash and
+// changes the user's status from 'New' to 'PendingApproval' if the approval is required;
+// otherwise, to 'Active'. It returns the user ID and the new user's status.
+func (s *RegistrationStore) VerifyEmail(ctx *actions.OperationContext, tokenHash []byte, requireApproval bool) (uint64, models.UserStatus, error) {
+	var userId uint64
+	var status models.UserStatus
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationStore_VerifyEmail,
+		[]*actions.OperationParam{actions.NewOperationParam("requireApproval", requireApproval)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.verify_user_email(IN _token_hash, IN _require_approval, OUT _user_id, OUT _status, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.verify_user_email($1, $2, NULL, NULL, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, tokenHash, requireApproval).Scan(&userId, &status, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RegistrationStore.VerifyEmail] execute a query (verify_user_email): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeEmailVerificationTokenNotFound:
+					return ierrors.ErrEmailVerificationTokenNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RegistrationStore.VerifyEmail] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RegistrationStore.VerifyEmail] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("[stores.RegistrationStore.VerifyEmail] execute an operation: %w", err)
+	}
+	return userId, status, nil
+}
+
+// Approve changes the user's status from 'PendingApproval' to 'Active'.
+func (s *RegistrationStore) Approve(ctx *actions.OperationContext, userId uint64, statusComment string) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRegistrationStore_Approve, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.approve_user(IN _id, IN _updated_by, IN _status_comment, OUT err_code, OUT err_msg)
+				// Minimum transaction isolation level: Read committed.
+				const query = "CALL public.approve_user($1, $2, $3, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, userId, opCtx.UserId.Ptr(), statusComment).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RegistrationStore.Approve] execute a query (approve_user): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeUserNotFound:
+					return ierrors.ErrUserNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RegistrationStore.Approve] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RegistrationStore.Approve] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RegistrationStore.Approve] execute an operation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbmodels
+
+import (
+	"time"
+
+	"personal-website-v2/identity/src/internal/resources/models"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+)
+
+// The resource type.
+type ResourceType struct {
+	// The unique ID to identify the resource type.
+	Id uint64 `db:"id"`
+
+	// The unique name to identify the resource type.
+	Name string `db:"name"`
+
+	// It stores the date and time at which the resource type was registered.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who registered the resource type.
+	CreatedBy uint64 `db:"created_by"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+// The resource role assignment.
+type ResourceRoleAssignment struct {
+	// The unique ID to identify the resource role assignment.
+	Id uint64 `db:"id"`
+
+	// The role ID.
+	RoleId uint64 `db:"role_id"`
+
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
+	AssignedTo uint64 `db:"assigned_to"`
+
+	// The type of the assignee.
+	AssigneeType rolemodels.AssigneeType `db:"assignee_type"`
+
+	// The resource type ID.
+	ResourceTypeId uint64 `db:"resource_type_id"`
+
+	// The resource ID if the assignment is bound to a specific resource.
+	ResourceId *uint64 `db:"resource_id"`
+
+	// The resource owner rule.
+	OwnerRule models.OwnerRule `db:"owner_rule"`
+
+	// It stores the date and time at which the resource role assignment was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the resource role assignment.
+	CreatedBy uint64 `db:"created_by"`
+
+	// The resource role assignment description.
+	Description *string `db:"description"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
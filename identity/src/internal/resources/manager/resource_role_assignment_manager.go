@@ -0,0 +1,232 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/resources"
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+	"personal-website-v2/identity/src/internal/resources/operations/resourceroleassignments"
+	"personal-website-v2/identity/src/internal/roles"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/actions"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// ResourceRoleAssignmentManager is a resource role assignment manager.
+type ResourceRoleAssignmentManager struct {
+	opExecutor            *actionhelper.OperationExecutor
+	roleManager           roles.RoleManager
+	resourceTypeManager   resources.ResourceTypeManager
+	userGroupManager      groups.UserGroupManager
+	assignmentStore       resources.ResourceRoleAssignmentStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
+}
+
+var _ resources.ResourceRoleAssignmentManager = (*ResourceRoleAssignmentManager)(nil)
+
+func NewResourceRoleAssignmentManager(
+	roleManager roles.RoleManager,
+	resourceTypeManager resources.ResourceTypeManager,
+	userGroupManager groups.UserGroupManager,
+	assignmentStore resources.ResourceRoleAssignmentStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ResourceRoleAssignmentManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.resources.manager.ResourceRoleAssignmentManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewResourceRoleAssignmentManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupResourceRoleAssignment,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewResourceRoleAssignmentManager] new operation executor: %w", err)
+	}
+
+	return &ResourceRoleAssignmentManager{
+		opExecutor:            e,
+		roleManager:           roleManager,
+		resourceTypeManager:   resourceTypeManager,
+		userGroupManager:      userGroupManager,
+		assignmentStore:       assignmentStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
+	}, nil
+}
+
+// Create creates a resource role assignment and returns the resource role assignment ID
+// if the operation is successful.
+func (m *ResourceRoleAssignmentManager) Create(ctx *actions.OperationContext, data *resourceroleassignments.CreateOperationData) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentManager_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if err := data.Validate(); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] validate data: %w", err)
+			}
+
+			if s, err := m.roleManager.GetStatusById(opCtx, data.RoleId); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] get a role status by id: %w", err)
+			} else if s != rolemodels.RoleStatusActive {
+				return errs.NewError(errs.ErrorCodeInvalidOperation, fmt.Sprintf("invalid role status (%v)", s))
+			}
+
+			if t, err := m.resourceTypeManager.FindById(opCtx, data.ResourceTypeId); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] find a resource type by id: %w", err)
+			} else if t == nil {
+				return ierrors.ErrResourceTypeNotFound
+			}
+
+			if data.AssigneeType == rolemodels.AssigneeTypeGroup {
+				if exists, err := m.userGroupManager.ExistsById(opCtx, groupmodels.UserGroup(data.AssignedTo)); err != nil {
+					return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] user group exists: %w", err)
+				} else if !exists {
+					return ierrors.ErrUserGroupNotFound
+				}
+			}
+
+			var err error
+			if id, err = m.assignmentStore.Create(opCtx, data); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] create a resource role assignment: %w", err)
+			}
+
+			leCtx := opCtx.CreateLogEntryContext()
+			m.logger.InfoWithEvent(leCtx, events.ResourceRoleAssignmentEvent, "[manager.ResourceRoleAssignmentManager.Create] resource role assignment has been created",
+				logging.NewField("id", id),
+			)
+
+			if err = m.invalidateAssignee(opCtx, data.AssignedTo, data.AssigneeType); err != nil {
+				m.logger.ErrorWithEvent(leCtx, events.ResourceRoleAssignmentEvent, err,
+					"[manager.ResourceRoleAssignmentManager.Create] invalidate the authorization cache of the assignee",
+					logging.NewField("id", id),
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ResourceRoleAssignmentManager.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Delete deletes a resource role assignment by the specified resource role assignment ID.
+func (m *ResourceRoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentManager_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			a, err := m.assignmentStore.FindById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Delete] find a resource role assignment by id: %w", err)
+			}
+			if a == nil {
+				return ierrors.ErrResourceRoleAssignmentNotFound
+			}
+
+			if err = m.assignmentStore.Delete(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Delete] delete a resource role assignment: %w", err)
+			}
+
+			leCtx := opCtx.CreateLogEntryContext()
+			m.logger.InfoWithEvent(leCtx, events.ResourceRoleAssignmentEvent, "[manager.ResourceRoleAssignmentManager.Delete] resource role assignment has been deleted",
+				logging.NewField("id", id),
+			)
+
+			if err = m.invalidateAssignee(opCtx, a.AssignedTo, a.AssigneeType); err != nil {
+				m.logger.ErrorWithEvent(leCtx, events.ResourceRoleAssignmentEvent, err,
+					"[manager.ResourceRoleAssignmentManager.Delete] invalidate the authorization cache of the assignee",
+					logging.NewField("id", id),
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ResourceRoleAssignmentManager.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// invalidateAssignee invalidates the cached authorization data of the assignee.
+func (m *ResourceRoleAssignmentManager) invalidateAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType rolemodels.AssigneeType) error {
+	var err error
+	switch assigneeType {
+	case rolemodels.AssigneeTypeUser:
+		err = m.authzCacheInvalidator.InvalidateUser(ctx, assigneeId)
+	case rolemodels.AssigneeTypeGroup:
+		err = m.authzCacheInvalidator.InvalidateGroup(ctx, groupmodels.UserGroup(assigneeId))
+	case rolemodels.AssigneeTypeClient:
+		err = m.authzCacheInvalidator.InvalidateClient(ctx, assigneeId)
+	default:
+		return fmt.Errorf("[manager.ResourceRoleAssignmentManager.invalidateAssignee] '%s' assignee type isn't supported", assigneeType)
+	}
+	if err != nil {
+		return fmt.Errorf("[manager.ResourceRoleAssignmentManager.invalidateAssignee] invalidate the %s: %w", assigneeType, err)
+	}
+	return nil
+}
+
+// FindById finds and returns a resource role assignment, if any, by the specified resource role assignment ID.
+func (m *ResourceRoleAssignmentManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceRoleAssignment, error) {
+	var a *dbmodels.ResourceRoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentManager_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if a, err = m.assignmentStore.FindById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.FindById] find a resource role assignment by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceRoleAssignmentManager.FindById] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// GetAllByAssignee gets all resource role assignments by the specified assignee.
+func (m *ResourceRoleAssignmentManager) GetAllByAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType rolemodels.AssigneeType,
+) ([]*dbmodels.ResourceRoleAssignment, error) {
+	var as []*dbmodels.ResourceRoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentManager_GetAllByAssignee,
+		[]*actions.OperationParam{actions.NewOperationParam("assigneeId", assigneeId), actions.NewOperationParam("assigneeType", assigneeType)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if as, err = m.assignmentStore.GetAllByAssignee(opCtx, assigneeId, assigneeType); err != nil {
+				return fmt.Errorf("[manager.ResourceRoleAssignmentManager.GetAllByAssignee] get all resource role assignments by assignee: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceRoleAssignmentManager.GetAllByAssignee] execute an operation: %w", err)
+	}
+	return as, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/resources"
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/strings"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+const (
+	maxResourceTypeNameLen = 256
+)
+
+// ResourceTypeManager is a resource type manager.
+type ResourceTypeManager struct {
+	opExecutor        *actionhelper.OperationExecutor
+	resourceTypeStore resources.ResourceTypeStore
+	logger            logging.Logger[*context.LogEntryContext]
+}
+
+var _ resources.ResourceTypeManager = (*ResourceTypeManager)(nil)
+
+func NewResourceTypeManager(resourceTypeStore resources.ResourceTypeStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*ResourceTypeManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.resources.manager.ResourceTypeManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewResourceTypeManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupResourceType,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewResourceTypeManager] new operation executor: %w", err)
+	}
+
+	return &ResourceTypeManager{
+		opExecutor:        e,
+		resourceTypeStore: resourceTypeStore,
+		logger:            l,
+	}, nil
+}
+
+// Register registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (m *ResourceTypeManager) Register(names []string, createdBy uint64) ([]*dbmodels.ResourceType, error) {
+	if err := validateResourceTypeNames(names); err != nil {
+		return nil, err
+	}
+
+	ts, err := m.resourceTypeStore.Register(names, createdBy)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceTypeManager.Register] register resource types: %w", err)
+	}
+	return ts, nil
+}
+
+// RegisterWithContext registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (m *ResourceTypeManager) RegisterWithContext(ctx *actions.OperationContext, names []string) ([]*dbmodels.ResourceType, error) {
+	var ts []*dbmodels.ResourceType
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeManager_Register, []*actions.OperationParam{actions.NewOperationParam("names", names)},
+		func(opCtx *actions.OperationContext) error {
+			if err := validateResourceTypeNames(names); err != nil {
+				return err
+			}
+
+			var err error
+			if ts, err = m.resourceTypeStore.RegisterWithContext(opCtx, names); err != nil {
+				return fmt.Errorf("[manager.ResourceTypeManager.RegisterWithContext] register resource types: %w", err)
+			}
+
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.ResourceTypeEvent,
+				"[manager.ResourceTypeManager.RegisterWithContext] resource types have been registered",
+				logging.NewField("names", names),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceTypeManager.RegisterWithContext] execute an operation: %w", err)
+	}
+	return ts, nil
+}
+
+// FindById finds and returns a resource type, if any, by the specified resource type ID.
+func (m *ResourceTypeManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceType, error) {
+	var t *dbmodels.ResourceType
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeManager_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if t, err = m.resourceTypeStore.FindById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ResourceTypeManager.FindById] find a resource type by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceTypeManager.FindById] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// GetAll gets all resource types.
+func (m *ResourceTypeManager) GetAll(ctx *actions.OperationContext) ([]*dbmodels.ResourceType, error) {
+	var ts []*dbmodels.ResourceType
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeManager_GetAll, nil,
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ts, err = m.resourceTypeStore.GetAll(opCtx); err != nil {
+				return fmt.Errorf("[manager.ResourceTypeManager.GetAll] get all resource types: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ResourceTypeManager.GetAll] execute an operation: %w", err)
+	}
+	return ts, nil
+}
+
+func validateResourceTypeNames(names []string) error {
+	if len(names) == 0 {
+		return errs.NewError(errs.ErrorCodeInvalidData, "number of names is 0")
+	}
+
+	for _, n := range names {
+		if strings.IsEmptyOrWhitespace(n) {
+			return errs.NewError(errs.ErrorCodeInvalidData, "name is empty")
+		}
+		if len(n) > maxResourceTypeNameLen {
+			return errs.NewError(errs.ErrorCodeInvalidData, fmt.Sprintf("name length is greater than %d", maxResourceTypeNameLen))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+	"personal-website-v2/identity/src/internal/resources/operations/resourceroleassignments"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/actions"
+)
+
+// ResourceTypeManager is a resource type manager.
+// The resource types are registered by the services at startup and can't be deleted.
+type ResourceTypeManager interface {
+	// Register registers the resource types that aren't registered yet and returns all the resource types
+	// with the specified names if the operation is successful.
+	Register(names []string, createdBy uint64) ([]*dbmodels.ResourceType, error)
+
+	// RegisterWithContext registers the resource types that aren't registered yet and returns all the resource types
+	// with the specified names if the operation is successful.
+	RegisterWithContext(ctx *actions.OperationContext, names []string) ([]*dbmodels.ResourceType, error)
+
+	// FindById finds and returns a resource type, if any, by the specified resource type ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceType, error)
+
+	// GetAll gets all resource types.
+	GetAll(ctx *actions.OperationContext) ([]*dbmodels.ResourceType, error)
+}
+
+// ResourceRoleAssignmentManager is a resource role assignment manager.
+// A resource role assignment grants the permissions of the role to the assignee
+// only for a specific resource or for the resources that satisfy the owner rule.
+type ResourceRoleAssignmentManager interface {
+	// Create creates a resource role assignment and returns the resource role assignment ID
+	// if the operation is successful.
+	Create(ctx *actions.OperationContext, data *resourceroleassignments.CreateOperationData) (uint64, error)
+
+	// Delete deletes a resource role assignment by the specified resource role assignment ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// FindById finds and returns a resource role assignment, if any, by the specified resource role assignment ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceRoleAssignment, error)
+
+	// GetAllByAssignee gets all resource role assignments by the specified assignee.
+	GetAllByAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType rolemodels.AssigneeType) ([]*dbmodels.ResourceRoleAssignment, error)
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "fmt"
+
+// The resource owner rule.
+// It specifies which resources of a resource type a resource role assignment applies to
+// if the assignment isn't bound to a specific resource.
+type OwnerRule uint8
+
+const (
+	// The assignment is bound to a specific resource.
+	OwnerRuleNone OwnerRule = 0
+
+	// The assignment applies to the resources created by the user to whom the permission check applies.
+	OwnerRuleCreatedBySelf OwnerRule = 1
+)
+
+func (r OwnerRule) IsValid() bool {
+	return r == OwnerRuleNone || r == OwnerRuleCreatedBySelf
+}
+
+func (r OwnerRule) String() string {
+	switch r {
+	case OwnerRuleNone:
+		return "none"
+	case OwnerRuleCreatedBySelf:
+		return "createdBySelf"
+	}
+	return fmt.Sprintf("OwnerRule(%d)", r)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourceroleassignments
+
+import (
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
+	groupmodels "personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/identity/src/internal/resources/models"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/errors"
+)
+
+type CreateOperationData struct {
+	// The role ID.
+	RoleId uint64 `json:"roleId"`
+
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
+	AssignedTo uint64 `json:"assignedTo"`
+
+	// The type of the assignee.
+	AssigneeType rolemodels.AssigneeType `json:"assigneeType"`
+
+	// The resource type ID.
+	ResourceTypeId uint64 `json:"resourceTypeId"`
+
+	// The resource ID. It must be specified if the owner rule is None and
+	// must not be specified otherwise.
+	ResourceId nullable.Nullable[uint64] `json:"resourceId"`
+
+	// The resource owner rule.
+	OwnerRule models.OwnerRule `json:"ownerRule"`
+
+	// The resource role assignment description.
+	Description nullable.Nullable[string] `json:"description"`
+}
+
+func (d *CreateOperationData) Validate() *errors.Error {
+	if d.RoleId == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid role id")
+	}
+	if !d.AssigneeType.IsValid() {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee type")
+	}
+	if d.ResourceTypeId == 0 {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid resource type id")
+	}
+	if !d.OwnerRule.IsValid() {
+		return errors.NewError(errors.ErrorCodeInvalidData, "invalid owner rule")
+	}
+
+	if d.OwnerRule == models.OwnerRuleNone {
+		if !d.ResourceId.HasValue {
+			return errors.NewError(errors.ErrorCodeInvalidData, "resource id is required if the owner rule is none")
+		}
+	} else if d.ResourceId.HasValue {
+		return errors.NewError(errors.ErrorCodeInvalidData, "resource id isn't allowed if the owner rule is specified")
+	}
+
+	switch d.AssigneeType {
+	case rolemodels.AssigneeTypeGroup:
+		if !groupmodels.UserGroup(d.AssignedTo).IsValid() {
+			return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee id")
+		}
+	case rolemodels.AssigneeTypeClient:
+		// only service clients can be assignees
+		if clientmodels.ClientType(byte(d.AssignedTo)) != clientmodels.ClientTypeService {
+			return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee id")
+		}
+		// service clients don't create resources on their own behalf
+		if d.OwnerRule == models.OwnerRuleCreatedBySelf {
+			return errors.NewError(errors.ErrorCodeInvalidData, "owner rule isn't supported for clients")
+		}
+	}
+	return nil
+}
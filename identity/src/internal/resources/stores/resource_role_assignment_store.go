@@ -0,0 +1,195 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/resources"
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+	"personal-website-v2/identity/src/internal/resources/operations/resourceroleassignments"
+	rolemodels "personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	resourceRoleAssignmentsTable = "public.resource_role_assignments"
+)
+
+// ResourceRoleAssignmentStore is a resource role assignment store.
+type ResourceRoleAssignmentStore struct {
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ResourceRoleAssignment]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ resources.ResourceRoleAssignmentStore = (*ResourceRoleAssignmentStore)(nil)
+
+func NewResourceRoleAssignmentStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ResourceRoleAssignmentStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.resources.stores.ResourceRoleAssignmentStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceRoleAssignmentStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupResourceRoleAssignment,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceRoleAssignmentStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceRoleAssignmentStore] new TxManager: %w", err)
+	}
+
+	return &ResourceRoleAssignmentStore{
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ResourceRoleAssignment](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a resource role assignment and returns the resource role assignment ID
+// if the operation is successful.
+func (s *ResourceRoleAssignmentStore) Create(ctx *actions.OperationContext, data *resourceroleassignments.CreateOperationData) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentStore_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_resource_role_assignment(IN _role_id, IN _assigned_to, IN _assignee_type, IN _resource_type_id, IN _resource_id,
+				// IN _owner_rule, IN _created_by, IN _description, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_resource_role_assignment($1, $2, $3, $4, $5, $6, $7, $8, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.RoleId, data.AssignedTo, data.AssigneeType, data.ResourceTypeId, data.ResourceId.Ptr(), data.OwnerRule,
+					opCtx.UserId.Ptr(), data.Description.Ptr(),
+				)
+
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Create] execute a query (create_resource_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeResourceRoleAssignmentAlreadyExists:
+					return ierrors.ErrResourceRoleAssignmentAlreadyExists
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ResourceRoleAssignmentStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Delete deletes a resource role assignment by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentStore) Delete(ctx *actions.OperationContext, id uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.delete_resource_role_assignment(IN _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.delete_resource_role_assignment($1, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, id).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Delete] execute a query (delete_resource_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeResourceRoleAssignmentNotFound:
+					return ierrors.ErrResourceRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Delete] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ResourceRoleAssignmentStore.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// FindById finds and returns a resource role assignment, if any, by the specified resource role assignment ID.
+func (s *ResourceRoleAssignmentStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceRoleAssignment, error) {
+	var a *dbmodels.ResourceRoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + resourceRoleAssignmentsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if a, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.FindById] find a resource role assignment by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceRoleAssignmentStore.FindById] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// GetAllByAssignee gets all resource role assignments by the specified assignee.
+func (s *ResourceRoleAssignmentStore) GetAllByAssignee(ctx *actions.OperationContext, assigneeId uint64, assigneeType rolemodels.AssigneeType) ([]*dbmodels.ResourceRoleAssignment, error) {
+	var as []*dbmodels.ResourceRoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceRoleAssignmentStore_GetAllByAssignee,
+		[]*actions.OperationParam{actions.NewOperationParam("assigneeId", assigneeId), actions.NewOperationParam("assigneeType", assigneeType)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + resourceRoleAssignmentsTable + " WHERE assigned_to = $1 AND assignee_type = $2"
+			var err error
+			if as, err = s.store.FindAll(opCtx.Ctx, query, assigneeId, assigneeType); err != nil {
+				return fmt.Errorf("[stores.ResourceRoleAssignmentStore.GetAllByAssignee] find all resource role assignments by assignee: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceRoleAssignmentStore.GetAllByAssignee] execute an operation: %w", err)
+	}
+	return as, nil
+}
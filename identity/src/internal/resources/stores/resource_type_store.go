@@ -0,0 +1,178 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/resources"
+	"personal-website-v2/identity/src/internal/resources/dbmodels"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	resourceTypesTable = "public.resource_types"
+)
+
+// ResourceTypeStore is a resource type store.
+type ResourceTypeStore struct {
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ResourceType]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ resources.ResourceTypeStore = (*ResourceTypeStore)(nil)
+
+func NewResourceTypeStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ResourceTypeStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.resources.stores.ResourceTypeStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceTypeStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupResourceType,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceTypeStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewResourceTypeStore] new TxManager: %w", err)
+	}
+
+	return &ResourceTypeStore{
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ResourceType](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Register registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (s *ResourceTypeStore) Register(names []string, createdBy uint64) ([]*dbmodels.ResourceType, error) {
+	ts, err := s.register(context.Background(), names, createdBy)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceTypeStore.Register] register resource types: %w", err)
+	}
+	return ts, nil
+}
+
+// RegisterWithContext registers the resource types that aren't registered yet and returns all the resource types
+// with the specified names if the operation is successful.
+func (s *ResourceTypeStore) RegisterWithContext(ctx *actions.OperationContext, names []string) ([]*dbmodels.ResourceType, error) {
+	var ts []*dbmodels.ResourceType
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeStore_Register, []*actions.OperationParam{actions.NewOperationParam("names", names)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ts, err = s.register(opCtx.Ctx, names, opCtx.UserId.Value); err != nil {
+				return fmt.Errorf("[stores.ResourceTypeStore.RegisterWithContext] register resource types: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceTypeStore.RegisterWithContext] execute an operation: %w", err)
+	}
+	return ts, nil
+}
+
+func (s *ResourceTypeStore) register(ctx context.Context, names []string, createdBy uint64) ([]*dbmodels.ResourceType, error) {
+	var ts []*dbmodels.ResourceType
+	err := s.txManager.ExecWithReadCommittedLevel(ctx, func(txCtx context.Context, tx pgx.Tx) error {
+		var errCode dberrors.DbErrorCode
+		var errMsg string
+		// PROCEDURE: public.register_resource_types(IN _names, IN _created_by, OUT err_code, OUT err_msg)
+		const query = "CALL public.register_resource_types($1, $2, NULL, NULL)"
+
+		if err := tx.QueryRow(txCtx, query, names, createdBy).Scan(&errCode, &errMsg); err != nil {
+			return fmt.Errorf("[stores.ResourceTypeStore.register] execute a query (register_resource_types): %w", err)
+		}
+
+		if errCode != dberrors.DbErrorCodeNoError {
+			// unknown error
+			return fmt.Errorf("[stores.ResourceTypeStore.register] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+		}
+
+		const query2 = "SELECT * FROM " + resourceTypesTable + " WHERE name = ANY($1)"
+		rows, err := tx.Query(txCtx, query2, names)
+		if err != nil {
+			return fmt.Errorf("[stores.ResourceTypeStore.register] execute a query: %w", err)
+		}
+		defer rows.Close()
+
+		if ts, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[dbmodels.ResourceType]); err != nil {
+			return fmt.Errorf("[stores.ResourceTypeStore.register] collect rows: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceTypeStore.register] execute a transaction: %w", err)
+	}
+	return ts, nil
+}
+
+// FindById finds and returns a resource type, if any, by the specified resource type ID.
+func (s *ResourceTypeStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ResourceType, error) {
+	var t *dbmodels.ResourceType
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + resourceTypesTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if t, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.ResourceTypeStore.FindById] find a resource type by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceTypeStore.FindById] execute an operation: %w", err)
+	}
+	return t, nil
+}
+
+// GetAll gets all resource types.
+func (s *ResourceTypeStore) GetAll(ctx *actions.OperationContext) ([]*dbmodels.ResourceType, error) {
+	var ts []*dbmodels.ResourceType
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeResourceTypeStore_GetAll, nil,
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + resourceTypesTable + " ORDER BY id"
+			var err error
+			if ts, err = s.store.FindAll(opCtx.Ctx, query); err != nil {
+				return fmt.Errorf("[stores.ResourceTypeStore.GetAll] find all resource types: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ResourceTypeStore.GetAll] execute an operation: %w", err)
+	}
+	return ts, nil
+}
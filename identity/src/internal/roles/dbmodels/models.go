@@ -132,8 +132,8 @@ type RoleAssignment struct {
 	// The role ID.
 	RoleId uint64 `db:"role_id"`
 
-	// The unique ID of the entity this role is assigned to - either the userId of a user
-	// or the groupId of a group.
+	// The unique ID of the entity this role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
 	AssignedTo uint64 `db:"assigned_to"`
 
 	// The type of the assignee.
@@ -166,6 +166,14 @@ type RoleAssignment struct {
 	// The role assignment description.
 	Description *string `db:"description"`
 
+	// Optional. It stores the date and time from which the role assignment is valid.
+	// If it isn't specified, then the role assignment is valid from the moment it is created.
+	ValidFrom *time.Time `db:"valid_from"`
+
+	// Optional. It stores the date and time until which the role assignment is valid.
+	// If it isn't specified, then the role assignment is permanent.
+	ValidUntil *time.Time `db:"valid_until"`
+
 	// rowversion
 	VersionStamp uint64 `db:"_version_stamp"`
 
@@ -211,6 +219,12 @@ type UserRoleAssignment struct {
 	// The user's role assignment status comment.
 	StatusComment *string `db:"status_comment"`
 
+	// Optional. It stores the date and time from which the user's role assignment is valid.
+	ValidFrom *time.Time `db:"valid_from"`
+
+	// Optional. It stores the date and time until which the user's role assignment is valid.
+	ValidUntil *time.Time `db:"valid_until"`
+
 	// rowversion
 	VersionStamp uint64 `db:"_version_stamp"`
 
@@ -256,6 +270,57 @@ type GroupRoleAssignment struct {
 	// The group role assignment status comment.
 	StatusComment *string `db:"status_comment"`
 
+	// Optional. It stores the date and time from which the group role assignment is valid.
+	ValidFrom *time.Time `db:"valid_from"`
+
+	// Optional. It stores the date and time until which the group role assignment is valid.
+	ValidUntil *time.Time `db:"valid_until"`
+
+	// rowversion
+	VersionStamp uint64 `db:"_version_stamp"`
+
+	// row timestamp
+	Timestamp time.Time `db:"_timestamp"`
+}
+
+// The client's role assignment.
+type ClientRoleAssignment struct {
+	// The unique ID to identify the client's role assignment.
+	Id uint64 `db:"id"`
+
+	// The role assignment ID.
+	RoleAssignmentId uint64 `db:"role_assignment_id"`
+
+	// The client ID.
+	ClientId uint64 `db:"client_id"`
+
+	// The role ID.
+	RoleId uint64 `db:"role_id"`
+
+	// It stores the date and time at which the client's role assignment was created.
+	CreatedAt time.Time `db:"created_at"`
+
+	// The user ID to identify the user who created the client's role assignment.
+	CreatedBy uint64 `db:"created_by"`
+
+	// It stores the date and time at which the client's role assignment was updated.
+	UpdatedAt time.Time `db:"updated_at"`
+
+	// The user ID to identify the user who updated the client's role assignment.
+	UpdatedBy uint64 `db:"updated_by"`
+
+	// The client's role assignment status.
+	Status models.ClientRoleAssignmentStatus `db:"status"`
+
+	// It stores the date and time at which the client's role assignment status was updated.
+	StatusUpdatedAt time.Time `db:"status_updated_at"`
+
+	// The user ID to identify the user who updated the client's role assignment status.
+	StatusUpdatedBy uint64 `db:"status_updated_by"`
+
+	// The client's role assignment status comment.
+	StatusComment *string `db:"status_comment"`
+
 	// rowversion
 	VersionStamp uint64 `db:"_version_stamp"`
 
@@ -0,0 +1,257 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	logginghelper "personal-website-v2/pkg/helper/logging"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const maxExpiredAssignmentsPerRun = 100
+
+type ExpirationServiceConfig struct {
+	// The interval between deactivations of role assignments whose validity period has ended.
+	Interval time.Duration
+
+	// The user ID (app user) on behalf of which role assignments are deactivated.
+	UserId uint64
+}
+
+// ExpirationService periodically deactivates role assignments whose validity period has ended.
+type ExpirationService struct {
+	appSessionId          uint64
+	tranManager           *actions.TransactionManager
+	actionExecutor        *actionhelper.ActionExecutor
+	roleAssignmentManager roles.RoleAssignmentManager
+	config                *ExpirationServiceConfig
+	logger                logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx             *lcontext.LogEntryContext
+	isStarted             atomic.Bool
+	isStopped             bool
+	mu                    sync.Mutex
+	done                  chan struct{}
+	wg                    sync.WaitGroup
+}
+
+func NewExpirationService(
+	appSessionId uint64,
+	tranManager *actions.TransactionManager,
+	actionManager *actions.ActionManager,
+	roleAssignmentManager roles.RoleAssignmentManager,
+	config *ExpirationServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ExpirationService, error) {
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("[expiration.NewExpirationService] invalid interval: %v", config.Interval)
+	}
+
+	l, err := loggerFactory.CreateLogger("internal.roles.expiration.ExpirationService")
+	if err != nil {
+		return nil, fmt.Errorf("[expiration.NewExpirationService] create a logger: %w", err)
+	}
+
+	c := &actionhelper.ActionExecutorConfig{
+		ActionCategory:    actions.ActionCategoryCommon,
+		ActionGroup:       iactions.ActionGroupRoleAssignment,
+		OperationCategory: actions.OperationCategoryCommon,
+		OperationGroup:    iactions.OperationGroupRoleAssignment,
+		StopAppIfError:    true,
+	}
+	e, err := actionhelper.NewActionExecutor(appSessionId, actionManager, c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[expiration.NewExpirationService] new action executor: %w", err)
+	}
+
+	return &ExpirationService{
+		appSessionId:          appSessionId,
+		tranManager:           tranManager,
+		actionExecutor:        e,
+		roleAssignmentManager: roleAssignmentManager,
+		config:                config,
+		logger:                l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *ExpirationService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the ExpirationService.
+func (s *ExpirationService) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[expiration.ExpirationService.Start] ExpirationService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[expiration.ExpirationService.Start] ExpirationService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.RoleAssignmentEvent, "[expiration.ExpirationService.Start] starting the ExpirationService...")
+
+	s.wg.Add(1)
+	go s.run()
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.RoleAssignmentEvent, "[expiration.ExpirationService.Start] ExpirationService has been started",
+		logging.NewField("interval", s.config.Interval),
+	)
+	return nil
+}
+
+// Stop stops the ExpirationService.
+func (s *ExpirationService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[expiration.ExpirationService.Stop] ExpirationService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.RoleAssignmentEvent, "[expiration.ExpirationService.Stop] stopping the ExpirationService...")
+	close(s.done)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.RoleAssignmentEvent, "[expiration.ExpirationService.Stop] ExpirationService has been stopped")
+	return nil
+}
+
+func (s *ExpirationService) run() {
+	defer s.wg.Done()
+
+	t := time.NewTicker(s.config.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			s.expireAll()
+		}
+	}
+}
+
+func (s *ExpirationService) expireAll() {
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.RoleAssignmentEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[expiration.ExpirationService.expireAll] panic: ", p.Value), p.StackTrace),
+			"[expiration.ExpirationService.expireAll] panic while deactivating role assignments",
+		)
+	})
+
+	ids, err := s.getAllExpiredIds()
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.RoleAssignmentEvent, err, "[expiration.ExpirationService.expireAll] get all expired role assignment ids")
+		return
+	}
+
+	for _, id := range ids {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		// each role assignment is deactivated in a separate action
+		s.expire(id)
+	}
+}
+
+func (s *ExpirationService) getAllExpiredIds() ([]uint64, error) {
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		return nil, fmt.Errorf("[expiration.ExpirationService.getAllExpiredIds] create and start a transaction: %w", err)
+	}
+
+	var ids []uint64
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeRoleAssignment_GetAllExpiredIds, uuid.NullUUID{}, true,
+		iactions.OperationTypeRoleAssignmentExpirationService_GetAllExpiredIds, uuid.NullUUID{}, nil,
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			var err error
+			if ids, err = s.roleAssignmentManager.GetAllExpiredIds(ctx, maxExpiredAssignmentsPerRun); err != nil {
+				return fmt.Errorf("[expiration.ExpirationService.getAllExpiredIds] get all expired role assignment ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[expiration.ExpirationService.getAllExpiredIds] execute an action: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *ExpirationService) expire(id uint64) {
+	t, err := s.tranManager.CreateAndStart()
+	if err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.RoleAssignmentEvent, err, "[expiration.ExpirationService.expire] create and start a transaction",
+			logging.NewField("id", id),
+		)
+		return
+	}
+
+	err = s.actionExecutor.ExecWithOperation(context.Background(), t, iactions.ActionTypeRoleAssignment_Expire, uuid.NullUUID{}, true,
+		iactions.OperationTypeRoleAssignmentExpirationService_Expire, uuid.NullUUID{}, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(ctx *actions.OperationContext) error {
+			ctx.UserId = nullable.NewNullable(s.config.UserId)
+
+			expired, err := s.roleAssignmentManager.Expire(ctx, id)
+			if err != nil {
+				return fmt.Errorf("[expiration.ExpirationService.expire] expire a role assignment: %w", err)
+			}
+
+			if expired {
+				s.logger.InfoWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent,
+					"[expiration.ExpirationService.expire] role assignment whose validity period has ended has been deactivated",
+					logging.NewField("id", id),
+				)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		s.logger.ErrorWithEvent(logginghelper.CreateLogEntryContext(s.appSessionId, t, nil, nil), events.RoleAssignmentEvent, err,
+			"[expiration.ExpirationService.expire] execute an action",
+			logging.NewField("id", id),
+		)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/roles/dbmodels"
+	"personal-website-v2/identity/src/internal/roles/models"
+	craoperations "personal-website-v2/identity/src/internal/roles/operations/clientroleassignments"
+	"personal-website-v2/pkg/actions"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// ClientRoleAssignmentManager is a client role assignment manager.
+type ClientRoleAssignmentManager struct {
+	opExecutor *actionhelper.OperationExecutor
+	craStore   roles.ClientRoleAssignmentStore
+	logger     logging.Logger[*context.LogEntryContext]
+}
+
+var _ roles.ClientRoleAssignmentManager = (*ClientRoleAssignmentManager)(nil)
+
+func NewClientRoleAssignmentManager(craStore roles.ClientRoleAssignmentStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*ClientRoleAssignmentManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.roles.manager.ClientRoleAssignmentManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewClientRoleAssignmentManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupClientRoleAssignment,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewClientRoleAssignmentManager] new operation executor: %w", err)
+	}
+
+	return &ClientRoleAssignmentManager{
+		opExecutor: e,
+		craStore:   craStore,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a client's role assignment and returns the client's role assignment ID if the operation is successful.
+func (m *ClientRoleAssignmentManager) Create(ctx *actions.OperationContext, data *craoperations.CreateOperationData) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			if exists, err := m.craStore.Exists(opCtx, data.ClientId, data.RoleId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.Create] client's role assignment exists: %w", err)
+			} else if exists {
+				return ierrors.ErrRoleAssignmentAlreadyExists
+			}
+
+			var err error
+			if id, err = m.craStore.Create(opCtx, data); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.Create] create a client's role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientRoleAssignmentEvent,
+				"[manager.ClientRoleAssignmentManager.Create] client's role assignment has been created",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ClientRoleAssignmentManager.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Delete deletes a client's role assignment by the specified client's role assignment ID.
+func (m *ClientRoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.craStore.Delete(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.Delete] delete a client's role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientRoleAssignmentEvent,
+				"[manager.ClientRoleAssignmentManager.Delete] client's role assignment has been deleted",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ClientRoleAssignmentManager.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// DeleteByRoleAssignmentId deletes a client's role assignment by the specified role assignment ID
+// and returns the ID of the client's deleted role assignment if the operation is successful.
+func (m *ClientRoleAssignmentManager) DeleteByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_DeleteByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.craStore.DeleteByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.DeleteByRoleAssignmentId] delete a client's role assignment by role assignment id: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.ClientRoleAssignmentEvent,
+				"[manager.ClientRoleAssignmentManager.DeleteByRoleAssignmentId] client's role assignment has been deleted",
+				logging.NewField("id", id),
+				logging.NewField("roleAssignmentId", roleAssignmentId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ClientRoleAssignmentManager.DeleteByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// FindById finds and returns a client's role assignment, if any, by the specified client's role assignment ID.
+func (m *ClientRoleAssignmentManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ClientRoleAssignment, error) {
+	var a *dbmodels.ClientRoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if a, err = m.craStore.FindById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.FindById] find a client's role assignment by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ClientRoleAssignmentManager.FindById] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// FindByRoleAssignmentId finds and returns a client's role assignment, if any, by the specified role assignment ID.
+func (m *ClientRoleAssignmentManager) FindByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*dbmodels.ClientRoleAssignment, error) {
+	var a *dbmodels.ClientRoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_FindByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if a, err = m.craStore.FindByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.FindByRoleAssignmentId] find a client's role assignment by role assignment id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ClientRoleAssignmentManager.FindByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// GetAllByClientId gets all client's role assignments by the specified client ID.
+func (m *ClientRoleAssignmentManager) GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ClientRoleAssignment, error) {
+	var as []*dbmodels.ClientRoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_GetAllByClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if as, err = m.craStore.GetAllByClientId(opCtx, clientId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.GetAllByClientId] get all client's role assignments by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ClientRoleAssignmentManager.GetAllByClientId] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+// Exists returns true if the client's role assignment exists.
+func (m *ClientRoleAssignmentManager) Exists(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error) {
+	var exists bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_Exists,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if exists, err = m.craStore.Exists(opCtx, clientId, roleId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.Exists] client's role assignment exists: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.ClientRoleAssignmentManager.Exists] execute an operation: %w", err)
+	}
+	return exists, nil
+}
+
+// IsAssigned returns true if the role is assigned to the client.
+func (m *ClientRoleAssignmentManager) IsAssigned(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error) {
+	var isAssigned bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_IsAssigned,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if isAssigned, err = m.craStore.IsAssigned(opCtx, clientId, roleId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.IsAssigned] is the role assigned to the client: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.ClientRoleAssignmentManager.IsAssigned] execute an operation: %w", err)
+	}
+	return isAssigned, nil
+}
+
+// GetIdByRoleAssignmentId gets the client's role assignment ID by the specified role assignment ID.
+func (m *ClientRoleAssignmentManager) GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_GetIdByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.craStore.GetIdByRoleAssignmentId(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.GetIdByRoleAssignmentId] get the client's role assignment id by role assignment id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ClientRoleAssignmentManager.GetIdByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// GetStatusById gets a client's role assignment status by the specified client's role assignment ID.
+func (m *ClientRoleAssignmentManager) GetStatusById(ctx *actions.OperationContext, id uint64) (models.ClientRoleAssignmentStatus, error) {
+	var s models.ClientRoleAssignmentStatus
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_GetStatusById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if s, err = m.craStore.GetStatusById(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.GetStatusById] get a client's role assignment status by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return s, fmt.Errorf("[manager.ClientRoleAssignmentManager.GetStatusById] execute an operation: %w", err)
+	}
+	return s, nil
+}
+
+// GetStatusByRoleAssignmentId gets a client's role assignment status by the specified role assignment ID.
+func (m *ClientRoleAssignmentManager) GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (models.ClientRoleAssignmentStatus, error) {
+	var s models.ClientRoleAssignmentStatus
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_GetStatusByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if s, err = m.craStore.GetStatusByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.GetStatusByRoleAssignmentId] get a client's role assignment status by role assignment id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return s, fmt.Errorf("[manager.ClientRoleAssignmentManager.GetStatusByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return s, nil
+}
+
+// GetClientRoleIdsByClientId gets the IDs of the roles assigned to the client by the specified client ID.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (m *ClientRoleAssignmentManager) GetClientRoleIdsByClientId(ctx *actions.OperationContext, clientId uint64, roleFilter []uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentManager_GetClientRoleIdsByClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleFilter", roleFilter)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.craStore.GetClientRoleIdsByClientId(opCtx, clientId, roleFilter); err != nil {
+				return fmt.Errorf("[manager.ClientRoleAssignmentManager.GetClientRoleIdsByClientId] get client's role ids by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ClientRoleAssignmentManager.GetClientRoleIdsByClientId] execute an operation: %w", err)
+	}
+	return ids, nil
+}
@@ -16,6 +16,7 @@ package manager
 
 import (
 	"fmt"
+	"time"
 
 	iactions "personal-website-v2/identity/src/internal/actions"
 	ierrors "personal-website-v2/identity/src/internal/errors"
@@ -146,6 +147,63 @@ func (m *GroupRoleAssignmentManager) DeleteByRoleAssignmentId(ctx *actions.Opera
 	return id, nil
 }
 
+// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a group role assignment
+// by the specified role assignment ID and returns the ID of the group role assignment if the operation is successful.
+func (m *GroupRoleAssignmentManager) UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentManager_UpdateValidUntilByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.graStore.UpdateValidUntilByRoleAssignmentId(opCtx, roleAssignmentId, validUntil); err != nil {
+				return fmt.Errorf("[manager.GroupRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] update the end of the validity period of a group role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.GroupRoleAssignmentEvent,
+				"[manager.GroupRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] end of the validity period of the group role assignment has been updated",
+				logging.NewField("id", id),
+				logging.NewField("roleAssignmentId", roleAssignmentId),
+				logging.NewField("validUntil", validUntil),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.GroupRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// DeactivateByRoleAssignmentId deactivates a group role assignment by the specified role assignment ID
+// and returns the ID of the deactivated role assignment of the group if the operation is successful.
+func (m *GroupRoleAssignmentManager) DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentManager_DeactivateByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.graStore.DeactivateByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[manager.GroupRoleAssignmentManager.DeactivateByRoleAssignmentId] deactivate a group role assignment by role assignment id: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.GroupRoleAssignmentEvent,
+				"[manager.GroupRoleAssignmentManager.DeactivateByRoleAssignmentId] group role assignment has been deactivated",
+				logging.NewField("id", id),
+				logging.NewField("roleAssignmentId", roleAssignmentId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.GroupRoleAssignmentManager.DeactivateByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
 // FindById finds and returns a group role assignment, if any, by the specified group role assignment ID.
 func (m *GroupRoleAssignmentManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.GroupRoleAssignment, error) {
 	var a *dbmodels.GroupRoleAssignment
@@ -316,3 +374,23 @@ func (m *GroupRoleAssignmentManager) GetGroupRoleIdsByGroup(ctx *actions.Operati
 	}
 	return ids, nil
 }
+
+// GetNextRoleChangeTimeByGroup gets the time of the next scheduled change of the group's roles by the specified group
+// (the nearest future start or end of the validity period of the active role assignments).
+// It returns nil if no change is scheduled.
+func (m *GroupRoleAssignmentManager) GetNextRoleChangeTimeByGroup(ctx *actions.OperationContext, group groupmodels.UserGroup) (*time.Time, error) {
+	var t *time.Time
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentManager_GetNextRoleChangeTimeByGroup, []*actions.OperationParam{actions.NewOperationParam("group", group)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if t, err = m.graStore.GetNextRoleChangeTimeByGroup(opCtx, group); err != nil {
+				return fmt.Errorf("[manager.GroupRoleAssignmentManager.GetNextRoleChangeTimeByGroup] get the time of the next change of the group's roles by group: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.GroupRoleAssignmentManager.GetNextRoleChangeTimeByGroup] execute an operation: %w", err)
+	}
+	return t, nil
+}
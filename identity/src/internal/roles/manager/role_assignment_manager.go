@@ -16,18 +16,23 @@ package manager
 
 import (
 	"fmt"
+	"time"
 
 	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
 	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/groups"
 	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/roles"
 	"personal-website-v2/identity/src/internal/roles/dbmodels"
 	"personal-website-v2/identity/src/internal/roles/models"
 	assignmentoperations "personal-website-v2/identity/src/internal/roles/operations/assignments"
+	craoperations "personal-website-v2/identity/src/internal/roles/operations/clientroleassignments"
 	graoperations "personal-website-v2/identity/src/internal/roles/operations/grouproleassignments"
 	uraoperations "personal-website-v2/identity/src/internal/roles/operations/userroleassignments"
 	"personal-website-v2/pkg/actions"
+	errs "personal-website-v2/pkg/errors"
 	actionhelper "personal-website-v2/pkg/helper/actions"
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/context"
@@ -35,12 +40,15 @@ import (
 
 // RoleAssignmentManager is a role assignment manager.
 type RoleAssignmentManager struct {
-	opExecutor          *actionhelper.OperationExecutor
-	rolesState          roles.RolesState
-	uraManager          roles.UserRoleAssignmentManager
-	graManager          roles.GroupRoleAssignmentManager
-	roleAssignmentStore roles.RoleAssignmentStore
-	logger              logging.Logger[*context.LogEntryContext]
+	opExecutor            *actionhelper.OperationExecutor
+	rolesState            roles.RolesState
+	uraManager            roles.UserRoleAssignmentManager
+	graManager            roles.GroupRoleAssignmentManager
+	craManager            roles.ClientRoleAssignmentManager
+	userGroupManager      groups.UserGroupManager
+	roleAssignmentStore   roles.RoleAssignmentStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
 }
 
 var _ roles.RoleAssignmentManager = (*RoleAssignmentManager)(nil)
@@ -49,7 +57,10 @@ func NewRoleAssignmentManager(
 	rolesState roles.RolesState,
 	uraManager roles.UserRoleAssignmentManager,
 	graManager roles.GroupRoleAssignmentManager,
+	craManager roles.ClientRoleAssignmentManager,
+	userGroupManager groups.UserGroupManager,
 	roleAssignmentStore roles.RoleAssignmentStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
 	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
 ) (*RoleAssignmentManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.roles.manager.RoleAssignmentManager")
@@ -69,12 +80,15 @@ func NewRoleAssignmentManager(
 	}
 
 	return &RoleAssignmentManager{
-		opExecutor:          e,
-		rolesState:          rolesState,
-		uraManager:          uraManager,
-		graManager:          graManager,
-		roleAssignmentStore: roleAssignmentStore,
-		logger:              l,
+		opExecutor:            e,
+		rolesState:            rolesState,
+		uraManager:            uraManager,
+		graManager:            graManager,
+		craManager:            craManager,
+		userGroupManager:      userGroupManager,
+		roleAssignmentStore:   roleAssignmentStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
 	}, nil
 }
 
@@ -86,10 +100,18 @@ func (m *RoleAssignmentManager) Create(ctx *actions.OperationContext, data *assi
 			if err := data.Validate(); err != nil {
 				return fmt.Errorf("[manager.RoleAssignmentManager.Create] validate data: %w", err)
 			}
-			if data.AssigneeType != models.AssigneeTypeUser && data.AssigneeType != models.AssigneeTypeGroup {
+			if !data.AssigneeType.IsValid() {
 				return fmt.Errorf("[manager.RoleAssignmentManager.Create] '%s' assignee type isn't supported", data.AssigneeType)
 			}
 
+			if data.AssigneeType == models.AssigneeTypeGroup {
+				if exists, err := m.userGroupManager.ExistsById(opCtx, groupmodels.UserGroup(data.AssignedTo)); err != nil {
+					return fmt.Errorf("[manager.RoleAssignmentManager.Create] user group exists: %w", err)
+				} else if !exists {
+					return ierrors.ErrUserGroupNotFound
+				}
+			}
+
 			if exists, err := m.roleAssignmentStore.Exists(opCtx, data.RoleId, data.AssignedTo, data.AssigneeType); err != nil {
 				return fmt.Errorf("[manager.RoleAssignmentManager.Create] role assignment exists: %w", err)
 			} else if exists {
@@ -134,12 +156,19 @@ func (m *RoleAssignmentManager) Create(ctx *actions.OperationContext, data *assi
 				}
 			}()
 
-			if data.AssigneeType == models.AssigneeTypeUser {
-				if err = m.createUserRoleAssignment(opCtx, id, data.RoleId, data.AssignedTo); err != nil {
+			switch data.AssigneeType {
+			case models.AssigneeTypeUser:
+				if err = m.createUserRoleAssignment(opCtx, id, data); err != nil {
 					return fmt.Errorf("[manager.RoleAssignmentManager.Create] create a user's role assignment: %w", err)
 				}
-			} else if err = m.createGroupRoleAssignment(opCtx, id, data.RoleId, groupmodels.UserGroup(data.AssignedTo)); err != nil {
-				return fmt.Errorf("[manager.RoleAssignmentManager.Create] create a group role assignment: %w", err)
+			case models.AssigneeTypeGroup:
+				if err = m.createGroupRoleAssignment(opCtx, id, data); err != nil {
+					return fmt.Errorf("[manager.RoleAssignmentManager.Create] create a group role assignment: %w", err)
+				}
+			case models.AssigneeTypeClient:
+				if err = m.createClientRoleAssignment(opCtx, id, data.RoleId, data.AssignedTo); err != nil {
+					return fmt.Errorf("[manager.RoleAssignmentManager.Create] create a client's role assignment: %w", err)
+				}
 			}
 
 			succeeded = true
@@ -152,11 +181,14 @@ func (m *RoleAssignmentManager) Create(ctx *actions.OperationContext, data *assi
 	return id, nil
 }
 
-func (m *RoleAssignmentManager) createUserRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, roleId, userId uint64) error {
+func (m *RoleAssignmentManager) createUserRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64, data *assignmentoperations.CreateOperationData) error {
+	userId := data.AssignedTo
 	d := &uraoperations.CreateOperationData{
 		RoleAssignmentId: roleAssignmentId,
 		UserId:           userId,
-		RoleId:           roleId,
+		RoleId:           data.RoleId,
+		ValidFrom:        data.ValidFrom,
+		ValidUntil:       data.ValidUntil,
 	}
 
 	id, err := m.uraManager.Create(ctx, d)
@@ -167,6 +199,13 @@ func (m *RoleAssignmentManager) createUserRoleAssignment(ctx *actions.OperationC
 		return fmt.Errorf("%s: %v", msg, err)
 	}
 
+	if err = m.authzCacheInvalidator.InvalidateUser(ctx, userId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.createUserRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
 	m.logger.InfoWithEvent(
 		ctx.CreateLogEntryContext(),
 		events.RoleAssignmentEvent,
@@ -177,11 +216,14 @@ func (m *RoleAssignmentManager) createUserRoleAssignment(ctx *actions.OperationC
 	return nil
 }
 
-func (m *RoleAssignmentManager) createGroupRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, roleId uint64, group groupmodels.UserGroup) error {
+func (m *RoleAssignmentManager) createGroupRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64, data *assignmentoperations.CreateOperationData) error {
+	group := groupmodels.UserGroup(data.AssignedTo)
 	d := &graoperations.CreateOperationData{
 		RoleAssignmentId: roleAssignmentId,
 		Group:            group,
-		RoleId:           roleId,
+		RoleId:           data.RoleId,
+		ValidFrom:        data.ValidFrom,
+		ValidUntil:       data.ValidUntil,
 	}
 
 	id, err := m.graManager.Create(ctx, d)
@@ -192,6 +234,13 @@ func (m *RoleAssignmentManager) createGroupRoleAssignment(ctx *actions.Operation
 		return fmt.Errorf("%s: %v", msg, err)
 	}
 
+	if err = m.authzCacheInvalidator.InvalidateGroup(ctx, group); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.createGroupRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
 	m.logger.InfoWithEvent(
 		ctx.CreateLogEntryContext(),
 		events.RoleAssignmentEvent,
@@ -202,6 +251,38 @@ func (m *RoleAssignmentManager) createGroupRoleAssignment(ctx *actions.Operation
 	return nil
 }
 
+func (m *RoleAssignmentManager) createClientRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, roleId, clientId uint64) error {
+	d := &craoperations.CreateOperationData{
+		RoleAssignmentId: roleAssignmentId,
+		ClientId:         clientId,
+		RoleId:           roleId,
+	}
+
+	id, err := m.craManager.Create(ctx, d)
+	if err != nil {
+		msg := "[manager.RoleAssignmentManager.createClientRoleAssignment] create a client's role assignment"
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", roleAssignmentId))
+		// internal error
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	if err = m.authzCacheInvalidator.InvalidateClient(ctx, clientId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.createClientRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.RoleAssignmentEvent,
+		"[manager.RoleAssignmentManager.createClientRoleAssignment] client's role assignment has been created",
+		logging.NewField("id", id),
+		logging.NewField("roleAssignmentId", roleAssignmentId),
+	)
+	return nil
+}
+
 // Delete deletes a role assignment by the specified role assignment ID.
 func (m *RoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64) error {
 	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
@@ -211,7 +292,7 @@ func (m *RoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64)
 				return fmt.Errorf("[manager.RoleAssignmentManager.Delete] get the role id and assignee by id: %w", err)
 			}
 
-			if r.AssigneeType != models.AssigneeTypeUser && r.AssigneeType != models.AssigneeTypeGroup {
+			if !r.AssigneeType.IsValid() {
 				return fmt.Errorf("[manager.RoleAssignmentManager.Delete] '%s' assignee type of the role assignment isn't supported", r.AssigneeType)
 			}
 
@@ -220,12 +301,19 @@ func (m *RoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64)
 				return fmt.Errorf("[manager.RoleAssignmentManager.Delete] start deleting a role assignment: %w", err)
 			}
 
-			if r.AssigneeType == models.AssigneeTypeUser {
-				if err = m.deleteUserRoleAssignment(opCtx, id); err != nil {
+			switch r.AssigneeType {
+			case models.AssigneeTypeUser:
+				if err = m.deleteUserRoleAssignment(opCtx, id, r.AssignedTo); err != nil {
 					return fmt.Errorf("[manager.RoleAssignmentManager.Delete] delete a user's role assignment: %w", err)
 				}
-			} else if err = m.deleteGroupRoleAssignment(opCtx, id); err != nil {
-				return fmt.Errorf("[manager.RoleAssignmentManager.Delete] delete a group role assignment: %w", err)
+			case models.AssigneeTypeGroup:
+				if err = m.deleteGroupRoleAssignment(opCtx, id, groupmodels.UserGroup(r.AssignedTo)); err != nil {
+					return fmt.Errorf("[manager.RoleAssignmentManager.Delete] delete a group role assignment: %w", err)
+				}
+			case models.AssigneeTypeClient:
+				if err = m.deleteClientRoleAssignment(opCtx, id, r.AssignedTo); err != nil {
+					return fmt.Errorf("[manager.RoleAssignmentManager.Delete] delete a client's role assignment: %w", err)
+				}
 			}
 
 			leCtx := opCtx.CreateLogEntryContext()
@@ -272,7 +360,7 @@ func (m *RoleAssignmentManager) Delete(ctx *actions.OperationContext, id uint64)
 	return nil
 }
 
-func (m *RoleAssignmentManager) deleteUserRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64) error {
+func (m *RoleAssignmentManager) deleteUserRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, userId uint64) error {
 	id, err := m.uraManager.DeleteByRoleAssignmentId(ctx, roleAssignmentId)
 	if err != nil {
 		msg := "[manager.RoleAssignmentManager.deleteUserRoleAssignment] delete a user's role assignment by the role assignment id"
@@ -281,6 +369,13 @@ func (m *RoleAssignmentManager) deleteUserRoleAssignment(ctx *actions.OperationC
 		return fmt.Errorf("%s: %v", msg, err)
 	}
 
+	if err = m.authzCacheInvalidator.InvalidateUser(ctx, userId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deleteUserRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
 	m.logger.InfoWithEvent(
 		ctx.CreateLogEntryContext(),
 		events.RoleAssignmentEvent,
@@ -291,7 +386,7 @@ func (m *RoleAssignmentManager) deleteUserRoleAssignment(ctx *actions.OperationC
 	return nil
 }
 
-func (m *RoleAssignmentManager) deleteGroupRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64) error {
+func (m *RoleAssignmentManager) deleteGroupRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64, group groupmodels.UserGroup) error {
 	id, err := m.graManager.DeleteByRoleAssignmentId(ctx, roleAssignmentId)
 	if err != nil {
 		msg := "[manager.RoleAssignmentManager.deleteGroupRoleAssignment] delete a group role assignment by the role assignment id"
@@ -300,6 +395,13 @@ func (m *RoleAssignmentManager) deleteGroupRoleAssignment(ctx *actions.Operation
 		return fmt.Errorf("%s: %v", msg, err)
 	}
 
+	if err = m.authzCacheInvalidator.InvalidateGroup(ctx, group); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deleteGroupRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
 	m.logger.InfoWithEvent(
 		ctx.CreateLogEntryContext(),
 		events.RoleAssignmentEvent,
@@ -310,6 +412,251 @@ func (m *RoleAssignmentManager) deleteGroupRoleAssignment(ctx *actions.Operation
 	return nil
 }
 
+func (m *RoleAssignmentManager) deleteClientRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, clientId uint64) error {
+	id, err := m.craManager.DeleteByRoleAssignmentId(ctx, roleAssignmentId)
+	if err != nil {
+		msg := "[manager.RoleAssignmentManager.deleteClientRoleAssignment] delete a client's role assignment by the role assignment id"
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", roleAssignmentId))
+		// internal error
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	if err = m.authzCacheInvalidator.InvalidateClient(ctx, clientId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deleteClientRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.RoleAssignmentEvent,
+		"[manager.RoleAssignmentManager.deleteClientRoleAssignment] client's role assignment has been deleted",
+		logging.NewField("id", id),
+		logging.NewField("roleAssignmentId", roleAssignmentId),
+	)
+	return nil
+}
+
+// Extend extends the validity period of an active role assignment by the specified role assignment ID.
+func (m *RoleAssignmentManager) Extend(ctx *actions.OperationContext, id uint64, validUntil time.Time) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_Extend,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			if !validUntil.After(time.Now()) {
+				return errs.NewError(errs.ErrorCodeInvalidData, "end of the validity period must be in the future")
+			}
+
+			r, err := m.roleAssignmentStore.GetRoleIdAndAssigneeById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Extend] get the role id and assignee by id: %w", err)
+			}
+
+			if !r.AssigneeType.IsValid() {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Extend] '%s' assignee type of the role assignment isn't supported", r.AssigneeType)
+			}
+			if r.AssigneeType == models.AssigneeTypeClient {
+				return errs.NewError(errs.ErrorCodeInvalidOperation, "validity period isn't supported for clients")
+			}
+
+			if err = m.roleAssignmentStore.Extend(opCtx, id, validUntil); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Extend] extend a role assignment: %w", err)
+			}
+
+			var aid uint64
+			switch r.AssigneeType {
+			case models.AssigneeTypeUser:
+				if aid, err = m.uraManager.UpdateValidUntilByRoleAssignmentId(opCtx, id, validUntil); err != nil {
+					msg := "[manager.RoleAssignmentManager.Extend] update the end of the validity period of a user's role assignment"
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", id))
+					// internal error
+					return fmt.Errorf("%s: %v", msg, err)
+				}
+			case models.AssigneeTypeGroup:
+				if aid, err = m.graManager.UpdateValidUntilByRoleAssignmentId(opCtx, id, validUntil); err != nil {
+					msg := "[manager.RoleAssignmentManager.Extend] update the end of the validity period of a group role assignment"
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", id))
+					// internal error
+					return fmt.Errorf("%s: %v", msg, err)
+				}
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RoleAssignmentEvent,
+				"[manager.RoleAssignmentManager.Extend] role assignment has been extended",
+				logging.NewField("id", id),
+				logging.NewField("assigneeRoleAssignmentId", aid),
+				logging.NewField("validUntil", validUntil),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RoleAssignmentManager.Extend] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Revoke revokes an active role assignment by the specified role assignment ID
+// before its validity period ends.
+func (m *RoleAssignmentManager) Revoke(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_Revoke, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.roleAssignmentStore.GetRoleIdAndAssigneeById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Revoke] get the role id and assignee by id: %w", err)
+			}
+
+			if !r.AssigneeType.IsValid() {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Revoke] '%s' assignee type of the role assignment isn't supported", r.AssigneeType)
+			}
+			if r.AssigneeType == models.AssigneeTypeClient {
+				return errs.NewError(errs.ErrorCodeInvalidOperation, "client's role assignments can't be revoked")
+			}
+
+			if err = m.roleAssignmentStore.Revoke(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Revoke] revoke a role assignment: %w", err)
+			}
+
+			if err = m.deactivateAssigneeRoleAssignment(opCtx, id, r); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Revoke] deactivate the assignee's role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RoleAssignmentEvent,
+				"[manager.RoleAssignmentManager.Revoke] role assignment has been revoked",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RoleAssignmentManager.Revoke] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Expire deactivates an active role assignment by the specified role assignment ID
+// if its validity period has ended and returns true if the role assignment has been deactivated.
+func (m *RoleAssignmentManager) Expire(ctx *actions.OperationContext, id uint64) (bool, error) {
+	var expired bool
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_Expire, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.roleAssignmentStore.GetRoleIdAndAssigneeById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Expire] get the role id and assignee by id: %w", err)
+			}
+
+			if !r.AssigneeType.IsValid() {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Expire] '%s' assignee type of the role assignment isn't supported", r.AssigneeType)
+			}
+
+			if expired, err = m.roleAssignmentStore.Expire(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Expire] expire a role assignment: %w", err)
+			}
+			if !expired {
+				return nil
+			}
+
+			if err = m.deactivateAssigneeRoleAssignment(opCtx, id, r); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.Expire] deactivate the assignee's role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RoleAssignmentEvent,
+				"[manager.RoleAssignmentManager.Expire] role assignment has expired",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.RoleAssignmentManager.Expire] execute an operation: %w", err)
+	}
+	return expired, nil
+}
+
+func (m *RoleAssignmentManager) deactivateAssigneeRoleAssignment(
+	ctx *actions.OperationContext,
+	roleAssignmentId uint64,
+	r *assignmentoperations.GetRoleIdAndAssigneeOperationResult,
+) error {
+	var err error
+	switch r.AssigneeType {
+	case models.AssigneeTypeUser:
+		if err = m.deactivateUserRoleAssignment(ctx, roleAssignmentId, r.AssignedTo); err != nil {
+			return fmt.Errorf("[manager.RoleAssignmentManager.deactivateAssigneeRoleAssignment] deactivate a user's role assignment: %w", err)
+		}
+	case models.AssigneeTypeGroup:
+		if err = m.deactivateGroupRoleAssignment(ctx, roleAssignmentId, groupmodels.UserGroup(r.AssignedTo)); err != nil {
+			return fmt.Errorf("[manager.RoleAssignmentManager.deactivateAssigneeRoleAssignment] deactivate a group role assignment: %w", err)
+		}
+	}
+
+	if err = m.rolesState.DecrActiveAssignments(ctx, r.RoleId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deactivateAssigneeRoleAssignment] decrement active assignments of the role",
+			logging.NewField("roleId", r.RoleId),
+		)
+	}
+	return nil
+}
+
+func (m *RoleAssignmentManager) deactivateUserRoleAssignment(ctx *actions.OperationContext, roleAssignmentId, userId uint64) error {
+	id, err := m.uraManager.DeactivateByRoleAssignmentId(ctx, roleAssignmentId)
+	if err != nil {
+		msg := "[manager.RoleAssignmentManager.deactivateUserRoleAssignment] deactivate a user's role assignment by the role assignment id"
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", roleAssignmentId))
+		// internal error
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	if err = m.authzCacheInvalidator.InvalidateUser(ctx, userId); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deactivateUserRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.RoleAssignmentEvent,
+		"[manager.RoleAssignmentManager.deactivateUserRoleAssignment] user's role assignment has been deactivated",
+		logging.NewField("id", id),
+		logging.NewField("roleAssignmentId", roleAssignmentId),
+	)
+	return nil
+}
+
+func (m *RoleAssignmentManager) deactivateGroupRoleAssignment(ctx *actions.OperationContext, roleAssignmentId uint64, group groupmodels.UserGroup) error {
+	id, err := m.graManager.DeactivateByRoleAssignmentId(ctx, roleAssignmentId)
+	if err != nil {
+		msg := "[manager.RoleAssignmentManager.deactivateGroupRoleAssignment] deactivate a group role assignment by the role assignment id"
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err, msg, logging.NewField("roleAssignmentId", roleAssignmentId))
+		// internal error
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	if err = m.authzCacheInvalidator.InvalidateGroup(ctx, group); err != nil {
+		m.logger.ErrorWithEvent(ctx.CreateLogEntryContext(), events.RoleAssignmentEvent, err,
+			"[manager.RoleAssignmentManager.deactivateGroupRoleAssignment] invalidate the authorization cache",
+			logging.NewField("roleAssignmentId", roleAssignmentId),
+		)
+	}
+
+	m.logger.InfoWithEvent(
+		ctx.CreateLogEntryContext(),
+		events.RoleAssignmentEvent,
+		"[manager.RoleAssignmentManager.deactivateGroupRoleAssignment] group role assignment has been deactivated",
+		logging.NewField("id", id),
+		logging.NewField("roleAssignmentId", roleAssignmentId),
+	)
+	return nil
+}
+
 // FindById finds and returns a role assignment, if any, by the specified role assignment ID.
 func (m *RoleAssignmentManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.RoleAssignment, error) {
 	var a *dbmodels.RoleAssignment
@@ -438,3 +785,68 @@ func (m *RoleAssignmentManager) GetRoleIdAndAssigneeById(ctx *actions.OperationC
 	}
 	return r, nil
 }
+
+// GetAllExpiredIds gets the IDs of up to limit active role assignments whose validity period has ended.
+func (m *RoleAssignmentManager) GetAllExpiredIds(ctx *actions.OperationContext, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_GetAllExpiredIds,
+		[]*actions.OperationParam{actions.NewOperationParam("limit", limit)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.roleAssignmentStore.GetAllExpiredIds(opCtx, limit); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.GetAllExpiredIds] get all expired role assignment ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleAssignmentManager.GetAllExpiredIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllExpiringWithin gets all active role assignments whose validity period ends within the specified period.
+func (m *RoleAssignmentManager) GetAllExpiringWithin(ctx *actions.OperationContext, period time.Duration) ([]*dbmodels.RoleAssignment, error) {
+	var as []*dbmodels.RoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_GetAllExpiringWithin,
+		[]*actions.OperationParam{actions.NewOperationParam("period", period)},
+		func(opCtx *actions.OperationContext) error {
+			if period <= 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "period must be greater than 0")
+			}
+
+			var err error
+			if as, err = m.roleAssignmentStore.GetAllExpiringBefore(opCtx, time.Now().Add(period)); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.GetAllExpiringWithin] get all role assignments expiring before the specified time: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleAssignmentManager.GetAllExpiringWithin] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+// GetAllActiveByRoleIds gets all active role assignments of any of the specified roles.
+func (m *RoleAssignmentManager) GetAllActiveByRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]*dbmodels.RoleAssignment, error) {
+	var as []*dbmodels.RoleAssignment
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentManager_GetAllActiveByRoleIds,
+		[]*actions.OperationParam{actions.NewOperationParam("roleIds", roleIds)},
+		func(opCtx *actions.OperationContext) error {
+			if len(roleIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of role ids is 0")
+			}
+
+			var err error
+			if as, err = m.roleAssignmentStore.GetAllActiveByRoleIds(opCtx, roleIds); err != nil {
+				return fmt.Errorf("[manager.RoleAssignmentManager.GetAllActiveByRoleIds] get all active role assignments by role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleAssignmentManager.GetAllActiveByRoleIds] execute an operation: %w", err)
+	}
+	return as, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// RoleInheritanceManager is a role inheritance manager.
+type RoleInheritanceManager struct {
+	opExecutor            *actionhelper.OperationExecutor
+	roleInheritanceStore  roles.RoleInheritanceStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
+}
+
+var _ roles.RoleInheritanceManager = (*RoleInheritanceManager)(nil)
+
+func NewRoleInheritanceManager(
+	roleInheritanceStore roles.RoleInheritanceStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*RoleInheritanceManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.roles.manager.RoleInheritanceManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewRoleInheritanceManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupRoleInheritance,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewRoleInheritanceManager] new operation executor: %w", err)
+	}
+
+	return &RoleInheritanceManager{
+		opExecutor:            e,
+		roleInheritanceStore:  roleInheritanceStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
+	}, nil
+}
+
+// AddParent adds a parent role to the role.
+func (m *RoleInheritanceManager) AddParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceManager_AddParent,
+		[]*actions.OperationParam{actions.NewOperationParam("roleId", roleId), actions.NewOperationParam("parentRoleId", parentRoleId)},
+		func(opCtx *actions.OperationContext) error {
+			if roleId == parentRoleId {
+				return errors.NewError(errors.ErrorCodeInvalidData, "role can't inherit from itself")
+			}
+
+			if err := m.roleInheritanceStore.AddParent(opCtx, roleId, parentRoleId); err != nil {
+				return fmt.Errorf("[manager.RoleInheritanceManager.AddParent] add a parent role to the role: %w", err)
+			}
+
+			// the cached roles of all permissions of the parent role and its ancestors are affected
+			if err := m.authzCacheInvalidator.InvalidateAllPermissions(opCtx); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RoleInheritanceEvent, err,
+					"[manager.RoleInheritanceManager.AddParent] invalidate the authorization cache",
+				)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RoleInheritanceEvent,
+				"[manager.RoleInheritanceManager.AddParent] parent role has been added to the role",
+				logging.NewField("roleId", roleId),
+				logging.NewField("parentRoleId", parentRoleId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RoleInheritanceManager.AddParent] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// RemoveParent removes a parent role from the role.
+func (m *RoleInheritanceManager) RemoveParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceManager_RemoveParent,
+		[]*actions.OperationParam{actions.NewOperationParam("roleId", roleId), actions.NewOperationParam("parentRoleId", parentRoleId)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.roleInheritanceStore.RemoveParent(opCtx, roleId, parentRoleId); err != nil {
+				return fmt.Errorf("[manager.RoleInheritanceManager.RemoveParent] remove a parent role from the role: %w", err)
+			}
+
+			if err := m.authzCacheInvalidator.InvalidateAllPermissions(opCtx); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.RoleInheritanceEvent, err,
+					"[manager.RoleInheritanceManager.RemoveParent] invalidate the authorization cache",
+				)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.RoleInheritanceEvent,
+				"[manager.RoleInheritanceManager.RemoveParent] parent role has been removed from the role",
+				logging.NewField("roleId", roleId),
+				logging.NewField("parentRoleId", parentRoleId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.RoleInheritanceManager.RemoveParent] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// GetParentRoleIds gets the IDs of the parent roles of the role by the specified role ID.
+func (m *RoleInheritanceManager) GetParentRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceManager_GetParentRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.roleInheritanceStore.GetParentRoleIds(opCtx, roleId); err != nil {
+				return fmt.Errorf("[manager.RoleInheritanceManager.GetParentRoleIds] get parent role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleInheritanceManager.GetParentRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllAncestorRoleIds gets the IDs of all roles from which the role inherits (directly or indirectly)
+// by the specified role ID.
+func (m *RoleInheritanceManager) GetAllAncestorRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceManager_GetAllAncestorRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if ids, err = m.roleInheritanceStore.GetAllAncestorRoleIds(opCtx, roleId); err != nil {
+				return fmt.Errorf("[manager.RoleInheritanceManager.GetAllAncestorRoleIds] get all ancestor role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleInheritanceManager.GetAllAncestorRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllDescendantRoleIds gets the IDs of all roles that inherit (directly or indirectly)
+// from any of the specified roles.
+func (m *RoleInheritanceManager) GetAllDescendantRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceManager_GetAllDescendantRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleIds", roleIds)},
+		func(opCtx *actions.OperationContext) error {
+			if len(roleIds) == 0 {
+				return errors.NewError(errors.ErrorCodeInvalidData, "number of role ids is 0")
+			}
+
+			var err error
+			if ids, err = m.roleInheritanceStore.GetAllDescendantRoleIds(opCtx, roleIds); err != nil {
+				return fmt.Errorf("[manager.RoleInheritanceManager.GetAllDescendantRoleIds] get all descendant role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.RoleInheritanceManager.GetAllDescendantRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
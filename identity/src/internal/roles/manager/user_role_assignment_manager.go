@@ -16,6 +16,7 @@ package manager
 
 import (
 	"fmt"
+	"time"
 
 	iactions "personal-website-v2/identity/src/internal/actions"
 	ierrors "personal-website-v2/identity/src/internal/errors"
@@ -145,6 +146,63 @@ func (m *UserRoleAssignmentManager) DeleteByRoleAssignmentId(ctx *actions.Operat
 	return id, nil
 }
 
+// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a user's role assignment
+// by the specified role assignment ID and returns the ID of the user's role assignment if the operation is successful.
+func (m *UserRoleAssignmentManager) UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentManager_UpdateValidUntilByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.uraStore.UpdateValidUntilByRoleAssignmentId(opCtx, roleAssignmentId, validUntil); err != nil {
+				return fmt.Errorf("[manager.UserRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] update the end of the validity period of a user's role assignment: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserRoleAssignmentEvent,
+				"[manager.UserRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] end of the validity period of the user's role assignment has been updated",
+				logging.NewField("id", id),
+				logging.NewField("roleAssignmentId", roleAssignmentId),
+				logging.NewField("validUntil", validUntil),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.UserRoleAssignmentManager.UpdateValidUntilByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// DeactivateByRoleAssignmentId deactivates a user's role assignment by the specified role assignment ID
+// and returns the ID of the user's deactivated role assignment if the operation is successful.
+func (m *UserRoleAssignmentManager) DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentManager_DeactivateByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = m.uraStore.DeactivateByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[manager.UserRoleAssignmentManager.DeactivateByRoleAssignmentId] deactivate a user's role assignment by role assignment id: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserRoleAssignmentEvent,
+				"[manager.UserRoleAssignmentManager.DeactivateByRoleAssignmentId] user's role assignment has been deactivated",
+				logging.NewField("id", id),
+				logging.NewField("roleAssignmentId", roleAssignmentId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.UserRoleAssignmentManager.DeactivateByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
 // FindById finds and returns a user's role assignment, if any, by the specified user's role assignment ID.
 func (m *UserRoleAssignmentManager) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.UserRoleAssignment, error) {
 	var a *dbmodels.UserRoleAssignment
@@ -315,3 +373,23 @@ func (m *UserRoleAssignmentManager) GetUserRoleIdsByUserId(ctx *actions.Operatio
 	}
 	return ids, nil
 }
+
+// GetNextRoleChangeTimeByUserId gets the time of the next scheduled change of the user's roles by the specified user ID
+// (the nearest future start or end of the validity period of the active role assignments).
+// It returns nil if no change is scheduled.
+func (m *UserRoleAssignmentManager) GetNextRoleChangeTimeByUserId(ctx *actions.OperationContext, userId uint64) (*time.Time, error) {
+	var t *time.Time
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentManager_GetNextRoleChangeTimeByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if t, err = m.uraStore.GetNextRoleChangeTimeByUserId(opCtx, userId); err != nil {
+				return fmt.Errorf("[manager.UserRoleAssignmentManager.GetNextRoleChangeTimeByUserId] get the time of the next change of the user's roles by user id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.UserRoleAssignmentManager.GetNextRoleChangeTimeByUserId] execute an operation: %w", err)
+	}
+	return t, nil
+}
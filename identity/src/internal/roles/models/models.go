@@ -53,6 +53,10 @@ const (
 	RoleStatusDeleted  RoleStatus = 5
 )
 
+// MaxInheritanceDepth is the maximum depth of the role hierarchy
+// (the maximum number of inheritance links in a chain of roles).
+const MaxInheritanceDepth = 8
+
 // The type of the object to which a role is assigned.
 type AssigneeType uint8
 
@@ -61,10 +65,13 @@ const (
 
 	AssigneeTypeUser  AssigneeType = 1
 	AssigneeTypeGroup AssigneeType = 2
+
+	// The service client.
+	AssigneeTypeClient AssigneeType = 3
 )
 
 func (t AssigneeType) IsValid() bool {
-	return t == AssigneeTypeUser || t == AssigneeTypeGroup
+	return t == AssigneeTypeUser || t == AssigneeTypeGroup || t == AssigneeTypeClient
 }
 
 func (t AssigneeType) String() string {
@@ -73,6 +80,8 @@ func (t AssigneeType) String() string {
 		return "user"
 	case AssigneeTypeGroup:
 		return "group"
+	case AssigneeTypeClient:
+		return "client"
 	}
 	return fmt.Sprintf("AssigneeType(%d)", t)
 }
@@ -115,3 +124,16 @@ const (
 	GroupRoleAssignmentStatusDeleting GroupRoleAssignmentStatus = 4
 	GroupRoleAssignmentStatusDeleted  GroupRoleAssignmentStatus = 5
 )
+
+// The client's role assignment status.
+type ClientRoleAssignmentStatus uint8
+
+const (
+	// Unspecified = 0 // Do not use.
+
+	ClientRoleAssignmentStatusNew      ClientRoleAssignmentStatus = 1
+	ClientRoleAssignmentStatusActive   ClientRoleAssignmentStatus = 2
+	ClientRoleAssignmentStatusInactive ClientRoleAssignmentStatus = 3
+	ClientRoleAssignmentStatusDeleting ClientRoleAssignmentStatus = 4
+	ClientRoleAssignmentStatusDeleted  ClientRoleAssignmentStatus = 5
+)
@@ -15,6 +15,9 @@
 package assignments
 
 import (
+	"time"
+
+	clientmodels "personal-website-v2/identity/src/internal/clients/models"
 	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	"personal-website-v2/identity/src/internal/roles/models"
 	"personal-website-v2/pkg/base/nullable"
@@ -25,8 +28,8 @@ type CreateOperationData struct {
 	// The role ID.
 	RoleId uint64 `json:"roleId"`
 
-	// The unique ID of the entity the role is assigned to - either the userId of a user
-	// or the groupId of a group.
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
 	AssignedTo uint64 `json:"assignedTo"`
 
 	// The type of the assignee.
@@ -34,11 +37,38 @@ type CreateOperationData struct {
 
 	// The role assignment description.
 	Description nullable.Nullable[string] `json:"description"`
+
+	// Optional. The date and time from which the role assignment is valid.
+	ValidFrom nullable.Nullable[time.Time] `json:"validFrom"`
+
+	// Optional. The date and time until which the role assignment is valid.
+	ValidUntil nullable.Nullable[time.Time] `json:"validUntil"`
 }
 
 func (d *CreateOperationData) Validate() *errors.Error {
-	if d.AssigneeType == models.AssigneeTypeGroup && !groupmodels.UserGroup(d.AssignedTo).IsValid() {
-		return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee id")
+	if d.ValidUntil.HasValue {
+		if d.ValidFrom.HasValue && !d.ValidUntil.Value.After(d.ValidFrom.Value) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "validUntil must be later than validFrom")
+		}
+		if !d.ValidUntil.Value.After(time.Now()) {
+			return errors.NewError(errors.ErrorCodeInvalidData, "validUntil must be in the future")
+		}
+	}
+
+	switch d.AssigneeType {
+	case models.AssigneeTypeGroup:
+		if !groupmodels.UserGroup(d.AssignedTo).IsValid() {
+			return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee id")
+		}
+	case models.AssigneeTypeClient:
+		// only service clients can be assignees
+		if clientmodels.ClientType(byte(d.AssignedTo)) != clientmodels.ClientTypeService {
+			return errors.NewError(errors.ErrorCodeInvalidData, "invalid assignee id")
+		}
+		// time-bounded role assignments are only supported for users and groups
+		if d.ValidFrom.HasValue || d.ValidUntil.HasValue {
+			return errors.NewError(errors.ErrorCodeInvalidData, "validity period isn't supported for clients")
+		}
 	}
 	return nil
 }
@@ -47,8 +77,8 @@ type GetRoleIdAndAssigneeOperationResult struct {
 	// The role ID.
 	RoleId uint64 `db:"role_id" json:"roleId"`
 
-	// The unique ID of the entity the role is assigned to - either the userId of a user
-	// or the groupId of a group.
+	// The unique ID of the entity the role is assigned to - either the userId of a user,
+	// the groupId of a group or the clientId of a service client.
 	AssignedTo uint64 `db:"assigned_to" json:"assignedTo"`
 
 	// The type of the assignee.
@@ -15,7 +15,10 @@
 package grouproleassignments
 
 import (
+	"time"
+
 	"personal-website-v2/identity/src/internal/groups/models"
+	"personal-website-v2/pkg/base/nullable"
 )
 
 type CreateOperationData struct {
@@ -27,4 +30,10 @@ type CreateOperationData struct {
 
 	// The role ID.
 	RoleId uint64 `json:"roleId"`
+
+	// Optional. The date and time from which the role assignment is valid.
+	ValidFrom nullable.Nullable[time.Time] `json:"validFrom"`
+
+	// Optional. The date and time until which the role assignment is valid.
+	ValidUntil nullable.Nullable[time.Time] `json:"validUntil"`
 }
@@ -14,6 +14,12 @@
 
 package userroleassignments
 
+import (
+	"time"
+
+	"personal-website-v2/pkg/base/nullable"
+)
+
 type CreateOperationData struct {
 	// The role assignment ID.
 	RoleAssignmentId uint64 `json:"roleAssignmentId"`
@@ -23,4 +29,10 @@ type CreateOperationData struct {
 
 	// The role ID.
 	RoleId uint64 `json:"roleId"`
+
+	// Optional. The date and time from which the role assignment is valid.
+	ValidFrom nullable.Nullable[time.Time] `json:"validFrom"`
+
+	// Optional. The date and time until which the role assignment is valid.
+	ValidUntil nullable.Nullable[time.Time] `json:"validUntil"`
 }
@@ -15,12 +15,15 @@
 package roles
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	"personal-website-v2/identity/src/internal/roles/dbmodels"
 	"personal-website-v2/identity/src/internal/roles/models"
 	"personal-website-v2/identity/src/internal/roles/operations/assignments"
+	"personal-website-v2/identity/src/internal/roles/operations/clientroleassignments"
 	"personal-website-v2/identity/src/internal/roles/operations/grouproleassignments"
 	"personal-website-v2/identity/src/internal/roles/operations/roles"
 	"personal-website-v2/identity/src/internal/roles/operations/userroleassignments"
@@ -72,6 +75,17 @@ type RoleAssignmentStore interface {
 	// Delete deletes a role assignment by the specified role assignment ID.
 	Delete(ctx *actions.OperationContext, id uint64) error
 
+	// Extend extends the validity period of an active role assignment by the specified role assignment ID.
+	Extend(ctx *actions.OperationContext, id uint64, validUntil time.Time) error
+
+	// Revoke revokes an active role assignment by the specified role assignment ID
+	// before its validity period ends.
+	Revoke(ctx *actions.OperationContext, id uint64) error
+
+	// Expire deactivates an active role assignment by the specified role assignment ID
+	// if its validity period has ended and returns true if the role assignment has been deactivated.
+	Expire(ctx *actions.OperationContext, id uint64) (bool, error)
+
 	// FindById finds and returns a role assignment, if any, by the specified role assignment ID.
 	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.RoleAssignment, error)
 
@@ -92,6 +106,15 @@ type RoleAssignmentStore interface {
 
 	// GetRoleIdAndAssigneeById gets the role ID and assignee by the specified role assignment ID.
 	GetRoleIdAndAssigneeById(ctx *actions.OperationContext, id uint64) (*assignments.GetRoleIdAndAssigneeOperationResult, error)
+
+	// GetAllExpiredIds gets the IDs of up to limit active role assignments whose validity period has ended.
+	GetAllExpiredIds(ctx *actions.OperationContext, limit int) ([]uint64, error)
+
+	// GetAllExpiringBefore gets all active role assignments whose validity period ends before the specified time.
+	GetAllExpiringBefore(ctx *actions.OperationContext, t time.Time) ([]*dbmodels.RoleAssignment, error)
+
+	// GetAllActiveByRoleIds gets all active role assignments of any of the specified roles.
+	GetAllActiveByRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]*dbmodels.RoleAssignment, error)
 }
 
 // UserRoleAssignmentStore is a user role assignment store.
@@ -106,6 +129,14 @@ type UserRoleAssignmentStore interface {
 	// and returns the ID of the user's deleted role assignment if the operation is successful.
 	DeleteByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
 
+	// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a user's role assignment
+	// by the specified role assignment ID and returns the ID of the user's role assignment if the operation is successful.
+	UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error)
+
+	// DeactivateByRoleAssignmentId deactivates a user's role assignment by the specified role assignment ID
+	// and returns the ID of the user's deactivated role assignment if the operation is successful.
+	DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
 	// FindById finds and returns a user's role assignment, if any, by the specified user's role assignment ID.
 	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.UserRoleAssignment, error)
 
@@ -132,8 +163,13 @@ type UserRoleAssignmentStore interface {
 
 	// GetUserRoleIdsByUserId gets the IDs of the roles assigned to the user by the specified user ID.
 	// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
-	// specified in the filter, if any, are returned.
+	// specified in the filter, if any, are returned. Role assignments outside their validity period are ignored.
 	GetUserRoleIdsByUserId(ctx *actions.OperationContext, userId uint64, roleFilter []uint64) ([]uint64, error)
+
+	// GetNextRoleChangeTimeByUserId gets the time of the next scheduled change of the user's roles
+	// by the specified user ID (the nearest future start or end of the validity period of the active
+	// role assignments). It returns nil if no change is scheduled.
+	GetNextRoleChangeTimeByUserId(ctx *actions.OperationContext, userId uint64) (*time.Time, error)
 }
 
 // GroupRoleAssignmentStore is a group role assignment store.
@@ -148,6 +184,14 @@ type GroupRoleAssignmentStore interface {
 	// and returns the ID of the deleted role assignment of the group if the operation is successful.
 	DeleteByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
 
+	// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a group role assignment
+	// by the specified role assignment ID and returns the ID of the group role assignment if the operation is successful.
+	UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error)
+
+	// DeactivateByRoleAssignmentId deactivates a group role assignment by the specified role assignment ID
+	// and returns the ID of the deactivated role assignment of the group if the operation is successful.
+	DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
 	// FindById finds and returns a group role assignment, if any, by the specified group role assignment ID.
 	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.GroupRoleAssignment, error)
 
@@ -174,8 +218,55 @@ type GroupRoleAssignmentStore interface {
 
 	// GetGroupRoleIdsByGroup gets the IDs of the roles assigned to the group by the specified group.
 	// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
-	// specified in the filter, if any, are returned.
+	// specified in the filter, if any, are returned. Role assignments outside their validity period are ignored.
 	GetGroupRoleIdsByGroup(ctx *actions.OperationContext, group groupmodels.UserGroup, roleFilter []uint64) ([]uint64, error)
+
+	// GetNextRoleChangeTimeByGroup gets the time of the next scheduled change of the group's roles
+	// by the specified group (the nearest future start or end of the validity period of the active
+	// role assignments). It returns nil if no change is scheduled.
+	GetNextRoleChangeTimeByGroup(ctx *actions.OperationContext, group groupmodels.UserGroup) (*time.Time, error)
+}
+
+// ClientRoleAssignmentStore is a client role assignment store.
+type ClientRoleAssignmentStore interface {
+	// Create creates a client's role assignment and returns the client's role assignment ID if the operation is successful.
+	Create(ctx *actions.OperationContext, data *clientroleassignments.CreateOperationData) (uint64, error)
+
+	// Delete deletes a client's role assignment by the specified client's role assignment ID.
+	Delete(ctx *actions.OperationContext, id uint64) error
+
+	// DeleteByRoleAssignmentId deletes a client's role assignment by the specified role assignment ID
+	// and returns the ID of the client's deleted role assignment if the operation is successful.
+	DeleteByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
+	// FindById finds and returns a client's role assignment, if any, by the specified client's role assignment ID.
+	FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ClientRoleAssignment, error)
+
+	// FindByRoleAssignmentId finds and returns a client's role assignment, if any, by the specified role assignment ID.
+	FindByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*dbmodels.ClientRoleAssignment, error)
+
+	// GetAllByClientId gets all client's role assignments by the specified client ID.
+	GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ClientRoleAssignment, error)
+
+	// Exists returns true if the client's role assignment exists.
+	Exists(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error)
+
+	// IsAssigned returns true if the role is assigned to the client.
+	IsAssigned(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error)
+
+	// GetIdByRoleAssignmentId gets the client's role assignment ID by the specified role assignment ID.
+	GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error)
+
+	// GetStatusById gets a client's role assignment status by the specified client's role assignment ID.
+	GetStatusById(ctx *actions.OperationContext, id uint64) (models.ClientRoleAssignmentStatus, error)
+
+	// GetStatusByRoleAssignmentId gets a client's role assignment status by the specified role assignment ID.
+	GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (models.ClientRoleAssignmentStatus, error)
+
+	// GetClientRoleIdsByClientId gets the IDs of the roles assigned to the client by the specified client ID.
+	// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+	// specified in the filter, if any, are returned.
+	GetClientRoleIdsByClientId(ctx *actions.OperationContext, clientId uint64, roleFilter []uint64) ([]uint64, error)
 }
 
 // RolesStateStore is a store of the state of the roles.
@@ -198,3 +289,23 @@ type RolesStateStore interface {
 	// DecrExistingAssignments decrements the number of existing assignments of the role.
 	DecrExistingAssignments(ctx *actions.OperationContext, roleId uint64) error
 }
+
+// RoleInheritanceStore is a role inheritance store.
+type RoleInheritanceStore interface {
+	// AddParent adds a parent role to the role.
+	AddParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error
+
+	// RemoveParent removes a parent role from the role.
+	RemoveParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error
+
+	// GetParentRoleIds gets the IDs of the parent roles of the role by the specified role ID.
+	GetParentRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error)
+
+	// GetAllAncestorRoleIds gets the IDs of all roles from which the role inherits (directly or indirectly)
+	// by the specified role ID.
+	GetAllAncestorRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error)
+
+	// GetAllDescendantRoleIds gets the IDs of all roles that inherit (directly or indirectly)
+	// from any of the specified roles.
+	GetAllDescendantRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]uint64, error)
+}
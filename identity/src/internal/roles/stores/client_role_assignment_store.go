@@ -0,0 +1,441 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/roles/dbmodels"
+	"personal-website-v2/identity/src/internal/roles/models"
+	craoperations "personal-website-v2/identity/src/internal/roles/operations/clientroleassignments"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	clientRoleAssignmentsTable = "public.client_role_assignments"
+)
+
+// ClientRoleAssignmentStore is a client role assignment store.
+type ClientRoleAssignmentStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	store      *postgres.Store[dbmodels.ClientRoleAssignment]
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ roles.ClientRoleAssignmentStore = (*ClientRoleAssignmentStore)(nil)
+
+func NewClientRoleAssignmentStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ClientRoleAssignmentStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.roles.stores.ClientRoleAssignmentStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientRoleAssignmentStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupClientRoleAssignment,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientRoleAssignmentStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewClientRoleAssignmentStore] new TxManager: %w", err)
+	}
+
+	return &ClientRoleAssignmentStore{
+		db:         db,
+		opExecutor: e,
+		store:      postgres.NewStore[dbmodels.ClientRoleAssignment](db),
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// Create creates a client's role assignment and returns the client's role assignment ID if the operation is successful.
+func (s *ClientRoleAssignmentStore) Create(ctx *actions.OperationContext, data *craoperations.CreateOperationData) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_Create, []*actions.OperationParam{actions.NewOperationParam("data", data)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.create_client_role_assignment(IN _role_assignment_id, IN _client_id, IN _role_id, IN _created_by, IN _status_comment,
+				// OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_client_role_assignment($1, $2, $3, $4, NULL, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.RoleAssignmentId, data.ClientId, data.RoleId, opCtx.UserId.Ptr())
+
+				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.ClientRoleAssignmentStore.Create] execute a query (create_client_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeRoleAlreadyAssigned:
+					return ierrors.ErrRoleAlreadyAssigned
+				}
+				// unknown error
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.Create] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.Create] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ClientRoleAssignmentStore.Create] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// Delete deletes a client's role assignment by the specified client's role assignment ID.
+func (s *ClientRoleAssignmentStore) Delete(ctx *actions.OperationContext, id uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_Delete, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if err := s.delete(opCtx, id); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.Delete] delete a client's role assignment: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.ClientRoleAssignmentStore.Delete] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// DeleteByRoleAssignmentId deletes a client's role assignment by the specified role assignment ID
+// and returns the ID of the client's deleted role assignment if the operation is successful.
+func (s *ClientRoleAssignmentStore) DeleteByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_DeleteByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.DeleteByRoleAssignmentId] get the client's role assignment id by role assignment id: %w", err)
+			}
+
+			if err = s.delete(opCtx, id); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.DeleteByRoleAssignmentId] delete a client's role assignment: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ClientRoleAssignmentStore.DeleteByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+func (s *ClientRoleAssignmentStore) delete(ctx *actions.OperationContext, id uint64) error {
+	err := s.txManager.ExecWithReadCommittedLevel(ctx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+		var errCode dberrors.DbErrorCode
+		var errMsg string
+		// PROCEDURE: public.delete_client_role_assignment(IN _id, IN _deleted_by, IN _status_comment, OUT err_code, OUT err_msg)
+		const query = "CALL public.delete_client_role_assignment($1, $2, 'deletion', NULL, NULL)"
+		r := tx.QueryRow(txCtx, query, id, ctx.UserId.Ptr())
+
+		if err := r.Scan(&errCode, &errMsg); err != nil {
+			return fmt.Errorf("[stores.ClientRoleAssignmentStore.delete] execute a query (delete_client_role_assignment): %w", err)
+		}
+
+		switch errCode {
+		case dberrors.DbErrorCodeNoError:
+			return nil
+		case dberrors.DbErrorCodeInvalidOperation:
+			return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+		case idberrors.DbErrorCodeRoleAssignmentNotFound:
+			return ierrors.ErrRoleAssignmentNotFound
+		}
+		// unknown error
+		return fmt.Errorf("[stores.ClientRoleAssignmentStore.delete] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+	})
+	if err != nil {
+		return fmt.Errorf("[stores.ClientRoleAssignmentStore.delete] execute a transaction: %w", err)
+	}
+	return nil
+}
+
+// FindById finds and returns a client's role assignment, if any, by the specified client's role assignment ID.
+func (s *ClientRoleAssignmentStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.ClientRoleAssignment, error) {
+	var a *dbmodels.ClientRoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_FindById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + clientRoleAssignmentsTable + " WHERE id = $1 LIMIT 1"
+			var err error
+			if a, err = s.store.Find(opCtx.Ctx, query, id); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.FindById] find a client's role assignment by id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ClientRoleAssignmentStore.FindById] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// FindByRoleAssignmentId finds and returns a client's role assignment, if any, by the specified role assignment ID.
+func (s *ClientRoleAssignmentStore) FindByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (*dbmodels.ClientRoleAssignment, error) {
+	var a *dbmodels.ClientRoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_FindByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + clientRoleAssignmentsTable + " WHERE role_assignment_id = $1 LIMIT 1"
+			var err error
+			if a, err = s.store.Find(opCtx.Ctx, query, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.FindByRoleAssignmentId] find a client's role assignment by role assignment id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ClientRoleAssignmentStore.FindByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return a, nil
+}
+
+// GetAllByClientId gets all client's role assignments by the specified client ID.
+func (s *ClientRoleAssignmentStore) GetAllByClientId(ctx *actions.OperationContext, clientId uint64) ([]*dbmodels.ClientRoleAssignment, error) {
+	var as []*dbmodels.ClientRoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_GetAllByClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + clientRoleAssignmentsTable + " WHERE client_id = $1"
+			var err error
+			if as, err = s.store.FindAll(opCtx.Ctx, query, clientId); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetAllByClientId] find all client's role assignments by client id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ClientRoleAssignmentStore.GetAllByClientId] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+// Exists returns true if the client's role assignment exists.
+func (s *ClientRoleAssignmentStore) Exists(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error) {
+	var exists bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_Exists,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.Exists] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			// FUNCTION: public.client_role_assignment_exists(_client_id, _role_id) RETURNS boolean
+			const query = "SELECT public.client_role_assignment_exists($1, $2)"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, clientId, roleId).Scan(&exists); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.Exists] execute a query (client_role_assignment_exists): %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.ClientRoleAssignmentStore.Exists] execute an operation: %w", err)
+	}
+	return exists, nil
+}
+
+// IsAssigned returns true if the role is assigned to the client.
+func (s *ClientRoleAssignmentStore) IsAssigned(ctx *actions.OperationContext, clientId, roleId uint64) (bool, error) {
+	var isAssigned bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_IsAssigned,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.IsAssigned] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			// FUNCTION: public.is_role_assigned(_client_id, _role_id) RETURNS boolean
+			const query = "SELECT public.is_role_assigned($1, $2)"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, clientId, roleId).Scan(&isAssigned); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.IsAssigned] execute a query (is_role_assigned): %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.ClientRoleAssignmentStore.IsAssigned] execute an operation: %w", err)
+	}
+	return isAssigned, nil
+}
+
+// GetIdByRoleAssignmentId gets the client's role assignment ID by the specified role assignment ID.
+func (s *ClientRoleAssignmentStore) GetIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_GetIdByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetIdByRoleAssignmentId] get the client's role assignment id by role assignment id: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ClientRoleAssignmentStore.GetIdByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+func (s *ClientRoleAssignmentStore) getIdByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	conn, err := s.db.ConnPool.Acquire(ctx.Ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.ClientRoleAssignmentStore.getIdByRoleAssignmentId] acquire a connection: %w", err)
+	}
+	defer conn.Release()
+
+	const query = "SELECT id FROM " + clientRoleAssignmentsTable + " WHERE role_assignment_id = $1 LIMIT 1"
+	var id uint64
+
+	if err = conn.QueryRow(ctx.Ctx, query, roleAssignmentId).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ierrors.ErrRoleAssignmentNotFound
+		}
+		return 0, fmt.Errorf("[stores.ClientRoleAssignmentStore.getIdByRoleAssignmentId] execute a query: %w", err)
+	}
+	return id, nil
+}
+
+// GetStatusById gets a client's role assignment status by the specified client's role assignment ID.
+func (s *ClientRoleAssignmentStore) GetStatusById(ctx *actions.OperationContext, id uint64) (models.ClientRoleAssignmentStatus, error) {
+	var status models.ClientRoleAssignmentStatus
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_GetStatusById, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusById] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT status FROM " + clientRoleAssignmentsTable + " WHERE id = $1 LIMIT 1"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, id).Scan(&status); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusById] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return status, fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusById] execute an operation: %w", err)
+	}
+	return status, nil
+}
+
+// GetStatusByRoleAssignmentId gets a client's role assignment status by the specified role assignment ID.
+func (s *ClientRoleAssignmentStore) GetStatusByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (models.ClientRoleAssignmentStatus, error) {
+	var status models.ClientRoleAssignmentStatus
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_GetStatusByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusByRoleAssignmentId] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT status FROM " + clientRoleAssignmentsTable + " WHERE role_assignment_id = $1 LIMIT 1"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, roleAssignmentId).Scan(&status); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusByRoleAssignmentId] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return status, fmt.Errorf("[stores.ClientRoleAssignmentStore.GetStatusByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return status, nil
+}
+
+// GetClientRoleIdsByClientId gets the IDs of the roles assigned to the client by the specified client ID.
+// If the role filter is empty, then all assigned roles are returned, otherwise only the roles
+// specified in the filter, if any, are returned.
+func (s *ClientRoleAssignmentStore) GetClientRoleIdsByClientId(ctx *actions.OperationContext, clientId uint64, roleFilter []uint64) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeClientRoleAssignmentStore_GetClientRoleIdsByClientId,
+		[]*actions.OperationParam{actions.NewOperationParam("clientId", clientId), actions.NewOperationParam("roleFilter", roleFilter)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetClientRoleIdsByClientId] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			var query string
+			var rows pgx.Rows
+
+			if len(roleFilter) > 0 {
+				query = "SELECT role_id FROM " + clientRoleAssignmentsTable + " WHERE client_id = $1 AND role_id = ANY ($2) AND status = $3"
+				rows, err = conn.Query(opCtx.Ctx, query, clientId, roleFilter, models.ClientRoleAssignmentStatusActive)
+			} else {
+				query = "SELECT role_id FROM " + clientRoleAssignmentsTable + " WHERE client_id = $1 AND status = $2"
+				rows, err = conn.Query(opCtx.Ctx, query, clientId, models.ClientRoleAssignmentStatusActive)
+			}
+
+			if err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetClientRoleIdsByClientId] execute a query: %w", err)
+			}
+			defer rows.Close()
+
+			if ids, err = pgx.CollectRows(rows, pgx.RowTo[uint64]); err != nil {
+				return fmt.Errorf("[stores.ClientRoleAssignmentStore.GetClientRoleIdsByClientId] collect rows: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.ClientRoleAssignmentStore.GetClientRoleIdsByClientId] execute an operation: %w", err)
+	}
+	return ids, nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -93,9 +94,9 @@ func (s *GroupRoleAssignmentStore) Create(ctx *actions.OperationContext, data *g
 				var errCode dberrors.DbErrorCode
 				var errMsg string
 				// PROCEDURE: public.create_group_role_assignment(IN _role_assignment_id, IN _group, IN _role_id, IN _created_by, IN _status_comment,
-				// OUT _id, OUT err_code, OUT err_msg)
-				const query = "CALL public.create_group_role_assignment($1, $2, $3, $4, NULL, NULL, NULL, NULL)"
-				r := tx.QueryRow(txCtx, query, data.RoleAssignmentId, data.Group, data.RoleId, opCtx.UserId.Ptr())
+				// IN _valid_from, IN _valid_until, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_group_role_assignment($1, $2, $3, $4, NULL, $5, $6, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.RoleAssignmentId, data.Group, data.RoleId, opCtx.UserId.Ptr(), utcTimePtr(data.ValidFrom), utcTimePtr(data.ValidUntil))
 
 				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
 					return fmt.Errorf("[stores.GroupRoleAssignmentStore.Create] execute a query (create_group_role_assignment): %w", err)
@@ -191,6 +192,98 @@ func (s *GroupRoleAssignmentStore) delete(ctx *actions.OperationContext, id uint
 	return nil
 }
 
+// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a group role assignment
+// by the specified role assignment ID and returns the ID of the group role assignment if the operation is successful.
+func (s *GroupRoleAssignmentStore) UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentStore_UpdateValidUntilByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] get the group role assignment id by role assignment id: %w", err)
+			}
+
+			err = s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.update_group_role_assignment_valid_until(IN _id, IN _valid_until, IN _updated_by, OUT err_code, OUT err_msg)
+				const query = "CALL public.update_group_role_assignment_valid_until($1, $2, $3, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, validUntil.UTC(), opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.GroupRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute a query (update_group_role_assignment_valid_until): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleAssignmentNotFound:
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.GroupRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// DeactivateByRoleAssignmentId deactivates a group role assignment by the specified role assignment ID
+// and returns the ID of the deactivated role assignment of the group if the operation is successful.
+func (s *GroupRoleAssignmentStore) DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentStore_DeactivateByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.DeactivateByRoleAssignmentId] get the group role assignment id by role assignment id: %w", err)
+			}
+
+			err = s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.deactivate_group_role_assignment(IN _id, IN _updated_by, IN _status_comment, OUT err_code, OUT err_msg)
+				const query = "CALL public.deactivate_group_role_assignment($1, $2, 'deactivation', NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.GroupRoleAssignmentStore.DeactivateByRoleAssignmentId] execute a query (deactivate_group_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleAssignmentNotFound:
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.DeactivateByRoleAssignmentId] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.DeactivateByRoleAssignmentId] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.GroupRoleAssignmentStore.DeactivateByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
 // FindById finds and returns a group role assignment, if any, by the specified group role assignment ID.
 func (s *GroupRoleAssignmentStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.GroupRoleAssignment, error) {
 	var a *dbmodels.GroupRoleAssignment
@@ -417,10 +510,12 @@ func (s *GroupRoleAssignmentStore) GetGroupRoleIdsByGroup(ctx *actions.Operation
 			var rows pgx.Rows
 
 			if len(roleFilter) > 0 {
-				query = "SELECT role_id FROM " + groupRoleAssignmentsTable + ` WHERE "group" = $1 AND role_id = ANY ($2) AND status = $3`
+				query = "SELECT role_id FROM " + groupRoleAssignmentsTable + ` WHERE "group" = $1 AND role_id = ANY ($2) AND status = $3` +
+					" AND (valid_from IS NULL OR valid_from <= (clock_timestamp() AT TIME ZONE 'UTC')) AND (valid_until IS NULL OR valid_until > (clock_timestamp() AT TIME ZONE 'UTC'))"
 				rows, err = conn.Query(opCtx.Ctx, query, group, roleFilter, models.GroupRoleAssignmentStatusActive)
 			} else {
-				query = "SELECT role_id FROM " + groupRoleAssignmentsTable + ` WHERE "group" = $1 AND status = $2`
+				query = "SELECT role_id FROM " + groupRoleAssignmentsTable + ` WHERE "group" = $1 AND status = $2` +
+					" AND (valid_from IS NULL OR valid_from <= (clock_timestamp() AT TIME ZONE 'UTC')) AND (valid_until IS NULL OR valid_until > (clock_timestamp() AT TIME ZONE 'UTC'))"
 				rows, err = conn.Query(opCtx.Ctx, query, group, models.GroupRoleAssignmentStatusActive)
 			}
 
@@ -440,3 +535,32 @@ func (s *GroupRoleAssignmentStore) GetGroupRoleIdsByGroup(ctx *actions.Operation
 	}
 	return ids, nil
 }
+
+// GetNextRoleChangeTimeByGroup gets the time of the next scheduled change of the group's roles by the specified group
+// (the nearest future start or end of the validity period of the active role assignments).
+// It returns nil if no change is scheduled.
+func (s *GroupRoleAssignmentStore) GetNextRoleChangeTimeByGroup(ctx *actions.OperationContext, group groupmodels.UserGroup) (*time.Time, error) {
+	var t *time.Time
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeGroupRoleAssignmentStore_GetNextRoleChangeTimeByGroup, []*actions.OperationParam{actions.NewOperationParam("group", group)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.GetNextRoleChangeTimeByGroup] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT min(LEAST(CASE WHEN valid_from > _time THEN valid_from END, CASE WHEN valid_until > _time THEN valid_until END))" +
+				" FROM " + groupRoleAssignmentsTable + ", (SELECT (clock_timestamp() AT TIME ZONE 'UTC') AS _time) AS c" +
+				` WHERE "group" = $1 AND status = $2`
+
+			if err = conn.QueryRow(opCtx.Ctx, query, group, models.GroupRoleAssignmentStatusActive).Scan(&t); err != nil {
+				return fmt.Errorf("[stores.GroupRoleAssignmentStore.GetNextRoleChangeTimeByGroup] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.GroupRoleAssignmentStore.GetNextRoleChangeTimeByGroup] execute an operation: %w", err)
+	}
+	return t, nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -29,6 +30,7 @@ import (
 	"personal-website-v2/identity/src/internal/roles/models"
 	assignmentoperations "personal-website-v2/identity/src/internal/roles/operations/assignments"
 	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
 	dberrors "personal-website-v2/pkg/db/errors"
 	"personal-website-v2/pkg/db/postgres"
 	errs "personal-website-v2/pkg/errors"
@@ -92,9 +94,11 @@ func (s *RoleAssignmentStore) Create(ctx *actions.OperationContext, data *assign
 				var errCode dberrors.DbErrorCode
 				var errMsg string
 				// PROCEDURE: public.create_role_assignment(IN _role_id, IN _assigned_to, IN _assignee_type, IN _created_by, IN _status_comment, IN _description,
-				// OUT _id, OUT err_code, OUT err_msg)
-				const query = "CALL public.create_role_assignment($1, $2, $3, $4, NULL, $5, NULL, NULL, NULL)"
-				r := tx.QueryRow(txCtx, query, data.RoleId, data.AssignedTo, data.AssigneeType, opCtx.UserId.Ptr(), data.Description.Ptr())
+				// IN _valid_from, IN _valid_until, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_role_assignment($1, $2, $3, $4, NULL, $5, $6, $7, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.RoleId, data.AssignedTo, data.AssigneeType, opCtx.UserId.Ptr(), data.Description.Ptr(),
+					utcTimePtr(data.ValidFrom), utcTimePtr(data.ValidUntil),
+				)
 
 				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
 					return fmt.Errorf("[stores.RoleAssignmentStore.Create] execute a query (create_role_assignment): %w", err)
@@ -201,6 +205,112 @@ func (s *RoleAssignmentStore) Delete(ctx *actions.OperationContext, id uint64) e
 	return nil
 }
 
+// Extend extends the validity period of an active role assignment by the specified role assignment ID.
+func (s *RoleAssignmentStore) Extend(ctx *actions.OperationContext, id uint64, validUntil time.Time) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_Extend,
+		[]*actions.OperationParam{actions.NewOperationParam("id", id), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.extend_role_assignment(IN _id, IN _valid_until, IN _updated_by, OUT err_code, OUT err_msg)
+				const query = "CALL public.extend_role_assignment($1, $2, $3, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, validUntil.UTC(), opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RoleAssignmentStore.Extend] execute a query (extend_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleAssignmentNotFound:
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RoleAssignmentStore.Extend] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.Extend] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RoleAssignmentStore.Extend] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Revoke revokes an active role assignment by the specified role assignment ID
+// before its validity period ends.
+func (s *RoleAssignmentStore) Revoke(ctx *actions.OperationContext, id uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_Revoke, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if _, err := s.deactivate(opCtx, id, false, "revocation"); err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.Revoke] deactivate a role assignment: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RoleAssignmentStore.Revoke] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// Expire deactivates an active role assignment by the specified role assignment ID
+// if its validity period has ended and returns true if the role assignment has been deactivated.
+func (s *RoleAssignmentStore) Expire(ctx *actions.OperationContext, id uint64) (bool, error) {
+	var deactivated bool
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_Expire, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if deactivated, err = s.deactivate(opCtx, id, true, "expiration"); err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.Expire] deactivate a role assignment: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[stores.RoleAssignmentStore.Expire] execute an operation: %w", err)
+	}
+	return deactivated, nil
+}
+
+func (s *RoleAssignmentStore) deactivate(ctx *actions.OperationContext, id uint64, onlyIfExpired bool, statusComment string) (bool, error) {
+	var deactivated bool
+	err := s.txManager.ExecWithReadCommittedLevel(ctx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+		var errCode dberrors.DbErrorCode
+		var errMsg string
+		// PROCEDURE: public.deactivate_role_assignment(IN _id, IN _only_if_expired, IN _updated_by, IN _status_comment, OUT _deactivated,
+		// OUT err_code, OUT err_msg)
+		const query = "CALL public.deactivate_role_assignment($1, $2, $3, $4, NULL, NULL, NULL)"
+		r := tx.QueryRow(txCtx, query, id, onlyIfExpired, ctx.UserId.Ptr(), statusComment)
+
+		if err := r.Scan(&deactivated, &errCode, &errMsg); err != nil {
+			return fmt.Errorf("[stores.RoleAssignmentStore.deactivate] execute a query (deactivate_role_assignment): %w", err)
+		}
+
+		switch errCode {
+		case dberrors.DbErrorCodeNoError:
+			return nil
+		case dberrors.DbErrorCodeInvalidOperation:
+			return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+		case idberrors.DbErrorCodeRoleAssignmentNotFound:
+			return ierrors.ErrRoleAssignmentNotFound
+		}
+		// unknown error
+		return fmt.Errorf("[stores.RoleAssignmentStore.deactivate] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+	})
+	if err != nil {
+		return false, fmt.Errorf("[stores.RoleAssignmentStore.deactivate] execute a transaction: %w", err)
+	}
+	return deactivated, nil
+}
+
 // FindById finds and returns a role assignment, if any, by the specified role assignment ID.
 func (s *RoleAssignmentStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.RoleAssignment, error) {
 	var a *dbmodels.RoleAssignment
@@ -378,3 +488,83 @@ func (s *RoleAssignmentStore) GetRoleIdAndAssigneeById(ctx *actions.OperationCon
 	}
 	return r, nil
 }
+
+// GetAllExpiredIds gets the IDs of up to limit active role assignments whose validity period has ended.
+func (s *RoleAssignmentStore) GetAllExpiredIds(ctx *actions.OperationContext, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_GetAllExpiredIds, []*actions.OperationParam{actions.NewOperationParam("limit", limit)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiredIds] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT id FROM " + roleAssignmentsTable +
+				" WHERE status = $1 AND valid_until <= (clock_timestamp() AT TIME ZONE 'UTC') ORDER BY valid_until LIMIT $2"
+
+			rows, err := conn.Query(opCtx.Ctx, query, models.RoleAssignmentStatusActive, limit)
+			if err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiredIds] execute a query: %w", err)
+			}
+			defer rows.Close()
+
+			if ids, err = pgx.CollectRows(rows, pgx.RowTo[uint64]); err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiredIds] collect rows: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiredIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllExpiringBefore gets all active role assignments whose validity period ends before the specified time.
+func (s *RoleAssignmentStore) GetAllExpiringBefore(ctx *actions.OperationContext, t time.Time) ([]*dbmodels.RoleAssignment, error) {
+	var as []*dbmodels.RoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_GetAllExpiringBefore, []*actions.OperationParam{actions.NewOperationParam("t", t)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + roleAssignmentsTable + " WHERE status = $1 AND valid_until < $2 ORDER BY valid_until"
+			var err error
+			if as, err = s.store.FindAll(opCtx.Ctx, query, models.RoleAssignmentStatusActive, t.UTC()); err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiringBefore] find all role assignments expiring before the specified time: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleAssignmentStore.GetAllExpiringBefore] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+// GetAllActiveByRoleIds gets all active role assignments of any of the specified roles.
+func (s *RoleAssignmentStore) GetAllActiveByRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]*dbmodels.RoleAssignment, error) {
+	var as []*dbmodels.RoleAssignment
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleAssignmentStore_GetAllActiveByRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleIds", roleIds)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT * FROM " + roleAssignmentsTable + " WHERE role_id = ANY($1) AND status = $2 ORDER BY role_id, id"
+			var err error
+			if as, err = s.store.FindAll(opCtx.Ctx, query, roleIds, models.RoleAssignmentStatusActive); err != nil {
+				return fmt.Errorf("[stores.RoleAssignmentStore.GetAllActiveByRoleIds] find all active role assignments by role ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleAssignmentStore.GetAllActiveByRoleIds] execute an operation: %w", err)
+	}
+	return as, nil
+}
+
+// utcTimePtr returns a pointer to the time in UTC or nil if the time isn't specified.
+// The timestamps are stored in the database in UTC without a time zone.
+func utcTimePtr(t nullable.Nullable[time.Time]) *time.Time {
+	if !t.HasValue {
+		return nil
+	}
+	v := t.Value.UTC()
+	return &v
+}
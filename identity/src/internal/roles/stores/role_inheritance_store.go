@@ -0,0 +1,247 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	idberrors "personal-website-v2/identity/src/internal/db/errors"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/roles"
+	"personal-website-v2/identity/src/internal/roles/models"
+	"personal-website-v2/pkg/actions"
+	dberrors "personal-website-v2/pkg/db/errors"
+	"personal-website-v2/pkg/db/postgres"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	roleInheritanceTable = "public.role_inheritance"
+)
+
+// RoleInheritanceStore is a role inheritance store.
+type RoleInheritanceStore struct {
+	db         *postgres.Database
+	opExecutor *actionhelper.OperationExecutor
+	txManager  *postgres.TxManager
+	logger     logging.Logger[*lcontext.LogEntryContext]
+}
+
+var _ roles.RoleInheritanceStore = (*RoleInheritanceStore)(nil)
+
+func NewRoleInheritanceStore(db *postgres.Database, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*RoleInheritanceStore, error) {
+	l, err := loggerFactory.CreateLogger("internal.roles.stores.RoleInheritanceStore")
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRoleInheritanceStore] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryDatabase,
+		DefaultGroup:    iactions.OperationGroupRoleInheritance,
+		StopAppIfError:  true,
+	}
+
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRoleInheritanceStore] new operation executor: %w", err)
+	}
+
+	txm, err := postgres.NewTxManager(db, &postgres.TxManagerConfig{MaxRetriesWhenSerializationFailureErr: 5}, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.NewRoleInheritanceStore] new TxManager: %w", err)
+	}
+
+	return &RoleInheritanceStore{
+		db:         db,
+		opExecutor: e,
+		txManager:  txm,
+		logger:     l,
+	}, nil
+}
+
+// AddParent adds a parent role to the role.
+func (s *RoleInheritanceStore) AddParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceStore_AddParent,
+		[]*actions.OperationParam{actions.NewOperationParam("roleId", roleId), actions.NewOperationParam("parentRoleId", parentRoleId)},
+		func(opCtx *actions.OperationContext) error {
+			// the procedure locks the role hierarchy before the cycle and depth checks, and the read committed
+			// isolation level is required so that the checks see the links added by the previous writers
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.add_role_parent(IN _role_id, IN _parent_role_id, IN _created_by, IN _max_depth, OUT err_code, OUT err_msg)
+				const query = "CALL public.add_role_parent($1, $2, $3, $4, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, roleId, parentRoleId, opCtx.UserId.Ptr(), models.MaxInheritanceDepth)
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RoleInheritanceStore.AddParent] execute a query (add_role_parent): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleNotFound:
+					return errs.NewError(ierrors.ErrorCodeRoleNotFound, errMsg)
+				case idberrors.DbErrorCodeRoleParentAlreadyAdded:
+					return ierrors.ErrRoleParentAlreadyAdded
+				case idberrors.DbErrorCodeRoleInheritanceCycle:
+					return ierrors.ErrRoleInheritanceCycle
+				case idberrors.DbErrorCodeRoleInheritanceDepthExceeded:
+					return ierrors.ErrRoleInheritanceDepthExceeded
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RoleInheritanceStore.AddParent] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RoleInheritanceStore.AddParent] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RoleInheritanceStore.AddParent] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// RemoveParent removes a parent role from the role.
+func (s *RoleInheritanceStore) RemoveParent(ctx *actions.OperationContext, roleId, parentRoleId uint64) error {
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceStore_RemoveParent,
+		[]*actions.OperationParam{actions.NewOperationParam("roleId", roleId), actions.NewOperationParam("parentRoleId", parentRoleId)},
+		func(opCtx *actions.OperationContext) error {
+			err := s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.remove_role_parent(IN _role_id, IN _parent_role_id, OUT err_code, OUT err_msg)
+				const query = "CALL public.remove_role_parent($1, $2, NULL, NULL)"
+
+				if err := tx.QueryRow(txCtx, query, roleId, parentRoleId).Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.RoleInheritanceStore.RemoveParent] execute a query (remove_role_parent): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case idberrors.DbErrorCodeRoleParentNotFound:
+					return ierrors.ErrRoleParentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.RoleInheritanceStore.RemoveParent] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.RoleInheritanceStore.RemoveParent] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[stores.RoleInheritanceStore.RemoveParent] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// GetParentRoleIds gets the IDs of the parent roles of the role by the specified role ID.
+func (s *RoleInheritanceStore) GetParentRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceStore_GetParentRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			const query = "SELECT parent_role_id FROM " + roleInheritanceTable + " WHERE role_id = $1"
+			var err error
+			if ids, err = s.getIds(opCtx, query, roleId); err != nil {
+				return fmt.Errorf("[stores.RoleInheritanceStore.GetParentRoleIds] get ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.GetParentRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllAncestorRoleIds gets the IDs of all roles from which the role inherits (directly or indirectly)
+// by the specified role ID.
+func (s *RoleInheritanceStore) GetAllAncestorRoleIds(ctx *actions.OperationContext, roleId uint64) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceStore_GetAllAncestorRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleId", roleId)},
+		func(opCtx *actions.OperationContext) error {
+			// FUNCTION: public.get_role_ancestor_ids(_role_id, _max_depth) RETURNS SETOF bigint
+			const query = "SELECT public.get_role_ancestor_ids($1, $2)"
+			var err error
+			if ids, err = s.getIds(opCtx, query, roleId, models.MaxInheritanceDepth); err != nil {
+				return fmt.Errorf("[stores.RoleInheritanceStore.GetAllAncestorRoleIds] get ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.GetAllAncestorRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+// GetAllDescendantRoleIds gets the IDs of all roles that inherit (directly or indirectly)
+// from any of the specified roles.
+func (s *RoleInheritanceStore) GetAllDescendantRoleIds(ctx *actions.OperationContext, roleIds []uint64) ([]uint64, error) {
+	var ids []uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeRoleInheritanceStore_GetAllDescendantRoleIds, []*actions.OperationParam{actions.NewOperationParam("roleIds", roleIds)},
+		func(opCtx *actions.OperationContext) error {
+			if len(roleIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of role ids is 0")
+			}
+
+			// FUNCTION: public.get_role_descendant_ids(_role_ids, _max_depth) RETURNS SETOF bigint
+			const query = "SELECT public.get_role_descendant_ids($1, $2)"
+			var err error
+			if ids, err = s.getIds(opCtx, query, roleIds, models.MaxInheritanceDepth); err != nil {
+				return fmt.Errorf("[stores.RoleInheritanceStore.GetAllDescendantRoleIds] get ids: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.GetAllDescendantRoleIds] execute an operation: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *RoleInheritanceStore) getIds(ctx *actions.OperationContext, query string, args ...any) ([]uint64, error) {
+	conn, err := s.db.ConnPool.Acquire(ctx.Ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.getIds] acquire a connection: %w", err)
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx.Ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.getIds] execute a query: %w", err)
+	}
+	defer rows.Close()
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return nil, fmt.Errorf("[stores.RoleInheritanceStore.getIds] collect rows: %w", err)
+	}
+	return ids, nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -92,9 +93,9 @@ func (s *UserRoleAssignmentStore) Create(ctx *actions.OperationContext, data *ur
 				var errCode dberrors.DbErrorCode
 				var errMsg string
 				// PROCEDURE: public.create_user_role_assignment(IN _role_assignment_id, IN _user_id, IN _role_id, IN _created_by, IN _status_comment,
-				// OUT _id, OUT err_code, OUT err_msg)
-				const query = "CALL public.create_user_role_assignment($1, $2, $3, $4, NULL, NULL, NULL, NULL)"
-				r := tx.QueryRow(txCtx, query, data.RoleAssignmentId, data.UserId, data.RoleId, opCtx.UserId.Ptr())
+				// IN _valid_from, IN _valid_until, OUT _id, OUT err_code, OUT err_msg)
+				const query = "CALL public.create_user_role_assignment($1, $2, $3, $4, NULL, $5, $6, NULL, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, data.RoleAssignmentId, data.UserId, data.RoleId, opCtx.UserId.Ptr(), utcTimePtr(data.ValidFrom), utcTimePtr(data.ValidUntil))
 
 				if err := r.Scan(&id, &errCode, &errMsg); err != nil {
 					return fmt.Errorf("[stores.UserRoleAssignmentStore.Create] execute a query (create_user_role_assignment): %w", err)
@@ -190,6 +191,98 @@ func (s *UserRoleAssignmentStore) delete(ctx *actions.OperationContext, id uint6
 	return nil
 }
 
+// UpdateValidUntilByRoleAssignmentId updates the end of the validity period of a user's role assignment
+// by the specified role assignment ID and returns the ID of the user's role assignment if the operation is successful.
+func (s *UserRoleAssignmentStore) UpdateValidUntilByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64, validUntil time.Time) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentStore_UpdateValidUntilByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId), actions.NewOperationParam("validUntil", validUntil)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] get the user's role assignment id by role assignment id: %w", err)
+			}
+
+			err = s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.update_user_role_assignment_valid_until(IN _id, IN _valid_until, IN _updated_by, OUT err_code, OUT err_msg)
+				const query = "CALL public.update_user_role_assignment_valid_until($1, $2, $3, NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, validUntil.UTC(), opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute a query (update_user_role_assignment_valid_until): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleAssignmentNotFound:
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.UserRoleAssignmentStore.UpdateValidUntilByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
+// DeactivateByRoleAssignmentId deactivates a user's role assignment by the specified role assignment ID
+// and returns the ID of the user's deactivated role assignment if the operation is successful.
+func (s *UserRoleAssignmentStore) DeactivateByRoleAssignmentId(ctx *actions.OperationContext, roleAssignmentId uint64) (uint64, error) {
+	var id uint64
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentStore_DeactivateByRoleAssignmentId,
+		[]*actions.OperationParam{actions.NewOperationParam("roleAssignmentId", roleAssignmentId)},
+		func(opCtx *actions.OperationContext) error {
+			var err error
+			if id, err = s.getIdByRoleAssignmentId(opCtx, roleAssignmentId); err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.DeactivateByRoleAssignmentId] get the user's role assignment id by role assignment id: %w", err)
+			}
+
+			err = s.txManager.ExecWithReadCommittedLevel(opCtx.Ctx, func(txCtx context.Context, tx pgx.Tx) error {
+				var errCode dberrors.DbErrorCode
+				var errMsg string
+				// PROCEDURE: public.deactivate_user_role_assignment(IN _id, IN _updated_by, IN _status_comment, OUT err_code, OUT err_msg)
+				const query = "CALL public.deactivate_user_role_assignment($1, $2, 'deactivation', NULL, NULL)"
+				r := tx.QueryRow(txCtx, query, id, opCtx.UserId.Ptr())
+
+				if err := r.Scan(&errCode, &errMsg); err != nil {
+					return fmt.Errorf("[stores.UserRoleAssignmentStore.DeactivateByRoleAssignmentId] execute a query (deactivate_user_role_assignment): %w", err)
+				}
+
+				switch errCode {
+				case dberrors.DbErrorCodeNoError:
+					return nil
+				case dberrors.DbErrorCodeInvalidOperation:
+					return errs.NewError(errs.ErrorCodeInvalidOperation, errMsg)
+				case idberrors.DbErrorCodeRoleAssignmentNotFound:
+					return ierrors.ErrRoleAssignmentNotFound
+				}
+				// unknown error
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.DeactivateByRoleAssignmentId] invalid operation: %w", dberrors.NewDbError(errCode, errMsg))
+			})
+			if err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.DeactivateByRoleAssignmentId] execute a transaction: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[stores.UserRoleAssignmentStore.DeactivateByRoleAssignmentId] execute an operation: %w", err)
+	}
+	return id, nil
+}
+
 // FindById finds and returns a user's role assignment, if any, by the specified user's role assignment ID.
 func (s *UserRoleAssignmentStore) FindById(ctx *actions.OperationContext, id uint64) (*dbmodels.UserRoleAssignment, error) {
 	var a *dbmodels.UserRoleAssignment
@@ -416,10 +509,12 @@ func (s *UserRoleAssignmentStore) GetUserRoleIdsByUserId(ctx *actions.OperationC
 			var rows pgx.Rows
 
 			if len(roleFilter) > 0 {
-				query = "SELECT role_id FROM " + userRoleAssignmentsTable + " WHERE user_id = $1 AND role_id = ANY ($2) AND status = $3"
+				query = "SELECT role_id FROM " + userRoleAssignmentsTable + " WHERE user_id = $1 AND role_id = ANY ($2) AND status = $3" +
+					" AND (valid_from IS NULL OR valid_from <= (clock_timestamp() AT TIME ZONE 'UTC')) AND (valid_until IS NULL OR valid_until > (clock_timestamp() AT TIME ZONE 'UTC'))"
 				rows, err = conn.Query(opCtx.Ctx, query, userId, roleFilter, models.UserRoleAssignmentStatusActive)
 			} else {
-				query = "SELECT role_id FROM " + userRoleAssignmentsTable + " WHERE user_id = $1 AND status = $2"
+				query = "SELECT role_id FROM " + userRoleAssignmentsTable + " WHERE user_id = $1 AND status = $2" +
+					" AND (valid_from IS NULL OR valid_from <= (clock_timestamp() AT TIME ZONE 'UTC')) AND (valid_until IS NULL OR valid_until > (clock_timestamp() AT TIME ZONE 'UTC'))"
 				rows, err = conn.Query(opCtx.Ctx, query, userId, models.UserRoleAssignmentStatusActive)
 			}
 
@@ -439,3 +534,32 @@ func (s *UserRoleAssignmentStore) GetUserRoleIdsByUserId(ctx *actions.OperationC
 	}
 	return ids, nil
 }
+
+// GetNextRoleChangeTimeByUserId gets the time of the next scheduled change of the user's roles by the specified user ID
+// (the nearest future start or end of the validity period of the active role assignments).
+// It returns nil if no change is scheduled.
+func (s *UserRoleAssignmentStore) GetNextRoleChangeTimeByUserId(ctx *actions.OperationContext, userId uint64) (*time.Time, error) {
+	var t *time.Time
+	err := s.opExecutor.Exec(ctx, iactions.OperationTypeUserRoleAssignmentStore_GetNextRoleChangeTimeByUserId, []*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			conn, err := s.db.ConnPool.Acquire(opCtx.Ctx)
+			if err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.GetNextRoleChangeTimeByUserId] acquire a connection: %w", err)
+			}
+			defer conn.Release()
+
+			const query = "SELECT min(LEAST(CASE WHEN valid_from > _time THEN valid_from END, CASE WHEN valid_until > _time THEN valid_until END))" +
+				" FROM " + userRoleAssignmentsTable + ", (SELECT (clock_timestamp() AT TIME ZONE 'UTC') AS _time) AS c" +
+				" WHERE user_id = $1 AND status = $2"
+
+			if err = conn.QueryRow(opCtx.Ctx, query, userId, models.UserRoleAssignmentStatusActive).Scan(&t); err != nil {
+				return fmt.Errorf("[stores.UserRoleAssignmentStore.GetNextRoleChangeTimeByUserId] execute a query: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[stores.UserRoleAssignmentStore.GetNextRoleChangeTimeByUserId] execute an operation: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,298 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/clients"
+	ierrors "personal-website-v2/identity/src/internal/errors"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/identity/src/internal/sessions/dbmodels"
+	"personal-website-v2/identity/src/internal/sessions/models"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/datetime"
+	"personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// ActiveSessionManager is a manager of the users' active sessions.
+//
+// The revoked sessions are added to the revocation list of the current app instance,
+// and other app instances are notified of the revocations, so that the tokens
+// of the revoked sessions are rejected without querying the DB on each authentication.
+type ActiveSessionManager struct {
+	opExecutor              *actionhelper.OperationExecutor
+	clientManager           clients.ClientManager
+	userSessionManager      sessions.UserSessionManager
+	userAgentSessionManager sessions.UserAgentSessionManager
+	revocationList          sessions.SessionRevocationList
+	revocationNotifier      sessions.SessionRevocationNotifier
+	logger                  logging.Logger[*context.LogEntryContext]
+}
+
+var _ sessions.ActiveSessionManager = (*ActiveSessionManager)(nil)
+
+func NewActiveSessionManager(
+	clientManager clients.ClientManager,
+	userSessionManager sessions.UserSessionManager,
+	userAgentSessionManager sessions.UserAgentSessionManager,
+	revocationList sessions.SessionRevocationList,
+	revocationNotifier sessions.SessionRevocationNotifier,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*ActiveSessionManager, error) {
+	l, err := loggerFactory.CreateLogger("internal.sessions.manager.ActiveSessionManager")
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewActiveSessionManager] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupActiveSession,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.NewActiveSessionManager] new operation executor: %w", err)
+	}
+
+	return &ActiveSessionManager{
+		opExecutor:              e,
+		clientManager:           clientManager,
+		userSessionManager:      userSessionManager,
+		userAgentSessionManager: userAgentSessionManager,
+		revocationList:          revocationList,
+		revocationNotifier:      revocationNotifier,
+		logger:                  l,
+	}, nil
+}
+
+// GetAllByUserId gets all active sessions of the user by the specified user ID.
+func (m *ActiveSessionManager) GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*models.ActiveSession, error) {
+	var ss []*models.ActiveSession
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeActiveSessionManager_GetAllByUserId,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId)},
+		func(opCtx *actions.OperationContext) error {
+			uss, err := m.userSessionManager.GetAllByUserId(opCtx, userId, true)
+			if err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.GetAllByUserId] get all user's sessions by user id: %w", err)
+			}
+
+			ss = make([]*models.ActiveSession, 0, len(uss))
+			for _, us := range uss {
+				if us.Status != models.UserSessionStatusActive || us.StartTime == nil {
+					continue
+				}
+
+				c, err := m.clientManager.FindById(opCtx, us.ClientId)
+				if err != nil {
+					return fmt.Errorf("[manager.ActiveSessionManager.GetAllByUserId] find a client by id: %w", err)
+				}
+
+				s := &models.ActiveSession{
+					Id:               us.Id,
+					ClientId:         us.ClientId,
+					Type:             us.Type,
+					StartTime:        *us.StartTime,
+					FirstIP:          us.FirstIP,
+					LastActivityTime: us.LastActivityTime,
+					LastActivityIP:   us.LastActivityIP,
+				}
+				if c != nil {
+					s.UserAgent = c.LastUserAgent
+				}
+				ss = append(ss, s)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[manager.ActiveSessionManager.GetAllByUserId] execute an operation: %w", err)
+	}
+	return ss, nil
+}
+
+// Revoke revokes the user's session by the specified user ID and user's session ID.
+// The session is ended and the tokens created for it are no longer valid in all app instances.
+func (m *ActiveSessionManager) Revoke(ctx *actions.OperationContext, userId, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeActiveSessionManager_Revoke,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			s, err := m.userSessionManager.FindById(opCtx, id)
+			if err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.Revoke] find a user's session by id: %w", err)
+			}
+
+			// the sessions of other users are treated as missing
+			if s == nil || s.UserId != userId {
+				return ierrors.ErrUserSessionNotFound
+			}
+			if s.Status != models.UserSessionStatusActive {
+				return errors.NewError(errors.ErrorCodeInvalidOperation, "user's session isn't active")
+			}
+
+			if err = m.terminate(opCtx, s); err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.Revoke] terminate a user's session: %w", err)
+			}
+
+			if err = m.revoke(opCtx, userId, []uint64{s.Id}, []uint64{s.ClientId}); err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.Revoke] revoke a user's session: %w", err)
+			}
+
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.ActiveSessionEvent,
+				"[manager.ActiveSessionManager.Revoke] user's session has been revoked",
+				logging.NewField("userId", userId),
+				logging.NewField("id", id),
+				logging.NewField("clientId", s.ClientId),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.ActiveSessionManager.Revoke] execute an operation: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllOther revokes all active sessions of the user except the session of the specified client
+// and returns the number of revoked sessions.
+func (m *ActiveSessionManager) RevokeAllOther(ctx *actions.OperationContext, userId, currentClientId uint64) (int, error) {
+	var n int
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeActiveSessionManager_RevokeAllOther,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("currentClientId", currentClientId)},
+		func(opCtx *actions.OperationContext) error {
+			uss, err := m.userSessionManager.GetAllByUserId(opCtx, userId, true)
+			if err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.RevokeAllOther] get all user's sessions by user id: %w", err)
+			}
+
+			ids := make([]uint64, 0, len(uss))
+			clientIds := make([]uint64, 0, len(uss))
+			for _, us := range uss {
+				if us.Status != models.UserSessionStatusActive || us.ClientId == currentClientId {
+					continue
+				}
+
+				if err = m.terminate(opCtx, us); err != nil {
+					return fmt.Errorf("[manager.ActiveSessionManager.RevokeAllOther] terminate a user's session: %w", err)
+				}
+				ids = append(ids, us.Id)
+				clientIds = append(clientIds, us.ClientId)
+			}
+
+			if len(ids) == 0 {
+				return nil
+			}
+
+			if err = m.revoke(opCtx, userId, ids, clientIds); err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.RevokeAllOther] revoke the user's sessions: %w", err)
+			}
+
+			n = len(ids)
+			m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.ActiveSessionEvent,
+				"[manager.ActiveSessionManager.RevokeAllOther] user's sessions have been revoked",
+				logging.NewField("userId", userId),
+				logging.NewField("currentClientId", currentClientId),
+				logging.NewField("ids", ids),
+				logging.NewField("clientIds", clientIds),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("[manager.ActiveSessionManager.RevokeAllOther] execute an operation: %w", err)
+	}
+	return n, nil
+}
+
+// terminate terminates the user's session and the user agent session that belongs to it (if it's active).
+func (m *ActiveSessionManager) terminate(ctx *actions.OperationContext, s *dbmodels.UserSessionInfo) error {
+	uas, err := m.userAgentSessionManager.FindByUserIdAndClientId(ctx, s.UserId, s.ClientId)
+	if err != nil {
+		return fmt.Errorf("[manager.ActiveSessionManager.terminate] find a user agent session by user id and client id: %w", err)
+	}
+
+	if uas != nil && uas.UserSessionId == s.Id && uas.Status == models.UserAgentSessionStatusActive {
+		if err = m.userAgentSessionManager.Terminate(ctx, uas.Id, true); err != nil {
+			return fmt.Errorf("[manager.ActiveSessionManager.terminate] terminate a user agent session: %w", err)
+		}
+	}
+
+	if err = m.userSessionManager.Terminate(ctx, s.Id); err != nil {
+		return fmt.Errorf("[manager.ActiveSessionManager.terminate] terminate a user's session: %w", err)
+	}
+	return nil
+}
+
+// revoke adds the revocation of the specified user's sessions of the specified clients to the revocation list
+// and notifies other app instances of the revocation.
+func (m *ActiveSessionManager) revoke(ctx *actions.OperationContext, userId uint64, ids, clientIds []uint64) error {
+	revokedAt := datetime.Now()
+	m.revocationList.Add(userId, ids, clientIds, revokedAt)
+
+	if err := m.revocationNotifier.Notify(ctx, userId, ids, clientIds, revokedAt); err != nil {
+		return fmt.Errorf("[manager.ActiveSessionManager.revoke] notify other app instances of the revocation: %w", err)
+	}
+	return nil
+}
+
+// IsSessionRevoked returns true if the user's session by the specified ID has been revoked.
+// The revocation list is only checked, so the DB isn't queried.
+func (m *ActiveSessionManager) IsSessionRevoked(userSessionId uint64) bool {
+	return m.revocationList.Contains(userSessionId)
+}
+
+// IsRevoked returns true if the user's session of the specified client has been revoked.
+//
+// It's used when the user's session ID isn't known, e.g. the result of the user's authentication
+// contains only the user ID and the client ID. If the revocation list doesn't contain the revocation
+// of the user's session of the client, then the DB isn't queried. Otherwise, the session isn't considered
+// revoked if the user has signed in with the client again after the revocation. The revocation
+// isn't removed in this case, because the revoked session's tokens must be rejected
+// by IsSessionRevoked wherever the user's session ID is known.
+func (m *ActiveSessionManager) IsRevoked(ctx *actions.OperationContext, userId, clientId uint64) (bool, error) {
+	revokedAt, ok := m.revocationList.Get(userId, clientId)
+	if !ok {
+		return false, nil
+	}
+
+	isRevoked := true
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeActiveSessionManager_IsRevoked,
+		[]*actions.OperationParam{actions.NewOperationParam("userId", userId), actions.NewOperationParam("clientId", clientId)},
+		func(opCtx *actions.OperationContext) error {
+			ss, err := m.userSessionManager.GetAllByUserIdAndClientId(opCtx, userId, clientId, true)
+			if err != nil {
+				return fmt.Errorf("[manager.ActiveSessionManager.IsRevoked] get all user's sessions by user id and client id: %w", err)
+			}
+
+			for _, s := range ss {
+				if s.Status == models.UserSessionStatusActive && s.StartTime != nil && s.StartTime.After(revokedAt) {
+					// the user has signed in again after the revocation
+					isRevoked = false
+					break
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[manager.ActiveSessionManager.IsRevoked] execute an operation: %w", err)
+	}
+	return isRevoked, nil
+}
@@ -114,3 +114,23 @@ type UserAgentSessionManager interface {
 	// GetStatusById gets a user agent session status by the specified user agent session ID.
 	GetStatusById(ctx *actions.OperationContext, id uint64) (models.UserAgentSessionStatus, error)
 }
+
+// ActiveSessionManager is a manager of the users' active sessions.
+type ActiveSessionManager interface {
+	// GetAllByUserId gets all active sessions of the user by the specified user ID.
+	GetAllByUserId(ctx *actions.OperationContext, userId uint64) ([]*models.ActiveSession, error)
+
+	// Revoke revokes the user's session by the specified user ID and user's session ID.
+	// The session is ended and the tokens created for it are no longer valid in all app instances.
+	Revoke(ctx *actions.OperationContext, userId, id uint64) error
+
+	// RevokeAllOther revokes all active sessions of the user except the session of the specified client
+	// and returns the number of revoked sessions.
+	RevokeAllOther(ctx *actions.OperationContext, userId, currentClientId uint64) (int, error)
+
+	// IsSessionRevoked returns true if the user's session by the specified ID has been revoked.
+	IsSessionRevoked(userSessionId uint64) bool
+
+	// IsRevoked returns true if the user's session of the specified client has been revoked.
+	IsRevoked(ctx *actions.OperationContext, userId, clientId uint64) (bool, error)
+}
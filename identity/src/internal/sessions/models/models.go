@@ -14,7 +14,10 @@
 
 package models
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // The user's session type.
 type UserSessionType uint8
@@ -101,3 +104,30 @@ const (
 	UserAgentSessionStatusDeleting             UserAgentSessionStatus = 8
 	UserAgentSessionStatusDeleted              UserAgentSessionStatus = 9
 )
+
+// The active session of the user.
+type ActiveSession struct {
+	// The user's session ID.
+	Id uint64
+
+	// The client ID.
+	ClientId uint64
+
+	// The user's session type.
+	Type UserSessionType
+
+	// The last user agent of the client, if any.
+	UserAgent *string
+
+	// The start time of the user's session.
+	StartTime time.Time
+
+	// The first IP address (sign-in IP address).
+	FirstIP string
+
+	// The last activity time.
+	LastActivityTime *time.Time
+
+	// The last activity IP address.
+	LastActivityIP *string
+}
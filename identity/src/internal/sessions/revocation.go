@@ -0,0 +1,45 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions
+
+import (
+	"time"
+
+	"personal-website-v2/pkg/actions"
+)
+
+// SessionRevocationList is an in-process list of the revocations of the users' sessions.
+// The revocation is kept for the lifetime of the user's token.
+type SessionRevocationList interface {
+	// Add adds the revocation of the specified user's sessions and of the user's sessions of the specified clients.
+	Add(userId uint64, userSessionIds, clientIds []uint64, revokedAt time.Time)
+
+	// Contains returns true if the user's session has been revoked.
+	Contains(userSessionId uint64) bool
+
+	// Get returns the date and time at which the user's session of the specified client was last revoked, if any.
+	// It's used for the tokens that don't carry the user's session ID, but carry the time at which they were issued.
+	Get(userId, clientId uint64) (time.Time, bool)
+
+	// Len returns the number of revocations in the list.
+	Len() int
+}
+
+// SessionRevocationNotifier notifies other app instances of the revocation of the user's sessions.
+type SessionRevocationNotifier interface {
+	// Notify notifies other app instances of the revocation of the specified user's sessions
+	// of the specified clients.
+	Notify(ctx *actions.OperationContext, userId uint64, userSessionIds, clientIds []uint64, revokedAt time.Time) error
+}
@@ -0,0 +1,292 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/proto"
+
+	sessionspb "personal-website-v2/go-data/identity/sessions"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	saramautil "personal-website-v2/pkg/components/kafka/utils/sarama"
+	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	defaultConsumerKafkaClientId = "IdentitySessionRevocationNotification"
+)
+
+type SessionRevocationNotificationServiceConfig struct {
+	Kafka *SessionRevocationNotificationServiceKafkaConfig
+}
+
+type SessionRevocationNotificationServiceKafkaConfig struct {
+	Config *kafka.Config
+
+	// The topic from which revocations are consumed.
+	// The retention period of the topic must not be less than the lifetime of the user's token.
+	Topic string
+}
+
+// SessionRevocationNotificationService consumes the revocations of the users' sessions sent by other app instances
+// and adds them to the revocation list of the current app instance.
+//
+// Each app instance must receive all revocations, therefore the consumer group isn't used,
+// and all partitions of the topic are consumed starting from the oldest offset, so that
+// a new app instance receives the revocations that haven't expired yet.
+type SessionRevocationNotificationService struct {
+	appSessionId       uint64
+	revocationList     sessions.SessionRevocationList
+	config             *SessionRevocationNotificationServiceConfig
+	consumer           sarama.Consumer
+	partitionConsumers []sarama.PartitionConsumer
+	logger             logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx          *lcontext.LogEntryContext
+	isStarted          atomic.Bool
+	isStopped          bool
+	mu                 sync.Mutex
+	wg                 sync.WaitGroup
+}
+
+func NewSessionRevocationNotificationService(
+	appSessionId uint64,
+	revocationList sessions.SessionRevocationList,
+	config *SessionRevocationNotificationServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*SessionRevocationNotificationService, error) {
+	l, err := loggerFactory.CreateLogger("internal.sessions.revocation.notification.SessionRevocationNotificationService")
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewSessionRevocationNotificationService] create a logger: %w", err)
+	}
+
+	return &SessionRevocationNotificationService{
+		appSessionId:   appSessionId,
+		revocationList: revocationList,
+		config:         config,
+		logger:         l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}, nil
+}
+
+func (s *SessionRevocationNotificationService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the SessionRevocationNotificationService.
+func (s *SessionRevocationNotificationService) Start() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[notification.SessionRevocationNotificationService.Start] SessionRevocationNotificationService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[notification.SessionRevocationNotificationService.Start] SessionRevocationNotificationService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+		"[notification.SessionRevocationNotificationService.Start] starting the SessionRevocationNotificationService...",
+	)
+
+	c, err := s.config.Kafka.Config.SaramaConfig()
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotificationService.Start] get a sarama config: %w", err)
+	}
+
+	if len(s.config.Kafka.Config.ClientId) == 0 {
+		c.ClientID = defaultConsumerKafkaClientId
+	}
+
+	consumer, err := sarama.NewConsumer(s.config.Kafka.Config.Addrs, c)
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotificationService.Start] new consumer: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			s.closeConsumers(consumer, s.partitionConsumers)
+			s.partitionConsumers = nil
+		}
+	}()
+
+	ps, err := consumer.Partitions(s.config.Kafka.Topic)
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotificationService.Start] get the partition ids of the topic: %w", err)
+	}
+
+	s.partitionConsumers = make([]sarama.PartitionConsumer, 0, len(ps))
+	for _, p := range ps {
+		pc, err := consumer.ConsumePartition(s.config.Kafka.Topic, p, sarama.OffsetOldest)
+		if err != nil {
+			return fmt.Errorf("[notification.SessionRevocationNotificationService.Start] consume a partition: %w", err)
+		}
+		s.partitionConsumers = append(s.partitionConsumers, pc)
+	}
+
+	s.consumer = consumer
+	s.wg.Add(len(s.partitionConsumers))
+	for _, pc := range s.partitionConsumers {
+		go s.consumePartition(pc)
+	}
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+		"[notification.SessionRevocationNotificationService.Start] SessionRevocationNotificationService has been started",
+		logging.NewField("topic", s.config.Kafka.Topic),
+		logging.NewField("partitions", ps),
+	)
+	return nil
+}
+
+// Stop stops the SessionRevocationNotificationService.
+func (s *SessionRevocationNotificationService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[notification.SessionRevocationNotificationService.Stop] SessionRevocationNotificationService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+		"[notification.SessionRevocationNotificationService.Stop] stopping the SessionRevocationNotificationService...",
+	)
+	s.closeConsumers(s.consumer, s.partitionConsumers)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+		"[notification.SessionRevocationNotificationService.Stop] SessionRevocationNotificationService has been stopped",
+	)
+	return nil
+}
+
+func (s *SessionRevocationNotificationService) closeConsumers(consumer sarama.Consumer, partitionConsumers []sarama.PartitionConsumer) {
+	for _, pc := range partitionConsumers {
+		// the Messages and Errors channels are closed after the partition consumer is closed
+		pc.AsyncClose()
+	}
+
+	if err := consumer.Close(); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent, err,
+			"[notification.SessionRevocationNotificationService.closeConsumers] close a consumer",
+		)
+	}
+}
+
+func (s *SessionRevocationNotificationService) consumePartition(pc sarama.PartitionConsumer) {
+	defer s.wg.Done()
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[notification.SessionRevocationNotificationService.consumePartition] panic: ", p.Value), p.StackTrace),
+			"[notification.SessionRevocationNotificationService.consumePartition] panic while consuming a partition",
+		)
+	})
+
+	errCh := pc.Errors()
+	msgCh := pc.Messages()
+	for errCh != nil || msgCh != nil {
+		select {
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			// revocations may have been missed
+			s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent, err,
+				"[notification.SessionRevocationNotificationService.consumePartition] error while consuming a partition",
+			)
+		case msg, ok := <-msgCh:
+			if !ok {
+				msgCh = nil
+				continue
+			}
+			s.processMessage(msg)
+		}
+	}
+}
+
+func (s *SessionRevocationNotificationService) processMessage(msg *sarama.ConsumerMessage) {
+	fs := []*logging.Field{
+		logging.NewField("topic", msg.Topic),
+		logging.NewField("partition", msg.Partition),
+		logging.NewField("offset", msg.Offset),
+		nil,
+	}
+
+	if msgIdH := saramautil.GetHeader(msg.Headers, metadata.MessageIdMDKey); msgIdH != nil {
+		if msgId, err := metadata.DecodeMessageId(msgIdH.Value); err != nil {
+			s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent, err,
+				"[notification.SessionRevocationNotificationService.processMessage] decode the message id", fs[:3]...,
+			)
+			fs = fs[:3]
+		} else {
+			fs[3] = logging.NewField("_msgId", msgId)
+		}
+	} else {
+		fs = fs[:3]
+	}
+
+	r := new(sessionspb.SessionRevocation)
+	if err := proto.Unmarshal(msg.Value, r); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent, err,
+			"[notification.SessionRevocationNotificationService.processMessage] unmarshal the Protobuf-encoded revocation", fs...,
+		)
+		return
+	}
+
+	if r.Metadata != nil && r.Metadata.AppSessionId == s.appSessionId {
+		// the revocation has already been added to the revocation list of the current app instance
+		return
+	}
+
+	if r.CreatedAt == nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.SessionRevocationEvent, nil,
+			"[notification.SessionRevocationNotificationService.processMessage] revocation time is missing",
+			append(fs,
+				logging.NewField("userId", r.UserId),
+				logging.NewField("userSessionIds", r.UserSessionIds),
+				logging.NewField("clientIds", r.ClientIds),
+			)...,
+		)
+		return
+	}
+
+	s.revocationList.Add(r.UserId, r.UserSessionIds, r.ClientIds, r.CreatedAt.AsTime())
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.SessionRevocationEvent,
+		"[notification.SessionRevocationNotificationService.processMessage] revocation of the user's sessions has been added",
+		append(fs,
+			logging.NewField("userId", r.UserId),
+			logging.NewField("userSessionIds", r.UserSessionIds),
+			logging.NewField("clientIds", r.ClientIds),
+			logging.NewField("revokedAt", r.CreatedAt.AsTime()),
+		)...,
+	)
+}
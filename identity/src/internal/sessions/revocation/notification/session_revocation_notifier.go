@@ -0,0 +1,225 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sessionspb "personal-website-v2/go-data/identity/sessions"
+	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/logging/events"
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/pkg/actions"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	errs "personal-website-v2/pkg/errors"
+	actionhelper "personal-website-v2/pkg/helper/actions"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+)
+
+const (
+	defaultProducerKafkaClientId = "IdentitySessionRevocationNotifier"
+)
+
+type SessionRevocationNotifierConfig struct {
+	Kafka *SessionRevocationNotifierKafkaConfig
+}
+
+type SessionRevocationNotifierKafkaConfig struct {
+	Config        *kafka.Config
+	AsyncProducer bool
+
+	// The topic to which revocations are sent.
+	Topic string
+}
+
+// SessionRevocationNotifier sends the revocations of the users' sessions to Kafka
+// to notify other app instances of the revocations.
+type SessionRevocationNotifier struct {
+	appSessionId    uint64
+	config          *SessionRevocationNotifierConfig
+	opExecutor      *actionhelper.OperationExecutor
+	kMsgIdGenerator *kafka.MessageIdGenerator
+	producer        kafka.Producer
+	logger          logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx       *lcontext.LogEntryContext
+	disposed        atomic.Bool
+}
+
+var _ sessions.SessionRevocationNotifier = (*SessionRevocationNotifier)(nil)
+
+func NewSessionRevocationNotifier(
+	appSessionId uint64,
+	config *SessionRevocationNotifierConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*SessionRevocationNotifier, error) {
+	l, err := loggerFactory.CreateLogger("internal.sessions.revocation.notification.SessionRevocationNotifier")
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewSessionRevocationNotifier] create a logger: %w", err)
+	}
+
+	c := &actionhelper.OperationExecutorConfig{
+		DefaultCategory: actions.OperationCategoryCommon,
+		DefaultGroup:    iactions.OperationGroupActiveSession,
+		StopAppIfError:  true,
+	}
+	e, err := actionhelper.NewOperationExecutor(c, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewSessionRevocationNotifier] new operation executor: %w", err)
+	}
+
+	n := &SessionRevocationNotifier{
+		appSessionId: appSessionId,
+		config:       config,
+		opExecutor:   e,
+		logger:       l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}
+
+	if config.Kafka.Config.Producer.OnCompletion == nil {
+		config.Kafka.Config.Producer.OnCompletion = n.onCompletion
+	}
+	if len(config.Kafka.Config.ClientId) == 0 {
+		config.Kafka.Config.ClientId = defaultProducerKafkaClientId
+	}
+
+	p, err := kafka.NewProducer(config.Kafka.Config, config.Kafka.AsyncProducer)
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewSessionRevocationNotifier] new producer: %w", err)
+	}
+
+	kMsgIdGenerator, err := kafka.NewMessageIdGenerator(appSessionId, uint32(runtime.NumCPU()*2))
+	if err != nil {
+		return nil, fmt.Errorf("[notification.NewSessionRevocationNotifier] new message id generator: %w", err)
+	}
+
+	n.kMsgIdGenerator = kMsgIdGenerator
+	n.producer = p
+	return n, nil
+}
+
+// Notify notifies other app instances of the revocation of the specified user's sessions
+// of the specified clients.
+func (n *SessionRevocationNotifier) Notify(ctx *actions.OperationContext, userId uint64, userSessionIds, clientIds []uint64, revokedAt time.Time) error {
+	if n.disposed.Load() {
+		return errors.New("[notification.SessionRevocationNotifier.Notify] SessionRevocationNotifier was disposed")
+	}
+
+	err := n.opExecutor.Exec(ctx, iactions.OperationTypeSessionRevocationNotifier_Notify,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId),
+			actions.NewOperationParam("userSessionIds", userSessionIds),
+			actions.NewOperationParam("clientIds", clientIds),
+			actions.NewOperationParam("revokedAt", revokedAt),
+		},
+		func(opCtx *actions.OperationContext) error {
+			if len(userSessionIds) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of user's session ids is 0")
+			}
+
+			r := &sessionspb.SessionRevocation{
+				UserId:         userId,
+				UserSessionIds: userSessionIds,
+				CreatedAt:      timestamppb.New(revokedAt),
+				ClientIds:      clientIds,
+			}
+			if err := n.send(opCtx, r); err != nil {
+				return fmt.Errorf("[notification.SessionRevocationNotifier.Notify] send a revocation: %w", err)
+			}
+
+			n.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.SessionRevocationEvent,
+				"[notification.SessionRevocationNotifier.Notify] revocation of the user's sessions has been sent",
+				logging.NewField("userId", userId),
+				logging.NewField("userSessionIds", userSessionIds),
+				logging.NewField("clientIds", clientIds),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotifier.Notify] execute an operation: %w", err)
+	}
+	return nil
+}
+
+func (n *SessionRevocationNotifier) send(ctx *actions.OperationContext, r *sessionspb.SessionRevocation) error {
+	tranId := ctx.Transaction.Id()
+	r.Metadata = &sessionspb.SessionRevocationMetadata{
+		AppSessionId: n.appSessionId,
+		TranId:       tranId.String(),
+	}
+
+	b, err := proto.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotifier.send] marshal a revocation to Protobuf: %w", err)
+	}
+
+	msgId, err := n.kMsgIdGenerator.Get()
+	if err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotifier.send] get id from kMsgIdGenerator: %w", err)
+	}
+
+	msg := &kafka.ProducerMessage{
+		Topic:    n.config.Kafka.Topic,
+		Headers:  kafka.RecordHeaders{metadata.MessageIdHeader(msgId)},
+		Key:      tranId[:],
+		Value:    b,
+		Metadata: r,
+	}
+
+	if err = n.producer.SendMessage(msg); err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotifier.send] send a message: %w", err)
+	}
+	return nil
+}
+
+func (n *SessionRevocationNotifier) onCompletion(msg *kafka.ProducerMessage, err error) {
+	if err == nil {
+		return
+	}
+
+	r := msg.Metadata.(*sessionspb.SessionRevocation)
+	n.logger.ErrorWithEvent(n.loggerCtx, events.SessionRevocationEvent, err,
+		"[notification.SessionRevocationNotifier.onCompletion] an error occurred while sending a revocation to kafka",
+		logging.NewField("userId", r.UserId),
+		logging.NewField("userSessionIds", r.UserSessionIds),
+		logging.NewField("clientIds", r.ClientIds),
+	)
+}
+
+// Dispose disposes of the SessionRevocationNotifier.
+func (n *SessionRevocationNotifier) Dispose() error {
+	if n.disposed.Load() {
+		return nil
+	}
+
+	if err := n.producer.Close(); err != nil {
+		return fmt.Errorf("[notification.SessionRevocationNotifier.Dispose] close a producer: %w", err)
+	}
+
+	n.disposed.Store(true)
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"personal-website-v2/identity/src/internal/sessions"
+	"personal-website-v2/pkg/base/datetime"
+)
+
+type SessionRevocationListConfig struct {
+	// The revocation lifetime. It must not be less than the lifetime of the user's token.
+	TTL time.Duration
+}
+
+type revocationKey struct {
+	userId   uint64
+	clientId uint64
+}
+
+// SessionRevocationList is an in-process list of the revocations of the users' sessions.
+// The expired revocations are removed when the revocations are added.
+type SessionRevocationList struct {
+	ttl               time.Duration
+	revocations       map[uint64]time.Time        // map[UserSessionId]RevokedAt
+	clientRevocations map[revocationKey]time.Time // map[(UserId, ClientId)]RevokedAt
+	nextCleanupTime   time.Time
+	mu                sync.RWMutex
+}
+
+var _ sessions.SessionRevocationList = (*SessionRevocationList)(nil)
+
+func NewSessionRevocationList(config *SessionRevocationListConfig) (*SessionRevocationList, error) {
+	if config.TTL <= 0 {
+		return nil, fmt.Errorf("[revocation.NewSessionRevocationList] ttl out of range (%s) (ttl must be greater than 0)", config.TTL)
+	}
+
+	return &SessionRevocationList{
+		ttl:               config.TTL,
+		revocations:       make(map[uint64]time.Time),
+		clientRevocations: make(map[revocationKey]time.Time),
+		nextCleanupTime:   datetime.Now().Add(config.TTL),
+	}, nil
+}
+
+// Add adds the revocation of the specified user's sessions and of the user's sessions of the specified clients.
+// If the revocation has already expired, then it is ignored.
+func (l *SessionRevocationList) Add(userId uint64, userSessionIds, clientIds []uint64, revokedAt time.Time) {
+	now := datetime.Now()
+	if !now.Before(revokedAt.Add(l.ttl)) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, id := range userSessionIds {
+		if t, ok := l.revocations[id]; !ok || t.Before(revokedAt) {
+			l.revocations[id] = revokedAt
+		}
+	}
+
+	for _, clientId := range clientIds {
+		k := revocationKey{userId: userId, clientId: clientId}
+		if t, ok := l.clientRevocations[k]; !ok || t.Before(revokedAt) {
+			l.clientRevocations[k] = revokedAt
+		}
+	}
+
+	if !now.Before(l.nextCleanupTime) {
+		l.removeExpired(now)
+		l.nextCleanupTime = now.Add(l.ttl)
+	}
+}
+
+// Contains returns true if the user's session has been revoked.
+func (l *SessionRevocationList) Contains(userSessionId uint64) bool {
+	l.mu.RLock()
+	t, ok := l.revocations[userSessionId]
+	l.mu.RUnlock()
+	return ok && datetime.Now().Before(t.Add(l.ttl))
+}
+
+// Get returns the date and time at which the user's session of the specified client was last revoked, if any.
+func (l *SessionRevocationList) Get(userId, clientId uint64) (time.Time, bool) {
+	l.mu.RLock()
+	t, ok := l.clientRevocations[revocationKey{userId: userId, clientId: clientId}]
+	l.mu.RUnlock()
+
+	if !ok || !datetime.Now().Before(t.Add(l.ttl)) {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Len returns the number of revocations in the list (including expired revocations that haven't been removed yet).
+func (l *SessionRevocationList) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.revocations) + len(l.clientRevocations)
+}
+
+func (l *SessionRevocationList) removeExpired(now time.Time) {
+	for k, t := range l.revocations {
+		if !now.Before(t.Add(l.ttl)) {
+			delete(l.revocations, k)
+		}
+	}
+
+	for k, t := range l.clientRevocations {
+		if !now.Before(t.Add(l.ttl)) {
+			delete(l.clientRevocations, k)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/google/uuid"
 
 	iactions "personal-website-v2/identity/src/internal/actions"
+	"personal-website-v2/identity/src/internal/authorization"
 	groupmodels "personal-website-v2/identity/src/internal/groups/models"
 	"personal-website-v2/identity/src/internal/logging/events"
 	"personal-website-v2/identity/src/internal/users"
@@ -38,14 +39,19 @@ import (
 
 // UserManager is a user manager.
 type UserManager struct {
-	opExecutor *actionhelper.OperationExecutor
-	userStore  users.UserStore
-	logger     logging.Logger[*context.LogEntryContext]
+	opExecutor            *actionhelper.OperationExecutor
+	userStore             users.UserStore
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator
+	logger                logging.Logger[*context.LogEntryContext]
 }
 
 var _ users.UserManager = (*UserManager)(nil)
 
-func NewUserManager(userStore users.UserStore, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*UserManager, error) {
+func NewUserManager(
+	userStore users.UserStore,
+	authzCacheInvalidator authorization.AuthorizationCacheInvalidator,
+	loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*UserManager, error) {
 	l, err := loggerFactory.CreateLogger("internal.users.manager.UserManager")
 	if err != nil {
 		return nil, fmt.Errorf("[manager.NewUserManager] create a logger: %w", err)
@@ -63,9 +69,10 @@ func NewUserManager(userStore users.UserStore, loggerFactory logging.LoggerFacto
 	}
 
 	return &UserManager{
-		opExecutor: e,
-		userStore:  userStore,
-		logger:     l,
+		opExecutor:            e,
+		userStore:             userStore,
+		authzCacheInvalidator: authzCacheInvalidator,
+		logger:                l,
 	}, nil
 }
 
@@ -115,6 +122,12 @@ func (m *UserManager) Delete(ctx *actions.OperationContext, id uint64) error {
 				return fmt.Errorf("[manager.UserManager.Delete] delete a user: %w", err)
 			}
 
+			if err := m.authzCacheInvalidator.InvalidateUser(opCtx, id); err != nil {
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.UserEvent, err,
+					"[manager.UserManager.Delete] invalidate the authorization cache",
+				)
+			}
+
 			m.logger.InfoWithEvent(
 				opCtx.CreateLogEntryContext(),
 				events.UserEvent,
@@ -490,3 +503,27 @@ func (m *UserManager) GetGroupAndStatusById(ctx *actions.OperationContext, id ui
 	succeeded = true
 	return g, s, nil
 }
+
+// Anonymize anonymizes the personal data of the user (name, email, IP addresses, personal info)
+// by the specified user ID.
+func (m *UserManager) Anonymize(ctx *actions.OperationContext, id uint64) error {
+	err := m.opExecutor.Exec(ctx, iactions.OperationTypeUserManager_Anonymize, []*actions.OperationParam{actions.NewOperationParam("id", id)},
+		func(opCtx *actions.OperationContext) error {
+			if err := m.userStore.Anonymize(opCtx, id); err != nil {
+				return fmt.Errorf("[manager.UserManager.Anonymize] anonymize a user: %w", err)
+			}
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.UserEvent,
+				"[manager.UserManager.Anonymize] user has been anonymized",
+				logging.NewField("id", id),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("[manager.UserManager.Anonymize] execute an operation: %w", err)
+	}
+	return nil
+}
@@ -57,10 +57,11 @@ func (d *CreateOperationData) Validate() *errors.Error {
 	if !d.Type.IsValid() {
 		return errors.NewError(errors.ErrorCodeInvalidData, "invalid type")
 	}
-	if !d.Group.IsValid() || d.Group == groupmodels.UserGroupAnonymousUsers {
+	if !d.Group.IsBuiltIn() || d.Group == groupmodels.UserGroupAnonymousUsers {
 		return errors.NewError(errors.ErrorCodeInvalidData, "invalid group")
 	}
-	if d.Status != models.UserStatusPendingApproval && d.Status != models.UserStatusActive {
+	// users with the status 'New' are created by the registration (the email must be verified)
+	if d.Status != models.UserStatusNew && d.Status != models.UserStatusPendingApproval && d.Status != models.UserStatusActive {
 		return errors.NewError(errors.ErrorCodeInvalidData, "invalid status")
 	}
 
@@ -69,6 +69,10 @@ type UserStore interface {
 
 	// GetGroupAndStatusById gets a group and a status of the user by the specified user ID.
 	GetGroupAndStatusById(ctx *actions.OperationContext, id uint64) (groupmodels.UserGroup, models.UserStatus, error)
+
+	// Anonymize anonymizes the personal data of the user (name, email, IP addresses, personal info)
+	// by the specified user ID.
+	Anonymize(ctx *actions.OperationContext, id uint64) error
 }
 
 // UserPersonalInfoStore is a store of users' personal info.
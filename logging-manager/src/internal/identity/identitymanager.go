@@ -69,16 +69,25 @@ func (m *startupIdentityManager) Init() error {
 	return nil
 }
 
-func (m *startupIdentityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64]) (identity.Identity, error) {
+func (m *startupIdentityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId, impersonatorId, apiKeyId nullable.Nullable[uint64],
+) (identity.Identity, error) {
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *identity.DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateById,
-		[]*actions.OperationParam{actions.NewOperationParam("userId", userId.Ptr()), actions.NewOperationParam("clientId", clientId.Ptr())},
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId.Ptr()),
+			actions.NewOperationParam("clientId", clientId.Ptr()),
+			actions.NewOperationParam("impersonatorId", impersonatorId.Ptr()),
+			actions.NewOperationParam("apiKeyId", apiKeyId.Ptr()),
+		},
 		func(opCtx *actions.OperationContext) error {
-			if userId.HasValue && m.allowedUsers[userId.Value] {
+			// only the allowed users themselves can be authenticated, impersonation and API keys aren't supported
+			if userId.HasValue && !impersonatorId.HasValue && !apiKeyId.HasValue && m.allowedUsers[userId.Value] {
 				i = identity.NewDefaultIdentity(userId, identity.UserTypeUser, nullable.Nullable[uint64]{})
 
 				m.logger.InfoWithEvent(opCtx.CreateLogEntryContext(), events.Identity_UserAuthenticated,
@@ -101,6 +110,8 @@ func (m *startupIdentityManager) AuthenticateByToken(ctx *actions.OperationConte
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *identity.DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByToken, nil,
@@ -115,10 +126,32 @@ func (m *startupIdentityManager) AuthenticateByToken(ctx *actions.OperationConte
 	return i, nil
 }
 
+func (m *startupIdentityManager) AuthenticateByApiKey(ctx *actions.OperationContext, apiKey []byte) (identity.Identity, error) {
+	ctx = ctx.Clone()
+	ctx.UserId = nullable.NewNullable(m.appUserId)
+	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
+
+	var i *identity.DefaultIdentity
+	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByApiKey, nil,
+		func(opCtx *actions.OperationContext) error {
+			i = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.startupIdentityManager.AuthenticateByApiKey] execute an operation: %w", err)
+	}
+	return i, nil
+}
+
 func (m *startupIdentityManager) Authorize(ctx *actions.OperationContext, user identity.Identity, requiredPermissions []string) (bool, error) {
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	authorized := false
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_Authorize,
@@ -166,3 +199,10 @@ func (m *startupIdentityManager) Authorize(ctx *actions.OperationContext, user i
 	}
 	return authorized, nil
 }
+
+// AuthorizeResources authorizes a user in the same way as Authorize, because
+// the permissions of the allowed users aren't scoped to resources.
+func (m *startupIdentityManager) AuthorizeResources(ctx *actions.OperationContext, user identity.Identity, requiredPermissions []string, resources []*identity.Resource,
+) (bool, error) {
+	return m.Authorize(ctx, user, requiredPermissions)
+}
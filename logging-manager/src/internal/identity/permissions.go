@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "loggingmanager.app.stop"
+	PermissionApp_Stop       = "loggingmanager.app.stop"
+	PermissionApp_GetMetrics = "loggingmanager.app.getMetrics"
 
 	// Logging session permissions.
 	PermissionLoggingSession_CreateAndStart = "loggingmanager.loggingSessions.createAndStart"
@@ -26,6 +27,7 @@ const (
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionLoggingSession_CreateAndStart,
 	PermissionLoggingSession_Get,
 }
@@ -140,6 +140,8 @@ func (m *LoggingSessionManager) checkApp(ctx *actions.OperationContext, appId ui
 		ctx = ctx.Clone()
 		ctx.UserId = nullable.NewNullable(m.appUserId)
 		ctx.ClientId = nullable.Nullable[uint64]{}
+		ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+		ctx.ApiKeyId = nullable.Nullable[uint64]{}
 		leCtx = ctx.CreateLogEntryContext()
 
 		as, err = m.apps.GetStatusByIdWithContext(ctx, appId)
@@ -157,6 +157,8 @@ func (m *ActionManager) CreateAndStart(
 	m.wgInProgress.Add(1)
 	atomic.AddUint64(m.numCreated, 1)
 	atomic.AddInt64(m.numInProgress, 1)
+	actionsCreatedTotal.Inc()
+	actionsInProgress.Inc()
 	return a, nil
 }
 
@@ -181,8 +183,10 @@ func (m *ActionManager) Complete(a *Action, succeeded bool) (err error) {
 		return fmt.Errorf("[actions.ActionManager.Complete] complete an action: %w", err2)
 	}
 
+	observeActionDuration(a)
 	defer func() {
 		atomic.AddInt64(m.numInProgress, -1)
+		actionsInProgress.Dec()
 		m.wgInProgress.Done()
 	}()
 
@@ -21,6 +21,7 @@ const (
 	ActionTypeApplication_Start            ActionType = 1
 	ActionTypeApplication_Stop             ActionType = 2
 	ActionTypeApplication_TerminateSession ActionType = 3
+	ActionTypeApplication_GetMetrics       ActionType = 4
 
 	// Application session action types (200-299)
 	ActionTypeApplicationSession_Start     ActionType = 200
@@ -0,0 +1,57 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"strconv"
+	"time"
+
+	"personal-website-v2/pkg/metrics"
+)
+
+var (
+	actionsCreatedTotal = metrics.NewCounter("actions_created_total",
+		"Number of actions that have been created and started.")
+	actionsInProgress = metrics.NewGauge("actions_in_progress",
+		"Number of actions in progress.")
+	actionDuration = metrics.NewHistogramVec("action_duration_seconds",
+		"Duration of actions by type.", nil, "type", "status")
+	operationsCreatedTotal = metrics.NewCounter("operations_created_total",
+		"Number of operations that have been created and started.")
+	operationsInProgress = metrics.NewGauge("operations_in_progress",
+		"Number of operations in progress.")
+	operationDuration = metrics.NewHistogramVec("operation_duration_seconds",
+		"Duration of operations by type.", nil, "type", "status")
+)
+
+func init() {
+	metrics.MustRegister(actionsCreatedTotal, actionsInProgress, actionDuration, operationsCreatedTotal, operationsInProgress, operationDuration)
+}
+
+func observeActionDuration(a *Action) {
+	observeDuration(actionDuration, uint64(a.atype), a.Status() == ActionStatusSuccess, a.ElapsedTime().Value)
+}
+
+func observeOperationDuration(o *Operation) {
+	observeDuration(operationDuration, uint64(o.otype), o.Status() == OperationStatusSuccess, o.ElapsedTime().Value)
+}
+
+func observeDuration(h *metrics.HistogramVec, typ uint64, succeeded bool, elapsedTime time.Duration) {
+	status := "failure"
+	if succeeded {
+		status = "success"
+	}
+	h.WithLabelValues(strconv.FormatUint(typ, 10), status).Observe(elapsedTime.Seconds())
+}
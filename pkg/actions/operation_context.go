@@ -29,6 +29,14 @@ type OperationContext struct {
 	Ctx          context.Context
 	UserId       nullable.Nullable[uint64]
 	ClientId     nullable.Nullable[uint64]
+
+	// ImpersonatorId is the ID of the user who impersonates the user (UserId),
+	// if the operation is performed during impersonation.
+	ImpersonatorId nullable.Nullable[uint64]
+
+	// ApiKeyId is the ID of the API key, if the operation is performed
+	// by an identity authenticated with an API key.
+	ApiKeyId nullable.Nullable[uint64]
 }
 
 func NewOperationContext(ctx context.Context, appSessionId uint64, tran *Transaction, action *Action, op *Operation) *OperationContext {
@@ -48,7 +56,8 @@ func (c *OperationContext) Clone() *OperationContext {
 
 func (c *OperationContext) CreateLogEntryContext() *lcontext.LogEntryContext {
 	return &lcontext.LogEntryContext{
-		AppSessionId: nullable.NewNullable(c.AppSessionId),
+		AppSessionId:   nullable.NewNullable(c.AppSessionId),
+		ImpersonatorId: c.ImpersonatorId,
 		Transaction: &lcontext.TransactionInfo{
 			Id: c.Transaction.id,
 		},
@@ -164,6 +164,8 @@ func (m *operationManager) CreateAndStart(
 	m.wgInProgress.Add(1)
 	atomic.AddUint64(m.numCreated, 1)
 	atomic.AddInt64(m.numInProgress, 1)
+	operationsCreatedTotal.Inc()
+	operationsInProgress.Inc()
 	return o, nil
 }
 
@@ -192,8 +194,10 @@ func (m *operationManager) Complete(o *Operation, succeeded bool) (err error) {
 		return fmt.Errorf("[actions.operationManager.Complete] complete an operation: %w", err2)
 	}
 
+	observeOperationDuration(o)
 	defer func() {
 		atomic.AddInt64(m.numInProgress, -1)
+		operationsInProgress.Dec()
 		m.wgInProgress.Done()
 	}()
 
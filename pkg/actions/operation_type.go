@@ -27,10 +27,12 @@ const (
 	OperationTypeApplicationSession_Terminate OperationType = 201
 
 	// IdentityManager operation types (300-499)
-	OperationTypeIdentityManager_Authenticate        OperationType = 300
-	OperationTypeIdentityManager_AuthenticateById    OperationType = 301
-	OperationTypeIdentityManager_AuthenticateByToken OperationType = 302
-	OperationTypeIdentityManager_Authorize           OperationType = 303
+	OperationTypeIdentityManager_Authenticate         OperationType = 300
+	OperationTypeIdentityManager_AuthenticateById     OperationType = 301
+	OperationTypeIdentityManager_AuthenticateByToken  OperationType = 302
+	OperationTypeIdentityManager_Authorize            OperationType = 303
+	OperationTypeIdentityManager_AuthorizeResources   OperationType = 304
+	OperationTypeIdentityManager_AuthenticateByApiKey OperationType = 305
 
 	// NetHttpServer_RequestPipelineLifetime operation types (500-549)
 	OperationTypeNetHttpServer_RequestPipelineLifetime_Authenticate OperationType = 500
@@ -41,7 +43,8 @@ const (
 	OperationTypeNetGrpcServer_RequestPipelineLifetime_Authorize    OperationType = 551
 
 	// [HTTP] ApplicationController operation types (7000-7099)
-	OperationTypeApplicationController_Stop OperationType = 7000
+	OperationTypeApplicationController_Stop       OperationType = 7000
+	OperationTypeApplicationController_GetMetrics OperationType = 7001
 
 	// [gRPC] ApplicationService operation types (8000-8099)
 
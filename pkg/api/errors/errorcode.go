@@ -42,6 +42,10 @@ const (
 	// gRPC Mapping: 1 Canceled
 	ApiErrorCodeOperationCanceled ApiErrorCode = 10504
 
+	// The operation isn't allowed for an impersonated user.
+	// HTTP Mapping: 403 Forbidden
+	ApiErrorCodeImpersonationNotAllowed ApiErrorCode = 10505
+
 	// Network Requests, Operations (11000-11999).
 	ApiErrorCodeInvalidQueryString ApiErrorCode = 11000
 	ApiErrorCodeInvalidRequestBody ApiErrorCode = 11001
@@ -26,6 +26,8 @@ var (
 	// Access denied
 	// HTTP Mapping: 403 Forbidden
 	ErrPermissionDenied = NewApiError(ApiErrorCodePermissionDenied, "forbidden")
+	// HTTP Mapping: 403 Forbidden
+	ErrImpersonationNotAllowed = NewApiError(ApiErrorCodeImpersonationNotAllowed, "operation not allowed during impersonation")
 
 	// Network Requests, Operations (11000-11999).
 	ErrInvalidQueryString = NewApiError(ApiErrorCodeInvalidQueryString, "invalid query string")
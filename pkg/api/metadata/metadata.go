@@ -16,4 +16,7 @@ package metadata
 
 const (
 	UserIdMDKey = "md_userid"
+
+	// AuthorizationMDKey is the key of the metadata that contains a bearer token (e.g. an API key).
+	AuthorizationMDKey = "authorization"
 )
@@ -29,29 +29,35 @@ import (
 const OperationContextMDKey = "md_opctx"
 
 type OperationContext struct {
-	TransactionId uuid.UUID
-	ActionId      uuid.UUID
-	OperationId   uuid.UUID
-	UserId        nullable.Nullable[uint64]
-	ClientId      nullable.Nullable[uint64]
+	TransactionId  uuid.UUID
+	ActionId       uuid.UUID
+	OperationId    uuid.UUID
+	UserId         nullable.Nullable[uint64]
+	ClientId       nullable.Nullable[uint64]
+	ImpersonatorId nullable.Nullable[uint64]
+	ApiKeyId       nullable.Nullable[uint64]
 }
 
 func NewOperationContext(ctx *actions.OperationContext) *OperationContext {
 	return &OperationContext{
-		TransactionId: ctx.Transaction.Id(),
-		ActionId:      ctx.Action.Id(),
-		OperationId:   ctx.Operation.Id(),
-		UserId:        ctx.UserId,
-		ClientId:      ctx.ClientId,
+		TransactionId:  ctx.Transaction.Id(),
+		ActionId:       ctx.Action.Id(),
+		OperationId:    ctx.Operation.Id(),
+		UserId:         ctx.UserId,
+		ClientId:       ctx.ClientId,
+		ImpersonatorId: ctx.ImpersonatorId,
+		ApiKeyId:       ctx.ApiKeyId,
 	}
 }
 
 type operationContext struct {
-	TranId   uuid.UUID `json:"tranId"`
-	ActionId uuid.UUID `json:"actionId"`
-	OpId     uuid.UUID `json:"opId"`
-	UserId   *uint64   `json:"userId,omitempty"`
-	ClientId *uint64   `json:"clientId,omitempty"`
+	TranId         uuid.UUID `json:"tranId"`
+	ActionId       uuid.UUID `json:"actionId"`
+	OpId           uuid.UUID `json:"opId"`
+	UserId         *uint64   `json:"userId,omitempty"`
+	ClientId       *uint64   `json:"clientId,omitempty"`
+	ImpersonatorId *uint64   `json:"impersonatorId,omitempty"`
+	ApiKeyId       *uint64   `json:"apiKeyId,omitempty"`
 }
 
 func EncodeOperationContext(ctx *OperationContext) ([]byte, error) {
@@ -96,11 +102,13 @@ func DecodeOperationContextFromString(encodedCtx string) (*OperationContext, err
 
 func serializeOperationContext(ctx *OperationContext) ([]byte, error) {
 	opCtx := &operationContext{
-		TranId:   ctx.TransactionId,
-		ActionId: ctx.ActionId,
-		OpId:     ctx.OperationId,
-		UserId:   ctx.UserId.Ptr(),
-		ClientId: ctx.ClientId.Ptr(),
+		TranId:         ctx.TransactionId,
+		ActionId:       ctx.ActionId,
+		OpId:           ctx.OperationId,
+		UserId:         ctx.UserId.Ptr(),
+		ClientId:       ctx.ClientId.Ptr(),
+		ImpersonatorId: ctx.ImpersonatorId.Ptr(),
+		ApiKeyId:       ctx.ApiKeyId.Ptr(),
 	}
 
 	b, err := json.Marshal(opCtx)
@@ -124,10 +132,12 @@ func deserializeOperationContext(serializedCtx []byte) (*OperationContext, error
 	}
 
 	return &OperationContext{
-		TransactionId: opCtx.TranId,
-		ActionId:      opCtx.ActionId,
-		OperationId:   opCtx.OpId,
-		UserId:        nullable.FromPtr(opCtx.UserId),
-		ClientId:      nullable.FromPtr(opCtx.ClientId),
+		TransactionId:  opCtx.TranId,
+		ActionId:       opCtx.ActionId,
+		OperationId:    opCtx.OpId,
+		UserId:         nullable.FromPtr(opCtx.UserId),
+		ClientId:       nullable.FromPtr(opCtx.ClientId),
+		ImpersonatorId: nullable.FromPtr(opCtx.ImpersonatorId),
+		ApiKeyId:       nullable.FromPtr(opCtx.ApiKeyId),
 	}, nil
 }
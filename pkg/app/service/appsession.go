@@ -15,6 +15,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -24,7 +25,7 @@ import (
 	"personal-website-v2/pkg/app"
 	"personal-website-v2/pkg/base/nullable"
 	"personal-website-v2/pkg/logging"
-	"personal-website-v2/pkg/logging/context"
+	lcontext "personal-website-v2/pkg/logging/context"
 	"personal-website-v2/pkg/logging/events"
 )
 
@@ -43,7 +44,7 @@ type ApplicationSession struct {
 	appId     uint64
 	userId    uint64
 	sessions  appSessions
-	logger    logging.Logger[*context.LogEntryContext]
+	logger    logging.Logger[*lcontext.LogEntryContext]
 	isStarted atomic.Bool
 	isEnded   bool
 	mu        sync.Mutex
@@ -51,7 +52,7 @@ type ApplicationSession struct {
 
 var _ app.ApplicationSession = (*ApplicationSession)(nil)
 
-func NewApplicationSession(appId uint64, userId uint64, sessions appSessions, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*ApplicationSession, error) {
+func NewApplicationSession(appId uint64, userId uint64, sessions appSessions, loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext]) (*ApplicationSession, error) {
 	l, err := loggerFactory.CreateLogger("app.service.ApplicationSession")
 
 	if err != nil {
@@ -70,6 +71,14 @@ func (s *ApplicationSession) IsStarted() bool {
 	return s.isStarted.Load()
 }
 
+// HealthCheck returns an error if the app session hasn't been started or has been ended.
+func (s *ApplicationSession) HealthCheck(ctx context.Context) error {
+	if !s.isStarted.Load() {
+		return errors.New("[service.ApplicationSession.HealthCheck] app session not started or ended")
+	}
+	return nil
+}
+
 func (s *ApplicationSession) GetId() (uint64, error) {
 	if !s.isStarted.Load() {
 		return 0, errors.New("[service.ApplicationSession.GetId] app session not started")
@@ -105,7 +114,7 @@ func (s *ApplicationSession) Start() error {
 	s.id.Store(id)
 	s.isStarted.Store(true)
 	s.logger.InfoWithEvent(
-		&context.LogEntryContext{AppSessionId: nullable.NewNullable(id)},
+		&lcontext.LogEntryContext{AppSessionId: nullable.NewNullable(id)},
 		events.ApplicationSessionStarted,
 		"[service.ApplicationSession.Start] app session has been started",
 	)
@@ -141,12 +150,12 @@ func (s *ApplicationSession) TerminateWithContext(ctx *actions.OperationContext)
 }
 
 func (s *ApplicationSession) terminate(ctx *actions.OperationContext) error {
-	var leCtx *context.LogEntryContext
+	var leCtx *lcontext.LogEntryContext
 
 	if ctx != nil {
 		leCtx = ctx.CreateLogEntryContext()
 	} else {
-		leCtx = &context.LogEntryContext{AppSessionId: nullable.NewNullable(s.id.Load())}
+		leCtx = &lcontext.LogEntryContext{AppSessionId: nullable.NewNullable(s.id.Load())}
 	}
 
 	s.logger.InfoWithEvent(
@@ -215,12 +224,12 @@ func (s *ApplicationSession) TerminateWithContext(ctx *actions.OperationContext)
 }
 
 func (s *ApplicationSession) terminate(ctx *actions.OperationContext) error {
-	var leCtx *context.LogEntryContext
+	var leCtx *lcontext.LogEntryContext
 
 	if ctx != nil {
 		leCtx = ctx.CreateLogEntryContext()
 	} else {
-		leCtx = &context.LogEntryContext{AppSessionId: nullable.NewNullable(s.id.Load())}
+		leCtx = &lcontext.LogEntryContext{AppSessionId: nullable.NewNullable(s.id.Load())}
 	}
 
 	s.logger.InfoWithEvent(
@@ -19,12 +19,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"personal-website-v2/pkg/base/nullable"
 	"personal-website-v2/pkg/db/postgres"
 	"personal-website-v2/pkg/logging"
 	grpclogging "personal-website-v2/pkg/net/grpc/logging"
 	"personal-website-v2/pkg/net/http/server/services/cors"
+	"personal-website-v2/pkg/net/tlsconfig"
 	"personal-website-v2/pkg/web/identity/authn/cookies"
 )
 
@@ -39,6 +41,7 @@ type AppConfig[TApis, TServices any] struct {
 	Db            *Db            `json:"db"`
 	Apis          TApis          `json:"apis"`
 	Auth          *Auth          `json:"auth"`
+	Identity      *Identity      `json:"identity"`
 	Services      TServices      `json:"services"`
 	Notifications *Notifications `json:"notifications"`
 }
@@ -54,6 +57,7 @@ type WebAppConfig[TApis, TServices any] struct {
 	Db            *Db            `json:"db"`
 	Apis          TApis          `json:"apis"`
 	Auth          *Auth          `json:"auth"`
+	Identity      *Identity      `json:"identity"`
 	Web           *Web           `json:"web"`
 	Services      TServices      `json:"services"`
 	Notifications *Notifications `json:"notifications"`
@@ -128,8 +132,14 @@ type HttpServer struct {
 	ReadTimeout  int64               `json:"readTimeout"`  // in milliseconds
 	WriteTimeout int64               `json:"writeTimeout"` // in milliseconds
 	IdleTimeout  int64               `json:"idleTimeout"`  // in milliseconds
+	TLS          *ServerTLS          `json:"tls"`          // optional
 	Logging      *HttpServerLogging  `json:"logging"`
 	Services     *HttpServerServices `json:"services"`
+
+	// If true, the server serves the liveness (/healthz) and readiness (/readyz) probes.
+	// The probes aren't authenticated, so they should only be enabled on a server
+	// that isn't exposed publicly.
+	HealthProbes bool `json:"healthProbes"`
 }
 
 type HttpServerLogging struct {
@@ -178,6 +188,36 @@ func (c *Cors) Options() *cors.Options {
 	}
 }
 
+type ServerTLS struct {
+	// The certificate file (PEM).
+	CertFile string `json:"certFile"`
+
+	// The private key file (PEM).
+	KeyFile string `json:"keyFile"`
+
+	// Optional. The file of the CA certificates (PEM) used to verify the client certificates.
+	// If it's specified, mutual TLS is used.
+	ClientCAFile string `json:"clientCAFile"`
+
+	// Optional. The names of the apps allowed to connect if mutual TLS is used.
+	// The common name and the DNS names of the client certificate are matched against them.
+	AllowedClientNames []string `json:"allowedClientNames"`
+
+	// The interval (in seconds) at which the files are checked for changes.
+	// If it's 0, the files aren't reloaded.
+	ReloadInterval int64 `json:"reloadInterval"`
+}
+
+func (t *ServerTLS) Options() *tlsconfig.ServerOptions {
+	return &tlsconfig.ServerOptions{
+		CertFile:           t.CertFile,
+		KeyFile:            t.KeyFile,
+		ClientCAFile:       t.ClientCAFile,
+		AllowedClientNames: t.AllowedClientNames,
+		ReloadInterval:     time.Duration(t.ReloadInterval) * time.Second,
+	}
+}
+
 type Grpc struct {
 	Logging *GrpcLogging `json:"logging"`
 	Server  *GrpcServer  `json:"server"`
@@ -190,6 +230,7 @@ type GrpcLogging struct {
 
 type GrpcServer struct {
 	Addr    string             `json:"addr"`
+	TLS     *ServerTLS         `json:"tls"` // optional
 	Logging *GrpcServerLogging `json:"logging"`
 }
 
@@ -290,6 +331,45 @@ type CookieAuthn struct {
 	ClientToken *AuthnTokenCookie `json:"clientToken"`
 }
 
+type Identity struct {
+	// Optional. If it's specified, the manifest is reconciled with the identity at startup.
+	Provisioning *IdentityProvisioning `json:"provisioning"`
+
+	// Optional. If it's specified, the API keys authenticated by ID are cached.
+	ApiKeyCache *IdentityApiKeyCache `json:"apiKeyCache"`
+}
+
+type IdentityProvisioning struct {
+	// The path of the manifest file.
+	ManifestFile string `json:"manifestFile"`
+
+	// If true, the changes required to reconcile the manifest are only logged, not applied.
+	PlanOnly bool `json:"planOnly"`
+}
+
+type IdentityApiKeyCache struct {
+	// The maximum number of cached API keys.
+	Capacity int `json:"capacity"`
+
+	// The cached data lifetime (in milliseconds).
+	TTL int64 `json:"ttl"`
+
+	// The invalidation of the cache when API keys are revoked.
+	Revocation *IdentityApiKeyRevocation `json:"revocation"`
+}
+
+type IdentityApiKeyRevocation struct {
+	Kafka *IdentityApiKeyRevocationKafka `json:"kafka"`
+}
+
+type IdentityApiKeyRevocationKafka struct {
+	// The Kafka config of the consumer.
+	ConsumerConfig *KafkaConfig `json:"consumerConfig"`
+
+	// The topic from which the revocations of API keys are consumed.
+	Topic string `json:"topic"`
+}
+
 var errUnmarshalNilSameSiteMode = errors.New("[config] can't unmarshal a nil *SameSiteMode")
 
 type SameSiteMode uint8
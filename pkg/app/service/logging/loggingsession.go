@@ -15,6 +15,7 @@
 package logging
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -53,6 +54,13 @@ func (s *LoggingSession) IsStarted() bool {
 	return s.isStarted.Load()
 }
 
+func (s *LoggingSession) HealthCheck(ctx context.Context) error {
+	if !s.isStarted.Load() {
+		return errors.New("[logging.LoggingSession.HealthCheck] logging session not started")
+	}
+	return nil
+}
+
 func (s *LoggingSession) GetId() (uint64, error) {
 	if !s.isStarted.Load() {
 		return 0, errors.New("[logging.LoggingSession.GetId] logging session not started")
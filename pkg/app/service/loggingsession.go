@@ -14,7 +14,12 @@
 
 package service
 
+import "context"
+
 type LoggingSession interface {
 	GetId() (uint64, error)
 	Start() error
+
+	// HealthCheck returns an error if the logging session hasn't been started.
+	HealthCheck(ctx context.Context) error
 }
@@ -128,7 +128,8 @@ func (l *RequestPipelineLifetime) BeginRequest(ctx *server.GrpcContext) error {
 }
 
 func (l *RequestPipelineLifetime) Authenticate(ctx *server.GrpcContext) error {
-	var userId, clientId nullable.Nullable[uint64]
+	var userId, clientId, impersonatorId, apiKeyId nullable.Nullable[uint64]
+	var apiKey []byte
 	if ctx.IncomingOperationCtx != nil {
 		if !ctx.IncomingOperationCtx.UserId.HasValue && !ctx.IncomingOperationCtx.ClientId.HasValue {
 			ctx.User = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
@@ -137,6 +138,10 @@ func (l *RequestPipelineLifetime) Authenticate(ctx *server.GrpcContext) error {
 
 		userId = ctx.IncomingOperationCtx.UserId
 		clientId = ctx.IncomingOperationCtx.ClientId
+		impersonatorId = ctx.IncomingOperationCtx.ImpersonatorId
+		apiKeyId = ctx.IncomingOperationCtx.ApiKeyId
+	} else if k, ok := getApiKey(ctx); ok {
+		apiKey = k
 	} else if userIdVal := ctx.IncomingMetadata.Get(metadata.UserIdMDKey); len(userIdVal) > 0 {
 		id, err := strconv.ParseUint(userIdVal[0], 10, 64)
 		if err != nil {
@@ -155,7 +160,20 @@ func (l *RequestPipelineLifetime) Authenticate(ctx *server.GrpcContext) error {
 	return l.reqProcessor.Process(server.NewIncomingContextWithGrpcContext(context.Background(), ctx),
 		actions.ActionTypeNetGrpcServer_RequestPipelineLifetime_Authenticate, actions.OperationTypeNetGrpcServer_RequestPipelineLifetime_Authenticate,
 		func(opCtx *grpcserverhelper.GrpcOperationContext) error {
-			i, err := l.identityManager.AuthenticateById(opCtx.OperationCtx, userId, clientId)
+			if apiKey != nil {
+				i, err := l.identityManager.AuthenticateByApiKey(opCtx.OperationCtx, apiKey)
+				if err != nil {
+					l.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpcServer_RequestPipelineLifetimeEvent, err,
+						"[server.RequestPipelineLifetime.Authenticate] authenticate a user or a service client by the API key",
+					)
+					return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+				}
+
+				ctx.User = i
+				return nil
+			}
+
+			i, err := l.identityManager.AuthenticateById(opCtx.OperationCtx, userId, clientId, impersonatorId, apiKeyId)
 			if err != nil {
 				l.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpcServer_RequestPipelineLifetimeEvent, err,
 					"[server.RequestPipelineLifetime.Authenticate] authenticate a user and a client by id",
@@ -169,6 +187,14 @@ func (l *RequestPipelineLifetime) Authenticate(ctx *server.GrpcContext) error {
 	)
 }
 
+// getApiKey returns the API key and true if the incoming metadata contains an API key as a bearer token.
+func getApiKey(ctx *server.GrpcContext) ([]byte, bool) {
+	if v := ctx.IncomingMetadata.Get(metadata.AuthorizationMDKey); len(v) > 0 {
+		return identity.ParseBearerApiKey(v[0])
+	}
+	return nil, false
+}
+
 func (l *RequestPipelineLifetime) Authorize(ctx *server.GrpcContext) error {
 	return nil
 }
@@ -15,7 +15,9 @@
 package app
 
 import (
+	"bytes"
 	"fmt"
+	"net/http"
 
 	"personal-website-v2/pkg/actions"
 	apihttp "personal-website-v2/pkg/api/http"
@@ -25,11 +27,13 @@ import (
 	"personal-website-v2/pkg/logging"
 	lcontext "personal-website-v2/pkg/logging/context"
 	"personal-website-v2/pkg/logging/events"
+	"personal-website-v2/pkg/metrics"
 	"personal-website-v2/pkg/net/http/server"
 )
 
 type ApplicationControllerIdentityConfig struct {
-	StopPermission string
+	StopPermission       string
+	GetMetricsPermission string
 }
 
 type ApplicationController struct {
@@ -93,3 +97,41 @@ func (c *ApplicationController) Stop(ctx *server.HttpContext) {
 		},
 	)
 }
+
+// GetMetrics gets the metrics of an app in the Prometheus text exposition format.
+//
+// The scraper (e.g. Prometheus) authenticates by an API key of a service user that has been
+// granted GetMetricsPermission (e.g. by the '<app>.metricsScraper' role). The API key is sent
+// as a bearer token in the Authorization header (authorization.credentials_file in the scrape config).
+//
+//	[GET] /metrics
+func (c *ApplicationController) GetMetrics(ctx *server.HttpContext) {
+	c.reqProcessor.ProcessWithAuthz(ctx, actions.ActionTypeApplication_GetMetrics, actions.OperationTypeApplicationController_GetMetrics,
+		[]string{c.identityConfig.GetMetricsPermission},
+		func(opCtx *actions.OperationContext) bool {
+			// the metrics are rendered before the header is written so that an error can be returned
+			var b bytes.Buffer
+			if err := metrics.WriteText(&b); err != nil {
+				leCtx := opCtx.CreateLogEntryContext()
+				c.logger.ErrorWithEvent(leCtx, events.HttpControllers_ApplicationControllerEvent, err, "[app.ApplicationController.GetMetrics] write metrics")
+
+				if err = apihttp.InternalServerError(ctx); err != nil {
+					c.logger.ErrorWithEvent(leCtx, events.HttpControllers_ApplicationControllerEvent, err, "[app.ApplicationController.GetMetrics] write InternalServerError")
+				}
+				return false
+			}
+
+			h := ctx.Response.Writer.Header()
+			h.Set("Cache-Control", "no-cache, no-store, must-revalidate")
+			h.Set("Content-Type", metrics.ContentType)
+			h.Set("X-Content-Type-Options", "nosniff")
+			ctx.Response.Writer.WriteHeader(http.StatusOK)
+
+			if _, err := b.WriteTo(ctx.Response.Writer); err != nil {
+				c.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.HttpControllers_ApplicationControllerEvent, err, "[app.ApplicationController.GetMetrics] write metrics to the response")
+				return false
+			}
+			return true
+		},
+	)
+}
@@ -28,6 +28,7 @@ import (
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/context"
 	"personal-website-v2/pkg/logging/events"
+	"personal-website-v2/pkg/net/http/headers"
 	"personal-website-v2/pkg/net/http/server"
 )
 
@@ -122,7 +123,9 @@ func (l *RequestPipelineLifetime) Authenticate(ctx *server.HttpContext) {
 					return true
 				}
 
-				i, err := l.identityManager.AuthenticateById(opCtx, ctx.IncomingOperationCtx.UserId, ctx.IncomingOperationCtx.ClientId)
+				i, err := l.identityManager.AuthenticateById(opCtx, ctx.IncomingOperationCtx.UserId, ctx.IncomingOperationCtx.ClientId,
+					ctx.IncomingOperationCtx.ImpersonatorId, ctx.IncomingOperationCtx.ApiKeyId,
+				)
 				if err != nil {
 					leCtx := opCtx.CreateLogEntryContext()
 					l.logger.ErrorWithEvent(leCtx, events.NetHttpServer_RequestPipelineLifetimeEvent, err,
@@ -136,6 +139,22 @@ func (l *RequestPipelineLifetime) Authenticate(ctx *server.HttpContext) {
 					return false
 				}
 
+				ctx.User = i
+			} else if apiKey, ok := identity.ParseBearerApiKey(ctx.Request.Header.Get(headers.HeaderNameAuthorization)); ok {
+				i, err := l.identityManager.AuthenticateByApiKey(opCtx, apiKey)
+				if err != nil {
+					leCtx := opCtx.CreateLogEntryContext()
+					l.logger.ErrorWithEvent(leCtx, events.NetHttpServer_RequestPipelineLifetimeEvent, err,
+						"[server.RequestPipelineLifetime.Authenticate] authenticate a user or a service client by the API key",
+					)
+					if err = apihttp.InternalServerError(ctx); err != nil {
+						l.logger.ErrorWithEvent(leCtx, events.NetHttpServer_RequestPipelineLifetimeEvent, err,
+							"[server.RequestPipelineLifetime.Authenticate] write InternalServerError",
+						)
+					}
+					return false
+				}
+
 				ctx.User = i
 			} else {
 				i, err := l.authnManager.Authenticate(opCtx, ctx.Request)
@@ -0,0 +1,214 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LRUCacheStats contains statistics of the LRUCache.
+type LRUCacheStats struct {
+	// The number of cache hits.
+	Hits uint64 `json:"hits"`
+
+	// The number of cache misses.
+	Misses uint64 `json:"misses"`
+
+	// The number of evictions (expired or least recently used entries that have been removed from the cache).
+	Evictions uint64 `json:"evictions"`
+
+	// The current number of entries in the cache.
+	Len int `json:"len"`
+
+	// The maximum number of entries in the cache.
+	Capacity int `json:"capacity"`
+}
+
+type lruCacheEntry[TKey comparable, TValue any] struct {
+	key       TKey
+	value     TValue
+	expiresAt time.Time
+}
+
+// LRUCache is a thread-safe, fixed-size, least recently used cache whose entries expire after the TTL.
+//
+// Each removal of entries from the cache increases the cache generation.
+// The generation can be used to prevent a value that was read from a data source before the removal
+// from being added to the cache after the removal (see LRUCache.AddIfGeneration).
+type LRUCache[TKey comparable, TValue any] struct {
+	capacity   int
+	ttl        time.Duration
+	ll         *list.List
+	items      map[TKey]*list.Element
+	generation uint64
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	evictions  atomic.Uint64
+	mu         sync.Mutex
+}
+
+// NewLRUCache returns a new LRUCache.
+// If ttl is 0, then entries don't expire.
+func NewLRUCache[TKey comparable, TValue any](capacity int, ttl time.Duration) (*LRUCache[TKey, TValue], error) {
+	if capacity < 1 {
+		return nil, fmt.Errorf("[cache.NewLRUCache] capacity out of range (%d) (capacity must be greater than 0)", capacity)
+	}
+	if ttl < 0 {
+		return nil, fmt.Errorf("[cache.NewLRUCache] ttl out of range (%s) (ttl must be greater than or equal to 0)", ttl)
+	}
+
+	return &LRUCache[TKey, TValue]{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[TKey]*list.Element, capacity),
+	}, nil
+}
+
+// Get returns the value, if any, by the specified key.
+func (c *LRUCache[TKey, TValue]) Get(key TKey) (TValue, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		ent := e.Value.(*lruCacheEntry[TKey, TValue])
+
+		if ent.expiresAt.IsZero() || time.Now().Before(ent.expiresAt) {
+			c.ll.MoveToFront(e)
+			c.hits.Add(1)
+			return ent.value, true
+		}
+
+		c.removeElement(e)
+		c.evictions.Add(1)
+	}
+
+	c.misses.Add(1)
+	var v TValue
+	return v, false
+}
+
+// Add adds a value to the cache or updates the existing value.
+func (c *LRUCache[TKey, TValue]) Add(key TKey, value TValue) {
+	c.mu.Lock()
+	c.add(key, value, time.Time{})
+	c.mu.Unlock()
+}
+
+// AddIfGeneration adds a value to the cache or updates the existing value if the current cache generation
+// is equal to the specified generation. It returns true if the value has been added.
+func (c *LRUCache[TKey, TValue]) AddIfGeneration(key TKey, value TValue, generation uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation != generation {
+		return false
+	}
+
+	c.add(key, value, time.Time{})
+	return true
+}
+
+// AddIfGenerationWithExpiration is like AddIfGeneration, but the value expires at the specified time
+// if it is earlier than the expiration time by the TTL. If expiresAt is zero, then it is ignored.
+func (c *LRUCache[TKey, TValue]) AddIfGenerationWithExpiration(key TKey, value TValue, generation uint64, expiresAt time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation != generation {
+		return false
+	}
+
+	c.add(key, value, expiresAt)
+	return true
+}
+
+func (c *LRUCache[TKey, TValue]) add(key TKey, value TValue, maxExpiresAt time.Time) {
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+	if !maxExpiresAt.IsZero() && (expiresAt.IsZero() || maxExpiresAt.Before(expiresAt)) {
+		expiresAt = maxExpiresAt
+	}
+
+	if e, ok := c.items[key]; ok {
+		ent := e.Value.(*lruCacheEntry[TKey, TValue])
+		ent.value = value
+		ent.expiresAt = expiresAt
+		c.ll.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruCacheEntry[TKey, TValue]{key: key, value: value, expiresAt: expiresAt})
+
+	if c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+		c.evictions.Add(1)
+	}
+}
+
+// Remove removes a value from the cache by the specified key.
+func (c *LRUCache[TKey, TValue]) Remove(key TKey) {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+	c.generation++
+	c.mu.Unlock()
+}
+
+// Clear removes all values from the cache.
+func (c *LRUCache[TKey, TValue]) Clear() {
+	c.mu.Lock()
+	c.ll.Init()
+	c.items = make(map[TKey]*list.Element, c.capacity)
+	c.generation++
+	c.mu.Unlock()
+}
+
+func (c *LRUCache[TKey, TValue]) removeElement(e *list.Element) {
+	c.ll.Remove(e)
+	delete(c.items, e.Value.(*lruCacheEntry[TKey, TValue]).key)
+}
+
+// Generation returns the current cache generation.
+func (c *LRUCache[TKey, TValue]) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache[TKey, TValue]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Stats returns the cache statistics.
+func (c *LRUCache[TKey, TValue]) Stats() *LRUCacheStats {
+	return &LRUCacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Len:       c.Len(),
+		Capacity:  c.capacity,
+	}
+}
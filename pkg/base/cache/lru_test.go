@@ -0,0 +1,160 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"personal-website-v2/pkg/base/cache"
+)
+
+func TestNewLRUCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ttl      time.Duration
+		wantErr  error
+	}{
+		{"capacity out of range", 0, time.Minute, errors.New("[cache.NewLRUCache] capacity out of range (0) (capacity must be greater than 0)")},
+		{"ttl out of range", 10, -time.Second, errors.New("[cache.NewLRUCache] ttl out of range (-1s) (ttl must be greater than or equal to 0)")},
+		{"valid", 10, time.Minute, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := cache.NewLRUCache[uint64, string](test.capacity, test.ttl)
+
+			if test.wantErr != nil {
+				if err == nil || err.Error() != test.wantErr.Error() {
+					t.Fatalf("err = %v; want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c == nil {
+				t.Fatal("c is nil")
+			}
+		})
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	c, err := cache.NewLRUCache[uint64, string](2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Add(1, "a")
+	c.Add(2, "b")
+
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Fatalf("c.Get(1) = %q, %t; want %q, true", v, ok, "a")
+	}
+
+	// 2 is the least recently used entry
+	c.Add(3, "c")
+
+	tests := []struct {
+		key    uint64
+		want   string
+		wantOk bool
+	}{
+		{1, "a", true},
+		{2, "", false},
+		{3, "c", true},
+	}
+
+	for _, test := range tests {
+		if v, ok := c.Get(test.key); ok != test.wantOk || v != test.want {
+			t.Errorf("c.Get(%d) = %q, %t; want %q, %t", test.key, v, ok, test.want, test.wantOk)
+		}
+	}
+
+	s := c.Stats()
+	if s.Hits != 3 || s.Misses != 1 || s.Evictions != 1 || s.Len != 2 || s.Capacity != 2 {
+		t.Errorf("c.Stats() = %+v", *s)
+	}
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	c, err := cache.NewLRUCache[uint64, string](2, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Add(1, "a")
+	time.Sleep(5 * time.Millisecond)
+
+	if v, ok := c.Get(1); ok {
+		t.Fatalf("c.Get(1) = %q, %t; want %q, false", v, ok, "")
+	}
+	if l := c.Len(); l != 0 {
+		t.Fatalf("c.Len() = %d; want 0", l)
+	}
+}
+
+func TestLRUCacheAddIfGeneration(t *testing.T) {
+	c, err := cache.NewLRUCache[uint64, string](2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := c.Generation()
+	c.Remove(1)
+
+	if c.AddIfGeneration(1, "a", g) {
+		t.Fatal("c.AddIfGeneration(1, \"a\", g) = true; want false")
+	}
+	if !c.AddIfGeneration(1, "a", c.Generation()) {
+		t.Fatal("c.AddIfGeneration(1, \"a\", c.Generation()) = false; want true")
+	}
+
+	c.Clear()
+	if l := c.Len(); l != 0 {
+		t.Fatalf("c.Len() = %d; want 0", l)
+	}
+}
+
+func TestLRUCacheAddIfGenerationWithExpiration(t *testing.T) {
+	c, err := cache.NewLRUCache[uint64, string](2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := c.Generation()
+	if !c.AddIfGenerationWithExpiration(1, "a", g, time.Now().Add(time.Millisecond)) {
+		t.Fatal("c.AddIfGenerationWithExpiration(1, \"a\", g, now+1ms) = false; want true")
+	}
+	if !c.AddIfGenerationWithExpiration(2, "b", g, time.Now().Add(time.Hour)) {
+		t.Fatal("c.AddIfGenerationWithExpiration(2, \"b\", g, now+1h) = false; want true")
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if v, ok := c.Get(1); ok {
+		t.Fatalf("c.Get(1) = %q, %t; want %q, false", v, ok, "")
+	}
+	if v, ok := c.Get(2); !ok || v != "b" {
+		t.Fatalf("c.Get(2) = %q, %t; want %q, true", v, ok, "b")
+	}
+
+	c.Remove(2)
+	if c.AddIfGenerationWithExpiration(2, "b", g, time.Time{}) {
+		t.Fatal("c.AddIfGenerationWithExpiration(2, \"b\", g, time.Time{}) = true; want false")
+	}
+}
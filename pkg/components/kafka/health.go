@@ -0,0 +1,73 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// producerStatus is the status of a producer that is used to check its health.
+type producerStatus struct {
+	lastErr error // error of the last message if it failed to be sent
+	mu      sync.Mutex
+}
+
+func (s *producerStatus) set(err error) {
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+}
+
+func (s *producerStatus) get() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastErr
+}
+
+// producerStatuses are the statuses of the open producers.
+var producerStatuses = struct {
+	statuses map[*producerStatus]struct{}
+	mu       sync.Mutex
+}{statuses: make(map[*producerStatus]struct{})}
+
+func newProducerStatus() *producerStatus {
+	s := new(producerStatus)
+	producerStatuses.mu.Lock()
+	producerStatuses.statuses[s] = struct{}{}
+	producerStatuses.mu.Unlock()
+	return s
+}
+
+func removeProducerStatus(s *producerStatus) {
+	producerStatuses.mu.Lock()
+	delete(producerStatuses.statuses, s)
+	producerStatuses.mu.Unlock()
+}
+
+// HealthCheck checks the health of the open producers. A producer isn't healthy
+// if the last message failed to be sent.
+func HealthCheck(ctx context.Context) error {
+	producerStatuses.mu.Lock()
+	defer producerStatuses.mu.Unlock()
+
+	for s := range producerStatuses.statuses {
+		if err := s.get(); err != nil {
+			return fmt.Errorf("[kafka.HealthCheck] last message failed to be sent: %w", err)
+		}
+	}
+	return nil
+}
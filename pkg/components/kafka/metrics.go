@@ -0,0 +1,36 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import "personal-website-v2/pkg/metrics"
+
+var (
+	producerMessagesTotal = metrics.NewCounterVec("kafka_producer_messages_total",
+		"Number of messages that have been sent to Kafka.", "topic")
+	producerErrorsTotal = metrics.NewCounterVec("kafka_producer_errors_total",
+		"Number of messages that failed to be sent to Kafka.", "topic")
+)
+
+func init() {
+	metrics.MustRegister(producerMessagesTotal, producerErrorsTotal)
+}
+
+func observeMessage(topic string, err error) {
+	if err != nil {
+		producerErrorsTotal.WithLabelValues(topic).Inc()
+	} else {
+		producerMessagesTotal.WithLabelValues(topic).Inc()
+	}
+}
@@ -51,6 +51,7 @@ func NewProducer(config *Config, async bool) (Producer, error) {
 
 type syncProducer struct {
 	producer sarama.SyncProducer
+	status   *producerStatus
 }
 
 func newSyncProducer(addrs []string, config *sarama.Config) (*syncProducer, error) {
@@ -62,6 +63,7 @@ func newSyncProducer(addrs []string, config *sarama.Config) (*syncProducer, erro
 
 	return &syncProducer{
 		producer: p,
+		status:   newProducerStatus(),
 	}, nil
 }
 
@@ -92,6 +94,8 @@ func (p *syncProducer) SendMessage(msg *ProducerMessage) error {
 	m.Timestamp = msg.Timestamp
 
 	partition, offset, err := p.producer.SendMessage(m)
+	observeMessage(msg.Topic, err)
+	p.status.set(err)
 
 	if err != nil {
 		return fmt.Errorf("[kafka.syncProducer.SendMessage] send a message: %w", err)
@@ -105,6 +109,7 @@ func (p *syncProducer) SendMessage(msg *ProducerMessage) error {
 }
 
 func (p *syncProducer) Close() error {
+	removeProducerStatus(p.status)
 	if err := p.producer.Close(); err != nil {
 		return fmt.Errorf("[kafka.syncProducer.Close] close a producer: %w", err)
 	}
@@ -114,6 +119,7 @@ func (p *syncProducer) Close() error {
 type asyncProducer struct {
 	producer     sarama.AsyncProducer
 	onCompletion func(msg *ProducerMessage, err error)
+	status       *producerStatus
 	wg           sync.WaitGroup
 }
 
@@ -127,6 +133,7 @@ func newAsyncProducer(addrs []string, config *sarama.Config, onCompletion func(m
 	p2 := &asyncProducer{
 		producer:     p,
 		onCompletion: onCompletion,
+		status:       newProducerStatus(),
 	}
 
 	p2.wg.Add(1)
@@ -188,6 +195,8 @@ func (p *asyncProducer) handleResult(msg *sarama.ProducerMessage, err error) {
 	m.Partition = msg.Partition
 	m.Offset = msg.Offset
 	m.Timestamp = msg.Timestamp
+	observeMessage(msg.Topic, err)
+	p.status.set(err)
 
 	if p.onCompletion != nil {
 		p.onCompletion(m, err)
@@ -228,5 +237,6 @@ func (p *asyncProducer) Close() error {
 	p.producer.AsyncClose()
 	p.wg.Wait()
 	p.onCompletion = nil
+	removeProducerStatus(p.status)
 	return nil
 }
@@ -0,0 +1,88 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+)
+
+// JWK is a JSON Web Key (RFC 7517) of the RSA public key.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSet is a JWK Set (RFC 7517, section 5).
+type JWKSet struct {
+	Keys []*JWK `json:"keys"`
+}
+
+// NewRS256JWK returns the JWK of the RSA public key that is used to verify RS256 signatures.
+func NewRS256JWK(key *rsa.PublicKey, kid string) *JWK {
+	return &JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: AlgRS256,
+		Kid: kid,
+		N:   b64.EncodeToString(key.N.Bytes()),
+		E:   b64.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// PublicKey returns the RSA public key of the JWK.
+func (k *JWK) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	n, err := b64.DecodeString(k.N)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	e, err := b64.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, ErrInvalidToken
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// Thumbprint returns the JWK thumbprint (RFC 7638) of the RSA public key,
+// which can be used as the key ID.
+func Thumbprint(key *rsa.PublicKey) string {
+	// the required members in lexicographic order
+	b, _ := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   b64.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		Kty: "RSA",
+		N:   b64.EncodeToString(key.N.Bytes()),
+	})
+	h := sha256.Sum256(b)
+	return b64.EncodeToString(h[:])
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AlgRS256 is the RSASSA-PKCS1-v1_5 using SHA-256 algorithm (RFC 7518, section 3.3).
+const AlgRS256 = "RS256"
+
+var (
+	ErrInvalidToken         = errors.New("invalid JWT")
+	ErrInvalidSignature     = errors.New("invalid JWT signature")
+	ErrUnsupportedAlgorithm = errors.New("unsupported JWT algorithm")
+	ErrKeyNotFound          = errors.New("JWT key not found")
+)
+
+var b64 = base64.RawURLEncoding
+
+// The JOSE header.
+type Header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// SignRS256 encodes the specified claims as a JWT signed with the RSA private key.
+// kid is the key ID that is used by the recipients to find the public key (JWK).
+func SignRS256(claims any, key *rsa.PrivateKey, kid string) (string, error) {
+	h, err := json.Marshal(&Header{Alg: AlgRS256, Typ: "JWT", Kid: kid})
+	if err != nil {
+		return "", fmt.Errorf("[jwt.SignRS256] marshal the header to JSON: %w", err)
+	}
+
+	p, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("[jwt.SignRS256] marshal the claims to JSON: %w", err)
+	}
+
+	input := b64.EncodeToString(h) + "." + b64.EncodeToString(p)
+	d := sha256.Sum256([]byte(input))
+	s, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, d[:])
+	if err != nil {
+		return "", fmt.Errorf("[jwt.SignRS256] sign a token: %w", err)
+	}
+	return input + "." + b64.EncodeToString(s), nil
+}
+
+// VerifyRS256 verifies the signature of the RS256-signed JWT and decodes its claims into the claims arg.
+// getKey returns the public key by the key ID from the header.
+// Registered claims (exp, iss, aud, etc.) aren't validated; the caller must validate them.
+func VerifyRS256(token string, getKey func(kid string) (*rsa.PublicKey, error), claims any) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+
+	hb, err := b64.DecodeString(parts[0])
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	var h Header
+	if err = json.Unmarshal(hb, &h); err != nil {
+		return ErrInvalidToken
+	}
+	if h.Alg != AlgRS256 {
+		return ErrUnsupportedAlgorithm
+	}
+
+	s, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	key, err := getKey(h.Kid)
+	if err != nil {
+		return fmt.Errorf("[jwt.VerifyRS256] get a key: %w", err)
+	}
+
+	d := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, d[:], s); err != nil {
+		return ErrInvalidSignature
+	}
+
+	p, err := b64.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber()
+	if err = dec.Decode(claims); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"testing"
+
+	"personal-website-v2/pkg/crypto/jwt"
+)
+
+type testClaims struct {
+	Sub string `json:"sub"`
+	Exp int64  `json:"exp"`
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestSignAndVerifyRS256(t *testing.T) {
+	k := generateKey(t)
+	kid := jwt.Thumbprint(&k.PublicKey)
+	token, err := jwt.SignRS256(&testClaims{Sub: "1", Exp: 1700000000}, k, kid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getKey := func(id string) (*rsa.PublicKey, error) {
+		if id != kid {
+			return nil, jwt.ErrKeyNotFound
+		}
+		return &k.PublicKey, nil
+	}
+
+	var c testClaims
+	if err = jwt.VerifyRS256(token, getKey, &c); err != nil {
+		t.Fatalf("VerifyRS256() error = %v", err)
+	}
+	if c.Sub != "1" || c.Exp != 1700000000 {
+		t.Errorf("VerifyRS256() claims = %+v", c)
+	}
+
+	parts := strings.Split(token, ".")
+	p, _ := jwt.SignRS256(&testClaims{Sub: "2", Exp: 1700000000}, k, kid)
+	tampered := parts[0] + "." + strings.Split(p, ".")[1] + "." + parts[2]
+	if err = jwt.VerifyRS256(tampered, getKey, &c); !errors.Is(err, jwt.ErrInvalidSignature) {
+		t.Errorf("VerifyRS256() of a tampered token error = %v, want %v", err, jwt.ErrInvalidSignature)
+	}
+
+	other := generateKey(t)
+	if err = jwt.VerifyRS256(token, func(string) (*rsa.PublicKey, error) { return &other.PublicKey, nil }, &c); !errors.Is(err, jwt.ErrInvalidSignature) {
+		t.Errorf("VerifyRS256() with another key error = %v, want %v", err, jwt.ErrInvalidSignature)
+	}
+
+	if err = jwt.VerifyRS256("a.b", getKey, &c); !errors.Is(err, jwt.ErrInvalidToken) {
+		t.Errorf("VerifyRS256() of a malformed token error = %v, want %v", err, jwt.ErrInvalidToken)
+	}
+}
+
+func TestVerifyRS256_UnsupportedAlgorithm(t *testing.T) {
+	// {"alg":"none"}.{"sub":"1"}.
+	const token = "eyJhbGciOiJub25lIn0.eyJzdWIiOiIxIn0."
+	var c testClaims
+	err := jwt.VerifyRS256(token, func(string) (*rsa.PublicKey, error) { return nil, jwt.ErrKeyNotFound }, &c)
+	if !errors.Is(err, jwt.ErrUnsupportedAlgorithm) {
+		t.Errorf("VerifyRS256() error = %v, want %v", err, jwt.ErrUnsupportedAlgorithm)
+	}
+}
+
+func TestThumbprint(t *testing.T) {
+	// RFC 7638, section 3.1.
+	jwk := &jwt.JWK{
+		Kty: "RSA",
+		N:   "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:   "AQAB",
+	}
+	key, err := jwk.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const want = "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"
+	if got := jwt.Thumbprint(key); got != want {
+		t.Errorf("Thumbprint() = %s, want %s", got, want)
+	}
+
+	if got := jwt.NewRS256JWK(key, want); got.N != jwk.N || got.E != jwk.E || got.Kid != want {
+		t.Errorf("NewRS256JWK() = %+v", got)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwords
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const argon2idAlgorithm = "argon2id"
+
+var (
+	ErrInvalidHash         = errors.New("invalid password hash")
+	ErrUnsupportedHash     = errors.New("unsupported password hash algorithm")
+	ErrIncompatibleVersion = errors.New("incompatible version of argon2")
+)
+
+type Argon2idParams struct {
+	// The amount of memory used by the algorithm (in kibibytes).
+	Memory uint32
+
+	// The number of iterations (passes) over the memory.
+	Iterations uint32
+
+	// The number of threads (lanes) used by the algorithm.
+	Parallelism uint8
+
+	// The length of the random salt (in bytes).
+	SaltLength uint32
+
+	// The length of the generated key (in bytes).
+	KeyLength uint32
+}
+
+// DefaultArgon2idParams are the parameters recommended by RFC 9106 (second recommended option)
+// and OWASP for password hashing with argon2id.
+var DefaultArgon2idParams = &Argon2idParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hash hashes a password with argon2id and default params and returns the hash
+// in the PHC string format.
+func Hash(password string) (string, error) {
+	return HashWithArgon2id(password, DefaultArgon2idParams)
+}
+
+// HashWithArgon2id hashes a password with argon2id and returns the hash in the PHC string format:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+func HashWithArgon2id(password string, p *Argon2idParams) (string, error) {
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("[passwords.HashWithArgon2id] generate a salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idAlgorithm, argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify returns true if the password matches the hash.
+// Supported hashes: argon2id (PHC string format) and bcrypt ($2a$, $2b$, $2y$).
+func Verify(password, hash string) (bool, error) {
+	if strings.HasPrefix(hash, "$"+argon2idAlgorithm+"$") {
+		return verifyArgon2id(password, hash)
+	}
+
+	if strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$") {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, fmt.Errorf("[passwords.Verify] compare a bcrypt hash and a password: %w", err)
+	}
+	return false, ErrUnsupportedHash
+}
+
+// NeedsRehash returns true if the hash was not created with argon2id and the specified params.
+func NeedsRehash(hash string, p *Argon2idParams) bool {
+	hp, salt, key, err := decodeArgon2idHash(hash)
+	if err != nil {
+		return true
+	}
+	return hp.Memory != p.Memory || hp.Iterations != p.Iterations || hp.Parallelism != p.Parallelism ||
+		uint32(len(salt)) != p.SaltLength || uint32(len(key)) != p.KeyLength
+}
+
+func verifyArgon2id(password, hash string) (bool, error) {
+	p, salt, key, err := decodeArgon2idHash(hash)
+	if err != nil {
+		return false, fmt.Errorf("[passwords.verifyArgon2id] decode an argon2id hash: %w", err)
+	}
+
+	key2 := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, key2) == 1, nil
+}
+
+func decodeArgon2idHash(hash string) (*Argon2idParams, []byte, []byte, error) {
+	// ["", "argon2id", "v=19", "m=65536,t=3,p=2", "<salt>", "<hash>"]
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != argon2idAlgorithm {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	var v int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &v); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	if v != argon2.Version {
+		return nil, nil, nil, ErrIncompatibleVersion
+	}
+
+	p := new(Argon2idParams)
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwords_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"personal-website-v2/pkg/crypto/passwords"
+)
+
+var testParams = &passwords.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHashWithArgon2id(t *testing.T) {
+	h, err := passwords.HashWithArgon2id("password", testParams)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(h, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Fatalf("invalid hash format: %s", h)
+	}
+
+	h2, err := passwords.HashWithArgon2id("password", testParams)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if h == h2 {
+		t.Fatal("expected different hashes (random salt)")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	argon2idHash, err := passwords.HashWithArgon2id("password", testParams)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		hash     string
+		want     bool
+		wantErr  bool
+	}{
+		{
+			"argon2id: password matches",
+			"password",
+			argon2idHash,
+			true,
+			false,
+		},
+		{
+			"argon2id: password does not match",
+			"Password",
+			argon2idHash,
+			false,
+			false,
+		},
+		{
+			"bcrypt: password matches",
+			"password",
+			string(bcryptHash),
+			true,
+			false,
+		},
+		{
+			"bcrypt: password does not match",
+			"password2",
+			string(bcryptHash),
+			false,
+			false,
+		},
+		{
+			"invalid argon2id hash",
+			"password",
+			"$argon2id$v=19$m=1024,t=1,p=1$invalid",
+			false,
+			true,
+		},
+		{
+			"unsupported hash",
+			"password",
+			"$1$salt$hash",
+			false,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := passwords.Verify(tt.password, tt.hash)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v; got: %v", tt.wantErr, err)
+			}
+
+			if actual != tt.want {
+				t.Fatalf("expected: %v; got: %v", tt.want, actual)
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	h, err := passwords.HashWithArgon2id("password", testParams)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if passwords.NeedsRehash(h, testParams) {
+		t.Fatal("expected: false; got: true")
+	}
+
+	if !passwords.NeedsRehash(h, passwords.DefaultArgon2idParams) {
+		t.Fatal("expected: true; got: false")
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !passwords.NeedsRehash(string(bcryptHash), testParams) {
+		t.Fatal("expected: true; got: false")
+	}
+}
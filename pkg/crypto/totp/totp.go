@@ -0,0 +1,154 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSecretSize is the secret size (in bytes) recommended by RFC 4226 (160 bits).
+const DefaultSecretSize = 20
+
+var ErrInvalidSecret = errors.New("invalid TOTP secret")
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type Params struct {
+	// The number of digits in a code (6-8).
+	Digits int
+
+	// The time step.
+	Period time.Duration
+
+	// The number of time steps before and after the current one in which codes are accepted
+	// to allow for clock drift.
+	Skew uint
+}
+
+// DefaultParams are the parameters supported by the most authenticator apps
+// (HMAC-SHA1, 6 digits, 30 seconds).
+var DefaultParams = &Params{
+	Digits: 6,
+	Period: 30 * time.Second,
+	Skew:   1,
+}
+
+// GenerateSecret generates a random secret of the specified size (in bytes).
+func GenerateSecret(size int) ([]byte, error) {
+	s := make([]byte, size)
+	if _, err := rand.Read(s); err != nil {
+		return nil, fmt.Errorf("[totp.GenerateSecret] generate a secret: %w", err)
+	}
+	return s, nil
+}
+
+// EncodeSecret encodes a secret in base32 without padding, as expected by authenticator apps.
+func EncodeSecret(secret []byte) string {
+	return b32.EncodeToString(secret)
+}
+
+// DecodeSecret decodes a base32-encoded secret. Padding, spaces and lower case are allowed.
+func DecodeSecret(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.ToUpper(strings.ReplaceAll(s, " ", "")), "=")
+	secret, err := b32.DecodeString(s)
+	if err != nil || len(secret) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return secret, nil
+}
+
+// Step returns the time step (the counter of RFC 4226) at the specified time.
+func Step(t time.Time, p *Params) uint64 {
+	return uint64(t.Unix()) / uint64(p.Period/time.Second)
+}
+
+// GenerateCode generates a code at the specified time.
+func GenerateCode(secret []byte, t time.Time, p *Params) string {
+	return generateCode(secret, Step(t, p), p.Digits)
+}
+
+// Validate returns true and the time step of the code if the code is valid at the specified time.
+// The time step should be stored and codes of the same or earlier time steps should be rejected
+// to prevent code reuse.
+func Validate(code string, secret []byte, t time.Time, p *Params) (uint64, bool) {
+	if len(code) != p.Digits {
+		return 0, false
+	}
+
+	step := Step(t, p)
+	skew := uint64(p.Skew)
+	first := uint64(0)
+	if step > skew {
+		first = step - skew
+	}
+
+	for s := first; s <= step+skew; s++ {
+		if subtle.ConstantTimeCompare([]byte(generateCode(secret, s, p.Digits)), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI returns a key URI used by authenticator apps to add an account (usually as a QR code):
+//
+//	otpauth://totp/Issuer:account?secret=<secret>&issuer=Issuer&algorithm=SHA1&digits=6&period=30
+func ProvisioningURI(issuer, accountName string, secret []byte, p *Params) string {
+	v := url.Values{}
+	v.Set("secret", EncodeSecret(secret))
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", strconv.Itoa(p.Digits))
+	v.Set("period", strconv.FormatInt(int64(p.Period/time.Second), 10))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// generateCode generates an HOTP code (RFC 4226).
+func generateCode(secret []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	h := hmac.New(sha1.New, secret)
+	h.Write(msg[:])
+	sum := h.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, v%mod)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"personal-website-v2/pkg/crypto/totp"
+)
+
+// RFC 6238, Appendix B (HMAC-SHA1).
+var rfc6238Secret = []byte("12345678901234567890")
+
+var rfc6238Params = &totp.Params{
+	Digits: 8,
+	Period: 30 * time.Second,
+	Skew:   1,
+}
+
+func TestGenerateCode(t *testing.T) {
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		if got := totp.GenerateCode(rfc6238Secret, time.Unix(tt.time, 0), rfc6238Params); got != tt.want {
+			t.Errorf("GenerateCode(%d) = %s, want %s", tt.time, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code := totp.GenerateCode(rfc6238Secret, now, rfc6238Params)
+
+	tests := []struct {
+		name string
+		code string
+		time time.Time
+		want bool
+	}{
+		{"current step", code, now, true},
+		{"previous step", code, now.Add(30 * time.Second), true},
+		{"next step", code, now.Add(-30 * time.Second), true},
+		{"outside of skew", code, now.Add(60 * time.Second), false},
+		{"invalid code", "00000000", now, false},
+		{"invalid length", code[:6], now, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := totp.Validate(tt.code, rfc6238Secret, tt.time, rfc6238Params)
+			if ok != tt.want {
+				t.Fatalf("Validate() = %v, want %v", ok, tt.want)
+			}
+			if ok && step != totp.Step(now, rfc6238Params) {
+				t.Fatalf("Validate() step = %d, want %d", step, totp.Step(now, rfc6238Params))
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeSecret(t *testing.T) {
+	s, err := totp.GenerateSecret(totp.DefaultSecretSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s2, err := totp.DecodeSecret(totp.EncodeSecret(s))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(s) != string(s2) {
+		t.Fatal("decoded secret doesn't match the original one")
+	}
+
+	if _, err = totp.DecodeSecret("not a base32 secret!"); err != totp.ErrInvalidSecret {
+		t.Fatalf("DecodeSecret() error = %v, want %v", err, totp.ErrInvalidSecret)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := totp.ProvisioningURI("Personal Website", "user@example.com", rfc6238Secret, totp.DefaultParams)
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Personal Website:user@example.com" {
+		t.Fatalf("invalid URI: %s", uri)
+	}
+
+	q := u.Query()
+	if q.Get("secret") != totp.EncodeSecret(rfc6238Secret) || q.Get("issuer") != "Personal Website" ||
+		q.Get("digits") != "6" || q.Get("period") != "30" || q.Get("algorithm") != "SHA1" {
+		t.Fatalf("invalid URI query: %s", u.RawQuery)
+	}
+}
@@ -148,6 +148,10 @@ func (m *DbManager[TStores]) Init() (err error) {
 		return fmt.Errorf("[postgres.DbManager.Init] init stores: %w", err)
 	}
 
+	for n, db := range m.Databases {
+		addConnPool(n, db.ConnPool)
+	}
+
 	m.isInitialized = true
 	return nil
 }
@@ -161,9 +165,27 @@ func (m *DbManager[TStores]) Dispose() {
 	}
 
 	if m.isInitialized {
-		for _, db := range m.Databases {
+		for n, db := range m.Databases {
+			removeConnPool(n)
 			db.ConnPool.Close()
 		}
 	}
 	m.disposed = true
 }
+
+// HealthCheck pings the databases.
+func (m *DbManager[TStores]) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.isInitialized || m.disposed {
+		m.mu.Unlock()
+		return errors.New("[postgres.DbManager.HealthCheck] DbManager not initialized or disposed")
+	}
+	m.mu.Unlock()
+
+	for n, db := range m.Databases {
+		if err := db.ConnPool.Ping(ctx); err != nil {
+			return fmt.Errorf("[postgres.DbManager.HealthCheck] ping the database '%s': %w", n, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"personal-website-v2/pkg/metrics"
+)
+
+// connPools are the connection pools whose stats are exported.
+var connPools = struct {
+	pools map[string]*pgxpool.Pool // map[DbConfigName]*pgxpool.Pool
+	mu    sync.RWMutex
+}{pools: make(map[string]*pgxpool.Pool)}
+
+func init() {
+	labelNames := []string{"db"}
+	metrics.MustRegister(
+		metrics.NewGaugeVecFunc("postgres_pool_total_connections", "Number of connections in the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) })),
+		metrics.NewGaugeVecFunc("postgres_pool_acquired_connections", "Number of acquired connections in the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) })),
+		metrics.NewGaugeVecFunc("postgres_pool_idle_connections", "Number of idle connections in the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) })),
+		metrics.NewGaugeVecFunc("postgres_pool_max_connections", "Maximum size of the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) })),
+		metrics.NewCounterVecFunc("postgres_pool_acquires_total", "Number of successful acquires from the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) })),
+		metrics.NewCounterVecFunc("postgres_pool_empty_acquires_total",
+			"Number of successful acquires from the pool that waited for a connection to be released or constructed.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) })),
+		metrics.NewCounterVecFunc("postgres_pool_canceled_acquires_total", "Number of acquires from the pool that were canceled.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) })),
+		metrics.NewCounterVecFunc("postgres_pool_acquire_duration_seconds_total", "Total duration of successful acquires from the pool.", labelNames,
+			collectPoolStats(func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() })),
+	)
+}
+
+func collectPoolStats(value func(s *pgxpool.Stat) float64) func(observe metrics.ObserveFunc) {
+	return func(observe metrics.ObserveFunc) {
+		connPools.mu.RLock()
+		defer connPools.mu.RUnlock()
+
+		names := make([]string, 0, len(connPools.pools))
+		for n := range connPools.pools {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		for _, n := range names {
+			observe(value(connPools.pools[n].Stat()), n)
+		}
+	}
+}
+
+func addConnPool(dbConfigName string, p *pgxpool.Pool) {
+	connPools.mu.Lock()
+	connPools.pools[dbConfigName] = p
+	connPools.mu.Unlock()
+}
+
+func removeConnPool(dbConfigName string) {
+	connPools.mu.Lock()
+	delete(connPools.pools, dbConfigName)
+	connPools.mu.Unlock()
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"personal-website-v2/pkg/base/datetime"
+)
+
+const (
+	// DefaultCheckTimeout is the default timeout of each check.
+	DefaultCheckTimeout = 5 * time.Second
+
+	// ReportCacheDuration is the duration for which a report is cached,
+	// so that frequent probes don't overload the checked components.
+	ReportCacheDuration = 2 * time.Second
+)
+
+// CheckFunc checks the health of a component and returns an error if the component isn't healthy.
+type CheckFunc func(ctx context.Context) error
+
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+)
+
+type check struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker runs the health checks registered by the components of an app.
+type Checker struct {
+	checks        []*check
+	timeout       time.Duration
+	mu            sync.RWMutex
+	cacheDuration time.Duration
+	report        *Report // the last report
+	reportTime    time.Time
+	reportMu      sync.Mutex
+}
+
+// NewChecker returns a new Checker. If timeout is 0, DefaultCheckTimeout is used.
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+
+	return &Checker{
+		timeout:       timeout,
+		cacheDuration: ReportCacheDuration,
+	}
+}
+
+// Add adds a check. It panics if a check with the same name has already been added.
+func (c *Checker) Add(name string, f CheckFunc) {
+	c.mu.Lock()
+	for _, ch := range c.checks {
+		if ch.name == name {
+			c.mu.Unlock()
+			panic(fmt.Sprintf("[health.Checker.Add] check '%s' has already been added", name))
+		}
+	}
+	c.checks = append(c.checks, &check{name: name, check: f})
+	c.mu.Unlock()
+
+	// the cached report doesn't contain the result of the new check
+	c.reportMu.Lock()
+	c.report = nil
+	c.reportMu.Unlock()
+}
+
+// Check runs all checks concurrently and returns a report. The report is cached for ReportCacheDuration,
+// the concurrent calls wait for the same run of the checks. The caller may modify the returned report.
+func (c *Checker) Check(ctx context.Context) *Report {
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+
+	if c.report != nil && datetime.Now().Sub(c.reportTime) < c.cacheDuration {
+		return c.report.clone()
+	}
+
+	r := c.check(ctx)
+	// the checks of the canceled call may fail regardless of the health of the components
+	if ctx.Err() == nil {
+		c.report = r
+		c.reportTime = datetime.Now()
+	}
+	return r.clone()
+}
+
+// check runs all checks concurrently and returns a report.
+func (c *Checker) check(ctx context.Context) *Report {
+	c.mu.RLock()
+	checks := make([]*check, len(c.checks))
+	copy(checks, c.checks)
+	c.mu.RUnlock()
+
+	r := &Report{
+		Status: StatusPass,
+		Checks: make([]*CheckResult, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(checks))
+
+	for i, ch := range checks {
+		go func(i int, ch *check) {
+			defer wg.Done()
+			r.Checks[i] = c.run(ctx, ch)
+		}(i, ch)
+	}
+	wg.Wait()
+
+	for _, cr := range r.Checks {
+		if cr.Status != StatusPass {
+			r.Status = StatusFail
+			break
+		}
+	}
+	return r
+}
+
+// run runs a check. If the check doesn't complete before the timeout,
+// it fails without waiting for the check.
+func (c *Checker) run(ctx context.Context, ch *check) *CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	startTime := datetime.Now()
+	errc := make(chan error, 1)
+
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				errc <- fmt.Errorf("[health.Checker.run] panic: %v", err)
+			}
+		}()
+		errc <- ch.check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errc:
+	case <-ctx.Done():
+		err = fmt.Errorf("[health.Checker.run] check hasn't completed: %w", ctx.Err())
+	}
+
+	r := &CheckResult{
+		Name:     ch.name,
+		Status:   StatusPass,
+		Duration: datetime.Now().Sub(startTime).String(),
+	}
+
+	if err != nil {
+		r.Status = StatusFail
+		r.Error = err.Error()
+	}
+	return r
+}
+
+// Report is the result of the health checks.
+type Report struct {
+	Status Status         `json:"status"`
+	Checks []*CheckResult `json:"checks"`
+}
+
+func (r *Report) IsHealthy() bool {
+	return r.Status == StatusPass
+}
+
+func (r *Report) clone() *Report {
+	r2 := &Report{
+		Status: r.Status,
+		Checks: make([]*CheckResult, len(r.Checks)),
+	}
+
+	for i, cr := range r.Checks {
+		cr2 := *cr
+		r2.Checks[i] = &cr2
+	}
+	return r2
+}
+
+type CheckResult struct {
+	Name     string `json:"name"`
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
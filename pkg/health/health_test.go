@@ -0,0 +1,83 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	c := NewChecker(50 * time.Millisecond)
+	c.Add("pass", func(ctx context.Context) error { return nil })
+	c.Add("fail", func(ctx context.Context) error { return errors.New("failed") })
+	c.Add("timeout", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+	c.Add("panic", func(ctx context.Context) error { panic("panicked") })
+
+	r := c.Check(context.Background())
+	if r.IsHealthy() {
+		t.Fatal("expected the report to be unhealthy")
+	}
+
+	expected := []Status{StatusPass, StatusFail, StatusFail, StatusFail}
+	for i, cr := range r.Checks {
+		if cr.Status != expected[i] {
+			t.Errorf("%s: expected: %s; got: %s (%s)", cr.Name, expected[i], cr.Status, cr.Error)
+		}
+	}
+
+	c = NewChecker(0)
+	c.Add("pass", func(ctx context.Context) error { return nil })
+	if r = c.Check(context.Background()); !r.IsHealthy() {
+		t.Fatal("expected the report to be healthy")
+	}
+}
+
+func TestChecker_Check_cache(t *testing.T) {
+	var n int
+	c := NewChecker(0)
+	c.Add("count", func(ctx context.Context) error {
+		n++
+		return nil
+	})
+
+	r := c.Check(context.Background())
+	// the returned report can be modified by the caller
+	r.Status = StatusFail
+	r.Checks[0].Status = StatusFail
+
+	if r = c.Check(context.Background()); !r.IsHealthy() || r.Checks[0].Status != StatusPass {
+		t.Fatal("expected the cached report to be healthy")
+	}
+	if n != 1 {
+		t.Fatalf("expected the checks to run once; ran: %d", n)
+	}
+
+	c.Add("pass", func(ctx context.Context) error { return nil })
+	if r = c.Check(context.Background()); len(r.Checks) != 2 || n != 2 {
+		t.Fatalf("expected the checks to run again after a check has been added; checks: %d; ran: %d", len(r.Checks), n)
+	}
+
+	c.cacheDuration = 0
+	c.Check(context.Background())
+	if n != 3 {
+		t.Fatalf("expected the checks to run again after the report has expired; ran: %d", n)
+	}
+}
@@ -142,6 +142,8 @@ func (p *RequestProcessor) Process(incomingCtx context.Context, atype actions.Ac
 	if grpcCtx.User != nil {
 		opCtx.UserId = grpcCtx.User.UserId()
 		opCtx.ClientId = grpcCtx.User.ClientId()
+		opCtx.ImpersonatorId = grpcCtx.User.ImpersonatorId()
+		opCtx.ApiKeyId = grpcCtx.User.ApiKeyId()
 	}
 
 	err = f(NewGrpcOperationContext(opCtx, grpcCtx))
@@ -159,8 +161,8 @@ func (p *RequestProcessor) ProcessWithAuthnCheck(incomingCtx context.Context, at
 				return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, apierrors.ErrUnauthenticated)
 			}
 
-			// userId must not be null. If userId.HasValue is false, then it is an error.
-			if !opCtx.GrpcCtx.User.UserId().HasValue {
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !opCtx.GrpcCtx.User.IsServiceClient() && !opCtx.GrpcCtx.User.UserId().HasValue {
 				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, nil,
 					"[server.RequestProcessor.ProcessWithAuthnCheck] userId is null",
 				)
@@ -212,8 +214,8 @@ func (p *RequestProcessor) ProcessWithAuthnCheckAndAuthz(
 				return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, apierrors.ErrUnauthenticated)
 			}
 
-			// userId must not be null. If userId.HasValue is false, then it is an error.
-			if !opCtx.GrpcCtx.User.UserId().HasValue {
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !opCtx.GrpcCtx.User.IsServiceClient() && !opCtx.GrpcCtx.User.UserId().HasValue {
 				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, nil,
 					"[server.RequestProcessor.ProcessWithAuthnCheckAndAuthz] userId is null",
 				)
@@ -236,6 +238,72 @@ func (p *RequestProcessor) ProcessWithAuthnCheckAndAuthz(
 	)
 }
 
+// ProcessWithAuthnCheckAndResourceAuthz is like ProcessWithAuthnCheckAndAuthz, but checks the required permissions
+// for the specified resources.
+func (p *RequestProcessor) ProcessWithAuthnCheckAndResourceAuthz(
+	incomingCtx context.Context,
+	atype actions.ActionType,
+	otype actions.OperationType,
+	requiredPermissions []string,
+	resources []*identity.Resource,
+	f func(ctx *GrpcOperationContext) error,
+) error {
+	return p.Process(incomingCtx, atype, otype,
+		func(opCtx *GrpcOperationContext) error {
+			if !opCtx.GrpcCtx.User.IsAuthenticated() {
+				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, nil,
+					"[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] user not authenticated",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Unauthenticated, apierrors.ErrUnauthenticated)
+			}
+
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !opCtx.GrpcCtx.User.IsServiceClient() && !opCtx.GrpcCtx.User.UserId().HasValue {
+				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, nil,
+					"[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] userId is null",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			}
+
+			if authorized, err := p.identityManager.AuthorizeResources(opCtx.OperationCtx, opCtx.GrpcCtx.User, requiredPermissions, resources); err != nil {
+				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, err,
+					"[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] authorize a user",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.Internal, apierrors.ErrInternal)
+			} else if !authorized {
+				p.logger.ErrorWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent, nil,
+					"[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] user not authorized",
+				)
+				return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrPermissionDenied)
+			}
+			return f(opCtx)
+		},
+	)
+}
+
+// ProcessSensitiveWithAuthnCheckAndAuthz is like ProcessWithAuthnCheckAndAuthz, but refuses impersonated users.
+// It is used for sensitive operations (e.g. changing credentials) that must be performed by the user themselves.
+func (p *RequestProcessor) ProcessSensitiveWithAuthnCheckAndAuthz(
+	incomingCtx context.Context,
+	atype actions.ActionType,
+	otype actions.OperationType,
+	requiredPermissions []string,
+	f func(ctx *GrpcOperationContext) error,
+) error {
+	return p.ProcessWithAuthnCheckAndAuthz(incomingCtx, atype, otype, requiredPermissions,
+		func(opCtx *GrpcOperationContext) error {
+			if opCtx.GrpcCtx.User.IsImpersonated() {
+				p.logger.WarningWithEvent(opCtx.OperationCtx.CreateLogEntryContext(), events.NetGrpc_ServerEvent,
+					"[server.RequestProcessor.ProcessSensitiveWithAuthnCheckAndAuthz] operation not allowed during impersonation",
+					logging.NewField("impersonatorId", opCtx.GrpcCtx.User.ImpersonatorId().Value),
+				)
+				return apigrpcerrors.CreateGrpcError(codes.PermissionDenied, apierrors.ErrImpersonationNotAllowed)
+			}
+			return f(opCtx)
+		},
+	)
+}
+
 type GrpcOperationContext struct {
 	OperationCtx *actions.OperationContext
 	GrpcCtx      *server.GrpcContext
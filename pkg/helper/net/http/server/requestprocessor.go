@@ -138,6 +138,8 @@ func (p *RequestProcessor) Process(ctx *server.HttpContext, atype actions.Action
 	if ctx.User != nil {
 		opCtx.UserId = ctx.User.UserId()
 		opCtx.ClientId = ctx.User.ClientId()
+		opCtx.ImpersonatorId = ctx.User.ImpersonatorId()
+		opCtx.ApiKeyId = ctx.User.ApiKeyId()
 	}
 
 	succeeded = f(opCtx)
@@ -156,8 +158,8 @@ func (p *RequestProcessor) ProcessWithAuthnCheck(ctx *server.HttpContext, atype
 				return false
 			}
 
-			// userId must not be null. If userId.HasValue is false, then it is an error.
-			if !ctx.User.UserId().HasValue {
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !ctx.User.IsServiceClient() && !ctx.User.UserId().HasValue {
 				leCtx := opCtx.CreateLogEntryContext()
 				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, nil, "[server.RequestProcessor.ProcessWithAuthnCheck] userId is null")
 
@@ -221,8 +223,8 @@ func (p *RequestProcessor) ProcessWithAuthnCheckAndAuthz(
 				return false
 			}
 
-			// userId must not be null. If userId.HasValue is false, then it is an error.
-			if !ctx.User.UserId().HasValue {
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !ctx.User.IsServiceClient() && !ctx.User.UserId().HasValue {
 				leCtx := opCtx.CreateLogEntryContext()
 				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, nil, "[server.RequestProcessor.ProcessWithAuthnCheckAndAuthz] userId is null")
 
@@ -253,3 +255,86 @@ func (p *RequestProcessor) ProcessWithAuthnCheckAndAuthz(
 		},
 	)
 }
+
+// ProcessWithAuthnCheckAndResourceAuthz is like ProcessWithAuthnCheckAndAuthz, but checks the required permissions
+// for the specified resources.
+func (p *RequestProcessor) ProcessWithAuthnCheckAndResourceAuthz(
+	ctx *server.HttpContext,
+	atype actions.ActionType,
+	otype actions.OperationType,
+	requiredPermissions []string,
+	resources []*identity.Resource,
+	f func(ctx *actions.OperationContext) (succeeded bool),
+) {
+	p.Process(ctx, atype, otype,
+		func(opCtx *actions.OperationContext) bool {
+			if !ctx.User.IsAuthenticated() {
+				leCtx := opCtx.CreateLogEntryContext()
+				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, nil, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] user not authenticated")
+
+				if err := apihttp.Unauthorized(ctx, apierrors.ErrUnauthenticated); err != nil {
+					p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] write Unauthorized")
+				}
+				return false
+			}
+
+			// userId must not be null unless the user is a service client. If userId.HasValue is false, then it is an error.
+			if !ctx.User.IsServiceClient() && !ctx.User.UserId().HasValue {
+				leCtx := opCtx.CreateLogEntryContext()
+				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, nil, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] userId is null")
+
+				if err := apihttp.InternalServerError(ctx); err != nil {
+					p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] write InternalServerError")
+				}
+				return false
+			}
+
+			if authorized, err := p.identityManager.AuthorizeResources(opCtx, ctx.User, requiredPermissions, resources); err != nil {
+				leCtx := opCtx.CreateLogEntryContext()
+				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] authorize a user")
+
+				if err = apihttp.InternalServerError(ctx); err != nil {
+					p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] write InternalServerError")
+				}
+				return false
+			} else if !authorized {
+				leCtx := opCtx.CreateLogEntryContext()
+				p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, nil, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] user not authorized")
+
+				if err = apihttp.Forbidden(ctx, apierrors.ErrPermissionDenied); err != nil {
+					p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessWithAuthnCheckAndResourceAuthz] write Forbidden")
+				}
+				return false
+			}
+			return f(opCtx)
+		},
+	)
+}
+
+// ProcessSensitiveWithAuthnCheckAndAuthz is like ProcessWithAuthnCheckAndAuthz, but refuses impersonated users.
+// It is used for sensitive operations (e.g. changing credentials) that must be performed by the user themselves.
+func (p *RequestProcessor) ProcessSensitiveWithAuthnCheckAndAuthz(
+	ctx *server.HttpContext,
+	atype actions.ActionType,
+	otype actions.OperationType,
+	requiredPermissions []string,
+	f func(ctx *actions.OperationContext) (succeeded bool),
+) {
+	p.ProcessWithAuthnCheckAndAuthz(ctx, atype, otype, requiredPermissions,
+		func(opCtx *actions.OperationContext) bool {
+			if ctx.User.IsImpersonated() {
+				leCtx := opCtx.CreateLogEntryContext()
+				p.logger.WarningWithEvent(leCtx, events.NetHttp_ServerEvent,
+					"[server.RequestProcessor.ProcessSensitiveWithAuthnCheckAndAuthz] operation not allowed during impersonation",
+					logging.NewField("impersonatorId", ctx.User.ImpersonatorId().Value),
+				)
+
+				if err := apihttp.Forbidden(ctx, apierrors.ErrImpersonationNotAllowed); err != nil {
+					p.logger.ErrorWithEvent(leCtx, events.NetHttp_ServerEvent, err, "[server.RequestProcessor.ProcessSensitiveWithAuthnCheckAndAuthz] write Forbidden")
+				}
+				return false
+			}
+			return f(opCtx)
+		},
+	)
+}
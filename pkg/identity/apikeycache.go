@@ -0,0 +1,89 @@
+// Copyright 2024 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"fmt"
+	"time"
+
+	"personal-website-v2/pkg/base/cache"
+	"personal-website-v2/pkg/base/nullable"
+)
+
+type ApiKeyCacheConfig struct {
+	// The maximum number of cached API keys.
+	Capacity int
+
+	// The cached data lifetime.
+	TTL time.Duration
+}
+
+// apiKeyAuthnResult is the result of the authentication of the API key by its ID.
+type apiKeyAuthnResult struct {
+	userId        nullable.Nullable[uint64]
+	userType      UserType
+	clientId      nullable.Nullable[uint64]
+	permissionIds []uint64 // nil if the API key isn't restricted
+}
+
+// ApiKeyCache is an in-process cache of the owners and the permission IDs of the API keys
+// that are authenticated by ID (see IdentityManager.AuthenticateById).
+//
+// The cached API keys must be invalidated when they are revoked (see apikeys.ApiKeyRevocationService),
+// otherwise a revoked API key remains valid in the app until the cached data expires.
+type ApiKeyCache struct {
+	results *cache.LRUCache[uint64, *apiKeyAuthnResult] // map[ApiKeyId]AuthnResult
+}
+
+func NewApiKeyCache(config *ApiKeyCacheConfig) (*ApiKeyCache, error) {
+	if config.TTL <= 0 {
+		return nil, fmt.Errorf("[identity.NewApiKeyCache] ttl out of range (%s) (ttl must be greater than 0)", config.TTL)
+	}
+
+	rs, err := cache.NewLRUCache[uint64, *apiKeyAuthnResult](config.Capacity, config.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.NewApiKeyCache] new cache of the authentication results: %w", err)
+	}
+	return &ApiKeyCache{results: rs}, nil
+}
+
+// get returns the cached authentication result of the API key, if any.
+func (c *ApiKeyCache) get(apiKeyId uint64) (*apiKeyAuthnResult, bool) {
+	return c.results.Get(apiKeyId)
+}
+
+// generation returns the current cache generation. It must be got before the authentication
+// of the API key so that the result isn't cached if the key has been invalidated in the meantime.
+func (c *ApiKeyCache) generation() uint64 {
+	return c.results.Generation()
+}
+
+// addIfGeneration adds the authentication result of the API key to the cache if the current cache generation
+// is equal to the specified generation.
+func (c *ApiKeyCache) addIfGeneration(apiKeyId uint64, r *apiKeyAuthnResult, generation uint64) {
+	c.results.AddIfGeneration(apiKeyId, r, generation)
+}
+
+// Invalidate removes the specified API keys from the cache.
+func (c *ApiKeyCache) Invalidate(apiKeyIds []uint64) {
+	for _, id := range apiKeyIds {
+		c.results.Remove(id)
+	}
+}
+
+// InvalidateAll removes all API keys from the cache.
+func (c *ApiKeyCache) InvalidateAll() {
+	c.results.Clear()
+}
@@ -0,0 +1,261 @@
+// Copyright 2024 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/proto"
+
+	apikeyspb "personal-website-v2/go-data/identity/apikeys"
+	"personal-website-v2/pkg/base/nullable"
+	"personal-website-v2/pkg/base/utils/runtime"
+	"personal-website-v2/pkg/components/kafka"
+	"personal-website-v2/pkg/components/kafka/metadata"
+	saramautil "personal-website-v2/pkg/components/kafka/utils/sarama"
+	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/logging/events"
+)
+
+const (
+	defaultConsumerKafkaClientId = "ApiKeyRevocation"
+)
+
+type ApiKeyRevocationServiceConfig struct {
+	Kafka *ApiKeyRevocationServiceKafkaConfig
+}
+
+type ApiKeyRevocationServiceKafkaConfig struct {
+	Config *kafka.Config
+
+	// The topic from which revocations are consumed.
+	Topic string
+}
+
+// ApiKeyRevocationService consumes the revocations of API keys sent by the identity
+// and invalidates the API key cache of the current app instance.
+//
+// Each app instance must receive all revocations, therefore the consumer group isn't used,
+// and all partitions of the topic are consumed starting from the newest offset.
+type ApiKeyRevocationService struct {
+	cache              *identity.ApiKeyCache
+	config             *ApiKeyRevocationServiceConfig
+	consumer           sarama.Consumer
+	partitionConsumers []sarama.PartitionConsumer
+	logger             logging.Logger[*lcontext.LogEntryContext]
+	loggerCtx          *lcontext.LogEntryContext
+	isStarted          atomic.Bool
+	isStopped          bool
+	mu                 sync.Mutex
+	wg                 sync.WaitGroup
+}
+
+func NewApiKeyRevocationService(
+	appSessionId uint64,
+	cache *identity.ApiKeyCache,
+	config *ApiKeyRevocationServiceConfig,
+	loggerFactory logging.LoggerFactory[*lcontext.LogEntryContext],
+) (*ApiKeyRevocationService, error) {
+	l, err := loggerFactory.CreateLogger("identity.apikeys.ApiKeyRevocationService")
+	if err != nil {
+		return nil, fmt.Errorf("[apikeys.NewApiKeyRevocationService] create a logger: %w", err)
+	}
+
+	return &ApiKeyRevocationService{
+		cache:  cache,
+		config: config,
+		logger: l,
+		loggerCtx: &lcontext.LogEntryContext{
+			AppSessionId: nullable.NewNullable(appSessionId),
+		},
+	}, nil
+}
+
+func (s *ApiKeyRevocationService) IsStarted() bool {
+	return s.isStarted.Load()
+}
+
+// Start starts the ApiKeyRevocationService.
+func (s *ApiKeyRevocationService) Start() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isStarted.Load() {
+		return errors.New("[apikeys.ApiKeyRevocationService.Start] ApiKeyRevocationService has already been started")
+	}
+	if s.isStopped {
+		return errors.New("[apikeys.ApiKeyRevocationService.Start] ApiKeyRevocationService has already been stopped")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.IdentityEvent, "[apikeys.ApiKeyRevocationService.Start] starting the ApiKeyRevocationService...")
+
+	c, err := s.config.Kafka.Config.SaramaConfig()
+	if err != nil {
+		return fmt.Errorf("[apikeys.ApiKeyRevocationService.Start] get a sarama config: %w", err)
+	}
+
+	if len(s.config.Kafka.Config.ClientId) == 0 {
+		c.ClientID = defaultConsumerKafkaClientId
+	}
+
+	consumer, err := sarama.NewConsumer(s.config.Kafka.Config.Addrs, c)
+	if err != nil {
+		return fmt.Errorf("[apikeys.ApiKeyRevocationService.Start] new consumer: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			s.closeConsumers(consumer, s.partitionConsumers)
+			s.partitionConsumers = nil
+		}
+	}()
+
+	ps, err := consumer.Partitions(s.config.Kafka.Topic)
+	if err != nil {
+		return fmt.Errorf("[apikeys.ApiKeyRevocationService.Start] get the partition ids of the topic: %w", err)
+	}
+
+	s.partitionConsumers = make([]sarama.PartitionConsumer, 0, len(ps))
+	for _, p := range ps {
+		pc, err := consumer.ConsumePartition(s.config.Kafka.Topic, p, sarama.OffsetNewest)
+		if err != nil {
+			return fmt.Errorf("[apikeys.ApiKeyRevocationService.Start] consume a partition: %w", err)
+		}
+		s.partitionConsumers = append(s.partitionConsumers, pc)
+	}
+
+	s.consumer = consumer
+	s.wg.Add(len(s.partitionConsumers))
+	for _, pc := range s.partitionConsumers {
+		go s.consumePartition(pc)
+	}
+
+	s.isStarted.Store(true)
+	s.logger.InfoWithEvent(s.loggerCtx, events.IdentityEvent, "[apikeys.ApiKeyRevocationService.Start] ApiKeyRevocationService has been started",
+		logging.NewField("topic", s.config.Kafka.Topic),
+		logging.NewField("partitions", ps),
+	)
+	return nil
+}
+
+// Stop stops the ApiKeyRevocationService.
+func (s *ApiKeyRevocationService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isStarted.Load() {
+		return errors.New("[apikeys.ApiKeyRevocationService.Stop] ApiKeyRevocationService not started")
+	}
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.IdentityEvent, "[apikeys.ApiKeyRevocationService.Stop] stopping the ApiKeyRevocationService...")
+	s.closeConsumers(s.consumer, s.partitionConsumers)
+	s.wg.Wait()
+
+	s.isStopped = true
+	s.isStarted.Store(false)
+	s.logger.InfoWithEvent(s.loggerCtx, events.IdentityEvent, "[apikeys.ApiKeyRevocationService.Stop] ApiKeyRevocationService has been stopped")
+	return nil
+}
+
+func (s *ApiKeyRevocationService) closeConsumers(consumer sarama.Consumer, partitionConsumers []sarama.PartitionConsumer) {
+	for _, pc := range partitionConsumers {
+		// the Messages and Errors channels are closed after the partition consumer is closed
+		pc.AsyncClose()
+	}
+
+	if err := consumer.Close(); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.IdentityEvent, err, "[apikeys.ApiKeyRevocationService.closeConsumers] close a consumer")
+	}
+}
+
+func (s *ApiKeyRevocationService) consumePartition(pc sarama.PartitionConsumer) {
+	defer s.wg.Done()
+	defer runtime.CatchPanic(func(p *runtime.PanicInfo) {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.IdentityEvent,
+			errs.NewErrorWithStackTrace(errs.ErrorCodeInternalError, fmt.Sprint("[apikeys.ApiKeyRevocationService.consumePartition] panic: ", p.Value), p.StackTrace),
+			"[apikeys.ApiKeyRevocationService.consumePartition] panic while consuming a partition",
+		)
+		// the cache may contain revoked API keys
+		s.cache.InvalidateAll()
+	})
+
+	errCh := pc.Errors()
+	msgCh := pc.Messages()
+	for errCh != nil || msgCh != nil {
+		select {
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			s.logger.ErrorWithEvent(s.loggerCtx, events.IdentityEvent, err,
+				"[apikeys.ApiKeyRevocationService.consumePartition] error while consuming a partition",
+			)
+			// revocations may have been missed
+			s.cache.InvalidateAll()
+		case msg, ok := <-msgCh:
+			if !ok {
+				msgCh = nil
+				continue
+			}
+			s.processMessage(msg)
+		}
+	}
+}
+
+func (s *ApiKeyRevocationService) processMessage(msg *sarama.ConsumerMessage) {
+	fs := []*logging.Field{
+		logging.NewField("topic", msg.Topic),
+		logging.NewField("partition", msg.Partition),
+		logging.NewField("offset", msg.Offset),
+		nil,
+	}
+
+	if msgIdH := saramautil.GetHeader(msg.Headers, metadata.MessageIdMDKey); msgIdH != nil {
+		if msgId, err := metadata.DecodeMessageId(msgIdH.Value); err != nil {
+			s.logger.ErrorWithEvent(s.loggerCtx, events.IdentityEvent, err,
+				"[apikeys.ApiKeyRevocationService.processMessage] decode the message id", fs[:3]...,
+			)
+			fs = fs[:3]
+		} else {
+			fs[3] = logging.NewField("_msgId", msgId)
+		}
+	} else {
+		fs = fs[:3]
+	}
+
+	r := new(apikeyspb.ApiKeyRevocation)
+	if err := proto.Unmarshal(msg.Value, r); err != nil {
+		s.logger.ErrorWithEvent(s.loggerCtx, events.IdentityEvent, err,
+			"[apikeys.ApiKeyRevocationService.processMessage] unmarshal the Protobuf-encoded revocation", fs...,
+		)
+		s.cache.InvalidateAll()
+		return
+	}
+
+	s.cache.Invalidate(r.ApiKeyIds)
+
+	s.logger.InfoWithEvent(s.loggerCtx, events.IdentityEvent,
+		"[apikeys.ApiKeyRevocationService.processMessage] API keys have been invalidated",
+		append(fs, logging.NewField("apiKeyIds", r.ApiKeyIds))...,
+	)
+}
@@ -20,9 +20,13 @@ import (
 
 type DefaultIdentity struct {
 	userId          nullable.Nullable[uint64]
+	impersonatorId  nullable.Nullable[uint64]
 	userType        UserType
 	userGroup       UserGroup
 	clientId        nullable.Nullable[uint64]
+	serviceClient   bool
+	apiKeyId        nullable.Nullable[uint64]
+	apiKeyPerms     map[string]bool            // map[PermissionName]true; nil if the API key isn't restricted
 	roles           map[string]bool            // map[RoleName]true
 	permissionRoles map[string]map[string]bool // map[PermissionName]map[RoleName]true
 }
@@ -37,12 +41,98 @@ func NewDefaultIdentity(userId nullable.Nullable[uint64], userType UserType, cli
 	}
 }
 
+// NewServiceClientIdentity returns the identity of the authenticated service client.
+func NewServiceClientIdentity(clientId uint64) *DefaultIdentity {
+	return &DefaultIdentity{
+		userType:        UserTypeUser,
+		clientId:        nullable.NewNullable(clientId),
+		serviceClient:   true,
+		roles:           map[string]bool{},
+		permissionRoles: map[string]map[string]bool{},
+	}
+}
+
+// NewImpersonatedIdentity returns the identity of the user impersonated by the specified impersonator.
+func NewImpersonatedIdentity(userId uint64, userType UserType, impersonatorId uint64, clientId nullable.Nullable[uint64]) *DefaultIdentity {
+	return &DefaultIdentity{
+		userId:          nullable.NewNullable(userId),
+		impersonatorId:  nullable.NewNullable(impersonatorId),
+		userType:        userType,
+		clientId:        clientId,
+		roles:           map[string]bool{},
+		permissionRoles: map[string]map[string]bool{},
+	}
+}
+
+// NewApiKeyIdentity returns the identity of the user or the service client authenticated by the API key
+// with the specified ID. If permissionNames is nil, the API key isn't restricted; otherwise,
+// only the specified permissions can be granted.
+func NewApiKeyIdentity(apiKeyId uint64, userId nullable.Nullable[uint64], userType UserType, clientId nullable.Nullable[uint64],
+	permissionNames []string) *DefaultIdentity {
+	i := &DefaultIdentity{
+		userId:          userId,
+		userType:        userType,
+		clientId:        clientId,
+		serviceClient:   !userId.HasValue,
+		apiKeyId:        nullable.NewNullable(apiKeyId),
+		roles:           map[string]bool{},
+		permissionRoles: map[string]map[string]bool{},
+	}
+
+	if permissionNames != nil {
+		i.apiKeyPerms = make(map[string]bool, len(permissionNames))
+		for _, n := range permissionNames {
+			i.apiKeyPerms[n] = true
+		}
+	}
+	return i
+}
+
 var _ Identity = (*DefaultIdentity)(nil)
 
 func (i *DefaultIdentity) UserId() nullable.Nullable[uint64] {
 	return i.userId
 }
 
+func (i *DefaultIdentity) ImpersonatorId() nullable.Nullable[uint64] {
+	return i.impersonatorId
+}
+
+// IsImpersonated returns true if the user is impersonated by another user.
+func (i *DefaultIdentity) IsImpersonated() bool {
+	return i.impersonatorId.HasValue
+}
+
+// ActorId returns the ID of the real actor, that is, the ID of the impersonator
+// if the user is impersonated; otherwise, the user ID.
+func (i *DefaultIdentity) ActorId() nullable.Nullable[uint64] {
+	if i.impersonatorId.HasValue {
+		return i.impersonatorId
+	}
+	return i.userId
+}
+
+// ApiKeyId returns the ID of the API key by which the user or the service client
+// has been authenticated, if any.
+func (i *DefaultIdentity) ApiKeyId() nullable.Nullable[uint64] {
+	return i.apiKeyId
+}
+
+// IsAllowedByApiKey returns true if the specified permissions aren't restricted
+// by the API key by which the user or the service client has been authenticated.
+func (i *DefaultIdentity) IsAllowedByApiKey(permissionNames ...string) bool {
+	if i.apiKeyPerms == nil {
+		return true
+	}
+
+	for _, n := range permissionNames {
+		if !i.apiKeyPerms[n] {
+			return false
+		}
+	}
+	return true
+}
+
 func (i *DefaultIdentity) UserType() UserType {
 	return i.userType
 }
@@ -59,8 +149,13 @@ func (i *DefaultIdentity) ClientId() nullable.Nullable[uint64] {
 	return i.clientId
 }
 
+// IsServiceClient returns true if the identity is the identity of the authenticated service client.
+func (i *DefaultIdentity) IsServiceClient() bool {
+	return i.serviceClient
+}
+
 func (i *DefaultIdentity) IsAuthenticated() bool {
-	return i.userId.HasValue
+	return i.userId.HasValue || i.serviceClient
 }
 
 func (i *DefaultIdentity) AddPermissionRoles(permissionRoles []*PermissionWithRoles) {
@@ -15,10 +15,54 @@
 package identity
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+
 	"personal-website-v2/pkg/base/nullable"
 )
 
+// ServiceClientTokenPrefix is the prefix of the access tokens of service clients.
+const ServiceClientTokenPrefix = "sct."
+
+// IsServiceClientToken returns true if the specified token is an access token of a service client.
+func IsServiceClientToken(token []byte) bool {
+	return bytes.HasPrefix(token, []byte(ServiceClientTokenPrefix))
+}
+
+// ImpersonationTokenPrefix is the prefix of the impersonation tokens.
+// An impersonation token is passed instead of the user's token.
+const ImpersonationTokenPrefix = "imp."
+
+// IsImpersonationToken returns true if the specified token is an impersonation token.
+func IsImpersonationToken(token []byte) bool {
+	return bytes.HasPrefix(token, []byte(ImpersonationTokenPrefix))
+}
+
+// ApiKeyPrefix is the prefix of the API keys.
+// An API key is passed in the Authorization header (HTTP) or metadata (gRPC) as a bearer token.
+const ApiKeyPrefix = "apk."
+
+// IsApiKey returns true if the specified token is an API key.
+func IsApiKey(token []byte) bool {
+	return bytes.HasPrefix(token, []byte(ApiKeyPrefix))
+}
+
+// ParseBearerApiKey returns the API key and true if the specified value of the Authorization header (metadata)
+// contains an API key as a bearer token; otherwise, nil and false.
+func ParseBearerApiKey(authorization string) ([]byte, bool) {
+	const prefix = "Bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return nil, false
+	}
+
+	k := []byte(strings.TrimSpace(authorization[len(prefix):]))
+	if !IsApiKey(k) {
+		return nil, false
+	}
+	return k, true
+}
+
 // The user's type (account type).
 //
 // UserType must be in sync with ../identity/src/internal/users/models/models.go:/^type.UserType.
@@ -85,11 +129,35 @@ func (g UserGroup) String() string {
 }
 
 type Identity interface {
+	// UserId returns the ID of the effective user. If the user is impersonated,
+	// it is the ID of the impersonated user.
 	UserId() nullable.Nullable[uint64]
+
+	// ImpersonatorId returns the ID of the user who impersonates the user, if any.
+	ImpersonatorId() nullable.Nullable[uint64]
+
+	// IsImpersonated returns true if the user is impersonated by another user.
+	IsImpersonated() bool
+
+	// ActorId returns the ID of the real actor, that is, the ID of the impersonator
+	// if the user is impersonated; otherwise, the user ID.
+	ActorId() nullable.Nullable[uint64]
+
+	// ApiKeyId returns the ID of the API key by which the user or the service client
+	// has been authenticated, if any.
+	ApiKeyId() nullable.Nullable[uint64]
+
+	// IsAllowedByApiKey returns true if the specified permissions aren't restricted
+	// by the API key by which the user or the service client has been authenticated.
+	// It always returns true if the identity hasn't been authenticated by an API key
+	// or the API key isn't restricted.
+	IsAllowedByApiKey(permissionNames ...string) bool
+
 	UserType() UserType
 	UserGroup() UserGroup
 	SetUserGroup(g UserGroup)
 	ClientId() nullable.Nullable[uint64]
+	IsServiceClient() bool
 	IsAuthenticated() bool
 	AddPermissionRoles(permissionRoles []*PermissionWithRoles)
 	HasRole(name string) bool
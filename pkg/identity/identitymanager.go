@@ -19,8 +19,11 @@ import (
 	"fmt"
 	"sync"
 
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"personal-website-v2/api-clients/identity"
 	ierrors "personal-website-v2/api-clients/identity/errors"
+	authorizationpb "personal-website-v2/go-apis/identity/authorization"
 	clientspb "personal-website-v2/go-apis/identity/clients"
 	permissionspb "personal-website-v2/go-apis/identity/permissions"
 	rolespb "personal-website-v2/go-apis/identity/roles"
@@ -38,9 +41,22 @@ import (
 
 type IdentityManager interface {
 	Init() error
-	AuthenticateById(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64]) (Identity, error)
+
+	// AuthenticateById authenticates a user and a client by their IDs. If impersonatorId has a value,
+	// the user is authenticated as impersonated by the user with the specified ID. If apiKeyId has a value,
+	// the user or the service client is authenticated by the API key with the specified ID and
+	// the restriction of the key is applied.
+	AuthenticateById(ctx *actions.OperationContext, userId, clientId, impersonatorId, apiKeyId nullable.Nullable[uint64]) (Identity, error)
 	AuthenticateByToken(ctx *actions.OperationContext, userToken, clientToken []byte) (Identity, error)
+
+	// AuthenticateByApiKey authenticates a user or a service client by the API key.
+	// It returns an anonymous identity if the API key is invalid.
+	AuthenticateByApiKey(ctx *actions.OperationContext, apiKey []byte) (Identity, error)
 	Authorize(ctx *actions.OperationContext, user Identity, requiredPermissions []string) (authorized bool, err error)
+
+	// AuthorizeResources authorizes a user and checks the required permissions for the specified resources.
+	// A permission is granted if it is granted globally or for all the specified resources.
+	AuthorizeResources(ctx *actions.OperationContext, user Identity, requiredPermissions []string, resources []*Resource) (authorized bool, err error)
 }
 
 type identityManager struct {
@@ -49,16 +65,22 @@ type identityManager struct {
 	identityService     *identity.IdentityService
 	roleNames           []string
 	permissionNames     []string
+	resourceTypeNames   []string
 	roles               map[uint64]*rolespb.Role
 	permissionsById     map[uint64]*permissionspb.Permission
 	permissionIdsByName map[string]uint64
+	resourceTypeIds     map[string]uint64
+	apiKeyCache         *ApiKeyCache
 	logger              logging.Logger[*context.LogEntryContext]
 	isInitialized       bool
 }
 
 var _ IdentityManager = (*identityManager)(nil)
 
-func NewIdentityManager(appUserId uint64, identityService *identity.IdentityService, roles, permissions []string, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (IdentityManager, error) {
+// NewIdentityManager returns a new identity manager.
+// apiKeyCache is optional; if it's nil, the API keys authenticated by ID aren't cached.
+func NewIdentityManager(appUserId uint64, identityService *identity.IdentityService, roles, permissions, resourceTypes []string,
+	apiKeyCache *ApiKeyCache, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (IdentityManager, error) {
 	l, err := loggerFactory.CreateLogger("identity.identityManager")
 	if err != nil {
 		return nil, fmt.Errorf("[identity.NewIdentityManager] create a logger: %w", err)
@@ -75,12 +97,14 @@ func NewIdentityManager(appUserId uint64, identityService *identity.IdentityServ
 	}
 
 	return &identityManager{
-		appUserId:       appUserId,
-		opExecutor:      e,
-		identityService: identityService,
-		roleNames:       roles,
-		permissionNames: permissions,
-		logger:          l,
+		appUserId:         appUserId,
+		opExecutor:        e,
+		identityService:   identityService,
+		roleNames:         roles,
+		permissionNames:   permissions,
+		resourceTypeNames: resourceTypes,
+		apiKeyCache:       apiKeyCache,
+		logger:            l,
 	}, nil
 }
 
@@ -99,6 +123,18 @@ func (m *identityManager) Init() error {
 		return fmt.Errorf("[identity.identityManager.Init] get all permissions by names: %w", err)
 	}
 
+	rtidm := make(map[string]uint64, len(m.resourceTypeNames))
+	if len(m.resourceTypeNames) > 0 {
+		rts, err := m.identityService.ResourceTypes.Register(m.resourceTypeNames, m.appUserId)
+		if err != nil {
+			return fmt.Errorf("[identity.identityManager.Init] register resource types: %w", err)
+		}
+
+		for _, rt := range rts {
+			rtidm[rt.Name] = rt.Id
+		}
+	}
+
 	rm := make(map[uint64]*rolespb.Role, len(rs))
 	for _, r := range rs {
 		rm[r.Id] = r
@@ -114,11 +150,17 @@ func (m *identityManager) Init() error {
 	m.roles = rm
 	m.permissionsById = pm
 	m.permissionIdsByName = pidm
+	m.resourceTypeIds = rtidm
 	m.isInitialized = true
 	return nil
 }
 
-func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId nullable.Nullable[uint64]) (Identity, error) {
+// AuthenticateById authenticates a user and a client by their IDs. If impersonatorId has a value,
+// the user is authenticated as impersonated by the user with the specified ID. If apiKeyId has a value,
+// the user or the service client is authenticated by the API key with the specified ID and
+// the restriction of the key is applied.
+func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId, clientId, impersonatorId, apiKeyId nullable.Nullable[uint64],
+) (Identity, error) {
 	if !m.isInitialized {
 		return nil, errors.New("[identity.identityManager.AuthenticateById] identityManager not initialized")
 	}
@@ -126,11 +168,26 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateById,
-		[]*actions.OperationParam{actions.NewOperationParam("userId", userId.Ptr()), actions.NewOperationParam("clientId", clientId.Ptr())},
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", userId.Ptr()),
+			actions.NewOperationParam("clientId", clientId.Ptr()),
+			actions.NewOperationParam("impersonatorId", impersonatorId.Ptr()),
+			actions.NewOperationParam("apiKeyId", apiKeyId.Ptr()),
+		},
 		func(opCtx *actions.OperationContext) error {
+			if apiKeyId.HasValue {
+				var err error
+				if i, err = m.authenticateByApiKeyId(opCtx, apiKeyId.Value, userId, clientId); err != nil {
+					return fmt.Errorf("[identity.identityManager.AuthenticateById] authenticate a user or a service client by the API key id: %w", err)
+				}
+				return nil
+			}
+
 			i = &DefaultIdentity{userType: UserTypeUser}
 
 			if !userId.HasValue && !clientId.HasValue {
@@ -175,6 +232,8 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 				} else if s == userspb.UserStatus_ACTIVE {
 					i.userId = userId
 					i.userType = UserType(t)
+					// the impersonation token has been verified by the app that received it
+					i.impersonatorId = impersonatorId
 				} else {
 					m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, "[identity.identityManager.AuthenticateById] invalid user's status",
 						logging.NewField("userStatus", s),
@@ -189,7 +248,16 @@ func (m *identityManager) AuthenticateById(ctx *actions.OperationContext, userId
 				}
 			}
 
-			if i.userId.HasValue && i.clientId.HasValue {
+			if i.impersonatorId.HasValue {
+				m.logger.InfoWithEvent(
+					opCtx.CreateLogEntryContext(),
+					events.Identity_ImpersonatedUserAuthenticated,
+					"[identity.identityManager.AuthenticateById] impersonated user has been authenticated",
+					logging.NewField("userId", i.userId.Value),
+					logging.NewField("impersonatorId", i.impersonatorId.Value),
+					logging.NewField("clientId", i.clientId.Ptr()),
+				)
+			} else if i.userId.HasValue && i.clientId.HasValue {
 				m.logger.InfoWithEvent(
 					opCtx.CreateLogEntryContext(),
 					events.Identity_UserAndClientAuthenticated,
@@ -229,13 +297,20 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	var i *DefaultIdentity
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByToken, nil,
 		func(opCtx *actions.OperationContext) error {
 			i = &DefaultIdentity{userType: UserTypeUser}
 
-			if len(userToken) > 0 && len(clientToken) > 0 {
+			if IsImpersonationToken(userToken) {
+				var err error
+				if i, err = m.authenticateImpersonatedUser(opCtx, userToken, clientToken); err != nil {
+					return fmt.Errorf("[identity.identityManager.AuthenticateByToken] authenticate an impersonated user: %w", err)
+				}
+			} else if len(userToken) > 0 && len(clientToken) > 0 {
 				if r, err := m.identityService.Authentication.Authenticate(opCtx, userToken, clientToken); err != nil {
 					msg := "[identity.identityManager.AuthenticateByToken] authenticate a user and a client"
 					if err2 := apierrors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken &&
@@ -275,6 +350,24 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 						logging.NewField("userId", r.UserId),
 					)
 				}
+			} else if IsServiceClientToken(clientToken) {
+				if r, err := m.identityService.Authentication.AuthenticateServiceClient(opCtx, clientToken); err != nil {
+					msg := "[identity.identityManager.AuthenticateByToken] authenticate a service client"
+					if err2 := apierrors.Unwrap(err); err2 == nil ||
+						err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ApiErrorCodeInvalidClientAuthnToken {
+						return fmt.Errorf("%s: %w", msg, err)
+					}
+					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+				} else {
+					i = NewServiceClientIdentity(r.ClientId)
+
+					m.logger.InfoWithEvent(
+						opCtx.CreateLogEntryContext(),
+						events.Identity_ServiceClientAuthenticated,
+						"[identity.identityManager.AuthenticateByToken] service client has been authenticated",
+						logging.NewField("clientId", r.ClientId),
+					)
+				}
 			} else if len(clientToken) > 0 {
 				if r, err := m.identityService.Authentication.AuthenticateClient(opCtx, clientToken); err != nil {
 					msg := "[identity.identityManager.AuthenticateByToken] authenticate a client"
@@ -303,6 +396,189 @@ func (m *identityManager) AuthenticateByToken(ctx *actions.OperationContext, use
 	return i, nil
 }
 
+func (m *identityManager) AuthenticateByApiKey(ctx *actions.OperationContext, apiKey []byte) (Identity, error) {
+	if !m.isInitialized {
+		return nil, errors.New("[identity.identityManager.AuthenticateByApiKey] identityManager not initialized")
+	}
+
+	ctx = ctx.Clone()
+	ctx.UserId = nullable.NewNullable(m.appUserId)
+	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
+
+	var i *DefaultIdentity
+	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthenticateByApiKey, nil,
+		func(opCtx *actions.OperationContext) error {
+			r, err := m.identityService.ApiKeys.Authenticate(opCtx, apiKey)
+			if err != nil {
+				msg := "[identity.identityManager.AuthenticateByApiKey] authenticate an API key"
+				if err2 := apierrors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken {
+					return fmt.Errorf("%s: %w", msg, err)
+				}
+				m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+				i = &DefaultIdentity{userType: UserTypeUser}
+				return nil
+			}
+
+			i = NewApiKeyIdentity(r.ApiKeyId, r.UserId, UserType(r.UserType), r.ClientId, m.getApiKeyPermissionNames(r.PermissionIds))
+
+			m.logger.InfoWithEvent(
+				opCtx.CreateLogEntryContext(),
+				events.Identity_ApiKeyAuthenticated,
+				"[identity.identityManager.AuthenticateByApiKey] API key has been authenticated",
+				logging.NewField("apiKeyId", r.ApiKeyId),
+				logging.NewField("userId", r.UserId.Ptr()),
+				logging.NewField("clientId", r.ClientId.Ptr()),
+			)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.identityManager.AuthenticateByApiKey] execute an operation: %w", err)
+	}
+	return i, nil
+}
+
+// authenticateByApiKeyId authenticates a user or a service client by the ID of the API key
+// that has already been authenticated by the app that received it, so that the restriction
+// of the key is applied in this app too. It returns an anonymous identity if the API key
+// is invalid or isn't owned by the specified user (service client).
+// The result is cached if the API key cache is used.
+func (m *identityManager) authenticateByApiKeyId(opCtx *actions.OperationContext, apiKeyId uint64, userId, clientId nullable.Nullable[uint64],
+) (*DefaultIdentity, error) {
+	r, err := m.getApiKeyAuthnResult(opCtx, apiKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("[identity.identityManager.authenticateByApiKeyId] get the authentication result of an API key: %w", err)
+	}
+
+	if r == nil {
+		return &DefaultIdentity{userType: UserTypeUser}, nil
+	}
+
+	if !r.userId.Equals(userId) || !r.clientId.Equals(clientId) {
+		m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent,
+			"[identity.identityManager.authenticateByApiKeyId] API key isn't owned by the user (service client)",
+			logging.NewField("apiKeyId", apiKeyId),
+			logging.NewField("userId", userId.Ptr()),
+			logging.NewField("clientId", clientId.Ptr()),
+		)
+		return &DefaultIdentity{userType: UserTypeUser}, nil
+	}
+
+	i := NewApiKeyIdentity(apiKeyId, r.userId, r.userType, r.clientId, m.getApiKeyPermissionNames(r.permissionIds))
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_ApiKeyAuthenticated,
+		"[identity.identityManager.authenticateByApiKeyId] API key has been authenticated by id",
+		logging.NewField("apiKeyId", apiKeyId),
+		logging.NewField("userId", r.userId.Ptr()),
+		logging.NewField("clientId", r.clientId.Ptr()),
+	)
+	return i, nil
+}
+
+// getApiKeyAuthnResult gets the authentication result of the API key from the cache, if it's used,
+// or from the identity service. It returns nil if the API key is invalid.
+func (m *identityManager) getApiKeyAuthnResult(opCtx *actions.OperationContext, apiKeyId uint64) (*apiKeyAuthnResult, error) {
+	var gen uint64
+	if m.apiKeyCache != nil {
+		if r, ok := m.apiKeyCache.get(apiKeyId); ok {
+			return r, nil
+		}
+		gen = m.apiKeyCache.generation()
+	}
+
+	r, err := m.identityService.ApiKeys.AuthenticateById(opCtx, apiKeyId)
+	if err != nil {
+		msg := "[identity.identityManager.getApiKeyAuthnResult] authenticate an API key by id"
+		if err2 := apierrors.Unwrap(err); err2 == nil || err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken {
+			return nil, fmt.Errorf("%s: %w", msg, err)
+		}
+		m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+		return nil, nil
+	}
+
+	ar := &apiKeyAuthnResult{
+		userId:        r.UserId,
+		userType:      UserType(r.UserType),
+		clientId:      r.ClientId,
+		permissionIds: r.PermissionIds,
+	}
+	if m.apiKeyCache != nil {
+		m.apiKeyCache.addIfGeneration(apiKeyId, ar, gen)
+	}
+	return ar, nil
+}
+
+// getApiKeyPermissionNames returns the names of the permissions the API key is restricted to,
+// or nil if the key isn't restricted.
+func (m *identityManager) getApiKeyPermissionNames(permissionIds []uint64) []string {
+	if permissionIds == nil {
+		return nil
+	}
+
+	pns := make([]string, 0, len(permissionIds))
+	for _, pid := range permissionIds {
+		// permissions that are missing in identityManager aren't used by the app
+		if p, ok := m.permissionsById[pid]; ok {
+			pns = append(pns, p.Name)
+		}
+	}
+	return pns
+}
+
+// authenticateImpersonatedUser authenticates a user by the impersonation token and a client by the client token, if any.
+// It returns an anonymous identity if the tokens are invalid.
+func (m *identityManager) authenticateImpersonatedUser(opCtx *actions.OperationContext, impersonationToken, clientToken []byte) (*DefaultIdentity, error) {
+	anonymous := &DefaultIdentity{userType: UserTypeUser}
+
+	// the impersonation tokens can't be used by service clients
+	if IsServiceClientToken(clientToken) {
+		m.logger.WarningWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent,
+			"[identity.identityManager.authenticateImpersonatedUser] impersonation token is used with the service client's token",
+		)
+		return anonymous, nil
+	}
+
+	r, err := m.identityService.Impersonation.Authenticate(opCtx, impersonationToken)
+	if err != nil {
+		msg := "[identity.identityManager.authenticateImpersonatedUser] authenticate an impersonated user"
+		if err2 := apierrors.Unwrap(err); err2 == nil ||
+			err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ApiErrorCodeInvalidUserAuthnToken {
+			return nil, fmt.Errorf("%s: %w", msg, err)
+		}
+		m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+		return anonymous, nil
+	}
+
+	var clientId nullable.Nullable[uint64]
+	if len(clientToken) > 0 {
+		r2, err := m.identityService.Authentication.AuthenticateClient(opCtx, clientToken)
+		if err != nil {
+			msg := "[identity.identityManager.authenticateImpersonatedUser] authenticate a client"
+			if err2 := apierrors.Unwrap(err); err2 == nil ||
+				err2.Code() != ierrors.ApiErrorCodeInvalidAuthnToken && err2.Code() != ierrors.ApiErrorCodeInvalidClientAuthnToken {
+				return nil, fmt.Errorf("%s: %w", msg, err)
+			}
+			m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+			return anonymous, nil
+		}
+		clientId = nullable.NewNullable(r2.ClientId)
+	}
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_ImpersonatedUserAuthenticated,
+		"[identity.identityManager.authenticateImpersonatedUser] impersonated user has been authenticated",
+		logging.NewField("userId", r.UserId),
+		logging.NewField("impersonatorId", r.ImpersonatorId),
+		logging.NewField("clientId", clientId.Ptr()),
+	)
+	return NewImpersonatedIdentity(r.UserId, UserType(r.UserType), r.ImpersonatorId, clientId), nil
+}
+
 func (m *identityManager) Authorize(ctx *actions.OperationContext, user Identity, requiredPermissions []string) (bool, error) {
 	if !m.isInitialized {
 		return false, errors.New("[identity.identityManager.Authorize] identityManager not initialized")
@@ -311,6 +587,8 @@ func (m *identityManager) Authorize(ctx *actions.OperationContext, user Identity
 	ctx = ctx.Clone()
 	ctx.UserId = nullable.NewNullable(m.appUserId)
 	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
 
 	authorized := false
 	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_Authorize,
@@ -320,71 +598,147 @@ func (m *identityManager) Authorize(ctx *actions.OperationContext, user Identity
 			actions.NewOperationParam("requiredPermissions", requiredPermissions),
 		},
 		func(opCtx *actions.OperationContext) error {
-			rpsLen := len(requiredPermissions)
-			if rpsLen == 0 {
-				return errs.NewError(errs.ErrorCodeInvalidData, "number of required permissions is 0")
+			var err error
+			if authorized, err = m.authorize(opCtx, user, requiredPermissions, nil); err != nil {
+				return fmt.Errorf("[identity.identityManager.Authorize] authorize a user: %w", err)
 			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("[identity.identityManager.Authorize] execute an operation: %w", err)
+	}
+	return authorized, nil
+}
 
-			pids := make([]uint64, rpsLen)
-			for i := 0; i < rpsLen; i++ {
-				id, ok := m.permissionIdsByName[requiredPermissions[i]]
-				if !ok {
-					return fmt.Errorf("[identity.identityManager.Authorize] '%s' permission is missing in identityManager", requiredPermissions[i])
-				}
+// AuthorizeResources authorizes a user and checks the required permissions for the specified resources.
+// A permission is granted if it is granted globally or for all the specified resources.
+func (m *identityManager) AuthorizeResources(ctx *actions.OperationContext, user Identity, requiredPermissions []string, resources []*Resource) (bool, error) {
+	if !m.isInitialized {
+		return false, errors.New("[identity.identityManager.AuthorizeResources] identityManager not initialized")
+	}
 
-				pids[i] = id
+	ctx = ctx.Clone()
+	ctx.UserId = nullable.NewNullable(m.appUserId)
+	ctx.ClientId = nullable.Nullable[uint64]{}
+	ctx.ImpersonatorId = nullable.Nullable[uint64]{}
+	ctx.ApiKeyId = nullable.Nullable[uint64]{}
+
+	authorized := false
+	err := m.opExecutor.Exec(ctx, actions.OperationTypeIdentityManager_AuthorizeResources,
+		[]*actions.OperationParam{
+			actions.NewOperationParam("userId", user.UserId().Ptr()),
+			actions.NewOperationParam("clientId", user.ClientId().Ptr()),
+			actions.NewOperationParam("requiredPermissions", requiredPermissions),
+			actions.NewOperationParam("resources", resources),
+		},
+		func(opCtx *actions.OperationContext) error {
+			if len(resources) == 0 {
+				return errs.NewError(errs.ErrorCodeInvalidData, "number of resources is 0")
 			}
 
-			r, err := m.identityService.Authorization.Authorize(opCtx, user.UserId(), user.ClientId(), pids)
-			if err != nil {
-				msg := "[identity.identityManager.Authorize] authorize a user"
-				if err2 := apierrors.Unwrap(err); err2 != nil && (err2.Code() == apierrors.ApiErrorCodeInvalidOperation || err2.Code() == ierrors.ApiErrorCodeUserNotFound ||
-					err2.Code() == ierrors.ApiErrorCodeClientNotFound || err2.Code() == ierrors.ApiErrorCodePermissionNotGranted) {
-					m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
-					return nil
+			rs := make([]*authorizationpb.Resource, len(resources))
+			for i := 0; i < len(resources); i++ {
+				r := resources[i]
+				if r == nil {
+					return errs.NewError(errs.ErrorCodeInvalidData, "resource is nil")
 				}
-				return fmt.Errorf("%s: %w", msg, err)
-			}
 
-			prs := make([]*PermissionWithRoles, len(r.PermissionRoles))
-			for i := 0; i < len(r.PermissionRoles); i++ {
-				item := r.PermissionRoles[i]
-				p, ok := m.permissionsById[item.PermissionId]
+				tid, ok := m.resourceTypeIds[r.Type]
 				if !ok {
-					return fmt.Errorf("[identity.identityManager.Authorize] permission (%d) is missing in identityManager", item.PermissionId)
+					return fmt.Errorf("[identity.identityManager.AuthorizeResources] '%s' resource type is missing in identityManager", r.Type)
 				}
 
-				rs := make([]string, len(item.RoleIds))
-				for j := 0; j < len(item.RoleIds); j++ {
-					r, ok := m.roles[item.RoleIds[j]]
-					if !ok {
-						return fmt.Errorf("[identity.identityManager.Authorize] role (%d) is missing in identityManager", item.RoleIds[j])
-					}
-
-					rs[j] = r.Name
+				rs[i] = &authorizationpb.Resource{TypeId: tid, Id: r.Id}
+				if r.OwnerId.HasValue {
+					rs[i].OwnerId = wrapperspb.UInt64(r.OwnerId.Value)
 				}
-
-				prs[i] = &PermissionWithRoles{PermissionName: p.Name, RoleNames: rs}
 			}
 
-			user.SetUserGroup(UserGroup(r.Group))
-			user.AddPermissionRoles(prs)
-			authorized = true
-
-			m.logger.InfoWithEvent(
-				opCtx.CreateLogEntryContext(),
-				events.Identity_UserAuthorized,
-				"[identity.identityManager.Authorize] user has been authorized",
-				logging.NewField("userId", user.UserId().Ptr()),
-				logging.NewField("clientId", user.ClientId().Ptr()),
-				logging.NewField("userGroup", user.UserGroup()),
-				logging.NewField("permissionRoles", prs),
-			)
+			var err error
+			if authorized, err = m.authorize(opCtx, user, requiredPermissions, rs); err != nil {
+				return fmt.Errorf("[identity.identityManager.AuthorizeResources] authorize a user: %w", err)
+			}
 			return nil
 		},
 	)
 	if err != nil {
-		return false, fmt.Errorf("[identity.identityManager.Authorize] execute an operation: %w", err)
+		return false, fmt.Errorf("[identity.identityManager.AuthorizeResources] execute an operation: %w", err)
 	}
 	return authorized, nil
 }
+
+func (m *identityManager) authorize(opCtx *actions.OperationContext, user Identity, requiredPermissions []string, resources []*authorizationpb.Resource) (bool, error) {
+	rpsLen := len(requiredPermissions)
+	if rpsLen == 0 {
+		return false, errs.NewError(errs.ErrorCodeInvalidData, "number of required permissions is 0")
+	}
+
+	pids := make([]uint64, rpsLen)
+	for i := 0; i < rpsLen; i++ {
+		id, ok := m.permissionIdsByName[requiredPermissions[i]]
+		if !ok {
+			return false, fmt.Errorf("[identity.identityManager.authorize] '%s' permission is missing in identityManager", requiredPermissions[i])
+		}
+
+		pids[i] = id
+	}
+
+	if !user.IsAllowedByApiKey(requiredPermissions...) {
+		m.logger.WarningWithEvent(
+			opCtx.CreateLogEntryContext(),
+			events.IdentityEvent,
+			"[identity.identityManager.authorize] required permissions are restricted by the API key",
+			logging.NewField("apiKeyId", user.ApiKeyId().Ptr()),
+			logging.NewField("requiredPermissions", requiredPermissions),
+		)
+		return false, nil
+	}
+
+	r, err := m.identityService.Authorization.Authorize(opCtx, user.UserId(), user.ClientId(), pids, resources)
+	if err != nil {
+		msg := "[identity.identityManager.authorize] authorize a user"
+		if err2 := apierrors.Unwrap(err); err2 != nil && (err2.Code() == apierrors.ApiErrorCodeInvalidOperation || err2.Code() == ierrors.ApiErrorCodeUserNotFound ||
+			err2.Code() == ierrors.ApiErrorCodeClientNotFound || err2.Code() == ierrors.ApiErrorCodePermissionNotGranted) {
+			m.logger.ErrorWithEvent(opCtx.CreateLogEntryContext(), events.IdentityEvent, err, msg)
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	prs := make([]*PermissionWithRoles, len(r.PermissionRoles))
+	for i := 0; i < len(r.PermissionRoles); i++ {
+		item := r.PermissionRoles[i]
+		p, ok := m.permissionsById[item.PermissionId]
+		if !ok {
+			return false, fmt.Errorf("[identity.identityManager.authorize] permission (%d) is missing in identityManager", item.PermissionId)
+		}
+
+		rs := make([]string, len(item.RoleIds))
+		for j := 0; j < len(item.RoleIds); j++ {
+			r, ok := m.roles[item.RoleIds[j]]
+			if !ok {
+				return false, fmt.Errorf("[identity.identityManager.authorize] role (%d) is missing in identityManager", item.RoleIds[j])
+			}
+
+			rs[j] = r.Name
+		}
+
+		prs[i] = &PermissionWithRoles{PermissionName: p.Name, RoleNames: rs}
+	}
+
+	user.SetUserGroup(UserGroup(r.Group))
+	user.AddPermissionRoles(prs)
+
+	m.logger.InfoWithEvent(
+		opCtx.CreateLogEntryContext(),
+		events.Identity_UserAuthorized,
+		"[identity.identityManager.authorize] user has been authorized",
+		logging.NewField("userId", user.UserId().Ptr()),
+		logging.NewField("clientId", user.ClientId().Ptr()),
+		logging.NewField("userGroup", user.UserGroup()),
+		logging.NewField("permissionRoles", prs),
+		logging.NewField("resources", resources),
+	)
+	return true, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	rolespb "personal-website-v2/go-apis/identity/roles"
+)
+
+// Manifest declares the permission groups, permissions, roles and grants of the service.
+// It's reconciled with the identity additively: the missing objects are created and the missing grants
+// are added, but the existing objects are never updated, revoked or deleted.
+type Manifest struct {
+	// The permission groups.
+	PermissionGroups []*PermissionGroup `json:"permissionGroups"`
+
+	// The permissions.
+	Permissions []*Permission `json:"permissions"`
+
+	// The roles.
+	Roles []*Role `json:"roles"`
+
+	// The grants of the permissions to the roles. The roles and permissions can be
+	// declared in the manifest of another service.
+	Grants []*Grant `json:"grants"`
+}
+
+// PermissionGroup is the permission group declared in the manifest.
+type PermissionGroup struct {
+	// The unique name to identify the permission group.
+	Name string `json:"name"`
+
+	// The permission group description.
+	Description string `json:"description"`
+}
+
+// Permission is the permission declared in the manifest.
+type Permission struct {
+	// The unique name to identify the permission.
+	Name string `json:"name"`
+
+	// The permission group name.
+	Group string `json:"group"`
+
+	// The permission description.
+	Description string `json:"description"`
+}
+
+// RoleType is the type of the role declared in the manifest.
+type RoleType string
+
+const (
+	RoleTypeSystem  RoleType = "system"
+	RoleTypeService RoleType = "service"
+)
+
+// Role is the role declared in the manifest.
+type Role struct {
+	// The unique name to identify the role.
+	Name string `json:"name"`
+
+	// The role type.
+	Type RoleType `json:"type"`
+
+	// The role title.
+	Title string `json:"title"`
+
+	// The role description.
+	Description string `json:"description"`
+}
+
+// Grant is the grant of the permissions to the role declared in the manifest.
+type Grant struct {
+	// The role name.
+	Role string `json:"role"`
+
+	// The permission names.
+	Permissions []string `json:"permissions"`
+}
+
+// LoadManifest loads a manifest from the JSON file.
+func LoadManifest(path string) (*Manifest, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.LoadManifest] read a file: %w", err)
+	}
+
+	m := new(Manifest)
+	if err = json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("[provisioning.LoadManifest] unmarshal JSON-encoded data (manifest): %w", err)
+	}
+	return m, nil
+}
+
+func convertToApiManifest(m *Manifest) (*provisioningpb.Manifest, error) {
+	gs := make([]*provisioningpb.ManifestPermissionGroup, len(m.PermissionGroups))
+	for i, g := range m.PermissionGroups {
+		gs[i] = &provisioningpb.ManifestPermissionGroup{
+			Name:        g.Name,
+			Description: g.Description,
+		}
+	}
+
+	ps := make([]*provisioningpb.ManifestPermission, len(m.Permissions))
+	for i, p := range m.Permissions {
+		ps[i] = &provisioningpb.ManifestPermission{
+			Name:        p.Name,
+			Group:       p.Group,
+			Description: p.Description,
+		}
+	}
+
+	rs := make([]*provisioningpb.ManifestRole, len(m.Roles))
+	for i, r := range m.Roles {
+		var t rolespb.RoleTypeEnum_RoleType
+		switch r.Type {
+		case RoleTypeSystem:
+			t = rolespb.RoleTypeEnum_SYSTEM
+		case RoleTypeService:
+			t = rolespb.RoleTypeEnum_SERVICE
+		default:
+			return nil, fmt.Errorf("[provisioning.convertToApiManifest] invalid type of the role '%s': %q", r.Name, r.Type)
+		}
+
+		rs[i] = &provisioningpb.ManifestRole{
+			Name:        r.Name,
+			Type:        t,
+			Title:       r.Title,
+			Description: r.Description,
+		}
+	}
+
+	grs := make([]*provisioningpb.ManifestGrant, len(m.Grants))
+	for i, g := range m.Grants {
+		grs[i] = &provisioningpb.ManifestGrant{
+			Role:        g.Role,
+			Permissions: g.Permissions,
+		}
+	}
+
+	return &provisioningpb.Manifest{
+		PermissionGroups: gs,
+		Permissions:      ps,
+		Roles:            rs,
+		Grants:           grs,
+	}, nil
+}
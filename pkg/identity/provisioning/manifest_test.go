@@ -0,0 +1,81 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"testing"
+
+	rolespb "personal-website-v2/go-apis/identity/roles"
+)
+
+func TestConvertToApiManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		roleType RoleType
+		want     rolespb.RoleTypeEnum_RoleType
+		wantErr  bool
+	}{
+		{
+			"system role",
+			RoleTypeSystem,
+			rolespb.RoleTypeEnum_SYSTEM,
+			false,
+		},
+		{
+			"service role",
+			RoleTypeService,
+			rolespb.RoleTypeEnum_SERVICE,
+			false,
+		},
+		{
+			"invalid role type",
+			RoleType("admin"),
+			rolespb.RoleTypeEnum_UNSPECIFIED,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manifest{
+				PermissionGroups: []*PermissionGroup{{Name: "test.pages", Description: "Pages."}},
+				Permissions:      []*Permission{{Name: "test.pages.get", Group: "test.pages", Description: "Get pages."}},
+				Roles:            []*Role{{Name: "test.pageUser", Type: tt.roleType, Title: "Page user", Description: "Page user."}},
+				Grants:           []*Grant{{Role: "test.pageUser", Permissions: []string{"test.pages.get"}}},
+			}
+
+			am, err := convertToApiManifest(m)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error; got: nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(am.PermissionGroups) != 1 || len(am.Permissions) != 1 || len(am.Roles) != 1 || len(am.Grants) != 1 {
+				t.Fatalf("unexpected manifest: %v", am)
+			}
+			if am.Permissions[0].Group != "test.pages" {
+				t.Fatalf("expected group: test.pages; got: %s", am.Permissions[0].Group)
+			}
+			if am.Roles[0].Type != tt.want {
+				t.Fatalf("expected role type: %v; got: %v", tt.want, am.Roles[0].Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"fmt"
+	"strings"
+
+	provisioningclient "personal-website-v2/api-clients/identity/provisioning"
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+)
+
+// Plan returns the changes required to reconcile the manifest with the identity without applying them.
+func Plan(p provisioningclient.Provisioning, m *Manifest, operationUserId uint64) ([]*provisioningpb.Change, error) {
+	am, err := convertToApiManifest(m)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.Plan] convert to an API manifest: %w", err)
+	}
+
+	cs, err := p.Plan(am, operationUserId)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.Plan] plan the reconciliation of the manifest: %w", err)
+	}
+	return cs, nil
+}
+
+// Apply reconciles the manifest with the identity and returns the applied changes
+// if the operation is successful.
+func Apply(p provisioningclient.Provisioning, m *Manifest, operationUserId uint64) ([]*provisioningpb.Change, error) {
+	am, err := convertToApiManifest(m)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.Apply] convert to an API manifest: %w", err)
+	}
+
+	cs, err := p.Apply(am, operationUserId)
+	if err != nil {
+		return nil, fmt.Errorf("[provisioning.Apply] apply the manifest: %w", err)
+	}
+	return cs, nil
+}
+
+// FormatChange returns a human-readable representation of the change.
+func FormatChange(c *provisioningpb.Change) string {
+	switch c.Type {
+	case provisioningpb.ChangeTypeEnum_CREATE_PERMISSION_GROUP:
+		return "+ permission group " + c.Name
+	case provisioningpb.ChangeTypeEnum_CREATE_PERMISSION:
+		return "+ permission " + c.Name
+	case provisioningpb.ChangeTypeEnum_CREATE_ROLE:
+		return "+ role " + c.Name
+	case provisioningpb.ChangeTypeEnum_GRANT_PERMISSIONS:
+		return fmt.Sprintf("+ grant %s to the role %s", strings.Join(c.Permissions, ", "), c.Name)
+	}
+	return fmt.Sprintf("? %s %s", c.Type, c.Name)
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import "personal-website-v2/pkg/base/nullable"
+
+// Resource represents a resource for which the permissions are checked.
+type Resource struct {
+	// The resource type name.
+	Type string `json:"type"`
+
+	// The resource ID.
+	Id uint64 `json:"id"`
+
+	// The resource owner ID (the ID of the user who created the resource).
+	// It is used for the resource role assignments with the owner rule.
+	OwnerId nullable.Nullable[uint64] `json:"ownerId"`
+}
+
+func NewResource(resourceType string, id uint64, ownerId nullable.Nullable[uint64]) *Resource {
+	return &Resource{
+		Type:    resourceType,
+		Id:      id,
+		OwnerId: ownerId,
+	}
+}
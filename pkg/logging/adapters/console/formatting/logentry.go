@@ -31,6 +31,7 @@ type logEntry struct {
 	Agent            *agent                 `json:"agent"`
 	LoggingSessionId uint64                 `json:"loggingSid"`
 	AppSessionId     *uint64                `json:"appSid,omitempty"`
+	ImpersonatorId   *uint64                `json:"impersonatorId,omitempty"`
 	Transaction      *transaction           `json:"tran,omitempty"`
 	Action           *action                `json:"action,omitempty"`
 	Operation        *operation             `json:"op,omitempty"`
@@ -79,6 +79,7 @@ func (f *JsonFormatter) Format(entry *logging.LogEntry[*context.LogEntryContext]
 
 	if entry.Context != nil {
 		e.AppSessionId = entry.Context.AppSessionId.Ptr()
+		e.ImpersonatorId = entry.Context.ImpersonatorId.Ptr()
 
 		if entry.Context.Transaction != nil {
 			e.Transaction = &transaction{
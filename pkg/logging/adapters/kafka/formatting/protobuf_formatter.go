@@ -84,6 +84,7 @@ func (f *ProtobufFormatter) Format(entry *logging.LogEntry[*context.LogEntryCont
 
 	if entry.Context != nil {
 		e.AppSessionId = entry.Context.AppSessionId.Ptr()
+		e.ImpersonatorId = entry.Context.ImpersonatorId.Ptr()
 
 		if entry.Context.Transaction != nil {
 			e.Tran = &loggingpb.Transaction{
@@ -27,6 +27,10 @@ type LogEntryContext struct {
 	Action       *ActionInfo
 	Operation    *OperationInfo
 	Fields       []*logging.Field
+
+	// ImpersonatorId is the ID of the user who impersonates the user
+	// on whose behalf the action is performed.
+	ImpersonatorId nullable.Nullable[uint64]
 }
 
 type TransactionInfo struct {
@@ -31,11 +31,14 @@ var (
 	ApplicationSessionEnded      = logging.NewEvent(103, "ApplicationSessionEnded", logging.EventCategoryCommon, logging.EventGroupApplication)
 
 	// Identity events (id: 0, 1000-1199)
-	IdentityEvent                       = logging.NewEvent(0, "Identity", logging.EventCategoryIdentity, logging.EventGroupIdentity)
-	Identity_UserAndClientAuthenticated = logging.NewEvent(1001, "Identity_UserAndClientAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
-	Identity_UserAuthenticated          = logging.NewEvent(1002, "Identity_UserAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
-	Identity_ClientAuthenticated        = logging.NewEvent(1003, "Identity_ClientAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
-	Identity_UserAuthorized             = logging.NewEvent(1004, "Identity_UserAuthorized", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	IdentityEvent                          = logging.NewEvent(0, "Identity", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_UserAndClientAuthenticated    = logging.NewEvent(1001, "Identity_UserAndClientAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_UserAuthenticated             = logging.NewEvent(1002, "Identity_UserAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_ClientAuthenticated           = logging.NewEvent(1003, "Identity_ClientAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_UserAuthorized                = logging.NewEvent(1004, "Identity_UserAuthorized", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_ServiceClientAuthenticated    = logging.NewEvent(1005, "Identity_ServiceClientAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_ImpersonatedUserAuthenticated = logging.NewEvent(1006, "Identity_ImpersonatedUserAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
+	Identity_ApiKeyAuthenticated           = logging.NewEvent(1007, "Identity_ApiKeyAuthenticated", logging.EventCategoryIdentity, logging.EventGroupIdentity)
 
 	// Transaction events (id: 0, 1200-1299)
 	TransactionEvent             = logging.NewEvent(0, "Transaction", logging.EventCategoryCommon, logging.EventGroupTransaction)
@@ -58,7 +61,9 @@ var (
 	OperationCompleted         = logging.NewEvent(1404, "OperationCompleted", logging.EventCategoryCommon, logging.EventGroupOperation)
 
 	// Network events (id: 0, 1500-1699)
-	NetworkEvent = logging.NewEvent(0, "Network", logging.EventCategoryNetwork, logging.EventGroupNetwork)
+	NetworkEvent                        = logging.NewEvent(0, "Network", logging.EventCategoryNetwork, logging.EventGroupNetwork)
+	Network_TlsCertificateReloaded      = logging.NewEvent(1501, "Network_TlsCertificateReloaded", logging.EventCategoryNetwork, logging.EventGroupNetwork)
+	Network_TlsCertificateReloadFailure = logging.NewEvent(1502, "Network_TlsCertificateReloadFailure", logging.EventCategoryNetwork, logging.EventGroupNetwork)
 
 	// NetHttp events (id: 0, 1700-1899)
 	NetHttpEvent = logging.NewEvent(0, "NetHttp", logging.EventCategoryCommon, logging.EventGroupNetHttp)
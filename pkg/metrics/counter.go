@@ -0,0 +1,84 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "bufio"
+
+// Counter is a metric whose value can only increase.
+type Counter struct {
+	desc  *desc // nil if the counter is a child of a CounterVec
+	value atomicFloat64
+}
+
+// NewCounter returns a new counter. It panics if the name is invalid.
+func NewCounter(name, help string) *Counter {
+	return &Counter{
+		desc: newDesc(name, help, "counter", nil),
+	}
+}
+
+func (c *Counter) Name() string {
+	return c.desc.name
+}
+
+func (c *Counter) Inc() {
+	c.value.add(1)
+}
+
+// Add adds the specified value to the counter. It panics if the value is negative.
+func (c *Counter) Add(v float64) {
+	if v < 0 {
+		panic("[metrics.Counter.Add] counter cannot decrease in value")
+	}
+	c.value.add(v)
+}
+
+func (c *Counter) Value() float64 {
+	return c.value.load()
+}
+
+func (c *Counter) writeText(w *bufio.Writer) {
+	writeHeader(w, c.desc)
+	writeSample(w, c.desc.name, nil, nil, "", "", c.value.load())
+}
+
+// CounterVec is a set of counters that are partitioned by the label values.
+type CounterVec struct {
+	vec *vec[Counter]
+}
+
+// NewCounterVec returns a new CounterVec. It panics if the name or any label name is invalid.
+func NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	return &CounterVec{
+		vec: newVec(newDesc(name, help, "counter", labelNames), func() *Counter { return new(Counter) }),
+	}
+}
+
+func (v *CounterVec) Name() string {
+	return v.vec.desc.name
+}
+
+// WithLabelValues returns the counter for the specified label values, creating it if necessary.
+// It panics if the number of label values isn't equal to the number of label names.
+func (v *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return v.vec.withLabelValues(labelValues)
+}
+
+func (v *CounterVec) writeText(w *bufio.Writer) {
+	writeHeader(w, v.vec.desc)
+	for _, c := range v.vec.sortedChildren() {
+		writeSample(w, v.vec.desc.name, v.vec.desc.labelNames, c.labelValues, "", "", c.metric.value.load())
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "bufio"
+
+// ObserveFunc reports a value of a metric with the specified label values.
+type ObserveFunc func(v float64, labelValues ...string)
+
+// funcCollector is a metric whose values are obtained by calling a function on each collection.
+type funcCollector struct {
+	desc    *desc
+	collect func(observe ObserveFunc)
+}
+
+// NewGaugeFunc returns a gauge whose value is obtained by calling fn on each collection.
+// It panics if the name is invalid.
+func NewGaugeFunc(name, help string, fn func() float64) Collector {
+	return newFuncCollector(newDesc(name, help, "gauge", nil), func(observe ObserveFunc) { observe(fn()) })
+}
+
+// NewCounterFunc returns a counter whose value is obtained by calling fn on each collection.
+// fn must return a value that can only increase. It panics if the name is invalid.
+func NewCounterFunc(name, help string, fn func() float64) Collector {
+	return newFuncCollector(newDesc(name, help, "counter", nil), func(observe ObserveFunc) { observe(fn()) })
+}
+
+// NewGaugeVecFunc returns a set of gauges whose values are reported by collect on each collection.
+// It panics if the name or any label name is invalid.
+func NewGaugeVecFunc(name, help string, labelNames []string, collect func(observe ObserveFunc)) Collector {
+	return newFuncCollector(newDesc(name, help, "gauge", labelNames), collect)
+}
+
+// NewCounterVecFunc returns a set of counters whose values are reported by collect on each collection.
+// It panics if the name or any label name is invalid.
+func NewCounterVecFunc(name, help string, labelNames []string, collect func(observe ObserveFunc)) Collector {
+	return newFuncCollector(newDesc(name, help, "counter", labelNames), collect)
+}
+
+func newFuncCollector(d *desc, collect func(observe ObserveFunc)) *funcCollector {
+	return &funcCollector{
+		desc:    d,
+		collect: collect,
+	}
+}
+
+func (c *funcCollector) Name() string {
+	return c.desc.name
+}
+
+func (c *funcCollector) writeText(w *bufio.Writer) {
+	writeHeader(w, c.desc)
+	c.collect(func(v float64, labelValues ...string) {
+		if len(labelValues) != len(c.desc.labelNames) {
+			panic(errInvalidLabelValues)
+		}
+		writeSample(w, c.desc.name, c.desc.labelNames, labelValues, "", "", v)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "bufio"
+
+// Gauge is a metric whose value can increase and decrease.
+type Gauge struct {
+	desc  *desc // nil if the gauge is a child of a GaugeVec
+	value atomicFloat64
+}
+
+// NewGauge returns a new gauge. It panics if the name is invalid.
+func NewGauge(name, help string) *Gauge {
+	return &Gauge{
+		desc: newDesc(name, help, "gauge", nil),
+	}
+}
+
+func (g *Gauge) Name() string {
+	return g.desc.name
+}
+
+func (g *Gauge) Set(v float64) {
+	g.value.store(v)
+}
+
+func (g *Gauge) Inc() {
+	g.value.add(1)
+}
+
+func (g *Gauge) Dec() {
+	g.value.add(-1)
+}
+
+func (g *Gauge) Add(v float64) {
+	g.value.add(v)
+}
+
+func (g *Gauge) Value() float64 {
+	return g.value.load()
+}
+
+func (g *Gauge) writeText(w *bufio.Writer) {
+	writeHeader(w, g.desc)
+	writeSample(w, g.desc.name, nil, nil, "", "", g.value.load())
+}
+
+// GaugeVec is a set of gauges that are partitioned by the label values.
+type GaugeVec struct {
+	vec *vec[Gauge]
+}
+
+// NewGaugeVec returns a new GaugeVec. It panics if the name or any label name is invalid.
+func NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	return &GaugeVec{
+		vec: newVec(newDesc(name, help, "gauge", labelNames), func() *Gauge { return new(Gauge) }),
+	}
+}
+
+func (v *GaugeVec) Name() string {
+	return v.vec.desc.name
+}
+
+// WithLabelValues returns the gauge for the specified label values, creating it if necessary.
+// It panics if the number of label values isn't equal to the number of label names.
+func (v *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return v.vec.withLabelValues(labelValues)
+}
+
+func (v *GaugeVec) writeText(w *bufio.Writer) {
+	writeHeader(w, v.vec.desc)
+	for _, c := range v.vec.sortedChildren() {
+		writeSample(w, v.vec.desc.name, v.vec.desc.labelNames, c.labelValues, "", "", c.metric.value.load())
+	}
+}
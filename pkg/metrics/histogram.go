@@ -0,0 +1,127 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+)
+
+// DefaultDurationBuckets are the default buckets of the duration histograms (in seconds).
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Histogram counts the observed values in the buckets.
+type Histogram struct {
+	desc    *desc // nil if the histogram is a child of a HistogramVec
+	buckets []float64
+	counts  []atomic.Uint64 // non-cumulative counts of the buckets; the last one is for +Inf
+	sum     atomicFloat64
+}
+
+// NewHistogram returns a new histogram. If the buckets aren't specified, DefaultDurationBuckets are used.
+// It panics if the name is invalid or the buckets aren't sorted in increasing order.
+func NewHistogram(name, help string, buckets []float64) *Histogram {
+	h := newHistogram(checkBuckets(buckets))
+	h.desc = newDesc(name, help, "histogram", nil)
+	return h
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	return &Histogram{
+		buckets: buckets,
+		counts:  make([]atomic.Uint64, len(buckets)+1),
+	}
+}
+
+func checkBuckets(buckets []float64) []float64 {
+	if len(buckets) == 0 {
+		return DefaultDurationBuckets
+	}
+
+	for i := 0; i < len(buckets); i++ {
+		if math.IsNaN(buckets[i]) || i > 0 && buckets[i] <= buckets[i-1] {
+			panic(fmt.Sprintf("[metrics.checkBuckets] buckets must be sorted in increasing order (%v)", buckets))
+		}
+	}
+
+	if math.IsInf(buckets[len(buckets)-1], 1) {
+		// the +Inf bucket is implicit
+		buckets = buckets[:len(buckets)-1]
+	}
+	return buckets
+}
+
+func (h *Histogram) Name() string {
+	return h.desc.name
+}
+
+// Observe adds the specified value to the histogram.
+func (h *Histogram) Observe(v float64) {
+	// the upper bounds of the buckets are inclusive
+	h.counts[sort.SearchFloat64s(h.buckets, v)].Add(1)
+	h.sum.add(v)
+}
+
+func (h *Histogram) writeText(w *bufio.Writer) {
+	writeHeader(w, h.desc)
+	h.writeSamples(w, h.desc, nil)
+}
+
+func (h *Histogram) writeSamples(w *bufio.Writer, d *desc, labelValues []string) {
+	var cumCount uint64
+	for i, b := range h.buckets {
+		cumCount += h.counts[i].Load()
+		writeSample(w, d.name+"_bucket", d.labelNames, labelValues, "le", formatFloat(b), float64(cumCount))
+	}
+
+	cumCount += h.counts[len(h.buckets)].Load()
+	writeSample(w, d.name+"_bucket", d.labelNames, labelValues, "le", "+Inf", float64(cumCount))
+	writeSample(w, d.name+"_sum", d.labelNames, labelValues, "", "", h.sum.load())
+	writeSample(w, d.name+"_count", d.labelNames, labelValues, "", "", float64(cumCount))
+}
+
+// HistogramVec is a set of histograms that are partitioned by the label values.
+type HistogramVec struct {
+	vec *vec[Histogram]
+}
+
+// NewHistogramVec returns a new HistogramVec. If the buckets aren't specified, DefaultDurationBuckets are used.
+// It panics if the name or any label name is invalid or the buckets aren't sorted in increasing order.
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	buckets = checkBuckets(buckets)
+	return &HistogramVec{
+		vec: newVec(newDesc(name, help, "histogram", labelNames), func() *Histogram { return newHistogram(buckets) }),
+	}
+}
+
+func (v *HistogramVec) Name() string {
+	return v.vec.desc.name
+}
+
+// WithLabelValues returns the histogram for the specified label values, creating it if necessary.
+// It panics if the number of label values isn't equal to the number of label names.
+func (v *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	return v.vec.withLabelValues(labelValues)
+}
+
+func (v *HistogramVec) writeText(w *bufio.Writer) {
+	writeHeader(w, v.vec.desc)
+	for _, c := range v.vec.sortedChildren() {
+		c.metric.writeSamples(w, v.vec.desc, c.labelValues)
+	}
+}
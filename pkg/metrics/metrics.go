@@ -0,0 +1,151 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// ContentType is the content type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var namePattern = regexp.MustCompile("^[a-zA-Z_:][a-zA-Z0-9_:]*$")
+
+// Collector is a metric (a metric family) that can be written in the text exposition format.
+type Collector interface {
+	Name() string
+	writeText(w *bufio.Writer)
+}
+
+// Registry is a set of metrics.
+type Registry struct {
+	collectors map[string]Collector
+	mu         sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		collectors: make(map[string]Collector),
+	}
+}
+
+// DefaultRegistry is the registry to which the metrics of the packages
+// (HTTP and gRPC servers, actions, Kafka producers, etc.) are added.
+var DefaultRegistry = NewRegistry()
+
+// Register adds a metric to the registry.
+func (r *Registry) Register(c Collector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.collectors[c.Name()]; ok {
+		return fmt.Errorf("[metrics.Registry.Register] metric '%s' has already been registered", c.Name())
+	}
+	r.collectors[c.Name()] = c
+	return nil
+}
+
+// MustRegister adds the metrics to the registry and panics if any metric has already been registered.
+func (r *Registry) MustRegister(cs ...Collector) {
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Unregister removes a metric from the registry.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.collectors[name]; !ok {
+		return false
+	}
+	delete(r.collectors, name)
+	return true
+}
+
+// WriteText writes all metrics sorted by name in the text exposition format.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.RLock()
+	cs := make([]Collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		cs = append(cs, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].Name() < cs[j].Name()
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, c := range cs {
+		c.writeText(bw)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("[metrics.Registry.WriteText] flush: %w", err)
+	}
+	return nil
+}
+
+// MustRegister adds the metrics to the default registry.
+func MustRegister(cs ...Collector) {
+	DefaultRegistry.MustRegister(cs...)
+}
+
+// WriteText writes the metrics of the default registry in the text exposition format.
+func WriteText(w io.Writer) error {
+	return DefaultRegistry.WriteText(w)
+}
+
+type desc struct {
+	name       string
+	help       string
+	mtype      string
+	labelNames []string
+}
+
+func newDesc(name, help, mtype string, labelNames []string) *desc {
+	if !namePattern.MatchString(name) {
+		panic(fmt.Sprintf("[metrics.newDesc] invalid metric name '%s'", name))
+	}
+
+	for _, n := range labelNames {
+		if !namePattern.MatchString(n) || n == "le" {
+			panic(fmt.Sprintf("[metrics.newDesc] invalid label name '%s' of the metric '%s'", n, name))
+		}
+	}
+
+	return &desc{
+		name:       name,
+		help:       help,
+		mtype:      mtype,
+		labelNames: labelNames,
+	}
+}
+
+func (d *desc) Name() string {
+	return d.name
+}
+
+var errInvalidLabelValues = errors.New("[metrics] number of label values isn't equal to number of label names")
@@ -0,0 +1,88 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistryWriteText(t *testing.T) {
+	r := NewRegistry()
+	requests := NewCounterVec("requests_total", "Number of requests.", "method", "path")
+	duration := NewHistogram("request_duration_seconds", "", []float64{0.1, 1})
+	inProgress := NewGauge("requests_in_progress", "Number of\nrequests in progress.")
+	r.MustRegister(requests, duration, inProgress,
+		NewGaugeVecFunc("pool_connections", "", []string{"db"}, func(observe ObserveFunc) {
+			observe(3, "a")
+			observe(5, "b")
+		}),
+	)
+
+	requests.WithLabelValues("POST", "/b").Inc()
+	requests.WithLabelValues("GET", `/a"\`).Add(2)
+	requests.WithLabelValues("GET", `/a"\`).Inc()
+	duration.Observe(0.1)
+	duration.Observe(0.5)
+	duration.Observe(3)
+	inProgress.Inc()
+	inProgress.Inc()
+	inProgress.Dec()
+
+	expected := `# TYPE pool_connections gauge
+pool_connections{db="a"} 3
+pool_connections{db="b"} 5
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.1"} 1
+request_duration_seconds_bucket{le="1"} 2
+request_duration_seconds_bucket{le="+Inf"} 3
+request_duration_seconds_sum 3.6
+request_duration_seconds_count 3
+# HELP requests_in_progress Number of\nrequests in progress.
+# TYPE requests_in_progress gauge
+requests_in_progress 1
+# HELP requests_total Number of requests.
+# TYPE requests_total counter
+requests_total{method="GET",path="/a\"\\"} 3
+requests_total{method="POST",path="/b"} 1
+`
+	var b strings.Builder
+	if err := r.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(NewCounter("a_total", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Register(NewGauge("a_total", "")); err == nil {
+		t.Fatal("expected an error if the metric has already been registered")
+	}
+
+	if !r.Unregister("a_total") {
+		t.Fatal("expected the metric to be unregistered")
+	}
+
+	if err := r.Register(NewGauge("a_total", "")); err != nil {
+		t.Fatal(err)
+	}
+}
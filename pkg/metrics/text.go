@@ -0,0 +1,91 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func writeHeader(w *bufio.Writer, d *desc) {
+	if len(d.help) > 0 {
+		w.WriteString("# HELP ")
+		w.WriteString(d.name)
+		w.WriteByte(' ')
+		helpReplacer.WriteString(w, d.help)
+		w.WriteByte('\n')
+	}
+
+	w.WriteString("# TYPE ")
+	w.WriteString(d.name)
+	w.WriteByte(' ')
+	w.WriteString(d.mtype)
+	w.WriteByte('\n')
+}
+
+// writeSample writes a sample. extraLabelName and extraLabelValue are used
+// for the "le" label of the histogram buckets.
+func writeSample(w *bufio.Writer, name string, labelNames, labelValues []string, extraLabelName, extraLabelValue string, v float64) {
+	w.WriteString(name)
+
+	if len(labelNames) > 0 || len(extraLabelName) > 0 {
+		w.WriteByte('{')
+
+		for i, n := range labelNames {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			writeLabel(w, n, labelValues[i])
+		}
+
+		if len(extraLabelName) > 0 {
+			if len(labelNames) > 0 {
+				w.WriteByte(',')
+			}
+			writeLabel(w, extraLabelName, extraLabelValue)
+		}
+		w.WriteByte('}')
+	}
+
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+func writeLabel(w *bufio.Writer, name, value string) {
+	w.WriteString(name)
+	w.WriteString(`="`)
+	labelValueReplacer.WriteString(w, value)
+	w.WriteByte('"')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+type atomicFloat64 struct {
+	bits atomic.Uint64
+}
+
+func (f *atomicFloat64) load() float64 {
+	return math.Float64frombits(f.bits.Load())
+}
+
+func (f *atomicFloat64) store(v float64) {
+	f.bits.Store(math.Float64bits(v))
+}
+
+func (f *atomicFloat64) add(v float64) {
+	for {
+		old := f.bits.Load()
+		if f.bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+v)) {
+			return
+		}
+	}
+}
+
+// labelValuesSep separates the label values in the keys of the vector children.
+const labelValuesSep = "\xff"
+
+type vecChild[T any] struct {
+	labelValues []string
+	metric      *T
+}
+
+// vec is a set of metrics of the same family that are partitioned by the label values.
+type vec[T any] struct {
+	desc     *desc
+	newChild func() *T
+	children map[string]*vecChild[T]
+	mu       sync.RWMutex
+}
+
+func newVec[T any](d *desc, newChild func() *T) *vec[T] {
+	return &vec[T]{
+		desc:     d,
+		newChild: newChild,
+		children: make(map[string]*vecChild[T]),
+	}
+}
+
+func (v *vec[T]) withLabelValues(labelValues []string) *T {
+	if len(labelValues) != len(v.desc.labelNames) {
+		panic(errInvalidLabelValues)
+	}
+
+	key := strings.Join(labelValues, labelValuesSep)
+	v.mu.RLock()
+	c, ok := v.children[key]
+	v.mu.RUnlock()
+
+	if ok {
+		return c.metric
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if c, ok = v.children[key]; !ok {
+		vs := make([]string, len(labelValues))
+		copy(vs, labelValues)
+		c = &vecChild[T]{labelValues: vs, metric: v.newChild()}
+		v.children[key] = c
+	}
+	return c.metric
+}
+
+// sortedChildren returns the children sorted by the label values.
+func (v *vec[T]) sortedChildren() []*vecChild[T] {
+	v.mu.RLock()
+	keys := make([]string, 0, len(v.children))
+	for k := range v.children {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	cs := make([]*vecChild[T], len(keys))
+	for i, k := range keys {
+		cs[i] = v.children[k]
+	}
+	v.mu.RUnlock()
+	return cs
+}
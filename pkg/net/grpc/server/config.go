@@ -14,11 +14,21 @@
 
 package server
 
+import (
+	"crypto/tls"
+
+	"personal-website-v2/pkg/health"
+)
+
 type GrpcServerConfig struct {
 	// Addr specifies the TCP address for the server to listen on,
 	// in the form "host:port".
 	Addr string
 
+	// Optional. If it's specified, the server uses TLS. If TLSConfig requires client certificates,
+	// the peer apps are authenticated by their certificates (mutual TLS).
+	TLSConfig *tls.Config
+
 	PipelineConfig *RequestPipelineConfig
 }
 
@@ -27,6 +37,9 @@ type RequestPipelineConfig struct {
 	UseAuthentication bool
 	UseAuthorization  bool
 	UseErrorHandler   bool
+
+	// Optional. If it's specified, the health service runs the checks of the checker.
+	HealthChecker *health.Checker
 }
 
 type RequestPipelineConfigBuilder struct {
@@ -34,6 +47,7 @@ type RequestPipelineConfigBuilder struct {
 	useAuthentication bool
 	useAuthorization  bool
 	useErrorHandler   bool
+	healthChecker     *health.Checker
 }
 
 func NewRequestPipelineConfigBuilder() *RequestPipelineConfigBuilder {
@@ -60,11 +74,18 @@ func (b *RequestPipelineConfigBuilder) UseErrorHandler() *RequestPipelineConfigB
 	return b
 }
 
+// UseHealthChecks specifies the checker whose checks are run by the health service.
+func (b *RequestPipelineConfigBuilder) UseHealthChecks(checker *health.Checker) *RequestPipelineConfigBuilder {
+	b.healthChecker = checker
+	return b
+}
+
 func (b *RequestPipelineConfigBuilder) Build() *RequestPipelineConfig {
 	return &RequestPipelineConfig{
 		Lifetime:          b.lifetime,
 		UseAuthentication: b.useAuthentication,
 		UseAuthorization:  b.useAuthorization,
 		UseErrorHandler:   b.useErrorHandler,
+		HealthChecker:     b.healthChecker,
 	}
 }
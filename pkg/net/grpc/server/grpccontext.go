@@ -18,11 +18,14 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"personal-website-v2/pkg/actions"
 	apimetadata "personal-website-v2/pkg/api/metadata"
 	"personal-website-v2/pkg/identity"
+	"personal-website-v2/pkg/net/tlsconfig"
 )
 
 type grpcContextKey struct{}
@@ -32,6 +35,7 @@ type GrpcContext struct {
 	IncomingOperationCtx *apimetadata.OperationContext
 	Transaction          *actions.Transaction
 	User                 identity.Identity
+	PeerName             string // the name of the peer app authenticated by its client certificate (mutual TLS), if any
 	callId               uuid.NullUUID
 	hasError             bool
 }
@@ -42,6 +46,16 @@ func NewGrpcContext(incomingMetadata metadata.MD) *GrpcContext {
 	}
 }
 
+// peerName returns the name of the peer app authenticated by its client certificate, if any.
+func peerName(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if i, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			return tlsconfig.PeerName(&i.State)
+		}
+	}
+	return ""
+}
+
 func (c *GrpcContext) CallId() uuid.NullUUID {
 	return c.callId
 }
@@ -22,6 +22,8 @@ import (
 	"sync/atomic"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"personal-website-v2/pkg/base/nullable"
 	"personal-website-v2/pkg/logging"
@@ -51,6 +53,7 @@ type GrpcServer struct {
 	appSessionId uint64
 	server       *grpc.Server
 	pipeline     *requestPipeline
+	health       *healthService
 	services     []*ServiceInfo
 	config       *GrpcServerConfig
 	logger       logging.Logger[*lcontext.LogEntryContext]
@@ -142,12 +145,20 @@ func (s *GrpcServer) Start() error {
 }
 
 func (s *GrpcServer) configure() {
-	server := grpc.NewServer(grpc.UnaryInterceptor(s.pipeline.onUnaryInterceptor), grpc.StreamInterceptor(s.pipeline.onStreamInterceptor))
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(s.pipeline.onUnaryInterceptor), grpc.StreamInterceptor(s.pipeline.onStreamInterceptor)}
+
+	if s.config.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.config.TLSConfig)))
+	}
+
+	server := grpc.NewServer(opts...)
 
 	for _, info := range s.services {
 		server.RegisterService(info.Desc, info.ServiceImpl)
 	}
 
+	s.health = newHealthService(s.pipeline, s.services)
+	healthpb.RegisterHealthServer(server, s.health)
 	s.server = server
 }
 
@@ -235,6 +246,7 @@ func (s *GrpcServer) stop(force bool) error {
 	s.pipeline.allowToServeGrpc(false)
 	s.pipeline.wait()
 
+	s.health.shutdown()
 	s.server.GracefulStop()
 
 	s.wg.Wait()
@@ -0,0 +1,120 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthWatchInterval is the interval at which the health status is checked for the watchers.
+const healthWatchInterval = 5 * time.Second
+
+var healthServicePrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// isHealthMethod reports whether the method is a method of the gRPC health service.
+// The health service bypasses the request pipeline so that it doesn't depend on
+// the call logging, authentication, etc.
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, healthServicePrefix)
+}
+
+// healthService is the standard gRPC health service (grpc.health.v1.Health).
+// The server (the empty service name) and the registered services are serving
+// if the pipeline is allowed to serve gRPC and all health checks pass.
+type healthService struct {
+	healthpb.UnimplementedHealthServer
+	pipeline     *requestPipeline
+	serviceNames map[string]bool
+	done         chan struct{}
+	shutdownOnce sync.Once
+}
+
+var _ healthpb.HealthServer = (*healthService)(nil)
+
+func newHealthService(pipeline *requestPipeline, services []*ServiceInfo) *healthService {
+	ns := make(map[string]bool, len(services)+1)
+	ns[""] = true
+
+	for _, info := range services {
+		ns[info.Desc.ServiceName] = true
+	}
+
+	return &healthService{
+		pipeline:     pipeline,
+		serviceNames: ns,
+		done:         make(chan struct{}),
+	}
+}
+
+func (s *healthService) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !s.serviceNames[req.Service] {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+	return &healthpb.HealthCheckResponse{Status: s.status(ctx)}, nil
+}
+
+func (s *healthService) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	lastStatus := healthpb.HealthCheckResponse_UNKNOWN
+	t := time.NewTicker(healthWatchInterval)
+	defer t.Stop()
+
+	for {
+		st := healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		if s.serviceNames[req.Service] {
+			st = s.status(ctx)
+		}
+
+		if st != lastStatus {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return status.Error(codes.Canceled, "stream has ended")
+			}
+			lastStatus = st
+		}
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		case <-s.done:
+			return status.Error(codes.Unavailable, "server is stopping")
+		}
+	}
+}
+
+func (s *healthService) status(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if !s.pipeline.isAllowedToServeGrpc.Load() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	if s.pipeline.config.HealthChecker != nil && !s.pipeline.config.HealthChecker.Check(ctx).IsHealthy() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// shutdown ends the watch streams so that the server can be stopped gracefully.
+func (s *healthService) shutdown() {
+	s.shutdownOnce.Do(func() {
+		close(s.done)
+	})
+}
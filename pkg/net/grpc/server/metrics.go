@@ -0,0 +1,44 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"personal-website-v2/pkg/metrics"
+)
+
+var (
+	callsTotal = metrics.NewCounterVec("grpc_server_calls_total",
+		"Number of gRPC calls that have been served.", "method", "status_code")
+	callDuration = metrics.NewHistogramVec("grpc_server_call_duration_seconds",
+		"Duration of serving gRPC calls.", nil, "method", "status_code")
+	callsInProgress = metrics.NewGauge("grpc_server_calls_in_progress",
+		"Number of gRPC calls in progress.")
+	callsWithErrTotal = metrics.NewCounter("grpc_server_calls_with_error_total",
+		"Number of gRPC calls with an error that occurred while serving and handling the call.")
+)
+
+func init() {
+	metrics.MustRegister(callsTotal, callDuration, callsInProgress, callsWithErrTotal)
+}
+
+func observeCall(fullMethod string, statusCode codes.Code, elapsedTime time.Duration) {
+	labelValues := []string{fullMethod, statusCode.String()}
+	callsTotal.WithLabelValues(labelValues...).Inc()
+	callDuration.WithLabelValues(labelValues...).Observe(elapsedTime.Seconds())
+}
@@ -95,6 +95,10 @@ func newRequestPipeline(
 }
 
 func (p *requestPipeline) onUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
 	startTime := datetime.Now()
 	p.wgInProgress.Add(1)
 	p.stats.incrRequestsInProgress()
@@ -110,6 +114,7 @@ func (p *requestPipeline) onUnaryInterceptor(ctx context.Context, req interface{
 	}
 
 	grpcCtx := NewGrpcContext(md)
+	grpcCtx.PeerName = peerName(ctx)
 	ctx = NewIncomingContextWithGrpcContext(ctx, grpcCtx)
 
 	cInfo := &CallInfo{
@@ -133,6 +138,10 @@ func (p *requestPipeline) onUnaryInterceptor(ctx context.Context, req interface{
 }
 
 func (p *requestPipeline) onStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthMethod(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
 	startTime := datetime.Now()
 	p.wgInProgress.Add(1)
 	p.stats.incrRequestsInProgress()
@@ -149,6 +158,7 @@ func (p *requestPipeline) onStreamInterceptor(srv interface{}, ss grpc.ServerStr
 	}
 
 	grpcCtx := NewGrpcContext(md)
+	grpcCtx.PeerName = peerName(ctx)
 	ctx = NewIncomingContextWithGrpcContext(ctx, grpcCtx)
 
 	cInfo := &CallInfo{
@@ -361,6 +371,7 @@ func (p *requestPipeline) endRequest(ctx *GrpcContext, info *CallInfo, h handler
 		}
 
 		info.StatusCode = nullable.NewNullable(uint32(statusCode))
+		observeCall(info.FullMethod, statusCode, info.ElapsedTime.Value)
 
 		if err := p.grpcServerLogger.LogCall(info); err != nil {
 			p.stats.addRequestWithError()
@@ -50,12 +50,15 @@ func (s *RequestPipelineStats) addRequest() {
 
 func (s *RequestPipelineStats) addRequestWithError() {
 	atomic.AddUint64(s.countOfReqsWithErr, 1)
+	callsWithErrTotal.Inc()
 }
 
 func (s *RequestPipelineStats) incrRequestsInProgress() {
 	atomic.AddInt64(s.countOfReqsInProgress, 1)
+	callsInProgress.Inc()
 }
 
 func (s *RequestPipelineStats) decrRequestsInProgress() {
 	atomic.AddInt64(s.countOfReqsInProgress, -1)
+	callsInProgress.Dec()
 }
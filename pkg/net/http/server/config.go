@@ -25,6 +25,7 @@ type HttpServerConfig struct {
 	// in the form "host:port".
 	Addr string
 
+	// Optional. If it's specified, the server serves HTTPS (HTTP/1.1 and HTTP/2).
 	TLSConfig *tls.Config
 
 	// ReadTimeout is the maximum duration for reading the entire
@@ -56,6 +57,15 @@ type RequestPipelineConfig struct {
 	UseErrorHandler   bool
 	UseHttpLogging    bool
 	CorsOptions       *cors.Options
+
+	// PreMiddlewares are the global middlewares, which are invoked in the order in which they have been added,
+	// after the request has been logged and before the built-in stages (CORS, authentication, authorization),
+	// e.g. a rate limiter or security headers.
+	PreMiddlewares []Middleware
+
+	// Middlewares are the global middlewares, which are invoked in the order in which they have been added,
+	// after the built-in stages (CORS, authentication, authorization) and before routing.
+	Middlewares []Middleware
 }
 
 type RequestPipelineConfigBuilder struct {
@@ -67,6 +77,8 @@ type RequestPipelineConfigBuilder struct {
 	useErrorHandler   bool
 	useHttpLogging    bool
 	corsOpts          *cors.Options
+	preMiddlewares    []Middleware
+	middlewares       []Middleware
 }
 
 func NewRequestPipelineConfigBuilder() *RequestPipelineConfigBuilder {
@@ -104,6 +116,32 @@ func (b *RequestPipelineConfigBuilder) UseHttpLogging() *RequestPipelineConfigBu
 	return b
 }
 
+// UseBefore adds the specified global middlewares to the pipeline, which are invoked
+// before the built-in stages (CORS, authentication, authorization).
+func (b *RequestPipelineConfigBuilder) UseBefore(middlewares ...Middleware) *RequestPipelineConfigBuilder {
+	for _, m := range middlewares {
+		if m == nil {
+			panic("[server.RequestPipelineConfigBuilder.UseBefore] middleware is nil")
+		}
+	}
+
+	b.preMiddlewares = append(b.preMiddlewares, middlewares...)
+	return b
+}
+
+// Use adds the specified global middlewares to the pipeline, which are invoked
+// after the built-in stages (CORS, authentication, authorization).
+func (b *RequestPipelineConfigBuilder) Use(middlewares ...Middleware) *RequestPipelineConfigBuilder {
+	for _, m := range middlewares {
+		if m == nil {
+			panic("[server.RequestPipelineConfigBuilder.Use] middleware is nil")
+		}
+	}
+
+	b.middlewares = append(b.middlewares, middlewares...)
+	return b
+}
+
 func (b *RequestPipelineConfigBuilder) UseRouting(r Router) *RequestPipelineConfigBuilder {
 	b.router = r
 	return b
@@ -119,5 +157,7 @@ func (b *RequestPipelineConfigBuilder) Build() *RequestPipelineConfig {
 		UseErrorHandler:   b.useErrorHandler,
 		UseHttpLogging:    b.useHttpLogging,
 		CorsOptions:       b.corsOpts,
+		PreMiddlewares:    b.preMiddlewares,
+		Middlewares:       b.middlewares,
 	}
 }
@@ -0,0 +1,107 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"personal-website-v2/pkg/health"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/logging/events"
+)
+
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// healthHandlers serve the liveness and readiness probes.
+type healthHandlers struct {
+	checker   *health.Checker
+	logger    logging.Logger[*context.LogEntryContext]
+	loggerCtx *context.LogEntryContext
+}
+
+// AddHealthRoutes adds the routes of the liveness (/healthz) and readiness (/readyz) probes
+// to the router. The readiness probe runs the checks of the checker. The probes aren't
+// authenticated, so they should only be added to a server that isn't exposed publicly.
+//
+// While the request pipeline isn't allowed to serve HTTP, it responds with 503 Service Unavailable
+// before routing, so both probes fail.
+func AddHealthRoutes(router Router, checker *health.Checker, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) error {
+	if checker == nil {
+		return errors.New("[server.AddHealthRoutes] checker is nil")
+	}
+
+	l, err := loggerFactory.CreateLogger("net.http.server.healthHandlers")
+	if err != nil {
+		return fmt.Errorf("[server.AddHealthRoutes] create a logger: %w", err)
+	}
+
+	h := &healthHandlers{
+		checker:   checker,
+		logger:    l,
+		loggerCtx: &context.LogEntryContext{},
+	}
+
+	router.Add("Health_Liveness", LivenessPath, h.serveLiveness, http.MethodGet, http.MethodHead)
+	router.Add("Health_Readiness", ReadinessPath, h.serveReadiness, http.MethodGet, http.MethodHead)
+	return nil
+}
+
+func (h *healthHandlers) serveLiveness(ctx *HttpContext) {
+	writeHealthResponse(ctx.Response.Writer, http.StatusOK, "text/plain; charset=utf-8", []byte("ok"))
+}
+
+// serveReadiness runs the health checks. The probes aren't authenticated, so the errors of the checks
+// are logged and aren't exposed.
+func (h *healthHandlers) serveReadiness(ctx *HttpContext) {
+	report := h.checker.Check(ctx.Request.Context())
+	for _, cr := range report.Checks {
+		if cr.Status != health.StatusPass {
+			h.logger.WarningWithEvent(h.loggerCtx, events.NetHttpServerEvent, "[server.healthHandlers.serveReadiness] health check failed",
+				logging.NewField("check", cr.Name),
+				logging.NewField("error", cr.Error),
+			)
+			cr.Error = ""
+		}
+	}
+
+	b, err := json.Marshal(report)
+	if err != nil {
+		h.logger.ErrorWithEvent(h.loggerCtx, events.NetHttpServerEvent, err, "[server.healthHandlers.serveReadiness] marshal a health report to JSON")
+		writeHealthResponse(ctx.Response.Writer, http.StatusInternalServerError, "text/plain; charset=utf-8", nil)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !report.IsHealthy() {
+		statusCode = http.StatusServiceUnavailable
+	}
+	writeHealthResponse(ctx.Response.Writer, statusCode, "application/json; charset=UTF-8", b)
+}
+
+func writeHealthResponse(w http.ResponseWriter, statusCode int, contentType string, body []byte) {
+	h := w.Header()
+	h.Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	h.Set("Content-Type", contentType)
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
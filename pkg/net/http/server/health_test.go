@@ -0,0 +1,101 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"personal-website-v2/pkg/health"
+	"personal-website-v2/pkg/logging"
+	lcontext "personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/logging/logger"
+)
+
+func newTestHealthHandlers(t *testing.T, check health.CheckFunc) *healthHandlers {
+	idGenerator, err := logger.NewIdGenerator(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := &healthHandlers{
+		checker: health.NewChecker(0),
+		logger: logger.NewLogger[*lcontext.LogEntryContext]("test", idGenerator, nil,
+			&logger.LoggerOptions{MinLogLevel: logging.LogLevelTrace, MaxLogLevel: logging.LogLevelFatal}, nil, nil, false,
+		),
+		loggerCtx: &lcontext.LogEntryContext{},
+	}
+	h.checker.Add("db", check)
+	return h
+}
+
+// newTestHealthContext returns a new HTTP context whose response is written to the recorder
+// (NewResponse requires the response of the net/http server).
+func newTestHealthContext(w *httptest.ResponseRecorder, path string) *HttpContext {
+	return &HttpContext{
+		Request:  httptest.NewRequest(http.MethodGet, path, nil),
+		Response: &Response{Writer: w},
+	}
+}
+
+func TestHealthHandlers(t *testing.T) {
+	cases := []struct {
+		name       string
+		path       string
+		checkErr   error
+		statusCode int
+	}{
+		{"liveness", LivenessPath, nil, http.StatusOK},
+		{"liveness (check failed)", LivenessPath, errors.New("connect to 10.0.0.1:5432"), http.StatusOK},
+		{"readiness", ReadinessPath, nil, http.StatusOK},
+		{"readiness (check failed)", ReadinessPath, errors.New("connect to 10.0.0.1:5432"), http.StatusServiceUnavailable},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := newTestHealthHandlers(t, func(ctx context.Context) error { return c.checkErr })
+			w := httptest.NewRecorder()
+			ctx := newTestHealthContext(w, c.path)
+
+			if c.path == LivenessPath {
+				h.serveLiveness(ctx)
+			} else {
+				h.serveReadiness(ctx)
+			}
+
+			if w.Code != c.statusCode {
+				t.Fatalf("status code: expected: %d; got: %d", c.statusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHealthHandlers_serveReadiness_errors(t *testing.T) {
+	h := newTestHealthHandlers(t, func(ctx context.Context) error { return errors.New("connect to 10.0.0.1:5432") })
+	w := httptest.NewRecorder()
+	h.serveReadiness(newTestHealthContext(w, ReadinessPath))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status code: expected: %d; got: %d", http.StatusServiceUnavailable, w.Code)
+	}
+
+	if body := w.Body.String(); strings.Contains(body, "error") {
+		t.Fatalf("expected the errors not to be exposed; body: %s", body)
+	}
+}
@@ -35,4 +35,5 @@ func (s *HttpServerStats) CountOfErrorsWithoutPipeline() uint64 {
 
 func (s *HttpServerStats) addError() {
 	atomic.AddUint64(s.countOfErrorsWithoutPipeline, 1)
+	errorsWithoutPipelineTotal.Inc()
 }
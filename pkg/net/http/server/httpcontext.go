@@ -16,6 +16,7 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -31,10 +32,15 @@ type HttpContext struct {
 	Transaction          *actions.Transaction
 	User                 identity.Identity
 
+	// PathParams are the values of the path parameters of the matched route, if any.
+	PathParams PathParams
+
 	// Items (SharedData) are a key/value collection that can be used to share data within the scope of this request.
-	Items    map[any]any
-	reqId    uuid.NullUUID
-	hasError bool
+	Items     map[any]any
+	reqId     uuid.NullUUID
+	hasError  bool
+	startTime time.Time
+	routeName string // name of the matched route, if any
 }
 
 func NewHttpContext(req *http.Request, res *Response) *HttpContext {
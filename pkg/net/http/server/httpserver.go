@@ -140,6 +140,7 @@ func (s *HttpServer) configure() {
 		ReadTimeout:  s.config.ReadTimeout,
 		WriteTimeout: s.config.WriteTimeout,
 		IdleTimeout:  s.config.IdleTimeout,
+		TLSConfig:    s.config.TLSConfig,
 		ErrorLog:     l,
 	}
 }
@@ -168,7 +169,14 @@ func (s *HttpServer) serve(l net.Listener) {
 
 	go func() {
 		defer s.wg.Done()
-		err := s.server.Serve(l)
+		var err error
+
+		if s.server.TLSConfig != nil {
+			// the certificates are provided by TLSConfig; HTTP/2 is configured by ServeTLS
+			err = s.server.ServeTLS(l, "", "")
+		} else {
+			err = s.server.Serve(l)
+		}
 
 		if err == nil || (err == http.ErrServerClosed && s.isStopping.Load()) {
 			return
@@ -0,0 +1,57 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"personal-website-v2/pkg/metrics"
+)
+
+var (
+	requestsTotal = metrics.NewCounterVec("http_server_requests_total",
+		"Number of HTTP requests that have been served.", "route", "method", "status_code")
+	requestDuration = metrics.NewHistogramVec("http_server_request_duration_seconds",
+		"Duration of serving HTTP requests.", nil, "route", "method", "status_code")
+	requestsInProgress = metrics.NewGauge("http_server_requests_in_progress",
+		"Number of HTTP requests in progress.")
+	requestsWithErrTotal = metrics.NewCounter("http_server_requests_with_error_total",
+		"Number of HTTP requests with an error that occurred while serving and handling the request.")
+	responsesWithErrTotal = metrics.NewCounter("http_server_responses_with_error_total",
+		"Number of HTTP responses with an error that occurred while logging.")
+	errorsWithoutPipelineTotal = metrics.NewCounter("http_server_errors_without_pipeline_total",
+		"Number of HTTP server errors without a request pipeline.")
+)
+
+func init() {
+	metrics.MustRegister(requestsTotal, requestDuration, requestsInProgress, requestsWithErrTotal, responsesWithErrTotal, errorsWithoutPipelineTotal)
+}
+
+func observeRequest(ctx *HttpContext, elapsedTime time.Duration) {
+	method := ctx.Request.Method
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+	default:
+		// the number of the label values must be limited
+		method = "OTHER"
+	}
+
+	labelValues := []string{ctx.routeName, method, strconv.Itoa(ctx.Response.StatusCode())}
+	requestsTotal.WithLabelValues(labelValues...).Inc()
+	requestDuration.WithLabelValues(labelValues...).Observe(elapsedTime.Seconds())
+}
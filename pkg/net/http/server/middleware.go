@@ -0,0 +1,31 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+// Middleware is a stage of the request pipeline. It can handle the request before
+// and after the next stage and can short-circuit the pipeline by not calling next.
+type Middleware func(ctx *HttpContext, next HandlerFunc)
+
+// Chain returns a handler that invokes the specified middlewares in the order
+// in which they are specified and then the specified handler.
+func Chain(handler HandlerFunc, middlewares ...Middleware) HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		m, next := middlewares[i], handler
+		handler = func(ctx *HttpContext) {
+			m(ctx, next)
+		}
+	}
+	return handler
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import "testing"
+
+func TestChain(t *testing.T) {
+	newMiddleware := func(name string, callNext bool) Middleware {
+		return func(ctx *HttpContext, next HandlerFunc) {
+			ctx.Items["trace"] = ctx.Items["trace"].(string) + name + ">"
+			if callNext {
+				next(ctx)
+			}
+			ctx.Items["trace"] = ctx.Items["trace"].(string) + "<" + name
+		}
+	}
+	handler := func(ctx *HttpContext) {
+		ctx.Items["trace"] = ctx.Items["trace"].(string) + "h"
+	}
+
+	cases := []struct {
+		name        string
+		middlewares []Middleware
+		expected    string
+	}{
+		{"no middlewares", nil, "h"},
+		{"ordering", []Middleware{newMiddleware("m1", true), newMiddleware("m2", true)}, "m1>m2>h<m2<m1"},
+		{"short-circuiting", []Middleware{newMiddleware("m1", true), newMiddleware("m2", false), newMiddleware("m3", true)}, "m1>m2><m2<m1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := NewHttpContext(nil, nil)
+			ctx.Items["trace"] = ""
+			Chain(handler, c.middlewares...).Invoke(ctx)
+
+			if trace := ctx.Items["trace"].(string); trace != c.expected {
+				t.Fatalf("expected: %q; got: %q", c.expected, trace)
+			}
+		})
+	}
+}
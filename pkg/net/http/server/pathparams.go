@@ -0,0 +1,58 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PathParams are the values of the path parameters of the matched route (map[ParamName]Value).
+type PathParams map[string]string
+
+// Get returns the value of the path parameter with the specified name and true
+// if the parameter exists; otherwise, an empty string and false.
+func (p PathParams) Get(name string) (string, bool) {
+	v, ok := p[name]
+	return v, ok
+}
+
+// Int64 returns the value of the path parameter with the specified name as int64.
+func (p PathParams) Int64(name string) (int64, error) {
+	v, ok := p[name]
+	if !ok {
+		return 0, fmt.Errorf("[server.PathParams.Int64] path parameter '%s' is missing", name)
+	}
+
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[server.PathParams.Int64] parse path parameter '%s': %w", name, err)
+	}
+	return i, nil
+}
+
+// Uint64 returns the value of the path parameter with the specified name as uint64.
+func (p PathParams) Uint64(name string) (uint64, error) {
+	v, ok := p[name]
+	if !ok {
+		return 0, fmt.Errorf("[server.PathParams.Uint64] path parameter '%s' is missing", name)
+	}
+
+	i, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[server.PathParams.Uint64] parse path parameter '%s': %w", name, err)
+	}
+	return i, nil
+}
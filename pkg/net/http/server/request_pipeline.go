@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"unsafe"
@@ -54,6 +55,7 @@ type requestPipeline struct {
 	isAllowedToServeHTTP atomic.Bool
 	loggerCtx            *context.LogEntryContext
 	cors                 *cors.Cors
+	handler              HandlerFunc
 }
 
 func newRequestPipeline(
@@ -107,9 +109,37 @@ func newRequestPipeline(
 		}
 		p.cors = c
 	}
+
+	p.handler = Chain(p.route, p.middlewares()...)
 	return p, nil
 }
 
+// middlewares returns the built-in stages of the pipeline with the global middlewares:
+// the request logging, the PreMiddlewares, CORS, authentication, authorization and the Middlewares.
+func (p *requestPipeline) middlewares() []Middleware {
+	ms := []Middleware{p.serveRequest}
+	ms = append(ms, p.config.PreMiddlewares...)
+
+	if p.cors != nil {
+		ms = append(ms, p.handleCors)
+	}
+
+	if p.lifetime != nil {
+		ms = append(ms, p.beginRequest)
+
+		if p.config.UseAuthentication {
+			ms = append(ms, p.authenticate)
+		}
+	}
+
+	ms = append(ms, p.setDefaultIdentity)
+
+	if p.lifetime != nil && p.config.UseAuthorization {
+		ms = append(ms, p.authorize)
+	}
+	return append(ms, p.config.Middlewares...)
+}
+
 func (p *requestPipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	startTime := datetime.Now()
 	p.wgInProgress.Add(1)
@@ -137,8 +167,15 @@ func (p *requestPipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	p.stats.addRequest()
-	res := NewResponse(w)
-	ctx := NewHttpContext(r, res)
+	ctx := NewHttpContext(r, NewResponse(w))
+	ctx.startTime = startTime
+	p.handler(ctx)
+}
+
+// serveRequest creates the request ID, logs the request, and logs the response
+// and handles panics after the next stages have been completed.
+func (p *requestPipeline) serveRequest(ctx *HttpContext, next HandlerFunc) {
+	w, r, res := ctx.Response.Writer, ctx.Request, ctx.Response
 	reqId, err := p.reqIdGenerator.get()
 
 	if err != nil {
@@ -175,7 +212,7 @@ func (p *requestPipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	reqInfo := NewRequestInfo(r)
 	reqInfo.Id = reqId
 	reqInfo.Status = RequestStatusInProgress
-	reqInfo.StartTime = startTime
+	reqInfo.StartTime = ctx.startTime
 
 	if err = p.httpServerLogger.LogRequest(reqInfo); err != nil {
 		p.stats.addRequestWithError()
@@ -251,53 +288,70 @@ func (p *requestPipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	p.handleRequest(ctx)
+	next(ctx)
 	succeeded = true
 }
 
-func (p *requestPipeline) handleRequest(ctx *HttpContext) {
-	if p.cors != nil {
-		p.cors.ServeHTTP(ctx.Response.Writer, ctx.Request, ctx.reqId.UUID)
-		if ctx.Response.isHeaderWritten() {
-			return
-		}
+func (p *requestPipeline) handleCors(ctx *HttpContext, next HandlerFunc) {
+	p.cors.ServeHTTP(ctx.Response.Writer, ctx.Request, ctx.reqId.UUID)
+	if ctx.Response.isHeaderWritten() {
+		return
 	}
+	next(ctx)
+}
 
-	if p.lifetime != nil {
-		p.lifetime.BeginRequest(ctx)
-		if ctx.Response.isHeaderWritten() {
-			return
-		}
-
-		if p.config.UseAuthentication {
-			p.lifetime.Authenticate(ctx)
-			if ctx.Response.isHeaderWritten() {
-				return
-			}
-		}
+func (p *requestPipeline) beginRequest(ctx *HttpContext, next HandlerFunc) {
+	p.lifetime.BeginRequest(ctx)
+	if ctx.Response.isHeaderWritten() {
+		return
+	}
+	next(ctx)
+}
 
-		if ctx.User == nil {
-			ctx.User = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
-		}
+func (p *requestPipeline) authenticate(ctx *HttpContext, next HandlerFunc) {
+	p.lifetime.Authenticate(ctx)
+	if ctx.Response.isHeaderWritten() {
+		return
+	}
+	next(ctx)
+}
 
-		if p.config.UseAuthorization {
-			p.lifetime.Authorize(ctx)
-			if ctx.Response.isHeaderWritten() {
-				return
-			}
-		}
-	} else {
+func (p *requestPipeline) setDefaultIdentity(ctx *HttpContext, next HandlerFunc) {
+	if ctx.User == nil {
 		ctx.User = identity.NewDefaultIdentity(nullable.Nullable[uint64]{}, identity.UserTypeUser, nullable.Nullable[uint64]{})
 	}
+	next(ctx)
+}
+
+func (p *requestPipeline) authorize(ctx *HttpContext, next HandlerFunc) {
+	p.lifetime.Authorize(ctx)
+	if ctx.Response.isHeaderWritten() {
+		return
+	}
+	next(ctx)
+}
 
+// route finds the route and invokes its middlewares and handler.
+func (p *requestPipeline) route(ctx *HttpContext) {
 	if p.router == nil {
 		return
 	}
 
-	if route := p.router.Find(ctx); route != nil {
-		route.Handler().Invoke(ctx)
+	route := p.router.Find(ctx)
+	if route == nil {
+		if ms := p.router.AllowedMethods(ctx); len(ms) > 0 {
+			p.writeMethodNotAllowed(ctx.Response.Writer, ms)
+		} else {
+			p.onNotFound(ctx)
+		}
+		return
+	}
+
+	ctx.routeName = route.Name()
+	if ms := route.Middlewares(); len(ms) > 0 {
+		Chain(route.Handler(), ms...).Invoke(ctx)
 	} else {
-		p.onNotFound(ctx)
+		route.Handler().Invoke(ctx)
 	}
 }
 
@@ -321,6 +375,16 @@ func (p *requestPipeline) writeNotFound(w http.ResponseWriter) {
 	w.Write([]byte("404 page not found"))
 }
 
+func (p *requestPipeline) writeMethodNotAllowed(w http.ResponseWriter, allowedMethods []string) {
+	h := w.Header()
+	h.Set("Allow", strings.Join(allowedMethods, ", "))
+	h.Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	h.Set("Content-Type", "text/plain; charset=utf-8")
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	w.Write([]byte("405 method not allowed"))
+}
+
 func (p *requestPipeline) endRequest(ctx *HttpContext, reqInfo *RequestInfo) {
 	succeeded := false
 	defer func() {
@@ -353,6 +417,7 @@ func (p *requestPipeline) endRequest(ctx *HttpContext, reqInfo *RequestInfo) {
 		defer func() {
 			reqInfo.EndTime = nullable.NewNullable(datetime.Now())
 			reqInfo.ElapsedTime = nullable.NewNullable(reqInfo.EndTime.Value.Sub(reqInfo.StartTime))
+			observeRequest(ctx, reqInfo.ElapsedTime.Value)
 
 			if err := p.httpServerLogger.LogRequest(reqInfo); err != nil {
 				p.stats.addRequestWithError()
@@ -67,16 +67,20 @@ func (s *RequestPipelineStats) addResponse() {
 
 func (s *RequestPipelineStats) addRequestWithError() {
 	atomic.AddUint64(s.countOfReqsWithErr, 1)
+	requestsWithErrTotal.Inc()
 }
 
 func (s *RequestPipelineStats) addResponseWithError() {
 	atomic.AddUint64(s.countOfResponsesWithErr, 1)
+	responsesWithErrTotal.Inc()
 }
 
 func (s *RequestPipelineStats) incrRequestsInProgress() {
 	atomic.AddInt64(s.countOfReqsInProgress, 1)
+	requestsInProgress.Inc()
 }
 
 func (s *RequestPipelineStats) decrRequestsInProgress() {
 	atomic.AddInt64(s.countOfReqsInProgress, -1)
+	requestsInProgress.Dec()
 }
@@ -377,3 +377,29 @@ func getId() (uuid.UUID, error) {
 	}
 	return id, nil
 }
+
+func TestRequestPipeline_middlewares(t *testing.T) {
+	var trace string
+	newMiddleware := func(name string) Middleware {
+		return func(ctx *HttpContext, next HandlerFunc) {
+			// the default identity is set by the built-in stages
+			trace += fmt.Sprintf("%s(%t)>", name, ctx.User != nil)
+			next(ctx)
+		}
+	}
+
+	c := NewRequestPipelineConfigBuilder().
+		Use(newMiddleware("m1")).
+		UseBefore(newMiddleware("pre1"), newMiddleware("pre2")).
+		Use(newMiddleware("m2")).
+		Build()
+	p := &requestPipeline{config: c}
+	ms := p.middlewares()
+
+	// the first stage (serveRequest) requires a fully initialized pipeline
+	Chain(func(ctx *HttpContext) { trace += "h" }, ms[1:]...).Invoke(NewHttpContext(nil, nil))
+
+	if expected := "pre1(false)>pre2(false)>m1(true)>m2(true)>h"; trace != expected {
+		t.Fatalf("expected: %q; got: %q", expected, trace)
+	}
+}
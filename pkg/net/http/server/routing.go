@@ -23,6 +23,11 @@ func (f HandlerFunc) Invoke(ctx *HttpContext) {
 type Router interface {
 	Add(name, pattern string, handler HandlerFunc, methods ...string) Route
 	Find(ctx *HttpContext) Route
+
+	// AllowedMethods returns the methods, other than the request method, for which a route
+	// matches the request path. It's used to respond with 405 Method Not Allowed
+	// if no route has been found for the request.
+	AllowedMethods(ctx *HttpContext) []string
 }
 
 type Route interface {
@@ -30,5 +35,12 @@ type Route interface {
 	Pattern() string
 	Handler() HandlerFunc
 	Methods() []string
+
+	// Middlewares returns the middlewares of the route, which are invoked in the order
+	// in which they have been added, after the global middlewares and before the route handler.
+	Middlewares() []Middleware
 	WithFullPathMatch() Route
+
+	// Use adds the specified middlewares to the route.
+	Use(middlewares ...Middleware) Route
 }
@@ -0,0 +1,105 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"net/http"
+	"strings"
+
+	"personal-website-v2/pkg/net/http/server"
+)
+
+// RouteGroup is a group of routes with the common path prefix and middlewares.
+// The middlewares of the group are invoked before the middlewares of its routes.
+type RouteGroup struct {
+	router      *Router
+	prefix      string
+	middlewares []server.Middleware
+}
+
+func newRouteGroup(router *Router, prefix string, middlewares []server.Middleware) *RouteGroup {
+	if len(prefix) == 0 || prefix[0] != '/' || strings.HasSuffix(prefix, "/") {
+		panic("[routing.newRouteGroup] invalid prefix (a prefix must start with '/' and must not end with '/')")
+	}
+
+	for _, m := range middlewares {
+		if m == nil {
+			panic("[routing.newRouteGroup] middleware is nil")
+		}
+	}
+
+	return &RouteGroup{
+		router:      router,
+		prefix:      prefix,
+		middlewares: middlewares,
+	}
+}
+
+// Prefix returns the path prefix of the group.
+func (g *RouteGroup) Prefix() string {
+	return g.prefix
+}
+
+// Group returns a nested route group. Its prefix is appended to the prefix of the group
+// and its middlewares are invoked after the middlewares of the group.
+func (g *RouteGroup) Group(prefix string, middlewares ...server.Middleware) *RouteGroup {
+	ms := make([]server.Middleware, 0, len(g.middlewares)+len(middlewares))
+	ms = append(ms, g.middlewares...)
+	ms = append(ms, middlewares...)
+	return newRouteGroup(g.router, g.prefix+prefix, ms)
+}
+
+// Add adds a route for the specified methods or for all methods if no methods are specified
+// (see Router.Add).
+func (g *RouteGroup) Add(name, pattern string, handler server.HandlerFunc, methods ...string) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, methods)
+}
+
+// AddWithMiddlewares adds a route with the specified middlewares for the specified methods
+// or for all methods if methods is empty. The middlewares of the route are invoked
+// after the middlewares of the group.
+func (g *RouteGroup) AddWithMiddlewares(name, pattern string, handler server.HandlerFunc, methods []string, middlewares ...server.Middleware) server.Route {
+	if len(pattern) == 0 || pattern[0] != '/' {
+		panic("[routing.RouteGroup.AddWithMiddlewares] invalid pattern")
+	}
+
+	ms := middlewares
+	if len(g.middlewares) > 0 {
+		ms = make([]server.Middleware, 0, len(g.middlewares)+len(middlewares))
+		ms = append(ms, g.middlewares...)
+		ms = append(ms, middlewares...)
+	}
+	return g.router.AddWithMiddlewares(name, g.prefix+pattern, handler, methods, ms...)
+}
+
+func (g *RouteGroup) AddGet(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, []string{http.MethodGet}, middlewares...)
+}
+
+func (g *RouteGroup) AddPost(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPost}, middlewares...)
+}
+
+func (g *RouteGroup) AddPut(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPut}, middlewares...)
+}
+
+func (g *RouteGroup) AddPatch(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPatch}, middlewares...)
+}
+
+func (g *RouteGroup) AddDelete(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return g.AddWithMiddlewares(name, pattern, handler, []string{http.MethodDelete}, middlewares...)
+}
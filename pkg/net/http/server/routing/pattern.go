@@ -0,0 +1,193 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"personal-website-v2/pkg/net/http/server"
+)
+
+type paramType uint8
+
+const (
+	// paramTypeString matches any non-empty segment: {name} or {name:string}.
+	paramTypeString paramType = iota
+
+	// paramTypeInt matches a signed 64-bit integer: {name:int}.
+	paramTypeInt
+
+	// paramTypeUint matches an unsigned 64-bit integer: {name:uint}.
+	paramTypeUint
+)
+
+func parseParamType(s string) (paramType, bool) {
+	switch s {
+	case "", "string":
+		return paramTypeString, true
+	case "int":
+		return paramTypeInt, true
+	case "uint":
+		return paramTypeUint, true
+	}
+	return 0, false
+}
+
+func (t paramType) isValid(v string) bool {
+	switch t {
+	case paramTypeString:
+		return len(v) > 0
+	case paramTypeInt:
+		_, err := strconv.ParseInt(v, 10, 64)
+		return err == nil
+	case paramTypeUint:
+		_, err := strconv.ParseUint(v, 10, 64)
+		return err == nil
+	}
+	return false
+}
+
+type segment struct {
+	value     string // literal or param name
+	isParam   bool
+	paramType paramType
+}
+
+// pathPattern is a pattern with path parameters, e.g. /api/apps/{id:uint}/status.
+// A path parameter must be a whole segment.
+type pathPattern struct {
+	segments []*segment
+}
+
+func hasPathParams(pattern string) bool {
+	return strings.ContainsAny(pattern, "{}")
+}
+
+func parsePathPattern(pattern string) (*pathPattern, error) {
+	if len(pattern) == 0 || pattern[0] != '/' {
+		return nil, fmt.Errorf("[routing.parsePathPattern] pattern '%s' doesn't start with '/'", pattern)
+	}
+
+	ss := strings.Split(pattern[1:], "/")
+	p := &pathPattern{segments: make([]*segment, len(ss))}
+	names := make(map[string]bool, len(ss))
+
+	for i, s := range ss {
+		if !strings.ContainsAny(s, "{}") {
+			p.segments[i] = &segment{value: s}
+			continue
+		}
+
+		if len(s) < 3 || s[0] != '{' || s[len(s)-1] != '}' || strings.ContainsAny(s[1:len(s)-1], "{}") {
+			return nil, fmt.Errorf("[routing.parsePathPattern] invalid segment '%s' (a path parameter must be a whole segment)", s)
+		}
+
+		name, typ, _ := strings.Cut(s[1:len(s)-1], ":")
+		if len(name) == 0 {
+			return nil, fmt.Errorf("[routing.parsePathPattern] path parameter name is empty in segment '%s'", s)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("[routing.parsePathPattern] duplicate path parameter '%s'", name)
+		}
+
+		t, ok := parseParamType(typ)
+		if !ok {
+			return nil, fmt.Errorf("[routing.parsePathPattern] invalid type '%s' of path parameter '%s'", typ, name)
+		}
+
+		names[name] = true
+		p.segments[i] = &segment{value: name, isParam: true, paramType: t}
+	}
+	return p, nil
+}
+
+// match returns the values of the path parameters and true if the specified escaped path matches the pattern.
+func (p *pathPattern) match(path string) (server.PathParams, bool) {
+	if len(path) == 0 || path[0] != '/' {
+		return nil, false
+	}
+
+	path = path[1:]
+	params := make(server.PathParams)
+
+	for i, s := range p.segments {
+		var v string
+		if i < len(p.segments)-1 {
+			var ok bool
+			if v, path, ok = strings.Cut(path, "/"); !ok {
+				return nil, false
+			}
+		} else {
+			if strings.IndexByte(path, '/') >= 0 {
+				return nil, false
+			}
+			v = path
+		}
+
+		v, err := url.PathUnescape(v)
+		if err != nil {
+			return nil, false
+		}
+
+		if !s.isParam {
+			if v != s.value {
+				return nil, false
+			}
+			continue
+		}
+
+		if !s.paramType.isValid(v) {
+			return nil, false
+		}
+		params[s.value] = v
+	}
+	return params, true
+}
+
+// build returns the path in which the path parameters are replaced with the specified values.
+func (p *pathPattern) build(params map[string]any) (string, error) {
+	var b strings.Builder
+	n := 0
+
+	for _, s := range p.segments {
+		b.WriteByte('/')
+
+		if !s.isParam {
+			b.WriteString(s.value)
+			continue
+		}
+
+		v, ok := params[s.value]
+		if !ok {
+			return "", fmt.Errorf("[routing.pathPattern.build] path parameter '%s' is missing", s.value)
+		}
+
+		vs := fmt.Sprint(v)
+		if !s.paramType.isValid(vs) {
+			return "", fmt.Errorf("[routing.pathPattern.build] invalid value '%s' of path parameter '%s'", vs, s.value)
+		}
+
+		b.WriteString(url.PathEscape(vs))
+		n++
+	}
+
+	if n != len(params) {
+		return "", fmt.Errorf("[routing.pathPattern.build] number of path parameters (%d) doesn't match the pattern (%d)", len(params), n)
+	}
+	return b.String(), nil
+}
@@ -21,7 +21,9 @@ type Route struct {
 	pattern       string
 	handler       server.HandlerFunc
 	methods       []string
+	middlewares   []server.Middleware
 	fullPathMatch bool
+	path          *pathPattern // nil if the pattern doesn't contain path parameters
 }
 
 var _ server.Route = (*Route)(nil)
@@ -51,7 +53,22 @@ func (r *Route) Methods() []string {
 	return r.methods
 }
 
+func (r *Route) Middlewares() []server.Middleware {
+	return r.middlewares
+}
+
 func (r *Route) WithFullPathMatch() server.Route {
 	r.fullPathMatch = true
 	return r
 }
+
+func (r *Route) Use(middlewares ...server.Middleware) server.Route {
+	for _, m := range middlewares {
+		if m == nil {
+			panic("[routing.Route.Use] middleware is nil")
+		}
+	}
+
+	r.middlewares = append(r.middlewares, middlewares...)
+	return r
+}
@@ -15,6 +15,7 @@
 package routing
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 
@@ -23,141 +24,242 @@ import (
 
 var notFoundFuncPtr = reflect.ValueOf(http.NotFoundHandler()).UnsafePointer()
 
+var methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
+// Router is an HTTP router. Patterns without path parameters are matched by http.ServeMux,
+// patterns with path parameters (e.g. /api/apps/{id:uint}) are matched in the order
+// in which they have been added. A static pattern that matches the whole path takes
+// precedence over patterns with path parameters.
 type Router struct {
-	getMux     *http.ServeMux
-	postMux    *http.ServeMux
-	putMux     *http.ServeMux
-	patchMux   *http.ServeMux
-	deleteMux  *http.ServeMux
-	headMux    *http.ServeMux
-	connectMux *http.ServeMux
-	optionsMux *http.ServeMux
-	traceMux   *http.ServeMux
+	getMux       *http.ServeMux
+	postMux      *http.ServeMux
+	putMux       *http.ServeMux
+	patchMux     *http.ServeMux
+	deleteMux    *http.ServeMux
+	headMux      *http.ServeMux
+	connectMux   *http.ServeMux
+	optionsMux   *http.ServeMux
+	traceMux     *http.ServeMux
+	paramRoutes  map[string][]*Route // map[Method][]*Route
+	routesByName map[string]*Route
 }
 
 var _ server.Router = (*Router)(nil)
 
 func NewRouter() *Router {
 	return &Router{
-		getMux:     http.NewServeMux(),
-		postMux:    http.NewServeMux(),
-		putMux:     http.NewServeMux(),
-		patchMux:   http.NewServeMux(),
-		deleteMux:  http.NewServeMux(),
-		headMux:    http.NewServeMux(),
-		connectMux: http.NewServeMux(),
-		optionsMux: http.NewServeMux(),
-		traceMux:   http.NewServeMux(),
+		getMux:       http.NewServeMux(),
+		postMux:      http.NewServeMux(),
+		putMux:       http.NewServeMux(),
+		patchMux:     http.NewServeMux(),
+		deleteMux:    http.NewServeMux(),
+		headMux:      http.NewServeMux(),
+		connectMux:   http.NewServeMux(),
+		optionsMux:   http.NewServeMux(),
+		traceMux:     http.NewServeMux(),
+		paramRoutes:  make(map[string][]*Route),
+		routesByName: make(map[string]*Route),
 	}
 }
 
+// Add adds a route for the specified methods or for all methods if no methods are specified.
+// The methods are variadic, therefore the middlewares of the route are specified
+// by AddWithMiddlewares, the AddGet, AddPost, ... helpers or Route.Use.
 func (r *Router) Add(name, pattern string, handler server.HandlerFunc, methods ...string) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, methods)
+}
+
+// AddWithMiddlewares adds a route with the specified middlewares for the specified methods
+// or for all methods if methods is empty.
+func (r *Router) AddWithMiddlewares(name, pattern string, handler server.HandlerFunc, methods []string, middlewares ...server.Middleware) server.Route {
 	if len(pattern) == 0 {
-		panic("[routing.Router.Add] invalid pattern")
+		panic("[routing.Router.AddWithMiddlewares] invalid pattern")
 	}
 	if handler == nil {
-		panic("[routing.Router.Add] handler is nil")
+		panic("[routing.Router.AddWithMiddlewares] handler is nil")
+	}
+	if _, ok := r.routesByName[name]; ok && len(name) > 0 {
+		panic(fmt.Sprintf("[routing.Router.AddWithMiddlewares] route '%s' has already been added", name))
 	}
 
 	route := NewRoute(name, pattern, handler, methods)
-	h := newHandler(route)
+	if len(middlewares) > 0 {
+		route.Use(middlewares...)
+	}
 
-	if len(methods) > 0 {
-		for _, m := range methods {
-			var mux *http.ServeMux
-
-			switch m {
-			case http.MethodGet:
-				mux = r.getMux
-			case http.MethodPost:
-				mux = r.postMux
-			case http.MethodPut:
-				mux = r.putMux
-			case http.MethodPatch:
-				mux = r.patchMux
-			case http.MethodDelete:
-				mux = r.deleteMux
-			case http.MethodHead:
-				mux = r.headMux
-			case http.MethodConnect:
-				mux = r.connectMux
-			case http.MethodOptions:
-				mux = r.optionsMux
-			case http.MethodTrace:
-				mux = r.traceMux
-			default:
-				panic("[routing.Router.Add] invalid method")
-			}
+	if hasPathParams(pattern) {
+		p, err := parsePathPattern(pattern)
+		if err != nil {
+			panic("[routing.Router.AddWithMiddlewares] parse a pattern: " + err.Error())
+		}
+		route.path = p
+	}
+
+	if len(methods) == 0 {
+		methods = allMethods()
+	}
+
+	h := newHandler(route)
+	for _, m := range methods {
+		mux := r.mux(m)
+		if mux == nil {
+			panic("[routing.Router.AddWithMiddlewares] invalid method")
+		}
 
+		if route.path != nil {
+			r.paramRoutes[m] = append(r.paramRoutes[m], route)
+		} else {
 			mux.Handle(pattern, h)
 		}
-	} else {
-		r.getMux.Handle(pattern, h)
-		r.postMux.Handle(pattern, h)
-		r.putMux.Handle(pattern, h)
-		r.patchMux.Handle(pattern, h)
-		r.deleteMux.Handle(pattern, h)
-		r.headMux.Handle(pattern, h)
-		r.connectMux.Handle(pattern, h)
-		r.optionsMux.Handle(pattern, h)
-		r.traceMux.Handle(pattern, h)
+	}
+
+	if len(name) > 0 {
+		r.routesByName[name] = route
 	}
 	return route
 }
 
-func (r *Router) AddGet(name, pattern string, handler server.HandlerFunc) server.Route {
-	return r.Add(name, pattern, handler, http.MethodGet)
+func (r *Router) AddGet(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, []string{http.MethodGet}, middlewares...)
 }
 
-func (r *Router) AddPost(name, pattern string, handler server.HandlerFunc) server.Route {
-	return r.Add(name, pattern, handler, http.MethodPost)
+func (r *Router) AddPost(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPost}, middlewares...)
 }
 
-func (r *Router) AddPut(name, pattern string, handler server.HandlerFunc) server.Route {
-	return r.Add(name, pattern, handler, http.MethodPut)
+func (r *Router) AddPut(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPut}, middlewares...)
 }
 
-func (r *Router) AddPatch(name, pattern string, handler server.HandlerFunc) server.Route {
-	return r.Add(name, pattern, handler, http.MethodPatch)
+func (r *Router) AddPatch(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, []string{http.MethodPatch}, middlewares...)
 }
 
-func (r *Router) AddDelete(name, pattern string, handler server.HandlerFunc) server.Route {
-	return r.Add(name, pattern, handler, http.MethodDelete)
+func (r *Router) AddDelete(name, pattern string, handler server.HandlerFunc, middlewares ...server.Middleware) server.Route {
+	return r.AddWithMiddlewares(name, pattern, handler, []string{http.MethodDelete}, middlewares...)
+}
+
+// Group returns a route group with the specified path prefix and middlewares.
+func (r *Router) Group(prefix string, middlewares ...server.Middleware) *RouteGroup {
+	return newRouteGroup(r, prefix, middlewares)
 }
 
 func (r *Router) Find(ctx *server.HttpContext) server.Route {
-	var mux *http.ServeMux
+	mux := r.mux(ctx.Request.Method)
+	if mux == nil {
+		return nil
+	}
 
-	switch ctx.Request.Method {
+	h, pattern := mux.Handler(ctx.Request)
+	if h2, ok := h.(*handler); ok && h2.route.pattern == ctx.Request.URL.Path {
+		return h2.route
+	}
+
+	if route, params := r.findParamRoute(ctx.Request.Method, ctx.Request.URL.EscapedPath()); route != nil {
+		ctx.PathParams = params
+		return route
+	}
+	return findStaticRoute(ctx.Request, h, pattern)
+}
+
+func (r *Router) AllowedMethods(ctx *server.HttpContext) []string {
+	var ms []string
+	p := ctx.Request.URL.EscapedPath()
+
+	for _, m := range methods {
+		if m == ctx.Request.Method {
+			continue
+		}
+
+		if h, pattern := r.mux(m).Handler(ctx.Request); findStaticRoute(ctx.Request, h, pattern) != nil {
+			ms = append(ms, m)
+		} else if route, _ := r.findParamRoute(m, p); route != nil {
+			ms = append(ms, m)
+		}
+	}
+	return ms
+}
+
+// URL returns the URL path of the route with the specified name, in which the path parameters
+// are replaced with the specified values (map[ParamName]Value).
+func (r *Router) URL(name string, params map[string]any) (string, error) {
+	route, ok := r.routesByName[name]
+	if !ok {
+		return "", fmt.Errorf("[routing.Router.URL] route '%s' not found", name)
+	}
+
+	if route.path == nil {
+		if len(params) > 0 {
+			return "", fmt.Errorf("[routing.Router.URL] route '%s' has no path parameters", name)
+		}
+		return route.pattern, nil
+	}
+
+	u, err := route.path.build(params)
+	if err != nil {
+		return "", fmt.Errorf("[routing.Router.URL] build a URL path of route '%s': %w", name, err)
+	}
+	return u, nil
+}
+
+func (r *Router) findParamRoute(method, escapedPath string) (*Route, server.PathParams) {
+	for _, route := range r.paramRoutes[method] {
+		if params, ok := route.path.match(escapedPath); ok {
+			return route, params
+		}
+	}
+	return nil, nil
+}
+
+func (r *Router) mux(method string) *http.ServeMux {
+	switch method {
 	case http.MethodGet:
-		mux = r.getMux
+		return r.getMux
 	case http.MethodPost:
-		mux = r.postMux
+		return r.postMux
 	case http.MethodPut:
-		mux = r.putMux
+		return r.putMux
 	case http.MethodPatch:
-		mux = r.patchMux
+		return r.patchMux
 	case http.MethodDelete:
-		mux = r.deleteMux
+		return r.deleteMux
 	case http.MethodHead:
-		mux = r.headMux
+		return r.headMux
 	case http.MethodConnect:
-		mux = r.connectMux
+		return r.connectMux
 	case http.MethodOptions:
-		mux = r.optionsMux
+		return r.optionsMux
 	case http.MethodTrace:
-		mux = r.traceMux
-	default:
-		return nil
+		return r.traceMux
 	}
+	return nil
+}
 
-	h, pattern := mux.Handler(ctx.Request)
+func allMethods() []string {
+	ms := make([]string, len(methods))
+	copy(ms, methods)
+	return ms
+}
+
+// findStaticRoute returns the route of the handler found by http.ServeMux or nil if the handler
+// is NotFoundHandler or the route requires a full path match.
+func findStaticRoute(req *http.Request, h http.Handler, pattern string) server.Route {
 	if h == nil {
 		return nil
 	}
 
 	if h2, ok := h.(*handler); ok {
-		if h2.route.fullPathMatch && h2.route.pattern != ctx.Request.URL.Path {
+		if h2.route.fullPathMatch && h2.route.pattern != req.URL.Path {
 			return nil
 		}
 		return h2.route
@@ -172,7 +274,7 @@ func (r *Router) Find(ctx *server.HttpContext) server.Route {
 	}
 
 	// see ../go/../net/http/server.go:/^type.redirectHandler
-	return NewRoute("", pattern, httpHandler(h), []string{ctx.Request.Method})
+	return NewRoute("", pattern, httpHandler(h), []string{req.Method})
 }
 
 type handler struct {
@@ -0,0 +1,196 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/exp/slices"
+
+	"personal-website-v2/pkg/net/http/server"
+)
+
+func test_newRouter() *Router {
+	h := func(ctx *server.HttpContext) {}
+	r := NewRouter()
+	r.AddGet("Apps_GetAll", "/api/apps", h)
+	r.AddGet("Apps_GetById", "/api/apps/{id:uint}", h)
+	r.AddGet("Apps_GetByName", "/api/apps/{name}", h)
+	r.AddPost("Apps_Stop", "/api/apps/{id:uint}/stop", h)
+
+	g := r.Group("/api/app-groups")
+	g.AddGet("AppGroups_GetById", "/{id:uint}", h)
+	g.Group("/{groupId:uint}").AddDelete("AppGroups_DeleteApp", "/apps/{appId:int}", h)
+	return r
+}
+
+func TestRouter_Find(t *testing.T) {
+	r := test_newRouter()
+	cases := []struct {
+		method         string
+		path           string
+		expectedRoute  string
+		expectedParams server.PathParams
+	}{
+		{http.MethodGet, "/api/apps", "Apps_GetAll", nil},
+		{http.MethodGet, "/api/apps/12", "Apps_GetById", server.PathParams{"id": "12"}},
+		{http.MethodGet, "/api/apps/app%20manager", "Apps_GetByName", server.PathParams{"name": "app manager"}},
+		{http.MethodPost, "/api/apps/12/stop", "Apps_Stop", server.PathParams{"id": "12"}},
+		{http.MethodPost, "/api/apps/abc/stop", "", nil},
+		{http.MethodGet, "/api/apps/12/stop", "", nil},
+		{http.MethodGet, "/api/app-groups/3", "AppGroups_GetById", server.PathParams{"id": "3"}},
+		{http.MethodDelete, "/api/app-groups/3/apps/-5", "AppGroups_DeleteApp", server.PathParams{"groupId": "3", "appId": "-5"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.method+" "+c.path, func(t *testing.T) {
+			ctx := server.NewHttpContext(httptest.NewRequest(c.method, c.path, nil), nil)
+			route := r.Find(ctx)
+
+			if len(c.expectedRoute) == 0 {
+				if route != nil {
+					t.Fatalf("expected: nil; got: %q", route.Name())
+				}
+				return
+			}
+
+			if route == nil {
+				t.Fatalf("expected: %q; got: nil", c.expectedRoute)
+			}
+			if route.Name() != c.expectedRoute {
+				t.Fatalf("expected: %q; got: %q", c.expectedRoute, route.Name())
+			}
+			if len(ctx.PathParams) != len(c.expectedParams) {
+				t.Fatalf("expected: %v; got: %v", c.expectedParams, ctx.PathParams)
+			}
+			for n, v := range c.expectedParams {
+				if v2, _ := ctx.PathParams.Get(n); v2 != v {
+					t.Fatalf("expected: %v; got: %v", c.expectedParams, ctx.PathParams)
+				}
+			}
+		})
+	}
+}
+
+func TestRouter_AllowedMethods(t *testing.T) {
+	r := test_newRouter()
+	cases := []struct {
+		method   string
+		path     string
+		expected []string
+	}{
+		{http.MethodPost, "/api/apps", []string{http.MethodGet}},
+		{http.MethodDelete, "/api/apps/12", []string{http.MethodGet}},
+		{http.MethodGet, "/api/apps/12/stop", []string{http.MethodPost}},
+		{http.MethodGet, "/api/unknown", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.method+" "+c.path, func(t *testing.T) {
+			ctx := server.NewHttpContext(httptest.NewRequest(c.method, c.path, nil), nil)
+
+			if ms := r.AllowedMethods(ctx); !slices.Equal(ms, c.expected) {
+				t.Fatalf("expected: %v; got: %v", c.expected, ms)
+			}
+		})
+	}
+}
+
+func TestRouter_URL(t *testing.T) {
+	r := test_newRouter()
+	cases := []struct {
+		name        string
+		params      map[string]any
+		expected    string
+		expectedErr bool
+	}{
+		{"Apps_GetAll", nil, "/api/apps", false},
+		{"Apps_GetById", map[string]any{"id": uint64(12)}, "/api/apps/12", false},
+		{"Apps_GetByName", map[string]any{"name": "app manager"}, "/api/apps/app%20manager", false},
+		{"AppGroups_DeleteApp", map[string]any{"groupId": 3, "appId": -5}, "/api/app-groups/3/apps/-5", false},
+		{"Apps_GetById", map[string]any{"id": "abc"}, "", true},
+		{"Apps_GetById", nil, "", true},
+		{"Apps_GetById", map[string]any{"id": 12, "name": "app"}, "", true},
+		{"Apps_Unknown", nil, "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			u, err := r.URL(c.name, c.params)
+
+			if c.expectedErr {
+				if err == nil {
+					t.Fatalf("expected: error; got: %q", u)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected: nil; got: %q", err)
+			}
+			if u != c.expected {
+				t.Fatalf("expected: %q; got: %q", c.expected, u)
+			}
+		})
+	}
+}
+
+func TestRouter_Middlewares(t *testing.T) {
+	var calls []string
+	mw := func(name string) server.Middleware {
+		return func(ctx *server.HttpContext, next server.HandlerFunc) {
+			calls = append(calls, name)
+			next.Invoke(ctx)
+		}
+	}
+	h := func(ctx *server.HttpContext) { calls = append(calls, "handler") }
+
+	r := NewRouter()
+	r.AddGet("Apps_GetAll", "/api/apps", h, mw("route"))
+	r.AddWithMiddlewares("Apps_Stop", "/api/apps/{id:uint}/stop", h, []string{http.MethodPost}, mw("route"))
+	g := r.Group("/api/app-groups", mw("group"))
+	g.AddGet("AppGroups_GetById", "/{id:uint}", h, mw("route"))
+	g.Group("/{groupId:uint}", mw("nestedGroup")).AddDelete("AppGroups_DeleteApp", "/apps/{appId:int}", h, mw("route")).Use(mw("use"))
+
+	cases := []struct {
+		method   string
+		path     string
+		expected []string
+	}{
+		{http.MethodGet, "/api/apps", []string{"route", "handler"}},
+		{http.MethodPost, "/api/apps/12/stop", []string{"route", "handler"}},
+		{http.MethodGet, "/api/app-groups/3", []string{"group", "route", "handler"}},
+		{http.MethodDelete, "/api/app-groups/3/apps/-5", []string{"group", "nestedGroup", "route", "use", "handler"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.method+" "+c.path, func(t *testing.T) {
+			ctx := server.NewHttpContext(httptest.NewRequest(c.method, c.path, nil), nil)
+			route := r.Find(ctx)
+			if route == nil {
+				t.Fatal("expected: route; got: nil")
+			}
+
+			calls = nil
+			server.Chain(route.Handler(), route.Middlewares()...).Invoke(ctx)
+
+			if !slices.Equal(calls, c.expected) {
+				t.Fatalf("expected: %v; got: %v", c.expected, calls)
+			}
+		})
+	}
+}
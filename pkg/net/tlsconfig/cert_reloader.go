@@ -0,0 +1,242 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"personal-website-v2/pkg/base/datetime"
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+	"personal-website-v2/pkg/logging/events"
+)
+
+// certReloader loads a certificate and, optionally, a CA certificate pool and reloads them
+// if the files have been changed. The files are checked lazily during TLS handshakes,
+// no more than once per interval.
+type certReloader struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	interval   time.Duration
+	mu         sync.RWMutex
+	cert       *tls.Certificate
+	caPool     *x509.CertPool
+	modTimes   [3]time.Time // certFile, keyFile, caFile
+	lastCheck  atomic.Int64 // unix nano
+	logger     logging.Logger[*context.LogEntryContext]
+	loggerCtx  *context.LogEntryContext
+	hasCert    bool
+	hasCAPool  bool
+	isChecking atomic.Bool
+}
+
+func newCertReloader(certFile, keyFile, caFile string, interval time.Duration, loggerFactory logging.LoggerFactory[*context.LogEntryContext],
+) (*certReloader, error) {
+	var l logging.Logger[*context.LogEntryContext]
+	if loggerFactory != nil {
+		var err error
+		if l, err = loggerFactory.CreateLogger("net.tlsconfig.certReloader"); err != nil {
+			return nil, fmt.Errorf("[tlsconfig.newCertReloader] create a logger: %w", err)
+		}
+	}
+
+	r := &certReloader{
+		certFile:  certFile,
+		keyFile:   keyFile,
+		caFile:    caFile,
+		interval:  interval,
+		logger:    l,
+		loggerCtx: &context.LogEntryContext{Fields: []*logging.Field{logging.NewField("certFile", certFile), logging.NewField("caFile", caFile)}},
+		hasCert:   len(certFile) > 0,
+		hasCAPool: len(caFile) > 0,
+	}
+
+	modTimes, err := r.getModTimes()
+	if err != nil {
+		return nil, fmt.Errorf("[tlsconfig.newCertReloader] get modification times of the files: %w", err)
+	}
+
+	if err = r.load(modTimes); err != nil {
+		return nil, fmt.Errorf("[tlsconfig.newCertReloader] load the files: %w", err)
+	}
+
+	r.lastCheck.Store(datetime.Now().UnixNano())
+	return r, nil
+}
+
+func (r *certReloader) certificate() *tls.Certificate {
+	r.reloadIfChanged()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+func (r *certReloader) certPool() *x509.CertPool {
+	r.reloadIfChanged()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+func (r *certReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+// verifyClientCertificate verifies the client certificate chain using the current CA certificate pool.
+func (r *certReloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("[tlsconfig.certReloader.verifyClientCertificate] client certificate is missing")
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i := 0; i < len(rawCerts); i++ {
+		c, err := x509.ParseCertificate(rawCerts[i])
+		if err != nil {
+			return fmt.Errorf("[tlsconfig.certReloader.verifyClientCertificate] parse a certificate: %w", err)
+		}
+		certs[i] = c
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.certPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, c := range certs[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+
+	if _, err := certs[0].Verify(opts); err != nil {
+		return fmt.Errorf("[tlsconfig.certReloader.verifyClientCertificate] verify a client certificate: %w", err)
+	}
+	return nil
+}
+
+func (r *certReloader) reloadIfChanged() {
+	if r.interval <= 0 {
+		return
+	}
+
+	now := datetime.Now().UnixNano()
+	if now-r.lastCheck.Load() < int64(r.interval) || !r.isChecking.CompareAndSwap(false, true) {
+		return
+	}
+	defer r.isChecking.Store(false)
+
+	r.lastCheck.Store(now)
+	modTimes, err := r.getModTimes()
+	if err != nil {
+		r.logError(err, "[tlsconfig.certReloader.reloadIfChanged] get modification times of the files")
+		return
+	}
+
+	r.mu.RLock()
+	changed := modTimes != r.modTimes
+	r.mu.RUnlock()
+
+	if !changed {
+		return
+	}
+
+	if err = r.load(modTimes); err != nil {
+		r.logError(err, "[tlsconfig.certReloader.reloadIfChanged] reload the files (the previous certificates are still used)")
+		return
+	}
+
+	const msg = "[tlsconfig.certReloader.reloadIfChanged] certificates have been reloaded"
+	if r.logger != nil {
+		r.logger.InfoWithEvent(r.loggerCtx, events.Network_TlsCertificateReloaded, msg)
+	} else {
+		log.Println("[INFO]", msg)
+	}
+}
+
+// logError logs an error. If the logger isn't specified (e.g. the client certificates
+// of the logging manager service are used before the logging is configured), the standard logger is used.
+func (r *certReloader) logError(err error, msg string) {
+	if r.logger != nil {
+		r.logger.ErrorWithEvent(r.loggerCtx, events.Network_TlsCertificateReloadFailure, err, msg)
+	} else {
+		log.Println("[ERROR]", msg+":", err)
+	}
+}
+
+func (r *certReloader) load(modTimes [3]time.Time) error {
+	var cert *tls.Certificate
+	if r.hasCert {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("[tlsconfig.certReloader.load] load a key pair: %w", err)
+		}
+		cert = &c
+	}
+
+	var caPool *x509.CertPool
+	if r.hasCAPool {
+		var err error
+		if caPool, err = loadCertPool(r.caFile); err != nil {
+			return fmt.Errorf("[tlsconfig.certReloader.load] load a CA certificate pool: %w", err)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *certReloader) getModTimes() ([3]time.Time, error) {
+	var ts [3]time.Time
+	for i, f := range [3]string{r.certFile, r.keyFile, r.caFile} {
+		if len(f) == 0 {
+			continue
+		}
+
+		fi, err := os.Stat(f)
+		if err != nil {
+			return ts, fmt.Errorf("[tlsconfig.certReloader.getModTimes] get the file info: %w", err)
+		}
+		ts[i] = fi.ModTime()
+	}
+	return ts, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("[tlsconfig.loadCertPool] read a file: %w", err)
+	}
+
+	p := x509.NewCertPool()
+	if !p.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("[tlsconfig.loadCertPool] no certificates in the file '%s'", file)
+	}
+	return p, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import "time"
+
+// ServerOptions are the TLS options of a server.
+type ServerOptions struct {
+	// The certificate file (PEM).
+	CertFile string
+
+	// The private key file (PEM).
+	KeyFile string
+
+	// Optional. The file of the CA certificates (PEM) used to verify the client certificates.
+	// If it's specified, mutual TLS is used and the clients must present a valid certificate.
+	ClientCAFile string
+
+	// Optional. The names of the clients (apps) allowed to connect if mutual TLS is used.
+	// The common name and the DNS names of the client certificate are matched against them.
+	// If it's empty, any client certificate issued by the CAs is accepted.
+	AllowedClientNames []string
+
+	// The interval at which the files are checked for changes. If it's zero or negative,
+	// the files aren't reloaded.
+	ReloadInterval time.Duration
+}
+
+// ClientOptions are the TLS options of a client.
+type ClientOptions struct {
+	// Optional. The file of the CA certificates (PEM) used to verify the server certificate.
+	// If it isn't specified, the system's root CAs are used.
+	CAFile string
+
+	// Optional. The certificate file (PEM) presented to the server if it requires mutual TLS.
+	CertFile string
+
+	// Optional. The private key file (PEM) of the client certificate.
+	KeyFile string
+
+	// Optional. The server name used to verify the server certificate.
+	// If it isn't specified, the host of the server address is used.
+	ServerName string
+
+	// The interval at which the client certificate files are checked for changes.
+	// If it's zero or negative, the files aren't reloaded.
+	ReloadInterval time.Duration
+}
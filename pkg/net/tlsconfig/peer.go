@@ -0,0 +1,72 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// verifyClientName returns a function that verifies that the client certificate has been issued
+// to one of the allowed names. The chain must be verified before the function is called.
+func verifyClientName(allowedNames []string) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	m := make(map[string]struct{}, len(allowedNames))
+	for _, n := range allowedNames {
+		m[n] = struct{}{}
+	}
+
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("[tlsconfig.verifyClientName] client certificate is missing")
+		}
+
+		c, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return fmt.Errorf("[tlsconfig.verifyClientName] parse a certificate: %w", err)
+		}
+
+		for _, n := range certificateNames(c) {
+			if _, ok := m[n]; ok {
+				return nil
+			}
+		}
+		return fmt.Errorf("[tlsconfig.verifyClientName] client certificate name '%s' isn't allowed", c.Subject.CommonName)
+	}
+}
+
+// PeerName returns the name of the peer authenticated by its certificate (mutual TLS),
+// that is, the common name of the certificate or, if it's empty, the first DNS name.
+// It returns an empty string if the peer hasn't presented a certificate.
+func PeerName(cs *tls.ConnectionState) string {
+	if cs == nil || len(cs.PeerCertificates) == 0 {
+		return ""
+	}
+
+	if ns := certificateNames(cs.PeerCertificates[0]); len(ns) > 0 {
+		return ns[0]
+	}
+	return ""
+}
+
+// certificateNames returns the common name and the DNS names of the certificate.
+func certificateNames(c *x509.Certificate) []string {
+	ns := make([]string, 0, len(c.DNSNames)+1)
+	if len(c.Subject.CommonName) > 0 {
+		ns = append(ns, c.Subject.CommonName)
+	}
+	return append(ns, c.DNSNames...)
+}
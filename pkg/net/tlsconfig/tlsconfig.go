@@ -0,0 +1,94 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"personal-website-v2/pkg/logging"
+	"personal-website-v2/pkg/logging/context"
+)
+
+// NewServerConfig returns a new TLS config of a server. The certificate and the client CA
+// certificates are reloaded if the files have been changed.
+func NewServerConfig(opts *ServerOptions, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*tls.Config, error) {
+	if len(opts.CertFile) == 0 || len(opts.KeyFile) == 0 {
+		return nil, errors.New("[tlsconfig.NewServerConfig] certFile or keyFile is empty")
+	}
+
+	r, err := newCertReloader(opts.CertFile, opts.KeyFile, opts.ClientCAFile, opts.ReloadInterval, loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[tlsconfig.NewServerConfig] new certReloader: %w", err)
+	}
+
+	c := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+	}
+
+	if len(opts.ClientCAFile) > 0 {
+		// the client certificates are verified by verifyClientCertificate instead of ClientCAs
+		// so that the CA certificates can be reloaded
+		c.ClientAuth = tls.RequireAnyClientCert
+		c.VerifyPeerCertificate = r.verifyClientCertificate
+
+		if len(opts.AllowedClientNames) > 0 {
+			verifyName := verifyClientName(opts.AllowedClientNames)
+			c.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+				if err := r.verifyClientCertificate(rawCerts, verifiedChains); err != nil {
+					return err
+				}
+				return verifyName(rawCerts, verifiedChains)
+			}
+		}
+	} else if len(opts.AllowedClientNames) > 0 {
+		return nil, errors.New("[tlsconfig.NewServerConfig] allowedClientNames requires clientCAFile")
+	}
+	return c, nil
+}
+
+// NewClientConfig returns a new TLS config of a client. The client certificate, if any,
+// is reloaded if the files have been changed. loggerFactory may be nil if the client
+// is used before the logging is configured.
+func NewClientConfig(opts *ClientOptions, loggerFactory logging.LoggerFactory[*context.LogEntryContext]) (*tls.Config, error) {
+	if (len(opts.CertFile) > 0) != (len(opts.KeyFile) > 0) {
+		return nil, errors.New("[tlsconfig.NewClientConfig] certFile and keyFile must be specified together")
+	}
+
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+	}
+
+	if len(opts.CAFile) > 0 {
+		p, err := loadCertPool(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("[tlsconfig.NewClientConfig] load a CA certificate pool: %w", err)
+		}
+		c.RootCAs = p
+	}
+
+	if len(opts.CertFile) > 0 {
+		r, err := newCertReloader(opts.CertFile, opts.KeyFile, "", opts.ReloadInterval, loggerFactory)
+		if err != nil {
+			return nil, fmt.Errorf("[tlsconfig.NewClientConfig] new certReloader: %w", err)
+		}
+		c.GetClientCertificate = r.getClientCertificate
+	}
+	return c, nil
+}
@@ -14,8 +14,11 @@
 
 package config
 
+import apiclientconfig "personal-website-v2/api-clients/config"
+
 type Config struct {
-	Apps map[string]*App `json:"apps"`
+	Apps     map[string]*App `json:"apps"`
+	Identity *Identity       `json:"identity"`
 }
 
 type App struct {
@@ -28,3 +31,11 @@ type AppInstance struct {
 	ConfigPath string   `json:"configFile"`
 	Tags       []string `json:"tags"`
 }
+
+type Identity struct {
+	// The identity service client config.
+	Service *apiclientconfig.ServiceClientConfig `json:"service"`
+
+	// The ID of the user on whose behalf the manifests are reconciled.
+	UserId uint64 `json:"userId"`
+}
@@ -23,8 +23,9 @@ import (
 )
 
 const (
-	CmdNameStart = "start"
-	CmdNameStop  = "stop"
+	CmdNameStart     = "start"
+	CmdNameStop      = "stop"
+	CmdNameProvision = "provision"
 )
 
 const (
@@ -56,6 +57,10 @@ func ExecPWCmd(cmd string, opts map[string]string, c *config.Config) error {
 		if err := ExecStopPWCmd(opts, c); err != nil {
 			return fmt.Errorf("[commands.ExecPWCmd] execute a 'stop pw' command: %w", err)
 		}
+	case CmdNameProvision:
+		if err := ExecProvisionPWCmd(opts, c); err != nil {
+			return fmt.Errorf("[commands.ExecPWCmd] execute a 'provision pw' command: %w", err)
+		}
 	default:
 		return fmt.Errorf("[commands.ExecPWCmd] invalid command %q", cmd)
 	}
@@ -0,0 +1,98 @@
+// Copyright 2023 Alexey Lavrenchenko. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	identityclient "personal-website-v2/api-clients/identity"
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
+	"personal-website-v2/pkg/base/strings"
+	"personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/identity/provisioning"
+	"personal-website-v2/pwctl/src/app/config"
+	"personal-website-v2/pwctl/src/internal/options"
+)
+
+// ExecProvisionPWCmd executes a command to reconcile the manifest (permission groups, permissions,
+// roles and grants) with the identity. If the plan option is specified, the changes are only printed.
+func ExecProvisionPWCmd(opts map[string]string, c *config.Config) error {
+	var mf string
+	if mf = opts[options.OptionNameManifestFile]; len(mf) == 0 {
+		if mf = opts[options.ShortOptionNameManifestFile]; len(mf) == 0 {
+			return errors.NewError(errors.ErrorCodeInvalidData, "manifest file not specified")
+		}
+	}
+
+	if c.Identity == nil || c.Identity.Service == nil {
+		return fmt.Errorf("[commands.ExecProvisionPWCmd] %s config is missing", appIdentity)
+	}
+	if strings.IsEmptyOrWhitespace(c.Identity.Service.ServerAddr) {
+		return errors.NewError(errors.ErrorCodeInvalidData, fmt.Sprintf("[%s] server address is empty", appIdentity))
+	}
+
+	m, err := provisioning.LoadManifest(mf)
+	if err != nil {
+		return fmt.Errorf("[commands.ExecProvisionPWCmd] load a manifest: %w", err)
+	}
+
+	tlsc, err := c.Identity.Service.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[commands.ExecProvisionPWCmd] get a TLS config of the identity service: %w", err)
+	}
+
+	is := identityclient.NewIdentityService(&identityclient.IdentityServiceClientConfig{
+		ServerAddr:  c.Identity.Service.ServerAddr,
+		DialTimeout: time.Duration(c.Identity.Service.DialTimeout) * time.Millisecond,
+		CallTimeout: time.Duration(c.Identity.Service.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
+	})
+	if err = is.Init(); err != nil {
+		return fmt.Errorf("[commands.ExecProvisionPWCmd] init an identity service: %w", err)
+	}
+
+	defer func() {
+		if err := is.Dispose(); err != nil {
+			fmt.Printf("[ERROR] [commands.ExecProvisionPWCmd] dispose of the identity service: %v\n", err)
+		}
+	}()
+
+	var cs []*provisioningpb.Change
+	_, plan := opts[options.OptionNamePlan]
+	if plan {
+		if cs, err = provisioning.Plan(is.Provisioning, m, c.Identity.UserId); err != nil {
+			return fmt.Errorf("[commands.ExecProvisionPWCmd] plan the reconciliation of the manifest: %w", err)
+		}
+	} else if cs, err = provisioning.Apply(is.Provisioning, m, c.Identity.UserId); err != nil {
+		return fmt.Errorf("[commands.ExecProvisionPWCmd] apply the manifest: %w", err)
+	}
+
+	if len(cs) == 0 {
+		fmt.Println("[commands.ExecProvisionPWCmd] no changes, the manifest is up to date")
+		return nil
+	}
+
+	for _, ch := range cs {
+		fmt.Println(provisioning.FormatChange(ch))
+	}
+
+	if plan {
+		fmt.Printf("[commands.ExecProvisionPWCmd] %d change(s) to apply\n", len(cs))
+	} else {
+		fmt.Printf("[commands.ExecProvisionPWCmd] %d change(s) have been applied\n", len(cs))
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ pwctl COMMAND [APP] [OPTIONS...]
 Commands:
 	start
 	stop
+	provision
 
 Apps:
 	app-manager
@@ -33,4 +34,6 @@ Apps:
 Options:
 	--help, -h
 	--version, -v
-	--config-file=, -c`
+	--config-file=, -c
+	--manifest-file=, -m
+	--plan`
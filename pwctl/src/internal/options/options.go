@@ -21,4 +21,8 @@ const (
 	ShortOptionNameVersion    = "v"
 	OptionNameConfigFile      = "config-file"
 	ShortOptionNameConfigFile = "c"
+
+	OptionNameManifestFile      = "manifest-file"
+	ShortOptionNameManifestFile = "m"
+	OptionNamePlan              = "plan"
 )
@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "webclient.app.stop"
+	PermissionApp_Stop       = "webclient.app.stop"
+	PermissionApp_GetMetrics = "webclient.app.getMetrics"
 
 	// Client permissions.
 	PermissionClient_Init = "webclient.clients.init"
@@ -24,5 +25,6 @@ const (
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionClient_Init,
 }
@@ -15,6 +15,7 @@
 package app
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"personal-website-v2/api-clients/appmanager"
 	identityclient "personal-website-v2/api-clients/identity"
 	"personal-website-v2/api-clients/loggingmanager"
+	provisioningpb "personal-website-v2/go-apis/identity/provisioning"
 	"personal-website-v2/pkg/actions"
 	actionlogging "personal-website-v2/pkg/actions/logging"
 	"personal-website-v2/pkg/app"
@@ -50,9 +52,13 @@ import (
 	appcontrollers "personal-website-v2/pkg/app/service/net/http/server/controllers/app"
 	"personal-website-v2/pkg/base/env"
 	"personal-website-v2/pkg/base/nullable"
+	kafkacomponent "personal-website-v2/pkg/components/kafka"
 	"personal-website-v2/pkg/db/postgres"
 	errs "personal-website-v2/pkg/errors"
+	"personal-website-v2/pkg/health"
 	"personal-website-v2/pkg/identity"
+	identityapikeys "personal-website-v2/pkg/identity/apikeys"
+	"personal-website-v2/pkg/identity/provisioning"
 	"personal-website-v2/pkg/logging"
 	"personal-website-v2/pkg/logging/adapters/console"
 	filelogadapter "personal-website-v2/pkg/logging/adapters/filelog"
@@ -67,6 +73,7 @@ import (
 	httpserver "personal-website-v2/pkg/net/http/server"
 	httpserverlogging "personal-website-v2/pkg/net/http/server/logging"
 	httpserverrouting "personal-website-v2/pkg/net/http/server/routing"
+	"personal-website-v2/pkg/net/tlsconfig"
 	"personal-website-v2/pkg/services/emailnotifier"
 	"personal-website-v2/pkg/web/identity/authn/cookies"
 	webresources "personal-website-v2/pkg/web/resources"
@@ -109,6 +116,8 @@ type Application struct {
 
 	resources appresources.AppResources
 
+	healthChecker *health.Checker
+
 	identityManager identity.IdentityManager
 
 	tranManager   *actions.TransactionManager
@@ -121,9 +130,10 @@ type Application struct {
 
 	postgresManager *postgres.DbManager[wpostgres.Stores]
 
-	appManagerService     *appmanager.AppManagerService
-	loggingManagerService *loggingmanager.LoggingManagerService
-	identityService       *identityclient.IdentityService
+	appManagerService       *appmanager.AppManagerService
+	loggingManagerService   *loggingmanager.LoggingManagerService
+	identityService         *identityclient.IdentityService
+	apiKeyRevocationService *identityapikeys.ApiKeyRevocationService
 
 	emailNotifier emailnotifier.EmailNotifier
 
@@ -291,10 +301,20 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] configure the identity: %w", err)
 	}
 
+	if err = a.provisionIdentity(); err != nil {
+		return fmt.Errorf("[app.Application.Start] provision the identity: %w", err)
+	}
+
 	if err = a.identityManager.Init(); err != nil {
 		return fmt.Errorf("[app.Application.Start] init an identity manager: %w", err)
 	}
 
+	if a.apiKeyRevocationService != nil {
+		if err = a.apiKeyRevocationService.Start(); err != nil {
+			return fmt.Errorf("[app.Application.Start] start the API key revocation service: %w", err)
+		}
+	}
+
 	if err = a.configureActions(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure actions: %w", err)
 	}
@@ -311,6 +331,8 @@ func (a *Application) Start() (err error) {
 		return fmt.Errorf("[app.Application.Start] configure: %w", err)
 	}
 
+	a.configureHealthChecks()
+
 	if err = a.configureHttpServer(); err != nil {
 		return fmt.Errorf("[app.Application.Start] configure an HTTP server: %w", err)
 	}
@@ -353,10 +375,16 @@ func (a *Application) configureResources() {
 }
 
 func (a *Application) startLoggingSession() error {
+	tlsc, err := a.config.Apis.Clients.LoggingManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startLoggingSession] get a TLS config of the logging manager service: %w", err)
+	}
+
 	c := &loggingmanager.LoggingManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.LoggingManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.LoggingManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	lms := loggingmanager.NewLoggingManagerService(c)
 
@@ -394,10 +422,16 @@ func (a *Application) startLoggingSession() error {
 }
 
 func (a *Application) startSession() error {
+	tlsc, err := a.config.Apis.Clients.AppManagerService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.startSession] get a TLS config of the app manager service: %w", err)
+	}
+
 	c := &appmanager.AppManagerServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.AppManagerService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.AppManagerService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	ams := appmanager.NewAppManagerService(c)
 
@@ -585,10 +619,16 @@ func (a *Application) createFileLogAdapter(appInfo *info.AppInfo, loggingSession
 }
 
 func (a *Application) configureIdentity() error {
+	tlsc, err := a.config.Apis.Clients.IdentityService.TLSConfig()
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureIdentity] get a TLS config of the identity service: %w", err)
+	}
+
 	c := &identityclient.IdentityServiceClientConfig{
 		ServerAddr:  a.config.Apis.Clients.IdentityService.ServerAddr,
 		DialTimeout: time.Duration(a.config.Apis.Clients.IdentityService.DialTimeout) * time.Millisecond,
 		CallTimeout: time.Duration(a.config.Apis.Clients.IdentityService.CallTimeout) * time.Millisecond,
+		TLSConfig:   tlsc,
 	}
 	is := identityclient.NewIdentityService(c)
 	if err := is.Init(); err != nil {
@@ -603,7 +643,12 @@ func (a *Application) configureIdentity() error {
 		}
 	}()
 
-	im, err := identity.NewIdentityManager(a.config.UserId, is, widentity.Roles, widentity.Permissions, a.loggerFactory)
+	akc, err := a.configureApiKeyCache()
+	if err != nil {
+		return fmt.Errorf("[app.Application.configureIdentity] configure the API key cache: %w", err)
+	}
+
+	im, err := identity.NewIdentityManager(a.config.UserId, is, widentity.Roles, widentity.Permissions, nil, akc, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureIdentity] new identity manager: %w", err)
 	}
@@ -613,6 +658,66 @@ func (a *Application) configureIdentity() error {
 	return nil
 }
 
+// configureApiKeyCache creates the API key cache and the service that invalidates the cache
+// when API keys are revoked, if the cache is configured.
+func (a *Application) configureApiKeyCache() (*identity.ApiKeyCache, error) {
+	if a.config.Identity == nil || a.config.Identity.ApiKeyCache == nil {
+		return nil, nil
+	}
+
+	cc := a.config.Identity.ApiKeyCache
+	c, err := identity.NewApiKeyCache(&identity.ApiKeyCacheConfig{
+		Capacity: cc.Capacity,
+		TTL:      time.Duration(cc.TTL) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key cache: %w", err)
+	}
+
+	sc := &identityapikeys.ApiKeyRevocationServiceConfig{
+		Kafka: &identityapikeys.ApiKeyRevocationServiceKafkaConfig{
+			Config: cc.Revocation.Kafka.ConsumerConfig.Config(),
+			Topic:  cc.Revocation.Kafka.Topic,
+		},
+	}
+	s, err := identityapikeys.NewApiKeyRevocationService(a.appSessionId.Value, c, sc, a.loggerFactory)
+	if err != nil {
+		return nil, fmt.Errorf("[app.Application.configureApiKeyCache] new API key revocation service: %w", err)
+	}
+
+	a.apiKeyRevocationService = s
+	return c, nil
+}
+
+// provisionIdentity reconciles the manifest of the service (permission groups, permissions, roles and grants)
+// with the identity, if the provisioning is configured.
+func (a *Application) provisionIdentity() error {
+	if a.config.Identity == nil || a.config.Identity.Provisioning == nil {
+		return nil
+	}
+
+	m, err := provisioning.LoadManifest(a.config.Identity.Provisioning.ManifestFile)
+	if err != nil {
+		return fmt.Errorf("[app.Application.provisionIdentity] load a manifest: %w", err)
+	}
+
+	var cs []*provisioningpb.Change
+	if a.config.Identity.Provisioning.PlanOnly {
+		if cs, err = provisioning.Plan(a.identityService.Provisioning, m, a.config.UserId); err != nil {
+			return fmt.Errorf("[app.Application.provisionIdentity] plan the reconciliation of the manifest: %w", err)
+		}
+	} else if cs, err = provisioning.Apply(a.identityService.Provisioning, m, a.config.UserId); err != nil {
+		return fmt.Errorf("[app.Application.provisionIdentity] apply the manifest: %w", err)
+	}
+
+	for _, c := range cs {
+		a.log(logging.LogLevelInfo, events.ApplicationEvent, nil, "[app.Application.provisionIdentity] "+provisioning.FormatChange(c),
+			logging.NewField("planOnly", a.config.Identity.Provisioning.PlanOnly),
+		)
+	}
+	return nil
+}
+
 func (a *Application) configureActions() error {
 	c := &actionlogging.LoggerConfig{
 		AppInfo: &info.AppInfo{
@@ -706,6 +811,28 @@ func (a *Application) configure() error {
 	return nil
 }
 
+func (a *Application) configureHealthChecks() {
+	c := health.NewChecker(0)
+	c.Add("appSession", a.session.HealthCheck)
+	c.Add("loggingSession", a.loggingSession.HealthCheck)
+	c.Add("postgres", a.postgresManager.HealthCheck)
+	c.Add("kafka", kafkacomponent.HealthCheck)
+
+	if a.appManagerService != nil {
+		c.Add("appManagerService", a.appManagerService.HealthCheck)
+	}
+
+	if a.loggingManagerService != nil {
+		c.Add("loggingManagerService", a.loggingManagerService.HealthCheck)
+	}
+
+	if a.identityService != nil {
+		c.Add("identityService", a.identityService.HealthCheck)
+	}
+
+	a.healthChecker = c
+}
+
 func (a *Application) configureHttpServer() error {
 	var ac *cookies.CookieAuthnConfig
 	if a.config.Auth != nil && a.config.Auth.Authn != nil && a.config.Auth.Authn.Http != nil && a.config.Auth.Authn.Http.Cookies != nil {
@@ -729,6 +856,12 @@ func (a *Application) configureHttpServer() error {
 		return fmt.Errorf("[app.Application.configureHttpServer] configure HTTP routing: %w", err)
 	}
 
+	if a.config.Net.Http.Server.HealthProbes {
+		if err := httpserver.AddHealthRoutes(router, a.healthChecker, a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] add the routes of the health probes: %w", err)
+		}
+	}
+
 	rpcb := httpserver.NewRequestPipelineConfigBuilder()
 	rpcb.SetPipelineLifetime(rpl).
 		UseAuthentication().
@@ -760,6 +893,13 @@ func (a *Application) configureHttpServer() error {
 	}
 
 	a.httpServerLogger = l
+	var tlsc *tls.Config
+	if a.config.Net.Http.Server.TLS != nil {
+		if tlsc, err = tlsconfig.NewServerConfig(a.config.Net.Http.Server.TLS.Options(), a.loggerFactory); err != nil {
+			return fmt.Errorf("[app.Application.configureHttpServer] new TLS config: %w", err)
+		}
+	}
+
 	hsb := httpserver.NewHttpServerBuilder(httpServerId, a.appSessionId.Value, l, a.loggerFactory)
 	hsb.Configure(func(config *httpserver.HttpServerConfig) {
 		config.Addr = a.config.Net.Http.Server.Addr
@@ -767,6 +907,7 @@ func (a *Application) configureHttpServer() error {
 		config.WriteTimeout = time.Duration(a.config.Net.Http.Server.WriteTimeout) * time.Millisecond
 		config.IdleTimeout = time.Duration(a.config.Net.Http.Server.IdleTimeout) * time.Millisecond
 		config.PipelineConfig = rpcb.Build()
+		config.TLSConfig = tlsc
 	})
 
 	s, err := hsb.Build()
@@ -779,7 +920,10 @@ func (a *Application) configureHttpServer() error {
 }
 
 func (a *Application) configureHttpRouting(router *httpserverrouting.Router) error {
-	ic := &appcontrollers.ApplicationControllerIdentityConfig{StopPermission: widentity.PermissionApp_Stop}
+	ic := &appcontrollers.ApplicationControllerIdentityConfig{
+		StopPermission:       widentity.PermissionApp_Stop,
+		GetMetricsPermission: widentity.PermissionApp_GetMetrics,
+	}
 	appController, err := appcontrollers.NewApplicationController(a, a.appSessionId.Value, a.actionManager, a.identityManager, ic, a.loggerFactory)
 	if err != nil {
 		return fmt.Errorf("[app.Application.configureHttpRouting] new application controller: %w", err)
@@ -811,6 +955,7 @@ func (a *Application) configureHttpRouting(router *httpserverrouting.Router) err
 	// private
 	// api
 	router.AddPost("App_Stop", "/private/api/app/stop", appController.Stop)
+	router.AddGet("App_GetMetrics", "/metrics", appController.GetMetrics)
 
 	// public
 	// pages
@@ -983,6 +1128,12 @@ func (a *Application) stop(ctx *actions.OperationContext) {
 		a.postgresManager.Dispose()
 	}
 
+	if a.apiKeyRevocationService != nil && a.apiKeyRevocationService.IsStarted() {
+		if err := a.apiKeyRevocationService.Stop(); err != nil {
+			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] stop the API key revocation service")
+		}
+	}
+
 	if a.identityService != nil {
 		if err := a.identityService.Dispose(); err != nil {
 			a.logWithContext(leCtx, logging.LogLevelError, events.ApplicationEvent, err, "[app.Application.stop] dispose of the identity service")
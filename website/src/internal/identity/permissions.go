@@ -16,7 +16,8 @@ package identity
 
 const (
 	// Application permissions.
-	PermissionApp_Stop = "website.app.stop"
+	PermissionApp_Stop       = "website.app.stop"
+	PermissionApp_GetMetrics = "website.app.getMetrics"
 
 	// Page permissions.
 	PermissionPage_Get        = "website.pages.get"
@@ -37,6 +38,7 @@ const (
 
 var Permissions = []string{
 	PermissionApp_Stop,
+	PermissionApp_GetMetrics,
 	PermissionPage_Get,
 	PermissionPage_GetHome,
 	PermissionPage_GetInfo,